@@ -2,13 +2,24 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
+	"math/rand"
+	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"encoding/base64"
+	"encoding/json"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"gopkg.in/yaml.v2"
 )
 
 type InstanceNetWork string
@@ -57,6 +68,9 @@ const (
 	Pending     = Status("Pending")
 	Creating    = Status("Creating")
 	Running     = Status("Running")
+	Starting    = Status("Starting")
+	Stopping    = Status("Stopping")
+	Stopped     = Status("Stopped")
 	Available   = Status("Available")
 	Unavailable = Status("Unavailable")
 	Modifying   = Status("Modifying")
@@ -66,9 +80,12 @@ const (
 	Unassociating = Status("Unassociating")
 	InUse         = Status("InUse")
 
-	Active   = Status("Active")
-	Inactive = Status("Inactive")
-	Idle     = Status("Idle")
+	Active       = Status("Active")
+	Inactive     = Status("Inactive")
+	Idle         = Status("Idle")
+	Activating   = Status("Activating")
+	Deactivating = Status("Deactivating")
+	Connecting   = Status("Connecting")
 )
 
 type IPType string
@@ -87,6 +104,11 @@ const (
 	ResourceTypeVSwitch  = ResourceType("VSwitch")
 	ResourceTypeRds      = ResourceType("Rds")
 	IoOptimized          = ResourceType("IoOptimized")
+
+	ResourceTypeMongoDB       = ResourceType("MongoDB")
+	ResourceTypePolarDB       = ResourceType("PolarDB")
+	ResourceTypeElasticsearch = ResourceType("Elasticsearch")
+	ResourceTypeHBase         = ResourceType("HBase")
 )
 
 type InternetChargeType string
@@ -96,6 +118,13 @@ const (
 	PayByTraffic   = InternetChargeType("PayByTraffic")
 )
 
+type OutputFileFormat string
+
+const (
+	OutputFileJson = OutputFileFormat("json")
+	OutputFileYaml = OutputFileFormat("yaml")
+)
+
 // timeout for common product, ecs e.g.
 const DefaultTimeout = 120
 
@@ -120,6 +149,27 @@ func getRegion(d *schema.ResourceData, meta interface{}) common.Region {
 	return meta.(*AliyunClient).Region
 }
 
+// regionSchema returns the schema of the region argument, which overrides the provider's region
+// for the resources that can be managed in another region.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+}
+
+// regionClient returns the client of the region specified by the resource's region argument,
+// or the provider's client if it is not specified.
+func regionClient(d *schema.ResourceData, meta interface{}) (*AliyunClient, error) {
+	client := meta.(*AliyunClient)
+	if v, ok := d.GetOk("region"); ok {
+		return client.WithRegion(common.Region(v.(string)))
+	}
+	return client, nil
+}
+
 // Protocol represents network protocol
 type Protocol string
 
@@ -145,10 +195,6 @@ func isProtocolValid(value string) bool {
 	return res
 }
 
-var DefaultBusinessInfo = ecs.BusinessInfo{
-	Pack: "terraform",
-}
-
 // default region for all resource
 const DEFAULT_REGION = "cn-beijing"
 
@@ -221,20 +267,40 @@ func getPagination(pageNumber, pageSize int) (pagination common.Pagination) {
 	return
 }
 
+// describeAllPages calls describe page by page from the first page with PageSizeLarge until the pagination
+// result shows there are no more pages. A nil pagination result is regarded as the last page.
+func describeAllPages(describe func(pagination common.Pagination) (*common.PaginationResult, error)) error {
+	pagination := getPagination(1, PageSizeLarge)
+	for {
+		result, err := describe(pagination)
+		if err != nil {
+			return err
+		}
+		if result == nil {
+			return nil
+		}
+		next := result.NextPage()
+		if next == nil {
+			return nil
+		}
+		pagination = *next
+	}
+}
+
 const CharityPageUrl = "http://promotion.alicdn.com/help/oss/error.html"
 
 func (client *AliyunClient) JudgeRegionValidation(key string, region common.Region) error {
-	regions, err := client.ecsconn.DescribeRegions()
+	regions, err := client.DescribeEcsRegions()
 	if err != nil {
 		return fmt.Errorf("DescribeRegions got an error: %#v", err)
 	}
 
 	var rs []string
 	for _, v := range regions {
-		if v.RegionId == region {
+		if v.RegionId == string(region) {
 			return nil
 		}
-		rs = append(rs, string(v.RegionId))
+		rs = append(rs, v.RegionId)
 	}
 	return fmt.Errorf("'%s' is invalid. Expected on %v.", key, strings.Join(rs, ", "))
 }
@@ -259,3 +325,395 @@ func Trim(v string) string {
 	}
 	return strings.Trim(v, " ")
 }
+
+// ProcessRpcRequest invokes an RPC style API of the product served on domain by the common request
+// of the official SDK. It is used by the products whose SDK has not been vendored, and the response
+// body is decoded into result when result is not nil. The domain may be a custom endpoint with a
+// scheme, which takes precedence over the protocol.
+func (client *AliyunClient) ProcessRpcRequest(domain, version, action string, params map[string]string, result interface{}) error {
+	request := requests.NewCommonRequest()
+	request.Method = requests.POST
+	request.Scheme, request.Domain = client.config.splitEndpoint(domain)
+	request.Version = version
+	request.ApiName = action
+	request.RegionId = string(client.Region)
+	for k, v := range params {
+		request.FormParams[k] = v
+	}
+
+	content, err := client.processCommonRequest(action, request)
+	if err != nil {
+		return WrapApiError(err, action, "")
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(content, result); err != nil {
+		return fmt.Errorf("Unmarshalling %s response got an error: %#v", action, err)
+	}
+	return nil
+}
+
+// ProcessRoaRequest invokes a ROA style API, which is identified by the method and the path instead of an action,
+// by the common request of the official SDK. The query is appended to the path, and the response body is decoded
+// into result when result is not nil.
+func (client *AliyunClient) ProcessRoaRequest(domain, version, method, path string, query map[string]string, result interface{}) error {
+	request := requests.NewCommonRequest()
+	request.Method = method
+	request.Scheme, request.Domain = client.config.splitEndpoint(domain)
+	request.Version = version
+	request.PathPattern = path
+	request.RegionId = string(client.Region)
+	request.Headers["Content-Type"] = "application/json"
+	for k, v := range query {
+		request.QueryParams[k] = v
+	}
+
+	content, err := client.processCommonRequest(method+" "+path, request)
+	if err != nil {
+		return WrapApiError(err, method+" "+path, "")
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(content, result); err != nil {
+		return fmt.Errorf("Unmarshalling %s %s response got an error: %#v", method, path, err)
+	}
+	return nil
+}
+
+// successFlagError represents a failed API call of the products which answer most of the failures with http code 200,
+// like CloudMonitor, MSE, SAE, DBS and HBR. The failure is reported by Success and a code, which is one of ErrorCode,
+// ErrCode and Code, and Code is a number or a string.
+type successFlagError struct {
+	Product   string
+	RequestId string
+	Code      string
+	Message   string
+}
+
+func (e *successFlagError) Error() string {
+	return fmt.Sprintf("%s Error: Code: %s Message: %s RequestId: %s", strings.ToUpper(e.Product), e.Code, e.Message, e.RequestId)
+}
+
+// parseSuccessFlagError returns the error reported by the response of the product, or nil if the call succeeded.
+func parseSuccessFlagError(product string, raw []byte) (*successFlagError, error) {
+	var resp struct {
+		RequestId  string          `json:"RequestId"`
+		Success    *bool           `json:"Success"`
+		ErrorCode  string          `json:"ErrorCode"`
+		ErrCode    string          `json:"ErrCode"`
+		Code       json.RawMessage `json:"Code"`
+		Message    string          `json:"Message"`
+		ErrMessage string          `json:"ErrMessage"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Success == nil || *resp.Success {
+		return nil, nil
+	}
+	e := &successFlagError{
+		Product:   product,
+		RequestId: resp.RequestId,
+		Code:      resp.ErrorCode,
+		Message:   resp.Message,
+	}
+	if e.Code == "" {
+		e.Code = resp.ErrCode
+	}
+	if e.Code == "" {
+		e.Code = strings.Trim(string(resp.Code), `"`)
+	}
+	if e.Message == "" {
+		e.Message = resp.ErrMessage
+	}
+	return e, nil
+}
+
+// processSuccessFlagRequest invokes the API action of the product by send, which is given the response body to decode,
+// and converts an unsuccessful response into successFlagError. The body is decoded into result when it is not nil.
+func processSuccessFlagRequest(product, action string, send func(raw *json.RawMessage) error, result interface{}) error {
+	var raw json.RawMessage
+	if err := send(&raw); err != nil {
+		return err
+	}
+
+	e, err := parseSuccessFlagError(product, raw)
+	if err != nil {
+		return fmt.Errorf("Unmarshalling %s response got an error: %#v", action, err)
+	}
+	if e != nil {
+		return e
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, result)
+}
+
+// processCommonRequest sends the common request with the retries of RetryWithBackoff and returns the response body.
+// An RPC action or a ROA POST creating a resource is only retried when its ClientToken is set.
+func (client *AliyunClient) processCommonRequest(action string, request *requests.CommonRequest) ([]byte, error) {
+	var content []byte
+	send := func() error {
+		var resp *responses.CommonResponse
+		var err error
+		if signer := client.getSigner(); signer != nil {
+			resp, err = client.commonconn.ProcessCommonRequestWithSigner(request, signer)
+		} else {
+			resp, err = client.commonconn.ProcessCommonRequest(request)
+		}
+		if err != nil {
+			return err
+		}
+		content = resp.GetHttpContentBytes()
+		return nil
+	}
+
+	clientToken := requestClientToken(request)
+	retrySafe := isRetrySafe(request.ApiName, clientToken)
+	if request.PathPattern != "" {
+		// A ROA API creates the resource by POST.
+		retrySafe = request.Method != requests.POST || clientToken != ""
+	}
+
+	var err error
+	if retrySafe {
+		err = client.RetryWithBackoff(action, send)
+	} else {
+		err = send()
+	}
+	return content, err
+}
+
+// sdkClient is the client of a product of the official SDK, like the ECS, VPC and RDS clients.
+type sdkClient interface {
+	DoActionWithSigner(request requests.AcsRequest, response responses.AcsResponse, signer auth.Signer) error
+}
+
+// doAction sends the request of the official SDK by the client of its product. All of the requests of the official
+// SDK are sent by it or by processCommonRequest, so that they are retried by RetryWithBackoff in the same way, and
+// an action creating a resource is only retried when its ClientToken is set.
+func (client *AliyunClient) doAction(conn sdkClient, request requests.AcsRequest, response responses.AcsResponse) error {
+	action := request.GetActionName()
+	send := func() error {
+		return conn.DoActionWithSigner(request, response, client.getSigner())
+	}
+	if !isRetrySafe(action, requestClientToken(request)) {
+		return send()
+	}
+	return client.RetryWithBackoff(action, send)
+}
+
+// nonIdempotentActionPrefixes are the prefixes of the actions which create a resource every time they are called.
+var nonIdempotentActionPrefixes = []string{"Create", "Allocate", "Run", "Clone", "Copy", "Purchase"}
+
+// isRetrySafe returns true if the action can be sent again after it failed. The action creating a resource may have
+// created it though it failed, so it is only sent again with the same ClientToken, which makes the service return the
+// resource created before instead of creating another one.
+func isRetrySafe(action, clientToken string) bool {
+	if clientToken != "" {
+		return true
+	}
+	for _, prefix := range nonIdempotentActionPrefixes {
+		if strings.HasPrefix(action, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// requestClientToken returns the ClientToken of a request of the official SDK, which is a field of the request of a
+// product or a parameter of a common request, or an empty string if it is not set.
+func requestClientToken(request requests.AcsRequest) string {
+	if token := request.GetQueryParams()["ClientToken"]; token != "" {
+		return token
+	}
+	if token := request.GetFormParams()["ClientToken"]; token != "" {
+		return token
+	}
+	if v := reflect.Indirect(reflect.ValueOf(request)); v.Kind() == reflect.Struct {
+		if field := v.FieldByName("ClientToken"); field.IsValid() && field.Kind() == reflect.String {
+			return field.String()
+		}
+	}
+	return ""
+}
+
+const (
+	RetryBaseDelay = 1 * time.Second
+	RetryMaxDelay  = 30 * time.Second
+)
+
+// RetryWithBackoff calls f until it succeeds, it fails with an error other than the server errors of
+// IsServerRetryableError, or it has been retried max_retries times. The action names the API in the logs. The requests of the
+// official SDK are retried by it through doAction and processCommonRequest, and the SDK does not retry them itself.
+func (client *AliyunClient) RetryWithBackoff(action string, f func() error) error {
+	maxRetries := DefaultMaxRetries
+	if client.config != nil {
+		maxRetries = client.config.MaxRetries
+	}
+	for retry := 0; ; retry++ {
+		err := f()
+		if err == nil || retry >= maxRetries || !IsServerRetryableError(err) {
+			return err
+		}
+		wait := retryDelay(retry)
+		log.Printf("[WARN] %s failed and it will be retried after %s: %s", action, wait, err)
+		time.Sleep(wait)
+	}
+}
+
+// retryDelay returns the wait before the retry, which doubles from RetryBaseDelay up to RetryMaxDelay. Half of
+// the wait is random, so that the requests throttled at the same time are not retried at the same time.
+func retryDelay(retry int) time.Duration {
+	delay := RetryMaxDelay
+	if retry < 16 && RetryBaseDelay<<uint(retry) < RetryMaxDelay {
+		delay = RetryBaseDelay << uint(retry)
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// timeoutSeconds returns the timeout of the operation specified by key in seconds,
+// which is the unit expected by the WaitFor methods.
+func timeoutSeconds(d *schema.ResourceData, key string) int {
+	return int(d.Timeout(key).Seconds())
+}
+
+// ParseResourceId splits the composite id of a child resource, like <parent_id>:<child_name>, into its parts. The
+// resource name and the part names describe the expected format when the id is invalid, for example an id imported
+// by hand with a missing part.
+func ParseResourceId(id, resourceName string, partNames ...string) ([]string, error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+	if len(parts) != len(partNames) {
+		return nil, fmt.Errorf("Invalid %s id %s. Expected format is <%s>.", resourceName, id, strings.Join(partNames, ">"+COLON_SEPARATED+"<"))
+	}
+	return parts, nil
+}
+
+// buildClientToken returns a token which ensures the idempotence of a create request. It should
+// be generated once and reused when the request is retried, so that a retry after a timeout
+// does not create another resource. The token is truncated to 64 characters, the limit of the API.
+func buildClientToken(prefix string) string {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		id = resource.UniqueId()
+	}
+	token := fmt.Sprintf("%s-%d-%s", prefix, time.Now().Unix(), strings.Replace(id, "-", "", -1))
+	if len(token) > 64 {
+		token = token[:64]
+	}
+	return token
+}
+
+// waitForStatus waits for the status returned by refresh to become target by a resource.StateChangeConf,
+// which refreshes with an exponential backoff and logs every refresh at TRACE level. The refresh function
+// returns a nil object while the resource is not found. The name describes the resource in the timeout
+// error and timeout is in seconds.
+func waitForStatus(name string, refresh resource.StateRefreshFunc, pending []string, target string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	conf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    refresh,
+		Timeout:    time.Duration(timeout) * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := conf.WaitForState(); err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok {
+			return GetTimeErrorFromString(GetTimeoutMessage(name, target))
+		}
+		return err
+	}
+	return nil
+}
+
+// pendingStatuses returns the statuses other than the target, which are regarded as pending while waiting for it.
+func pendingStatuses(statuses []Status, target Status) []string {
+	var pending []string
+	for _, status := range statuses {
+		if status != target {
+			pending = append(pending, string(status))
+		}
+	}
+	return pending
+}
+
+// prepaidPeriod converts the period in months to the used time and its unit of a PrePaid resource. The APIs support
+// the periods of [1-9] months and [1-5] years.
+func prepaidPeriod(period int) (usedTime string, unit string) {
+	if period > 9 {
+		return strconv.Itoa(period / 12), string(Year)
+	}
+	return strconv.Itoa(period), string(Month)
+}
+
+// compareEngineVersion compares the numeric engine versions of the databases, like 5.6 and 10.0 of RDS and 4.0 of
+// KVStore, segment by segment, so that 5.10 is higher than 5.9 and 8 is the same as 8.0. It returns an error for
+// the versions which are not numeric, like 2008r2 of SQLServer.
+func compareEngineVersion(a, b string) (int, error) {
+	va, err := engineVersionSegments(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := engineVersionSegments(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < len(va) || i < len(vb); i++ {
+		var sa, sb int
+		if i < len(va) {
+			sa = va[i]
+		}
+		if i < len(vb) {
+			sb = vb[i]
+		}
+		switch {
+		case sa < sb:
+			return -1, nil
+		case sa > sb:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func engineVersionSegments(version string) ([]int, error) {
+	parts := strings.Split(version, ".")
+	segments := make([]int, 0, len(parts))
+	for _, part := range parts {
+		segment, err := strconv.Atoi(part)
+		if err != nil || segment < 0 {
+			return nil, fmt.Errorf("The engine version %s can not be compared.", version)
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+// jsonStringEqual returns whether the JSON documents are the same regardless of their formats.
+func jsonStringEqual(a, b string) bool {
+	var da, db interface{}
+	if err := json.Unmarshal([]byte(a), &da); err != nil {
+		return a == b
+	}
+	if err := json.Unmarshal([]byte(b), &db); err != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(da, db)
+}
+
+// yamlStringEqual is like jsonStringEqual, while it also accepts YAML documents, which is a superset of JSON.
+func yamlStringEqual(a, b string) bool {
+	var da, db interface{}
+	if err := yaml.Unmarshal([]byte(a), &da); err != nil {
+		return a == b
+	}
+	if err := yaml.Unmarshal([]byte(b), &db); err != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(da, db)
+}
@@ -0,0 +1,323 @@
+package alicloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/denverdino/aliyungo/common"
+)
+
+func TestBuildClientToken(t *testing.T) {
+	token := buildClientToken("TF-CreateInstance")
+	if !strings.HasPrefix(token, "TF-CreateInstance-") {
+		t.Fatalf("Expected the client token starts with the prefix, got %s", token)
+	}
+	if len(token) > 64 {
+		t.Fatalf("Expected the client token is no longer than 64 characters, got %d", len(token))
+	}
+	if token == buildClientToken("TF-CreateInstance") {
+		t.Fatalf("Expected the client tokens are different, got %s twice", token)
+	}
+}
+
+func TestDescribeAllPages(t *testing.T) {
+	var pages []int
+	err := describeAllPages(func(pagination common.Pagination) (*common.PaginationResult, error) {
+		if pagination.PageSize != PageSizeLarge {
+			t.Fatalf("Expected the page size %d, got %d", PageSizeLarge, pagination.PageSize)
+		}
+		pages = append(pages, pagination.PageNumber)
+		return &common.PaginationResult{TotalCount: 120, PageNumber: pagination.PageNumber, PageSize: pagination.PageSize}, nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %#v", err)
+	}
+	if len(pages) != 3 || pages[0] != 1 || pages[2] != 3 {
+		t.Fatalf("Expected pages [1 2 3], got %v", pages)
+	}
+}
+
+func TestPrepaidPeriod(t *testing.T) {
+	cases := []struct {
+		period   int
+		usedTime string
+		unit     string
+	}{
+		{1, "1", string(Month)},
+		{9, "9", string(Month)},
+		{12, "1", string(Year)},
+		{36, "3", string(Year)},
+	}
+	for _, c := range cases {
+		if usedTime, unit := prepaidPeriod(c.period); usedTime != c.usedTime || unit != c.unit {
+			t.Fatalf("Expected %s %s for the period %d, got %s %s.", c.usedTime, c.unit, c.period, usedTime, unit)
+		}
+	}
+}
+
+func TestCompareEngineVersion(t *testing.T) {
+	cases := []struct {
+		a, b   string
+		result int
+	}{
+		{"4.0", "5.0", -1},
+		{"5.9", "5.10", -1},
+		{"10.0", "5.6", 1},
+		{"8.0", "8", 0},
+		{"5.7", "5.7", 0},
+	}
+	for _, c := range cases {
+		if result, err := compareEngineVersion(c.a, c.b); err != nil || result != c.result {
+			t.Fatalf("Expected %d comparing %s with %s, got %d and the error %#v.", c.result, c.a, c.b, result, err)
+		}
+	}
+	if _, err := compareEngineVersion("2008r2", "2012"); err == nil {
+		t.Fatalf("Expected the version which is not numeric can not be compared.")
+	}
+}
+
+func TestJsonStringEqual(t *testing.T) {
+	a := `{"Statement":[{"Effect":"Allow","Action":["oss:ListObjects"],"Resource":["*"]}],"Version":"1"}`
+	b := `{
+  "Version": "1",
+  "Statement": [{"Action": ["oss:ListObjects"], "Effect": "Allow", "Resource": ["*"]}]
+}`
+	if !jsonStringEqual(a, b) {
+		t.Fatalf("Expected the JSON documents are equal.")
+	}
+	if jsonStringEqual(a, strings.Replace(b, "Allow", "Deny", 1)) {
+		t.Fatalf("Expected the JSON documents are not equal.")
+	}
+}
+
+func TestYamlStringEqual(t *testing.T) {
+	a := `{"FormatVersion": "OOS-2019-06-01", "Tasks": [{"Name": "stop", "Action": "ACS::ECS::StopInstance"}]}`
+	b := `
+FormatVersion: OOS-2019-06-01
+Tasks:
+  - Name: stop
+    Action: ACS::ECS::StopInstance
+`
+	if !yamlStringEqual(a, b) {
+		t.Fatalf("Expected the YAML and JSON documents are equal.")
+	}
+	if yamlStringEqual(a, strings.Replace(b, "Stop", "Start", 1)) {
+		t.Fatalf("Expected the documents are not equal.")
+	}
+}
+
+func TestParseResourceId(t *testing.T) {
+	parts, err := ParseResourceId("rm-12345:tf_account:ReadOnly", "alicloud_db_account_privilege", "instance_id", "account_name", "privilege")
+	if err != nil {
+		t.Fatalf("Expected the id is parsed, got %s", err)
+	}
+	if len(parts) != 3 || parts[0] != "rm-12345" || parts[1] != "tf_account" || parts[2] != "ReadOnly" {
+		t.Fatalf("Expected the id parts [rm-12345 tf_account ReadOnly], got %v", parts)
+	}
+	_, err = ParseResourceId("rm-12345", "alicloud_db_account", "instance_id", "account_name")
+	if err == nil {
+		t.Fatalf("Expected an error for the malformed id")
+	}
+	if !strings.Contains(err.Error(), "<instance_id>:<account_name>") {
+		t.Fatalf("Expected the error describes the id format, got %s", err)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	for retry, max := range []time.Duration{RetryBaseDelay, 2 * RetryBaseDelay, 4 * RetryBaseDelay} {
+		if delay := retryDelay(retry); delay < max/2 || delay > max {
+			t.Fatalf("Expected the delay of retry %d is in [%s, %s], got %s", retry, max/2, max, delay)
+		}
+	}
+	if delay := retryDelay(100); delay < RetryMaxDelay/2 || delay > RetryMaxDelay {
+		t.Fatalf("Expected the delay is capped by %s, got %s", RetryMaxDelay, delay)
+	}
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	client := &AliyunClient{config: &Config{MaxRetries: 0}}
+	throttling := &common.Error{}
+	throttling.Code = EcsThrottling
+	invalid := &common.Error{}
+	invalid.Code = "InvalidParameter"
+
+	calls := 0
+	err := client.RetryWithBackoff("DescribeInstances", func() error {
+		calls++
+		return throttling
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("Expected no retry when max_retries is 0, got %d calls", calls)
+	}
+
+	client.config.MaxRetries = 3
+	calls = 0
+	err = client.RetryWithBackoff("DescribeInstances", func() error {
+		calls++
+		return invalid
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("Expected the error other than the server errors is returned at once, got %d calls", calls)
+	}
+}
+
+type fakeSdkClient struct {
+	calls  int
+	err    error
+	signer auth.Signer
+}
+
+func (c *fakeSdkClient) DoActionWithSigner(request requests.AcsRequest, response responses.AcsResponse, signer auth.Signer) error {
+	c.calls++
+	c.signer = signer
+	return c.err
+}
+
+func TestParseSuccessFlagError(t *testing.T) {
+	cases := []struct {
+		raw     string
+		code    string
+		message string
+	}{
+		{`{"RequestId": "A", "Success": true, "Code": 200}`, "", ""},
+		{`{"RequestId": "A", "Success": true, "Code": "200"}`, "", ""},
+		{`{"RequestId": "A", "Items": {}}`, "", ""},
+		{`{"RequestId": "A", "Success": false, "Code": 404, "Message": "not found"}`, "404", "not found"},
+		{`{"RequestId": "A", "Success": false, "Code": "ResourceNotFound", "Message": "not found"}`, "ResourceNotFound", "not found"},
+		{`{"RequestId": "A", "Success": false, "Code": 200, "ErrorCode": "NotFound", "Message": "not found"}`, "NotFound", "not found"},
+		{`{"RequestId": "A", "Success": false, "ErrCode": "NotFound", "ErrMessage": "not found"}`, "NotFound", "not found"},
+	}
+	for _, c := range cases {
+		e, err := parseSuccessFlagError(CmsCode, []byte(c.raw))
+		if err != nil {
+			t.Fatalf("Parsing %s got an error: %#v", c.raw, err)
+		}
+		if c.code == "" {
+			if e != nil {
+				t.Fatalf("Expected no error for %s, got %#v", c.raw, e)
+			}
+			continue
+		}
+		if e == nil || e.Code != c.code || e.Message != c.message || GetRequestId(e) != "A" {
+			t.Fatalf("Expected the error code %s and message %s for %s, got %#v", c.code, c.message, c.raw, e)
+		}
+		if !IsExceptedError(e, c.code) {
+			t.Fatalf("Expected the error is excepted by its code %s", c.code)
+		}
+	}
+	if _, err := parseSuccessFlagError(CmsCode, []byte(`not json`)); err == nil {
+		t.Fatalf("Expected an error when the response is not json.")
+	}
+}
+
+// newTestAliyunClient returns a client whose requests of the official SDK to the product are sent to the handler.
+// The server should be closed by the caller.
+func newTestAliyunClient(t *testing.T, code string, handler http.HandlerFunc) (*AliyunClient, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	config := &Config{
+		AccessKey: "ak",
+		SecretKey: "sk",
+		Region:    common.Hangzhou,
+		RegionId:  string(common.Hangzhou),
+		Endpoints: map[string]string{
+			code: server.URL,
+		},
+	}
+	config.rewriter = config.newRequestRewriter()
+	commonconn, err := config.commonConn()
+	if err != nil {
+		server.Close()
+		t.Fatalf("Building the common client got an error: %#v", err)
+	}
+	return &AliyunClient{Region: config.Region, commonconn: commonconn, config: config}, server
+}
+
+func TestAliyunClientDoAction(t *testing.T) {
+	client := &AliyunClient{config: &Config{MaxRetries: 1}}
+	throttling := errors.NewServerError(400, `{"Code":"Throttling","Message":"Request was denied due to request throttling."}`, "")
+
+	conn := &fakeSdkClient{err: throttling}
+	client.doAction(conn, vpc.CreateDescribeVpcsRequest(), vpc.CreateDescribeVpcsResponse())
+	if conn.calls != 2 {
+		t.Fatalf("Expected the throttled request is retried, got %d calls", conn.calls)
+	}
+
+	conn = &fakeSdkClient{err: throttling}
+	client.doAction(conn, vpc.CreateCreateVpcRequest(), vpc.CreateCreateVpcResponse())
+	if conn.calls != 1 {
+		t.Fatalf("Expected the request creating a resource without a ClientToken is not retried, got %d calls", conn.calls)
+	}
+
+	conn = &fakeSdkClient{err: throttling}
+	request := vpc.CreateCreateVpcRequest()
+	request.ClientToken = buildClientToken("TF-CreateVpc")
+	client.doAction(conn, request, vpc.CreateCreateVpcResponse())
+	if conn.calls != 2 {
+		t.Fatalf("Expected the request creating a resource with a ClientToken is retried, got %d calls", conn.calls)
+	}
+
+	internal := errors.NewServerError(500, `{"Code":"InternalError","Message":"The request processing has failed due to some unknown error."}`, "")
+	conn = &fakeSdkClient{err: internal}
+	client.doAction(conn, vpc.CreateDescribeVpcsRequest(), vpc.CreateDescribeVpcsResponse())
+	if conn.calls != 2 {
+		t.Fatalf("Expected the request failed by an InternalError is retried, got %d calls", conn.calls)
+	}
+
+	conn = &fakeSdkClient{err: internal}
+	client.doAction(conn, vpc.CreateCreateVpcRequest(), vpc.CreateCreateVpcResponse())
+	if conn.calls != 1 {
+		t.Fatalf("Expected the request creating a resource without a ClientToken is not retried after an InternalError, got %d calls", conn.calls)
+	}
+
+	conn = &fakeSdkClient{err: internal}
+	request = vpc.CreateCreateVpcRequest()
+	request.ClientToken = buildClientToken("TF-CreateVpc")
+	client.doAction(conn, request, vpc.CreateCreateVpcResponse())
+	if conn.calls != 2 {
+		t.Fatalf("Expected the request creating a resource with a ClientToken is retried after an InternalError, got %d calls", conn.calls)
+	}
+}
+
+func TestIsRetrySafe(t *testing.T) {
+	for _, c := range []struct {
+		action      string
+		clientToken string
+		expected    bool
+	}{
+		{"DescribeInstances", "", true},
+		{"DeleteInstance", "", true},
+		{"CreateInstance", "", false},
+		{"AllocateEipAddress", "", false},
+		{"RunInstances", "", false},
+		{"CreateInstance", "TF-CreateInstance-1", true},
+	} {
+		if isRetrySafe(c.action, c.clientToken) != c.expected {
+			t.Fatalf("Expected isRetrySafe of %s with the ClientToken %q is %t", c.action, c.clientToken, c.expected)
+		}
+	}
+}
+
+func TestRequestClientToken(t *testing.T) {
+	request := vpc.CreateCreateVpcRequest()
+	request.ClientToken = "TF-CreateVpc-1"
+	if token := requestClientToken(request); token != request.ClientToken {
+		t.Fatalf("Expected the ClientToken of the request is %s, got %s", request.ClientToken, token)
+	}
+
+	commonRequest := requests.NewCommonRequest()
+	if token := requestClientToken(commonRequest); token != "" {
+		t.Fatalf("Expected no ClientToken of the common request, got %s", token)
+	}
+	commonRequest.FormParams["ClientToken"] = "TF-CreateCluster-1"
+	if token := requestClientToken(commonRequest); token != "TF-CreateCluster-1" {
+		t.Fatalf("Expected the ClientToken parameter of the common request, got %s", token)
+	}
+}
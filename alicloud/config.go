@@ -1,31 +1,39 @@
 package alicloud
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net"
 	"strings"
 
 	"net/http"
+	"net/url"
 	"os"
+	"sort"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/denverdino/aliyungo/cdn"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/cs"
 	"github.com/denverdino/aliyungo/dns"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/denverdino/aliyungo/ess"
 	"github.com/denverdino/aliyungo/kms"
 	"github.com/denverdino/aliyungo/location"
 	"github.com/denverdino/aliyungo/ram"
-	"github.com/denverdino/aliyungo/slb"
+	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -36,24 +44,115 @@ type Config struct {
 	Region        common.Region
 	RegionId      string
 	SecurityToken string
+	EcsRoleName   string
+	// CredentialsUri serves the temporary credentials, for example by a vault agent or a sidecar
+	CredentialsUri string
+
+	SkipRegionValidation bool
+
+	ProxyUrl string
+
+	// Protocol is HTTP or HTTPS used by all of the API requests
+	Protocol string
+	// ConfigurationSource is appended to the User-Agent to identify the source of the API requests
+	ConfigurationSource string
+
+	// DefaultTags are applied to all of the resources which support tags
+	DefaultTags map[string]string
+
+	// MaxRetries is the maximum times to retry the requests which were throttled or found the service unavailable
+	MaxRetries int
+	// ClientConnectTimeout and ClientReadTimeout are in milliseconds
+	ClientConnectTimeout int
+	ClientReadTimeout    int
+
+	RoleArn               string
+	RoleSessionName       string
+	RolePolicy            string
+	RoleSessionExpiration int
+
+	// RequestsPerSecond limits the requests sent to each API endpoint, and 0 means no limit
+	RequestsPerSecond int
+	limiter           *requestLimiter
+	// rewriter sends the API requests of all of the clients built from the config
+	rewriter *requestRewriter
+	// credential holds the temporary credentials which are loaded again before they expire, and it is nil when the
+	// static credentials are used
+	credential *sessionCredential
+
+	// Endpoints holds the custom endpoints keyed by the product code, like ecs and vpc
+	Endpoints map[string]string
 }
 
+// The product codes used as the keys of the custom endpoints
+const (
+	EcsCode           = "ecs"
+	RdsCode           = "rds"
+	SlbCode           = "slb"
+	VpcCode           = "vpc"
+	EssCode           = "ess"
+	OssCode           = "oss"
+	DnsCode           = "dns"
+	RamCode           = "ram"
+	CdnCode           = "cdn"
+	KmsCode           = "kms"
+	LocationCode      = "location"
+	LogCode           = "log"
+	StsCode           = "sts"
+	ApiGatewayCode    = "apigateway"
+	DmsEnterpriseCode = "dms_enterprise"
+	CenCode           = "cen"
+	VpcPeerCode       = "vpcpeer"
+	KVStoreCode       = "kvstore"
+	MongoDBCode       = "mongodb"
+	PolarDBCode       = "polardb"
+	ElasticsearchCode = "elasticsearch"
+	HBaseCode         = "hbase"
+	FcCode            = "fc"
+	BssCode           = "bssopenapi"
+	CmsCode           = "cms"
+	EventBridgeCode   = "eventbridge"
+	CloudFirewallCode = "cloudfw"
+	HitsdbCode        = "hitsdb"
+	CddcCode          = "cddc"
+	OosCode           = "oos"
+	FnfCode           = "fnf"
+	MseCode           = "mse"
+	SaeCode           = "sae"
+	ArmsCode          = "arms"
+	DbsCode           = "dbs"
+	HbrCode           = "hbr"
+	QuotasCode        = "quotas"
+	MarketCode        = "market"
+)
+
 // AliyunClient of aliyun
 type AliyunClient struct {
-	Region  common.Region
-	ecsconn *ecs.Client
-	essconn *ess.Client
-	rdsconn *rds.Client
-	// use new version
-	ecsNewconn *ecs.Client
-	vpcconn    *vpc.Client
-	slbconn    *slb.Client
-	ossconn    *oss.Client
-	dnsconn    *dns.Client
-	ramconn    ram.RamClientInterface
-	csconn     *cs.Client
-	cdnconn    *cdn.CdnClient
-	kmsconn    *kms.Client
+	Region common.Region
+	// ecsClient is built with the provider, since it validates the credentials by DescribeRegions.
+	ecsClient *ecs.Client
+	rdsconn   *rds.Client
+	vpcconn   *vpc.Client
+	slbClient *slb.Client
+	// commonconn is used to call the products whose SDK has not been vendored
+	commonconn *sdk.Client
+	logconn    *LogClient
+
+	// clients caches the clients which are built on first use by lazyClient, keyed by the product code.
+	clients      map[string]interface{}
+	clientsMutex sync.Mutex
+	// clientsAccessKeyId is the AccessKey ID of the temporary credentials which the cached clients are built with.
+	clientsAccessKeyId string
+
+	config *Config
+
+	// accountId is the ID of the account owning the credentials, which is loaded when it is first used.
+	accountId      string
+	accountIdMutex sync.Mutex
+
+	// regionClients caches the clients of the other regions built by WithRegion.
+	regionClients      map[common.Region]*AliyunClient
+	regionClientsMutex sync.Mutex
 }
 
 // Client for AliyunClient
@@ -63,80 +162,228 @@ func (c *Config) Client() (*AliyunClient, error) {
 		return nil, err
 	}
 
-	ecsconn, err := c.ecsConn()
-	if err != nil {
-		return nil, err
+	// The limiter and the rewriter are shared by all of the clients built from the config, including the ones of the
+	// other regions, and they are not shared with the other configs, like the ones of the provider aliases.
+	c.limiter = newRequestLimiter(c.RequestsPerSecond)
+	c.rewriter = c.newRequestRewriter()
+
+	if c.CredentialsUri != "" {
+		c.credential = &sessionCredential{load: c.loadUriCredential}
+	} else if c.EcsRoleName != "" || c.AccessKey == "" || c.SecretKey == "" {
+		if err := c.loadEcsRoleName(); err != nil {
+			return nil, err
+		}
+		c.credential = &sessionCredential{load: c.loadEcsRoleCredential}
+	}
+	if c.credential != nil {
+		if err := c.credential.refresh(); err != nil {
+			return nil, err
+		}
 	}
 
-	ecsNewconn, err := c.ecsConn()
-	if err != nil {
-		return nil, err
+	if c.RoleArn != "" {
+		// The role is assumed again before its credentials expire, with the current credentials of the base.
+		base := c.credential
+		c.credential = &sessionCredential{load: func() (EcsRoleCredential, error) {
+			return c.assumeRole(base)
+		}}
+		if err := c.credential.refresh(); err != nil {
+			return nil, err
+		}
 	}
-	ecsNewconn.SetVersion(EcsApiVersion20160314)
 
-	rdsconn, err := c.rdsConn()
+	return c.newAliyunClient()
+}
+
+// newAliyunClient builds the ECS client, which validates the credentials, and the clients of the official SDK and Log
+// Service, which send no request until they are used. The clients of aliyungo and the OSS client, some of which look up
+// their endpoints by the location service when they are built, are built on first use by lazyClient instead. A product
+// which is not available in the region then fails only the resources using it.
+func (c *Config) newAliyunClient() (*AliyunClient, error) {
+	ecsClient, err := c.ecsConn()
 	if err != nil {
 		return nil, err
 	}
-
-	slbconn, err := c.slbConn()
+	rdsconn, err := c.rdsConn()
 	if err != nil {
 		return nil, err
 	}
-
 	vpcconn, err := c.vpcConn()
 	if err != nil {
 		return nil, err
 	}
-
-	essconn, err := c.essConn()
+	slbClient, err := c.slbConn()
 	if err != nil {
 		return nil, err
 	}
-	ossconn, err := c.ossConn()
+	commonconn, err := c.commonConn()
 	if err != nil {
 		return nil, err
 	}
-	dnsconn, err := c.dnsConn()
+	logconn, err := c.logConn()
 	if err != nil {
 		return nil, err
 	}
-	ramconn, err := c.ramConn()
-	if err != nil {
-		return nil, err
+	return &AliyunClient{
+		Region:     c.Region,
+		ecsClient:  ecsClient,
+		vpcconn:    vpcconn,
+		slbClient:  slbClient,
+		rdsconn:    rdsconn,
+		commonconn: commonconn,
+		logconn:    logconn,
+		config:     c,
+		clients:    make(map[string]interface{}),
+	}, nil
+}
+
+// lazyClient returns the client of the product, which is built by build on first use and cached for the following
+// calls. The client is not cached when build fails, so that it is built again by the next call. The cached clients
+// are built again after the temporary credentials are refreshed, since they sign the requests with the credentials
+// they are built with.
+func (client *AliyunClient) lazyClient(code string, build func() (interface{}, error)) (interface{}, error) {
+	client.clientsMutex.Lock()
+	defer client.clientsMutex.Unlock()
+
+	if client.config != nil && client.config.credential != nil {
+		if accessKeyId := client.config.getCredential().AccessKeyId; accessKeyId != client.clientsAccessKeyId {
+			client.clients = make(map[string]interface{})
+			client.clientsAccessKeyId = accessKeyId
+		}
+	}
+
+	if c, ok := client.clients[code]; ok {
+		return c, nil
 	}
-	csconn, err := c.csConn()
+	c, err := build()
 	if err != nil {
 		return nil, err
 	}
-	cdnconn, err := c.cdnConn()
+	if client.clients == nil {
+		client.clients = make(map[string]interface{})
+	}
+	client.clients[code] = c
+	return c, nil
+}
+
+// mustLazyClient is lazyClient for the clients of aliyungo, which are built without any error.
+func (client *AliyunClient) mustLazyClient(code string, build func() interface{}) interface{} {
+	c, _ := client.lazyClient(code, func() (interface{}, error) {
+		return build(), nil
+	})
+	return c
+}
+
+func (client *AliyunClient) ecsconn() *ecs.Client {
+	return client.ecsClient
+}
+
+func (client *AliyunClient) essconn() *ess.Client {
+	return client.mustLazyClient(EssCode, func() interface{} { return client.config.essConn() }).(*ess.Client)
+}
+
+func (client *AliyunClient) slbconn() *slb.Client {
+	return client.slbClient
+}
+
+func (client *AliyunClient) dnsconn() *dns.Client {
+	return client.mustLazyClient(DnsCode, func() interface{} { return client.config.dnsConn() }).(*dns.Client)
+}
+
+func (client *AliyunClient) ramconn() ram.RamClientInterface {
+	return client.mustLazyClient(RamCode, func() interface{} { return client.config.ramConn() }).(ram.RamClientInterface)
+}
+
+func (client *AliyunClient) csconn() *cs.Client {
+	return client.mustLazyClient("cs", func() interface{} { return client.config.csConn() }).(*cs.Client)
+}
+
+func (client *AliyunClient) cdnconn() *cdn.CdnClient {
+	return client.mustLazyClient(CdnCode, func() interface{} { return client.config.cdnConn() }).(*cdn.CdnClient)
+}
+
+func (client *AliyunClient) kmsconn() *kms.Client {
+	return client.mustLazyClient(KmsCode, func() interface{} { return client.config.kmsConn() }).(*kms.Client)
+}
+
+func (client *AliyunClient) ossconn() (*oss.Client, error) {
+	c, err := client.lazyClient(OssCode, func() (interface{}, error) { return client.config.ossConn() })
 	if err != nil {
 		return nil, err
 	}
-	kmsconn, err := c.kmsConn()
+	return c.(*oss.Client), nil
+}
+
+// WithRegion returns a client working in the given region, so that a resource can be managed in a region
+// other than the provider's one. The client shares the credentials and custom endpoints of the provider,
+// and it is built on demand and cached for the following calls.
+func (client *AliyunClient) WithRegion(region common.Region) (*AliyunClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	client.regionClientsMutex.Lock()
+	defer client.regionClientsMutex.Unlock()
+
+	if c, ok := client.regionClients[region]; ok {
+		return c, nil
+	}
+
+	config := *client.config
+	config.Region = region
+	config.RegionId = string(region)
+	c, err := config.newAliyunClient()
 	if err != nil {
-		return nil, err
+		return nil, WrapErrorf(err, "Building the client of region %s got an error", region)
 	}
-	return &AliyunClient{
-		Region:     c.Region,
-		ecsconn:    ecsconn,
-		ecsNewconn: ecsNewconn,
-		vpcconn:    vpcconn,
-		slbconn:    slbconn,
-		rdsconn:    rdsconn,
-		essconn:    essconn,
-		ossconn:    ossconn,
-		dnsconn:    dnsconn,
-		ramconn:    ramconn,
-		csconn:     csconn,
-		cdnconn:    cdnconn,
-		kmsconn:    kmsconn,
-	}, nil
+	// The caller identity does not vary with the region.
+	client.accountIdMutex.Lock()
+	c.accountId = client.accountId
+	client.accountIdMutex.Unlock()
+
+	if client.regionClients == nil {
+		client.regionClients = make(map[common.Region]*AliyunClient)
+	}
+	client.regionClients[region] = c
+	return c, nil
 }
 
 const BusinessInfoKey = "Terraform"
 
+const (
+	DefaultMaxRetries           = 5
+	DefaultClientConnectTimeout = 30000
+	DefaultClientReadTimeout    = 60000
+)
+
+const (
+	EcsMetadataCredentialsUrl = "http://100.100.100.200/latest/meta-data/ram/security-credentials/"
+	EcsMetadataTimeout        = 5 * time.Second
+	CredentialsUriTimeout     = 10 * time.Second
+)
+
+const (
+	// CredentialRefreshAhead is how long before their expiration the temporary credentials are loaded again
+	CredentialRefreshAhead = 5 * time.Minute
+	// CredentialRefreshInterval is the least interval between two loads of the temporary credentials, since the
+	// metadata service keeps responding the same credentials until they are about to expire
+	CredentialRefreshInterval = time.Minute
+)
+
+type EcsRoleCredential struct {
+	Code            string `json:"Code"`
+	AccessKeyId     string `json:"AccessKeyId"`
+	AccessKeySecret string `json:"AccessKeySecret"`
+	SecurityToken   string `json:"SecurityToken"`
+	Expiration      string `json:"Expiration"`
+}
+
 func (c *Config) loadAndValidate() error {
+	if c.SkipRegionValidation {
+		log.Printf("[WARN] Region validation is skipped, and the region %s is used by the APIs directly.", c.Region)
+		return nil
+	}
+
 	err := c.validateRegion()
 	if err != nil {
 		return err
@@ -145,6 +392,170 @@ func (c *Config) loadAndValidate() error {
 	return nil
 }
 
+// sessionCredential holds the temporary credentials of the provider, like the ones of the ECS RAM role, the
+// credentials_uri and the assumed role. They are loaded by load again before they expire, so that an apply running
+// longer than the session keeps working. The clients get the credentials from it when they sign a request or when they are built.
+type sessionCredential struct {
+	load      func() (EcsRoleCredential, error)
+	current   EcsRoleCredential
+	refreshAt time.Time
+	mutex     sync.Mutex
+}
+
+// refresh loads the credentials, and they are loaded again CredentialRefreshAhead before they expire. The credentials
+// without a valid expiration are loaded again after CredentialRefreshInterval.
+func (s *sessionCredential) refresh() error {
+	credential, err := s.load()
+	if err != nil {
+		return err
+	}
+	s.current = credential
+	s.refreshAt = time.Now().Add(CredentialRefreshInterval)
+	if expiration, err := time.Parse(time.RFC3339, credential.Expiration); err == nil && expiration.Add(-CredentialRefreshAhead).After(s.refreshAt) {
+		s.refreshAt = expiration.Add(-CredentialRefreshAhead)
+	}
+	return nil
+}
+
+// get returns the current credentials, which are loaded again when they are about to expire. When the load fails, the
+// credentials loaded before are returned, since they are still valid until they expire.
+func (s *sessionCredential) get() EcsRoleCredential {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if time.Now().Before(s.refreshAt) {
+		return s.current
+	}
+	if err := s.refresh(); err != nil {
+		log.Printf("[WARN] Refreshing the temporary credentials got an error: %s", err)
+		s.refreshAt = time.Now().Add(CredentialRefreshInterval)
+	}
+	return s.current
+}
+
+// getCredential returns the credentials used by the clients, which are the current temporary credentials if any.
+func (c *Config) getCredential() EcsRoleCredential {
+	if c.credential != nil {
+		return c.credential.get()
+	}
+	return EcsRoleCredential{AccessKeyId: c.AccessKey, AccessKeySecret: c.SecretKey, SecurityToken: c.SecurityToken}
+}
+
+// loadEcsRoleName discovers the RAM role attached to the ECS instance from the metadata service when the role name is
+// not specified, which makes it possible to run without any static AccessKey on an ECS instance.
+func (c *Config) loadEcsRoleName() error {
+	if c.EcsRoleName != "" {
+		return nil
+	}
+	// The metadata service is only reachable from the instance, and it never goes through the proxy.
+	httpClient := &http.Client{Timeout: EcsMetadataTimeout, Transport: &http.Transport{}}
+	roleName, err := getEcsMetadata(httpClient, EcsMetadataCredentialsUrl)
+	if err != nil || roleName == "" {
+		return fmt.Errorf("The access_key and secret_key must be set, or the provider should run on an ECS instance " +
+			"with a RAM role attached. Specify the ecs_role_name or the credentials explicitly.")
+	}
+	c.EcsRoleName = strings.TrimSpace(roleName)
+	return nil
+}
+
+// loadEcsRoleCredential fetches the temporary credentials of the RAM role attached to the ECS instance from the
+// metadata service. They are fetched again by the sessionCredential of the config before they expire.
+func (c *Config) loadEcsRoleCredential() (credential EcsRoleCredential, err error) {
+	httpClient := &http.Client{Timeout: EcsMetadataTimeout, Transport: &http.Transport{}}
+	content, err := getEcsMetadata(httpClient, EcsMetadataCredentialsUrl+c.EcsRoleName)
+	if err != nil {
+		return credential, fmt.Errorf("Fetching the credentials of ECS RAM role %s got an error: %#v", c.EcsRoleName, err)
+	}
+
+	if err := json.Unmarshal([]byte(content), &credential); err != nil {
+		return credential, fmt.Errorf("Unmarshalling the credentials of ECS RAM role %s got an error: %#v", c.EcsRoleName, err)
+	}
+	if credential.Code != "Success" {
+		return credential, fmt.Errorf("Fetching the credentials of ECS RAM role %s failed and the code is %s.", c.EcsRoleName, credential.Code)
+	}
+	log.Printf("[DEBUG] Using the credentials of ECS RAM role %s, and they expire at %s.", c.EcsRoleName, credential.Expiration)
+	return credential, nil
+}
+
+// loadUriCredential fetches the temporary credentials from the credentials_uri. The URI responds the credentials
+// in the same format as the metadata service, so that no static AccessKey has to be stored on the disk.
+func (c *Config) loadUriCredential() (EcsRoleCredential, error) {
+	// The URI is usually served locally, and it never goes through the proxy like the metadata service.
+	httpClient := &http.Client{Timeout: CredentialsUriTimeout, Transport: &http.Transport{}}
+
+	credential, err := getUriCredential(httpClient, c.CredentialsUri)
+	if err != nil {
+		return credential, err
+	}
+	log.Printf("[DEBUG] Using the credentials fetched from the credentials_uri, and they expire at %s.", credential.Expiration)
+	return credential, nil
+}
+
+func getUriCredential(httpClient *http.Client, uri string) (credential EcsRoleCredential, err error) {
+	content, err := getEcsMetadata(httpClient, uri)
+	if err != nil {
+		return credential, fmt.Errorf("Fetching the credentials from the credentials_uri got an error: %#v", err)
+	}
+	if err := json.Unmarshal([]byte(content), &credential); err != nil {
+		return credential, fmt.Errorf("Unmarshalling the credentials fetched from the credentials_uri got an error: %#v", err)
+	}
+	if credential.Code != "Success" {
+		return credential, fmt.Errorf("Fetching the credentials from the credentials_uri failed and the code is %s.", credential.Code)
+	}
+	if credential.AccessKeyId == "" || credential.AccessKeySecret == "" {
+		return credential, fmt.Errorf("The credentials fetched from the credentials_uri have no AccessKeyId or AccessKeySecret.")
+	}
+	return credential, nil
+}
+
+func getEcsMetadata(httpClient *http.Client, url string) (string, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	bs, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s responded %d: %s", url, resp.StatusCode, string(bs))
+	}
+	return string(bs), nil
+}
+
+// assumeRole returns the temporary credentials of the role, which is assumed with the credentials of base, or with
+// the configured credentials when base is nil. All of the clients use the credentials of the assumed role.
+func (c *Config) assumeRole(base *sessionCredential) (credential EcsRoleCredential, err error) {
+	credential = EcsRoleCredential{AccessKeyId: c.AccessKey, AccessKeySecret: c.SecretKey, SecurityToken: c.SecurityToken}
+	if base != nil {
+		credential = base.get()
+	}
+	conn, err := sdk.NewClientWithOptions(c.RegionId, c.getSdkConfig(""), newAuthCredential(credential))
+	if err != nil {
+		return credential, err
+	}
+
+	sessionName := c.RoleSessionName
+	if sessionName == "" {
+		sessionName = StsSessionName
+	}
+
+	scheme, domain := c.splitEndpoint(c.getEndpoint(StsCode, StsDomain))
+	resp, err := stsAssumeRole(conn, scheme, domain, c.RoleArn, sessionName, c.RolePolicy, c.RoleSessionExpiration)
+	if err != nil {
+		return credential, fmt.Errorf("Assuming role %s got an error: %#v", c.RoleArn, err)
+	}
+	log.Printf("[DEBUG] Assumed role %s, and the credentials expire at %s.", resp.AssumedRoleUser.Arn, resp.Credentials.Expiration)
+
+	return EcsRoleCredential{
+		AccessKeyId:     resp.Credentials.AccessKeyId,
+		AccessKeySecret: resp.Credentials.AccessKeySecret,
+		SecurityToken:   resp.Credentials.SecurityToken,
+		Expiration:      resp.Credentials.Expiration,
+	}, nil
+}
+
 func (c *Config) validateRegion() error {
 
 	for _, valid := range common.ValidRegions {
@@ -157,11 +568,14 @@ func (c *Config) validateRegion() error {
 }
 
 func (c *Config) ecsConn() (*ecs.Client, error) {
-	client := ecs.NewECSClientWithSecurityToken(c.AccessKey, c.SecretKey, c.SecurityToken, c.Region)
-	client.SetBusinessInfo(BusinessInfoKey)
-	client.SetUserAgent(getUserAgent())
+	client, err := ecs.NewClientWithOptions(c.RegionId, c.getSdkConfig(EcsCode), c.getAuthCredential())
+	if err != nil {
+		return nil, err
+	}
+	c.setSdkEndpoint(&client.Client, EcsCode)
 
-	if _, err := client.DescribeRegions(); err != nil {
+	request := ecs.CreateDescribeRegionsRequest()
+	if err := client.DoAction(request, ecs.CreateDescribeRegionsResponse()); err != nil {
 		return nil, err
 	}
 
@@ -169,117 +583,525 @@ func (c *Config) ecsConn() (*ecs.Client, error) {
 }
 
 func (c *Config) rdsConn() (*rds.Client, error) {
-	return rds.NewClientWithOptions(c.RegionId, getSdkConfig(), c.getAuthCredential(false))
+	client, err := rds.NewClientWithOptions(c.RegionId, c.getSdkConfig(RdsCode), c.getAuthCredential())
+	if err != nil {
+		return nil, err
+	}
+	c.setSdkEndpoint(&client.Client, RdsCode)
+	return client, nil
 }
 
 func (c *Config) slbConn() (*slb.Client, error) {
-	client := slb.NewSLBClient(c.AccessKey, c.SecretKey, c.Region)
-	client.SetBusinessInfo(BusinessInfoKey)
-	client.SetUserAgent(getUserAgent())
+	client, err := slb.NewClientWithOptions(c.RegionId, c.getSdkConfig(SlbCode), c.getAuthCredential())
+	if err != nil {
+		return nil, err
+	}
+	c.setSdkEndpoint(&client.Client, SlbCode)
 	return client, nil
 }
 
 func (c *Config) vpcConn() (*vpc.Client, error) {
-	return vpc.NewClientWithOptions(c.RegionId, getSdkConfig(), c.getAuthCredential(true))
-
+	client, err := vpc.NewClientWithOptions(c.RegionId, c.getSdkConfig(VpcCode), c.getAuthCredential())
+	if err != nil {
+		return nil, err
+	}
+	c.setSdkEndpoint(&client.Client, VpcCode)
+	return client, nil
 }
-func (c *Config) essConn() (*ess.Client, error) {
-	client := ess.NewESSClient(c.AccessKey, c.SecretKey, c.Region)
+
+func (c *Config) essConn() *ess.Client {
+	endpoint := c.getRegionalEndpoint(EssCode, "ESS_ENDPOINT", ess.ESSServiceCode, ess.ESSDefaultEndpoint)
+	credential := c.getCredential()
+	client := ess.NewClientWithRegion(endpoint, credential.AccessKeyId, credential.AccessKeySecret, c.Region)
+	client.SetSecurityToken(credential.SecurityToken)
 	client.SetBusinessInfo(BusinessInfoKey)
-	client.SetUserAgent(getUserAgent())
-	return client, nil
+	client.SetUserAgent(c.getUserAgent())
+	client.SetTransport(c.rewriter)
+	return client
 }
-func (c *Config) ossConn() (*oss.Client, error) {
 
-	endpointClient := location.NewClient(c.AccessKey, c.SecretKey)
-	endpointClient.SetSecurityToken(c.SecurityToken)
-	args := &location.DescribeEndpointsArgs{
-		Id:          c.Region,
-		ServiceCode: "oss",
-		Type:        "openAPI",
+func (c *Config) ossConn() (*oss.Client, error) {
+	credential := c.getCredential()
+	if endpoint, ok := c.Endpoints[OssCode]; ok {
+		log.Printf("[DEBUG] Instantiate OSS client using custom endpoint: %#v", endpoint)
+		endpoint = c.withScheme(OssCode, endpoint)
+		return oss.New(endpoint, credential.AccessKeyId, credential.AccessKeySecret, c.ossOptions(endpoint, credential.SecurityToken)...)
 	}
 
-	endpoints, err := endpointClient.DescribeEndpoints(args)
+	endpoint, err := c.describeEndpoint("oss")
 	if err != nil {
-		return nil, fmt.Errorf("Describe endpoint using region: %#v got an error: %#v.", c.Region, err)
-	}
-	endpointItem := endpoints.Endpoints.Endpoint
-	var endpoint string
-	if endpointItem == nil || len(endpointItem) <= 0 {
+		// The location service does not know the regions which are not public, and the default endpoint is used
+		// for them when the region validation is skipped.
+		if !c.SkipRegionValidation {
+			return nil, fmt.Errorf("Describe endpoint using region: %#v got an error: %#v.", c.Region, err)
+		}
+		log.Printf("[WARN] Describe endpoint using region: %#v got an error: %#v, and the default OSS endpoint is used.", c.Region, err)
+	} else if endpoint == "" {
 		log.Printf("Cannot find endpoint in the region: %#v", c.Region)
-		endpoint = ""
-	} else {
-		endpoint = strings.ToLower(endpointItem[0].Protocols.Protocols[0]) + "://" + endpointItem[0].Endpoint
 	}
 
 	if endpoint == "" {
 		endpoint = fmt.Sprintf("http://oss-%s.aliyuncs.com", c.Region)
 	}
+	endpoint = c.withScheme(OssCode, endpoint)
 
 	log.Printf("[DEBUG] Instantiate OSS client using endpoint: %#v", endpoint)
-	client, err := oss.New(endpoint, c.AccessKey, c.SecretKey, oss.UserAgent(getUserAgent()))
+	client, err := oss.New(endpoint, credential.AccessKeyId, credential.AccessKeySecret, c.ossOptions(endpoint, credential.SecurityToken)...)
 
 	return client, err
 }
 
-func (c *Config) dnsConn() (*dns.Client, error) {
-	client := dns.NewClientNew(c.AccessKey, c.SecretKey)
+func (c *Config) ossOptions(endpoint, securityToken string) []oss.ClientOption {
+	options := []oss.ClientOption{oss.UserAgent(c.getUserAgent())}
+	if securityToken != "" {
+		options = append(options, oss.SecurityToken(securityToken))
+	}
+
+	// The OSS client builds its own transport, so the proxy is passed by the options.
+	if req, err := http.NewRequest(http.MethodGet, endpoint, nil); err == nil {
+		if proxy, err := c.getProxy(req); err == nil && proxy != nil {
+			if proxy.User != nil {
+				password, _ := proxy.User.Password()
+				options = append(options, oss.AuthProxy(proxy.Scheme+"://"+proxy.Host, proxy.User.Username(), password))
+			} else {
+				options = append(options, oss.Proxy(proxy.String()))
+			}
+		}
+	}
+	return options
+}
+
+func (c *Config) dnsConn() *dns.Client {
+	credential := c.getCredential()
+	client := dns.NewClientNew(credential.AccessKeyId, credential.AccessKeySecret)
+	client.SetSecurityToken(credential.SecurityToken)
 	client.SetBusinessInfo(BusinessInfoKey)
-	client.SetUserAgent(getUserAgent())
-	return client, nil
+	client.SetUserAgent(c.getUserAgent())
+	client.SetTransport(c.rewriter)
+	// The default endpoint of the aliyungo dns client is HTTP, so it is always completed with the scheme.
+	defaultEndpoint := os.Getenv("DNS_ENDPOINT")
+	if defaultEndpoint == "" {
+		defaultEndpoint = dns.DNSDefaultEndpointNew
+	}
+	client.SetEndpoint(c.withScheme(DnsCode, c.getEndpoint(DnsCode, defaultEndpoint)))
+	return client
 }
 
-func (c *Config) ramConn() (ram.RamClientInterface, error) {
-	client := ram.NewClient(c.AccessKey, c.SecretKey)
-	return client, nil
+func (c *Config) ramConn() ram.RamClientInterface {
+	defaultEndpoint := os.Getenv("RAM_ENDPOINT")
+	if defaultEndpoint == "" {
+		defaultEndpoint = ram.RAMDefaultEndpoint
+	}
+	endpoint := c.withScheme(RamCode, c.getEndpoint(RamCode, defaultEndpoint))
+	credential := c.getCredential()
+	client := ram.NewClientWithEndpointAndSecurityToken(endpoint, credential.AccessKeyId, credential.AccessKeySecret, credential.SecurityToken).(*ram.RamClient)
+	client.SetTransport(c.rewriter)
+	return client
 }
 
-func (c *Config) csConn() (*cs.Client, error) {
-	client := cs.NewClientForAussumeRole(c.AccessKey, c.SecretKey, c.SecurityToken)
-	client.SetUserAgent(getUserAgent())
-	return client, nil
+func (c *Config) csConn() *cs.Client {
+	credential := c.getCredential()
+	client := cs.NewClientForAussumeRole(credential.AccessKeyId, credential.AccessKeySecret, credential.SecurityToken)
+	client.SetUserAgent(c.getUserAgent())
+	client.SetTransport(c.rewriter)
+	return client
 }
 
-func (c *Config) cdnConn() (*cdn.CdnClient, error) {
-	client := cdn.NewClient(c.AccessKey, c.SecretKey)
+func (c *Config) cdnConn() *cdn.CdnClient {
+	credential := c.getCredential()
+	client := cdn.NewClient(credential.AccessKeyId, credential.AccessKeySecret)
+	client.SetSecurityToken(credential.SecurityToken)
 	client.SetBusinessInfo(BusinessInfoKey)
-	client.SetUserAgent(getUserAgent())
-	return client, nil
+	client.SetUserAgent(c.getUserAgent())
+	client.SetTransport(c.rewriter)
+	if endpoint, ok := c.Endpoints[CdnCode]; ok {
+		client.SetEndpoint(c.withScheme(CdnCode, endpoint))
+	}
+	return client
 }
 
-func (c *Config) kmsConn() (*kms.Client, error) {
-	client := kms.NewECSClientWithSecurityToken(c.AccessKey, c.SecretKey, c.SecurityToken, c.Region)
+func (c *Config) kmsConn() *kms.Client {
+	endpoint := c.getRegionalEndpoint(KmsCode, "KMS_ENDPOINT", kms.KMSServiceCode, fmt.Sprintf("kms.%s.aliyuncs.com", c.RegionId))
+	credential := c.getCredential()
+	client := kms.NewKMSClientWithEndpointAndSecurityToken(endpoint, credential.AccessKeyId, credential.AccessKeySecret, credential.SecurityToken, c.Region)
 	client.SetBusinessInfo(BusinessInfoKey)
-	client.SetUserAgent(getUserAgent())
-	return client, nil
+	client.SetUserAgent(c.getUserAgent())
+	client.SetTransport(c.rewriter)
+	return client
 }
 
-func getSdkConfig() *sdk.Config {
+// getRegionalEndpoint returns the endpoint of a product of aliyungo in the region with the scheme of the product. It is
+// the custom endpoint, the endpoint in the environment variable env, or the one found by the location service, and
+// defaultEndpoint is used when none of them is found.
+func (c *Config) getRegionalEndpoint(code, env, serviceCode, defaultEndpoint string) string {
+	endpoint := c.getEndpoint(code, os.Getenv(env))
+	if endpoint == "" {
+		var err error
+		if endpoint, err = c.describeEndpoint(serviceCode); err != nil {
+			log.Printf("[WARN] Describing the endpoint of %s in the region %s got an error: %#v, and the default endpoint is used.", serviceCode, c.Region, err)
+		}
+	}
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+	return c.withScheme(code, endpoint)
+}
+
+// describeEndpoint looks up the endpoint of the service in the region by the location service, and it returns an empty
+// string if the service has no endpoint in the region. The location client sends its request by the rewriter like the
+// other clients, so that the request is logged and limited by the requests_per_second as well.
+func (c *Config) describeEndpoint(serviceCode string) (string, error) {
+	credential := c.getCredential()
+	client := location.NewClientWithEndpoint(c.withScheme(LocationCode, c.getEndpoint(LocationCode, location.LocationDefaultEndpoint)), credential.AccessKeyId, credential.AccessKeySecret)
+	client.SetSecurityToken(credential.SecurityToken)
+	client.SetUserAgent(c.getUserAgent())
+	client.SetTransport(c.rewriter)
+	endpoints, err := client.DescribeEndpoints(&location.DescribeEndpointsArgs{
+		Id:          c.Region,
+		ServiceCode: serviceCode,
+		Type:        "openAPI",
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(endpoints.Endpoints.Endpoint) == 0 {
+		return "", nil
+	}
+	return endpoints.Endpoints.Endpoint[0].Endpoint, nil
+}
+
+func (c *Config) commonConn() (*sdk.Client, error) {
+	return sdk.NewClientWithOptions(c.RegionId, c.getSdkConfig(""), c.getAuthCredential())
+}
+
+func (c *Config) logConn() (*LogClient, error) {
+	return &LogClient{
+		Endpoint:        c.getEndpoint(LogCode, fmt.Sprintf("%s.log.aliyuncs.com", c.RegionId)),
+		Scheme:          c.getScheme(LogCode),
+		AccessKeyId:     c.AccessKey,
+		AccessKeySecret: c.SecretKey,
+		SecurityToken:   c.SecurityToken,
+		UserAgent:       c.getUserAgent(),
+		MaxRetries:      c.MaxRetries,
+		credential:      c.credential,
+		httpClient:      c.getHttpClient(),
+	}, nil
+}
+
+// getSdkConfig returns the config of a client of the official SDK, which requests the product by its scheme. The code is
+// empty for the clients which request the endpoints of several products. The SDK does not retry the requests, which
+// are retried by RetryWithBackoff instead.
+func (c *Config) getSdkConfig(code string) *sdk.Config {
 	return sdk.NewConfig().
-		WithMaxRetryTime(5).
-		WithUserAgent(getUserAgent()).
+		WithAutoRetry(false).
+		WithTimeout(time.Duration(c.ClientConnectTimeout+c.ClientReadTimeout) * time.Millisecond).
+		WithUserAgent(c.getUserAgent()).
 		WithGoRoutinePoolSize(10).
 		WithDebug(false).
-		WithHttpTransport(getTransport())
+		WithScheme(c.getScheme(code)).
+		WithHttpTransport(c.getTransport())
+}
+
+// setSdkEndpoint makes the client of the official SDK request the custom endpoint of the product in its region. The
+// endpoints of a product are shared by all of the clients of the SDK, so they are replaced instead of being modified.
+func (c *Config) setSdkEndpoint(client *sdk.Client, code string) {
+	endpoint, ok := c.Endpoints[code]
+	if !ok || endpoint == "" {
+		return
+	}
+	_, host := splitScheme(endpoint)
+	client.EndpointMap = map[string]string{c.RegionId: host}
+	if client.EndpointType == "" {
+		client.EndpointType = "regional"
+	}
+}
+
+// getEndpoint returns the custom endpoint of the product, or defaultEndpoint if it is not specified.
+func (c *Config) getEndpoint(code, defaultEndpoint string) string {
+	if endpoint, ok := c.Endpoints[code]; ok && endpoint != "" {
+		return endpoint
+	}
+	return defaultEndpoint
 }
 
-func (c *Config) getAuthCredential(stsSupported bool) auth.Credential {
-	if stsSupported {
-		return credentials.NewStsTokenCredential(c.AccessKey, c.SecretKey, c.SecurityToken)
+// withScheme completes the endpoint with the scheme of the product, which is required by the clients of aliyungo and oss.
+func (c *Config) withScheme(code, endpoint string) string {
+	_, host := splitScheme(endpoint)
+	return c.getScheme(code) + "://" + host
+}
+
+// getScheme returns the scheme of the requests to the product. The scheme of its custom endpoint takes precedence over
+// the protocol, so that a product can be requested by HTTP, e.g. by a VPC endpoint, while the others use HTTPS.
+func (c *Config) getScheme(code string) string {
+	scheme, _ := c.splitEndpoint(c.Endpoints[code])
+	return scheme
+}
+
+// splitEndpoint splits the endpoint into the scheme of its requests and its host. The scheme of the endpoint takes
+// precedence over the protocol, which is used by the endpoint without a scheme.
+func (c *Config) splitEndpoint(endpoint string) (scheme, host string) {
+	scheme, host = splitScheme(endpoint)
+	if scheme != "" {
+		return scheme, host
+	}
+	if c.Protocol != "" {
+		return strings.ToLower(c.Protocol), host
 	}
+	return "https", host
+}
 
-	return credentials.NewAccessKeyCredential(c.AccessKey, c.SecretKey)
+// splitScheme splits the endpoint into its lower case scheme, which is empty if it is not specified, and its host.
+func splitScheme(endpoint string) (scheme, host string) {
+	if i := strings.Index(endpoint, "://"); i >= 0 {
+		return strings.ToLower(endpoint[:i]), endpoint[i+3:]
+	}
+	return "", endpoint
 }
 
-func getUserAgent() string {
-	return fmt.Sprintf("HashiCorp-Terraform-v%s", strings.TrimSuffix(terraform.VersionString(), "-dev"))
+// requestRewriter sends the API requests of the clients built from a config. The scheme of the requests is replaced
+// with the protocol, or the scheme of the custom endpoint which is requested, so that the protocol applies to the
+// default endpoints of aliyungo as well. Since all of the API requests go through it, the requests wait for the limiter
+// and are logged here, and they are sent by the transport.
+type requestRewriter struct {
+	scheme string
+	// schemes are the schemes of the custom endpoints specified with a scheme, by their hosts
+	schemes   map[string]string
+	limiter   *requestLimiter
+	transport http.RoundTripper
 }
 
-func getTransport() *http.Transport {
+func (r *requestRewriter) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request of its caller, which may send it again.
+	req = req.Clone(req.Context())
+	if scheme, ok := r.schemes[req.URL.Host]; ok {
+		req.URL.Scheme = scheme
+	} else if r.scheme != "" {
+		req.URL.Scheme = r.scheme
+	}
+	r.limiter.wait(req.URL.Host)
+
+	resp, err := r.transport.RoundTrip(req)
+	if logging.IsDebugOrHigher() {
+		logApiRequest(req, resp, err)
+	}
+	return resp, err
+}
+
+// apiRequestIdHeaders are the headers carrying the RequestId of the ROA style APIs and Log Service.
+var apiRequestIdHeaders = []string{"x-acs-request-id", "x-log-requestid"}
+
+// logApiRequest logs the action, the parameters and the RequestId of an API request, which the support of Alibaba Cloud
+// asks for to look into a failed request. The signature, the credentials and the passwords in the parameters are redacted.
+func logApiRequest(req *http.Request, resp *http.Response, err error) {
+	params := req.URL.Query()
+	if req.GetBody != nil && strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if body, e := req.GetBody(); e == nil {
+			bs, _ := ioutil.ReadAll(body)
+			body.Close()
+			if form, e := url.ParseQuery(string(bs)); e == nil {
+				for k, v := range form {
+					params[k] = v
+				}
+			}
+		}
+	}
+
+	action := params.Get("Action")
+	if action == "" {
+		action = req.Method + " " + req.URL.Path
+	}
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var values []string
+	for _, k := range keys {
+		v := strings.Join(params[k], ",")
+		if isSecretApiParam(k) {
+			v = "******"
+		}
+		values = append(values, k+"="+v)
+	}
+
+	if err != nil {
+		log.Printf("[DEBUG] API request %s to %s with %s failed: %s", action, req.URL.Host, strings.Join(values, "&"), err)
+		return
+	}
+	log.Printf("[DEBUG] API request %s to %s with %s responded %d and the RequestId is %s.", action, req.URL.Host,
+		strings.Join(values, "&"), resp.StatusCode, getApiRequestId(resp))
+}
+
+// getApiRequestId returns the RequestId from the headers, or from the body for the RPC style APIs. The body is
+// restored after it is read.
+func getApiRequestId(resp *http.Response) string {
+	for _, header := range apiRequestIdHeaders {
+		if id := resp.Header.Get(header); id != "" {
+			return id
+		}
+	}
+	if resp.Body == nil {
+		return ""
+	}
+	bs, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(bs))
+	if err != nil {
+		return ""
+	}
+	var body struct {
+		RequestId string `json:"RequestId"`
+	}
+	json.Unmarshal(bs, &body)
+	return body.RequestId
+}
+
+func isSecretApiParam(key string) bool {
+	key = strings.ToLower(key)
+	if key == "signature" || key == "accesskeyid" {
+		return true
+	}
+	for _, s := range []string{"password", "secret", "securitytoken"} {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestLimiter spaces the requests sent to each endpoint evenly, so that no more than the requests_per_second
+// are sent to an API in a second. A nil limiter does not limit anything.
+type requestLimiter struct {
+	interval time.Duration
+	next     map[string]time.Time
+	mutex    sync.Mutex
+}
+
+func newRequestLimiter(requestsPerSecond int) *requestLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return &requestLimiter{
+		interval: time.Second / time.Duration(requestsPerSecond),
+		next:     make(map[string]time.Time),
+	}
+}
+
+// wait blocks until the next request can be sent to the endpoint.
+func (l *requestLimiter) wait(endpoint string) {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	now := time.Now()
+	next := l.next[endpoint]
+	if next.Before(now) {
+		next = now
+	}
+	l.next[endpoint] = next.Add(l.interval)
+	l.mutex.Unlock()
+
+	if delay := next.Sub(now); delay > 0 {
+		log.Printf("[TRACE] The request to %s is delayed %s by the requests_per_second.", endpoint, delay)
+		time.Sleep(delay)
+	}
+}
+
+// getAuthCredential returns the STS token credential when the security token is provided,
+// and all of the clients of the official SDK are built with it.
+func (c *Config) getAuthCredential() auth.Credential {
+	return newAuthCredential(c.getCredential())
+}
+
+// newAuthCredential returns the credential of the official SDK with the credentials.
+func newAuthCredential(credential EcsRoleCredential) auth.Credential {
+	if credential.SecurityToken != "" {
+		return credentials.NewStsTokenCredential(credential.AccessKeyId, credential.AccessKeySecret, credential.SecurityToken)
+	}
+
+	return credentials.NewAccessKeyCredential(credential.AccessKeyId, credential.AccessKeySecret)
+}
+
+// getSigner returns the signer of the current temporary credentials, and the clients of the official SDK sign the
+// requests with it instead of the credentials they are built with. It returns nil for the static credentials, and
+// the clients sign the requests with their own signers then.
+func (client *AliyunClient) getSigner() auth.Signer {
+	if client.config == nil || client.config.credential == nil {
+		return nil
+	}
+	signer, err := auth.NewSignerWithCredential(client.config.getAuthCredential(), nil)
+	if err != nil {
+		log.Printf("[WARN] Building the signer of the temporary credentials got an error: %s", err)
+		return nil
+	}
+	return signer
+}
+
+func (c *Config) getUserAgent() string {
+	userAgent := fmt.Sprintf("HashiCorp-Terraform-v%s", strings.TrimSuffix(terraform.VersionString(), "-dev"))
+	if c.ConfigurationSource != "" {
+		userAgent += " " + c.ConfigurationSource
+	}
+	return userAgent
+}
+
+// newRequestRewriter builds the rewriter of the config, which sends the requests by a transport of its own with the
+// proxy and the timeouts of the config.
+func (c *Config) newRequestRewriter() *requestRewriter {
 	handshakeTimeout, err := strconv.Atoi(os.Getenv("TLSHandshakeTimeout"))
 	if err != nil {
 		handshakeTimeout = 120
 	}
-	return &http.Transport{
-		TLSHandshakeTimeout: time.Duration(handshakeTimeout) * time.Second}
+	transport := &http.Transport{
+		TLSHandshakeTimeout: time.Duration(handshakeTimeout) * time.Second,
+		Proxy:               c.getProxy,
+	}
+
+	if c.ClientConnectTimeout > 0 {
+		transport.DialContext = (&net.Dialer{
+			Timeout:   time.Duration(c.ClientConnectTimeout) * time.Millisecond,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
+	}
+	if c.ClientReadTimeout > 0 {
+		transport.ResponseHeaderTimeout = time.Duration(c.ClientReadTimeout) * time.Millisecond
+	}
+
+	rewriter := &requestRewriter{
+		scheme:    strings.ToLower(c.Protocol),
+		schemes:   make(map[string]string),
+		limiter:   c.limiter,
+		transport: transport,
+	}
+	for _, endpoint := range c.Endpoints {
+		if scheme, host := splitScheme(endpoint); scheme != "" {
+			rewriter.schemes[host] = scheme
+		}
+	}
+	return rewriter
+}
+
+// getTransport returns the transport of a client of the official SDK, which only hands the requests to the rewriter.
+// The SDK sets the timeouts and the TLS config of the transport of its client, so every client gets one of its own.
+func (c *Config) getTransport() *http.Transport {
+	dispatcher := &http.Transport{}
+	dispatcher.RegisterProtocol("http", c.rewriter)
+	dispatcher.RegisterProtocol("https", c.rewriter)
+	return dispatcher
+}
+
+// getHttpClient returns the http client of Log Service. The clients of aliyungo send their requests by the rewriter
+// set by SetTransport.
+func (c *Config) getHttpClient() *http.Client {
+	return &http.Client{Transport: c.rewriter}
+}
+
+// getProxy returns the proxy used by the request. The proxy_url takes precedence over the environment
+// variables HTTP_PROXY, HTTPS_PROXY and NO_PROXY.
+func (c *Config) getProxy(req *http.Request) (*url.URL, error) {
+	if c.ProxyUrl != "" {
+		return url.Parse(c.ProxyUrl)
+	}
+	return http.ProxyFromEnvironment(req)
 }
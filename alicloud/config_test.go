@@ -0,0 +1,542 @@
+package alicloud
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/denverdino/aliyungo/common"
+	"github.com/denverdino/aliyungo/ram"
+)
+
+func TestConfigSdkEndpoint(t *testing.T) {
+	var host string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.Host
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"RequestId":"A1B2C3D4"}`))
+	}))
+	defer server.Close()
+
+	// The custom endpoint is requested by HTTP as its scheme, though the protocol is HTTPS.
+	config := &Config{
+		AccessKey: "ak",
+		SecretKey: "sk",
+		RegionId:  string(common.Hangzhou),
+		Protocol:  "HTTPS",
+		Endpoints: map[string]string{
+			VpcCode: server.URL,
+		},
+	}
+	config.rewriter = config.newRequestRewriter()
+	conn, err := config.vpcConn()
+	if err != nil {
+		t.Fatalf("Building the VPC client got an error: %#v", err)
+	}
+
+	if _, err := conn.DescribeVpcs(vpc.CreateDescribeVpcsRequest()); err != nil {
+		t.Fatalf("Sending request to the custom endpoint got an error: %#v", err)
+	}
+	if expected := strings.TrimPrefix(server.URL, "http://"); host != expected {
+		t.Fatalf("Expected the request is sent to %s, got %s.", expected, host)
+	}
+}
+
+func TestConfigRequestRewriter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	config := &Config{Protocol: "HTTP"}
+	config.rewriter = config.newRequestRewriter()
+
+	req, _ := http.NewRequest(http.MethodGet, strings.Replace(server.URL, "http://", "https://", 1), nil)
+	resp, err := config.getHttpClient().Do(req)
+	if err != nil {
+		t.Fatalf("Expected the request is sent by the protocol HTTP, got an error: %#v", err)
+	}
+	resp.Body.Close()
+	if req.URL.Scheme != "https" {
+		t.Fatalf("Expected the request of the caller is not modified, got the scheme %s.", req.URL.Scheme)
+	}
+}
+
+// recordingTransport responds to the requests without sending them, and it records the hosts they are sent to.
+type recordingTransport struct {
+	hosts []string
+	mutex sync.Mutex
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mutex.Lock()
+	t.hosts = append(t.hosts, req.URL.Host)
+	t.mutex.Unlock()
+
+	body := `{"RequestId":"A1B2C3D4"}`
+	if strings.HasPrefix(req.URL.Host, "cs.") {
+		body = `[]`
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func (t *recordingTransport) count() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return len(t.hosts)
+}
+
+func TestConfigRequestRewriterSendsAllRequests(t *testing.T) {
+	config := &Config{
+		AccessKey: "ak",
+		SecretKey: "sk",
+		Region:    common.Hangzhou,
+		RegionId:  string(common.Hangzhou),
+	}
+	transport := &recordingTransport{}
+	config.rewriter = config.newRequestRewriter()
+	config.rewriter.transport = transport
+
+	// A request which does not go through the rewriter is sent to the network, which fails in the test.
+	client, err := config.newAliyunClient()
+	if err != nil {
+		t.Fatalf("Building the clients got an error: %#v", err)
+	}
+	if transport.count() == 0 {
+		t.Fatalf("Expected the DescribeRegions of the ECS client is sent by the rewriter.")
+	}
+
+	for name, send := range map[string]func() error{
+		"vpc": func() error {
+			return client.doAction(client.vpcconn, vpc.CreateDescribeVpcsRequest(), vpc.CreateDescribeVpcsResponse())
+		},
+		"ess": func() error {
+			return client.essconn().Invoke("DescribeScalingGroups", &struct{}{}, &common.Response{})
+		},
+		"kms": func() error { return client.kmsconn().Invoke("ListKeys", &struct{}{}, &common.Response{}) },
+		"dns": func() error { return client.dnsconn().Invoke("DescribeDomains", &struct{}{}, &common.Response{}) },
+		"cdn": func() error { return client.cdnconn().Invoke("DescribeUserDomains", &struct{}{}, &common.Response{}) },
+		"ram": func() error {
+			return client.ramconn().(*ram.RamClient).Invoke("ListRoles", &struct{}{}, &common.Response{})
+		},
+		"cs": func() error {
+			_, err := client.csconn().DescribeClusters("")
+			return err
+		},
+	} {
+		sent := transport.count()
+		if err := send(); err != nil {
+			t.Fatalf("Sending the request of %s got an error: %#v", name, err)
+		}
+		if transport.count() == sent {
+			t.Fatalf("Expected the request of %s is sent by the rewriter.", name)
+		}
+	}
+
+	// The endpoints of ESS and KMS are looked up by the location service through the rewriter as well.
+	found := false
+	for _, host := range transport.hosts {
+		found = found || strings.HasPrefix(host, "location.")
+	}
+	if !found {
+		t.Fatalf("Expected the location service is requested by the rewriter, got the hosts %v.", transport.hosts)
+	}
+}
+
+func TestConfigGetEndpoint(t *testing.T) {
+	config := &Config{
+		Endpoints: map[string]string{
+			EcsCode: "ecs.example.com",
+		},
+	}
+
+	if endpoint := config.getEndpoint(EcsCode, "ecs.aliyuncs.com"); endpoint != "ecs.example.com" {
+		t.Fatalf("Expected the custom endpoint ecs.example.com, got %s.", endpoint)
+	}
+	if endpoint := config.getEndpoint(SlbCode, "slb.aliyuncs.com"); endpoint != "slb.aliyuncs.com" {
+		t.Fatalf("Expected the default endpoint slb.aliyuncs.com, got %s.", endpoint)
+	}
+	if endpoint := config.withScheme(EcsCode, "ecs.example.com"); endpoint != "https://ecs.example.com" {
+		t.Fatalf("Expected the endpoint is completed with https, got %s.", endpoint)
+	}
+
+	config.Protocol = "HTTP"
+	if endpoint := config.withScheme(EcsCode, "https://ecs.example.com"); endpoint != "http://ecs.example.com" {
+		t.Fatalf("Expected the endpoint uses the protocol http, got %s.", endpoint)
+	}
+}
+
+func TestConfigGetScheme(t *testing.T) {
+	config := &Config{
+		Protocol: "HTTPS",
+		Endpoints: map[string]string{
+			EcsCode: "ecs.example.com",
+			SlbCode: "HTTP://slb.vpc.example.com",
+		},
+	}
+
+	if scheme := config.getScheme(EcsCode); scheme != "https" {
+		t.Fatalf("Expected the protocol is used by the endpoint without a scheme, got %s.", scheme)
+	}
+	if endpoint := config.withScheme(SlbCode, config.Endpoints[SlbCode]); endpoint != "http://slb.vpc.example.com" {
+		t.Fatalf("Expected the scheme of the custom endpoint takes precedence over the protocol, got %s.", endpoint)
+	}
+	if endpoint := config.withScheme(DnsCode, "http://alidns.aliyuncs.com"); endpoint != "https://alidns.aliyuncs.com" {
+		t.Fatalf("Expected the default endpoint uses the protocol, got %s.", endpoint)
+	}
+
+	config.Protocol = "HTTP"
+	if scheme, host := config.splitEndpoint("sts.aliyuncs.com"); scheme != "http" || host != "sts.aliyuncs.com" {
+		t.Fatalf("Expected the endpoint without a scheme uses the protocol, got %s://%s.", scheme, host)
+	}
+	if scheme, host := config.splitEndpoint("HTTPS://sts.vpc.example.com"); scheme != "https" || host != "sts.vpc.example.com" {
+		t.Fatalf("Expected the scheme of the endpoint takes precedence over the protocol, got %s://%s.", scheme, host)
+	}
+}
+
+func TestConfigSkipRegionValidation(t *testing.T) {
+	config := &Config{
+		Region: common.Region("cn-unknown-1"),
+	}
+	if err := config.loadAndValidate(); err == nil {
+		t.Fatalf("Expected the unknown region is rejected.")
+	}
+
+	config.SkipRegionValidation = true
+	if err := config.loadAndValidate(); err != nil {
+		t.Fatalf("Expected the region validation is skipped, got an error: %#v", err)
+	}
+}
+
+func TestConfigUserAgent(t *testing.T) {
+	config := &Config{ConfigurationSource: "pipeline/network"}
+	if userAgent := config.getUserAgent(); !strings.HasSuffix(userAgent, " pipeline/network") {
+		t.Fatalf("Expected the configuration source is appended to the User-Agent, got %s.", userAgent)
+	}
+}
+
+func TestConfigGetProxy(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://ecs.aliyuncs.com", nil)
+
+	config := &Config{ProxyUrl: "http://proxy.example.com:8080"}
+	proxy, err := config.getProxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.example.com:8080" {
+		t.Fatalf("Expected the proxy_url is used, got %#v and error %#v", proxy, err)
+	}
+}
+
+func TestConfigGetAuthCredential(t *testing.T) {
+	config := &Config{AccessKey: "id", SecretKey: "secret"}
+	if _, ok := config.getAuthCredential().(*credentials.AccessKeyCredential); !ok {
+		t.Fatalf("Expected the AccessKey credential is used without a security token.")
+	}
+
+	config.SecurityToken = "token"
+	credential, ok := config.getAuthCredential().(*credentials.StsTokenCredential)
+	if !ok || credential.AccessKeyStsToken != "token" {
+		t.Fatalf("Expected the STS token credential is used with the security token, got %#v", config.getAuthCredential())
+	}
+}
+
+func TestConfigGetEcsMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/terraform-provider-alicloud") {
+			w.Write([]byte(`{"Code":"Success","AccessKeyId":"STS.id","AccessKeySecret":"secret","SecurityToken":"token"}`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	content, err := getEcsMetadata(server.Client(), server.URL+"/ram/security-credentials/terraform-provider-alicloud")
+	if err != nil || !strings.Contains(content, `"AccessKeyId":"STS.id"`) {
+		t.Fatalf("Expected the credentials of the role are fetched, got %s and error %#v", content, err)
+	}
+	if _, err := getEcsMetadata(server.Client(), server.URL+"/ram/security-credentials/unknown"); err == nil {
+		t.Fatalf("Expected an error when the metadata service does not respond 200.")
+	}
+}
+
+func TestConfigGetUriCredential(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/credentials":
+			w.Write([]byte(`{"Code":"Success","AccessKeyId":"STS.id","AccessKeySecret":"secret","SecurityToken":"token","Expiration":"2018-11-01T12:00:00Z"}`))
+		case "/failed":
+			w.Write([]byte(`{"Code":"Failed"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	credential, err := getUriCredential(server.Client(), server.URL+"/credentials")
+	if err != nil || credential.AccessKeyId != "STS.id" || credential.AccessKeySecret != "secret" || credential.SecurityToken != "token" {
+		t.Fatalf("Expected the credentials are fetched from the URI, got %#v and error %#v", credential, err)
+	}
+	if _, err := getUriCredential(server.Client(), server.URL+"/failed"); err == nil {
+		t.Fatalf("Expected an error when the code is not Success.")
+	}
+	if _, err := getUriCredential(server.Client(), server.URL+"/unknown"); err == nil {
+		t.Fatalf("Expected an error when the URI does not respond 200.")
+	}
+}
+
+func TestConfigSessionCredential(t *testing.T) {
+	loads := 0
+	var loadErr error
+	expiresIn := time.Hour
+	session := &sessionCredential{load: func() (EcsRoleCredential, error) {
+		if loadErr != nil {
+			return EcsRoleCredential{}, loadErr
+		}
+		loads++
+		return EcsRoleCredential{
+			AccessKeyId:     fmt.Sprintf("STS.%d", loads),
+			AccessKeySecret: "secret",
+			SecurityToken:   "token",
+			Expiration:      time.Now().Add(expiresIn).UTC().Format(time.RFC3339),
+		}, nil
+	}}
+	if err := session.refresh(); err != nil {
+		t.Fatalf("Refreshing the credentials got an error: %#v", err)
+	}
+	if credential := session.get(); credential.AccessKeyId != "STS.1" || loads != 1 {
+		t.Fatalf("Expected the credentials are not loaded again before they are about to expire, got %s and %d loads", credential.AccessKeyId, loads)
+	}
+	if !session.refreshAt.After(time.Now().Add(expiresIn - CredentialRefreshAhead - time.Minute)) {
+		t.Fatalf("Expected the credentials are loaded again %s before they expire, got %s", CredentialRefreshAhead, session.refreshAt)
+	}
+
+	session.refreshAt = time.Now()
+	if credential := session.get(); credential.AccessKeyId != "STS.2" || loads != 2 {
+		t.Fatalf("Expected the credentials are loaded again when they are about to expire, got %s and %d loads", credential.AccessKeyId, loads)
+	}
+
+	loadErr = fmt.Errorf("metadata service is unavailable")
+	session.refreshAt = time.Now()
+	if credential := session.get(); credential.AccessKeyId != "STS.2" {
+		t.Fatalf("Expected the credentials loaded before are kept when the load fails, got %s", credential.AccessKeyId)
+	}
+	if !session.refreshAt.After(time.Now()) {
+		t.Fatalf("Expected the next load is delayed after the load fails, got %s", session.refreshAt)
+	}
+
+	loadErr = nil
+	expiresIn = time.Minute
+	session.refreshAt = time.Now()
+	session.get()
+	if session.refreshAt.Before(time.Now().Add(CredentialRefreshInterval - time.Second)) {
+		t.Fatalf("Expected the credentials are loaded at most once per %s, got %s", CredentialRefreshInterval, session.refreshAt)
+	}
+}
+
+func TestConfigAssumeRoleRefresh(t *testing.T) {
+	assumed := 0
+	var signedBy []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assumed++
+		signedBy = append(signedBy, r.FormValue("AccessKeyId"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"RequestId":"A1B2C3D4","Credentials":{"AccessKeyId":"STS.role.%d","AccessKeySecret":"secret",`+
+			`"SecurityToken":"token","Expiration":"%s"},"AssumedRoleUser":{"Arn":"acs:ram::123456:role/terraform"}}`,
+			assumed, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))))
+	}))
+	defer server.Close()
+
+	loads := 0
+	base := &sessionCredential{load: func() (EcsRoleCredential, error) {
+		loads++
+		return EcsRoleCredential{AccessKeyId: fmt.Sprintf("STS.base.%d", loads), AccessKeySecret: "secret", SecurityToken: "token"}, nil
+	}}
+	if err := base.refresh(); err != nil {
+		t.Fatalf("Refreshing the base credentials got an error: %#v", err)
+	}
+
+	config := &Config{
+		Region:                common.Hangzhou,
+		RegionId:              string(common.Hangzhou),
+		RoleArn:               "acs:ram::123456:role/terraform",
+		RoleSessionExpiration: 3600,
+		Endpoints:             map[string]string{StsCode: server.URL},
+	}
+	config.rewriter = config.newRequestRewriter()
+	config.credential = &sessionCredential{load: func() (EcsRoleCredential, error) {
+		return config.assumeRole(base)
+	}}
+	if err := config.credential.refresh(); err != nil {
+		t.Fatalf("Assuming the role got an error: %#v", err)
+	}
+	if credential := config.getCredential(); credential.AccessKeyId != "STS.role.1" || signedBy[0] != "STS.base.1" {
+		t.Fatalf("Expected the role is assumed with the base credentials, got %s signed by %v", credential.AccessKeyId, signedBy)
+	}
+	if credential := config.getCredential(); credential.AccessKeyId != "STS.role.1" || assumed != 1 {
+		t.Fatalf("Expected the role is not assumed again before its credentials are about to expire, got %d AssumeRole", assumed)
+	}
+
+	base.refreshAt = time.Now()
+	config.credential.refreshAt = time.Now()
+	if credential := config.getCredential(); credential.AccessKeyId != "STS.role.2" || signedBy[1] != "STS.base.2" {
+		t.Fatalf("Expected the role is assumed again with the refreshed base credentials, got %s signed by %v", credential.AccessKeyId, signedBy)
+	}
+}
+
+func TestAliyunClientSessionCredential(t *testing.T) {
+	loads := 0
+	session := &sessionCredential{load: func() (EcsRoleCredential, error) {
+		loads++
+		return EcsRoleCredential{AccessKeyId: fmt.Sprintf("STS.%d", loads), AccessKeySecret: "secret", SecurityToken: "token"}, nil
+	}}
+	if err := session.refresh(); err != nil {
+		t.Fatalf("Refreshing the credentials got an error: %#v", err)
+	}
+	client := &AliyunClient{config: &Config{credential: session}}
+
+	conn := &fakeSdkClient{}
+	client.doAction(conn, vpc.CreateDescribeVpcsRequest(), vpc.CreateDescribeVpcsResponse())
+	if conn.signer == nil {
+		t.Fatalf("Expected the request is signed with the temporary credentials.")
+	}
+	if accessKeyId, _ := conn.signer.GetAccessKeyId(); accessKeyId != "STS.1" {
+		t.Fatalf("Expected the request is signed with the current credentials, got %s", accessKeyId)
+	}
+
+	builds := 0
+	build := func() interface{} {
+		builds++
+		return builds
+	}
+	client.mustLazyClient(EssCode, build)
+	client.mustLazyClient(EssCode, build)
+	if builds != 1 {
+		t.Fatalf("Expected the client is cached until the credentials are refreshed, got %d builds", builds)
+	}
+
+	session.refreshAt = time.Now()
+	client.doAction(conn, vpc.CreateDescribeVpcsRequest(), vpc.CreateDescribeVpcsResponse())
+	if accessKeyId, _ := conn.signer.GetAccessKeyId(); accessKeyId != "STS.2" {
+		t.Fatalf("Expected the request is signed with the refreshed credentials, got %s", accessKeyId)
+	}
+	client.mustLazyClient(EssCode, build)
+	if builds != 2 {
+		t.Fatalf("Expected the client is built again after the credentials are refreshed, got %d builds", builds)
+	}
+
+	client = &AliyunClient{config: &Config{AccessKey: "id", SecretKey: "secret"}}
+	client.doAction(conn, vpc.CreateDescribeVpcsRequest(), vpc.CreateDescribeVpcsResponse())
+	if conn.signer != nil {
+		t.Fatalf("Expected the request is signed by the client itself with the static credentials.")
+	}
+}
+
+func TestConfigRequestLimiter(t *testing.T) {
+	if newRequestLimiter(0) != nil {
+		t.Fatalf("Expected no limiter when requests_per_second is 0.")
+	}
+
+	limiter := newRequestLimiter(20)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		limiter.wait("ecs.aliyuncs.com")
+	}
+	limiter.wait("vpc.aliyuncs.com")
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond || elapsed > time.Second {
+		t.Fatalf("Expected the requests to ecs.aliyuncs.com are spaced by 50ms, got %s for 3 requests.", elapsed)
+	}
+}
+
+func TestConfigGetApiRequestId(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("Action") == "" {
+			w.Header().Set("x-acs-request-id", "roa-request-id")
+		}
+		w.Write([]byte(`{"RequestId":"rpc-request-id"}`))
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/?Action=DescribeVpcs")
+	if err != nil {
+		t.Fatalf("Sending request got an error: %#v", err)
+	}
+	if id := getApiRequestId(resp); id != "rpc-request-id" {
+		t.Fatalf("Expected the RequestId is read from the body, got %s.", id)
+	}
+	if bs, _ := ioutil.ReadAll(resp.Body); string(bs) != `{"RequestId":"rpc-request-id"}` {
+		t.Fatalf("Expected the body is restored after reading the RequestId, got %s.", string(bs))
+	}
+
+	resp, err = http.Get(server.URL + "/clusters")
+	if err != nil {
+		t.Fatalf("Sending request got an error: %#v", err)
+	}
+	if id := getApiRequestId(resp); id != "roa-request-id" {
+		t.Fatalf("Expected the RequestId is read from the header, got %s.", id)
+	}
+}
+
+func TestConfigIsSecretApiParam(t *testing.T) {
+	for key, expected := range map[string]bool{
+		"Signature":       true,
+		"AccessKeyId":     true,
+		"SecurityToken":   true,
+		"Password":        true,
+		"AccountPassword": true,
+		"ClientSecret":    true,
+		"Action":          false,
+		"InstanceId":      false,
+	} {
+		if isSecretApiParam(key) != expected {
+			t.Fatalf("Expected isSecretApiParam of %s is %t", key, expected)
+		}
+	}
+}
+
+func TestAliyunClientLazyClient(t *testing.T) {
+	client := &AliyunClient{}
+	builds := 0
+	build := func() (interface{}, error) {
+		builds++
+		if builds == 1 {
+			return nil, fmt.Errorf("The endpoint of oss is not found.")
+		}
+		return builds, nil
+	}
+
+	if _, err := client.lazyClient(OssCode, build); err == nil {
+		t.Fatalf("Expected the error of building the client is returned.")
+	}
+	for i := 0; i < 2; i++ {
+		if c, err := client.lazyClient(OssCode, build); err != nil || c.(int) != 2 {
+			t.Fatalf("Expected the client is built again after a failure and cached, got %#v and error %#v", c, err)
+		}
+	}
+	if builds != 2 {
+		t.Fatalf("Expected the client is built twice, got %d", builds)
+	}
+}
+
+func TestAliyunClientWithRegion(t *testing.T) {
+	client := &AliyunClient{Region: common.Hangzhou}
+	if c, err := client.WithRegion(""); err != nil || c != client {
+		t.Fatalf("Expected the provider's client is returned when the region is not specified.")
+	}
+	if c, err := client.WithRegion(common.Hangzhou); err != nil || c != client {
+		t.Fatalf("Expected the provider's client is returned for the provider's region.")
+	}
+
+	cached := &AliyunClient{Region: common.Beijing}
+	client.regionClients = map[common.Region]*AliyunClient{common.Beijing: cached}
+	if c, err := client.WithRegion(common.Beijing); err != nil || c != cached {
+		t.Fatalf("Expected the cached client of %s is returned.", common.Beijing)
+	}
+}
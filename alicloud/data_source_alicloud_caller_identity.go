@@ -0,0 +1,53 @@
+package alicloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCallerIdentity() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCallerIdentityRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed values
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	identity, err := client.GetCallerIdentity()
+	if err != nil {
+		return err
+	}
+
+	d.SetId(identity.AccountId)
+	d.Set("account_id", identity.AccountId)
+	d.Set("arn", identity.Arn)
+	d.Set("identity_type", identity.IdentityType)
+	d.Set("user_id", identity.UserId)
+	d.Set("principal_id", identity.PrincipalId)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package alicloud
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudCallerIdentityDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWithAccountId(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudCallerIdentityDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_caller_identity.current"),
+					resource.TestCheckResourceAttr("data.alicloud_caller_identity.current", "account_id", os.Getenv("ALICLOUD_ACCOUNT_ID")),
+					resource.TestCheckResourceAttrSet("data.alicloud_caller_identity.current", "arn"),
+					resource.TestCheckResourceAttrSet("data.alicloud_caller_identity.current", "identity_type"),
+					resource.TestCheckResourceAttrSet("data.alicloud_caller_identity.current", "principal_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudCallerIdentityDataSourceBasic = `
+data "alicloud_caller_identity" "current" {
+}
+`
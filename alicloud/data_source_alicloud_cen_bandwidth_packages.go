@@ -0,0 +1,171 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCenBandwidthPackages() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCenBandwidthPackagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"packages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"charge_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"geographic_region_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cen_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"business_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expired_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCenBandwidthPackagesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	filters := make(map[string][]string)
+	if v, ok := d.GetOk("cen_id"); ok {
+		filters["CenId"] = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("ids"); ok {
+		for _, id := range v.([]interface{}) {
+			filters["CenBandwidthPackageId"] = append(filters["CenBandwidthPackageId"], Trim(id.(string)))
+		}
+	}
+
+	allPackages, err := client.DescribeCenBandwidthPackages(filters)
+	if err != nil {
+		return fmt.Errorf("DescribeCenBandwidthPackages got an error: %#v", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+
+	var filteredPackages []CenBandwidthPackage
+	for _, pkg := range allPackages {
+		if nameRegex != nil && !nameRegex.MatchString(pkg.Name) {
+			continue
+		}
+		filteredPackages = append(filteredPackages, pkg)
+	}
+
+	if len(filteredPackages) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_cen_bandwidth_packages - CEN bandwidth packages found: %#v", filteredPackages)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, pkg := range filteredPackages {
+		mapping := map[string]interface{}{
+			"id":                    pkg.CenBandwidthPackageId,
+			"name":                  pkg.Name,
+			"description":           pkg.Description,
+			"bandwidth":             pkg.Bandwidth,
+			"charge_type":           pkg.BandwidthPackageChargeType,
+			"geographic_region_ids": []string{pkg.GeographicRegionAId, pkg.GeographicRegionBId},
+			"cen_ids":               pkg.CenIds.CenId,
+			"status":                pkg.Status,
+			"business_status":       pkg.BusinessStatus,
+			"creation_time":         pkg.CreationTime,
+			"expired_time":          pkg.ExpiredTime,
+		}
+		ids = append(ids, pkg.CenBandwidthPackageId)
+		names = append(names, pkg.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("packages", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
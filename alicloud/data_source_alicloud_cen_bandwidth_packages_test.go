@@ -0,0 +1,35 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudCenBandwidthPackagesDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudCenBandwidthPackages().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudCenBandwidthPackagesDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudCenBandwidthPackagesDataSourceEmpty,
+				ExpectError: regexp.MustCompile("Your query returned no results"),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudCenBandwidthPackagesDataSourceEmpty = `
+data "alicloud_cen_bandwidth_packages" "default" {
+  name_regex = "^tf-testAccCenBandwidthPackagesDataSource-none$"
+}
+`
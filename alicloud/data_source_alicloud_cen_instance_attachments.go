@@ -0,0 +1,125 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCenInstanceAttachments() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCenInstanceAttachmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"child_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{CenChildInstanceVpc, CenChildInstanceVbr, CenChildInstanceCcn}),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cen_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attach_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCenInstanceAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	cenId := d.Get("cen_id").(string)
+	children, err := client.DescribeCenAttachedChildInstances(cenId, d.Get("child_instance_region_id").(string), d.Get("child_instance_type").(string))
+	if err != nil {
+		return fmt.Errorf("DescribeCenAttachedChildInstances got an error: %#v", err)
+	}
+
+	if len(children) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_cen_instance_attachments - CEN attachments found: %#v", children)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, child := range children {
+		id := fmt.Sprintf("%s%s%s", child.CenId, COLON_SEPARATED, child.ChildInstanceId)
+		mapping := map[string]interface{}{
+			"id":                       id,
+			"cen_id":                   child.CenId,
+			"child_instance_id":        child.ChildInstanceId,
+			"child_instance_type":      child.ChildInstanceType,
+			"child_instance_region_id": child.ChildInstanceRegionId,
+			"child_instance_owner_id":  fmt.Sprint(child.ChildInstanceOwnerId),
+			"status":                   child.Status,
+			"attach_time":              child.ChildInstanceAttachTime,
+		}
+		ids = append(ids, id)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("attachments", s); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudCenInstanceAttachmentsDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudCenInstanceAttachments().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudCenInstanceAttachmentsDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudCenInstanceAttachmentsDataSourceNotFound,
+				ExpectError: regexp.MustCompile("DescribeCenAttachedChildInstances got an error"),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudCenInstanceAttachmentsDataSourceNotFound = `
+data "alicloud_cen_instance_attachments" "default" {
+  cen_id = "cen-tftestacc0000000000"
+}
+`
@@ -0,0 +1,142 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCenInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCenInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protection_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth_package_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCenInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	filters := make(map[string][]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, id := range v.([]interface{}) {
+			filters["CenId"] = append(filters["CenId"], Trim(id.(string)))
+		}
+	}
+
+	allCens, err := client.DescribeCenInstances(filters)
+	if err != nil {
+		return fmt.Errorf("DescribeCens got an error: %#v", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+
+	var filteredCens []CenInstance
+	for _, cen := range allCens {
+		if nameRegex != nil && !nameRegex.MatchString(cen.Name) {
+			continue
+		}
+		filteredCens = append(filteredCens, cen)
+	}
+
+	if len(filteredCens) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_cen_instances - CEN instances found: %#v", filteredCens)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, cen := range filteredCens {
+		mapping := map[string]interface{}{
+			"id":                    cen.CenId,
+			"name":                  cen.Name,
+			"description":           cen.Description,
+			"status":                cen.Status,
+			"protection_level":      cen.ProtectionLevel,
+			"bandwidth_package_ids": cen.CenBandwidthPackageIds.CenBandwidthPackageId,
+			"creation_time":         cen.CreationTime,
+		}
+		ids = append(ids, cen.CenId)
+		names = append(names, cen.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("instances", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package alicloud
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildCenFilterParams(t *testing.T) {
+	params := make(map[string]string)
+	buildCenFilterParams(params, map[string][]string{
+		"Name":  {"foo"},
+		"CenId": {"cen-1", "cen-2"},
+		"Empty": {},
+	})
+	expected := map[string]string{
+		"Filter.1.Key":     "CenId",
+		"Filter.1.Value.1": "cen-1",
+		"Filter.1.Value.2": "cen-2",
+		"Filter.2.Key":     "Name",
+		"Filter.2.Value.1": "foo",
+	}
+	if !reflect.DeepEqual(params, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, params)
+	}
+}
@@ -0,0 +1,186 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCenRouteEntries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCenRouteEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      CenChildInstanceVpc,
+				ValidateFunc: validateAllowedStringValue([]string{CenChildInstanceVpc, CenChildInstanceVbr}),
+			},
+			"child_instance_region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"cidr_block": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"publish_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operational_mode": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"conflicts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cidr_block": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"region_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"instance_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"instance_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCenRouteEntriesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	regionId := string(client.Region)
+	if v, ok := d.GetOk("child_instance_region_id"); ok {
+		regionId = v.(string)
+	}
+	allEntries, err := client.DescribeCenPublishedRouteEntries(d.Get("cen_id").(string), d.Get("child_instance_id").(string),
+		d.Get("child_instance_type").(string), regionId, d.Get("route_table_id").(string))
+	if err != nil {
+		return fmt.Errorf("DescribePublishedRouteEntries got an error: %#v", err)
+	}
+
+	var filteredEntries []CenPublishedRouteEntry
+	for _, entry := range allEntries {
+		if v, ok := d.GetOk("cidr_block"); ok && entry.DestinationCidrBlock != v.(string) {
+			continue
+		}
+		filteredEntries = append(filteredEntries, entry)
+	}
+
+	if len(filteredEntries) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_cen_route_entries - CEN route entries found: %#v", filteredEntries)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, entry := range filteredEntries {
+		var conflicts []map[string]interface{}
+		for _, conflict := range entry.Conflicts.Conflict {
+			conflicts = append(conflicts, map[string]interface{}{
+				"cidr_block":    conflict.DestinationCidrBlock,
+				"region_id":     conflict.RegionId,
+				"instance_id":   conflict.InstanceId,
+				"instance_type": conflict.InstanceType,
+				"status":        conflict.Status,
+			})
+		}
+		mapping := map[string]interface{}{
+			"route_table_id":   entry.ChildInstanceRouteTableId,
+			"cidr_block":       entry.DestinationCidrBlock,
+			"next_hop_type":    entry.NextHopType,
+			"next_hop_id":      entry.NextHopId,
+			"route_type":       entry.RouteType,
+			"publish_status":   entry.PublishStatus,
+			"operational_mode": entry.OperationalMode,
+			"conflicts":        conflicts,
+		}
+		ids = append(ids, entry.ChildInstanceRouteTableId+COLON_SEPARATED+entry.DestinationCidrBlock)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("entries", s); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudCenRouteEntriesDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudCenRouteEntries().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudCenRouteEntriesDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudCenRouteEntriesDataSourceNotFound,
+				ExpectError: regexp.MustCompile("DescribePublishedRouteEntries got an error"),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudCenRouteEntriesDataSourceNotFound = `
+data "alicloud_cen_route_entries" "default" {
+  cen_id            = "cen-tftestacc0000000000"
+  child_instance_id = "vpc-tftestacc0000000000"
+}
+`
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"os"
+	"sort"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"gopkg.in/yaml.v2"
 )
 
 // Generates a hash for the set hash function used by the ID
@@ -22,12 +25,99 @@ func dataResourceIdHash(ids []string) string {
 	return fmt.Sprintf("%d", hashcode.String(buf.String()))
 }
 
-func writeToFile(filePath string, data interface{}) {
+// writeToFile writes data to the file in the specified format. The items of a list are sorted by
+// their id or name, and the file is left untouched when its content is unchanged, so that
+// repeated plans produce the same file.
+func writeToFile(filePath string, data interface{}, format OutputFileFormat) {
+	sortOutputData(data)
+	writeOrderedToFile(filePath, data, format)
+}
+
+// writeOrderedToFile is writeToFile for the data sources whose results are ordered, like the
+// instance types sorted by price, and it keeps the items of a list in their order.
+func writeOrderedToFile(filePath string, data interface{}, format OutputFileFormat) {
+	var bs []byte
+	var err error
+	if format == OutputFileYaml {
+		bs, err = yaml.Marshal(data)
+	} else {
+		bs, err = json.MarshalIndent(data, "", "\t")
+	}
+	if err != nil {
+		log.Printf("[WARN] Marshaling the data of %s got an error: %#v", filePath, err)
+		return
+	}
+
+	if old, err := ioutil.ReadFile(filePath); err == nil && bytes.Equal(old, bs) {
+		return
+	}
 	os.Remove(filePath)
-	if bs, err := json.MarshalIndent(data, "", "\t"); err == nil {
-		str := string(bs)
-		ioutil.WriteFile(filePath, []byte(str), 777)
+	if err := ioutil.WriteFile(filePath, bs, 0644); err != nil {
+		log.Printf("[WARN] Writing %s got an error: %#v", filePath, err)
+	}
+}
+
+// sortOutputData sorts a list of items by their id, or by their name if they have no id.
+func sortOutputData(data interface{}) {
+	items, ok := data.([]map[string]interface{})
+	if !ok {
+		return
+	}
+	key := func(item map[string]interface{}) string {
+		if v, ok := item["id"]; ok {
+			return fmt.Sprint(v)
+		}
+		return fmt.Sprint(item["name"])
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return key(items[i]) < key(items[j])
+	})
+}
+
+// outputFileFormatSchema returns the schema of the argument `output_file_format` which
+// specifies the format of the file saved by `output_file`.
+func outputFileFormatSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      string(OutputFileJson),
+		ValidateFunc: validateAllowedStringValue([]string{string(OutputFileJson), string(OutputFileYaml)}),
+	}
+}
+
+// idsSchema returns the schema of the argument `ids` shared by the data sources. It filters
+// the results by their ids, and it is set to the ids of the results after reading.
+func idsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		MinItems: 1,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// namesSchema returns the schema of the attribute `names`, which is the names of the results.
+func namesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// idsFilter returns the ids specified by the argument `ids`, or nil if it is not specified.
+func idsFilter(d *schema.ResourceData) map[string]bool {
+	v, ok := d.GetOk("ids")
+	if !ok || len(v.([]interface{})) < 1 {
+		return nil
+	}
+	ids := make(map[string]bool)
+	for _, id := range v.([]interface{}) {
+		ids[Trim(id.(string))] = true
 	}
+	return ids
 }
 
 func outputInstancesSchema() map[string]*schema.Schema {
@@ -0,0 +1,58 @@
+package alicloud
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWriteToFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-alicloud-output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := []map[string]interface{}{
+		{"id": "vpc-2", "name": "web"},
+		{"id": "vpc-1", "name": "db"},
+	}
+
+	jsonFile := filepath.Join(dir, "vpcs.json")
+	writeToFile(jsonFile, data, OutputFileJson)
+	bs, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "[\n\t{\n\t\t\"id\": \"vpc-1\",\n\t\t\"name\": \"db\"\n\t},\n\t{\n\t\t\"id\": \"vpc-2\",\n\t\t\"name\": \"web\"\n\t}\n]"
+	if string(bs) != expected {
+		t.Fatalf("Expected the json file %q, got %q", expected, string(bs))
+	}
+
+	// The file is not rewritten when its content is unchanged.
+	past := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(jsonFile, past, past); err != nil {
+		t.Fatal(err)
+	}
+	writeToFile(jsonFile, data, OutputFileJson)
+	info, err := os.Stat(jsonFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.ModTime().Equal(past) {
+		t.Fatalf("Expected %s is not rewritten, but it was modified at %s", jsonFile, info.ModTime())
+	}
+
+	yamlFile := filepath.Join(dir, "vpcs.yaml")
+	writeToFile(yamlFile, data, OutputFileYaml)
+	bs, err = ioutil.ReadFile(yamlFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = "- id: vpc-1\n  name: db\n- id: vpc-2\n  name: web\n"
+	if string(bs) != expected {
+		t.Fatalf("Expected the yaml file %q, got %q", expected, string(bs))
+	}
+}
@@ -0,0 +1,327 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCSKubernetesClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCSKubernetesClustersRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"enable_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_kube_config": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slb_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connections": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"node_pools": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"instance_types": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"vswitch_ids": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"total_nodes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"nodes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"private_ip": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"role": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"kube_config": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"cluster_ca_cert": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCSKubernetesClustersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allClusters, err := client.DescribeKubernetesClusters()
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredClusters []KubernetesCluster
+	for _, cluster := range allClusters {
+		if nameRegex != nil && !nameRegex.MatchString(cluster.Name) {
+			continue
+		}
+		if idsMap != nil && !idsMap[cluster.ClusterId] {
+			continue
+		}
+		filteredClusters = append(filteredClusters, cluster)
+	}
+
+	if len(filteredClusters) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_cs_kubernetes_clusters - Clusters found: %#v", filteredClusters)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, cluster := range filteredClusters {
+		connections := describeKubernetesConnections(cluster)
+		mapping := map[string]interface{}{
+			"id":                cluster.ClusterId,
+			"name":              cluster.Name,
+			"cluster_type":      cluster.ClusterType,
+			"version":           cluster.CurrentVersion,
+			"state":             cluster.State,
+			"availability_zone": cluster.ZoneId,
+			"vpc_id":            cluster.VpcId,
+			"vswitch_ids":       splitKubernetesVSwitchIds(cluster.VSwitchId),
+			"security_group_id": cluster.SecurityGroupId,
+			"slb_id":            cluster.ExternalLoadbalancerId,
+			"size":              cluster.Size,
+			"creation_time":     cluster.Created,
+			"connections": map[string]interface{}{
+				"api_server_internet": connections.ApiServerInternet,
+				"api_server_intranet": connections.ApiServerIntranet,
+			},
+		}
+
+		// The node pools and the nodes require a request per cluster, so they are only read when asked.
+		if d.Get("enable_details").(bool) {
+			nodePools, err := client.DescribeKubernetesNodePools(cluster.ClusterId)
+			if err != nil {
+				return err
+			}
+			var pools []map[string]interface{}
+			for _, pool := range nodePools {
+				pools = append(pools, map[string]interface{}{
+					"id":             pool.NodePoolInfo.NodePoolId,
+					"name":           pool.NodePoolInfo.Name,
+					"type":           pool.NodePoolInfo.Type,
+					"state":          pool.Status.State,
+					"instance_types": pool.ScalingGroup.InstanceTypes,
+					"vswitch_ids":    pool.ScalingGroup.VSwitchIds,
+					"total_nodes":    pool.Status.TotalNodes,
+				})
+			}
+			mapping["node_pools"] = pools
+
+			var nodes []map[string]interface{}
+			for pageNumber := 1; ; pageNumber++ {
+				result, pagination, err := client.csconn().GetKubernetesClusterNodes(cluster.ClusterId, common.Pagination{PageNumber: pageNumber, PageSize: PageSizeLarge})
+				if err != nil {
+					return fmt.Errorf("GetKubernetesClusterNodes got an error: %#v", err)
+				}
+				for _, node := range result {
+					privateIp := ""
+					if len(node.IpAddress) > 0 {
+						privateIp = node.IpAddress[0]
+					}
+					nodes = append(nodes, map[string]interface{}{
+						"id":         node.InstanceId,
+						"name":       node.InstanceName,
+						"private_ip": privateIp,
+						"role":       node.InstanceRole,
+					})
+				}
+				if len(result) < PageSizeLarge || pagination.TotalCount <= pageNumber*PageSizeLarge {
+					break
+				}
+			}
+			mapping["nodes"] = nodes
+		}
+
+		if d.Get("enable_kube_config").(bool) {
+			kubeConfig, err := client.DescribeKubernetesUserConfig(cluster.ClusterId)
+			if err != nil {
+				return err
+			}
+			certs, err := client.csconn().GetClusterCerts(cluster.ClusterId)
+			if err != nil {
+				return fmt.Errorf("GetClusterCerts got an error: %#v", err)
+			}
+			mapping["kube_config"] = kubeConfig
+			mapping["cluster_ca_cert"] = certs.CA
+		}
+
+		ids = append(ids, cluster.ClusterId)
+		names = append(names, cluster.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("clusters", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
+
+// splitKubernetesVSwitchIds returns the VSwitches of a cluster, which are separated by commas when the masters are
+// spread over several zones.
+func splitKubernetesVSwitchIds(vswitchId string) (ids []string) {
+	for _, id := range strings.Split(vswitchId, COMMA_SEPARATED) {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
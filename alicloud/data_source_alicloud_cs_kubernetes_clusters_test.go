@@ -0,0 +1,93 @@
+package alicloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudCSKubernetesClustersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudCSKubernetesClustersDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_cs_kubernetes_clusters.default"),
+					resource.TestCheckResourceAttr("data.alicloud_cs_kubernetes_clusters.default", "clusters.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_cs_kubernetes_clusters.default", "clusters.0.name", "tf-testAccCSKubernetesClustersDataSource"),
+					resource.TestCheckResourceAttr("data.alicloud_cs_kubernetes_clusters.default", "clusters.0.cluster_type", "Kubernetes"),
+					resource.TestCheckResourceAttr("data.alicloud_cs_kubernetes_clusters.default", "clusters.0.vswitch_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_cs_kubernetes_clusters.default", "clusters.0.nodes.#", "4"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_clusters.default", "clusters.0.version"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_clusters.default", "clusters.0.connections.api_server_intranet"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_clusters.default", "clusters.0.kube_config"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_clusters.default", "clusters.0.cluster_ca_cert"),
+				),
+			},
+		},
+	})
+}
+
+func TestSplitKubernetesVSwitchIds(t *testing.T) {
+	cases := map[string][]string{
+		"":                   nil,
+		"vsw-a":              {"vsw-a"},
+		"vsw-a,vsw-b, vsw-c": {"vsw-a", "vsw-b", "vsw-c"},
+		"vsw-a,,vsw-b,":      {"vsw-a", "vsw-b"},
+	}
+	for vswitchId, expected := range cases {
+		if got := splitKubernetesVSwitchIds(vswitchId); !reflect.DeepEqual(got, expected) {
+			t.Errorf("splitKubernetesVSwitchIds(%q) = %#v, expected %#v", vswitchId, got, expected)
+		}
+	}
+}
+
+const testAccCheckAlicloudCSKubernetesClustersDataSourceConfig = `
+provider "alicloud" {
+  region = "cn-shanghai"
+}
+
+data "alicloud_zones" "main" {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+  cpu_core_count    = 1
+  memory_size       = 2
+}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccCSKubernetesClustersDataSource"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+}
+
+resource "alicloud_cs_kubernetes" "k8s" {
+  name                 = "tf-testAccCSKubernetesClustersDataSource"
+  vswitch_id           = "${alicloud_vswitch.foo.id}"
+  new_nat_gateway      = true
+  master_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_number        = 1
+  password             = "Test12345"
+  pod_cidr             = "192.168.1.0/24"
+  service_cidr         = "192.168.2.0/24"
+}
+
+data "alicloud_cs_kubernetes_clusters" "default" {
+  ids                = ["${alicloud_cs_kubernetes.k8s.id}"]
+  enable_details     = true
+  enable_kube_config = true
+}
+`
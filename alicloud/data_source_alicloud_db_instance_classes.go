@@ -0,0 +1,164 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDBInstanceClasses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDBInstanceClassesRead,
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(MySQL),
+					string(SQLServer),
+					string(PPAS),
+					string(PostgreSQL),
+				}),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      Postpaid,
+				ValidateFunc: validateAllowedStringValue([]string{string(Postpaid), string(Prepaid)}),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"storage_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_classes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"storage_range": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDBInstanceClassesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	resources, err := client.DescribeRdsAvailableResources(d.Get("engine").(string), d.Get("engine_version").(string),
+		d.Get("instance_charge_type").(string), d.Get("zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// An instance class is returned once for every zone, version and storage type which supports it.
+	zoneIds := make(map[string]map[string]bool)
+	storageRanges := make(map[string]RdsStorageRange)
+	for _, res := range resources {
+		if v, ok := d.GetOk("category"); ok && res.Category != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("storage_type"); ok && res.StorageType != v.(string) {
+			continue
+		}
+		if _, ok := storageRanges[res.DBInstanceClass]; !ok {
+			storageRanges[res.DBInstanceClass] = res.StorageRange
+		}
+		if zoneIds[res.DBInstanceClass] == nil {
+			zoneIds[res.DBInstanceClass] = make(map[string]bool)
+		}
+		zoneIds[res.DBInstanceClass][res.ZoneId] = true
+	}
+
+	if len(storageRanges) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	var ids []string
+	for class := range storageRanges {
+		ids = append(ids, class)
+	}
+	sort.Strings(ids)
+
+	log.Printf("[DEBUG] alicloud_db_instance_classes - Instance classes found: %#v", ids)
+
+	var s []map[string]interface{}
+	for _, class := range ids {
+		var zones []string
+		for zone := range zoneIds[class] {
+			zones = append(zones, zone)
+		}
+		sort.Strings(zones)
+		storageRange := storageRanges[class]
+		mapping := map[string]interface{}{
+			"instance_class": class,
+			"zone_ids":       zones,
+			"storage_range": map[string]interface{}{
+				"min":  fmt.Sprint(storageRange.Min),
+				"max":  fmt.Sprint(storageRange.Max),
+				"step": fmt.Sprint(storageRange.Step),
+			},
+		}
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("instance_classes", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
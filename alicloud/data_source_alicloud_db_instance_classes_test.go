@@ -0,0 +1,95 @@
+package alicloud
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudDBInstanceClassesDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudDBInstanceClasses().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudDBInstanceClassesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBInstanceClassesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_instance_classes.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_classes.default", "ids.#"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_classes.default", "instance_classes.0.instance_class"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_classes.default", "instance_classes.0.storage_range.max"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_classes.default", "instance_classes.0.zone_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestDBInstanceClassesRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, RdsCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if action := r.FormValue("Action"); action != "DescribeAvailableResource" {
+			t.Errorf("Unexpected action %s", action)
+		}
+		if engine := r.FormValue("Engine"); engine != "MySQL" {
+			t.Errorf("Expected the engine MySQL, got %q", engine)
+		}
+		zone := func(zoneId string) string {
+			return `{"ZoneId":"` + zoneId + `","SupportedEngines":{"SupportedEngine":[{"Engine":"MySQL",` +
+				`"SupportedEngineVersions":{"SupportedEngineVersion":[{"Version":"5.6","SupportedCategorys":{"SupportedCategory":[` +
+				`{"Category":"HighAvailability","SupportedStorageTypes":{"SupportedStorageType":[{"StorageType":"local_ssd",` +
+				`"AvailableResources":{"AvailableResource":[{"DBInstanceClass":"rds.mysql.s2.large","StorageRange":{"Min":5,"Max":1000,"Step":5}}]}}]}},` +
+				`{"Category":"Basic","SupportedStorageTypes":{"SupportedStorageType":[{"StorageType":"cloud_ssd",` +
+				`"AvailableResources":{"AvailableResource":[{"DBInstanceClass":"mysql.n1.micro.1","StorageRange":{"Min":20,"Max":2000,"Step":5}}]}}]}}]}}]}}]}}`
+		}
+		w.Write([]byte(`{"RequestId":"A1B2C3D4","AvailableZones":{"AvailableZone":[` + zone("cn-hangzhou-h") + `,` + zone("cn-hangzhou-b") + `]}}`))
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudDBInstanceClasses().Schema, map[string]interface{}{
+		"engine":   "MySQL",
+		"category": "HighAvailability",
+	})
+	if err := dataSourceAlicloudDBInstanceClassesRead(d, client); err != nil {
+		t.Fatalf("Reading the instance classes got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"ids.#":                                 "1",
+		"ids.0":                                 "rds.mysql.s2.large",
+		"instance_classes.#":                    "1",
+		"instance_classes.0.instance_class":     "rds.mysql.s2.large",
+		"instance_classes.0.zone_ids.#":         "2",
+		"instance_classes.0.zone_ids.0":         "cn-hangzhou-b",
+		"instance_classes.0.zone_ids.1":         "cn-hangzhou-h",
+		"instance_classes.0.storage_range.%":    "3",
+		"instance_classes.0.storage_range.min":  "5",
+		"instance_classes.0.storage_range.max":  "1000",
+		"instance_classes.0.storage_range.step": "5",
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+const testAccCheckAlicloudDBInstanceClassesDataSourceConfig = `
+data "alicloud_db_instance_classes" "default" {
+  engine               = "MySQL"
+  engine_version       = "5.6"
+  instance_charge_type = "Postpaid"
+  category             = "HighAvailability"
+}
+`
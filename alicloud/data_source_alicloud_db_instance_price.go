@@ -0,0 +1,116 @@
+package alicloud
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDBInstancePrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDBInstancePriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(MySQL), string(SQLServer), string(PostgreSQL), string(PPAS)}),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_storage": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      Postpaid,
+				ValidateFunc: validateAllowedStringValue([]string{string(Postpaid), string(Prepaid)}),
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+			},
+			"amount": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 100),
+			},
+
+			// Computed values
+			"original_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"discount_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"trade_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"monthly_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"currency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDBInstancePriceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	prepaid := d.Get("instance_charge_type").(string) == string(Prepaid)
+	params := map[string]string{
+		"CommodityCode":     "bards",
+		"OrderType":         "BUY",
+		"Engine":            d.Get("engine").(string),
+		"EngineVersion":     d.Get("engine_version").(string),
+		"DBInstanceClass":   d.Get("instance_type").(string),
+		"DBInstanceStorage": strconv.Itoa(d.Get("instance_storage").(int)),
+		"PayType":           d.Get("instance_charge_type").(string),
+		"Quantity":          strconv.Itoa(d.Get("amount").(int)),
+	}
+	if v := d.Get("zone_id").(string); v != "" {
+		params["ZoneId"] = v
+	}
+	period := d.Get("period").(int)
+	if prepaid {
+		params["CommodityCode"] = "rds"
+		params["UsedTime"], params["TimeType"] = prepaidPeriod(period)
+	}
+
+	price, err := client.DescribeRdsPrice(params)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] alicloud_db_instance_price - Price found: %#v", price)
+
+	d.SetId(dataResourceIdHash([]string{params["Engine"], params["EngineVersion"], params["DBInstanceClass"], params["PayType"]}))
+	d.Set("original_price", price.OriginalPrice)
+	d.Set("discount_price", price.DiscountPrice)
+	d.Set("trade_price", price.TradePrice)
+	d.Set("currency", price.Currency)
+	d.Set("monthly_price", estimateMonthlyPrice(price.TradePrice, prepaid, period, "Month"))
+	return nil
+}
@@ -0,0 +1,96 @@
+package alicloud
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudDBInstancePriceDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudDBInstancePrice().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudDBInstancePriceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBInstancePriceDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_instance_price.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_price.default", "trade_price"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_price.default", "monthly_price"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_price.default", "currency"),
+				),
+			},
+		},
+	})
+}
+
+func TestDBInstancePriceRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, RdsCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if action := r.FormValue("Action"); action != "DescribePrice" {
+			t.Errorf("Unexpected action %s", action)
+		}
+		expected := map[string]string{
+			"CommodityCode":     "rds",
+			"OrderType":         "BUY",
+			"Engine":            "MySQL",
+			"EngineVersion":     "5.7",
+			"DBInstanceClass":   "rds.mysql.s2.large",
+			"DBInstanceStorage": "50",
+			"PayType":           string(Prepaid),
+			"Quantity":          "2",
+			"UsedTime":          "1",
+			"TimeType":          string(Year),
+		}
+		for key, value := range expected {
+			if got := r.FormValue(key); got != value {
+				t.Errorf("Expected the parameter %s is %s, got %s", key, value, got)
+			}
+		}
+		w.Write([]byte(`{"RequestId":"A1B2C3D4","PriceInfo":{"OriginalPrice":4000,"DiscountPrice":1600,"TradePrice":2400,"Currency":"CNY"}}`))
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudDBInstancePrice().Schema, map[string]interface{}{
+		"engine":               "MySQL",
+		"engine_version":       "5.7",
+		"instance_type":        "rds.mysql.s2.large",
+		"instance_storage":     50,
+		"instance_charge_type": string(Prepaid),
+		"period":               12,
+		"amount":               2,
+	})
+	if err := dataSourceAlicloudDBInstancePriceRead(d, client); err != nil {
+		t.Fatalf("Reading the price got an error: %#v", err)
+	}
+	if d.Get("trade_price").(float64) != 2400 || d.Get("original_price").(float64) != 4000 || d.Get("currency").(string) != "CNY" {
+		t.Fatalf("Expected the price is read from the PriceInfo, got %v, %v and %v", d.Get("trade_price"), d.Get("original_price"), d.Get("currency"))
+	}
+	if price := d.Get("monthly_price").(float64); price != 200 {
+		t.Fatalf("Expected the yearly price is 200 per month, got %v", price)
+	}
+}
+
+const testAccCheckAlicloudDBInstancePriceDataSourceConfig = `
+data "alicloud_db_instance_classes" "default" {
+  engine         = "MySQL"
+  engine_version = "5.6"
+}
+
+data "alicloud_db_instance_price" "default" {
+  engine           = "MySQL"
+  engine_version   = "5.6"
+  instance_type    = "${data.alicloud_db_instance_classes.default.instance_classes.0.instance_class}"
+  instance_storage = "${data.alicloud_db_instance_classes.default.instance_classes.0.storage_range.min}"
+}
+`
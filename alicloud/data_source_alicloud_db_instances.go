@@ -0,0 +1,280 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDBInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDBInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids": idsSchema(),
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(MySQL),
+					string(SQLServer),
+					string(PPAS),
+					string(PostgreSQL),
+				}),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"db_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Primary", "Readonly", "Guard", "Temp"}),
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"connection_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Standard", "Safe"}),
+			},
+			"tags":  tagsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"charge_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expire_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"net_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connection_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"master_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"guard_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"temp_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"readonly_instance_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDBInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	conn := client.rdsconn
+
+	args := rds.CreateDescribeDBInstancesRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.Engine = d.Get("engine").(string)
+	args.DBInstanceStatus = d.Get("status").(string)
+	args.DBInstanceType = d.Get("db_type").(string)
+	args.VpcId = d.Get("vpc_id").(string)
+	args.VSwitchId = d.Get("vswitch_id").(string)
+	args.ConnectionMode = d.Get("connection_mode").(string)
+	args.PageSize = requests.NewInteger(PageSizeLarge)
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		tags, err := json.Marshal(v.(map[string]interface{}))
+		if err != nil {
+			return fmt.Errorf("Marshalling tags got an error: %#v", err)
+		}
+		args.Tags = string(tags)
+	}
+
+	var allInstances []rds.DBInstance
+	for pageNumber := 1; ; pageNumber++ {
+		args.PageNumber = requests.NewInteger(pageNumber)
+		resp := rds.CreateDescribeDBInstancesResponse()
+		err := client.doAction(conn, args, resp)
+		if err != nil {
+			return fmt.Errorf("DescribeDBInstances got an error: %#v", err)
+		}
+		if resp == nil || len(resp.Items.DBInstance) < 1 {
+			break
+		}
+		allInstances = append(allInstances, resp.Items.DBInstance...)
+		if len(resp.Items.DBInstance) < PageSizeLarge {
+			break
+		}
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredInstances []rds.DBInstance
+	for _, instance := range allInstances {
+		if nameRegex != nil && !nameRegex.MatchString(instance.DBInstanceDescription) {
+			continue
+		}
+		if idsMap != nil && !idsMap[instance.DBInstanceId] {
+			continue
+		}
+		filteredInstances = append(filteredInstances, instance)
+	}
+
+	if len(filteredInstances) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_db_instances - DB instances found: %#v", filteredInstances)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, instance := range filteredInstances {
+		var readonlyIds []string
+		for _, readonly := range instance.ReadOnlyDBInstanceIds.ReadOnlyDBInstanceId {
+			readonlyIds = append(readonlyIds, readonly.DBInstanceId)
+		}
+		mapping := map[string]interface{}{
+			"id":                    instance.DBInstanceId,
+			"name":                  instance.DBInstanceDescription,
+			"charge_type":           instance.PayType,
+			"db_type":               instance.DBInstanceType,
+			"region_id":             instance.RegionId,
+			"create_time":           instance.CreateTime,
+			"expire_time":           instance.ExpireTime,
+			"status":                instance.DBInstanceStatus,
+			"engine":                instance.Engine,
+			"engine_version":        instance.EngineVersion,
+			"net_type":              instance.DBInstanceNetType,
+			"connection_mode":       instance.ConnectionMode,
+			"instance_type":         instance.DBInstanceClass,
+			"availability_zone":     instance.ZoneId,
+			"master_instance_id":    instance.MasterInstanceId,
+			"guard_instance_id":     instance.GuardDBInstanceId,
+			"temp_instance_id":      instance.TempDBInstanceId,
+			"readonly_instance_ids": readonlyIds,
+			"vpc_id":                instance.VpcId,
+			"vswitch_id":            instance.VSwitchId,
+		}
+		ids = append(ids, instance.DBInstanceId)
+		names = append(names, instance.DBInstanceDescription)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("instances", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
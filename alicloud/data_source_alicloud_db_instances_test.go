@@ -0,0 +1,67 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDBInstancesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBInstancesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_instances.default"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instances.default", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instances.default", "instances.0.name", "tf-testAccDBInstancesDataSource"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instances.default", "instances.0.engine", "MySQL"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instances.default", "instances.0.engine_version", "5.6"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instances.default", "instances.0.instance_type", "rds.mysql.t1.small"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instances.default", "instances.0.db_type", "Primary"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instances.default", "instances.0.charge_type", "Postpaid"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instances.default", "instances.0.vpc_id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instances.default", "instances.0.availability_zone"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudDBInstancesDataSourceConfig = `
+data "alicloud_db_zones" "default" {
+  engine         = "MySQL"
+  engine_version = "5.6"
+}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccDBInstancesDataSource"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_db_zones.default.zones.0.id}"
+}
+
+resource "alicloud_db_instance" "foo" {
+  engine               = "MySQL"
+  engine_version       = "5.6"
+  instance_type        = "rds.mysql.t1.small"
+  instance_storage     = "10"
+  instance_charge_type = "Postpaid"
+  instance_name        = "tf-testAccDBInstancesDataSource"
+  vswitch_id           = "${alicloud_vswitch.foo.id}"
+}
+
+data "alicloud_db_instances" "default" {
+  name_regex = "${alicloud_db_instance.foo.instance_name}"
+  engine     = "MySQL"
+  vpc_id     = "${alicloud_vpc.foo.id}"
+}
+`
@@ -0,0 +1,154 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDBZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDBZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(MySQL),
+					string(SQLServer),
+					string(PPAS),
+					string(PostgreSQL),
+				}),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      Postpaid,
+				ValidateFunc: validateAllowedStringValue([]string{string(Postpaid), string(Prepaid)}),
+			},
+			"multi": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"multi_zone_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDBZonesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	resources, err := client.DescribeRdsAvailableResources(d.Get("engine").(string), d.Get("engine_version").(string),
+		d.Get("instance_charge_type").(string), "")
+	if err != nil {
+		return err
+	}
+
+	multi := d.Get("multi").(bool)
+	zones := make(map[string]bool)
+	for _, res := range resources {
+		if isRdsMultiZone(res.ZoneId) != multi {
+			continue
+		}
+		zones[res.ZoneId] = true
+	}
+
+	if len(zones) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	var ids []string
+	for zone := range zones {
+		ids = append(ids, zone)
+	}
+	sort.Strings(ids)
+
+	log.Printf("[DEBUG] alicloud_db_zones - Zones found: %#v", ids)
+
+	var s []map[string]interface{}
+	for _, id := range ids {
+		mapping := map[string]interface{}{
+			"id":             id,
+			"multi_zone_ids": splitRdsMultiZoneId(id),
+		}
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("zones", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
+
+// isRdsMultiZone returns whether the zone is a multi-zone of RDS, like cn-hangzhou-MAZ5(b,c).
+func isRdsMultiZone(zoneId string) bool {
+	return strings.Contains(zoneId, "MAZ")
+}
+
+// splitRdsMultiZoneId returns the zones covered by a multi-zone, for example cn-hangzhou-MAZ5(b,c) covers
+// cn-hangzhou-b and cn-hangzhou-c. It returns nil for a single zone.
+func splitRdsMultiZoneId(zoneId string) (zoneIds []string) {
+	if !isRdsMultiZone(zoneId) {
+		return nil
+	}
+	start := strings.Index(zoneId, "(")
+	end := strings.Index(zoneId, ")")
+	prefix := strings.Index(zoneId, "MAZ")
+	if start < 0 || end < start || prefix < 0 {
+		return nil
+	}
+	for _, suffix := range strings.Split(zoneId[start+1:end], ",") {
+		zoneIds = append(zoneIds, zoneId[:prefix]+strings.TrimSpace(suffix))
+	}
+	return zoneIds
+}
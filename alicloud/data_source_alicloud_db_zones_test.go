@@ -0,0 +1,58 @@
+package alicloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDBZonesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBZonesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_zones.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_zones.default", "zones.#"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_zones.default", "zones.0.id"),
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_instance_classes.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_classes.default", "instance_classes.0.instance_class"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_instance_classes.default", "instance_classes.0.storage_range.min"),
+					resource.TestCheckResourceAttr("data.alicloud_db_instance_classes.default", "instance_classes.0.zone_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestSplitRdsMultiZoneId(t *testing.T) {
+	cases := map[string][]string{
+		"cn-hangzhou-b":           nil,
+		"cn-hangzhou-MAZ5(b,c)":   {"cn-hangzhou-b", "cn-hangzhou-c"},
+		"cn-beijing-MAZ1(a, c)":   {"cn-beijing-a", "cn-beijing-c"},
+		"cn-shanghai-MAZ2(b,c,d)": {"cn-shanghai-b", "cn-shanghai-c", "cn-shanghai-d"},
+	}
+	for zoneId, expected := range cases {
+		if got := splitRdsMultiZoneId(zoneId); !reflect.DeepEqual(got, expected) {
+			t.Errorf("splitRdsMultiZoneId(%q) = %#v, expected %#v", zoneId, got, expected)
+		}
+	}
+}
+
+const testAccCheckAlicloudDBZonesDataSourceConfig = `
+data "alicloud_db_zones" "default" {
+  engine         = "MySQL"
+  engine_version = "5.6"
+}
+
+data "alicloud_db_instance_classes" "default" {
+  engine         = "MySQL"
+  engine_version = "5.6"
+  zone_id        = "${data.alicloud_db_zones.default.zones.0.id}"
+}
+`
@@ -39,10 +39,13 @@ func dataSourceAlicloudDnsDomains() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"domains": {
@@ -96,7 +99,7 @@ func dataSourceAlicloudDnsDomains() *schema.Resource {
 	}
 }
 func dataSourceAlicloudDnsDomainsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainsArgs{}
 
@@ -116,9 +119,14 @@ func dataSourceAlicloudDnsDomainsRead(d *schema.ResourceData, meta interface{})
 		pagination.PageNumber += 1
 	}
 
+	idsMap := idsFilter(d)
 	var filteredDomains []dns.DomainType
 
 	for _, domain := range allDomains {
+		if idsMap != nil && !idsMap[domain.DomainId] {
+			continue
+		}
+
 		if v, ok := d.GetOk("ali_domain"); ok && domain.AliDomain != v.(bool) {
 			continue
 		}
@@ -159,6 +167,7 @@ func dataSourceAlicloudDnsDomainsRead(d *schema.ResourceData, meta interface{})
 
 func domainsDecriptionAttributes(d *schema.ResourceData, domainTypes []dns.DomainType, meta interface{}) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 	for _, domain := range domainTypes {
 		mapping := map[string]interface{}{
@@ -174,6 +183,7 @@ func domainsDecriptionAttributes(d *schema.ResourceData, domainTypes []dns.Domai
 		}
 		log.Printf("[DEBUG] alicloud_dns_domains - adding domain: %v", mapping)
 		ids = append(ids, domain.DomainId)
+		names = append(names, domain.DomainName)
 		s = append(s, mapping)
 	}
 
@@ -181,10 +191,16 @@ func domainsDecriptionAttributes(d *schema.ResourceData, domainTypes []dns.Domai
 	if err := d.Set("domains", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -19,10 +19,13 @@ func dataSourceAlicloudDnsGroups() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"groups": {
@@ -46,7 +49,7 @@ func dataSourceAlicloudDnsGroups() *schema.Resource {
 }
 
 func dataSourceAlicloudDnsGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainGroupsArgs{}
 
@@ -66,17 +69,21 @@ func dataSourceAlicloudDnsGroupsRead(d *schema.ResourceData, meta interface{}) e
 		pagination.PageNumber += 1
 	}
 
-	var filteredGroups []dns.DomainGroupType
+	var r *regexp.Regexp
 	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
-		r := regexp.MustCompile(v.(string))
+		r = regexp.MustCompile(v.(string))
+	}
+	idsMap := idsFilter(d)
 
-		for _, group := range allGroups {
-			if r.MatchString(group.GroupName) {
-				filteredGroups = append(filteredGroups, group)
-			}
+	var filteredGroups []dns.DomainGroupType
+	for _, group := range allGroups {
+		if r != nil && !r.MatchString(group.GroupName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[group.GroupId] {
+			continue
 		}
-	} else {
-		filteredGroups = allGroups[:]
+		filteredGroups = append(filteredGroups, group)
 	}
 
 	if len(filteredGroups) < 1 {
@@ -90,6 +97,7 @@ func dataSourceAlicloudDnsGroupsRead(d *schema.ResourceData, meta interface{}) e
 
 func groupsDecriptionAttributes(d *schema.ResourceData, groupTypes []dns.DomainGroupType, meta interface{}) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 	for _, group := range groupTypes {
 		mapping := map[string]interface{}{
@@ -98,6 +106,7 @@ func groupsDecriptionAttributes(d *schema.ResourceData, groupTypes []dns.DomainG
 		}
 		log.Printf("[DEBUG] alicloud_dns_groups - adding group: %v", mapping)
 		ids = append(ids, group.GroupId)
+		names = append(names, group.GroupName)
 		s = append(s, mapping)
 	}
 
@@ -105,10 +114,16 @@ func groupsDecriptionAttributes(d *schema.ResourceData, groupTypes []dns.DomainG
 	if err := d.Set("groups", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -59,10 +59,12 @@ func dataSourceAlicloudDnsRecords() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"ids": idsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"records": {
@@ -118,7 +120,7 @@ func dataSourceAlicloudDnsRecords() *schema.Resource {
 }
 
 func dataSourceAlicloudDnsRecordsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainRecordsNewArgs{
 		DomainName: d.Get("domain_name").(string),
@@ -145,9 +147,13 @@ func dataSourceAlicloudDnsRecordsRead(d *schema.ResourceData, meta interface{})
 		pagination.PageNumber += 1
 	}
 
+	idsMap := idsFilter(d)
 	var filteredRecords []dns.RecordTypeNew
 
 	for _, record := range allRecords {
+		if idsMap != nil && !idsMap[record.RecordId] {
+			continue
+		}
 		if v, ok := d.GetOk("line"); ok && v.(string) != "" && strings.ToUpper(record.Line) != strings.ToUpper(v.(string)) {
 			continue
 		}
@@ -210,10 +216,13 @@ func recordsDecriptionAttributes(d *schema.ResourceData, recordTypes []dns.Recor
 	if err := d.Set("records", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
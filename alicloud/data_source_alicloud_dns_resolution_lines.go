@@ -0,0 +1,136 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDnsResolutionLines() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDnsResolutionLinesRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"lang": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"en", "zh"}),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNameRegex,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"lines": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"line_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"line_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"line_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"father_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDnsResolutionLinesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	lines, err := client.DescribeDnsSupportLines(d.Get("domain_name").(string), d.Get("lang").(string))
+	if err != nil {
+		return err
+	}
+
+	var r *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		r = regexp.MustCompile(v.(string))
+	}
+	idsMap := idsFilter(d)
+
+	var filteredLines []DnsRecordLine
+	for _, line := range lines {
+		if r != nil && !r.MatchString(line.LineDisplayName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[line.LineCode] {
+			continue
+		}
+		filteredLines = append(filteredLines, line)
+	}
+
+	if len(filteredLines) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_dns_resolution_lines - Lines found: %#v", filteredLines)
+
+	return dnsResolutionLinesDescriptionAttributes(d, filteredLines)
+}
+
+func dnsResolutionLinesDescriptionAttributes(d *schema.ResourceData, lines []DnsRecordLine) error {
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, line := range lines {
+		mapping := map[string]interface{}{
+			"line_code":         line.LineCode,
+			"line_name":         line.LineName,
+			"line_display_name": line.LineDisplayName,
+			"father_code":       line.FatherCode,
+		}
+		ids = append(ids, line.LineCode)
+		names = append(names, line.LineDisplayName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("lines", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDnsResolutionLinesDataSource_ids(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDnsResolutionLinesDataSourceIdsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_dns_resolution_lines.lines"),
+					resource.TestCheckResourceAttr("data.alicloud_dns_resolution_lines.lines", "lines.#", "2"),
+					resource.TestCheckResourceAttr("data.alicloud_dns_resolution_lines.lines", "ids.#", "2"),
+					resource.TestCheckResourceAttrSet("data.alicloud_dns_resolution_lines.lines", "lines.0.line_display_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudDnsResolutionLinesDataSourceIdsConfig = `
+data "alicloud_dns_resolution_lines" "lines" {
+  ids  = ["default", "telecom"]
+  lang = "en"
+}`
@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
@@ -14,12 +15,12 @@ func dataSourceAlicloudEips() *schema.Resource {
 		Read: dataSourceAlicloudEipsRead,
 
 		Schema: map[string]*schema.Schema{
-			"ids": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				ForceNew: true,
-				MinItems: 1,
+			"ids": idsSchema(),
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
 			},
 			"in_use": {
 				Type:       schema.TypeBool,
@@ -33,10 +34,12 @@ func dataSourceAlicloudEips() *schema.Resource {
 				ForceNew: true,
 				MinItems: 1,
 			},
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"eips": {
@@ -48,6 +51,10 @@ func dataSourceAlicloudEips() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"ip_address": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -83,19 +90,15 @@ func dataSourceAlicloudEips() *schema.Resource {
 	}
 }
 func dataSourceAlicloudEipsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
 
 	args := vpc.CreateDescribeEipAddressesRequest()
 	args.RegionId = string(getRegion(d, meta))
 	args.PageSize = requests.NewInteger(PageSizeLarge)
 
-	idsMap := make(map[string]string)
+	idsMap := idsFilter(d)
 	ipsMap := make(map[string]string)
-	if v, ok := d.GetOk("ids"); ok {
-		for _, vv := range v.([]interface{}) {
-			idsMap[Trim(vv.(string))] = Trim(vv.(string))
-		}
-	}
 	if v, ok := d.GetOk("ip_addresses"); ok && len(v.([]interface{})) > 0 {
 		for _, vv := range v.([]interface{}) {
 			if vv == nil {
@@ -105,10 +108,18 @@ func dataSourceAlicloudEipsRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+
 	var allEips []vpc.EipAddress
 
 	for {
-		resp, err := conn.DescribeEipAddresses(args)
+		resp := vpc.CreateDescribeEipAddressesResponse()
+		err := client.doAction(conn, args, resp)
 		if err != nil {
 			return err
 		}
@@ -118,10 +129,11 @@ func dataSourceAlicloudEipsRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		for _, e := range resp.EipAddresses.EipAddress {
-			if len(idsMap) > 0 {
-				if _, ok := idsMap[e.AllocationId]; !ok {
-					continue
-				}
+			if idsMap != nil && !idsMap[e.AllocationId] {
+				continue
+			}
+			if nameRegex != nil && !nameRegex.MatchString(e.Name) {
+				continue
 			}
 			if len(ipsMap) > 0 {
 				if _, ok := ipsMap[e.IpAddress]; !ok {
@@ -149,10 +161,12 @@ func dataSourceAlicloudEipsRead(d *schema.ResourceData, meta interface{}) error
 
 func eipsDecriptionAttributes(d *schema.ResourceData, eipSetTypes []vpc.EipAddress, meta interface{}) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 	for _, eip := range eipSetTypes {
 		mapping := map[string]interface{}{
 			"id":                   eip.AllocationId,
+			"name":                 eip.Name,
 			"status":               eip.Status,
 			"ip_address":           eip.IpAddress,
 			"bandwidth":            eip.Bandwidth,
@@ -163,6 +177,7 @@ func eipsDecriptionAttributes(d *schema.ResourceData, eipSetTypes []vpc.EipAddre
 		}
 		log.Printf("[DEBUG] alicloud_eip - adding eip: %v", mapping)
 		ids = append(ids, eip.AllocationId)
+		names = append(names, eip.Name)
 		s = append(s, mapping)
 	}
 
@@ -170,10 +185,16 @@ func eipsDecriptionAttributes(d *schema.ResourceData, eipSetTypes []vpc.EipAddre
 	if err := d.Set("eips", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -0,0 +1,171 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudFcCustomDomains() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudFcCustomDomainsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids": idsSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cert_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"service_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"function_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"qualifier": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modification_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudFcCustomDomainsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allDomains, err := client.DescribeFcCustomDomains()
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredDomains []FcCustomDomain
+	for _, domain := range allDomains {
+		if nameRegex != nil && !nameRegex.MatchString(domain.DomainName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[domain.DomainName] {
+			continue
+		}
+		filteredDomains = append(filteredDomains, domain)
+	}
+
+	if len(filteredDomains) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_fc_custom_domains - Custom domains found: %#v", filteredDomains)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, domain := range filteredDomains {
+		var routes []map[string]interface{}
+		for _, route := range domain.RouteConfig.Routes {
+			routes = append(routes, map[string]interface{}{
+				"path":          route.Path,
+				"service_name":  route.ServiceName,
+				"function_name": route.FunctionName,
+				"qualifier":     route.Qualifier,
+			})
+		}
+		mapping := map[string]interface{}{
+			"id":                     domain.DomainName,
+			"domain_name":            domain.DomainName,
+			"account_id":             domain.AccountId,
+			"protocol":               domain.Protocol,
+			"api_version":            domain.ApiVersion,
+			"cert_name":              domain.CertConfig.CertName,
+			"route_config":           routes,
+			"creation_time":          domain.CreatedTime,
+			"last_modification_time": domain.LastModifiedTime,
+		}
+		ids = append(ids, domain.DomainName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("domains", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
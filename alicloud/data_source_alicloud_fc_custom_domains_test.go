@@ -0,0 +1,78 @@
+package alicloud
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudFcCustomDomainsDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudFcCustomDomains().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudFcCustomDomainsDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudFcCustomDomainsDataSourceEmpty,
+				ExpectError: regexp.MustCompile("Your query returned no results"),
+			},
+		},
+	})
+}
+
+func TestFcCustomDomainsRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, FcCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/"+FcApiVersion+"/custom-domains" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"customDomains":[{"domainName":"tf-test.example.com","accountId":"123","protocol":"HTTP,HTTPS",` +
+			`"apiVersion":"2016-08-15","certConfig":{"certName":"tf-test-cert"},"routeConfig":{"routes":[` +
+			`{"path":"/login/*","serviceName":"tf-test","functionName":"hello","qualifier":"LATEST"}]}},` +
+			`{"domainName":"other.example.com","accountId":"123","protocol":"HTTP"}]}`))
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudFcCustomDomains().Schema, map[string]interface{}{
+		"ids": []interface{}{"tf-test.example.com"},
+	})
+	if err := dataSourceAlicloudFcCustomDomainsRead(d, client); err != nil {
+		t.Fatalf("Reading the custom domains got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"ids.#":                                  "1",
+		"domains.#":                              "1",
+		"domains.0.id":                           "tf-test.example.com",
+		"domains.0.account_id":                   "123",
+		"domains.0.protocol":                     "HTTP,HTTPS",
+		"domains.0.cert_name":                    "tf-test-cert",
+		"domains.0.route_config.#":               "1",
+		"domains.0.route_config.0.path":          "/login/*",
+		"domains.0.route_config.0.service_name":  "tf-test",
+		"domains.0.route_config.0.function_name": "hello",
+		"domains.0.route_config.0.qualifier":     "LATEST",
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+const testAccCheckAlicloudFcCustomDomainsDataSourceEmpty = `
+data "alicloud_fc_custom_domains" "default" {
+  name_regex = "^tf-testAccFcCustomDomainsDataSource-none$"
+}
+`
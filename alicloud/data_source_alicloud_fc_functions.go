@@ -0,0 +1,168 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudFcFunctions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudFcFunctionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"functions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"runtime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"handler": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"code_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"code_checksum": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_variables": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modification_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudFcFunctionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allFunctions, err := client.DescribeFcFunctions(d.Get("service_name").(string))
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredFunctions []FcFunction
+	for _, function := range allFunctions {
+		if nameRegex != nil && !nameRegex.MatchString(function.FunctionName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[function.FunctionId] {
+			continue
+		}
+		filteredFunctions = append(filteredFunctions, function)
+	}
+
+	if len(filteredFunctions) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_fc_functions - Functions found: %#v", filteredFunctions)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, function := range filteredFunctions {
+		mapping := map[string]interface{}{
+			"id":                     function.FunctionId,
+			"name":                   function.FunctionName,
+			"description":            function.Description,
+			"runtime":                function.Runtime,
+			"handler":                function.Handler,
+			"timeout":                function.Timeout,
+			"memory_size":            function.MemorySize,
+			"code_size":              int(function.CodeSize),
+			"code_checksum":          function.CodeChecksum,
+			"environment_variables":  function.EnvironmentVariables,
+			"creation_time":          function.CreatedTime,
+			"last_modification_time": function.LastModifiedTime,
+		}
+		ids = append(ids, function.FunctionId)
+		names = append(names, function.FunctionName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("functions", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
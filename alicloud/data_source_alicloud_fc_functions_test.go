@@ -0,0 +1,87 @@
+package alicloud
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudFcFunctionsDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudFcFunctions().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudFcFunctionsDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudFcFunctionsDataSourceNotFound,
+				ExpectError: regexp.MustCompile("GET /services/tf-testAccFcFunctionsDataSource-none/functions got an error"),
+			},
+		},
+	})
+}
+
+func TestFcFunctionsRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, FcCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/"+FcApiVersion+"/services/tf-test/functions" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		// The functions are listed in two pages.
+		switch r.URL.Query().Get("nextToken") {
+		case "":
+			w.Write([]byte(`{"functions":[{"functionId":"f-1","functionName":"tf-test-hello","runtime":"python3",` +
+				`"handler":"index.handler","timeout":60,"memorySize":128,"codeSize":1024,"codeChecksum":"123",` +
+				`"environmentVariables":{"ENV":"test"}}],"nextToken":"tf-test-other"}`))
+		default:
+			w.Write([]byte(`{"functions":[{"functionId":"f-2","functionName":"tf-test-world","runtime":"nodejs12"},` +
+				`{"functionId":"f-3","functionName":"other","runtime":"nodejs12"}]}`))
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudFcFunctions().Schema, map[string]interface{}{
+		"service_name": "tf-test",
+		"name_regex":   "^tf-test-",
+	})
+	if err := dataSourceAlicloudFcFunctionsRead(d, client); err != nil {
+		t.Fatalf("Reading the functions got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"ids.#":                                 "2",
+		"names.#":                               "2",
+		"functions.#":                           "2",
+		"functions.0.id":                        "f-1",
+		"functions.0.name":                      "tf-test-hello",
+		"functions.0.runtime":                   "python3",
+		"functions.0.handler":                   "index.handler",
+		"functions.0.timeout":                   "60",
+		"functions.0.memory_size":               "128",
+		"functions.0.code_size":                 "1024",
+		"functions.0.environment_variables.ENV": "test",
+		"functions.1.id":                        "f-2",
+		"functions.1.name":                      "tf-test-world",
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+const testAccCheckAlicloudFcFunctionsDataSourceNotFound = `
+data "alicloud_fc_functions" "default" {
+  service_name = "tf-testAccFcFunctionsDataSource-none"
+}
+`
@@ -0,0 +1,176 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudFcServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudFcServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"internet_access": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"log_config": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"vpc_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vpc_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"vswitch_ids": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"security_group_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modification_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudFcServicesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allServices, err := client.DescribeFcServices()
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredServices []FcService
+	for _, service := range allServices {
+		if nameRegex != nil && !nameRegex.MatchString(service.ServiceName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[service.ServiceId] {
+			continue
+		}
+		filteredServices = append(filteredServices, service)
+	}
+
+	if len(filteredServices) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_fc_services - Services found: %#v", filteredServices)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, service := range filteredServices {
+		var vpcConfig []map[string]interface{}
+		if service.VpcConfig.VpcId != "" {
+			vpcConfig = append(vpcConfig, map[string]interface{}{
+				"vpc_id":            service.VpcConfig.VpcId,
+				"vswitch_ids":       service.VpcConfig.VSwitchIds,
+				"security_group_id": service.VpcConfig.SecurityGroupId,
+			})
+		}
+		mapping := map[string]interface{}{
+			"id":              service.ServiceId,
+			"name":            service.ServiceName,
+			"description":     service.Description,
+			"role":            service.Role,
+			"internet_access": service.InternetAccess,
+			"log_config": map[string]interface{}{
+				"project":  service.LogConfig.Project,
+				"logstore": service.LogConfig.Logstore,
+			},
+			"vpc_config":             vpcConfig,
+			"creation_time":          service.CreatedTime,
+			"last_modification_time": service.LastModifiedTime,
+		}
+		ids = append(ids, service.ServiceId)
+		names = append(names, service.ServiceName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("services", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
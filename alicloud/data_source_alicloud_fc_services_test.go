@@ -0,0 +1,28 @@
+package alicloud
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProcessFcListRequest(t *testing.T) {
+	pages := map[string]string{
+		"":   "t1",
+		"t1": "t2",
+		"t2": "",
+	}
+	var tokens []string
+	err := (&AliyunClient{}).processFcListRequest(func(query map[string]string) (string, error) {
+		if query["limit"] != "100" {
+			t.Errorf("limit is %q, expected 100", query["limit"])
+		}
+		tokens = append(tokens, query["nextToken"])
+		return pages[query["nextToken"]], nil
+	})
+	if err != nil {
+		t.Fatalf("processFcListRequest got an error: %#v", err)
+	}
+	if expected := []string{"", "t1", "t2"}; !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("processFcListRequest requested %#v, expected %#v", tokens, expected)
+	}
+}
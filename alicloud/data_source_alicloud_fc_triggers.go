@@ -0,0 +1,167 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudFcTriggers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudFcTriggersRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"triggers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invocation_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"config": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modification_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudFcTriggersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allTriggers, err := client.DescribeFcTriggers(d.Get("service_name").(string), d.Get("function_name").(string))
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredTriggers []FcTrigger
+	for _, trigger := range allTriggers {
+		if nameRegex != nil && !nameRegex.MatchString(trigger.TriggerName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[trigger.TriggerId] {
+			continue
+		}
+		if v, ok := d.GetOk("type"); ok && trigger.TriggerType != v.(string) {
+			continue
+		}
+		filteredTriggers = append(filteredTriggers, trigger)
+	}
+
+	if len(filteredTriggers) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_fc_triggers - Triggers found: %#v", filteredTriggers)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, trigger := range filteredTriggers {
+		// The config differs between the types of the triggers, so it is exported as JSON.
+		config, err := json.Marshal(trigger.TriggerConfig)
+		if err != nil {
+			return fmt.Errorf("Marshalling the config of trigger %s got an error: %#v", trigger.TriggerName, err)
+		}
+		mapping := map[string]interface{}{
+			"id":                     trigger.TriggerId,
+			"name":                   trigger.TriggerName,
+			"type":                   trigger.TriggerType,
+			"source_arn":             trigger.SourceArn,
+			"invocation_role":        trigger.InvocationRole,
+			"config":                 string(config),
+			"creation_time":          trigger.CreatedTime,
+			"last_modification_time": trigger.LastModifiedTime,
+		}
+		ids = append(ids, trigger.TriggerId)
+		names = append(names, trigger.TriggerName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("triggers", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
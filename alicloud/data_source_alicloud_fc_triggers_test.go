@@ -0,0 +1,79 @@
+package alicloud
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudFcTriggersDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudFcTriggers().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudFcTriggersDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudFcTriggersDataSourceNotFound,
+				ExpectError: regexp.MustCompile("GET /services/tf-testAccFcTriggersDataSource-none/functions/none/triggers got an error"),
+			},
+		},
+	})
+}
+
+func TestFcTriggersRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, FcCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/"+FcApiVersion+"/services/tf-test/functions/hello/triggers" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"triggers":[{"triggerId":"t-1","triggerName":"tf-test-timer","triggerType":"timer",` +
+			`"triggerConfig":{"cronExpression":"@every 5m","enable":true}},` +
+			`{"triggerId":"t-2","triggerName":"tf-test-oss","triggerType":"oss","sourceArn":"acs:oss:cn-hangzhou:123:bucket",` +
+			`"invocationRole":"acs:ram::123:role/fc","triggerConfig":{"events":["oss:ObjectCreated:*"]}}]}`))
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudFcTriggers().Schema, map[string]interface{}{
+		"service_name":  "tf-test",
+		"function_name": "hello",
+		"type":          "oss",
+	})
+	if err := dataSourceAlicloudFcTriggersRead(d, client); err != nil {
+		t.Fatalf("Reading the triggers got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"ids.#":                      "1",
+		"names.#":                    "1",
+		"triggers.#":                 "1",
+		"triggers.0.id":              "t-2",
+		"triggers.0.name":            "tf-test-oss",
+		"triggers.0.type":            "oss",
+		"triggers.0.source_arn":      "acs:oss:cn-hangzhou:123:bucket",
+		"triggers.0.invocation_role": "acs:ram::123:role/fc",
+		"triggers.0.config":          `{"events":["oss:ObjectCreated:*"]}`,
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+const testAccCheckAlicloudFcTriggersDataSourceNotFound = `
+data "alicloud_fc_triggers" "default" {
+  service_name  = "tf-testAccFcTriggersDataSource-none"
+  function_name = "none"
+}
+`
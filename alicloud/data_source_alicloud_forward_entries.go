@@ -0,0 +1,138 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudForwardEntries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudForwardEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"forward_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"external_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"internal_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ids": idsSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"internal_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"internal_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudForwardEntriesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allEntries, err := client.DescribeForwardEntries(d.Get("forward_table_id").(string))
+	if err != nil {
+		return fmt.Errorf("DescribeForwardTableEntries got an error: %#v", err)
+	}
+
+	idsMap := idsFilter(d)
+
+	var filteredEntries []vpc.ForwardTableEntry
+	for _, entry := range allEntries {
+		if idsMap != nil && !idsMap[entry.ForwardEntryId] {
+			continue
+		}
+		if v, ok := d.GetOk("external_ip"); ok && entry.ExternalIp != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("internal_ip"); ok && entry.InternalIp != v.(string) {
+			continue
+		}
+		filteredEntries = append(filteredEntries, entry)
+	}
+
+	if len(filteredEntries) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_forward_entries - Forward entries found: %#v", filteredEntries)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, entry := range filteredEntries {
+		mapping := map[string]interface{}{
+			"id":            entry.ForwardEntryId,
+			"external_ip":   entry.ExternalIp,
+			"external_port": entry.ExternalPort,
+			"ip_protocol":   entry.IpProtocol,
+			"internal_ip":   entry.InternalIp,
+			"internal_port": entry.InternalPort,
+			"status":        entry.Status,
+		}
+		ids = append(ids, entry.ForwardEntryId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("entries", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
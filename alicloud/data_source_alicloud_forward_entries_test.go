@@ -0,0 +1,75 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudForwardEntriesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudForwardEntriesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_forward_entries.default"),
+					resource.TestCheckResourceAttr("data.alicloud_forward_entries.default", "entries.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_forward_entries.default", "entries.0.external_port", "80"),
+					resource.TestCheckResourceAttr("data.alicloud_forward_entries.default", "entries.0.ip_protocol", "tcp"),
+					resource.TestCheckResourceAttr("data.alicloud_forward_entries.default", "entries.0.internal_ip", "172.16.0.3"),
+					resource.TestCheckResourceAttr("data.alicloud_forward_entries.default", "entries.0.internal_port", "8080"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudForwardEntriesDataSourceConfig = `
+data "alicloud_zones" "default" {
+  "available_resource_creation" = "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccForwardEntriesDataSource"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_nat_gateway" "foo" {
+  vpc_id        = "${alicloud_vswitch.foo.vpc_id}"
+  specification = "Small"
+  name          = "tf-testAccForwardEntriesDataSource"
+}
+
+resource "alicloud_eip" "foo" {}
+
+resource "alicloud_eip_association" "foo" {
+  allocation_id = "${alicloud_eip.foo.id}"
+  instance_id   = "${alicloud_nat_gateway.foo.id}"
+}
+
+resource "alicloud_forward_entry" "foo" {
+  forward_table_id = "${alicloud_nat_gateway.foo.forward_table_ids}"
+  external_ip      = "${alicloud_eip.foo.ip_address}"
+  external_port    = "80"
+  ip_protocol      = "tcp"
+  internal_ip      = "172.16.0.3"
+  internal_port    = "8080"
+
+  depends_on = ["alicloud_eip_association.foo"]
+}
+
+data "alicloud_forward_entries" "default" {
+  forward_table_id = "${alicloud_forward_entry.foo.forward_table_id}"
+  internal_ip      = "${alicloud_forward_entry.foo.internal_ip}"
+}
+`
@@ -7,7 +7,7 @@ import (
 	"sort"
 	"time"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -34,10 +34,23 @@ func dataSourceAlicloudImages() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateImageOwners,
 			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"image_family": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 			// Computed values.
 			"images": {
 				Type:     schema.TypeList,
@@ -138,6 +151,18 @@ func dataSourceAlicloudImages() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"is_support_cloudinit": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"nvme_support": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"image_version": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -161,43 +186,52 @@ func dataSourceAlicloudImages() *schema.Resource {
 
 // dataSourceAlicloudImagesDescriptionRead performs the Alicloud Image lookup.
 func dataSourceAlicloudImagesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 
 	nameRegex, nameRegexOk := d.GetOk("name_regex")
 	owners, ownersOk := d.GetOk("owners")
+	ownerId, ownerIdOk := d.GetOk("owner_id")
+	family, familyOk := d.GetOk("image_family")
 	mostRecent, mostRecentOk := d.GetOk("most_recent")
+	idsMap := idsFilter(d)
 
-	if nameRegexOk == false && ownersOk == false && mostRecentOk == false {
-		return fmt.Errorf("One of name_regex, owners or most_recent must be assigned")
+	if nameRegexOk == false && ownersOk == false && ownerIdOk == false && familyOk == false && mostRecentOk == false && idsMap == nil {
+		return fmt.Errorf("One of ids, name_regex, owners, owner_id, image_family or most_recent must be assigned")
 	}
 
-	params := &ecs.DescribeImagesArgs{
-		RegionId: getRegion(d, meta),
-	}
-
-	if ownersOk {
-		params.ImageOwnerAlias = ecs.ImageOwnerAlias(owners.(string))
-	}
-
-	var allImages []ecs.ImageType
-
-	for {
-		images, paginationResult, err := conn.DescribeImages(params)
-		if err != nil {
-			break
+	var found []EcsImage
+	if familyOk {
+		// The family returns only its latest available image.
+		image, err := client.DescribeImageFromFamily(family.(string))
+		if err != nil && !NotFoundError(err) {
+			return err
 		}
-
-		allImages = append(allImages, images...)
-
-		pagination := paginationResult.NextPage()
-		if pagination == nil {
-			break
+		if err == nil {
+			found = append(found, image)
+		}
+	} else {
+		params := make(map[string]string)
+		if ownersOk {
+			params["ImageOwnerAlias"] = owners.(string)
+		}
+		if ownerIdOk {
+			params["ImageOwnerId"] = ownerId.(string)
 		}
+		var err error
+		if found, err = client.DescribeEcsImages(params); err != nil {
+			return err
+		}
+	}
 
-		params.Pagination = *pagination
+	var allImages []EcsImage
+	for _, image := range found {
+		if idsMap != nil && !idsMap[image.ImageId] {
+			continue
+		}
+		allImages = append(allImages, image)
 	}
 
-	var filteredImages []ecs.ImageType
+	var filteredImages []EcsImage
 	if nameRegexOk {
 		r := regexp.MustCompile(nameRegex.(string))
 		for _, image := range allImages {
@@ -218,7 +252,7 @@ func dataSourceAlicloudImagesRead(d *schema.ResourceData, meta interface{}) erro
 		filteredImages = allImages[:]
 	}
 
-	var images []ecs.ImageType
+	var images []EcsImage
 	if len(filteredImages) < 1 {
 		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
 	}
@@ -236,14 +270,15 @@ func dataSourceAlicloudImagesRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 // populate the numerous fields that the image description returns.
-func imagesDescriptionAttributes(d *schema.ResourceData, images []ecs.ImageType, meta interface{}) error {
+func imagesDescriptionAttributes(d *schema.ResourceData, images []EcsImage, meta interface{}) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 	for _, image := range images {
 		mapping := map[string]interface{}{
 			"id":                      image.ImageId,
 			"architecture":            image.Architecture,
-			"creation_time":           image.CreationTime.String(),
+			"creation_time":           image.CreationTime,
 			"description":             image.Description,
 			"image_id":                image.ImageId,
 			"image_owner_alias":       image.ImageOwnerAlias,
@@ -258,6 +293,9 @@ func imagesDescriptionAttributes(d *schema.ResourceData, images []ecs.ImageType,
 			"is_subscribed":           image.IsSubscribed,
 			"is_copied":               image.IsCopied,
 			"is_support_io_optimized": image.IsSupportIoOptimized,
+			"is_support_cloudinit":    image.IsSupportCloudinit,
+			"nvme_support":            image.Features.NvmeSupport,
+			"image_family":            image.ImageFamily,
 			"image_version":           image.ImageVersion,
 			"progress":                image.Progress,
 			"usage":                   image.Usage,
@@ -270,6 +308,7 @@ func imagesDescriptionAttributes(d *schema.ResourceData, images []ecs.ImageType,
 
 		log.Printf("[DEBUG] alicloud_image - adding image mapping: %v", mapping)
 		ids = append(ids, image.ImageId)
+		names = append(names, image.ImageName)
 		s = append(s, mapping)
 	}
 
@@ -277,16 +316,22 @@ func imagesDescriptionAttributes(d *schema.ResourceData, images []ecs.ImageType,
 	if err := d.Set("images", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
 
 //Find most recent image
-type imageSort []ecs.ImageType
+type imageSort []EcsImage
 
 func (a imageSort) Len() int {
 	return len(a)
@@ -295,13 +340,13 @@ func (a imageSort) Swap(i, j int) {
 	a[i], a[j] = a[j], a[i]
 }
 func (a imageSort) Less(i, j int) bool {
-	itime, _ := time.Parse(time.RFC3339, a[i].CreationTime.String())
-	jtime, _ := time.Parse(time.RFC3339, a[j].CreationTime.String())
+	itime, _ := time.Parse(time.RFC3339, a[i].CreationTime)
+	jtime, _ := time.Parse(time.RFC3339, a[j].CreationTime)
 	return itime.Unix() < jtime.Unix()
 }
 
 // Returns the most recent Image out of a slice of images.
-func mostRecentImage(images []ecs.ImageType) ecs.ImageType {
+func mostRecentImage(images []EcsImage) EcsImage {
 	sortedImages := images
 	sort.Sort(imageSort(sortedImages))
 	return sortedImages[len(sortedImages)-1]
@@ -327,14 +372,7 @@ func imageDiskDeviceMappings(m []ecs.DiskDeviceMapping) []map[string]interface{}
 
 //Returns a mapping of image tags
 func imageTagsMappings(d *schema.ResourceData, imageId string, meta interface{}) map[string]string {
-	client := meta.(*AliyunClient)
-	conn := client.ecsconn
-
-	tags, _, err := conn.DescribeTags(&ecs.DescribeTagsArgs{
-		RegionId:     getRegion(d, meta),
-		ResourceType: ecs.TagResourceImage,
-		ResourceId:   imageId,
-	})
+	tags, err := meta.(*AliyunClient).describeEcsTags(getRegion(d, meta), TagResourceImage, imageId)
 
 	if err != nil {
 		log.Printf("[ERROR] DescribeTags for image got error: %#v", err)
@@ -59,6 +59,7 @@ func TestAccAlicloudImagesDataSource_owners(t *testing.T) {
 				Config: testAccCheckAlicloudImagesDataSourceOwnersConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAlicloudDataSourceID("data.alicloud_images.owners_filtered_image"),
+					resource.TestCheckResourceAttrSet("data.alicloud_images.owners_filtered_image", "images.0.is_support_cloudinit"),
 				),
 			},
 		},
@@ -0,0 +1,198 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// HoursPerMonth is used to estimate the monthly price of a resource which is charged by hours.
+const HoursPerMonth = 730
+
+func dataSourceAlicloudInstancePrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudInstancePriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateInstanceType,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_network_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "vpc",
+				ValidateFunc: validateAllowedStringValue([]string{"vpc", "classic"}),
+			},
+			"system_disk_category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      DiskCategoryCloudEfficiency,
+				ValidateFunc: validateDiskCategory,
+			},
+			"system_disk_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  40,
+			},
+			"data_disks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 16,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      DiskCategoryCloudEfficiency,
+							ValidateFunc: validateDiskCategory,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"internet_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      common.PayByTraffic,
+				ValidateFunc: validateInternetChargeType,
+			},
+			"internet_max_bandwidth_out": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      common.PostPaid,
+				ValidateFunc: validateInstanceChargeType,
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"period_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      common.Month,
+				ValidateFunc: validateInstanceChargeTypePeriodUnit,
+			},
+			"spot_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      NoSpot,
+				ValidateFunc: validateAllowedStringValue([]string{string(NoSpot), string(SpotAsPriceGo)}),
+			},
+			"amount": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 1000),
+			},
+
+			// Computed values
+			"original_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"discount_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"trade_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"monthly_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"currency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudInstancePriceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	chargeType := d.Get("instance_charge_type").(string)
+	prepaid := chargeType == string(common.PrePaid)
+	if d.Get("spot_strategy").(string) != string(NoSpot) && prepaid {
+		return fmt.Errorf("'spot_strategy' %s requires 'instance_charge_type' to be %s.", d.Get("spot_strategy").(string), common.PostPaid)
+	}
+
+	params := map[string]string{
+		"ResourceType":            "instance",
+		"InstanceType":            d.Get("instance_type").(string),
+		"InstanceNetworkType":     d.Get("instance_network_type").(string),
+		"IoOptimized":             "optimized",
+		"SystemDisk.Category":     d.Get("system_disk_category").(string),
+		"SystemDisk.Size":         strconv.Itoa(d.Get("system_disk_size").(int)),
+		"InternetChargeType":      d.Get("internet_charge_type").(string),
+		"InternetMaxBandwidthOut": strconv.Itoa(d.Get("internet_max_bandwidth_out").(int)),
+		"Amount":                  strconv.Itoa(d.Get("amount").(int)),
+		"PriceUnit":               "Hour",
+	}
+	if v := d.Get("availability_zone").(string); v != "" {
+		params["ZoneId"] = v
+	}
+	for i, v := range d.Get("data_disks").([]interface{}) {
+		disk := v.(map[string]interface{})
+		params[fmt.Sprintf("DataDisk.%d.Category", i+1)] = disk["category"].(string)
+		params[fmt.Sprintf("DataDisk.%d.Size", i+1)] = strconv.Itoa(disk["size"].(int))
+	}
+	if prepaid {
+		params["PriceUnit"] = d.Get("period_unit").(string)
+		params["Period"] = strconv.Itoa(d.Get("period").(int))
+	} else if v := d.Get("spot_strategy").(string); v != string(NoSpot) {
+		params["SpotStrategy"] = v
+	}
+
+	price, err := client.DescribeEcsPrice(params)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] alicloud_instance_price - Price found: %#v", price)
+
+	d.SetId(dataResourceIdHash([]string{params["InstanceType"], params["ZoneId"], chargeType}))
+	d.Set("original_price", price.OriginalPrice)
+	d.Set("discount_price", price.DiscountPrice)
+	d.Set("trade_price", price.TradePrice)
+	d.Set("currency", price.Currency)
+	d.Set("monthly_price", estimateMonthlyPrice(price.TradePrice, prepaid, d.Get("period").(int), d.Get("period_unit").(string)))
+	return nil
+}
+
+// estimateMonthlyPrice converts the trade price of a resource to its monthly price. The price of a PostPaid resource
+// is hourly, and the one of a PrePaid resource is for the whole period.
+func estimateMonthlyPrice(tradePrice float64, prepaid bool, period int, periodUnit string) float64 {
+	if !prepaid {
+		return tradePrice * HoursPerMonth
+	}
+	if period < 1 {
+		period = 1
+	}
+	switch common.TimeType(periodUnit) {
+	case common.Week:
+		return tradePrice / float64(period) * 52 / 12
+	case common.Year:
+		return tradePrice / float64(period) / 12
+	}
+	return tradePrice / float64(period)
+}
@@ -0,0 +1,69 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudInstancePriceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudInstancePriceDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_instance_price.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_instance_price.default", "trade_price"),
+					resource.TestCheckResourceAttrSet("data.alicloud_instance_price.default", "monthly_price"),
+					resource.TestCheckResourceAttrSet("data.alicloud_instance_price.default", "currency"),
+				),
+			},
+		},
+	})
+}
+
+func TestEstimateMonthlyPrice(t *testing.T) {
+	cases := []struct {
+		tradePrice float64
+		prepaid    bool
+		period     int
+		periodUnit string
+		expected   float64
+	}{
+		{0.5, false, 1, "Month", 365},
+		{300, true, 3, "Month", 100},
+		{1200, true, 1, "Year", 100},
+		{120, true, 2, "Week", 260},
+	}
+	for _, c := range cases {
+		if price := estimateMonthlyPrice(c.tradePrice, c.prepaid, c.period, c.periodUnit); price != c.expected {
+			t.Errorf("estimateMonthlyPrice(%v, %t, %d, %s) got %v, expected %v", c.tradePrice, c.prepaid, c.period, c.periodUnit, price, c.expected)
+		}
+	}
+}
+
+const testAccCheckAlicloudInstancePriceDataSourceConfig = `
+data "alicloud_zones" "default" {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+data "alicloud_instance_price" "default" {
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+  instance_type     = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  data_disks = [
+    {
+      size = 100
+    },
+  ]
+}
+`
@@ -0,0 +1,219 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudInstanceTypePrices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudInstanceTypePricesRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      common.PostPaid,
+				ValidateFunc: validateInstanceChargeType,
+			},
+			"spot_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      NoSpot,
+				ValidateFunc: validateAllowedStringValue([]string{string(NoSpot), string(SpotAsPriceGo)}),
+			},
+			"system_disk_category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      DiskCategoryCloudEfficiency,
+				ValidateFunc: validateAllowedStringValue([]string{string(DiskCategoryCloudEfficiency), string(DiskCategoryCloudSSD)}),
+			},
+			"instance_type_family": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateInstanceType,
+			},
+			"cpu_core_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"memory_size": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				ForceNew: true,
+			},
+			"max_price": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ids": idsSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"instance_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cpu_core_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_size": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"original_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"currency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type instanceTypePrice struct {
+	ecs.InstanceType
+	EcsPrice
+}
+
+func dataSourceAlicloudInstanceTypePricesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	zoneId := d.Get("availability_zone").(string)
+	chargeType := d.Get("instance_charge_type").(string)
+	spotStrategy := d.Get("spot_strategy").(string)
+	if spotStrategy != string(NoSpot) && chargeType != string(common.PostPaid) {
+		return fmt.Errorf("'spot_strategy' %s requires 'instance_charge_type' to be %s.", spotStrategy, common.PostPaid)
+	}
+
+	available, err := client.DescribeAvailableInstanceTypes(zoneId, chargeType, spotStrategy)
+	if err != nil {
+		return err
+	}
+	availableMap := make(map[string]bool)
+	for _, t := range available {
+		availableMap[t] = true
+	}
+
+	allTypes, err := client.DescribeInstanceTypes(d.Get("instance_type_family").(string))
+	if err != nil {
+		return fmt.Errorf("DescribeInstanceTypes got an error: %#v", err)
+	}
+
+	cpu := d.Get("cpu_core_count").(int)
+	mem := d.Get("memory_size").(float64)
+	idsMap := idsFilter(d)
+
+	// DescribePrice is called for every candidate, so the cheaper filters are applied first.
+	var prices []instanceTypePrice
+	for _, t := range allTypes {
+		if !availableMap[t.InstanceTypeId] {
+			continue
+		}
+		if cpu > 0 && t.CpuCoreCount != cpu {
+			continue
+		}
+		if mem > 0 && t.MemorySize != mem {
+			continue
+		}
+		if idsMap != nil && !idsMap[t.InstanceTypeId] {
+			continue
+		}
+		price, err := client.DescribeInstanceTypePrice(zoneId, t.InstanceTypeId, chargeType, spotStrategy, d.Get("system_disk_category").(string))
+		if err != nil {
+			return err
+		}
+		if v, ok := d.GetOk("max_price"); ok && price.TradePrice > v.(float64) {
+			continue
+		}
+		prices = append(prices, instanceTypePrice{t, price})
+	}
+
+	if len(prices) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	sortInstanceTypePrices(prices)
+
+	log.Printf("[DEBUG] alicloud_instance_type_prices - Instance type prices found: %#v", prices)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, p := range prices {
+		mapping := map[string]interface{}{
+			"id":             p.InstanceTypeId,
+			"family":         p.InstanceTypeFamily,
+			"cpu_core_count": p.CpuCoreCount,
+			"memory_size":    p.MemorySize,
+			"price":          p.TradePrice,
+			"original_price": p.OriginalPrice,
+			"currency":       p.Currency,
+		}
+		ids = append(ids, p.InstanceTypeId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("instance_types", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeOrderedToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
+
+// sortInstanceTypePrices sorts the instance types from the cheapest one. The types with the same price are
+// sorted by their IDs so that the result is stable.
+func sortInstanceTypePrices(prices []instanceTypePrice) {
+	sort.SliceStable(prices, func(i, j int) bool {
+		if prices[i].TradePrice != prices[j].TradePrice {
+			return prices[i].TradePrice < prices[j].TradePrice
+		}
+		return prices[i].InstanceTypeId < prices[j].InstanceTypeId
+	})
+}
@@ -0,0 +1,151 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccAlicloudInstanceTypePricesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudInstanceTypePricesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_instance_type_prices.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_instance_type_prices.default", "instance_types.0.id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_instance_type_prices.default", "instance_types.0.price"),
+					resource.TestCheckResourceAttr("data.alicloud_instance_type_prices.default", "instance_types.0.cpu_core_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestSortInstanceTypePrices(t *testing.T) {
+	price := func(id string, tradePrice float64) instanceTypePrice {
+		return instanceTypePrice{ecs.InstanceType{InstanceTypeId: id}, EcsPrice{TradePrice: tradePrice}}
+	}
+	prices := []instanceTypePrice{
+		price("ecs.sn1ne.large", 0.5),
+		price("ecs.n4.large", 0.3),
+		price("ecs.c5.large", 0.3),
+		price("ecs.t5-lc1m2.large", 0.1),
+	}
+	sortInstanceTypePrices(prices)
+
+	var ids []string
+	for _, p := range prices {
+		ids = append(ids, p.InstanceTypeId)
+	}
+	expected := []string{"ecs.t5-lc1m2.large", "ecs.c5.large", "ecs.n4.large", "ecs.sn1ne.large"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("sortInstanceTypePrices got %#v, expected %#v", ids, expected)
+	}
+}
+
+func TestInstanceTypePricesOutputFile(t *testing.T) {
+	prices := map[string]string{
+		"ecs.c5.large":       "0.3",
+		"ecs.n4.large":       "0.2",
+		"ecs.t5-lc1m2.large": "0.1",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("Action") {
+		case "DescribeAvailableResource":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","AvailableZones":{"AvailableZone":[{"ZoneId":"cn-hangzhou-b",` +
+				`"AvailableResources":{"AvailableResource":[{"Type":"InstanceType","SupportedResources":{"SupportedResource":[` +
+				`{"Value":"ecs.c5.large","Status":"Available"},{"Value":"ecs.n4.large","Status":"Available"},` +
+				`{"Value":"ecs.t5-lc1m2.large","Status":"Available"}]}}]}}]}}`))
+		case "DescribeInstanceTypes":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","InstanceTypes":{"InstanceType":[` +
+				`{"InstanceTypeId":"ecs.c5.large","CpuCoreCount":2,"MemorySize":4},` +
+				`{"InstanceTypeId":"ecs.n4.large","CpuCoreCount":2,"MemorySize":4},` +
+				`{"InstanceTypeId":"ecs.t5-lc1m2.large","CpuCoreCount":2,"MemorySize":4}]}}`))
+		case "DescribePrice":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","PriceInfo":{"Price":{"TradePrice":` + prices[r.FormValue("InstanceType")] + `,"Currency":"CNY"}}}`))
+		default:
+			w.Write([]byte(`{"RequestId":"A1B2C3D4"}`))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		AccessKey: "ak",
+		SecretKey: "sk",
+		Region:    common.Hangzhou,
+		RegionId:  string(common.Hangzhou),
+		Endpoints: map[string]string{
+			EcsCode: server.URL,
+		},
+	}
+	config.rewriter = config.newRequestRewriter()
+	ecsClient, err := config.ecsConn()
+	if err != nil {
+		t.Fatalf("Building the ECS client got an error: %#v", err)
+	}
+	commonconn, err := config.commonConn()
+	if err != nil {
+		t.Fatalf("Building the common client got an error: %#v", err)
+	}
+	client := &AliyunClient{Region: config.Region, ecsClient: ecsClient, commonconn: commonconn, config: config}
+
+	dir, err := ioutil.TempDir("", "tf-alicloud-output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	outputFile := filepath.Join(dir, "prices.json")
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudInstanceTypePrices().Schema, map[string]interface{}{
+		"availability_zone": "cn-hangzhou-b",
+		"output_file":       outputFile,
+	})
+	if err := dataSourceAlicloudInstanceTypePricesRead(d, client); err != nil {
+		t.Fatalf("Reading the instance type prices got an error: %#v", err)
+	}
+
+	bs, err := ioutil.ReadFile(outputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var output []map[string]interface{}
+	if err := json.Unmarshal(bs, &output); err != nil {
+		t.Fatalf("Unmarshalling %s got an error: %#v", outputFile, err)
+	}
+	var ids []string
+	for _, item := range output {
+		ids = append(ids, item["id"].(string))
+	}
+	expected := []string{"ecs.t5-lc1m2.large", "ecs.n4.large", "ecs.c5.large"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Expected the output file keeps the instance types sorted by price %#v, got %#v", expected, ids)
+	}
+}
+
+const testAccCheckAlicloudInstanceTypePricesDataSourceConfig = `
+data "alicloud_zones" "default" {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_type_prices" "default" {
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+`
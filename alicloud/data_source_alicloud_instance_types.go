@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -38,10 +38,12 @@ func dataSourceAlicloudInstanceTypes() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"ids": idsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 			// Computed values.
 			"instance_types": {
 				Type:     schema.TypeList,
@@ -83,13 +85,7 @@ func dataSourceAlicloudInstanceTypesRead(d *schema.ResourceData, meta interface{
 	cpu := d.Get("cpu_core_count").(int)
 	mem := d.Get("memory_size").(float64)
 
-	args, err := buildAliyunAlicloudInstanceTypesArgs(d, meta)
-
-	if err != nil {
-		return err
-	}
-
-	resp, err := client.ecsconn.DescribeInstanceTypesNew(args)
+	resp, err := client.DescribeInstanceTypes(d.Get("instance_type_family").(string))
 	if err != nil {
 		return err
 	}
@@ -102,7 +98,9 @@ func dataSourceAlicloudInstanceTypesRead(d *schema.ResourceData, meta interface{
 		validInstanceTypes = val.(map[string]string)
 	}
 
-	var instanceTypes []ecs.InstanceTypeItemType
+	idsMap := idsFilter(d)
+
+	var instanceTypes []ecs.InstanceType
 	for _, types := range resp {
 		// Only filter series three instance type.
 		if _, ok := validInstanceTypes[types.InstanceTypeId]; !ok {
@@ -116,6 +114,10 @@ func dataSourceAlicloudInstanceTypesRead(d *schema.ResourceData, meta interface{
 		if mem > 0 && types.MemorySize != mem {
 			continue
 		}
+
+		if idsMap != nil && !idsMap[types.InstanceTypeId] {
+			continue
+		}
 		instanceTypes = append(instanceTypes, types)
 	}
 
@@ -127,7 +129,7 @@ func dataSourceAlicloudInstanceTypesRead(d *schema.ResourceData, meta interface{
 	return instanceTypesDescriptionAttributes(d, instanceTypes)
 }
 
-func instanceTypesDescriptionAttributes(d *schema.ResourceData, types []ecs.InstanceTypeItemType) error {
+func instanceTypesDescriptionAttributes(d *schema.ResourceData, types []ecs.InstanceType) error {
 	var ids []string
 	var s []map[string]interface{}
 	for _, t := range types {
@@ -147,20 +149,13 @@ func instanceTypesDescriptionAttributes(d *schema.ResourceData, types []ecs.Inst
 	if err := d.Set("instance_types", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
-
-func buildAliyunAlicloudInstanceTypesArgs(d *schema.ResourceData, meta interface{}) (*ecs.DescribeInstanceTypesArgs, error) {
-	args := &ecs.DescribeInstanceTypesArgs{}
-
-	if v := d.Get("instance_type_family").(string); v != "" {
-		args.InstanceTypeFamily = v
-	}
-
-	return args, nil
-}
@@ -5,7 +5,7 @@ import (
 	"log"
 	"regexp"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -14,13 +14,7 @@ func dataSourceAlicloudInstances() *schema.Resource {
 		Read: dataSourceAlicloudInstancesRead,
 
 		Schema: map[string]*schema.Schema{
-			"ids": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				ForceNew: true,
-				MinItems: 1,
-			},
+			"ids": idsSchema(),
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -56,11 +50,13 @@ func dataSourceAlicloudInstances() *schema.Resource {
 
 			"tags": tagsSchema(),
 
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"instances": {
@@ -182,12 +178,11 @@ func dataSourceAlicloudInstances() *schema.Resource {
 	}
 }
 func dataSourceAlicloudInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 
-	args := &ecs.DescribeInstancesArgs{
-		RegionId: getRegion(d, meta),
-		Status:   ecs.InstanceStatus(d.Get("status").(string)),
-	}
+	args := ecs.CreateDescribeInstancesRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.Status = d.Get("status").(string)
 
 	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
 		args.InstanceIds = convertListToJsonString(v.([]interface{}))
@@ -202,32 +197,19 @@ func dataSourceAlicloudInstancesRead(d *schema.ResourceData, meta interface{}) e
 		args.ZoneId = v.(string)
 	}
 	if v, ok := d.GetOk("tags"); ok {
-		mapping := make(map[string]string)
+		var tags []ecs.DescribeInstancesTag
 		for key, value := range v.(map[string]interface{}) {
-			mapping[key] = value.(string)
+			tags = append(tags, ecs.DescribeInstancesTag{Key: key, Value: value.(string)})
 		}
-		args.Tag = mapping
+		args.Tag = &tags
 	}
 
-	var allInstances []ecs.InstanceAttributesType
-
-	for {
-		instances, paginationResult, err := conn.DescribeInstances(args)
-		if err != nil {
-			return err
-		}
-
-		allInstances = append(allInstances, instances...)
-
-		pagination := paginationResult.NextPage()
-		if pagination == nil {
-			break
-		}
-
-		args.Pagination = *pagination
+	allInstances, err := client.DescribeEcsInstances(args)
+	if err != nil {
+		return fmt.Errorf("DescribeInstances got an error: %#v", err)
 	}
 
-	var filteredInstancesTemp []ecs.InstanceAttributesType
+	var filteredInstancesTemp []ecs.Instance
 
 	nameRegex, ok := d.GetOk("name_regex")
 	imageId, okImg := d.GetOk("image_id")
@@ -259,8 +241,9 @@ func dataSourceAlicloudInstancesRead(d *schema.ResourceData, meta interface{}) e
 }
 
 // populate the numerous fields that the instance description returns.
-func instancessDescriptionAttributes(d *schema.ResourceData, instances []ecs.InstanceAttributesType, meta interface{}) error {
+func instancessDescriptionAttributes(d *schema.ResourceData, instances []ecs.Instance, meta interface{}) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 	for _, inst := range instances {
 		mapping := map[string]interface{}{
@@ -278,7 +261,7 @@ func instancessDescriptionAttributes(d *schema.ResourceData, instances []ecs.Ins
 			"eip":                        inst.EipAddress.IpAddress,
 			"key_name":                   inst.KeyPairName,
 			"spot_strategy":              inst.SpotStrategy,
-			"creation_time":              inst.CreationTime.String(),
+			"creation_time":              inst.CreationTime,
 			"instance_charge_type":       inst.InstanceChargeType,
 			"internet_charge_type":       inst.InternetChargeType,
 			"internet_max_bandwidth_out": inst.InternetMaxBandwidthOut,
@@ -299,6 +282,7 @@ func instancessDescriptionAttributes(d *schema.ResourceData, instances []ecs.Ins
 
 		log.Printf("[DEBUG] alicloud_instance - adding instance mapping: %v", mapping)
 		ids = append(ids, inst.InstanceId)
+		names = append(names, inst.InstanceName)
 		s = append(s, mapping)
 	}
 
@@ -306,10 +290,16 @@ func instancessDescriptionAttributes(d *schema.ResourceData, instances []ecs.Ins
 	if err := d.Set("instances", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -317,10 +307,10 @@ func instancessDescriptionAttributes(d *schema.ResourceData, instances []ecs.Ins
 //Returns a mapping of instance disks
 func instanceDisksMappings(d *schema.ResourceData, instanceId string, meta interface{}) []map[string]interface{} {
 
-	disks, _, err := meta.(*AliyunClient).ecsconn.DescribeDisks(&ecs.DescribeDisksArgs{
-		RegionId:   getRegion(d, meta),
-		InstanceId: instanceId,
-	})
+	request := ecs.CreateDescribeDisksRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.InstanceId = instanceId
+	disks, err := meta.(*AliyunClient).DescribeEcsDisks(request)
 
 	if err != nil {
 		log.Printf("[ERROR] DescribeDisks for instance got error: %#v", err)
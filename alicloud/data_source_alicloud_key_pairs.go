@@ -5,7 +5,7 @@ import (
 	"log"
 	"regexp"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -26,10 +26,18 @@ func dataSourceAlicloudKeyPairs() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			//Computed value
 			"key_pairs": &schema.Schema{
@@ -62,36 +70,43 @@ func dataSourceAlicloudKeyPairs() *schema.Resource {
 }
 
 func dataSourceAlicloudKeyPairsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 
 	var regex *regexp.Regexp
 	if name, ok := d.GetOk("name_regex"); ok {
 		regex = regexp.MustCompile(name.(string))
 	}
 
-	args := &ecs.DescribeKeyPairsArgs{
-		RegionId: getRegion(d, meta),
-	}
+	args := ecs.CreateDescribeKeyPairsRequest()
+	args.RegionId = string(getRegion(d, meta))
 	if fingerPrint, ok := d.GetOk("finger_print"); ok {
 		args.KeyPairFingerPrint = fingerPrint.(string)
 	}
-	var keyPairs []ecs.KeyPairItemType
-	pagination := getPagination(1, 50)
-	for true {
-		args.Pagination = pagination
-		results, _, err := conn.DescribeKeyPairs(args)
+	var taggedNames map[string]bool
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		names, err := client.describeEcsResourceIdsByTags(TagResourceKeyPair, v.(map[string]interface{}))
 		if err != nil {
-			return fmt.Errorf("Error DescribekeyPairs: %#v", err)
+			return err
 		}
-		for _, key := range results {
-			if regex == nil || (regex != nil && regex.MatchString(key.KeyPairName)) {
-				keyPairs = append(keyPairs, key)
-			}
+		taggedNames = names
+	}
+	idsMap := idsFilter(d)
+
+	results, err := client.DescribeEcsKeyPairs(args)
+	if err != nil {
+		return fmt.Errorf("Error DescribekeyPairs: %#v", err)
+	}
+	var keyPairs []ecs.KeyPair
+	for _, key := range results {
+		if taggedNames != nil && !taggedNames[key.KeyPairName] {
+			continue
+		}
+		if idsMap != nil && !idsMap[key.KeyPairName] {
+			continue
 		}
-		if len(results) < pagination.PageSize {
-			break
+		if regex == nil || (regex != nil && regex.MatchString(key.KeyPairName)) {
+			keyPairs = append(keyPairs, key)
 		}
-		pagination.PageNumber += 1
 	}
 
 	if len(keyPairs) < 1 {
@@ -99,53 +114,45 @@ func dataSourceAlicloudKeyPairsRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	keyPairsAttach := make(map[string][]map[string]interface{})
-	pagination.PageNumber = 1
-	for true {
-		instances, _, err := conn.DescribeInstances(&ecs.DescribeInstancesArgs{
-			RegionId:   getRegion(d, meta),
-			Pagination: pagination,
-		})
-		if err != nil {
-			return fmt.Errorf("Error DescribeInstances: %#v", err)
-		}
-		for _, inst := range instances {
-			if inst.KeyPairName != "" {
-				public_ip := inst.EipAddress.IpAddress
-				if public_ip == "" && len(inst.PublicIpAddress.IpAddress) > 0 {
-					public_ip = inst.PublicIpAddress.IpAddress[0]
-				}
-				var private_ip string
-				if len(inst.InnerIpAddress.IpAddress) > 0 {
-					private_ip = inst.InnerIpAddress.IpAddress[0]
-				} else if len(inst.VpcAttributes.PrivateIpAddress.IpAddress) > 0 {
-					private_ip = inst.VpcAttributes.PrivateIpAddress.IpAddress[0]
-				}
-				mapping := map[string]interface{}{
-					"availability_zone": inst.ZoneId,
-					"instance_id":       inst.InstanceId,
-					"instance_name":     inst.InstanceName,
-					"vswitch_id":        inst.VpcAttributes.VSwitchId,
-					"public_ip":         public_ip,
-					"private_ip":        private_ip,
-				}
-				if val, ok := keyPairsAttach[inst.KeyPairName]; ok {
-					val = append(val, mapping)
-					keyPairsAttach[inst.KeyPairName] = val
-				} else {
-					keyPairsAttach[inst.KeyPairName] = append(make([]map[string]interface{}, 0, 1), mapping)
-				}
+	request := ecs.CreateDescribeInstancesRequest()
+	request.RegionId = string(getRegion(d, meta))
+	instances, err := client.DescribeEcsInstances(request)
+	if err != nil {
+		return fmt.Errorf("Error DescribeInstances: %#v", err)
+	}
+	for _, inst := range instances {
+		if inst.KeyPairName != "" {
+			public_ip := inst.EipAddress.IpAddress
+			if public_ip == "" && len(inst.PublicIpAddress.IpAddress) > 0 {
+				public_ip = inst.PublicIpAddress.IpAddress[0]
+			}
+			var private_ip string
+			if len(inst.InnerIpAddress.IpAddress) > 0 {
+				private_ip = inst.InnerIpAddress.IpAddress[0]
+			} else if len(inst.VpcAttributes.PrivateIpAddress.IpAddress) > 0 {
+				private_ip = inst.VpcAttributes.PrivateIpAddress.IpAddress[0]
+			}
+			mapping := map[string]interface{}{
+				"availability_zone": inst.ZoneId,
+				"instance_id":       inst.InstanceId,
+				"instance_name":     inst.InstanceName,
+				"vswitch_id":        inst.VpcAttributes.VSwitchId,
+				"public_ip":         public_ip,
+				"private_ip":        private_ip,
+			}
+			if val, ok := keyPairsAttach[inst.KeyPairName]; ok {
+				val = append(val, mapping)
+				keyPairsAttach[inst.KeyPairName] = val
+			} else {
+				keyPairsAttach[inst.KeyPairName] = append(make([]map[string]interface{}, 0, 1), mapping)
 			}
 		}
-		if len(instances) < pagination.PageSize {
-			break
-		}
-		pagination.PageNumber += 1
 	}
 
 	return keyPairsDescriptionAttributes(d, keyPairs, keyPairsAttach)
 }
 
-func keyPairsDescriptionAttributes(d *schema.ResourceData, keyPairs []ecs.KeyPairItemType, keyPairsAttach map[string][]map[string]interface{}) error {
+func keyPairsDescriptionAttributes(d *schema.ResourceData, keyPairs []ecs.KeyPair, keyPairsAttach map[string][]map[string]interface{}) error {
 	var names []string
 	var s []map[string]interface{}
 	for _, key := range keyPairs {
@@ -165,10 +172,17 @@ func keyPairsDescriptionAttributes(d *schema.ResourceData, keyPairs []ecs.KeyPai
 	if err := d.Set("key_pairs", s); err != nil {
 		return err
 	}
+	// The name of a key pair is its id.
+	if err := d.Set("ids", names); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
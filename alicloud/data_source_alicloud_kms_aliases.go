@@ -0,0 +1,131 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudKmsAliases() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudKmsAliasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"aliases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alias_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudKmsAliasesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allAliases, err := client.DescribeKmsAliases()
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredAliases []KmsAlias
+	for _, alias := range allAliases {
+		if nameRegex != nil && !nameRegex.MatchString(alias.AliasName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[alias.AliasName] {
+			continue
+		}
+		if v, ok := d.GetOk("key_id"); ok && alias.KeyId != v.(string) {
+			continue
+		}
+		filteredAliases = append(filteredAliases, alias)
+	}
+
+	if len(filteredAliases) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_kms_aliases - Aliases found: %#v", filteredAliases)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, alias := range filteredAliases {
+		mapping := map[string]interface{}{
+			"id":         alias.AliasName,
+			"alias_name": alias.AliasName,
+			"key_id":     alias.KeyId,
+			"arn":        alias.AliasArn,
+		}
+		ids = append(ids, alias.AliasName)
+		names = append(names, alias.AliasName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("aliases", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
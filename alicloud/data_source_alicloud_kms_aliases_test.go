@@ -0,0 +1,75 @@
+package alicloud
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudKmsAliasesDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudKmsAliases().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudKmsAliasesDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudKmsAliasesDataSourceEmpty,
+				ExpectError: regexp.MustCompile("Your query returned no results"),
+			},
+		},
+	})
+}
+
+func TestKmsAliasesRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, KmsCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if action := r.FormValue("Action"); action != "ListAliases" {
+			t.Errorf("Unexpected action %s", action)
+		}
+		w.Write([]byte(`{"RequestId":"A1B2C3D4","TotalCount":3,"Aliases":{"Alias":[` +
+			`{"KeyId":"key-1","AliasName":"alias/tf-test-app","AliasArn":"acs:kms:cn-hangzhou:123:alias/tf-test-app"},` +
+			`{"KeyId":"key-2","AliasName":"alias/tf-test-db","AliasArn":"acs:kms:cn-hangzhou:123:alias/tf-test-db"},` +
+			`{"KeyId":"key-1","AliasName":"alias/other","AliasArn":"acs:kms:cn-hangzhou:123:alias/other"}]}}`))
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudKmsAliases().Schema, map[string]interface{}{
+		"key_id": "key-1",
+	})
+	if err := dataSourceAlicloudKmsAliasesRead(d, client); err != nil {
+		t.Fatalf("Reading the aliases got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"ids.#":            "2",
+		"names.#":          "2",
+		"names.0":          "alias/tf-test-app",
+		"names.1":          "alias/other",
+		"aliases.#":        "2",
+		"aliases.0.key_id": "key-1",
+		"aliases.0.arn":    "acs:kms:cn-hangzhou:123:alias/tf-test-app",
+		"aliases.1.id":     "alias/other",
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+const testAccCheckAlicloudKmsAliasesDataSourceEmpty = `
+data "alicloud_kms_aliases" "default" {
+  name_regex = "^alias/tf-testAccKmsAliasesDataSource-none$"
+}
+`
@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/denverdino/aliyungo/kms"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,13 +14,7 @@ func dataSourceAlicloudKmsKeys() *schema.Resource {
 		Read: dataSourceAlicloudKmsKeysRead,
 
 		Schema: map[string]*schema.Schema{
-			"ids": &schema.Schema{
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				MinItems: 1,
-			},
+			"ids": idsSchema(),
 
 			"description_regex": &schema.Schema{
 				Type:         schema.TypeString,
@@ -35,10 +30,17 @@ func dataSourceAlicloudKmsKeys() *schema.Resource {
 				ValidateFunc: validateKmsKeyStatus,
 			},
 
+			"alias": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			//Computed value
 			"keys": &schema.Schema{
@@ -74,6 +76,11 @@ func dataSourceAlicloudKmsKeys() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"aliases": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -82,16 +89,21 @@ func dataSourceAlicloudKmsKeys() *schema.Resource {
 }
 
 func dataSourceAlicloudKmsKeysRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).kmsconn
+	client := meta.(*AliyunClient)
+	conn := client.kmsconn()
+
+	aliases, err := client.DescribeKmsAliases()
+	if err != nil {
+		return err
+	}
+	keyAliases := make(map[string][]string)
+	for _, alias := range aliases {
+		keyAliases[alias.KeyId] = append(keyAliases[alias.KeyId], alias.AliasName)
+	}
 
 	args := &kms.ListKeysArgs{}
 
-	idsMap := make(map[string]string)
-	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
-		for _, i := range v.([]interface{}) {
-			idsMap[i.(string)] = i.(string)
-		}
-	}
+	idsMap := idsFilter(d)
 
 	var keyIds []string
 	pagination := getPagination(1, 50)
@@ -102,11 +114,8 @@ func dataSourceAlicloudKmsKeysRead(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Error ListKeys: %#v", err)
 		}
 		for _, key := range results.Keys.Key {
-			if idsMap != nil {
-				if _, ok := idsMap[key.KeyId]; ok {
-					keyIds = append(keyIds, key.KeyId)
-					continue
-				}
+			if idsMap != nil && !idsMap[key.KeyId] {
+				continue
 			}
 			keyIds = append(keyIds, key.KeyId)
 		}
@@ -128,8 +137,12 @@ func dataSourceAlicloudKmsKeysRead(d *schema.ResourceData, meta interface{}) err
 		r = regexp.MustCompile(descriptionRegex.(string))
 	}
 	status, statusOk := d.GetOk("status")
+	alias, aliasOk := d.GetOk("alias")
 
 	for _, k := range keyIds {
+		if aliasOk && !kmsKeyHasAlias(keyAliases[k], alias.(string)) {
+			continue
+		}
 		key, err := conn.DescribeKey(k)
 		if err != nil {
 			return fmt.Errorf("DescribeKey got an error: %#v", err)
@@ -149,6 +162,7 @@ func dataSourceAlicloudKmsKeysRead(d *schema.ResourceData, meta interface{}) err
 			"creation_date": key.KeyMetadata.CreationDate,
 			"delete_date":   key.KeyMetadata.DeleteDate,
 			"creator":       key.KeyMetadata.Creator,
+			"aliases":       keyAliases[k],
 		}
 		s = append(s, mapping)
 		ids = append(ids, key.KeyMetadata.KeyId)
@@ -158,10 +172,27 @@ func dataSourceAlicloudKmsKeysRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("keys", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
+
+// kmsKeyHasAlias returns whether the alias is one of the aliases of a key. The alias can be given
+// with or without the prefix alias/.
+func kmsKeyHasAlias(aliases []string, alias string) bool {
+	if !strings.HasPrefix(alias, KmsAliasPrefix) {
+		alias = KmsAliasPrefix + alias
+	}
+	for _, a := range aliases {
+		if a == alias {
+			return true
+		}
+	}
+	return false
+}
@@ -25,6 +25,24 @@ func TestAccAlicloudKmsKeyDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestKmsKeyHasAlias(t *testing.T) {
+	aliases := []string{"alias/app", "alias/db"}
+	cases := map[string]bool{
+		"alias/app": true,
+		"db":        true,
+		"alias/log": false,
+		"log":       false,
+	}
+	for alias, expected := range cases {
+		if got := kmsKeyHasAlias(aliases, alias); got != expected {
+			t.Errorf("kmsKeyHasAlias(%#v, %q) = %t, expected %t", aliases, alias, got, expected)
+		}
+	}
+	if kmsKeyHasAlias(nil, "app") {
+		t.Errorf("kmsKeyHasAlias of a key without alias should be false")
+	}
+}
+
 const testAccCheckAlicloudKmsKeyDataSourceBasic = `
 resource "alicloud_kms_key" "key" {
     description = "Terraform acc test datasource"
@@ -0,0 +1,161 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudKmsSecrets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudKmsSecretsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"with_secret_value": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"version_stage": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  KmsSecretCurrentStage,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"secrets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"planned_delete_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_data": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudKmsSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allSecrets, err := client.DescribeKmsSecrets()
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredSecrets []KmsSecret
+	for _, secret := range allSecrets {
+		if nameRegex != nil && !nameRegex.MatchString(secret.SecretName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[secret.SecretName] {
+			continue
+		}
+		filteredSecrets = append(filteredSecrets, secret)
+	}
+
+	if len(filteredSecrets) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_kms_secrets - Secrets found: %#v", filteredSecrets)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, secret := range filteredSecrets {
+		mapping := map[string]interface{}{
+			"id":                  secret.SecretName,
+			"secret_name":         secret.SecretName,
+			"creation_time":       secret.CreateTime,
+			"update_time":         secret.UpdateTime,
+			"planned_delete_time": secret.PlannedDeleteTime,
+		}
+		// The values are only read when asked, and they are never logged.
+		if d.Get("with_secret_value").(bool) {
+			value, err := client.GetKmsSecretValue(secret.SecretName, d.Get("version_stage").(string))
+			if err != nil {
+				return err
+			}
+			mapping["version_id"] = value.VersionId
+			mapping["secret_data_type"] = value.SecretDataType
+			mapping["secret_data"] = value.SecretData
+		}
+		ids = append(ids, secret.SecretName)
+		names = append(names, secret.SecretName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("secrets", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package alicloud
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudKmsSecretsDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudKmsSecrets().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudKmsSecretsDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudKmsSecretsDataSourceEmpty,
+				ExpectError: regexp.MustCompile("Your query returned no results"),
+			},
+		},
+	})
+}
+
+func TestKmsSecretsRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, KmsCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("Action") {
+		case "ListSecrets":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","TotalCount":3,"SecretList":{"Secret":[` +
+				`{"SecretName":"tf-test-db","CreateTime":"2020-01-01T00:00:00Z"},` +
+				`{"SecretName":"tf-test-app","CreateTime":"2020-01-02T00:00:00Z"},` +
+				`{"SecretName":"other","CreateTime":"2020-01-03T00:00:00Z"}]}}`))
+		case "GetSecretValue":
+			if stage := r.FormValue("VersionStage"); stage != KmsSecretCurrentStage {
+				t.Errorf("Expected the version stage %s, got %s", KmsSecretCurrentStage, stage)
+			}
+			name := r.FormValue("SecretName")
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","SecretName":"` + name + `","VersionId":"v1","SecretDataType":"text","SecretData":"` + name + `-value"}`))
+		default:
+			t.Errorf("Unexpected action %s", r.FormValue("Action"))
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudKmsSecrets().Schema, map[string]interface{}{
+		"name_regex":        "^tf-test-",
+		"with_secret_value": true,
+	})
+	if err := dataSourceAlicloudKmsSecretsRead(d, client); err != nil {
+		t.Fatalf("Reading the secrets got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"ids.#":                      "2",
+		"names.#":                    "2",
+		"names.0":                    "tf-test-db",
+		"names.1":                    "tf-test-app",
+		"secrets.#":                  "2",
+		"secrets.0.secret_name":      "tf-test-db",
+		"secrets.0.creation_time":    "2020-01-01T00:00:00Z",
+		"secrets.0.version_id":       "v1",
+		"secrets.0.secret_data_type": "text",
+		"secrets.0.secret_data":      "tf-test-db-value",
+		"secrets.1.secret_data":      "tf-test-app-value",
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+const testAccCheckAlicloudKmsSecretsDataSourceEmpty = `
+data "alicloud_kms_secrets" "default" {
+  name_regex = "^tf-testAccKmsSecretsDataSource-none$"
+}
+`
@@ -0,0 +1,197 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudKVStoreInstanceClasses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudKVStoreInstanceClassesRead,
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(KVStoreRedis),
+				ValidateFunc: validateAllowedStringValue([]string{string(KVStoreRedis), string(KVStoreMemcache)}),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      PostPaid,
+				ValidateFunc: validateAllowedStringValue([]string{string(PostPaid), string(PrePaid)}),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"architecture": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					KVStoreArchitectureStandard,
+					KVStoreArchitectureCluster,
+					KVStoreArchitectureReadSplit,
+				}),
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"edition_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_classes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"engine_versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shard_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudKVStoreInstanceClassesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	resources, err := client.DescribeKVStoreAvailableResources(d.Get("engine").(string),
+		d.Get("instance_charge_type").(string), d.Get("zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// An instance class is returned once for every zone and engine version which supports it.
+	classes := make(map[string]KVStoreAvailableResource)
+	zoneIds := make(map[string]map[string]bool)
+	versions := make(map[string]map[string]bool)
+	for _, res := range resources {
+		if v, ok := d.GetOk("engine_version"); ok && res.EngineVersion != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("architecture"); ok && res.Architecture != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("node_type"); ok && res.NodeType != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("edition_type"); ok && res.EditionType != v.(string) {
+			continue
+		}
+		if _, ok := classes[res.InstanceClass]; !ok {
+			classes[res.InstanceClass] = res
+			zoneIds[res.InstanceClass] = make(map[string]bool)
+			versions[res.InstanceClass] = make(map[string]bool)
+		}
+		zoneIds[res.InstanceClass][res.ZoneId] = true
+		versions[res.InstanceClass][res.EngineVersion] = true
+	}
+
+	if len(classes) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	var ids []string
+	for class := range classes {
+		ids = append(ids, class)
+	}
+	sort.Strings(ids)
+
+	log.Printf("[DEBUG] alicloud_kvstore_instance_classes - Instance classes found: %#v", ids)
+
+	var s []map[string]interface{}
+	for _, class := range ids {
+		res := classes[class]
+		mapping := map[string]interface{}{
+			"instance_class":  class,
+			"zone_ids":        sortedKVStoreKeys(zoneIds[class]),
+			"engine_versions": sortedKVStoreKeys(versions[class]),
+			"architecture":    res.Architecture,
+			"node_type":       res.NodeType,
+			"shard_number":    res.ShardNumber,
+			"description":     res.Remark,
+		}
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("instance_classes", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
+
+func sortedKVStoreKeys(set map[string]bool) (keys []string) {
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
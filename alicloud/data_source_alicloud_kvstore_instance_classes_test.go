@@ -0,0 +1,107 @@
+package alicloud
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudKVStoreInstanceClassesDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudKVStoreInstanceClasses().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudKVStoreInstanceClassesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudKVStoreInstanceClassesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_kvstore_instance_classes.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_kvstore_instance_classes.default", "ids.#"),
+					resource.TestCheckResourceAttrSet("data.alicloud_kvstore_instance_classes.default", "instance_classes.0.instance_class"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instance_classes.default", "instance_classes.0.architecture", KVStoreArchitectureCluster),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instance_classes.default", "instance_classes.0.engine_versions.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instance_classes.default", "instance_classes.0.engine_versions.0", "5.0"),
+					resource.TestCheckResourceAttrSet("data.alicloud_kvstore_instance_classes.default", "instance_classes.0.shard_number"),
+				),
+			},
+		},
+	})
+}
+
+func TestKVStoreInstanceClassesRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, KVStoreCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if action := r.FormValue("Action"); action != "DescribeAvailableResource" {
+			t.Errorf("Unexpected action %s", action)
+		}
+		if orderType := r.FormValue("OrderType"); orderType != "BUY" {
+			t.Errorf("Expected the order type BUY, got %q", orderType)
+		}
+		version := func(version, architecture, shardNumber, class string) string {
+			return `{"Version":"` + version + `","SupportedArchitectureTypes":{"SupportedArchitectureType":[{"Architecture":"` + architecture + `",` +
+				`"SupportedShardNumbers":{"SupportedShardNumber":[{"ShardNumber":"` + shardNumber + `","SupportedNodeTypes":{"SupportedNodeType":[` +
+				`{"SupportedNodeType":"double","AvailableResources":{"AvailableResource":[{"InstanceClass":"` + class + `","InstanceClassRemark":"` + class + ` remark"}]}}]}}]}}]}}`
+		}
+		zone := func(zoneId string, versions ...string) string {
+			return `{"ZoneId":"` + zoneId + `","SupportedEngines":{"SupportedEngine":[{"Engine":"Redis","SupportedEditionTypes":{"SupportedEditionType":[` +
+				`{"EditionType":"Community","SupportedSeriesTypes":{"SupportedSeriesType":[{"SeriesType":"enhanced_performance_type",` +
+				`"SupportedEngineVersions":{"SupportedEngineVersion":[` + strings.Join(versions, ",") + `]}}]}}]}}]}}`
+		}
+		w.Write([]byte(`{"RequestId":"A1B2C3D4","AvailableZones":{"AvailableZone":[` +
+			zone("cn-hangzhou-h",
+				version("5.0", KVStoreArchitectureCluster, "8", "redis.logic.sharding.2g.8db.0rodb.8proxy.default"),
+				version("4.0", KVStoreArchitectureCluster, "8", "redis.logic.sharding.2g.8db.0rodb.8proxy.default"),
+				version("5.0", KVStoreArchitectureStandard, "1", "redis.master.small.default")) + `,` +
+			zone("cn-hangzhou-b",
+				version("5.0", KVStoreArchitectureCluster, "8", "redis.logic.sharding.2g.8db.0rodb.8proxy.default")) + `]}}`))
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudKVStoreInstanceClasses().Schema, map[string]interface{}{
+		"engine":       "Redis",
+		"architecture": KVStoreArchitectureCluster,
+	})
+	if err := dataSourceAlicloudKVStoreInstanceClassesRead(d, client); err != nil {
+		t.Fatalf("Reading the instance classes got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"ids.#":                                "1",
+		"instance_classes.#":                   "1",
+		"instance_classes.0.instance_class":    "redis.logic.sharding.2g.8db.0rodb.8proxy.default",
+		"instance_classes.0.zone_ids.#":        "2",
+		"instance_classes.0.zone_ids.0":        "cn-hangzhou-b",
+		"instance_classes.0.zone_ids.1":        "cn-hangzhou-h",
+		"instance_classes.0.engine_versions.#": "2",
+		"instance_classes.0.engine_versions.0": "4.0",
+		"instance_classes.0.engine_versions.1": "5.0",
+		"instance_classes.0.architecture":      KVStoreArchitectureCluster,
+		"instance_classes.0.node_type":         "double",
+		"instance_classes.0.shard_number":      "8",
+		"instance_classes.0.description":       "redis.logic.sharding.2g.8db.0rodb.8proxy.default remark",
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+const testAccCheckAlicloudKVStoreInstanceClassesDataSourceConfig = `
+data "alicloud_kvstore_instance_classes" "default" {
+  engine         = "Redis"
+  engine_version = "5.0"
+  architecture   = "cluster"
+}
+`
@@ -0,0 +1,264 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudKVStoreInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudKVStoreInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids": idsSchema(),
+			"instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(KVStoreRedis), string(KVStoreMemcache)}),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"architecture_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					KVStoreArchitectureStandard,
+					KVStoreArchitectureCluster,
+					KVStoreArchitectureReadSplit,
+				}),
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags":  tagsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"architecture_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"charge_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connection_domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bandwidth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"connections": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"qps": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expire_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudKVStoreInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	filters := map[string]string{
+		"InstanceType":     d.Get("instance_type").(string),
+		"InstanceStatus":   d.Get("status").(string),
+		"ArchitectureType": d.Get("architecture_type").(string),
+		"VpcId":            d.Get("vpc_id").(string),
+		"VSwitchId":        d.Get("vswitch_id").(string),
+	}
+	tags := make(map[string]string)
+	if v, ok := d.GetOk("tags"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			tags[key] = value.(string)
+		}
+	}
+
+	allInstances, err := client.DescribeKVStoreInstances(filters, tags)
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredInstances []KVStoreInstance
+	for _, instance := range allInstances {
+		if nameRegex != nil && !nameRegex.MatchString(instance.InstanceName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[instance.InstanceId] {
+			continue
+		}
+		filteredInstances = append(filteredInstances, instance)
+	}
+
+	if len(filteredInstances) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_kvstore_instances - KVStore instances found: %#v", filteredInstances)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, instance := range filteredInstances {
+		mapping := map[string]interface{}{
+			"id":                instance.InstanceId,
+			"name":              instance.InstanceName,
+			"instance_type":     instance.InstanceType,
+			"instance_class":    instance.InstanceClass,
+			"engine_version":    instance.EngineVersion,
+			"architecture_type": instance.ArchitectureType,
+			"node_type":         instance.NodeType,
+			"status":            instance.InstanceStatus,
+			"charge_type":       instance.ChargeType,
+			"region_id":         instance.RegionId,
+			"availability_zone": instance.ZoneId,
+			"network_type":      instance.NetworkType,
+			"vpc_id":            instance.VpcId,
+			"vswitch_id":        instance.VSwitchId,
+			"private_ip":        instance.PrivateIp,
+			"connection_domain": instance.ConnectionDomain,
+			"port":              instance.Port,
+			"capacity":          int(instance.Capacity),
+			"bandwidth":         int(instance.Bandwidth),
+			"connections":       int(instance.Connections),
+			"qps":               int(instance.QPS),
+			"create_time":       instance.CreateTime,
+			"expire_time":       instance.EndTime,
+		}
+		ids = append(ids, instance.InstanceId)
+		names = append(names, instance.InstanceName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("instances", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
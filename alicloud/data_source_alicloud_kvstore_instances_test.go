@@ -0,0 +1,90 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudKVStoreInstancesDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudKVStoreInstances().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudKVStoreInstancesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudKVStoreInstancesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_kvstore_instances.default"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instances.default", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.alicloud_kvstore_instances.default", "instances.0.id", "alicloud_kvstore_instance.foo", "id"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instances.default", "instances.0.name", "tf-testAccKVStoreInstancesDataSource"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instances.default", "instances.0.instance_type", string(KVStoreRedis)),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instances.default", "instances.0.instance_class", "redis.master.small.default"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instances.default", "instances.0.engine_version", "4.0"),
+					resource.TestCheckResourceAttrPair("data.alicloud_kvstore_instances.default", "instances.0.vswitch_id", "alicloud_vswitch.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_kvstore_instances.default", "instances.0.connection_domain"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instances.default", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instances.default", "names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudKVStoreInstancesDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudKVStoreInstancesDataSourceEmpty,
+				ExpectError: regexp.MustCompile("Your query returned no results"),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudKVStoreInstancesDataSourceConfig = `
+data "alicloud_kvstore_zones" "default" {}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccKVStoreInstancesDataSource"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/24"
+  availability_zone = "${data.alicloud_kvstore_zones.default.zones.0.id}"
+}
+
+resource "alicloud_kvstore_instance" "foo" {
+  instance_name  = "tf-testAccKVStoreInstancesDataSource"
+  instance_class = "redis.master.small.default"
+  engine_version = "4.0"
+  vswitch_id     = "${alicloud_vswitch.foo.id}"
+  security_ips   = ["10.0.0.0/8"]
+}
+
+data "alicloud_kvstore_instances" "default" {
+  name_regex = "${alicloud_kvstore_instance.foo.instance_name}"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+`
+
+const testAccCheckAlicloudKVStoreInstancesDataSourceEmpty = `
+data "alicloud_kvstore_instances" "default" {
+  name_regex = "^tf-testAccKVStoreInstancesDataSource-none$"
+}
+`
@@ -0,0 +1,121 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudKVStoreZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudKVStoreZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(KVStoreRedis),
+				ValidateFunc: validateAllowedStringValue([]string{string(KVStoreRedis), string(KVStoreMemcache)}),
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      PostPaid,
+				ValidateFunc: validateAllowedStringValue([]string{string(PostPaid), string(PrePaid)}),
+			},
+			"multi": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"multi_zone_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudKVStoreZonesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	resources, err := client.DescribeKVStoreAvailableResources(d.Get("engine").(string), d.Get("instance_charge_type").(string), "")
+	if err != nil {
+		return err
+	}
+
+	// KVStore names its multi-zones the same way as RDS, like cn-hangzhou-MAZ5(b,c).
+	multi := d.Get("multi").(bool)
+	zones := make(map[string]bool)
+	for _, res := range resources {
+		if isRdsMultiZone(res.ZoneId) != multi {
+			continue
+		}
+		zones[res.ZoneId] = true
+	}
+
+	if len(zones) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	var ids []string
+	for zone := range zones {
+		ids = append(ids, zone)
+	}
+	sort.Strings(ids)
+
+	log.Printf("[DEBUG] alicloud_kvstore_zones - Zones found: %#v", ids)
+
+	var s []map[string]interface{}
+	for _, id := range ids {
+		mapping := map[string]interface{}{
+			"id":             id,
+			"multi_zone_ids": splitRdsMultiZoneId(id),
+		}
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("zones", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudKVStoreZonesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudKVStoreZonesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_kvstore_zones.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_kvstore_zones.default", "zones.#"),
+					resource.TestCheckResourceAttrSet("data.alicloud_kvstore_zones.default", "zones.0.id"),
+					testAccCheckAlicloudDataSourceID("data.alicloud_kvstore_instance_classes.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_kvstore_instance_classes.default", "instance_classes.0.instance_class"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instance_classes.default", "instance_classes.0.architecture", "standard"),
+					resource.TestCheckResourceAttr("data.alicloud_kvstore_instance_classes.default", "instance_classes.0.zone_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudKVStoreZonesDataSourceConfig = `
+data "alicloud_kvstore_zones" "default" {
+  engine = "Redis"
+}
+
+data "alicloud_kvstore_instance_classes" "default" {
+  engine       = "Redis"
+  architecture = "standard"
+  zone_id      = "${data.alicloud_kvstore_zones.default.zones.0.id}"
+}
+`
@@ -0,0 +1,181 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudMarketProducts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudMarketProductsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"product_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  MarketProductImage,
+			},
+			"category_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"search_term": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"products": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"supplier_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"short_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delivery_way": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"price_info": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudMarketProductsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	filters := map[string]string{
+		"productType": d.Get("product_type").(string),
+	}
+	if v, ok := d.GetOk("category_id"); ok {
+		filters["categoryId"] = strconv.Itoa(v.(int))
+	}
+	if v, ok := d.GetOk("search_term"); ok {
+		filters["searchTerm"] = v.(string)
+	}
+	allProducts, err := client.DescribeMarketProducts(filters)
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredProducts []MarketProduct
+	for _, product := range allProducts {
+		if nameRegex != nil && !nameRegex.MatchString(product.Name) {
+			continue
+		}
+		if idsMap != nil && !idsMap[product.Code] {
+			continue
+		}
+		filteredProducts = append(filteredProducts, product)
+	}
+
+	if len(filteredProducts) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_market_products - Products found: %#v", filteredProducts)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, product := range filteredProducts {
+		mapping := map[string]interface{}{
+			"id":                product.Code,
+			"code":              product.Code,
+			"name":              product.Name,
+			"category_id":       product.CategoryId,
+			"supplier_name":     product.SupplierName,
+			"short_description": product.ShortDescription,
+			"delivery_way":      product.DeliveryWay,
+			"price_info":        product.PriceInfo,
+			"score":             product.Score,
+			"image_url":         product.ImageUrl,
+			"target_url":        product.TargetUrl,
+		}
+		ids = append(ids, product.Code)
+		names = append(names, product.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("products", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
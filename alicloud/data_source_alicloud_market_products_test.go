@@ -0,0 +1,80 @@
+package alicloud
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudMarketProductsDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudMarketProducts().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudMarketProductsDataSource_searchTerm(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudMarketProductsDataSourceSearchTermConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_market_products.images"),
+					resource.TestCheckResourceAttrSet("data.alicloud_market_products.images", "names.0"),
+					resource.TestCheckResourceAttrSet("data.alicloud_market_products.images", "products.0.code"),
+					resource.TestCheckResourceAttrSet("data.alicloud_market_products.images", "products.0.name"),
+					resource.TestCheckResourceAttrSet("data.alicloud_market_products.images", "products.0.supplier_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestMarketProductsRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, MarketCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if action := r.FormValue("Action"); action != "DescribeProducts" {
+			t.Errorf("Unexpected action %s", action)
+		}
+		if key, value := r.FormValue("Filter.1.Key"), r.FormValue("Filter.1.Value"); key != "productType" || value != MarketProductImage {
+			t.Errorf("Expected the filter productType is %s, got %s=%s", MarketProductImage, key, value)
+		}
+		w.Write([]byte(`{"RequestId":"A1B2C3D4","TotalCount":2,"ProductItems":{"ProductItem":[` +
+			`{"Code":"cmjj000001","Name":"LNMP on CentOS","CategoryId":53616009,"SupplierName":"tf-supplier"},` +
+			`{"Code":"cmjj000002","Name":"LAMP on Ubuntu","CategoryId":53616009,"SupplierName":"tf-supplier"}]}}`))
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudMarketProducts().Schema, map[string]interface{}{
+		"name_regex": "^LNMP",
+	})
+	if err := dataSourceAlicloudMarketProductsRead(d, client); err != nil {
+		t.Fatalf("Reading the products got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"ids.#":                    "1",
+		"ids.0":                    "cmjj000001",
+		"names.#":                  "1",
+		"names.0":                  "LNMP on CentOS",
+		"products.#":               "1",
+		"products.0.code":          "cmjj000001",
+		"products.0.supplier_name": "tf-supplier",
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+const testAccCheckAlicloudMarketProductsDataSourceSearchTermConfig = `
+data "alicloud_market_products" "images" {
+  search_term = "LNMP"
+}`
@@ -0,0 +1,225 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudNetworkAcls() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudNetworkAclsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"acls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ingress_entries": networkAclEntriesSchema("source_cidr_ip"),
+						"egress_entries":  networkAclEntriesSchema("destination_cidr_ip"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func networkAclEntriesSchema(cidrIpKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"policy": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				cidrIpKey: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudNetworkAclsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	filters := make(map[string]string)
+	if v, ok := d.GetOk("vpc_id"); ok {
+		filters["VpcId"] = v.(string)
+	}
+	if v, ok := d.GetOk("vswitch_id"); ok {
+		filters["ResourceId"] = v.(string)
+		filters["ResourceType"] = NetworkAclResourceVSwitch
+	}
+
+	allAcls, err := client.DescribeNetworkAcls(filters)
+	if err != nil {
+		return fmt.Errorf("DescribeNetworkAcls got an error: %#v", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredAcls []NetworkAcl
+	for _, acl := range allAcls {
+		if nameRegex != nil && !nameRegex.MatchString(acl.NetworkAclName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[acl.NetworkAclId] {
+			continue
+		}
+		filteredAcls = append(filteredAcls, acl)
+	}
+
+	if len(filteredAcls) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_network_acls - Network ACLs found: %#v", filteredAcls)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, acl := range filteredAcls {
+		var vswitchIds []string
+		for _, res := range acl.Resources.Resource {
+			if res.ResourceType == NetworkAclResourceVSwitch {
+				vswitchIds = append(vswitchIds, res.ResourceId)
+			}
+		}
+		var ingress []map[string]interface{}
+		for _, entry := range acl.IngressAclEntries.IngressAclEntry {
+			ingress = append(ingress, map[string]interface{}{
+				"name":           entry.NetworkAclEntryName,
+				"description":    entry.Description,
+				"policy":         entry.Policy,
+				"protocol":       entry.Protocol,
+				"port":           entry.Port,
+				"source_cidr_ip": entry.SourceCidrIp,
+			})
+		}
+		var egress []map[string]interface{}
+		for _, entry := range acl.EgressAclEntries.EgressAclEntry {
+			egress = append(egress, map[string]interface{}{
+				"name":                entry.NetworkAclEntryName,
+				"description":         entry.Description,
+				"policy":              entry.Policy,
+				"protocol":            entry.Protocol,
+				"port":                entry.Port,
+				"destination_cidr_ip": entry.DestinationCidrIp,
+			})
+		}
+		mapping := map[string]interface{}{
+			"id":              acl.NetworkAclId,
+			"name":            acl.NetworkAclName,
+			"description":     acl.Description,
+			"vpc_id":          acl.VpcId,
+			"status":          acl.Status,
+			"creation_time":   acl.CreationTime,
+			"vswitch_ids":     vswitchIds,
+			"ingress_entries": ingress,
+			"egress_entries":  egress,
+		}
+		ids = append(ids, acl.NetworkAclId)
+		names = append(names, acl.NetworkAclName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("acls", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
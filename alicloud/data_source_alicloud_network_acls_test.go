@@ -0,0 +1,35 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudNetworkAclsDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudNetworkAcls().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudNetworkAclsDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudNetworkAclsDataSourceEmpty,
+				ExpectError: regexp.MustCompile("Your query returned no results"),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudNetworkAclsDataSourceEmpty = `
+data "alicloud_network_acls" "default" {
+  name_regex = "^tf-testAccNetworkAclsDataSource-none$"
+}
+`
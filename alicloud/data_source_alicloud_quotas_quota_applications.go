@@ -0,0 +1,170 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudQuotasQuotaApplications() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudQuotasQuotaApplicationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"product_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": idsSchema(),
+			"quota_action_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{QuotasApplicationDisagree, QuotasApplicationProcess, QuotasApplicationAgree}),
+			},
+			"dimensions": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"applications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_action_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"desire_value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"approve_value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"audit_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"apply_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"effective_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expire_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudQuotasQuotaApplicationsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"ProductCode": d.Get("product_code").(string),
+	}
+	if v, ok := d.GetOk("quota_action_code"); ok {
+		params["QuotaActionCode"] = v.(string)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		params["Status"] = v.(string)
+	}
+	setQuotasDimensionParams(params, d.Get("dimensions").(map[string]interface{}))
+	allApplications, err := client.DescribeQuotasQuotaApplications(params)
+	if err != nil {
+		return err
+	}
+
+	idsMap := idsFilter(d)
+
+	var filteredApplications []QuotasQuotaApplication
+	for _, application := range allApplications {
+		if idsMap != nil && !idsMap[application.ApplicationId] {
+			continue
+		}
+		filteredApplications = append(filteredApplications, application)
+	}
+
+	if len(filteredApplications) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_quotas_quota_applications - Applications found: %#v", filteredApplications)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, application := range filteredApplications {
+		mapping := map[string]interface{}{
+			"id":                application.ApplicationId,
+			"quota_action_code": application.QuotaActionCode,
+			"quota_name":        application.QuotaName,
+			"desire_value":      application.DesireValue,
+			"approve_value":     application.ApproveValue,
+			"reason":            application.Reason,
+			"audit_reason":      application.AuditReason,
+			"status":            application.Status,
+			"dimensions":        application.Dimension,
+			"apply_time":        application.ApplyTime,
+			"effective_time":    application.EffectiveTime,
+			"expire_time":       application.ExpireTime,
+		}
+		ids = append(ids, application.ApplicationId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("applications", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudQuotasQuotas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudQuotasQuotasRead,
+
+		Schema: map[string]*schema.Schema{
+			"product_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"quota_category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"CommonQuota", "FlowControl"}),
+			},
+			"key_word": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dimensions": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_action_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_quota": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"total_usage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"adjustable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"applicable_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"applicable_range": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+							Computed: true,
+						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudQuotasQuotasRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"ProductCode": d.Get("product_code").(string),
+	}
+	if v, ok := d.GetOk("quota_category"); ok {
+		params["QuotaCategory"] = v.(string)
+	}
+	if v, ok := d.GetOk("key_word"); ok {
+		params["KeyWord"] = v.(string)
+	}
+	setQuotasDimensionParams(params, d.Get("dimensions").(map[string]interface{}))
+	allQuotas, err := client.DescribeQuotasQuotas(params)
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredQuotas []QuotasQuota
+	for _, quota := range allQuotas {
+		if nameRegex != nil && !nameRegex.MatchString(quota.QuotaName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[quota.QuotaActionCode] {
+			continue
+		}
+		filteredQuotas = append(filteredQuotas, quota)
+	}
+
+	if len(filteredQuotas) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_quotas_quotas - Quotas found: %#v", filteredQuotas)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, quota := range filteredQuotas {
+		mapping := map[string]interface{}{
+			"id":                quota.QuotaActionCode,
+			"quota_action_code": quota.QuotaActionCode,
+			"quota_name":        quota.QuotaName,
+			"quota_description": quota.QuotaDescription,
+			"quota_type":        quota.QuotaType,
+			"quota_unit":        quota.QuotaUnit,
+			"total_quota":       quota.TotalQuota,
+			"total_usage":       quota.TotalUsage,
+			"adjustable":        quota.Adjustable,
+			"applicable_type":   quota.ApplicableType,
+			"applicable_range":  quota.ApplicableRange,
+			"dimensions":        quota.Dimensions,
+		}
+		ids = append(ids, quota.QuotaActionCode)
+		names = append(names, quota.QuotaName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("quotas", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package alicloud
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudQuotasQuotasDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudQuotasQuotas().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudQuotasQuotasDataSource_ids(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudQuotasQuotasDataSourceIdsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_quotas_quotas.ecs"),
+					resource.TestCheckResourceAttr("data.alicloud_quotas_quotas.ecs", "quotas.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_quotas_quotas.ecs", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_quotas_quotas.ecs", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_quotas_quotas.ecs", "quotas.0.quota_action_code", "q_prepaid-instance-count-per-once-purchase"),
+					resource.TestCheckResourceAttrSet("data.alicloud_quotas_quotas.ecs", "quotas.0.total_quota"),
+				),
+			},
+		},
+	})
+}
+
+func TestQuotasQuotasRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, QuotasCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if action := r.FormValue("Action"); action != "ListProductQuotas" {
+			t.Errorf("Unexpected action %s", action)
+		}
+		if r.FormValue("ProductCode") != "ecs" || r.FormValue("Dimensions.1.Key") != "regionId" || r.FormValue("Dimensions.1.Value") != "cn-hangzhou" {
+			t.Errorf("Unexpected request %s", r.Form.Encode())
+		}
+		// The quotas are listed in two pages.
+		switch r.FormValue("NextToken") {
+		case "":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","NextToken":"page-2","Quotas":[{"QuotaActionCode":"q_desktop-count",` +
+				`"QuotaName":"Desktops","TotalQuota":10,"TotalUsage":2,"Adjustable":true,"Dimensions":{"regionId":"cn-hangzhou"}}]}`))
+		default:
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Quotas":[{"QuotaActionCode":"q_prepaid-instance-count-per-once-purchase",` +
+				`"QuotaName":"Instances per purchase","TotalQuota":100,"ApplicableRange":[100,1000]}]}`))
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudQuotasQuotas().Schema, map[string]interface{}{
+		"product_code": "ecs",
+		"dimensions":   map[string]interface{}{"regionId": "cn-hangzhou"},
+	})
+	if err := dataSourceAlicloudQuotasQuotasRead(d, client); err != nil {
+		t.Fatalf("Reading the quotas got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"ids.#":                        "2",
+		"names.#":                      "2",
+		"names.0":                      "Desktops",
+		"names.1":                      "Instances per purchase",
+		"quotas.0.id":                  "q_desktop-count",
+		"quotas.0.total_usage":         "2",
+		"quotas.0.adjustable":          "true",
+		"quotas.0.dimensions.regionId": "cn-hangzhou",
+		"quotas.1.applicable_range.#":  "2",
+		"quotas.1.applicable_range.1":  "1000",
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+const testAccCheckAlicloudQuotasQuotasDataSourceIdsConfig = `
+data "alicloud_quotas_quotas" "ecs" {
+  product_code = "ecs"
+  ids          = ["q_prepaid-instance-count-per-once-purchase"]
+}`
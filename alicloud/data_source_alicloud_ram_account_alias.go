@@ -13,6 +13,7 @@ func dataSourceAlicloudRamAccountAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 			"account_alias": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -22,7 +23,7 @@ func dataSourceAlicloudRamAccountAlias() *schema.Resource {
 }
 
 func dataSourceAlicloudRamAccountAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	resp, err := conn.GetAccountAlias()
 	if err != nil {
@@ -33,7 +34,7 @@ func dataSourceAlicloudRamAccountAliasRead(d *schema.ResourceData, meta interfac
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
 		s := map[string]interface{}{"account_alias": resp.AccountAlias}
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -37,10 +37,13 @@ func dataSourceAlicloudRamGroups() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validatePolicyType,
 			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"groups": {
@@ -64,7 +67,7 @@ func dataSourceAlicloudRamGroups() *schema.Resource {
 }
 
 func dataSourceAlicloudRamGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	allGroups := []interface{}{}
 
 	allGroupsMap := make(map[string]interface{})
@@ -77,6 +80,7 @@ func dataSourceAlicloudRamGroupsRead(d *schema.ResourceData, meta interface{}) e
 	policyName, policyNameOk := d.GetOk("policy_name")
 	policyType, policyTypeOk := d.GetOk("policy_type")
 	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	idsMap := idsFilter(d)
 
 	if policyTypeOk && !policyNameOk {
 		return fmt.Errorf("You must set 'policy_name' at one time when you set 'policy_type'.")
@@ -96,6 +100,9 @@ func dataSourceAlicloudRamGroupsRead(d *schema.ResourceData, meta interface{}) e
 					continue
 				}
 			}
+			if idsMap != nil && !idsMap[v.GroupName] {
+				continue
+			}
 			allGroupsMap[v.GroupName] = v
 		}
 		if !resp.IsTruncated {
@@ -164,10 +171,17 @@ func ramGroupsDescriptionAttributes(d *schema.ResourceData, groups []interface{}
 	if err := d.Set("groups", s); err != nil {
 		return err
 	}
+	// The name of a RAM group is its id.
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", ids); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -43,10 +43,13 @@ func dataSourceAlicloudRamPolicies() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateRamName,
 			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"policies": {
@@ -94,7 +97,7 @@ func dataSourceAlicloudRamPolicies() *schema.Resource {
 }
 
 func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	allPolicies := []interface{}{}
 
 	allPoliciesMap := make(map[string]interface{})
@@ -109,6 +112,7 @@ func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{})
 	roleName, roleNameOk := d.GetOk("role_name")
 	policyType, policyTypeOk := d.GetOk("type")
 	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	idsMap := idsFilter(d)
 
 	// policies filtered by name_regex and type
 	args := ram.PolicyQueryRequest{}
@@ -127,6 +131,9 @@ func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{})
 					continue
 				}
 			}
+			if idsMap != nil && !idsMap[v.PolicyName] {
+				continue
+			}
 			allPoliciesMap[v.PolicyType+v.PolicyName] = v
 		}
 		if !resp.IsTruncated {
@@ -187,7 +194,7 @@ func dataSourceAlicloudRamPoliciesRead(d *schema.ResourceData, meta interface{})
 }
 
 func ramPoliciesDescriptionAttributes(d *schema.ResourceData, policies []interface{}, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	var ids []string
 	var s []map[string]interface{}
 	for _, v := range policies {
@@ -221,10 +228,17 @@ func ramPoliciesDescriptionAttributes(d *schema.ResourceData, policies []interfa
 	if err := d.Set("policies", s); err != nil {
 		return err
 	}
+	// The name of a RAM policy is its id.
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", ids); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -31,10 +31,13 @@ func dataSourceAlicloudRamRoles() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validatePolicyType,
 			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"roles": {
@@ -82,7 +85,7 @@ func dataSourceAlicloudRamRoles() *schema.Resource {
 }
 
 func dataSourceAlicloudRamRolesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	allRoles := []interface{}{}
 
 	allRolesMap := make(map[string]interface{})
@@ -93,6 +96,7 @@ func dataSourceAlicloudRamRolesRead(d *schema.ResourceData, meta interface{}) er
 	policyName, policyNameOk := d.GetOk("policy_name")
 	policyType, policyTypeOk := d.GetOk("policy_type")
 	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	idsMap := idsFilter(d)
 
 	if policyTypeOk && !policyNameOk {
 		return fmt.Errorf("You must set 'policy_name' at one time when you set 'policy_type'.")
@@ -110,6 +114,9 @@ func dataSourceAlicloudRamRolesRead(d *schema.ResourceData, meta interface{}) er
 				continue
 			}
 		}
+		if idsMap != nil && !idsMap[v.RoleId] {
+			continue
+		}
 		allRolesMap[v.RoleName] = v
 	}
 
@@ -144,10 +151,11 @@ func dataSourceAlicloudRamRolesRead(d *schema.ResourceData, meta interface{}) er
 
 func ramRolesDescriptionAttributes(d *schema.ResourceData, meta interface{}, roles []interface{}) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 	for _, v := range roles {
 		role := v.(ram.Role)
-		conn := meta.(*AliyunClient).ramconn
+		conn := meta.(*AliyunClient).ramconn()
 		resp, _ := conn.GetRole(ram.RoleQueryRequest{RoleName: role.RoleName})
 		mapping := map[string]interface{}{
 			"id":                          role.RoleId,
@@ -161,6 +169,7 @@ func ramRolesDescriptionAttributes(d *schema.ResourceData, meta interface{}, rol
 		}
 		log.Printf("[DEBUG] alicloud_ram_roles - adding role: %v", mapping)
 		ids = append(ids, role.RoleId)
+		names = append(names, role.RoleName)
 		s = append(s, mapping)
 	}
 
@@ -168,10 +177,16 @@ func ramRolesDescriptionAttributes(d *schema.ResourceData, meta interface{}, rol
 	if err := d.Set("roles", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -37,10 +37,13 @@ func dataSourceAlicloudRamUsers() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validatePolicyType,
 			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"users": {
@@ -56,10 +59,22 @@ func dataSourceAlicloudRamUsers() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comments": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"create_date": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"update_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"last_login_date": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -72,7 +87,7 @@ func dataSourceAlicloudRamUsers() *schema.Resource {
 }
 
 func dataSourceAlicloudRamUsersRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	allUsers := []interface{}{}
 
 	allUsersMap := make(map[string]interface{})
@@ -85,6 +100,7 @@ func dataSourceAlicloudRamUsersRead(d *schema.ResourceData, meta interface{}) er
 	policyName, policyNameOk := d.GetOk("policy_name")
 	policyType, policyTypeOk := d.GetOk("policy_type")
 	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	idsMap := idsFilter(d)
 
 	if policyTypeOk && !policyNameOk {
 		return fmt.Errorf("You must set 'policy_name' at one time when you set 'policy_type'.")
@@ -104,6 +120,9 @@ func dataSourceAlicloudRamUsersRead(d *schema.ResourceData, meta interface{}) er
 					continue
 				}
 			}
+			if idsMap != nil && !idsMap[v.UserId] {
+				continue
+			}
 			allUsersMap[v.UserName] = v
 		}
 		if !resp.IsTruncated {
@@ -156,17 +175,22 @@ func dataSourceAlicloudRamUsersRead(d *schema.ResourceData, meta interface{}) er
 
 func ramUsersDescriptionAttributes(d *schema.ResourceData, users []interface{}) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 	for _, v := range users {
 		user := v.(ram.User)
 		mapping := map[string]interface{}{
 			"id":              user.UserId,
 			"name":            user.UserName,
+			"display_name":    user.DisplayName,
+			"comments":        user.Comments,
 			"create_date":     user.CreateDate,
+			"update_date":     user.UpdateDate,
 			"last_login_date": user.LastLoginDate,
 		}
 		log.Printf("[DEBUG] alicloud_ram_users - adding user: %v", mapping)
 		ids = append(ids, user.UserId)
+		names = append(names, user.UserName)
 		s = append(s, mapping)
 	}
 
@@ -174,10 +198,16 @@ func ramUsersDescriptionAttributes(d *schema.ResourceData, users []interface{})
 	if err := d.Set("users", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -3,9 +3,10 @@ package alicloud
 import (
 	"fmt"
 	"log"
+	"regexp"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -20,15 +21,23 @@ func dataSourceAlicloudRegions() *schema.Resource {
 				Computed: true,
 			},
 
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+			},
+
 			"current": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
 			},
+			"ids": idsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			//Computed value
 			"regions": &schema.Schema{
@@ -48,6 +57,10 @@ func dataSourceAlicloudRegions() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -56,32 +69,42 @@ func dataSourceAlicloudRegions() *schema.Resource {
 }
 
 func dataSourceAlicloudRegionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
 	currentRegion := getRegion(d, meta)
 
-	resp, err := conn.DescribeRegions()
+	resp, err := meta.(*AliyunClient).DescribeEcsRegions()
 	if err != nil {
-		return err
+		return WrapApiError(err, "DescribeRegions", "")
 	}
 	if resp == nil || len(resp) == 0 {
 		return fmt.Errorf("no matching regions found")
 	}
 	name, nameOk := d.GetOk("name")
 	current := d.Get("current").(bool)
-	var filterRegions []ecs.RegionType
+	idsMap := idsFilter(d)
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	var filterRegions []ecs.Region
 	for _, region := range resp {
+		if idsMap != nil && !idsMap[string(region.RegionId)] {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(string(region.RegionId)) {
+			continue
+		}
 		if current {
 			if nameOk && common.Region(name.(string)) != currentRegion {
 				return fmt.Errorf("name doesn't match current region: %#v, please input again.", currentRegion)
 			}
-			if region.RegionId == currentRegion {
+			if region.RegionId == string(currentRegion) {
 				filterRegions = append(filterRegions, region)
 				break
 			}
 			continue
 		}
 		if nameOk {
-			if common.Region(name.(string)) == region.RegionId {
+			if name.(string) == region.RegionId {
 				filterRegions = append(filterRegions, region)
 				break
 			}
@@ -96,7 +119,7 @@ func dataSourceAlicloudRegionsRead(d *schema.ResourceData, meta interface{}) err
 	return regionsDescriptionAttributes(d, filterRegions)
 }
 
-func regionsDescriptionAttributes(d *schema.ResourceData, regions []ecs.RegionType) error {
+func regionsDescriptionAttributes(d *schema.ResourceData, regions []ecs.Region) error {
 	var ids []string
 	var s []map[string]interface{}
 	for _, region := range regions {
@@ -104,6 +127,7 @@ func regionsDescriptionAttributes(d *schema.ResourceData, regions []ecs.RegionTy
 			"id":         region.RegionId,
 			"region_id":  region.RegionId,
 			"local_name": region.LocalName,
+			"endpoint":   region.RegionEndpoint,
 		}
 
 		log.Printf("[DEBUG] alicloud_regions - adding region mapping: %v", mapping)
@@ -115,10 +139,13 @@ func regionsDescriptionAttributes(d *schema.ResourceData, regions []ecs.RegionTy
 	if err := d.Set("regions", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -80,6 +80,24 @@ func TestAccAlicloudRegionsDataSource_empty(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudRegionsDataSource_nameRegex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudRegionsDataSourceNameRegexConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_regions.name_regex_filtered_region"),
+					resource.TestCheckResourceAttr("data.alicloud_regions.name_regex_filtered_region", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_regions.name_regex_filtered_region", "regions.0.id", "cn-hangzhou"),
+					resource.TestCheckResourceAttr("data.alicloud_regions.name_regex_filtered_region", "regions.0.endpoint", "ecs.aliyuncs.com"),
+				),
+			},
+		},
+	})
+}
+
 // Instance store test - using centos regions
 const testAccCheckAlicloudRegionsDataSourceRegionsConfig = `
 data "alicloud_regions" "region" {
@@ -95,6 +113,13 @@ data "alicloud_regions" "name_filtered_region" {
 }
 `
 
+// Testing name_regex parameter
+const testAccCheckAlicloudRegionsDataSourceNameRegexConfig = `
+data "alicloud_regions" "name_regex_filtered_region" {
+	name_regex = "^cn-hangzhou$"
+}
+`
+
 // Testing current parameter
 const testAccCheckAlicloudRegionsDataSourceCurrentConfig = `
 data "alicloud_regions" "current_filtered_region" {
@@ -0,0 +1,203 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudRouteEntries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudRouteEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr_block": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"System", "Custom", "BGP"}),
+			},
+			"nexthop_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"nexthop_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nexthop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nexthop_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nexthop_region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nexthops": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"nexthop_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"nexthop_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"nexthop_region_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"weight": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudRouteEntriesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	routeTableId := d.Get("route_table_id").(string)
+	table, err := client.QueryRouteTableById(routeTableId)
+	if err != nil {
+		return fmt.Errorf("DescribeRouteTables got an error: %#v", err)
+	}
+
+	var filteredEntries []vpc.RouteEntry
+	for _, entry := range table.RouteEntrys.RouteEntry {
+		if v, ok := d.GetOk("cidr_block"); ok && entry.DestinationCidrBlock != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("type"); ok && entry.Type != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("nexthop_type"); ok && !routeEntryHasNextHop(entry, v.(string), "") {
+			continue
+		}
+		if v, ok := d.GetOk("nexthop_id"); ok && !routeEntryHasNextHop(entry, "", v.(string)) {
+			continue
+		}
+		filteredEntries = append(filteredEntries, entry)
+	}
+
+	if len(filteredEntries) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_route_entries - Route entries found: %#v", filteredEntries)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, entry := range filteredEntries {
+		var nextHops []map[string]interface{}
+		for _, hop := range entry.NextHops.NextHop {
+			nextHops = append(nextHops, map[string]interface{}{
+				"nexthop_type":      hop.NextHopType,
+				"nexthop_id":        hop.NextHopId,
+				"nexthop_region_id": hop.NextHopRegionId,
+				"enabled":           hop.Enabled == 1,
+				"weight":            hop.Weight,
+			})
+		}
+		mapping := map[string]interface{}{
+			"route_table_id":    entry.RouteTableId,
+			"cidr_block":        entry.DestinationCidrBlock,
+			"type":              entry.Type,
+			"status":            entry.Status,
+			"nexthop_type":      entry.NextHopType,
+			"nexthop_id":        entry.InstanceId,
+			"nexthop_region_id": entry.NextHopRegionId,
+			"nexthops":          nextHops,
+		}
+		ids = append(ids, entry.DestinationCidrBlock)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(append([]string{routeTableId}, ids...)))
+	if err := d.Set("entries", s); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
+
+// routeEntryHasNextHop returns true if the next hop of the entry, or one of the next hops of an ECMP entry,
+// matches the type and the id. An empty type or id matches any.
+func routeEntryHasNextHop(entry vpc.RouteEntry, nextHopType, nextHopId string) bool {
+	match := func(t, id string) bool {
+		return (nextHopType == "" || t == nextHopType) && (nextHopId == "" || id == nextHopId)
+	}
+	if match(entry.NextHopType, entry.InstanceId) {
+		return true
+	}
+	for _, hop := range entry.NextHops.NextHop {
+		if match(hop.NextHopType, hop.NextHopId) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudRouteEntriesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudRouteEntriesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_route_entries.default"),
+					resource.TestCheckResourceAttr("data.alicloud_route_entries.default", "entries.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_route_entries.default", "entries.0.cidr_block", "172.16.0.0/21"),
+					resource.TestCheckResourceAttr("data.alicloud_route_entries.default", "entries.0.type", "System"),
+					resource.TestCheckResourceAttrSet("data.alicloud_route_entries.default", "entries.0.route_table_id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_route_entries.default", "entries.0.status"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudRouteEntriesDataSourceConfig = `
+data "alicloud_zones" "default" {
+  "available_resource_creation" = "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccRouteEntriesDataSource"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+data "alicloud_route_entries" "default" {
+  route_table_id = "${alicloud_vpc.foo.route_table_id}"
+  cidr_block     = "${alicloud_vswitch.foo.cidr_block}"
+  type           = "System"
+}
+`
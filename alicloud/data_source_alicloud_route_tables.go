@@ -0,0 +1,171 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudRouteTables() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudRouteTablesRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"route_table_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"System", "Custom"}),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"tables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"router_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"router_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_table_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allTables, err := client.DescribeRouteTableList(d.Get("vpc_id").(string))
+	if err != nil {
+		return fmt.Errorf("DescribeRouteTableList got an error: %#v", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredTables []RouteTableListType
+	for _, table := range allTables {
+		if nameRegex != nil && !nameRegex.MatchString(table.RouteTableName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[table.RouteTableId] {
+			continue
+		}
+		if v, ok := d.GetOk("route_table_type"); ok && table.RouteTableType != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("vswitch_id"); ok && !vpcVswitchIdListContains(table.VSwitchIds.VSwitchId, v.(string)) {
+			continue
+		}
+		filteredTables = append(filteredTables, table)
+	}
+
+	if len(filteredTables) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_route_tables - Route tables found: %#v", filteredTables)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, table := range filteredTables {
+		mapping := map[string]interface{}{
+			"id":               table.RouteTableId,
+			"name":             table.RouteTableName,
+			"description":      table.Description,
+			"vpc_id":           table.VpcId,
+			"router_id":        table.RouterId,
+			"router_type":      table.RouterType,
+			"route_table_type": table.RouteTableType,
+			"vswitch_ids":      table.VSwitchIds.VSwitchId,
+			"creation_time":    table.CreationTime,
+		}
+		ids = append(ids, table.RouteTableId)
+		names = append(names, table.RouteTableName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("tables", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudRouteTablesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudRouteTablesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_route_tables.default"),
+					resource.TestCheckResourceAttr("data.alicloud_route_tables.default", "tables.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_route_tables.default", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_route_tables.default", "tables.0.route_table_type", "System"),
+					resource.TestCheckResourceAttr("data.alicloud_route_tables.default", "tables.0.router_type", "VRouter"),
+					resource.TestCheckResourceAttr("data.alicloud_route_tables.default", "tables.0.vswitch_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("data.alicloud_route_tables.default", "tables.0.id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_route_tables.default", "tables.0.router_id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_route_tables.default", "tables.0.creation_time"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudRouteTablesDataSourceConfig = `
+data "alicloud_zones" "default" {
+  "available_resource_creation" = "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccRouteTablesDataSource"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+data "alicloud_route_tables" "default" {
+  vpc_id           = "${alicloud_vswitch.foo.vpc_id}"
+  vswitch_id       = "${alicloud_vswitch.foo.id}"
+  route_table_type = "System"
+}
+`
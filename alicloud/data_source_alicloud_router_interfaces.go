@@ -0,0 +1,290 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudRouterInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudRouterInterfacesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"specification": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"router_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(VRouter), string(VBR)}),
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(InitiatingSide), string(AcceptingSide)}),
+			},
+			"opposite_interface_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"opposite_interface_owner_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"interfaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"specification": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"router_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"router_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_point_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opposite_region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opposite_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opposite_router_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opposite_router_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opposite_interface_owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_source_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_target_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudRouterInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
+
+	args := vpc.CreateDescribeRouterInterfacesRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.PageSize = requests.NewInteger(PageSizeLarge)
+	pageNumber := 1
+	args.PageNumber = requests.NewInteger(pageNumber)
+
+	// The filters are applied by the API, and the others are applied after reading.
+	var filters []vpc.DescribeRouterInterfacesFilter
+	for key, attribute := range map[string]string{
+		"RouterId":   "router_id",
+		"RouterType": "router_type",
+		"Status":     "status",
+	} {
+		if v, ok := d.GetOk(attribute); ok && v.(string) != "" {
+			filters = append(filters, vpc.DescribeRouterInterfacesFilter{
+				Key:   key,
+				Value: &[]string{v.(string)},
+			})
+		}
+	}
+	if len(filters) > 0 {
+		args.Filter = &filters
+	}
+
+	var allInterfaces []vpc.RouterInterfaceType
+	for {
+		resp := vpc.CreateDescribeRouterInterfacesResponse()
+		err := client.doAction(conn, args, resp)
+		if err != nil {
+			return fmt.Errorf("DescribeRouterInterfaces got an error: %#v", err)
+		}
+
+		if resp == nil || len(resp.RouterInterfaceSet.RouterInterfaceType) < 1 {
+			break
+		}
+
+		allInterfaces = append(allInterfaces, resp.RouterInterfaceSet.RouterInterfaceType...)
+
+		if len(resp.RouterInterfaceSet.RouterInterfaceType) < PageSizeLarge {
+			break
+		}
+
+		pageNumber++
+		args.PageNumber = requests.NewInteger(pageNumber)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredInterfaces []vpc.RouterInterfaceType
+	for _, ri := range allInterfaces {
+		if nameRegex != nil && !nameRegex.MatchString(ri.Name) {
+			continue
+		}
+		if idsMap != nil && !idsMap[ri.RouterInterfaceId] {
+			continue
+		}
+		if v, ok := d.GetOk("specification"); ok && ri.Spec != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("role"); ok && ri.Role != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("opposite_interface_id"); ok && ri.OppositeInterfaceId != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("opposite_interface_owner_id"); ok && ri.OppositeInterfaceOwnerId != v.(string) {
+			continue
+		}
+		filteredInterfaces = append(filteredInterfaces, ri)
+	}
+
+	if len(filteredInterfaces) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_router_interfaces - Router interfaces found: %#v", filteredInterfaces)
+
+	return routerInterfacesDescriptionAttributes(d, filteredInterfaces)
+}
+
+func routerInterfacesDescriptionAttributes(d *schema.ResourceData, interfaces []vpc.RouterInterfaceType) error {
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, ri := range interfaces {
+		mapping := map[string]interface{}{
+			"id":                          ri.RouterInterfaceId,
+			"status":                      ri.Status,
+			"name":                        ri.Name,
+			"description":                 ri.Description,
+			"role":                        ri.Role,
+			"specification":               ri.Spec,
+			"router_id":                   ri.RouterId,
+			"router_type":                 ri.RouterType,
+			"vpc_id":                      ri.VpcInstanceId,
+			"access_point_id":             ri.AccessPointId,
+			"creation_time":               ri.CreationTime,
+			"opposite_region_id":          ri.OppositeRegionId,
+			"opposite_interface_id":       ri.OppositeInterfaceId,
+			"opposite_router_id":          ri.OppositeRouterId,
+			"opposite_router_type":        ri.OppositeRouterType,
+			"opposite_interface_owner_id": ri.OppositeInterfaceOwnerId,
+			"health_check_source_ip":      ri.HealthCheckSourceIp,
+			"health_check_target_ip":      ri.HealthCheckTargetIp,
+		}
+		ids = append(ids, ri.RouterInterfaceId)
+		names = append(names, ri.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("interfaces", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
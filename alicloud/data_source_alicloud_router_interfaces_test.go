@@ -0,0 +1,51 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudRouterInterfacesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudRouterInterfacesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_router_interfaces.default"),
+					resource.TestCheckResourceAttr("data.alicloud_router_interfaces.default", "interfaces.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_router_interfaces.default", "interfaces.0.name", "tf-testAccRouterInterfacesDataSource"),
+					resource.TestCheckResourceAttr("data.alicloud_router_interfaces.default", "interfaces.0.role", "InitiatingSide"),
+					resource.TestCheckResourceAttr("data.alicloud_router_interfaces.default", "interfaces.0.specification", "Large.2"),
+					resource.TestCheckResourceAttr("data.alicloud_router_interfaces.default", "interfaces.0.router_type", "VRouter"),
+					resource.TestCheckResourceAttrSet("data.alicloud_router_interfaces.default", "interfaces.0.vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudRouterInterfacesDataSourceConfig = `
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccRouterInterfacesDataSource"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_router_interface" "interface" {
+  opposite_region = "cn-beijing"
+  router_type     = "VRouter"
+  router_id       = "${alicloud_vpc.foo.router_id}"
+  role            = "InitiatingSide"
+  specification   = "Large.2"
+  name            = "tf-testAccRouterInterfacesDataSource"
+}
+
+data "alicloud_router_interfaces" "default" {
+  router_id  = "${alicloud_router_interface.interface.router_id}"
+  name_regex = "${alicloud_router_interface.interface.name}"
+}
+`
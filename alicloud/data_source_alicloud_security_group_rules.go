@@ -3,9 +3,10 @@ package alicloud
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -110,22 +111,21 @@ func dataSourceAlicloudSecurityGroupRules() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 		},
 	}
 }
 
 func dataSourceAlicloudSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
-
-	attr, err := conn.DescribeSecurityGroupAttribute(
-		&ecs.DescribeSecurityGroupAttributeArgs{
-			SecurityGroupId: d.Get("group_id").(string),
-			RegionId:        getRegion(d, meta),
-			NicType:         ecs.NicType(d.Get("nic_type").(string)),
-			Direction:       ecs.Direction(d.Get("direction").(string)),
-		},
-	)
-	if err != nil {
+	client := meta.(*AliyunClient)
+
+	request := ecs.CreateDescribeSecurityGroupAttributeRequest()
+	request.SecurityGroupId = d.Get("group_id").(string)
+	request.RegionId = string(getRegion(d, meta))
+	request.NicType = d.Get("nic_type").(string)
+	request.Direction = d.Get("direction").(string)
+	attr := ecs.CreateDescribeSecurityGroupAttributeResponse()
+	if err := client.doAction(client.ecsconn(), request, attr); err != nil {
 		return fmt.Errorf("DescribeSecurityGroupAttribute: %#v", err)
 	}
 
@@ -141,6 +141,7 @@ func dataSourceAlicloudSecurityGroupRulesRead(d *schema.ResourceData, meta inter
 			continue
 		}
 
+		priority, _ := strconv.Atoi(item.Priority)
 		mapping := map[string]interface{}{
 			"ip_protocol":                strings.ToLower(string(item.IpProtocol)),
 			"port_range":                 item.PortRange,
@@ -152,7 +153,7 @@ func dataSourceAlicloudSecurityGroupRulesRead(d *schema.ResourceData, meta inter
 			"dest_group_owner_account":   item.DestGroupOwnerAccount,
 			"policy":                     strings.ToLower(string(item.Policy)),
 			"nic_type":                   item.NicType,
-			"priority":                   item.Priority,
+			"priority":                   priority,
 			"direction":                  item.Direction,
 			"description":                item.Description,
 		}
@@ -176,7 +177,7 @@ func dataSourceAlicloudSecurityGroupRulesRead(d *schema.ResourceData, meta inter
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), rules)
+		writeToFile(output.(string), rules, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -5,14 +5,14 @@ import (
 	"log"
 	"regexp"
 
-	"github.com/denverdino/aliyungo/ecs"
-	"github.com/denverdino/aliyungo/util"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 type SecurityGroup struct {
 	Attributes   ecs.DescribeSecurityGroupAttributeResponse
-	CreationTime util.ISO6801Time
+	CreationTime string
+	Tags         []ecs.Tag
 }
 
 func dataSourceAlicloudSecurityGroups() *schema.Resource {
@@ -30,10 +30,18 @@ func dataSourceAlicloudSecurityGroups() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"groups": {
@@ -65,6 +73,7 @@ func dataSourceAlicloudSecurityGroups() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"tags": tagsSchema(),
 					},
 				},
 			},
@@ -73,14 +82,13 @@ func dataSourceAlicloudSecurityGroups() *schema.Resource {
 }
 
 func dataSourceAlicloudSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 
 	regionId := getRegion(d, meta)
 
-	args := &ecs.DescribeSecurityGroupsArgs{
-		RegionId: regionId,
-		VpcId:    d.Get("vpc_id").(string),
-	}
+	request := ecs.CreateDescribeSecurityGroupsRequest()
+	request.RegionId = string(regionId)
+	request.VpcId = d.Get("vpc_id").(string)
 
 	var sg []SecurityGroup
 
@@ -91,43 +99,54 @@ func dataSourceAlicloudSecurityGroupsRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	for {
-		items, paginationResult, err := conn.DescribeSecurityGroups(args)
+	var taggedIds map[string]bool
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		ids, err := client.describeEcsResourceIdsByTags(TagResourceSecurityGroup, v.(map[string]interface{}))
 		if err != nil {
-			return fmt.Errorf("DescribeSecurityGroups: %#v", err)
+			return err
 		}
+		taggedIds = ids
+	}
+	idsMap := idsFilter(d)
 
-		for _, item := range items {
-			if nameRegex != nil {
-				if !nameRegex.MatchString(item.SecurityGroupName) {
-					continue
-				}
-			}
+	items, err := client.DescribeEcsSecurityGroups(request)
+	if err != nil {
+		return fmt.Errorf("DescribeSecurityGroups: %#v", err)
+	}
 
-			attr, err := conn.DescribeSecurityGroupAttribute(
-				&ecs.DescribeSecurityGroupAttributeArgs{
-					SecurityGroupId: item.SecurityGroupId,
-					RegionId:        regionId,
-				},
-			)
-			if err != nil {
-				return fmt.Errorf("DescribeSecurityGroupAttribute: %#v", err)
+	for _, item := range items {
+		if nameRegex != nil {
+			if !nameRegex.MatchString(item.SecurityGroupName) {
+				continue
 			}
+		}
+		if taggedIds != nil && !taggedIds[item.SecurityGroupId] {
+			continue
+		}
+		if idsMap != nil && !idsMap[item.SecurityGroupId] {
+			continue
+		}
 
-			sg = append(sg,
-				SecurityGroup{
-					Attributes:   *attr,
-					CreationTime: item.CreationTime,
-				},
-			)
+		attrRequest := ecs.CreateDescribeSecurityGroupAttributeRequest()
+		attrRequest.SecurityGroupId = item.SecurityGroupId
+		attrRequest.RegionId = string(regionId)
+		attr := ecs.CreateDescribeSecurityGroupAttributeResponse()
+		if err := client.doAction(client.ecsconn(), attrRequest, attr); err != nil {
+			return fmt.Errorf("DescribeSecurityGroupAttribute: %#v", err)
 		}
 
-		pagination := paginationResult.NextPage()
-		if pagination == nil {
-			break
+		tags, err := client.describeEcsTags(regionId, TagResourceSecurityGroup, item.SecurityGroupId)
+		if err != nil {
+			return fmt.Errorf("DescribeTags: %#v", err)
 		}
 
-		args.Pagination = *pagination
+		sg = append(sg,
+			SecurityGroup{
+				Attributes:   *attr,
+				CreationTime: item.CreationTime,
+				Tags:         tags,
+			},
+		)
 	}
 
 	return securityGroupsDescription(d, sg)
@@ -135,6 +154,7 @@ func dataSourceAlicloudSecurityGroupsRead(d *schema.ResourceData, meta interface
 
 func securityGroupsDescription(d *schema.ResourceData, sg []SecurityGroup) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 
 	for _, item := range sg {
@@ -143,12 +163,14 @@ func securityGroupsDescription(d *schema.ResourceData, sg []SecurityGroup) error
 			"name":          item.Attributes.SecurityGroupName,
 			"description":   item.Attributes.Description,
 			"vpc_id":        item.Attributes.VpcId,
-			"inner_access":  item.Attributes.InnerAccessPolicy == ecs.GroupInnerAccept,
-			"creation_time": item.CreationTime.String(),
+			"inner_access":  item.Attributes.InnerAccessPolicy == string(GroupInnerAccept),
+			"creation_time": item.CreationTime,
+			"tags":          tagsToMap(item.Tags),
 		}
 
 		log.Printf("alicloud_security_groups - adding security group mapping: %v", mapping)
 		ids = append(ids, string(item.Attributes.SecurityGroupId))
+		names = append(names, item.Attributes.SecurityGroupName)
 		s = append(s, mapping)
 	}
 
@@ -156,10 +178,16 @@ func securityGroupsDescription(d *schema.ResourceData, sg []SecurityGroup) error
 	if err := d.Set("groups", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it
+	// create a file in current directory and write data source to it
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -0,0 +1,99 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudSlbAttachments() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudSlbAttachmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudSlbAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	slbId := d.Get("load_balancer_id").(string)
+	loadBalancer, err := client.DescribeLoadBalancerAttribute(slbId)
+	if err != nil {
+		return fmt.Errorf("DescribeLoadBalancerAttribute got an error: %#v", err)
+	}
+
+	instanceIds := make(map[string]bool)
+	if v, ok := d.GetOk("instance_ids"); ok {
+		for _, id := range v.([]interface{}) {
+			instanceIds[Trim(id.(string))] = true
+		}
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, server := range loadBalancer.BackendServers.BackendServer {
+		if len(instanceIds) > 0 && !instanceIds[server.ServerId] {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"instance_id": server.ServerId,
+			"weight":      server.Weight,
+		}
+		ids = append(ids, server.ServerId)
+		s = append(s, mapping)
+	}
+
+	if len(s) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_slb_attachments - Backend servers found: %#v", s)
+
+	d.SetId(dataResourceIdHash(append([]string{slbId}, ids...)))
+	if err := d.Set("attachments", s); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
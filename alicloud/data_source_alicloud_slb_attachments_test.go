@@ -0,0 +1,84 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudSlbAttachmentsDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudSlbAttachments().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudSlbAttachmentsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudSlbAttachmentsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_slb_attachments.default"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_attachments.default", "attachments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.alicloud_slb_attachments.default", "attachments.0.instance_id", "alicloud_instance.foo", "id"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_attachments.default", "attachments.0.weight", "90"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudSlbAttachmentsDataSourceConfig = `
+data "alicloud_images" "image" {
+  most_recent = true
+  owners      = "system"
+  name_regex  = "^centos_6\\w{1,5}[64]{1}.*"
+}
+
+data "alicloud_zones" "zone" {
+  available_resource_creation = "VSwitch"
+}
+
+resource "alicloud_vpc" "main" {
+  name       = "tf-testAccSlbAttachmentsDataSource"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "main" {
+  vpc_id            = "${alicloud_vpc.main.id}"
+  cidr_block        = "172.16.0.0/16"
+  availability_zone = "${data.alicloud_zones.zone.zones.0.id}"
+}
+
+resource "alicloud_security_group" "group" {
+  vpc_id = "${alicloud_vpc.main.id}"
+}
+
+resource "alicloud_instance" "foo" {
+  image_id             = "${data.alicloud_images.image.images.0.id}"
+  instance_type        = "ecs.n4.large"
+  system_disk_category = "cloud_efficiency"
+  security_groups      = ["${alicloud_security_group.group.id}"]
+  instance_name        = "tf-testAccSlbAttachmentsDataSource"
+  vswitch_id           = "${alicloud_vswitch.main.id}"
+}
+
+resource "alicloud_slb" "foo" {
+  name       = "tf-testAccSlbAttachmentsDataSource"
+  vswitch_id = "${alicloud_vswitch.main.id}"
+}
+
+resource "alicloud_slb_attachment" "foo" {
+  load_balancer_id = "${alicloud_slb.foo.id}"
+  instance_ids     = ["${alicloud_instance.foo.id}"]
+  weight           = 90
+}
+
+data "alicloud_slb_attachments" "default" {
+  load_balancer_id = "${alicloud_slb_attachment.foo.load_balancer_id}"
+}
+`
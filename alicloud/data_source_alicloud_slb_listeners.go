@@ -0,0 +1,144 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudSlbListeners() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudSlbListenersRead,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateInstanceProtocol,
+			},
+			"frontend_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"listeners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frontend_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"backend_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"scheduler": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sticky_session": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ssl_certificate_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudSlbListenersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	slbId := d.Get("load_balancer_id").(string)
+	loadBalancer, err := client.DescribeLoadBalancerAttribute(slbId)
+	if err != nil {
+		return fmt.Errorf("DescribeLoadBalancerAttribute got an error: %#v", err)
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, port := range loadBalancer.ListenerPortsAndProtocol.ListenerPortAndProtocol {
+		if v, ok := d.GetOk("protocol"); ok && port.ListenerProtocol != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("frontend_port"); ok && port.ListenerPort != v.(int) {
+			continue
+		}
+		listener, err := client.DescribeSlbListenerAttribute(slbId, port.ListenerProtocol, port.ListenerPort)
+		if err != nil {
+			return err
+		}
+		mapping := map[string]interface{}{
+			"frontend_port":      port.ListenerPort,
+			"backend_port":       listener.BackendServerPort,
+			"protocol":           port.ListenerProtocol,
+			"status":             listener.Status,
+			"bandwidth":          listener.Bandwidth,
+			"scheduler":          listener.Scheduler,
+			"server_group_id":    listener.VServerGroupId,
+			"health_check":       listener.HealthCheck,
+			"sticky_session":     listener.StickySession,
+			"ssl_certificate_id": listener.ServerCertificateId,
+		}
+		ids = append(ids, fmt.Sprintf("%s%s%d", port.ListenerProtocol, COLON_SEPARATED, port.ListenerPort))
+		s = append(s, mapping)
+	}
+
+	if len(s) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_slb_listeners - Listeners found: %#v", s)
+
+	d.SetId(dataResourceIdHash(append([]string{slbId}, ids...)))
+	if err := d.Set("listeners", s); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudSlbListenersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudSlbListenersDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_slb_listeners.default"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_listeners.default", "listeners.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_listeners.default", "listeners.0.frontend_port", "80"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_listeners.default", "listeners.0.backend_port", "8080"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_listeners.default", "listeners.0.protocol", "http"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_listeners.default", "listeners.0.bandwidth", "5"),
+					resource.TestCheckResourceAttrSet("data.alicloud_slb_listeners.default", "listeners.0.status"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudSlbListenersDataSourceConfig = `
+resource "alicloud_slb" "foo" {
+  name = "tf-testAccSlbListenersDataSource"
+}
+
+resource "alicloud_slb_listener" "http" {
+  load_balancer_id = "${alicloud_slb.foo.id}"
+  backend_port     = 8080
+  frontend_port    = 80
+  protocol         = "http"
+  bandwidth        = 5
+}
+
+resource "alicloud_slb_listener" "tcp" {
+  load_balancer_id = "${alicloud_slb.foo.id}"
+  backend_port     = 22
+  frontend_port    = 22
+  protocol         = "tcp"
+  bandwidth        = 5
+}
+
+data "alicloud_slb_listeners" "default" {
+  load_balancer_id = "${alicloud_slb_listener.http.load_balancer_id}"
+  protocol         = "http"
+
+  depends_on = ["alicloud_slb_listener.tcp"]
+}
+`
@@ -0,0 +1,133 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudSlbRulesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudSlbRulesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_slb_rules.default"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_rules.default", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_rules.default", "rules.0.name", "tf-testAccSlbRulesDataSource"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_rules.default", "rules.0.domain", "*.aliyun.com"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_rules.default", "rules.0.url", "/image"),
+					resource.TestCheckResourceAttrSet("data.alicloud_slb_rules.default", "rules.0.server_group_id"),
+
+					testAccCheckAlicloudDataSourceID("data.alicloud_slb_server_groups.default"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.0.name", "tf-testAccSlbRulesDataSource"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.0.servers.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.0.servers.0.port", "80"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.0.servers.0.weight", "100"),
+
+					testAccCheckAlicloudDataSourceID("data.alicloud_slb_attachments.default"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_attachments.default", "attachments.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_attachments.default", "attachments.0.weight", "100"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudSlbRulesDataSourceConfig = `
+data "alicloud_images" "image" {
+  most_recent = true
+  owners      = "system"
+  name_regex  = "^centos_6\\w{1,5}[64]{1}.*"
+}
+
+data "alicloud_zones" "zone" {
+  "available_resource_creation" = "VSwitch"
+}
+
+resource "alicloud_vpc" "main" {
+  name       = "tf-testAccSlbRulesDataSource"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "main" {
+  vpc_id            = "${alicloud_vpc.main.id}"
+  cidr_block        = "172.16.0.0/16"
+  availability_zone = "${data.alicloud_zones.zone.zones.0.id}"
+}
+
+resource "alicloud_security_group" "group" {
+  vpc_id = "${alicloud_vpc.main.id}"
+}
+
+resource "alicloud_instance" "instance" {
+  image_id                   = "${data.alicloud_images.image.images.0.id}"
+  instance_type              = "ecs.n4.small"
+  security_groups            = ["${alicloud_security_group.group.id}"]
+  internet_charge_type       = "PayByTraffic"
+  internet_max_bandwidth_out = "10"
+  availability_zone          = "${data.alicloud_zones.zone.zones.0.id}"
+  instance_charge_type       = "PostPaid"
+  system_disk_category       = "cloud_efficiency"
+  vswitch_id                 = "${alicloud_vswitch.main.id}"
+}
+
+resource "alicloud_slb" "instance" {
+  name       = "tf-testAccSlbRulesDataSource"
+  vswitch_id = "${alicloud_vswitch.main.id}"
+}
+
+resource "alicloud_slb_attachment" "attachment" {
+  load_balancer_id = "${alicloud_slb.instance.id}"
+  instance_ids     = ["${alicloud_instance.instance.id}"]
+}
+
+resource "alicloud_slb_listener" "listener" {
+  load_balancer_id          = "${alicloud_slb.instance.id}"
+  backend_port              = 22
+  frontend_port             = 22
+  protocol                  = "http"
+  bandwidth                 = 5
+  health_check_connect_port = "20"
+}
+
+resource "alicloud_slb_server_group" "group" {
+  load_balancer_id = "${alicloud_slb.instance.id}"
+  name             = "tf-testAccSlbRulesDataSource"
+
+  servers = [
+    {
+      server_ids = ["${alicloud_instance.instance.id}"]
+      port       = 80
+      weight     = 100
+    },
+  ]
+}
+
+resource "alicloud_slb_rule" "rule" {
+  load_balancer_id = "${alicloud_slb.instance.id}"
+  frontend_port    = "${alicloud_slb_listener.listener.frontend_port}"
+  name             = "tf-testAccSlbRulesDataSource"
+  domain           = "*.aliyun.com"
+  url              = "/image"
+  server_group_id  = "${alicloud_slb_server_group.group.id}"
+}
+
+data "alicloud_slb_rules" "default" {
+  load_balancer_id = "${alicloud_slb_rule.rule.load_balancer_id}"
+  frontend_port    = "${alicloud_slb_rule.rule.frontend_port}"
+}
+
+data "alicloud_slb_server_groups" "default" {
+  load_balancer_id = "${alicloud_slb_server_group.group.load_balancer_id}"
+}
+
+data "alicloud_slb_attachments" "default" {
+  load_balancer_id = "${alicloud_slb_attachment.attachment.load_balancer_id}"
+}
+`
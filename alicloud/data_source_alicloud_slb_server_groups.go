@@ -0,0 +1,155 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudSlbServerGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudSlbServerGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"servers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"weight": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudSlbServerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	request := slb.CreateDescribeVServerGroupsRequest()
+	request.RegionId = string(client.Region)
+	request.LoadBalancerId = d.Get("load_balancer_id").(string)
+	resp := slb.CreateDescribeVServerGroupsResponse()
+	if err := client.doAction(client.slbconn(), request, resp); err != nil {
+		return fmt.Errorf("DescribeVServerGroups got an error: %#v", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredGroups []slb.VServerGroup
+	for _, group := range resp.VServerGroups.VServerGroup {
+		if nameRegex != nil && !nameRegex.MatchString(group.VServerGroupName) {
+			continue
+		}
+		if idsMap != nil && !idsMap[group.VServerGroupId] {
+			continue
+		}
+		filteredGroups = append(filteredGroups, group)
+	}
+
+	if len(filteredGroups) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_slb_server_groups - Server groups found: %#v", filteredGroups)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, group := range filteredGroups {
+		backends, err := client.DescribeSlbVServerGroupBackendServers(group.VServerGroupId)
+		if err != nil {
+			return fmt.Errorf("DescribeVServerGroupAttribute got an error: %#v", err)
+		}
+		var servers []map[string]interface{}
+		for _, backend := range backends {
+			servers = append(servers, map[string]interface{}{
+				"instance_id": backend.ServerId,
+				"port":        backend.Port,
+				"weight":      backend.Weight,
+			})
+		}
+		mapping := map[string]interface{}{
+			"id":      group.VServerGroupId,
+			"name":    group.VServerGroupName,
+			"servers": servers,
+		}
+		ids = append(ids, group.VServerGroupId)
+		names = append(names, group.VServerGroupName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("groups", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudSlbServerGroupsDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudSlbServerGroups().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudSlbServerGroupsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudSlbServerGroupsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_slb_server_groups.default"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.alicloud_slb_server_groups.default", "groups.0.id", "alicloud_slb_server_group.foo", "id"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.0.name", "tf-testAccSlbServerGroupsDataSource"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.0.servers.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.0.servers.0.port", "80"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "groups.0.servers.0.weight", "100"),
+					resource.TestCheckResourceAttr("data.alicloud_slb_server_groups.default", "names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudSlbServerGroupsDataSourceConfig = `
+data "alicloud_images" "image" {
+  most_recent = true
+  owners      = "system"
+  name_regex  = "^centos_6\\w{1,5}[64]{1}.*"
+}
+
+data "alicloud_zones" "zone" {
+  available_resource_creation = "VSwitch"
+}
+
+resource "alicloud_vpc" "main" {
+  name       = "tf-testAccSlbServerGroupsDataSource"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "main" {
+  vpc_id            = "${alicloud_vpc.main.id}"
+  cidr_block        = "172.16.0.0/16"
+  availability_zone = "${data.alicloud_zones.zone.zones.0.id}"
+}
+
+resource "alicloud_security_group" "group" {
+  vpc_id = "${alicloud_vpc.main.id}"
+}
+
+resource "alicloud_instance" "foo" {
+  image_id             = "${data.alicloud_images.image.images.0.id}"
+  instance_type        = "ecs.n4.large"
+  system_disk_category = "cloud_efficiency"
+  security_groups      = ["${alicloud_security_group.group.id}"]
+  instance_name        = "tf-testAccSlbServerGroupsDataSource"
+  vswitch_id           = "${alicloud_vswitch.main.id}"
+}
+
+resource "alicloud_slb" "foo" {
+  name       = "tf-testAccSlbServerGroupsDataSource"
+  vswitch_id = "${alicloud_vswitch.main.id}"
+}
+
+resource "alicloud_slb_server_group" "foo" {
+  load_balancer_id = "${alicloud_slb.foo.id}"
+  name             = "tf-testAccSlbServerGroupsDataSource"
+  servers = [
+    {
+      server_ids = ["${alicloud_instance.foo.id}"]
+      port       = 80
+      weight     = 100
+    },
+  ]
+}
+
+data "alicloud_slb_server_groups" "default" {
+  load_balancer_id = "${alicloud_slb_server_group.foo.load_balancer_id}"
+  name_regex       = "${alicloud_slb_server_group.foo.name}"
+}
+`
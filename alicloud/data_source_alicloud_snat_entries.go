@@ -0,0 +1,128 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudSnatEntries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudSnatEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"snat_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snat_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ids": idsSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"snat_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_vswitch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudSnatEntriesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	allEntries, err := client.DescribeSnatEntries(d.Get("snat_table_id").(string))
+	if err != nil {
+		return fmt.Errorf("DescribeSnatTableEntries got an error: %#v", err)
+	}
+
+	idsMap := idsFilter(d)
+
+	var filteredEntries []vpc.SnatTableEntry
+	for _, entry := range allEntries {
+		if idsMap != nil && !idsMap[entry.SnatEntryId] {
+			continue
+		}
+		if v, ok := d.GetOk("snat_ip"); ok && entry.SnatIp != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("source_cidr"); ok && entry.SourceCIDR != v.(string) {
+			continue
+		}
+		filteredEntries = append(filteredEntries, entry)
+	}
+
+	if len(filteredEntries) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_snat_entries - Snat entries found: %#v", filteredEntries)
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, entry := range filteredEntries {
+		mapping := map[string]interface{}{
+			"id":                entry.SnatEntryId,
+			"snat_ip":           entry.SnatIp,
+			"source_cidr":       entry.SourceCIDR,
+			"source_vswitch_id": entry.SourceVSwitchId,
+			"status":            entry.Status,
+		}
+		ids = append(ids, entry.SnatEntryId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("entries", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
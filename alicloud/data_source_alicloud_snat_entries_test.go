@@ -0,0 +1,71 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudSnatEntriesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudSnatEntriesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_snat_entries.default"),
+					resource.TestCheckResourceAttr("data.alicloud_snat_entries.default", "entries.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_snat_entries.default", "entries.0.source_cidr", "172.16.0.0/21"),
+					resource.TestCheckResourceAttrSet("data.alicloud_snat_entries.default", "entries.0.source_vswitch_id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_snat_entries.default", "entries.0.snat_ip"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudSnatEntriesDataSourceConfig = `
+data "alicloud_zones" "default" {
+  "available_resource_creation" = "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccSnatEntriesDataSource"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_nat_gateway" "foo" {
+  vpc_id        = "${alicloud_vswitch.foo.vpc_id}"
+  specification = "Small"
+  name          = "tf-testAccSnatEntriesDataSource"
+}
+
+resource "alicloud_eip" "foo" {}
+
+resource "alicloud_eip_association" "foo" {
+  allocation_id = "${alicloud_eip.foo.id}"
+  instance_id   = "${alicloud_nat_gateway.foo.id}"
+}
+
+resource "alicloud_snat_entry" "foo" {
+  snat_table_id     = "${alicloud_nat_gateway.foo.snat_table_ids}"
+  source_vswitch_id = "${alicloud_vswitch.foo.id}"
+  snat_ip           = "${alicloud_eip.foo.ip_address}"
+
+  depends_on = ["alicloud_eip_association.foo"]
+}
+
+data "alicloud_snat_entries" "default" {
+  snat_table_id = "${alicloud_snat_entry.foo.snat_table_id}"
+  snat_ip       = "${alicloud_snat_entry.foo.snat_ip}"
+}
+`
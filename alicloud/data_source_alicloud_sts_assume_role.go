@@ -0,0 +1,86 @@
+package alicloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudStsAssumeRole() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudStsAssumeRoleRead,
+
+		Schema: map[string]*schema.Schema{
+			"role_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"session_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  StsSessionName,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      3600,
+				ValidateFunc: validateIntegerInRange(900, 3600),
+			},
+
+			// Computed values
+			"access_key_id": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"access_key_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"security_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assumed_role_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudStsAssumeRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	resp, err := client.AssumeRole(d.Get("role_arn").(string), d.Get("session_name").(string),
+		d.Get("policy").(string), d.Get("duration_seconds").(int))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.AssumedRoleUser.AssumedRoleId)
+	d.Set("access_key_id", resp.Credentials.AccessKeyId)
+	d.Set("access_key_secret", resp.Credentials.AccessKeySecret)
+	d.Set("security_token", resp.Credentials.SecurityToken)
+	d.Set("expiration", resp.Credentials.Expiration)
+	d.Set("assumed_role_id", resp.AssumedRoleUser.AssumedRoleId)
+	d.Set("arn", resp.AssumedRoleUser.Arn)
+
+	return nil
+}
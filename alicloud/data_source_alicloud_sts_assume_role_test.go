@@ -0,0 +1,50 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudStsAssumeRoleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWithAccountId(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudStsAssumeRoleDataSourceBasic(os.Getenv("ALICLOUD_ACCOUNT_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_sts_assume_role.role"),
+					resource.TestCheckResourceAttr("data.alicloud_sts_assume_role.role", "session_name", "tf-testacc-session"),
+					resource.TestCheckResourceAttrSet("data.alicloud_sts_assume_role.role", "access_key_id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_sts_assume_role.role", "access_key_secret"),
+					resource.TestCheckResourceAttrSet("data.alicloud_sts_assume_role.role", "security_token"),
+					resource.TestCheckResourceAttrSet("data.alicloud_sts_assume_role.role", "expiration"),
+					resource.TestCheckResourceAttrSet("data.alicloud_sts_assume_role.role", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlicloudStsAssumeRoleDataSourceBasic(accountId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_ram_role" "role" {
+  name = "tf-testacc-sts-assume-role"
+  ram_users = ["acs:ram::%s:root"]
+  description = "Terraform acc test datasource"
+  force = true
+}
+
+data "alicloud_sts_assume_role" "role" {
+  role_arn = "${alicloud_ram_role.role.arn}"
+  session_name = "tf-testacc-session"
+  duration_seconds = 900
+}
+`, accountId)
+}
@@ -41,10 +41,13 @@ func dataSourceAlicloudVpcs() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"vpcs": {
@@ -104,7 +107,8 @@ func dataSourceAlicloudVpcs() *schema.Resource {
 	}
 }
 func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
 
 	args := vpc.CreateDescribeVpcsRequest()
 	args.RegionId = string(getRegion(d, meta))
@@ -113,7 +117,8 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 	var allVpcs []vpc.Vpc
 
 	for {
-		resp, err := conn.DescribeVpcs(args)
+		resp := vpc.CreateDescribeVpcsResponse()
+		err := client.doAction(conn, args, resp)
 		if err != nil {
 			return err
 		}
@@ -131,10 +136,26 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 		args.PageNumber = args.PageNumber + requests.NewInteger(1)
 	}
 
-	var filteredVpcsTemp []vpc.Vpc
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredVpcs []vpc.Vpc
 	var route_tables []string
 
 	for _, v := range allVpcs {
+		if nameRegex != nil && !nameRegex.MatchString(v.VpcName) {
+			continue
+		}
+
+		if idsMap != nil && !idsMap[v.VpcId] {
+			continue
+		}
+
 		if cidrBlock, ok := d.GetOk("cidr_block"); ok && v.CidrBlock != cidrBlock.(string) {
 			continue
 		}
@@ -155,7 +176,8 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 		request.VRouterId = v.VRouterId
 		request.RegionId = string(getRegion(d, meta))
 
-		vrs, err := conn.DescribeVRouters(request)
+		vrs := vpc.CreateDescribeVRoutersResponse()
+		err := client.doAction(conn, request, vrs)
 		if err != nil {
 			return fmt.Errorf("Error DescribVRouters by vrouter_id %s: %#v", v.VRouterId, err)
 		}
@@ -165,21 +187,7 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 			route_tables = append(route_tables, "")
 		}
 
-		filteredVpcsTemp = append(filteredVpcsTemp, v)
-	}
-
-	var filteredVpcs []vpc.Vpc
-
-	if nameRegex, ok := d.GetOk("name_regex"); ok {
-		if r, err := regexp.Compile(nameRegex.(string)); err == nil {
-			for _, vpc := range filteredVpcsTemp {
-				if r.MatchString(vpc.VpcName) {
-					filteredVpcs = append(filteredVpcs, vpc)
-				}
-			}
-		}
-	} else {
-		filteredVpcs = filteredVpcsTemp[:]
+		filteredVpcs = append(filteredVpcs, v)
 	}
 
 	if len(filteredVpcs) < 1 {
@@ -188,7 +196,7 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] alicloud_vpc - VPCs found: %#v", allVpcs)
 
-	return vpcsDecriptionAttributes(d, filteredVpcsTemp, route_tables, meta)
+	return vpcsDecriptionAttributes(d, filteredVpcs, route_tables, meta)
 }
 func vpcVswitchIdListContains(vswitchIdList []string, vswitchId string) bool {
 	for _, idListItem := range vswitchIdList {
@@ -200,6 +208,7 @@ func vpcVswitchIdListContains(vswitchIdList []string, vswitchId string) bool {
 }
 func vpcsDecriptionAttributes(d *schema.ResourceData, vpcSetTypes []vpc.Vpc, route_tables []string, meta interface{}) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 	for index, vpc := range vpcSetTypes {
 		mapping := map[string]interface{}{
@@ -217,6 +226,7 @@ func vpcsDecriptionAttributes(d *schema.ResourceData, vpcSetTypes []vpc.Vpc, rou
 		}
 		log.Printf("[DEBUG] alicloud_vpc - adding vpc: %v", mapping)
 		ids = append(ids, vpc.VpcId)
+		names = append(names, vpc.VpcName)
 		s = append(s, mapping)
 	}
 
@@ -224,10 +234,16 @@ func vpcsDecriptionAttributes(d *schema.ResourceData, vpcSetTypes []vpc.Vpc, rou
 	if err := d.Set("vpcs", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
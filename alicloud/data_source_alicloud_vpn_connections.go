@@ -0,0 +1,281 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudVpnConnections() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudVpnConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"customer_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"customer_gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpn_gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_subnet": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_subnet": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"effect_immediately": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ike_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ike_mode": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ike_enc_alg": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ike_auth_alg": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ike_pfs": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ike_lifetime": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"ike_local_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ike_remote_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"ipsec_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ipsec_enc_alg": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ipsec_auth_alg": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ipsec_pfs": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ipsec_lifetime": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudVpnConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
+
+	args := vpc.CreateDescribeVpnConnectionsRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.PageSize = requests.NewInteger(PageSizeLarge)
+	pageNumber := 1
+	args.PageNumber = requests.NewInteger(pageNumber)
+	if v, ok := d.GetOk("vpn_gateway_id"); ok {
+		args.VpnGatewayId = v.(string)
+	}
+	if v, ok := d.GetOk("customer_gateway_id"); ok {
+		args.CustomerGatewayId = v.(string)
+	}
+
+	var allConnections []vpc.VpnConnection
+	for {
+		resp := vpc.CreateDescribeVpnConnectionsResponse()
+		err := client.doAction(conn, args, resp)
+		if err != nil {
+			return fmt.Errorf("DescribeVpnConnections got an error: %#v", err)
+		}
+
+		if resp == nil || len(resp.VpnConnections.VpnConnection) < 1 {
+			break
+		}
+
+		allConnections = append(allConnections, resp.VpnConnections.VpnConnection...)
+
+		if len(resp.VpnConnections.VpnConnection) < PageSizeLarge {
+			break
+		}
+
+		pageNumber++
+		args.PageNumber = requests.NewInteger(pageNumber)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredConnections []vpc.VpnConnection
+	for _, connection := range allConnections {
+		if nameRegex != nil && !nameRegex.MatchString(connection.Name) {
+			continue
+		}
+		if idsMap != nil && !idsMap[connection.VpnConnectionId] {
+			continue
+		}
+		filteredConnections = append(filteredConnections, connection)
+	}
+
+	if len(filteredConnections) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_vpn_connections - VPN connections found: %#v", filteredConnections)
+
+	return vpnConnectionsDescriptionAttributes(d, filteredConnections)
+}
+
+func vpnConnectionsDescriptionAttributes(d *schema.ResourceData, connections []vpc.VpnConnection) error {
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, connection := range connections {
+		// The pre-shared key is a secret, and it is not exported.
+		ike := connection.IkeConfig
+		ipsec := connection.IpsecConfig
+		mapping := map[string]interface{}{
+			"id":                  connection.VpnConnectionId,
+			"customer_gateway_id": connection.CustomerGatewayId,
+			"vpn_gateway_id":      connection.VpnGatewayId,
+			"name":                connection.Name,
+			"local_subnet":        connection.LocalSubnet,
+			"remote_subnet":       connection.RemoteSubnet,
+			"create_time":         formatVpnTimestamp(connection.CreateTime),
+			"effect_immediately":  connection.EffectImmediately,
+			"status":              connection.Status,
+			"ike_config": []map[string]interface{}{
+				{
+					"ike_version":   ike.IkeVersion,
+					"ike_mode":      ike.IkeMode,
+					"ike_enc_alg":   ike.IkeEncAlg,
+					"ike_auth_alg":  ike.IkeAuthAlg,
+					"ike_pfs":       ike.IkePfs,
+					"ike_lifetime":  ike.IkeLifetime,
+					"ike_local_id":  ike.LocalId,
+					"ike_remote_id": ike.RemoteId,
+				},
+			},
+			"ipsec_config": []map[string]interface{}{
+				{
+					"ipsec_enc_alg":  ipsec.IpsecEncAlg,
+					"ipsec_auth_alg": ipsec.IpsecAuthAlg,
+					"ipsec_pfs":      ipsec.IpsecPfs,
+					"ipsec_lifetime": ipsec.IpsecLifetime,
+				},
+			},
+		}
+		ids = append(ids, connection.VpnConnectionId)
+		names = append(names, connection.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("connections", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
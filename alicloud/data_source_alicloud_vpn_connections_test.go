@@ -0,0 +1,35 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudVpnConnectionsDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudVpnConnections().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudVpnConnectionsDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudVpnConnectionsDataSourceEmpty,
+				ExpectError: regexp.MustCompile("Your query returned no results"),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudVpnConnectionsDataSourceEmpty = `
+data "alicloud_vpn_connections" "default" {
+  name_regex = "^tf-testAccVpnConnectionsDataSource-none$"
+}
+`
@@ -0,0 +1,154 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudVpnCustomerGateways() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudVpnCustomerGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"gateways": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudVpnCustomerGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
+
+	args := vpc.CreateDescribeCustomerGatewaysRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.PageSize = requests.NewInteger(PageSizeLarge)
+	pageNumber := 1
+	args.PageNumber = requests.NewInteger(pageNumber)
+
+	var allGateways []vpc.CustomerGateway
+	for {
+		resp := vpc.CreateDescribeCustomerGatewaysResponse()
+		err := client.doAction(conn, args, resp)
+		if err != nil {
+			return fmt.Errorf("DescribeCustomerGateways got an error: %#v", err)
+		}
+
+		if resp == nil || len(resp.CustomerGateways.CustomerGateway) < 1 {
+			break
+		}
+
+		allGateways = append(allGateways, resp.CustomerGateways.CustomerGateway...)
+
+		if len(resp.CustomerGateways.CustomerGateway) < PageSizeLarge {
+			break
+		}
+
+		pageNumber++
+		args.PageNumber = requests.NewInteger(pageNumber)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredGateways []vpc.CustomerGateway
+	for _, gateway := range allGateways {
+		if nameRegex != nil && !nameRegex.MatchString(gateway.Name) {
+			continue
+		}
+		if idsMap != nil && !idsMap[gateway.CustomerGatewayId] {
+			continue
+		}
+		filteredGateways = append(filteredGateways, gateway)
+	}
+
+	if len(filteredGateways) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_vpn_customer_gateways - Customer gateways found: %#v", filteredGateways)
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, gateway := range filteredGateways {
+		mapping := map[string]interface{}{
+			"id":          gateway.CustomerGatewayId,
+			"name":        gateway.Name,
+			"ip_address":  gateway.IpAddress,
+			"description": gateway.Description,
+			"create_time": formatVpnTimestamp(gateway.CreateTime),
+		}
+		ids = append(ids, gateway.CustomerGatewayId)
+		names = append(names, gateway.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("gateways", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudVpnCustomerGatewaysDataSource_schema(t *testing.T) {
+	if err := dataSourceAlicloudVpnCustomerGateways().InternalValidate(nil, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudVpnCustomerGatewaysDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAlicloudVpnCustomerGatewaysDataSourceEmpty,
+				ExpectError: regexp.MustCompile("Your query returned no results"),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudVpnCustomerGatewaysDataSourceEmpty = `
+data "alicloud_vpn_customer_gateways" "default" {
+  name_regex = "^tf-testAccVpnCustomerGatewaysDataSource-none$"
+}
+`
@@ -0,0 +1,243 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudVpnGateways() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudVpnGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Init", "Provisioning", "Active", "Updating", "Deleting"}),
+			},
+			"business_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Normal", "FinancialLocked"}),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file_format": outputFileFormatSchema(),
+
+			// Computed values
+			"gateways": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"internet_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"specification": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"business_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_charge_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_ipsec": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_ssl": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ssl_connections": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudVpnGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
+
+	args := vpc.CreateDescribeVpnGatewaysRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.PageSize = requests.NewInteger(PageSizeLarge)
+	pageNumber := 1
+	args.PageNumber = requests.NewInteger(pageNumber)
+	if v, ok := d.GetOk("vpc_id"); ok {
+		args.VpcId = v.(string)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		args.Status = v.(string)
+	}
+	if v, ok := d.GetOk("business_status"); ok {
+		args.BusinessStatus = v.(string)
+	}
+
+	var allGateways []vpc.VpnGateway
+	for {
+		resp := vpc.CreateDescribeVpnGatewaysResponse()
+		err := client.doAction(conn, args, resp)
+		if err != nil {
+			return fmt.Errorf("DescribeVpnGateways got an error: %#v", err)
+		}
+
+		if resp == nil || len(resp.VpnGateways.VpnGateway) < 1 {
+			break
+		}
+
+		allGateways = append(allGateways, resp.VpnGateways.VpnGateway...)
+
+		if len(resp.VpnGateways.VpnGateway) < PageSizeLarge {
+			break
+		}
+
+		pageNumber++
+		args.PageNumber = requests.NewInteger(pageNumber)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+	idsMap := idsFilter(d)
+
+	var filteredGateways []vpc.VpnGateway
+	for _, gateway := range allGateways {
+		if nameRegex != nil && !nameRegex.MatchString(gateway.Name) {
+			continue
+		}
+		if idsMap != nil && !idsMap[gateway.VpnGatewayId] {
+			continue
+		}
+		filteredGateways = append(filteredGateways, gateway)
+	}
+
+	if len(filteredGateways) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	log.Printf("[DEBUG] alicloud_vpn_gateways - VPN gateways found: %#v", filteredGateways)
+
+	return vpnGatewaysDescriptionAttributes(d, filteredGateways)
+}
+
+func vpnGatewaysDescriptionAttributes(d *schema.ResourceData, gateways []vpc.VpnGateway) error {
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, gateway := range gateways {
+		mapping := map[string]interface{}{
+			"id":                   gateway.VpnGatewayId,
+			"vpc_id":               gateway.VpcId,
+			"vswitch_id":           gateway.VSwitchId,
+			"internet_ip":          gateway.InternetIp,
+			"create_time":          formatVpnTimestamp(gateway.CreateTime),
+			"end_time":             formatVpnTimestamp(gateway.EndTime),
+			"specification":        gateway.Spec,
+			"name":                 gateway.Name,
+			"description":          gateway.Description,
+			"status":               gateway.Status,
+			"business_status":      gateway.BusinessStatus,
+			"instance_charge_type": gateway.ChargeType,
+			"enable_ipsec":         gateway.IpsecVpn,
+			"enable_ssl":           gateway.SslVpn,
+			"ssl_connections":      gateway.SslMaxConnections,
+		}
+		ids = append(ids, gateway.VpnGatewayId)
+		names = append(names, gateway.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("gateways", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	// create a file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
+	}
+	return nil
+}
+
+// formatVpnTimestamp converts the milliseconds returned by the VPN APIs to a UTC time in RFC3339.
+func formatVpnTimestamp(milliseconds int64) string {
+	if milliseconds <= 0 {
+		return ""
+	}
+	return time.Unix(milliseconds/1000, 0).UTC().Format(time.RFC3339)
+}
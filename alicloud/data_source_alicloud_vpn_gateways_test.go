@@ -0,0 +1,19 @@
+package alicloud
+
+import (
+	"testing"
+)
+
+func TestFormatVpnTimestamp(t *testing.T) {
+	cases := map[int64]string{
+		0:             "",
+		-1:            "",
+		1514736000000: "2017-12-31T16:00:00Z",
+		1514736000999: "2017-12-31T16:00:00Z",
+	}
+	for milliseconds, expected := range cases {
+		if got := formatVpnTimestamp(milliseconds); got != expected {
+			t.Fatalf("formatVpnTimestamp(%d): expected %q, got %q", milliseconds, expected, got)
+		}
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -42,10 +42,13 @@ func dataSourceAlicloudVSwitches() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"ids":   idsSchema(),
+			"names": namesSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 
 			// Computed values
 			"vswitches": {
@@ -97,7 +100,8 @@ func dataSourceAlicloudVSwitches() *schema.Resource {
 	}
 }
 func dataSourceAlicloudVSwitchesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
 
 	args := vpc.CreateDescribeVSwitchesRequest()
 	args.RegionId = string(getRegion(d, meta))
@@ -116,8 +120,10 @@ func dataSourceAlicloudVSwitchesRead(d *schema.ResourceData, meta interface{}) e
 			nameRegex = r
 		}
 	}
+	idsMap := idsFilter(d)
 	for {
-		resp, err := conn.DescribeVSwitches(args)
+		resp := vpc.CreateDescribeVSwitchesResponse()
+		err := client.doAction(conn, args, resp)
 		if err != nil {
 			return err
 		}
@@ -140,6 +146,9 @@ func dataSourceAlicloudVSwitchesRead(d *schema.ResourceData, meta interface{}) e
 					continue
 				}
 			}
+			if idsMap != nil && !idsMap[vsw.VSwitchId] {
+				continue
+			}
 			allVSwitches = append(allVSwitches, vsw)
 		}
 
@@ -161,6 +170,7 @@ func dataSourceAlicloudVSwitchesRead(d *schema.ResourceData, meta interface{}) e
 
 func VSwitchesDecriptionAttributes(d *schema.ResourceData, vsws []vpc.VSwitch, meta interface{}) error {
 	var ids []string
+	var names []string
 	var s []map[string]interface{}
 	for _, vsw := range vsws {
 		mapping := map[string]interface{}{
@@ -173,25 +183,24 @@ func VSwitchesDecriptionAttributes(d *schema.ResourceData, vsws []vpc.VSwitch, m
 			"is_default":    vsw.IsDefault,
 			"creation_time": vsw.CreationTime,
 		}
-		instances, _, err := meta.(*AliyunClient).ecsconn.DescribeInstances(&ecs.DescribeInstancesArgs{
-			RegionId:  getRegion(d, meta),
-			VpcId:     vsw.VpcId,
-			VSwitchId: vsw.VSwitchId,
-			ZoneId:    vsw.ZoneId,
-		})
+		instance_ids := []string{}
+		request := ecs.CreateDescribeInstancesRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.VpcId = vsw.VpcId
+		request.VSwitchId = vsw.VSwitchId
+		request.ZoneId = vsw.ZoneId
+		instances, err := meta.(*AliyunClient).DescribeEcsInstances(request)
 		if err != nil {
 			return fmt.Errorf("DescribeInstances got an error: %#v.", err)
 		}
-		instance_ids := make([]string, len(instances))
-		if len(instance_ids) > 0 {
-			for _, inst := range instances {
-				instance_ids = append(instance_ids, inst.InstanceId)
-			}
+		for _, inst := range instances {
+			instance_ids = append(instance_ids, inst.InstanceId)
 		}
 		mapping["instance_ids"] = instance_ids
 
 		log.Printf("[DEBUG] alicloud_vswitches - adding vswitch: %v", mapping)
 		ids = append(ids, vsw.VSwitchId)
+		names = append(names, vsw.VSwitchName)
 		s = append(s, mapping)
 	}
 
@@ -199,10 +208,16 @@ func VSwitchesDecriptionAttributes(d *schema.ResourceData, vsws []vpc.VSwitch, m
 	if err := d.Set("vswitches", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 	return nil
 }
@@ -7,8 +7,8 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -33,6 +33,10 @@ func dataSourceAlicloudZones() *schema.Resource {
 					string(ResourceTypeVSwitch),
 					string(ResourceTypeDisk),
 					string(IoOptimized),
+					string(ResourceTypeMongoDB),
+					string(ResourceTypePolarDB),
+					string(ResourceTypeElasticsearch),
+					string(ResourceTypeHBase),
 				}),
 			},
 			"available_disk_category": {
@@ -40,8 +44,9 @@ func dataSourceAlicloudZones() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 				ValidateFunc: validateAllowedStringValue([]string{
-					string(ecs.DiskCategoryCloudSSD),
-					string(ecs.DiskCategoryCloudEfficiency),
+					string(DiskCategoryCloudESSD),
+					string(DiskCategoryCloudSSD),
+					string(DiskCategoryCloudEfficiency),
 				}),
 			},
 
@@ -51,10 +56,12 @@ func dataSourceAlicloudZones() *schema.Resource {
 				Default:  false,
 			},
 
+			"ids": idsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_file_format": outputFileFormatSchema(),
 			// Computed values.
 			"zones": {
 				Type:     schema.TypeList,
@@ -96,25 +103,44 @@ func dataSourceAlicloudZonesRead(d *schema.ResourceData, meta interface{}) error
 	resType, _ := d.Get("available_resource_creation").(string)
 	diskType, _ := d.Get("available_disk_category").(string)
 	multi := d.Get("multi").(bool)
+	idsMap := idsFilter(d)
 
 	var zoneIds []string
-	rdsZones := make(map[string]string)
+	productZones := make(map[string]string)
 	if strings.ToLower(Trim(resType)) == strings.ToLower(string(ResourceTypeRds)) {
-		request := rds.CreateDescribeRegionsRequest()
-		if regions, err := meta.(*AliyunClient).rdsconn.DescribeRegions(request); err != nil {
+		client := meta.(*AliyunClient)
+		regions := rds.CreateDescribeRegionsResponse()
+		if err := client.doAction(client.rdsconn, rds.CreateDescribeRegionsRequest(), regions); err != nil {
 			return fmt.Errorf("[ERROR] DescribeRegions got an error: %#v", err)
 		} else if len(regions.Regions.RDSRegion) <= 0 {
 			return fmt.Errorf("[ERROR] There is no available region for RDS.")
 		} else {
 			for _, r := range regions.Regions.RDSRegion {
 				if multi && strings.Contains(r.ZoneId, MULTI_IZ_SYMBOL) && r.RegionId == string(getRegion(d, meta)) {
+					if idsMap != nil && !idsMap[r.ZoneId] {
+						continue
+					}
 					zoneIds = append(zoneIds, r.ZoneId)
 					continue
 				}
-				rdsZones[r.ZoneId] = r.RegionId
+				productZones[r.ZoneId] = r.RegionId
 			}
 		}
 	}
+	if ids, ok, err := meta.(*AliyunClient).describeDatabaseZones(resType); err != nil {
+		return err
+	} else if ok {
+		for _, id := range ids {
+			if multi && strings.Contains(id, MULTI_IZ_SYMBOL) {
+				if idsMap != nil && !idsMap[id] {
+					continue
+				}
+				zoneIds = append(zoneIds, id)
+				continue
+			}
+			productZones[id] = string(getRegion(d, meta))
+		}
+	}
 	if len(zoneIds) > 0 {
 		sort.Strings(zoneIds)
 		return multiZonesDescriptionAttributes(d, zoneIds)
@@ -126,24 +152,28 @@ func dataSourceAlicloudZonesRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	zones := make(map[string]ecs.ZoneType)
+	zones := make(map[string]ecs.Zone)
 	if val, ok := validData[ZoneKey]; ok {
-		zones = val.(map[string]ecs.ZoneType)
+		zones = val.(map[string]ecs.Zone)
 	}
 
-	zoneTypes := make(map[string]ecs.ZoneType)
+	zoneTypes := make(map[string]ecs.Zone)
 	for _, zone := range zones {
 
 		if len(zone.AvailableInstanceTypes.InstanceTypes) == 0 {
 			continue
 		}
 
+		if idsMap != nil && !idsMap[zone.ZoneId] {
+			continue
+		}
+
 		if insType != "" && !constraints(zone.AvailableInstanceTypes.InstanceTypes, insType) {
 			continue
 		}
 
-		if len(rdsZones) > 0 {
-			if _, ok := rdsZones[zone.ZoneId]; !ok {
+		if len(productZones) > 0 {
+			if _, ok := productZones[zone.ZoneId]; !ok {
 				continue
 			}
 		} else if len(zone.AvailableResourceCreation.ResourceTypes) == 0 || (resType != "" && !constraints(zone.AvailableResourceCreation.ResourceTypes, resType)) {
@@ -164,7 +194,7 @@ func dataSourceAlicloudZonesRead(d *schema.ResourceData, meta interface{}) error
 	// Sort zones before reading
 	sort.Strings(zoneIds)
 
-	var newZoneTypes []ecs.ZoneType
+	var newZoneTypes []ecs.Zone
 	for _, id := range zoneIds {
 		newZoneTypes = append(newZoneTypes, zoneTypes[id])
 	}
@@ -173,6 +203,24 @@ func dataSourceAlicloudZonesRead(d *schema.ResourceData, meta interface{}) error
 	return zonesDescriptionAttributes(d, newZoneTypes)
 }
 
+// describeDatabaseZones returns the zones of the current region where the database product of the resource type
+// can be created. It returns false if the resource type is not one of these products.
+func (client *AliyunClient) describeDatabaseZones(resType string) (zoneIds []string, ok bool, err error) {
+	switch strings.ToLower(Trim(resType)) {
+	case strings.ToLower(string(ResourceTypeMongoDB)):
+		zoneIds, err = client.DescribeMongoDBZones()
+	case strings.ToLower(string(ResourceTypePolarDB)):
+		zoneIds, err = client.DescribePolarDBZones()
+	case strings.ToLower(string(ResourceTypeElasticsearch)):
+		zoneIds, err = client.DescribeElasticsearchZones()
+	case strings.ToLower(string(ResourceTypeHBase)):
+		zoneIds, err = client.DescribeHBaseZones()
+	default:
+		return nil, false, nil
+	}
+	return zoneIds, true, err
+}
+
 // check array constraints str
 func constraints(arr interface{}, v string) bool {
 	arrs := reflect.ValueOf(arr)
@@ -185,7 +233,7 @@ func constraints(arr interface{}, v string) bool {
 	return false
 }
 
-func zonesDescriptionAttributes(d *schema.ResourceData, types []ecs.ZoneType) error {
+func zonesDescriptionAttributes(d *schema.ResourceData, types []ecs.Zone) error {
 	var ids []string
 	var s []map[string]interface{}
 	for _, t := range types {
@@ -206,10 +254,13 @@ func zonesDescriptionAttributes(d *schema.ResourceData, types []ecs.ZoneType) er
 	if err := d.Set("zones", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 
 	return nil
@@ -228,10 +279,13 @@ func multiZonesDescriptionAttributes(d *schema.ResourceData, zones []string) err
 	if err := d.Set("zones", s); err != nil {
 		return err
 	}
+	if err := d.Set("ids", zones); err != nil {
+		return err
+	}
 
-	// create a json file in current directory and write data source to it.
+	// create a file in current directory and write data source to it.
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), s)
+		writeToFile(output.(string), s, OutputFileFormat(d.Get("output_file_format").(string)))
 	}
 
 	return nil
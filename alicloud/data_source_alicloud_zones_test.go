@@ -90,6 +90,30 @@ func TestAccAlicloudZonesDataSource_multiZone(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudZonesDataSource_databases(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudZonesDataSource_databases,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_zones.mongodb"),
+					testCheckZoneLength("data.alicloud_zones.mongodb"),
+					testAccCheckAlicloudDataSourceID("data.alicloud_zones.polardb"),
+					testCheckZoneLength("data.alicloud_zones.polardb"),
+					testAccCheckAlicloudDataSourceID("data.alicloud_zones.elasticsearch"),
+					testCheckZoneLength("data.alicloud_zones.elasticsearch"),
+					testAccCheckAlicloudDataSourceID("data.alicloud_zones.hbase"),
+					testCheckZoneLength("data.alicloud_zones.hbase"),
+				),
+			},
+		},
+	})
+}
+
 // the zone length changed occasionally
 // check by range to avoid test case failure
 func testCheckZoneLength(name string) resource.TestCheckFunc {
@@ -165,3 +189,21 @@ data "alicloud_zones" "default" {
   available_resource_creation= "Rds"
   multi = true
 }`
+
+const testAccCheckAlicloudZonesDataSource_databases = `
+data "alicloud_zones" "mongodb" {
+	available_resource_creation= "MongoDB"
+}
+
+data "alicloud_zones" "polardb" {
+	available_resource_creation= "PolarDB"
+}
+
+data "alicloud_zones" "elasticsearch" {
+	available_resource_creation= "Elasticsearch"
+}
+
+data "alicloud_zones" "hbase" {
+	available_resource_creation= "HBase"
+}
+`
@@ -5,9 +5,7 @@ import (
 
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/dns"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/denverdino/aliyungo/rds"
-	"github.com/denverdino/aliyungo/slb"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -20,7 +18,7 @@ func httpHttpsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 
 func stickySessionTypeDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	httpDiff := httpHttpsDiffSuppressFunc(k, old, new, d)
-	if session, ok := d.GetOk("sticky_session"); !httpDiff && ok && slb.FlagType(session.(string)) == slb.OnFlag {
+	if session, ok := d.GetOk("sticky_session"); !httpDiff && ok && session.(string) == SlbOnFlag {
 		return false
 	}
 	return true
@@ -28,7 +26,7 @@ func stickySessionTypeDiffSuppressFunc(k, old, new string, d *schema.ResourceDat
 
 func cookieTimeoutDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	stickSessionTypeDiff := stickySessionTypeDiffSuppressFunc(k, old, new, d)
-	if session_type, ok := d.GetOk("sticky_session_type"); !stickSessionTypeDiff && ok && slb.StickySessionType(session_type.(string)) == slb.InsertStickySessionType {
+	if session_type, ok := d.GetOk("sticky_session_type"); !stickSessionTypeDiff && ok && session_type.(string) == SlbInsertStickySessionType {
 		return false
 	}
 	return true
@@ -36,7 +34,7 @@ func cookieTimeoutDiffSuppressFunc(k, old, new string, d *schema.ResourceData) b
 
 func cookieDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	stickSessionTypeDiff := stickySessionTypeDiffSuppressFunc(k, old, new, d)
-	if session_type, ok := d.GetOk("sticky_session_type"); !stickSessionTypeDiff && ok && slb.StickySessionType(session_type.(string)) == slb.ServerStickySessionType {
+	if session_type, ok := d.GetOk("sticky_session_type"); !stickSessionTypeDiff && ok && session_type.(string) == SlbServerStickySessionType {
 		return false
 	}
 	return true
@@ -51,7 +49,7 @@ func tcpUdpDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 
 func healthCheckDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	httpDiff := httpHttpsDiffSuppressFunc(k, old, new, d)
-	if health, ok := d.GetOk("health_check"); httpDiff || (ok && slb.FlagType(health.(string)) == slb.OnFlag) {
+	if health, ok := d.GetOk("health_check"); httpDiff || (ok && health.(string) == SlbOnFlag) {
 		return false
 	}
 	return true
@@ -68,8 +66,8 @@ func httpHttpsTcpDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bo
 	health, okHc := d.GetOk("health_check")
 	protocol, okPro := d.GetOk("protocol")
 	checkType, okType := d.GetOk("health_check_type")
-	if (!httpDiff && okHc && slb.FlagType(health.(string)) == slb.OnFlag) ||
-		(okPro && Protocol(protocol.(string)) == Tcp && okType && slb.HealthCheckType(checkType.(string)) == slb.HTTPHealthCheckType) {
+	if (!httpDiff && okHc && health.(string) == SlbOnFlag) ||
+		(okPro && Protocol(protocol.(string)) == Tcp && okType && checkType.(string) == SlbHTTPHealthCheckType) {
 		return false
 	}
 	return true
@@ -81,6 +79,13 @@ func sslCertificateIdDiffSuppressFunc(k, old, new string, d *schema.ResourceData
 	return true
 }
 
+func httpsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if protocol, ok := d.GetOk("protocol"); ok && Protocol(protocol.(string)) == Https {
+		return false
+	}
+	return true
+}
+
 func dnsPriorityDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	if recordType, ok := d.GetOk("type"); ok && recordType.(string) == dns.MXRecord {
 		return false
@@ -107,7 +112,7 @@ func slbInstanceSpecDiffSuppressFunc(k, old, new string, d *schema.ResourceData)
 }
 
 func slbBandwidthDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	if slbInternetDiffSuppressFunc(k, old, new, d) && slb.InternetChargeType(d.Get("internet_charge_type").(string)) == slb.PayByBandwidth {
+	if slbInternetDiffSuppressFunc(k, old, new, d) && d.Get("internet_charge_type").(string) == SlbPayByBandwidth {
 		return false
 	}
 	return true
@@ -184,15 +189,15 @@ func ecsSpotStrategyDiffSuppressFunc(k, old, new string, d *schema.ResourceData)
 
 func ecsSpotPriceLimitDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	if common.InstanceChargeType(d.Get("instance_charge_type").(string)) == common.PostPaid &&
-		ecs.SpotStrategyType(d.Get("spot_strategy").(string)) == ecs.SpotWithPriceLimit {
+		SpotStrategyType(d.Get("spot_strategy").(string)) == SpotWithPriceLimit {
 		return false
 	}
 	return true
 }
 
 func ecsSecurityGroupRulePortRangeDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	protocol := ecs.IpProtocol(d.Get("ip_protocol").(string))
-	if protocol == ecs.IpProtocolTCP || protocol == ecs.IpProtocolUDP {
+	protocol := IpProtocol(d.Get("ip_protocol").(string))
+	if protocol == IpProtocolTCP || protocol == IpProtocolUDP {
 		if new == AllPortRange {
 			return true
 		}
@@ -210,3 +215,27 @@ func vpcTypeResourceDiffSuppressFunc(k, old, new string, d *schema.ResourceData)
 	}
 	return true
 }
+
+func kvstorePostPaidDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return PayType(d.Get("instance_charge_type").(string)) != PrePaid
+}
+
+func prePaidDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return PayType(d.Get("instance_charge_type").(string)) != PrePaid
+}
+
+func autoRenewDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return PayType(d.Get("instance_charge_type").(string)) != PrePaid || d.Get("renewal_status").(string) != RenewAutoRenewal
+}
+
+func essdDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return DiskCategory(d.Get("category").(string)) != DiskCategoryCloudESSD
+}
+
+func jsonDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && new != "" && jsonStringEqual(old, new)
+}
+
+func yamlDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && new != "" && yamlStringEqual(old, new)
+}
@@ -2,11 +2,13 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
 )
 
 const (
@@ -18,6 +20,11 @@ const (
 	MessageInstanceNotFound = "instance is not found"
 	EcsThrottling           = "Throttling"
 	EcsInternalError        = "InternalError"
+	// instance capacity
+	OperationDeniedNoStock               = "OperationDenied.NoStock"
+	InvalidInstanceTypeNotSupported      = "InvalidInstanceType.NotSupported"
+	InvalidInstanceTypeValueNotSupported = "InvalidInstanceType.ValueNotSupported"
+	InvalidResourceTypeNotSupported      = "InvalidResourceType.NotSupported"
 	// disk
 	DiskIncorrectStatus       = "IncorrectDiskStatus"
 	DiskCreatingSnapshot      = "DiskCreatingSnapshot"
@@ -26,6 +33,7 @@ const (
 	DiskOperationConflict     = "OperationConflict"
 	DiskInternalError         = "InternalError"
 	DiskInvalidOperation      = "InvalidOperation.Conflict"
+	InvalidDiskIdNotFound     = "InvalidDiskId.NotFound"
 	// eip
 	EipIncorrectStatus         = "IncorrectEipStatus"
 	InstanceIncorrectStatus    = "IncorrectInstanceStatus"
@@ -42,6 +50,8 @@ const (
 	SystemBusy                  = "SystemBusy"
 	SlbOrderFailed              = "OrderFailed"
 	VServerGroupNotFoundMessage = "The specified VServerGroupId does not exist"
+	SlbCACertificateNotFound    = "CACertificateId.NotFound"
+	SlbCACertificateInUse       = "CACertificate.InUse"
 	RspoolVipExist              = "RspoolVipExist"
 	InvalidParameter            = "InvalidParameter"
 	InvalidRuleIdNotFound       = "InvalidRuleId.NotFound"
@@ -147,6 +157,9 @@ const (
 	// cdn
 	ServiceBusy = "ServiceBusy"
 
+	// The server errors shared by all products
+	ServiceUnavailable = "ServiceUnavailable"
+
 	// KMS
 	ForbiddenKeyNotFound = "Forbidden.KeyNotFound"
 	// RAM
@@ -162,6 +175,73 @@ const (
 	ApplicationNotFound          = "Not Found"
 	ApplicationErrorIgnore       = "Unable to reach primary cluster manager"
 	ApplicationConfirmConflict   = "Conflicts with unconfirmed updates for operation"
+
+	// api gateway
+	ApiGatewayPluginNotFound   = "NotFoundPlugin"
+	ApiGatewayApiNotFound      = "NotFoundApi"
+	ApiGatewayGroupNotFound    = "NotFoundApiGroup"
+	ApiGatewayVpcAccessExists  = "RepeatedCommit"
+	ApiGatewayConcurrencyLimit = "ConcurrencyLockTimeout"
+
+	// vpc peer connection
+	VpcPeerConnectionNotFound        = "ResourceNotFound.InstanceId"
+	VpcPeerConnectionIncorrectStatus = "IncorrectStatus.VpcPeer"
+
+	// kvstore
+	KVStoreInstanceNotFound = "InvalidInstanceId.NotFound"
+
+	// dms enterprise
+	DmsEnterpriseInstanceNotFound = "InstanceNotExist"
+	DmsEnterpriseUserNotFound     = "UserNotExist"
+
+	// log
+	LogProjectNotExist     = "ProjectNotExist"
+	LogStoreNotExist       = "LogStoreNotExist"
+	LogShipperNotExist     = "ShipperNotExist"
+	LogAppNotExist         = "AppNotExist"
+	LogInternalServerError = "InternalServerError"
+
+	// cms
+	CmsResourceNotFound = "ResourceNotFound"
+
+	// event bridge
+	EventBridgeEventBusNotExist = "EventBusNotExist"
+	EventBridgeRuleNotExist     = "EventRuleNotExisted"
+
+	// cloud firewall
+	CloudFirewallAclNotExist   = "ErrorAclNotExist"
+	CloudFirewallGroupNotExist = "ErrorAddressBookNotExist"
+
+	// lindorm and tsdb
+	HitsdbInstanceNotValid = "Instance.IsNotValid"
+	HitsdbInstanceDeleted  = "Instance.IsDeleted"
+
+	// cddc
+	CddcHostGroupNotFound = "InvalidDedicatedHostGroup.NotFound"
+	CddcHostNotFound      = "InvalidDedicatedHost.NotFound"
+
+	// oos
+	OosTemplateNotExists  = "EntityNotExists.Template"
+	OosExecutionNotExists = "EntityNotExists.Execution"
+
+	// fnf
+	FnfFlowNotExists     = "FlowNotExists"
+	FnfScheduleNotExists = "ScheduleNotExists"
+
+	// mse
+	MseInstanceNotFound = "mse-200-021"
+
+	// sae
+	SaeNamespaceNotFound = "InvalidNamespaceId.NotFound"
+	SaeAppNotFound       = "InvalidAppId.NotFound"
+	SaeConfigMapNotFound = "InvalidConfigMapId.NotFound"
+
+	// dbs
+	DbsBackupPlanNotFound = "InvalidJobId.NotFound"
+
+	// hbr
+	HbrVaultNotFound = "VaultNotExist"
+	HbrPlanNotFound  = "BackupPlanNotExist"
 )
 
 // An Error represents a custom error for Terraform failure response
@@ -190,6 +270,8 @@ func GetNotFoundErrorFromString(str string) error {
 }
 
 func NotFoundError(err error) bool {
+	err = unwrapError(err)
+
 	if e, ok := err.(*common.Error); ok &&
 		(e.Code == InstanceNotFound || e.Code == RamInstanceNotFound ||
 			strings.Contains(strings.ToLower(e.Message), MessageInstanceNotFound)) {
@@ -212,6 +294,8 @@ func NotFoundError(err error) bool {
 }
 
 func IsExceptedError(err error, expectCode string) bool {
+	err = unwrapError(err)
+
 	if e, ok := err.(*common.Error); ok && (e.Code == expectCode || strings.Contains(e.Message, expectCode)) {
 		return true
 	}
@@ -223,6 +307,17 @@ func IsExceptedError(err error, expectCode string) bool {
 	if e, ok := err.(*ProviderError); ok && (e.ErrorCode() == expectCode || strings.Contains(e.Message(), expectCode)) {
 		return true
 	}
+
+	if e, ok := err.(*LogError); ok && (e.Code == expectCode || strings.Contains(e.Message, expectCode)) {
+		return true
+	}
+
+	if e, ok := err.(*DmsEnterpriseError); ok && (e.ErrorCode == expectCode || strings.Contains(e.ErrorMessage, expectCode)) {
+		return true
+	}
+	if e, ok := err.(*successFlagError); ok && (e.Code == expectCode || strings.Contains(e.Message, expectCode)) {
+		return true
+	}
 	return false
 }
 
@@ -247,3 +342,260 @@ func GetNotFoundMessage(product, id string) string {
 func GetTimeoutMessage(product, status string) string {
 	return fmt.Sprintf("Waitting for %s %s is timeout.", product, status)
 }
+
+// ErrorCategory classifies the errors which mean a request can be retried later.
+type ErrorCategory string
+
+const (
+	// The request is throttled or the service is busy.
+	ErrorCategoryThrottling = ErrorCategory("Throttling")
+	// The request conflicts with another task, or a dependency has not been synchronized yet.
+	ErrorCategoryEventualConsistency = ErrorCategory("EventualConsistency")
+	// The resource is in a status which does not allow the operation for the moment.
+	ErrorCategoryInvalidStatus = ErrorCategory("InvalidStatus")
+)
+
+// commonRetryableErrors are the retryable error codes shared by all products.
+var commonRetryableErrors = map[ErrorCategory][]string{
+	ErrorCategoryThrottling:          {EcsThrottling, "Throttling.User", "Throttling.Api", KeyPairServiceUnavailable, SystemBusy, ServiceBusy},
+	ErrorCategoryEventualConsistency: {TaskConflict},
+}
+
+// retryableErrorCatalog maintains the retryable error codes of each product, keyed by the
+// product codes used by the endpoints. Add a code here instead of checking it in a resource.
+var retryableErrorCatalog = map[string]map[ErrorCategory][]string{
+	EcsCode: {
+		ErrorCategoryEventualConsistency: {DiskOperationConflict, DiskInvalidOperation, EcsInternalError, SgDependencyViolation},
+		ErrorCategoryInvalidStatus:       {DiskIncorrectStatus, DiskCreatingSnapshot, InstanceIncorrectStatus, InstanceLockedForSecurity},
+	},
+	VpcCode: {
+		// The VPC APIs return an UnknownError while the VPC is being changed. The VPC creates retried by it carry a
+		// ClientToken, except the forward and snat entries, which are rejected instead of being created twice.
+		ErrorCategoryEventualConsistency: {"OperationConflict", "LastTokenProcessing", UnknownError, InvalidIpNotInNatgw, EIP_NOT_IN_GATEWAY,
+			DependencyViolationRouterInterfaceReferedByRouteEntry},
+		ErrorCategoryInvalidStatus: {EipIncorrectStatus, InstanceIncorrectStatus, HaVipIncorrectStatus, VswitchStatusError,
+			IncorrectRouteEntryStatus, RouterInterfaceIncorrectStatus, RouterEntryForbbiden},
+	},
+	SlbCode: {
+		ErrorCategoryEventualConsistency: {SlbCACertificateInUse, RspoolVipExist},
+		ErrorCategoryInvalidStatus:       {ServiceIsConfiguring, ServiceIsStopping, BackendServerconfiguring},
+	},
+	RdsCode: {
+		ErrorCategoryEventualConsistency: {DBInternalError},
+		ErrorCategoryInvalidStatus:       {OperationDeniedDBInstanceStatus},
+	},
+	EssCode: {
+		ErrorCategoryInvalidStatus: {IncorrectScalingGroupStatus, IncorrectScalingConfigurationLifecycleState, ScalingActivityInProgress},
+	},
+	VpcPeerCode: {
+		ErrorCategoryInvalidStatus: {VpcPeerConnectionIncorrectStatus},
+	},
+	DnsCode: {
+		ErrorCategoryInvalidStatus: {RecordForbiddenDNSChange, FobiddenNotEmptyGroup},
+	},
+	RamCode: {
+		ErrorCategoryInvalidStatus: {DeleteConflictUserGroup, DeleteConflictUserAccessKey, DeleteConflictUserLoginProfile,
+			DeleteConflictUserMFADevice, DeleteConflictUserPolicy, DeleteConflictGroupUser, DeleteConflictGroupPolicy,
+			DeleteConflictRolePolicy, DeleteConflictPolicyUser, DeleteConflictPolicyGroup, DeleteConflictPolicyVersion},
+	},
+	ApiGatewayCode: {
+		ErrorCategoryThrottling: {ApiGatewayConcurrencyLimit},
+	},
+	LogCode: {
+		ErrorCategoryEventualConsistency: {LogInternalServerError},
+	},
+}
+
+// ClassifyError returns the category of a retryable error of the product, or an empty
+// category if the error is not retryable.
+func ClassifyError(product string, err error) ErrorCategory {
+	code := GetErrorCode(err)
+	if code == "" {
+		return ""
+	}
+	for _, catalog := range []map[ErrorCategory][]string{commonRetryableErrors, retryableErrorCatalog[product]} {
+		for category, codes := range catalog {
+			for _, c := range codes {
+				if c == code {
+					return category
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// IsRetryableError returns true if the error of the product is in the retryable error catalog.
+func IsRetryableError(product string, err error) bool {
+	return ClassifyError(product, err) != ""
+}
+
+// IsServerRetryableError returns true if the request is throttled, the service is unavailable or it failed by an
+// InternalError. The errors are transient for all of the products, and the request is retried with a backoff. It is
+// only used for the requests which are safe to send again by isRetrySafe, so that a request creating a resource which
+// failed by an InternalError is only sent again with the same ClientToken.
+func IsServerRetryableError(err error) bool {
+	code := GetErrorCode(err)
+	if code == "" {
+		return false
+	}
+	return ClassifyError("", err) == ErrorCategoryThrottling || strings.HasPrefix(code, EcsThrottling) ||
+		code == ServiceUnavailable || code == EcsInternalError
+}
+
+// IsDeletionProtectionError returns true if the deletion is rejected because the deletion protection
+// of the resource is enabled. The products return different codes which all name the protection.
+func IsDeletionProtectionError(err error) bool {
+	code := GetErrorCode(err)
+	return strings.Contains(code, "DeletionProtection") || strings.Contains(code, "DeleteProtection")
+}
+
+// IsInstanceCapacityError returns true if the instance can not be created because the instance type
+// is out of stock or is not offered in the zone, in which case another type or zone may succeed.
+func IsInstanceCapacityError(err error) bool {
+	for _, code := range []string{OperationDeniedNoStock, InvalidInstanceTypeNotSupported, InvalidInstanceTypeValueNotSupported, InvalidResourceTypeNotSupported} {
+		if IsExceptedError(err, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// WrapDeletionProtectionError explains how to delete the resource whose deletion protection is enabled.
+func WrapDeletionProtectionError(err error, product, id string) error {
+	return WrapErrorf(err, "The deletion protection of %s %s is enabled. Set deletion_protection to false and apply it before deleting the %s", product, id, product)
+}
+
+// GetErrorCode returns the code of an API error, or an empty string for the other errors.
+func GetErrorCode(err error) string {
+	switch e := unwrapError(err).(type) {
+	case *common.Error:
+		return e.Code
+	case *errors.ServerError:
+		return e.ErrorCode()
+	case *ProviderError:
+		return e.ErrorCode()
+	case *LogError:
+		return e.Code
+	case *DmsEnterpriseError:
+		return e.ErrorCode
+	case *successFlagError:
+		return e.Code
+	}
+	return ""
+}
+
+// GetRequestId returns the id of the request which failed with the error, or an empty string
+// if the error does not come from an API response.
+func GetRequestId(err error) string {
+	switch e := unwrapError(err).(type) {
+	case *common.Error:
+		return e.RequestId
+	case *errors.ServerError:
+		return e.RequestId()
+	case *LogError:
+		return e.RequestId
+	case *successFlagError:
+		return e.RequestId
+	}
+	return ""
+}
+
+// WrappedError is an error of the provider which keeps the original error and the id of the
+// failed request, so that the request can be tracked when the error is reported. The API action
+// and the resource id give the context of the failure when they are known.
+type WrappedError struct {
+	Err        error
+	RequestId  string
+	Message    string
+	Action     string
+	ResourceId string
+}
+
+func (e *WrappedError) Error() string {
+	msg := e.Err.Error()
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", e.Message, msg)
+	}
+	for _, c := range [][]string{{"Action", e.Action}, {"ResourceId", e.ResourceId}, {"RequestId", e.RequestId}} {
+		if c[1] != "" && !strings.Contains(msg, c[1]) {
+			msg = fmt.Sprintf("%s %s: %s", msg, c[0], c[1])
+		}
+	}
+	return msg
+}
+
+// WrapError attaches the id of the failed request to the error. It returns nil if err is nil.
+func WrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*WrappedError); ok {
+		return err
+	}
+	return &WrappedError{
+		Err:       err,
+		RequestId: GetRequestId(err),
+	}
+}
+
+// WrapErrorf is like WrapError and it describes the failed operation with the message.
+func WrapErrorf(err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	wrapped := &WrappedError{
+		Err:       unwrapError(err),
+		RequestId: GetRequestId(err),
+		Message:   fmt.Sprintf(format, args...),
+	}
+	if e, ok := err.(*WrappedError); ok {
+		wrapped.Action, wrapped.ResourceId = e.Action, e.ResourceId
+	}
+	return wrapped
+}
+
+// WrapApiError is like WrapError and it records the API action which failed and the id of the
+// resource it was called for. The id can be empty when the resource has not been created.
+func WrapApiError(err error, action, resourceId string) error {
+	if err == nil {
+		return nil
+	}
+	wrapped := &WrappedError{
+		Err:        unwrapError(err),
+		RequestId:  GetRequestId(err),
+		Action:     action,
+		ResourceId: resourceId,
+	}
+	if e, ok := err.(*WrappedError); ok {
+		wrapped.Message = e.Message
+		if action == "" {
+			wrapped.Action = e.Action
+		}
+		if resourceId == "" {
+			wrapped.ResourceId = e.ResourceId
+		}
+	}
+	return wrapped
+}
+
+func unwrapError(err error) error {
+	if e, ok := err.(*WrappedError); ok {
+		return e.Err
+	}
+	return err
+}
+
+// RetryOnError calls f until it succeeds or the timeout is reached. The errors in the
+// retryable error catalog of the product are retried and the others are returned at once.
+func RetryOnError(product string, timeout time.Duration, f func() error) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		if err := f(); err != nil {
+			if IsRetryableError(product, err) {
+				return resource.RetryableError(WrapError(err))
+			}
+			return resource.NonRetryableError(WrapError(err))
+		}
+		return nil
+	})
+}
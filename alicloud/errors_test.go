@@ -0,0 +1,121 @@
+package alicloud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/denverdino/aliyungo/common"
+)
+
+func TestClassifyError(t *testing.T) {
+	newError := func(code string) error {
+		e := &common.Error{}
+		e.Code = code
+		e.RequestId = "request-" + code
+		return e
+	}
+
+	cases := []struct {
+		product  string
+		err      error
+		category ErrorCategory
+	}{
+		{EcsCode, newError(EcsThrottling), ErrorCategoryThrottling},
+		{VpcCode, newError(TaskConflict), ErrorCategoryEventualConsistency},
+		{VpcCode, newError(UnknownError), ErrorCategoryEventualConsistency},
+		{CmsCode, newError(UnknownError), ""},
+		{VpcCode, newError(EipIncorrectStatus), ErrorCategoryInvalidStatus},
+		{SlbCode, WrapError(newError(ServiceIsConfiguring)), ErrorCategoryInvalidStatus},
+		{SlbCode, newError(EipIncorrectStatus), ""},
+		{VpcCode, newError(VpcQuotaExceeded), ""},
+		{VpcCode, GetNotFoundErrorFromString("not found"), ""},
+	}
+	for _, c := range cases {
+		if category := ClassifyError(c.product, c.err); category != c.category {
+			t.Fatalf("Expected the %s error %s is classified as %q, got %q", c.product, GetErrorCode(c.err), c.category, category)
+		}
+	}
+}
+
+func TestWrapError(t *testing.T) {
+	if WrapError(nil) != nil || WrapErrorf(nil, "CreateVpc got an error") != nil {
+		t.Fatalf("Expected wrapping a nil error returns nil")
+	}
+
+	e := &common.Error{}
+	e.Code = InvalidVpcIDNotFound
+	e.RequestId = "F8D2A1B6-1C43-4A3E-9F0A-6A8B0C4D2E11"
+
+	err := WrapErrorf(WrapError(e), "DescribeVpcs got an error")
+	if GetRequestId(err) != e.RequestId {
+		t.Fatalf("Expected the request id %s, got %s", e.RequestId, GetRequestId(err))
+	}
+	if !strings.HasPrefix(err.Error(), "DescribeVpcs got an error: ") || !strings.Contains(err.Error(), e.RequestId) {
+		t.Fatalf("Expected the error message contains the operation and the request id, got %s", err.Error())
+	}
+	if !IsExceptedError(err, InvalidVpcIDNotFound) {
+		t.Fatalf("Expected the wrapped error keeps its code %s", InvalidVpcIDNotFound)
+	}
+}
+
+func TestIsInstanceCapacityError(t *testing.T) {
+	for code, expected := range map[string]bool{
+		OperationDeniedNoStock:               true,
+		InvalidInstanceTypeNotSupported:      true,
+		InvalidInstanceTypeValueNotSupported: true,
+		InvalidResourceTypeNotSupported:      true,
+		EcsThrottling:                        false,
+	} {
+		e := &common.Error{}
+		e.Code = code
+		if IsInstanceCapacityError(WrapError(e)) != expected {
+			t.Fatalf("Expected IsInstanceCapacityError of %s is %t", code, expected)
+		}
+	}
+}
+
+func TestIsServerRetryableError(t *testing.T) {
+	for code, expected := range map[string]bool{
+		EcsThrottling:          true,
+		"Throttling.User":      true,
+		ServiceUnavailable:     true,
+		EcsInternalError:       true,
+		SystemBusy:             true,
+		OperationDeniedNoStock: false,
+	} {
+		e := &common.Error{}
+		e.Code = code
+		if IsServerRetryableError(WrapError(e)) != expected {
+			t.Fatalf("Expected IsServerRetryableError of %s is %t", code, expected)
+		}
+	}
+}
+
+func TestWrapApiError(t *testing.T) {
+	if WrapApiError(nil, "CreateInstance", "") != nil {
+		t.Fatalf("Expected wrapping a nil error returns nil")
+	}
+
+	e := &common.Error{}
+	e.Code = OperationDeniedNoStock
+	e.RequestId = "0B6F1F3E-6E4A-4C5B-9B8E-2E8E6B0C7D21"
+
+	err := WrapApiError(e, "StartInstance", "i-abc123")
+	for _, s := range []string{"Action: StartInstance", "ResourceId: i-abc123", "RequestId: " + e.RequestId} {
+		if !strings.Contains(err.Error(), s) {
+			t.Fatalf("Expected the error message contains %q, got %s", s, err.Error())
+		}
+	}
+
+	err = WrapErrorf(err, "WaitForInstance Running got an error")
+	if !strings.HasPrefix(err.Error(), "WaitForInstance Running got an error: ") || !strings.Contains(err.Error(), "ResourceId: i-abc123") {
+		t.Fatalf("Expected the message keeps the context of the wrapped error, got %s", err.Error())
+	}
+	if !IsExceptedError(err, OperationDeniedNoStock) || GetRequestId(err) != e.RequestId {
+		t.Fatalf("Expected the wrapped error keeps its code and request id, got %s", err.Error())
+	}
+	err = WrapApiError(err, "", "")
+	if !strings.Contains(err.Error(), "Action: StartInstance") || !strings.Contains(err.Error(), "ResourceId: i-abc123") {
+		t.Fatalf("Expected an empty action and id keep the ones of the wrapped error, got %s", err.Error())
+	}
+}
@@ -0,0 +1,22 @@
+package alicloud
+
+const ApiGatewayApiVersion = "2016-07-14"
+
+type ApiGatewayPluginType string
+
+const (
+	PluginTypeIpControl        = ApiGatewayPluginType("ipControl")
+	PluginTypeTrafficControl   = ApiGatewayPluginType("trafficControl")
+	PluginTypeJwtAuth          = ApiGatewayPluginType("jwtAuth")
+	PluginTypeCors             = ApiGatewayPluginType("cors")
+	PluginTypeBackendSignature = ApiGatewayPluginType("backendSignature")
+	PluginTypeCaching          = ApiGatewayPluginType("caching")
+)
+
+type ApiGatewayStageName string
+
+const (
+	StageNameRelease = ApiGatewayStageName("RELEASE")
+	StageNamePre     = ApiGatewayStageName("PRE")
+	StageNameTest    = ApiGatewayStageName("TEST")
+)
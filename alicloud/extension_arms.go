@@ -0,0 +1,90 @@
+package alicloud
+
+const ArmsApiVersion = "2019-08-08"
+
+// The types of a Prometheus instance
+const (
+	ArmsPrometheusRemoteWrite = "remote-write"
+	ArmsPrometheusEcs         = "ecs"
+	ArmsPrometheusKubernetes  = "aliyun-cs"
+)
+
+type ArmsAlertContact struct {
+	ContactId   int64  `json:"ContactId"`
+	ContactName string `json:"ContactName"`
+	Email       string `json:"Email"`
+	Phone       string `json:"Phone"`
+	DingRobot   string `json:"DingRobot"`
+	SystemNoc   bool   `json:"SystemNoc"`
+}
+
+type ArmsAlertContactGroup struct {
+	ContactGroupId   int64              `json:"ContactGroupId"`
+	ContactGroupName string             `json:"ContactGroupName"`
+	Contacts         []ArmsAlertContact `json:"Contacts"`
+}
+
+type ArmsMatchingCondition struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Operator string `json:"operator"`
+}
+
+// ArmsMatchingRule is decoded from the response case-insensitively, so the fields are tagged as they are requested.
+type ArmsMatchingRule struct {
+	MatchingConditions []ArmsMatchingCondition `json:"matchingConditions"`
+}
+
+type ArmsSilencePolicy struct {
+	Id            int64              `json:"Id"`
+	Name          string             `json:"Name"`
+	MatchingRules []ArmsMatchingRule `json:"MatchingRules"`
+}
+
+type ArmsGroupRule struct {
+	GroupWait      int      `json:"groupWait"`
+	GroupInterval  int      `json:"groupInterval"`
+	GroupingFields []string `json:"groupingFields"`
+}
+
+type ArmsNotifyObject struct {
+	NotifyObjectType string `json:"notifyObjectType"`
+	NotifyObjectId   string `json:"notifyObjectId"`
+	NotifyObjectName string `json:"notifyObjectName"`
+}
+
+type ArmsNotifyRule struct {
+	NotifyStartTime string             `json:"notifyStartTime"`
+	NotifyEndTime   string             `json:"notifyEndTime"`
+	NotifyChannels  []string           `json:"notifyChannels"`
+	NotifyObjects   []ArmsNotifyObject `json:"notifyObjects"`
+}
+
+type ArmsNotificationPolicy struct {
+	Id                 int64              `json:"Id"`
+	Name               string             `json:"Name"`
+	MatchingRules      []ArmsMatchingRule `json:"MatchingRules"`
+	GroupRule          ArmsGroupRule      `json:"GroupRule"`
+	NotifyRule         ArmsNotifyRule     `json:"NotifyRule"`
+	RepeatInterval     int                `json:"RepeatInterval"`
+	SendRecoverMessage bool               `json:"SendRecoverMessage"`
+}
+
+type ArmsPrometheus struct {
+	ClusterId         string `json:"ClusterId"`
+	ClusterName       string `json:"ClusterName"`
+	ClusterType       string `json:"ClusterType"`
+	VpcId             string `json:"VpcId"`
+	VSwitchId         string `json:"VSwitchId"`
+	SecurityGroupId   string `json:"SecurityGroupId"`
+	GrafanaInstanceId string `json:"GrafanaInstanceId"`
+	RemoteWriteUrl    string `json:"RemoteWriteInterUrl"`
+	RemoteReadUrl     string `json:"RemoteReadInterUrl"`
+	HttpApiInterUrl   string `json:"HttpApiInterUrl"`
+	HttpApiIntraUrl   string `json:"HttpApiIntraUrl"`
+}
+
+type ArmsRemoteWrite struct {
+	RemoteWriteName string `json:"RemoteWriteName"`
+	RemoteWriteYaml string `json:"RemoteWriteYaml"`
+}
@@ -0,0 +1,39 @@
+package alicloud
+
+const CddcApiVersion = "2020-03-20"
+
+// The status of a dedicated host which is running
+const CddcHostRunning = "1"
+
+// The allocation statuses of a dedicated host
+const (
+	CddcHostAllocatable = "Allocatable"
+	CddcHostSuspended   = "Suspended"
+)
+
+type CddcDedicatedHostGroup struct {
+	DedicatedHostGroupId   string `json:"DedicatedHostGroupId"`
+	DedicatedHostGroupDesc string `json:"DedicatedHostGroupDesc"`
+	Engine                 string `json:"Engine"`
+	VPCId                  string `json:"VPCId"`
+	CpuAllocationRatio     int    `json:"CpuAllocationRatio"`
+	MemAllocationRatio     int    `json:"MemAllocationRatio"`
+	DiskAllocationRatio    int    `json:"DiskAllocationRatio"`
+	AllocationPolicy       string `json:"AllocationPolicy"`
+	HostReplacePolicy      string `json:"HostReplacePolicy"`
+	OpenPermission         string `json:"OpenPermission"`
+}
+
+type CddcDedicatedHost struct {
+	DedicatedHostGroupId string `json:"DedicatedHostGroupId"`
+	DedicatedHostId      string `json:"DedicatedHostId"`
+	HostName             string `json:"HostName"`
+	HostClass            string `json:"HostClass"`
+	ZoneId               string `json:"ZoneId"`
+	VSwitchId            string `json:"VSwitchId"`
+	VPCID                string `json:"VPCID"`
+	IPAddress            string `json:"IPAddress"`
+	HostStatus           string `json:"HostStatus"`
+	AllocationStatus     string `json:"AllocationStatus"`
+	ExpiredTime          string `json:"ExpiredTime"`
+}
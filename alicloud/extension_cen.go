@@ -0,0 +1,10 @@
+package alicloud
+
+const CenApiVersion = "2017-09-12"
+
+// The child instance types which can be attached to a CEN instance
+const (
+	CenChildInstanceVpc = "VPC"
+	CenChildInstanceVbr = "VBR"
+	CenChildInstanceCcn = "CCN"
+)
@@ -0,0 +1,49 @@
+package alicloud
+
+const CloudFirewallApiVersion = "2017-12-07"
+
+// The directions of the internet border control policies
+const (
+	CloudFirewallDirectionIn  = "in"
+	CloudFirewallDirectionOut = "out"
+)
+
+// The versions of a cloud firewall instance
+const (
+	CloudFirewallPremiumVersion    = "premium_version"
+	CloudFirewallEnterpriseVersion = "enterprise_version"
+	CloudFirewallUltimateVersion   = "ultimate_version"
+)
+
+type CloudFirewallControlPolicy struct {
+	AclUuid         string `json:"AclUuid"`
+	AclAction       string `json:"AclAction"`
+	ApplicationName string `json:"ApplicationName"`
+	Description     string `json:"Description"`
+	DestPort        string `json:"DestPort"`
+	DestPortGroup   string `json:"DestPortGroup"`
+	DestPortType    string `json:"DestPortType"`
+	Destination     string `json:"Destination"`
+	DestinationType string `json:"DestinationType"`
+	Direction       string `json:"Direction"`
+	Proto           string `json:"Proto"`
+	Source          string `json:"Source"`
+	SourceType      string `json:"SourceType"`
+	Order           int    `json:"Order"`
+}
+
+type CloudFirewallTag struct {
+	TagKey   string `json:"TagKey"`
+	TagValue string `json:"TagValue"`
+}
+
+type CloudFirewallAddressBook struct {
+	GroupUuid     string             `json:"GroupUuid"`
+	GroupName     string             `json:"GroupName"`
+	GroupType     string             `json:"GroupType"`
+	Description   string             `json:"Description"`
+	AddressList   []string           `json:"AddressList"`
+	AutoAddTagEcs int                `json:"AutoAddTagEcs"`
+	TagRelation   string             `json:"TagRelation"`
+	TagList       []CloudFirewallTag `json:"TagList"`
+}
@@ -0,0 +1,84 @@
+package alicloud
+
+const CmsApiVersion = "2019-01-01"
+
+// The relations of the match expressions of a dynamic tag group
+const (
+	CmsFilterRelationAnd = "and"
+	CmsFilterRelationOr  = "or"
+)
+
+type CmsMonitorGroup struct {
+	GroupId          int64  `json:"GroupId"`
+	GroupName        string `json:"GroupName"`
+	Type             string `json:"Type"`
+	DynamicTagRuleId string `json:"DynamicTagRuleId"`
+	ContactGroups    struct {
+		ContactGroup []struct {
+			Name string `json:"Name"`
+		} `json:"ContactGroup"`
+	} `json:"ContactGroups"`
+	TemplateIds struct {
+		TemplateId []string `json:"TemplateId"`
+	} `json:"TemplateIds"`
+}
+
+type CmsDynamicTagGroup struct {
+	DynamicTagRuleId           string `json:"DynamicTagRuleId"`
+	TagKey                     string `json:"TagKey"`
+	Status                     string `json:"Status"`
+	MatchExpressFilterRelation string `json:"MatchExpressFilterRelation"`
+	MatchExpress               struct {
+		MatchExpress []struct {
+			TagValue              string `json:"TagValue"`
+			TagValueMatchFunction string `json:"TagValueMatchFunction"`
+		} `json:"MatchExpress"`
+	} `json:"MatchExpress"`
+	ContactGroupList struct {
+		ContactGroupList []string `json:"ContactGroupList"`
+	} `json:"ContactGroupList"`
+	TemplateIdList struct {
+		TemplateIdList []string `json:"TemplateIdList"`
+	} `json:"TemplateIdList"`
+}
+
+// The modes to apply a metric rule template to a monitor group
+const (
+	CmsApplyModeGroupInstanceFirst = "GROUP_INSTANCE_FIRST"
+	CmsApplyModeAlarmTemplateFirst = "ALARM_TEMPLATE_FIRST"
+)
+
+// The alarm levels of the escalations of a metric rule
+var CmsAlarmLevels = []string{"critical", "warn", "info"}
+
+type CmsEscalation struct {
+	Comparison string `json:"Comparison"`
+	Statistics string `json:"Statistics"`
+	Threshold  string `json:"Threshold"`
+	Times      int    `json:"Times"`
+}
+
+type CmsAlertTemplate struct {
+	Category    string `json:"Category"`
+	Namespace   string `json:"Namespace"`
+	MetricName  string `json:"MetricName"`
+	RuleName    string `json:"RuleName"`
+	Period      int    `json:"Period"`
+	Selector    string `json:"Selector"`
+	Webhook     string `json:"Webhook"`
+	Escalations struct {
+		Critical CmsEscalation `json:"Critical"`
+		Warn     CmsEscalation `json:"Warn"`
+		Info     CmsEscalation `json:"Info"`
+	} `json:"Escalations"`
+}
+
+type CmsMetricRuleTemplate struct {
+	TemplateId     int64  `json:"TemplateId"`
+	Name           string `json:"Name"`
+	Description    string `json:"Description"`
+	RestVersion    int64  `json:"RestVersion"`
+	AlertTemplates struct {
+		AlertTemplate []CmsAlertTemplate `json:"AlertTemplate"`
+	} `json:"AlertTemplates"`
+}
@@ -0,0 +1,34 @@
+package alicloud
+
+const DbsApiVersion = "2019-03-06"
+
+// The status of a backup plan
+const (
+	DbsBackupPlanWait    = "wait"
+	DbsBackupPlanRunning = "running"
+)
+
+// DbsBackupPlan is a backup plan of Database Backup, and BackupPeriod is the days of the week to back up, like
+// "Monday,Thursday".
+type DbsBackupPlan struct {
+	BackupPlanId                      string `json:"BackupPlanId"`
+	BackupPlanName                    string `json:"BackupPlanName"`
+	BackupPlanStatus                  string `json:"BackupPlanStatus"`
+	BackupMethod                      string `json:"BackupMethod"`
+	InstanceClass                     string `json:"InstanceClass"`
+	SourceEndpointInstanceType        string `json:"SourceEndpointInstanceType"`
+	SourceEndpointRegion              string `json:"SourceEndpointRegion"`
+	SourceEndpointInstanceID          string `json:"SourceEndpointInstanceID"`
+	SourceEndpointIpPort              string `json:"SourceEndpointIpPort"`
+	SourceEndpointDatabaseName        string `json:"SourceEndpointDatabaseName"`
+	SourceEndpointUserName            string `json:"SourceEndpointUserName"`
+	DatabaseType                      string `json:"DatabaseType"`
+	BackupObjects                     string `json:"BackupObjects"`
+	BackupPeriod                      string `json:"BackupPeriod"`
+	BackupStartTime                   string `json:"BackupStartTime"`
+	EnableBackupLog                   bool   `json:"EnableBackupLog"`
+	OSSBucketName                     string `json:"OSSBucketName"`
+	BackupRetentionPeriod             int    `json:"BackupRetentionPeriod"`
+	DuplicationArchivePeriod          int    `json:"DuplicationArchivePeriod"`
+	DuplicationInfrequentAccessPeriod int    `json:"DuplicationInfrequentAccessPeriod"`
+}
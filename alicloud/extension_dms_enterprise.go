@@ -0,0 +1,10 @@
+package alicloud
+
+const DmsEnterpriseApiVersion = "2018-11-01"
+
+type DmsUserStatus string
+
+const (
+	DmsUserNormal  = DmsUserStatus("NORMAL")
+	DmsUserDisable = DmsUserStatus("DISABLE")
+)
@@ -1,7 +1,5 @@
 package alicloud
 
-import "github.com/denverdino/aliyungo/ecs"
-
 type GroupRuleNicType string
 
 const (
@@ -21,6 +19,24 @@ const (
 	EcsApiVersion20140526 = "2014-05-26"
 )
 
+// The types of a security group, and the enterprise one supports more instances and network interfaces
+const (
+	SecurityGroupTypeNormal     = "normal"
+	SecurityGroupTypeEnterprise = "enterprise"
+)
+
+// The billing modes of a stopped instance
+const (
+	StoppedModeKeepCharging = "KeepCharging"
+	StoppedModeStopCharging = "StopCharging"
+)
+
+// The types of the network interfaces attached to an instance
+const (
+	NetworkInterfaceTypePrimary   = "Primary"
+	NetworkInterfaceTypeSecondary = "Secondary"
+)
+
 const GenerationOne = "ecs-1"
 const GenerationTwo = "ecs-2"
 const GenerationThree = "ecs-3"
@@ -30,19 +46,116 @@ var NoneIoOptimizedFamily = map[string]string{"ecs.t1": "", "ecs.t2": "", "ecs.s
 var NoneIoOptimizedInstanceType = map[string]string{"ecs.s2.small": ""}
 var HalfIoOptimizedFamily = map[string]string{"ecs.s2": "", "ecs.s3": "", "ecs.m1": "", "ecs.m2": "", "ecs.c1": "", "ecs.c2": ""}
 
-var OutdatedDiskCategory = map[ecs.DiskCategory]ecs.DiskCategory{
-	ecs.DiskCategoryCloud: ecs.DiskCategoryCloud}
+type DiskCategory string
+
+const (
+	DiskCategoryCloud           = DiskCategory("cloud")
+	DiskCategoryCloudEfficiency = DiskCategory("cloud_efficiency")
+	DiskCategoryCloudSSD        = DiskCategory("cloud_ssd")
+	DiskCategoryCloudESSD       = DiskCategory("cloud_essd")
+)
+
+var OutdatedDiskCategory = map[DiskCategory]DiskCategory{
+	DiskCategoryCloud: DiskCategoryCloud}
 
-var SupportedDiskCategory = map[ecs.DiskCategory]ecs.DiskCategory{
-	ecs.DiskCategoryCloudSSD:        ecs.DiskCategoryCloudSSD,
-	ecs.DiskCategoryCloudEfficiency: ecs.DiskCategoryCloudEfficiency,
-	ecs.DiskCategoryCloud:           ecs.DiskCategoryCloud}
+var SupportedDiskCategory = map[DiskCategory]DiskCategory{
+	DiskCategoryCloudESSD:       DiskCategoryCloudESSD,
+	DiskCategoryCloudSSD:        DiskCategoryCloudSSD,
+	DiskCategoryCloudEfficiency: DiskCategoryCloudEfficiency,
+	DiskCategoryCloud:           DiskCategoryCloud}
+
+// The types of the disks queried by DescribeDisks
+const (
+	DiskTypeAll    = "all"
+	DiskTypeSystem = "system"
+	DiskTypeData   = "data"
+)
+
+type Direction string
+
+const (
+	DirectionIngress = Direction("ingress")
+	DirectionEgress  = Direction("egress")
+	DirectionAll     = Direction("all")
+)
+
+type IpProtocol string
+
+const (
+	IpProtocolAll  = IpProtocol("all")
+	IpProtocolTCP  = IpProtocol("tcp")
+	IpProtocolUDP  = IpProtocol("udp")
+	IpProtocolICMP = IpProtocol("icmp")
+	IpProtocolGRE  = IpProtocol("gre")
+)
+
+type GroupInnerAccessPolicy string
+
+const (
+	GroupInnerAccept = GroupInnerAccessPolicy("Accept")
+	GroupInnerDrop   = GroupInnerAccessPolicy("Drop")
+)
+
+type IoOptimizedType string
+
+const (
+	IoOptimizedNone      = IoOptimizedType("none")
+	IoOptimizedOptimized = IoOptimizedType("optimized")
+)
+
+type SpotStrategyType string
+
+const (
+	NoSpot             = SpotStrategyType("NoSpot")
+	SpotAsPriceGo      = SpotStrategyType("SpotAsPriceGo")
+	SpotWithPriceLimit = SpotStrategyType("SpotWithPriceLimit")
+)
+
+type ImageOwnerAlias string
+
+const (
+	ImageOwnerSystem      = ImageOwnerAlias("system")
+	ImageOwnerSelf        = ImageOwnerAlias("self")
+	ImageOwnerOthers      = ImageOwnerAlias("others")
+	ImageOwnerMarketplace = ImageOwnerAlias("marketplace")
+	ImageOwnerDefault     = ImageOwnerAlias("")
+)
+
+// The performance levels of an ESSD disk
+const (
+	DiskPerformanceLevel0 = "PL0"
+	DiskPerformanceLevel1 = "PL1"
+	DiskPerformanceLevel2 = "PL2"
+	DiskPerformanceLevel3 = "PL3"
+)
+
+const (
+	CreditSpecificationStandard  = "Standard"
+	CreditSpecificationUnlimited = "Unlimited"
+)
+
+// The strategies of a deployment set
+const (
+	DeploymentSetAvailability      = "Availability"
+	DeploymentSetAvailabilityGroup = "AvailabilityGroup"
+	DeploymentSetLowLatency        = "LowLatency"
+)
 
 const AllPortRange = "-1/-1"
 
+// SecurityGroupRuleBatchSize is the maximum number of rules which can be authorized or revoked in one request
+const SecurityGroupRuleBatchSize = 100
+
 const (
 	KubernetesImageId       = "centos_7"
 	KubernetesMasterNumber  = 3
 	KubernetesVersion       = "1.9.3"
 	KubernetesDockerVersion = "17.06.2-ce-1"
 )
+
+type KubernetesType string
+
+const (
+	KubernetesClusterType        = KubernetesType("Kubernetes")
+	ManagedKubernetesClusterType = KubernetesType("ManagedKubernetes")
+)
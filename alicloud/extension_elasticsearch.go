@@ -0,0 +1,3 @@
+package alicloud
+
+const ElasticsearchApiVersion = "2017-06-13"
@@ -0,0 +1,39 @@
+package alicloud
+
+const EssApiVersion = "2014-08-28"
+
+// The policies of a scaling group to distribute its instances among the zones of its vswitches
+const (
+	EssMultiAZPolicyPriority      = "PRIORITY"
+	EssMultiAZPolicyCostOptimized = "COST_OPTIMIZED"
+	EssMultiAZPolicyBalance       = "BALANCE"
+)
+
+// EssScalingGroupAllocation is the allocation of the instances of a scaling group, which the aliyungo client
+// does not support.
+type EssScalingGroupAllocation struct {
+	ScalingGroupId                      string `json:"ScalingGroupId"`
+	MultiAZPolicy                       string `json:"MultiAZPolicy"`
+	OnDemandBaseCapacity                int    `json:"OnDemandBaseCapacity"`
+	OnDemandPercentageAboveBaseCapacity int    `json:"OnDemandPercentageAboveBaseCapacity"`
+	SpotInstancePools                   int    `json:"SpotInstancePools"`
+	SpotInstanceRemedy                  bool   `json:"SpotInstanceRemedy"`
+}
+
+type EssSpotPriceModel struct {
+	InstanceType string  `json:"InstanceType"`
+	PriceLimit   float64 `json:"PriceLimit"`
+}
+
+// EssScalingConfigurationSpot is the instance types and the spot strategy of a scaling configuration, which
+// the aliyungo client does not support.
+type EssScalingConfigurationSpot struct {
+	ScalingConfigurationId string `json:"ScalingConfigurationId"`
+	InstanceTypes          struct {
+		InstanceType []string `json:"InstanceType"`
+	} `json:"InstanceTypes"`
+	SpotStrategy   string `json:"SpotStrategy"`
+	SpotPriceLimit struct {
+		SpotPriceModel []EssSpotPriceModel `json:"SpotPriceModel"`
+	} `json:"SpotPriceLimit"`
+}
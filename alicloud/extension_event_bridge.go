@@ -0,0 +1,41 @@
+package alicloud
+
+const EventBridgeApiVersion = "2020-04-01"
+
+// The statuses of an event rule
+const (
+	EventBridgeRuleEnable  = "ENABLE"
+	EventBridgeRuleDisable = "DISABLE"
+)
+
+type EventBridgeEventBus struct {
+	EventBusName     string `json:"EventBusName"`
+	Description      string `json:"Description"`
+	EventBusARN      string `json:"EventBusARN"`
+	CreatedTimestamp int64  `json:"CreatedTimestamp"`
+}
+
+type EventBridgeTargetParam struct {
+	ResourceKey string `json:"ResourceKey"`
+	Form        string `json:"Form"`
+	Value       string `json:"Value,omitempty"`
+	Template    string `json:"Template,omitempty"`
+}
+
+type EventBridgeTarget struct {
+	Id                string                   `json:"Id"`
+	Type              string                   `json:"Type"`
+	Endpoint          string                   `json:"Endpoint"`
+	PushRetryStrategy string                   `json:"PushRetryStrategy,omitempty"`
+	ParamList         []EventBridgeTargetParam `json:"ParamList"`
+}
+
+type EventBridgeRule struct {
+	EventBusName  string              `json:"EventBusName"`
+	RuleName      string              `json:"RuleName"`
+	RuleARN       string              `json:"RuleARN"`
+	Description   string              `json:"Description"`
+	FilterPattern string              `json:"FilterPattern"`
+	Status        string              `json:"Status"`
+	Targets       []EventBridgeTarget `json:"Targets"`
+}
@@ -0,0 +1,3 @@
+package alicloud
+
+const FcApiVersion = "2021-04-06"
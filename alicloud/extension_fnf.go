@@ -0,0 +1,28 @@
+package alicloud
+
+const FnfApiVersion = "2019-03-15"
+
+// The type of a flow, which is defined in the Flow Definition Language
+const FnfFlowTypeFDL = "FDL"
+
+type FnfFlow struct {
+	Id               string `json:"Id"`
+	Name             string `json:"Name"`
+	Description      string `json:"Description"`
+	Definition       string `json:"Definition"`
+	Type             string `json:"Type"`
+	RoleArn          string `json:"RoleArn"`
+	CreatedTime      string `json:"CreatedTime"`
+	LastModifiedTime string `json:"LastModifiedTime"`
+}
+
+type FnfSchedule struct {
+	ScheduleId       string `json:"ScheduleId"`
+	ScheduleName     string `json:"ScheduleName"`
+	Description      string `json:"Description"`
+	CronExpression   string `json:"CronExpression"`
+	Payload          string `json:"Payload"`
+	Enable           bool   `json:"Enable"`
+	CreatedTime      string `json:"CreatedTime"`
+	LastModifiedTime string `json:"LastModifiedTime"`
+}
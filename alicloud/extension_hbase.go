@@ -0,0 +1,3 @@
+package alicloud
+
+const HBaseApiVersion = "2019-01-01"
@@ -0,0 +1,46 @@
+package alicloud
+
+const HbrApiVersion = "2017-09-08"
+
+// The source types of the backup plans
+const (
+	HbrSourceEcsFile = "ECS_FILE"
+	HbrSourceOss     = "OSS"
+	HbrSourceNas     = "NAS"
+)
+
+// The status of a vault which is ready
+const HbrVaultCreated = "CREATED"
+
+type HbrVault struct {
+	VaultId     string `json:"VaultId"`
+	VaultName   string `json:"VaultName"`
+	VaultType   string `json:"VaultType"`
+	Description string `json:"Description"`
+	Status      string `json:"Status"`
+}
+
+// HbrBackupPlan is a backup plan of any source type. Schedule is formatted "I|{startTime}|{interval}", like
+// "I|1602673264|P1D", and Retention is in days.
+type HbrBackupPlan struct {
+	PlanId     string `json:"PlanId"`
+	PlanName   string `json:"PlanName"`
+	VaultId    string `json:"VaultId"`
+	SourceType string `json:"SourceType"`
+	Schedule   string `json:"Schedule"`
+	Retention  int    `json:"Retention"`
+	BackupType string `json:"BackupType"`
+	Disabled   bool   `json:"Disabled"`
+	InstanceId string `json:"InstanceId"`
+	Paths      struct {
+		Path []string `json:"Path"`
+	} `json:"Paths"`
+	Include      string `json:"Include"`
+	Exclude      string `json:"Exclude"`
+	SpeedLimit   string `json:"SpeedLimit"`
+	Options      string `json:"Options"`
+	Bucket       string `json:"Bucket"`
+	Prefix       string `json:"Prefix"`
+	FileSystemId string `json:"FileSystemId"`
+	CreateTime   int64  `json:"CreateTime"`
+}
@@ -0,0 +1,69 @@
+package alicloud
+
+// Lindorm and TSDB share the endpoint of HiTSDB, and they are distinguished by the API versions.
+const (
+	LindormApiVersion = "2020-06-15"
+	TsdbApiVersion    = "2017-06-01"
+)
+
+// The status of a running Lindorm or TSDB instance
+const HitsdbActivation = "ACTIVATION"
+
+// The engines of a TSDB instance
+const (
+	TsdbEngineTsdb     = "tsdb_tsdb"
+	TsdbEngineInfluxdb = "tsdb_influxdb"
+)
+
+// LindormEngine is an engine of a Lindorm instance, like lindorm, solr and tsdb. CoreCount is the number of its nodes.
+type LindormEngine struct {
+	Engine    string `json:"Engine"`
+	Version   string `json:"Version"`
+	CoreCount string `json:"CoreCount"`
+	CpuCount  string `json:"CpuCount"`
+}
+
+type LindormInstance struct {
+	InstanceId         string          `json:"InstanceId"`
+	InstanceAlias      string          `json:"InstanceAlias"`
+	InstanceStatus     string          `json:"InstanceStatus"`
+	PayType            string          `json:"PayType"`
+	ZoneId             string          `json:"ZoneId"`
+	VpcId              string          `json:"VpcId"`
+	VswitchId          string          `json:"VswitchId"`
+	DiskCategory       string          `json:"DiskCategory"`
+	InstanceStorage    string          `json:"InstanceStorage"`
+	ColdStorage        int             `json:"ColdStorage"`
+	DeletionProtection string          `json:"DeletionProtection"`
+	ExpireTime         string          `json:"ExpireTime"`
+	EngineList         []LindormEngine `json:"EngineList"`
+}
+
+type TsdbInstance struct {
+	InstanceId      string `json:"InstanceId"`
+	InstanceAlias   string `json:"InstanceAlias"`
+	InstanceClass   string `json:"InstanceClass"`
+	InstanceStorage string `json:"InstanceStorage"`
+	Status          string `json:"Status"`
+	PaymentType     string `json:"PaymentType"`
+	ZoneId          string `json:"ZoneId"`
+	VpcId           string `json:"VpcId"`
+	VswitchId       string `json:"VswitchId"`
+	EngineType      string `json:"EngineType"`
+	ExpiredTime     string `json:"ExpiredTime"`
+	// DataRetention is the days to keep the data, and 0 means forever.
+	DataRetention          int    `json:"DataRetention"`
+	ConnectionString       string `json:"ConnectionString"`
+	PublicConnectionString string `json:"PublicConnectionString"`
+}
+
+// lindormEngines maps the engines of a Lindorm instance in the schema to their parameters in the API, like LindormNum
+// and LindormSpec. The engine is named by the lower case of the parameter in the response.
+var lindormEngines = []struct {
+	Prefix string
+	Param  string
+}{
+	{"wide_table", "Lindorm"},
+	{"search", "Solr"},
+	{"time_series", "Tsdb"},
+}
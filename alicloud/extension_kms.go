@@ -1,5 +1,7 @@
 package alicloud
 
+const KmsApiVersion = "2016-01-20"
+
 type KeyState string
 
 const (
@@ -7,3 +9,9 @@ const (
 	Disabled        = KeyState("Disabled")
 	PendingDeletion = KeyState("PendingDeletion")
 )
+
+// KmsSecretCurrentStage is the stage of the current version of a secret
+const KmsSecretCurrentStage = "ACSCurrent"
+
+// KmsAliasPrefix is the prefix of the names of all the aliases
+const KmsAliasPrefix = "alias/"
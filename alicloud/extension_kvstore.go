@@ -0,0 +1,35 @@
+package alicloud
+
+const KVStoreApiVersion = "2015-01-01"
+
+// The resource type of a KVStore instance used by the TagResources API
+const TagResourceKVStoreInstance = "INSTANCE"
+
+type KVStoreEngine string
+
+const (
+	KVStoreRedis    = KVStoreEngine("Redis")
+	KVStoreMemcache = KVStoreEngine("Memcache")
+)
+
+// The architectures of a KVStore instance
+const (
+	KVStoreArchitectureStandard  = "standard"
+	KVStoreArchitectureCluster   = "cluster"
+	KVStoreArchitectureReadSplit = "rwsplit"
+)
+
+// The statuses of a KVStore instance
+const (
+	KVStoreNormal                = Status("Normal")
+	KVStoreCreating              = Status("Creating")
+	KVStoreChanging              = Status("Changing")
+	KVStoreMajorVersionUpgrading = Status("MajorVersionUpgrading")
+	KVStoreTransforming          = Status("Transforming")
+)
+
+// The time when the engine version upgrade of a KVStore instance takes effect
+const (
+	KVStoreEffectiveImmediately  = "Immediately"
+	KVStoreEffectiveMaintainTime = "MaintainTime"
+)
@@ -0,0 +1,21 @@
+package alicloud
+
+const (
+	LogApiVersion       = "0.6.0"
+	LogSignatureMethod  = "hmac-sha1"
+	LogPopApiVersion    = "2019-10-23"
+	LogAuditAppType     = "audit"
+	LogAuditProjectName = "slsaudit-center-%s-%s"
+	LogAuditLogstore    = "slsaudit-center-log"
+)
+
+const (
+	LogShipperTargetOss = "oss"
+
+	LogShipperFormatJson    = "json"
+	LogShipperFormatCsv     = "csv"
+	LogShipperFormatParquet = "parquet"
+
+	LogCompressSnappy = "snappy"
+	LogCompressNone   = "none"
+)
@@ -0,0 +1,40 @@
+package alicloud
+
+const MarketApiVersion = "2015-11-01"
+
+// The type of the image products
+const MarketProductImage = "MIRROR"
+
+type MarketProduct struct {
+	Code             string `json:"Code"`
+	Name             string `json:"Name"`
+	CategoryId       int    `json:"CategoryId"`
+	SupplierName     string `json:"SupplierName"`
+	ShortDescription string `json:"ShortDescription"`
+	DeliveryWay      string `json:"DeliveryWay"`
+	ImageUrl         string `json:"ImageUrl"`
+	PriceInfo        string `json:"PriceInfo"`
+	Score            string `json:"Score"`
+	TargetUrl        string `json:"TargetUrl"`
+}
+
+// MarketCommodity is the commodity to order, which is requested in JSON.
+type MarketCommodity struct {
+	ProductCode  string            `json:"productCode"`
+	SkuCode      string            `json:"skuCode"`
+	ChargeType   string            `json:"chargeType"`
+	Duration     int               `json:"duration,omitempty"`
+	PricingCycle string            `json:"pricingCycle,omitempty"`
+	Quantity     int               `json:"quantity"`
+	Components   map[string]string `json:"components"`
+}
+
+type MarketOrder struct {
+	OrderId        int64    `json:"OrderId"`
+	ProductCode    string   `json:"ProductCode"`
+	ProductSkuCode string   `json:"ProductSkuCode"`
+	OrderStatus    string   `json:"OrderStatus"`
+	PayStatus      string   `json:"PayStatus"`
+	InstanceIds    []string `json:"InstanceIds"`
+	CreatedOn      int64    `json:"CreatedOn"`
+}
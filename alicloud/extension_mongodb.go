@@ -0,0 +1,3 @@
+package alicloud
+
+const MongoDBApiVersion = "2015-12-01"
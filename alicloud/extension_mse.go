@@ -0,0 +1,51 @@
+package alicloud
+
+const MseApiVersion = "2019-05-31"
+
+// The engines of a cluster
+const (
+	MseZooKeeper = "ZooKeeper"
+	MseNacos     = "Nacos-Ans"
+	MseEureka    = "Eureka"
+)
+
+// The network types of a cluster
+const (
+	MsePrivateNet = "privatenet"
+	MsePublicNet  = "pubnet"
+)
+
+// The status of a cluster which is ready
+const MseClusterInitSuccess = "INIT_SUCCESS"
+
+// The status of a gateway which is running
+const MseGatewayRunning = 2
+
+type MseCluster struct {
+	InstanceId       string `json:"InstanceId"`
+	ClusterId        string `json:"ClusterId"`
+	ClusterAliasName string `json:"ClusterAliasName"`
+	ClusterType      string `json:"ClusterType"`
+	ClusterVersion   string `json:"ClusterVersion"`
+	InstanceCount    int    `json:"InstanceCount"`
+	NetType          string `json:"NetType"`
+	PubNetworkFlow   string `json:"PubNetworkFlow"`
+	VSwitchId        string `json:"VSwitchId"`
+	VpcId            string `json:"VpcId"`
+	DiskType         string `json:"DiskType"`
+	InitStatus       string `json:"InitStatus"`
+	IntranetDomain   string `json:"IntranetDomain"`
+	InternetDomain   string `json:"InternetDomain"`
+}
+
+type MseGateway struct {
+	GatewayUniqueId string `json:"GatewayUniqueId"`
+	Name            string `json:"Name"`
+	Replica         int    `json:"Replica"`
+	Spec            string `json:"Spec"`
+	VpcId           string `json:"VpcId"`
+	VSwitchId       string `json:"Vswitch"`
+	BackupVSwitchId string `json:"Vswitch2"`
+	Status          int    `json:"Status"`
+	StatusDesc      string `json:"StatusDesc"`
+}
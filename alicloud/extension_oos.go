@@ -0,0 +1,46 @@
+package alicloud
+
+const OosApiVersion = "2019-06-01"
+
+// The modes of an execution
+const (
+	OosExecutionAutomatic = "Automatic"
+	OosExecutionDebug     = "Debug"
+)
+
+// The statuses of an execution
+const (
+	OosExecutionStarted   = "Started"
+	OosExecutionQueued    = "Queued"
+	OosExecutionRunning   = "Running"
+	OosExecutionWaiting   = "Waiting"
+	OosExecutionSuccess   = "Success"
+	OosExecutionFailed    = "Failed"
+	OosExecutionCancelled = "Cancelled"
+)
+
+type OosTemplate struct {
+	TemplateId      string `json:"TemplateId"`
+	TemplateName    string `json:"TemplateName"`
+	TemplateVersion string `json:"TemplateVersion"`
+	TemplateFormat  string `json:"TemplateFormat"`
+	Description     string `json:"Description"`
+	Hash            string `json:"Hash"`
+	CreatedBy       string `json:"CreatedBy"`
+	CreatedDate     string `json:"CreatedDate"`
+	UpdatedDate     string `json:"UpdatedDate"`
+}
+
+type OosExecution struct {
+	ExecutionId     string `json:"ExecutionId"`
+	TemplateName    string `json:"TemplateName"`
+	TemplateVersion string `json:"TemplateVersion"`
+	Mode            string `json:"Mode"`
+	SafetyCheck     string `json:"SafetyCheck"`
+	Description     string `json:"Description"`
+	Outputs         string `json:"Outputs"`
+	Status          string `json:"Status"`
+	StatusMessage   string `json:"StatusMessage"`
+	StartDate       string `json:"StartDate"`
+	EndDate         string `json:"EndDate"`
+}
@@ -0,0 +1,3 @@
+package alicloud
+
+const PolarDBApiVersion = "2017-08-01"
@@ -0,0 +1,44 @@
+package alicloud
+
+const QuotasApiVersion = "2020-05-10"
+
+// The status of a quota application
+const (
+	QuotasApplicationDisagree = "Disagree"
+	QuotasApplicationProcess  = "Process"
+	QuotasApplicationAgree    = "Agree"
+)
+
+// QuotasQuota is a quota of a product. Dimensions are the scope of the quota, like {"regionId": "cn-hangzhou"}.
+type QuotasQuota struct {
+	QuotaActionCode  string            `json:"QuotaActionCode"`
+	QuotaName        string            `json:"QuotaName"`
+	QuotaDescription string            `json:"QuotaDescription"`
+	QuotaType        string            `json:"QuotaType"`
+	QuotaUnit        string            `json:"QuotaUnit"`
+	TotalQuota       float64           `json:"TotalQuota"`
+	TotalUsage       float64           `json:"TotalUsage"`
+	Adjustable       bool              `json:"Adjustable"`
+	ApplicableType   string            `json:"ApplicableType"`
+	ApplicableRange  []float64         `json:"ApplicableRange"`
+	Dimensions       map[string]string `json:"Dimensions"`
+}
+
+type QuotasQuotaApplication struct {
+	ApplicationId    string            `json:"ApplicationId"`
+	ProductCode      string            `json:"ProductCode"`
+	QuotaActionCode  string            `json:"QuotaActionCode"`
+	QuotaName        string            `json:"QuotaName"`
+	QuotaDescription string            `json:"QuotaDescription"`
+	QuotaUnit        string            `json:"QuotaUnit"`
+	DesireValue      float64           `json:"DesireValue"`
+	ApproveValue     float64           `json:"ApproveValue"`
+	Reason           string            `json:"Reason"`
+	AuditReason      string            `json:"AuditReason"`
+	Status           string            `json:"Status"`
+	NoticeType       int               `json:"NoticeType"`
+	Dimension        map[string]string `json:"Dimension"`
+	ApplyTime        string            `json:"ApplyTime"`
+	EffectiveTime    string            `json:"EffectiveTime"`
+	ExpireTime       string            `json:"ExpireTime"`
+}
@@ -1,5 +1,7 @@
 package alicloud
 
+const RdsApiVersion = "2014-08-15"
+
 type Engine string
 
 const (
@@ -37,3 +39,18 @@ var CHARACTER_SET_NAME = []string{
 	"utf8", "gbk", "latin1", "utf8mb4",
 	"Chinese_PRC_CI_AS", "Chinese_PRC_CS_AS", "SQL_Latin1_General_CP1_CI_AS", "SQL_Latin1_General_CP1_CS_AS", "Chinese_PRC_BIN",
 }
+
+// RdsRestoreTimeFormat is the format of the time in UTC to which an instance is cloned.
+const RdsRestoreTimeFormat = "2006-01-02T15:04:05Z"
+
+// The types of the data used to clone an instance
+const (
+	RdsRestoreByBackup = "0"
+	RdsRestoreByTime   = "1"
+)
+
+// The time when the engine version upgrade of an instance takes effect
+const (
+	RdsEffectiveImmediate    = "Immediate"
+	RdsEffectiveMaintainTime = "MaintainTime"
+)
@@ -0,0 +1,111 @@
+package alicloud
+
+const SaeApiVersion = "2019-05-06"
+
+// The package types of an application
+const (
+	SaePackageImage  = "Image"
+	SaePackageFatJar = "FatJar"
+	SaePackageWar    = "War"
+)
+
+// The types of a scaling rule
+const (
+	SaeScalingRuleTiming = "timing"
+	SaeScalingRuleMetric = "metric"
+)
+
+// The status of a change order which failed
+const SaeChangeOrderFail = "FAIL"
+
+type SaeNamespace struct {
+	NamespaceId          string `json:"NamespaceId"`
+	NamespaceName        string `json:"NamespaceName"`
+	NamespaceDescription string `json:"NamespaceDescription"`
+	RegionId             string `json:"RegionId"`
+}
+
+type SaeApplication struct {
+	AppId          string `json:"AppId"`
+	AppName        string `json:"AppName"`
+	AppDescription string `json:"AppDescription"`
+	NamespaceId    string `json:"NamespaceId"`
+	PackageType    string `json:"PackageType"`
+	ImageUrl       string `json:"ImageUrl"`
+	PackageUrl     string `json:"PackageUrl"`
+	PackageVersion string `json:"PackageVersion"`
+	Jdk            string `json:"Jdk"`
+	Replicas       int    `json:"Replicas"`
+	Cpu            int    `json:"Cpu"`
+	Memory         int    `json:"Memory"`
+	VpcId          string `json:"VpcId"`
+	VSwitchId      string `json:"VSwitchId"`
+	Envs           string `json:"Envs"`
+}
+
+type SaeApplicationStatus struct {
+	CurrentStatus          string `json:"CurrentStatus"`
+	LastChangeOrderId      string `json:"LastChangeOrderId"`
+	LastChangeOrderRunning bool   `json:"LastChangeOrderRunning"`
+	LastChangeOrderStatus  string `json:"LastChangeOrderStatus"`
+}
+
+type SaeEnv struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SaeSlbPort is a port of the SLB which forwards to the port of the application.
+type SaeSlbPort struct {
+	Port       int    `json:"port"`
+	TargetPort int    `json:"targetPort"`
+	Protocol   string `json:"protocol"`
+}
+
+type SaeApplicationSlb struct {
+	InternetSlbId string       `json:"InternetSlbId"`
+	InternetIp    string       `json:"InternetIp"`
+	Internet      []SaeSlbPort `json:"Internet"`
+	IntranetSlbId string       `json:"IntranetSlbId"`
+	IntranetIp    string       `json:"IntranetIp"`
+	Intranet      []SaeSlbPort `json:"Intranet"`
+}
+
+type SaeScalingSchedule struct {
+	AtTime         string `json:"atTime"`
+	TargetReplicas int    `json:"targetReplicas"`
+}
+
+type SaeScalingTimer struct {
+	BeginDate string               `json:"beginDate,omitempty"`
+	EndDate   string               `json:"endDate,omitempty"`
+	Period    string               `json:"period"`
+	Schedules []SaeScalingSchedule `json:"schedules"`
+}
+
+type SaeScalingMetricTarget struct {
+	MetricType                     string `json:"metricType"`
+	MetricTargetAverageUtilization int    `json:"metricTargetAverageUtilization"`
+}
+
+type SaeScalingMetric struct {
+	MinReplicas int                      `json:"minReplicas"`
+	MaxReplicas int                      `json:"maxReplicas"`
+	Metrics     []SaeScalingMetricTarget `json:"metrics"`
+}
+
+// SaeScalingRule is decoded from the response case-insensitively, so the fields are tagged as they are requested.
+type SaeScalingRule struct {
+	ScaleRuleName string            `json:"ScaleRuleName"`
+	ScaleRuleType string            `json:"ScaleRuleType"`
+	Timer         *SaeScalingTimer  `json:"Timer"`
+	Metric        *SaeScalingMetric `json:"Metric"`
+}
+
+type SaeConfigMap struct {
+	ConfigMapId int64             `json:"ConfigMapId"`
+	Name        string            `json:"Name"`
+	NamespaceId string            `json:"NamespaceId"`
+	Description string            `json:"Description"`
+	Data        map[string]string `json:"Data"`
+}
@@ -1,26 +1,118 @@
 package alicloud
 
-import (
-	"github.com/denverdino/aliyungo/slb"
+import "encoding/json"
+
+const SlbApiVersion = "2014-05-15"
+
+// The values of the DeleteProtection of a load balancer
+const (
+	SlbDeleteProtectionOn  = "on"
+	SlbDeleteProtectionOff = "off"
+)
+
+// The pay types of a load balancer
+const (
+	SlbPayOnDemand = "PayOnDemand"
+	SlbPrePay      = "PrePay"
+)
+
+// The statuses of a load balancer and its listeners
+const (
+	SlbActive   = "active"
+	SlbInactive = "inactive"
+	SlbLocked   = "locked"
+
+	SlbListenerStarting    = "starting"
+	SlbListenerRunning     = "running"
+	SlbListenerConfiguring = "configuring"
+	SlbListenerStopping    = "stopping"
+	SlbListenerStopped     = "stopped"
+)
+
+// The address types of a load balancer
+const (
+	SlbInternetAddressType = "internet"
+	SlbIntranetAddressType = "intranet"
+)
+
+// The internet charge types of a load balancer
+const (
+	SlbPayByBandwidth = "paybybandwidth"
+	SlbPayByTraffic   = "paybytraffic"
+)
+
+// The specifications of a load balancer
+const (
+	SlbS1Small  = "slb.s1.small"
+	SlbS2Small  = "slb.s2.small"
+	SlbS2Medium = "slb.s2.medium"
+	SlbS3Small  = "slb.s3.small"
+	SlbS3Medium = "slb.s3.medium"
+	SlbS3Large  = "slb.s3.large"
+)
+
+// The values of the switches of a listener, like StickySession, HealthCheck and EnableHttp2
+const (
+	SlbOnFlag  = "on"
+	SlbOffFlag = "off"
 )
 
-type Listener struct {
-	slb.HTTPListenerType
+// The settings of a listener
+const (
+	SlbWRRScheduler = "wrr"
+	SlbWLCScheduler = "wlc"
 
-	InstancePort     int
-	LoadBalancerPort int
-	Protocol         string
-	//tcp & udp
-	PersistenceTimeout int
+	SlbInsertStickySessionType = "insert"
+	SlbServerStickySessionType = "server"
 
-	//https
-	SSLCertificateId string
+	SlbTCPHealthCheckType  = "tcp"
+	SlbHTTPHealthCheckType = "http"
 
-	//tcp
-	HealthCheckType slb.HealthCheckType
+	SlbHTTP2XX = "http_2xx"
+	SlbHTTP3XX = "http_3xx"
+	SlbHTTP4XX = "http_4xx"
+	SlbHTTP5XX = "http_5xx"
+)
+
+// The TLS cipher policies of an HTTPS listener
+const (
+	TlsCipherPolicy10       = "tls_cipher_policy_1_0"
+	TlsCipherPolicy11       = "tls_cipher_policy_1_1"
+	TlsCipherPolicy12       = "tls_cipher_policy_1_2"
+	TlsCipherPolicy12Strict = "tls_cipher_policy_1_2_strict"
+)
 
-	//api interface: http & https is HealthCheckTimeout, tcp & udp is HealthCheckConnectTimeout
+// slbListenerArgs holds the parameters of a listener, which are set to the request of creating or modifying the listener
+// by setListenerRequest. The parameters which are not supported by the protocol of the listener are not sent.
+type slbListenerArgs struct {
+	LoadBalancerId            string
+	ListenerPort              int
+	BackendServerPort         int
+	Bandwidth                 int
+	Scheduler                 string
+	VServerGroupId            string
+	StickySession             string
+	StickySessionType         string
+	CookieTimeout             int
+	Cookie                    string
+	PersistenceTimeout        int
+	HealthCheck               string
+	HealthCheckType           string
+	HealthCheckDomain         string
+	HealthCheckURI            string
+	HealthCheckConnectPort    int
+	HealthyThreshold          int
+	UnhealthyThreshold        int
+	HealthCheckTimeout        int
 	HealthCheckConnectTimeout int
+	HealthCheckInterval       int
+	HealthCheckHttpCode       string
+	ServerCertificateId       string
+	IdleTimeout               int
+	RequestTimeout            int
+	TLSCipherPolicy           string
+	EnableHttp2               string
+	CACertificateId           string
 }
 
 type ListenerErr struct {
@@ -33,12 +125,21 @@ func (e *ListenerErr) Error() string {
 
 }
 
-func expandBackendServers(list []interface{}, weight int) []slb.BackendServerType {
-	result := make([]slb.BackendServerType, 0, len(list))
+// slbBackendServer is a backend server of a load balancer in the JSON format required by the SLB API.
+type slbBackendServer struct {
+	ServerId string `json:"ServerId"`
+	Weight   int    `json:"Weight"`
+}
+
+// expandBackendServers returns the instances with the weight in the JSON format required by the SLB API, like
+// [{"ServerId":"i-abc","Weight":100}].
+func expandBackendServers(list []interface{}, weight int) string {
+	result := make([]slbBackendServer, 0, len(list))
 	for _, i := range list {
 		if i.(string) != "" {
-			result = append(result, slb.BackendServerType{ServerId: i.(string), Weight: weight})
+			result = append(result, slbBackendServer{ServerId: i.(string), Weight: weight})
 		}
 	}
-	return result
+	bs, _ := json.Marshal(result)
+	return string(bs)
 }
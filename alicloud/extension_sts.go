@@ -0,0 +1,10 @@
+package alicloud
+
+const (
+	StsApiVersion  = "2015-04-01"
+	StsDomain      = "sts.aliyuncs.com"
+	StsSessionName = "terraform"
+
+	StsMinSessionExpiration = 900
+	StsMaxSessionExpiration = 3600
+)
@@ -1,5 +1,23 @@
 package alicloud
 
+const VpcApiVersion = "2016-04-28"
+
+// The resource types used by the TagResources API of VPC
+const (
+	TagResourceNatGateway = "NATGATEWAY"
+	TagResourceVpc        = "VPC"
+)
+
+// The resource types which a network ACL can be associated with
+const (
+	NetworkAclResourceVSwitch = "VSwitch"
+)
+
+// The instance types used by the DeletionProtection API of VPC
+const (
+	DeletionProtectionNatGateway = "NATGW"
+)
+
 type NatGatewaySpec string
 
 const (
@@ -13,6 +31,15 @@ const (
 	SlbInstance = "SlbInstance"
 	Nat         = "Nat"
 	HaVip       = "HaVip"
+
+	NetworkInterface = "NetworkInterface"
+)
+
+// The modes of an EIP associated with a network interface
+const (
+	EipAssociationModeNat         = "NAT"
+	EipAssociationModeMultiBinded = "MULTI_BINDED"
+	EipAssociationModeBinded      = "BINDED"
 )
 
 type RouterType string
@@ -35,3 +62,10 @@ const (
 	Large1  = Spec("Large.1")
 	Large2  = Spec("Large.2")
 )
+
+type NextHopType string
+
+const (
+	NextHopInstance              = NextHopType("Instance")
+	NextHopTunnelRouterInterface = NextHopType("RouterInterface")
+)
@@ -0,0 +1,13 @@
+package alicloud
+
+const VpcPeerApiVersion = "2022-01-01"
+
+// The statuses of a VPC peer connection
+const (
+	VpcPeerCreating  = Status("Creating")
+	VpcPeerAccepting = Status("Accepting")
+	VpcPeerUpdating  = Status("Updating")
+	VpcPeerActivated = Status("Activated")
+	VpcPeerRejected  = Status("Rejected")
+	VpcPeerDeleting  = Status("Deleting")
+)
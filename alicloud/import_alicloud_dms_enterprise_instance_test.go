@@ -0,0 +1,33 @@
+package alicloud
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDmsEnterpriseInstance_importBasic(t *testing.T) {
+	resourceName := "alicloud_dms_enterprise_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWithDmsEnterpriseUser(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDmsEnterpriseInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDmsEnterpriseInstanceConfig(os.Getenv("ALICLOUD_DMS_USER_UID"), 70),
+			},
+
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"database_password", "network_type", "tid"},
+			},
+		},
+	})
+}
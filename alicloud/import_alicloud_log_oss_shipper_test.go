@@ -0,0 +1,32 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudLogOssShipper_importBasic(t *testing.T) {
+	resourceName := "alicloud_log_oss_shipper.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWithLogProject(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLogOssShipperDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLogOssShipperBasic(acctest.RandInt()),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
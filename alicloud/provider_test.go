@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/denverdino/aliyungo/common"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -21,6 +23,55 @@ func init() {
 	}
 }
 
+// TestMain runs the sweepers instead of the tests when the flag -sweep is set, for example
+// go test ./alicloud -v -sweep=cn-beijing,cn-hangzhou
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// The name prefixes of the resources created by the acceptance tests. The sweepers only
+// delete the resources whose names start with one of them.
+var testSweepPrefixes = []string{
+	"tf-test",
+	"tf_test",
+	"testAcc",
+}
+
+func isTestSweepName(name string) bool {
+	for _, prefix := range testSweepPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sharedClientForRegion returns a client of the region for the sweepers, with the credentials
+// used by the acceptance tests.
+func sharedClientForRegion(region string) (*AliyunClient, error) {
+	accessKey := os.Getenv("ALICLOUD_ACCESS_KEY")
+	if accessKey == "" {
+		return nil, fmt.Errorf("empty ALICLOUD_ACCESS_KEY")
+	}
+	secretKey := os.Getenv("ALICLOUD_SECRET_KEY")
+	if secretKey == "" {
+		return nil, fmt.Errorf("empty ALICLOUD_SECRET_KEY")
+	}
+
+	conf := Config{
+		AccessKey:            accessKey,
+		SecretKey:            secretKey,
+		SecurityToken:        os.Getenv("ALICLOUD_SECURITY_TOKEN"),
+		Region:               common.Region(region),
+		RegionId:             region,
+		MaxRetries:           DefaultMaxRetries,
+		ClientConnectTimeout: DefaultClientConnectTimeout,
+		ClientReadTimeout:    DefaultClientReadTimeout,
+		Protocol:             "HTTPS",
+	}
+	return conf.Client()
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
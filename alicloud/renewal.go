@@ -0,0 +1,76 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// renewalStatusSchema and autoRenewPeriodSchema are the renewal settings of a PrePaid resource. They are ignored
+// unless the resource's instance_charge_type is PrePaid.
+func renewalStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateFunc:     validateAllowedStringValue([]string{RenewAutoRenewal, RenewManualRenewal, RenewNotRenewal}),
+		DiffSuppressFunc: prePaidDiffSuppressFunc,
+	}
+}
+
+func autoRenewPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Computed:         true,
+		ValidateFunc:     validateAllowedIntValue([]int{1, 2, 3, 6, 12, 24, 36}),
+		DiffSuppressFunc: autoRenewDiffSuppressFunc,
+	}
+}
+
+// setRenewal is a helper to set the renewal status of a PrePaid resource when the renewal_status or
+// auto_renew_period changes. It expects the fields to be named "instance_charge_type", "renewal_status"
+// and "auto_renew_period".
+func setRenewal(client *AliyunClient, product BssProduct, d *schema.ResourceData) error {
+	if PayType(d.Get("instance_charge_type").(string)) != PrePaid {
+		return nil
+	}
+	if !d.HasChange("renewal_status") && !d.HasChange("auto_renew_period") {
+		return nil
+	}
+	status := d.Get("renewal_status").(string)
+	if status == "" {
+		return nil
+	}
+	period := d.Get("auto_renew_period").(int)
+	if status == RenewAutoRenewal && period == 0 {
+		return fmt.Errorf("'auto_renew_period' is required when 'renewal_status' is %s.", RenewAutoRenewal)
+	}
+	if err := client.SetRenewal(product, d.Id(), status, period); err != nil {
+		return err
+	}
+	d.SetPartial("renewal_status")
+	d.SetPartial("auto_renew_period")
+	return nil
+}
+
+// readRenewal is a helper to read the renewal status of a PrePaid resource into the renewal_status and
+// auto_renew_period fields.
+func readRenewal(client *AliyunClient, product BssProduct, d *schema.ResourceData) error {
+	if PayType(d.Get("instance_charge_type").(string)) != PrePaid {
+		return nil
+	}
+	renewal, err := client.DescribeBssInstance(product, d.Id())
+	if err != nil {
+		// The subscription can be found only after the order is paid.
+		if NotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	d.Set("renewal_status", renewal.RenewStatus)
+	if renewal.RenewStatus == RenewAutoRenewal {
+		d.Set("auto_renew_period", renewal.RenewalDuration)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package alicloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestRenewalDiffSuppressFunc(t *testing.T) {
+	cases := []struct {
+		raw                 map[string]interface{}
+		suppressStatus      bool
+		suppressRenewPeriod bool
+	}{
+		{map[string]interface{}{"instance_charge_type": "PostPaid", "renewal_status": RenewAutoRenewal}, true, true},
+		{map[string]interface{}{"instance_charge_type": "PrePaid", "renewal_status": RenewNotRenewal}, false, true},
+		{map[string]interface{}{"instance_charge_type": "PrePaid", "renewal_status": RenewAutoRenewal}, false, false},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceAliyunEip().Schema, c.raw)
+		if s := prePaidDiffSuppressFunc("renewal_status", "", "", d); s != c.suppressStatus {
+			t.Fatalf("Expected the renewal_status diff is suppressed %t for %#v, got %t.", c.suppressStatus, c.raw, s)
+		}
+		if s := autoRenewDiffSuppressFunc("auto_renew_period", "", "", d); s != c.suppressRenewPeriod {
+			t.Fatalf("Expected the auto_renew_period diff is suppressed %t for %#v, got %t.", c.suppressRenewPeriod, c.raw, s)
+		}
+	}
+}
+
+func TestBssProductParams(t *testing.T) {
+	expected := map[string]string{
+		"ProductCode":      "slb",
+		"ProductType":      "slb_pre",
+		"SubscriptionType": "Subscription",
+		"InstanceIDs":      "lb-abc",
+	}
+	if params := BssProductSlb.params("lb-abc"); !reflect.DeepEqual(params, expected) {
+		t.Fatalf("Expected the parameters %#v, got %#v.", expected, params)
+	}
+	if _, ok := BssProductEcs.params("i-abc")["ProductType"]; ok {
+		t.Fatalf("Expected ProductType is not sent for ECS.")
+	}
+}
+
+func TestBuildBssParameters(t *testing.T) {
+	params := map[string]string{}
+	buildBssParameters(params, map[string]string{"Spec": "premium_version", "BandWidth": "10"})
+	expected := map[string]string{
+		"Parameter.1.Code":  "BandWidth",
+		"Parameter.1.Value": "10",
+		"Parameter.2.Code":  "Spec",
+		"Parameter.2.Value": "premium_version",
+	}
+	if !reflect.DeepEqual(params, expected) {
+		t.Fatalf("Expected the parameters %#v, got %#v.", expected, params)
+	}
+}
@@ -0,0 +1,131 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayPlugin() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayPluginCreate,
+		Read:   resourceAlicloudApiGatewayPluginRead,
+		Update: resourceAlicloudApiGatewayPluginUpdate,
+		Delete: resourceAlicloudApiGatewayPluginDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"plugin_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"plugin_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(PluginTypeIpControl),
+					string(PluginTypeTrafficControl),
+					string(PluginTypeJwtAuth),
+					string(PluginTypeCors),
+					string(PluginTypeBackendSignature),
+					string(PluginTypeCaching),
+				}),
+			},
+			"plugin_data": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayPluginCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"PluginName": d.Get("plugin_name").(string),
+		"PluginType": d.Get("plugin_type").(string),
+		"PluginData": d.Get("plugin_data").(string),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params["Description"] = v.(string)
+	}
+
+	var resp CreatePluginResponse
+	if err := client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "CreatePlugin", params, &resp); err != nil {
+		return WrapErrorf(err, "CreatePlugin got an error")
+	}
+
+	d.SetId(resp.PluginId)
+
+	return resourceAlicloudApiGatewayPluginRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayPluginRead(d *schema.ResourceData, meta interface{}) error {
+	plugin, err := meta.(*AliyunClient).DescribeApiGatewayPlugin(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, "DescribePlugins got an error")
+	}
+
+	d.Set("plugin_name", plugin.PluginName)
+	d.Set("plugin_type", plugin.PluginType)
+	d.Set("plugin_data", plugin.PluginData)
+	d.Set("description", plugin.Description)
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayPluginUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("plugin_name") || d.HasChange("plugin_data") || d.HasChange("description") {
+		if err := client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "ModifyPlugin", map[string]string{
+			"PluginId":    d.Id(),
+			"PluginName":  d.Get("plugin_name").(string),
+			"PluginData":  d.Get("plugin_data").(string),
+			"Description": d.Get("description").(string),
+		}, nil); err != nil {
+			return WrapErrorf(err, "ModifyPlugin got an error")
+		}
+	}
+
+	return resourceAlicloudApiGatewayPluginRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayPluginDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(ApiGatewayCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "DeletePlugin", map[string]string{
+			"PluginId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, ApiGatewayPluginNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeletePlugin got an error")
+	}
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		if _, err := client.DescribeApiGatewayPlugin(d.Id()); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Deleting plugin %s timeout and it still exists.", d.Id()))
+	})
+}
@@ -0,0 +1,127 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayPluginAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayPluginAttachmentCreate,
+		Read:   resourceAlicloudApiGatewayPluginAttachmentRead,
+		Delete: resourceAlicloudApiGatewayPluginAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plugin_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stage_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(StageNameRelease), string(StageNamePre), string(StageNameTest)}),
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayPluginAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	groupId := d.Get("group_id").(string)
+	apiId := d.Get("api_id").(string)
+	pluginId := d.Get("plugin_id").(string)
+	stageName := d.Get("stage_name").(string)
+
+	if err := RetryOnError(ApiGatewayCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "AttachPlugin", map[string]string{
+			"GroupId":   groupId,
+			"ApiId":     apiId,
+			"PluginId":  pluginId,
+			"StageName": stageName,
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "AttachPlugin got an error")
+	}
+
+	d.SetId(strings.Join([]string{groupId, apiId, pluginId, stageName}, COLON_SEPARATED))
+
+	return resourceAlicloudApiGatewayPluginAttachmentRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayPluginAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseApiGatewayPluginAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := meta.(*AliyunClient).DescribeApiGatewayPluginAttachment(parts[0], parts[1], parts[2], parts[3]); err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, "DescribePluginsByApi got an error")
+	}
+
+	d.Set("group_id", parts[0])
+	d.Set("api_id", parts[1])
+	d.Set("plugin_id", parts[2])
+	d.Set("stage_name", parts[3])
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayPluginAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseApiGatewayPluginAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := RetryOnError(ApiGatewayCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "DetachPlugin", map[string]string{
+			"GroupId":   parts[0],
+			"ApiId":     parts[1],
+			"PluginId":  parts[2],
+			"StageName": parts[3],
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, ApiGatewayPluginNotFound) || IsExceptedError(err, ApiGatewayApiNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DetachPlugin got an error")
+	}
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		if _, err := client.DescribeApiGatewayPluginAttachment(parts[0], parts[1], parts[2], parts[3]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Detaching plugin %s timeout and it is still attached.", d.Id()))
+	})
+}
+
+func parseApiGatewayPluginAttachmentId(id string) ([]string, error) {
+	return ParseResourceId(id, "api gateway plugin attachment", "group_id", "api_id", "plugin_id", "stage_name")
+}
@@ -0,0 +1,106 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudApiGatewayPluginAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWithApiGatewayApi(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_api_gateway_plugin_attachment.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayPluginAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccApiGatewayPluginAttachmentConfig(os.Getenv("ALICLOUD_API_GATEWAY_GROUP_ID"), os.Getenv("ALICLOUD_API_GATEWAY_API_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayPluginAttachmentExists("alicloud_api_gateway_plugin_attachment.foo"),
+					resource.TestCheckResourceAttr("alicloud_api_gateway_plugin_attachment.foo", "stage_name", "RELEASE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckWithApiGatewayApi(t *testing.T) {
+	if v := os.Getenv("ALICLOUD_API_GATEWAY_GROUP_ID"); v == "" {
+		t.Fatal("ALICLOUD_API_GATEWAY_GROUP_ID must be set for api gateway plugin attachment acceptance tests")
+	}
+	if v := os.Getenv("ALICLOUD_API_GATEWAY_API_ID"); v == "" {
+		t.Fatal("ALICLOUD_API_GATEWAY_API_ID must be set for api gateway plugin attachment acceptance tests")
+	}
+}
+
+func testAccCheckApiGatewayPluginAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No api gateway plugin attachment ID is set")
+		}
+
+		parts, err := parseApiGatewayPluginAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = testAccProvider.Meta().(*AliyunClient).DescribeApiGatewayPluginAttachment(parts[0], parts[1], parts[2], parts[3])
+		return err
+	}
+}
+
+func testAccCheckApiGatewayPluginAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_api_gateway_plugin_attachment" {
+			continue
+		}
+
+		parts, err := parseApiGatewayPluginAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeApiGatewayPluginAttachment(parts[0], parts[1], parts[2], parts[3]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Api gateway plugin attachment %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApiGatewayPluginAttachmentConfig(groupId, apiId string) string {
+	return fmt.Sprintf(`
+resource "alicloud_api_gateway_plugin" "foo" {
+  plugin_name = "tf-testacc-plugin-attachment"
+  plugin_type = "ipControl"
+  plugin_data = "{\"type\": \"ALLOW\", \"items\": [{\"blocks\": \"10.0.0.0/8\"}]}"
+}
+
+resource "alicloud_api_gateway_plugin_attachment" "foo" {
+  group_id   = "%s"
+  api_id     = "%s"
+  plugin_id  = "${alicloud_api_gateway_plugin.foo.id}"
+  stage_name = "RELEASE"
+}
+`, groupId, apiId)
+}
@@ -0,0 +1,100 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudApiGatewayPlugin_basic(t *testing.T) {
+	var plugin ApiGatewayPlugin
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_api_gateway_plugin.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayPluginDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccApiGatewayPluginConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayPluginExists("alicloud_api_gateway_plugin.foo", &plugin),
+					resource.TestCheckResourceAttr("alicloud_api_gateway_plugin.foo", "plugin_name", "tf-testacc-plugin"),
+					resource.TestCheckResourceAttr("alicloud_api_gateway_plugin.foo", "plugin_type", "cors"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccApiGatewayPluginUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayPluginExists("alicloud_api_gateway_plugin.foo", &plugin),
+					resource.TestCheckResourceAttr("alicloud_api_gateway_plugin.foo", "description", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApiGatewayPluginExists(n string, plugin *ApiGatewayPlugin) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No api gateway plugin ID is set")
+		}
+
+		resp, err := testAccProvider.Meta().(*AliyunClient).DescribeApiGatewayPlugin(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*plugin = resp
+		return nil
+	}
+}
+
+func testAccCheckApiGatewayPluginDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_api_gateway_plugin" {
+			continue
+		}
+
+		if _, err := client.DescribeApiGatewayPlugin(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Api gateway plugin %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccApiGatewayPluginConfig = `
+resource "alicloud_api_gateway_plugin" "foo" {
+  plugin_name = "tf-testacc-plugin"
+  plugin_type = "cors"
+  plugin_data = "{\"allowOrigins\": \"*\"}"
+}
+`
+
+const testAccApiGatewayPluginUpdate = `
+resource "alicloud_api_gateway_plugin" "foo" {
+  plugin_name = "tf-testacc-plugin"
+  plugin_type = "cors"
+  plugin_data = "{\"allowOrigins\": \"*\", \"allowMethods\": \"GET,POST\"}"
+  description = "updated by terraform"
+}
+`
@@ -0,0 +1,139 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudApiGatewayVpcAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudApiGatewayVpcAccessCreate,
+		Read:   resourceAlicloudApiGatewayVpcAccessRead,
+		Delete: resourceAlicloudApiGatewayVpcAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+		},
+	}
+}
+
+func resourceAlicloudApiGatewayVpcAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	name := d.Get("name").(string)
+	vpcId := d.Get("vpc_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	port := d.Get("port").(int)
+
+	if err := RetryOnError(ApiGatewayCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "SetVpcAccess", map[string]string{
+			"Name":       name,
+			"VpcId":      vpcId,
+			"InstanceId": instanceId,
+			"Port":       strconv.Itoa(port),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, ApiGatewayVpcAccessExists) {
+			return fmt.Errorf("The vpc access %s has already existed. Please import it using ID '%s:%s:%s:%d' or specify a new 'name' and try again.",
+				name, name, vpcId, instanceId, port)
+		}
+		return WrapErrorf(err, "SetVpcAccess got an error")
+	}
+
+	d.SetId(strings.Join([]string{name, vpcId, instanceId, strconv.Itoa(port)}, COLON_SEPARATED))
+
+	return resourceAlicloudApiGatewayVpcAccessRead(d, meta)
+}
+
+func resourceAlicloudApiGatewayVpcAccessRead(d *schema.ResourceData, meta interface{}) error {
+	name, vpcId, instanceId, port, err := parseApiGatewayVpcAccessId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vpcAccess, err := meta.(*AliyunClient).DescribeApiGatewayVpcAccess(name, vpcId, instanceId, port)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, "DescribeVpcAccesses got an error")
+	}
+
+	d.Set("name", vpcAccess.Name)
+	d.Set("vpc_id", vpcAccess.VpcId)
+	d.Set("instance_id", vpcAccess.InstanceId)
+	d.Set("port", vpcAccess.Port)
+
+	return nil
+}
+
+func resourceAlicloudApiGatewayVpcAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	name, vpcId, instanceId, port, err := parseApiGatewayVpcAccessId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := RetryOnError(ApiGatewayCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "RemoveVpcAccess", map[string]string{
+			"VpcId":      vpcId,
+			"InstanceId": instanceId,
+			"Port":       strconv.Itoa(port),
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "RemoveVpcAccess got an error")
+	}
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		if _, err := client.DescribeApiGatewayVpcAccess(name, vpcId, instanceId, port); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Removing vpc access %s timeout and it still exists.", d.Id()))
+	})
+}
+
+func parseApiGatewayVpcAccessId(id string) (name, vpcId, instanceId string, port int, err error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+	if len(parts) != 4 {
+		err = fmt.Errorf("Invalid api gateway vpc access id %s. Expected format is <name>:<vpc_id>:<instance_id>:<port>.", id)
+		return
+	}
+	port, err = strconv.Atoi(parts[3])
+	if err != nil {
+		err = fmt.Errorf("Invalid port %s of api gateway vpc access id %s.", parts[3], id)
+		return
+	}
+	return parts[0], parts[1], parts[2], port, nil
+}
@@ -0,0 +1,123 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudApiGatewayVpcAccess_basic(t *testing.T) {
+	var vpcAccess ApiGatewayVpcAccess
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_api_gateway_vpc_access.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayVpcAccessDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccApiGatewayVpcAccessConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiGatewayVpcAccessExists("alicloud_api_gateway_vpc_access.foo", &vpcAccess),
+					resource.TestCheckResourceAttr("alicloud_api_gateway_vpc_access.foo", "name", "tf-testacc-vpc-access"),
+					resource.TestCheckResourceAttr("alicloud_api_gateway_vpc_access.foo", "port", "8080"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApiGatewayVpcAccessExists(n string, vpcAccess *ApiGatewayVpcAccess) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No api gateway vpc access ID is set")
+		}
+
+		name, vpcId, instanceId, port, err := parseApiGatewayVpcAccessId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := testAccProvider.Meta().(*AliyunClient).DescribeApiGatewayVpcAccess(name, vpcId, instanceId, port)
+		if err != nil {
+			return err
+		}
+
+		*vpcAccess = resp
+		return nil
+	}
+}
+
+func testAccCheckApiGatewayVpcAccessDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_api_gateway_vpc_access" {
+			continue
+		}
+
+		name, vpcId, instanceId, port, err := parseApiGatewayVpcAccessId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeApiGatewayVpcAccess(name, vpcId, instanceId, port); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Api gateway vpc access %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccApiGatewayVpcAccessConfig = `
+data "alicloud_zones" "default" {
+  "available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "foo" {
+  name = "tf-testacc-vpc-access"
+  vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_instance" "foo" {
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  image_id = "ubuntu_140405_32_40G_cloudinit_20161115.vhd"
+  instance_type = "ecs.n4.large"
+  system_disk_category = "cloud_efficiency"
+  security_groups = ["${alicloud_security_group.foo.id}"]
+  instance_name = "tf-testacc-vpc-access"
+}
+
+resource "alicloud_api_gateway_vpc_access" "foo" {
+  name = "tf-testacc-vpc-access"
+  vpc_id = "${alicloud_vpc.foo.id}"
+  instance_id = "${alicloud_instance.foo.id}"
+  port = 8080
+}
+`
@@ -0,0 +1,129 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudArmsAlertContact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudArmsAlertContactCreate,
+		Read:   resourceAlicloudArmsAlertContactRead,
+		Update: resourceAlicloudArmsAlertContactUpdate,
+		Delete: resourceAlicloudArmsAlertContactDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alert_contact_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"phone_num": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ding_robot_webhook_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"system_noc": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func buildArmsAlertContactParams(d *schema.ResourceData) (map[string]string, error) {
+	params := map[string]string{
+		"ContactName":         d.Get("alert_contact_name").(string),
+		"Email":               d.Get("email").(string),
+		"PhoneNum":            d.Get("phone_num").(string),
+		"DingRobotWebhookUrl": d.Get("ding_robot_webhook_url").(string),
+		"SystemNoc":           strconv.FormatBool(d.Get("system_noc").(bool)),
+	}
+	if params["Email"] == "" && params["PhoneNum"] == "" && params["DingRobotWebhookUrl"] == "" {
+		return nil, fmt.Errorf("At least one of 'email', 'phone_num' and 'ding_robot_webhook_url' should be set.")
+	}
+	return params, nil
+}
+
+func resourceAlicloudArmsAlertContactCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params, err := buildArmsAlertContactParams(d)
+	if err != nil {
+		return err
+	}
+	var resp struct {
+		ContactId int64 `json:"ContactId"`
+	}
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "CreateAlertContact", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateAlertContact got an error")
+	}
+	d.SetId(strconv.FormatInt(resp.ContactId, 10))
+
+	return resourceAlicloudArmsAlertContactRead(d, meta)
+}
+
+func resourceAlicloudArmsAlertContactRead(d *schema.ResourceData, meta interface{}) error {
+	contact, err := meta.(*AliyunClient).DescribeArmsAlertContact(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("alert_contact_name", contact.ContactName)
+	d.Set("email", contact.Email)
+	d.Set("phone_num", contact.Phone)
+	d.Set("ding_robot_webhook_url", contact.DingRobot)
+	d.Set("system_noc", contact.SystemNoc)
+
+	return nil
+}
+
+func resourceAlicloudArmsAlertContactUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params, err := buildArmsAlertContactParams(d)
+	if err != nil {
+		return err
+	}
+	params["ContactId"] = d.Id()
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "UpdateAlertContact", params, nil)
+	}); err != nil {
+		return WrapErrorf(err, "UpdateAlertContact got an error")
+	}
+
+	return resourceAlicloudArmsAlertContactRead(d, meta)
+}
+
+func resourceAlicloudArmsAlertContactDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "DeleteAlertContact", map[string]string{
+			"ContactId": d.Id(),
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "DeleteAlertContact got an error")
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package alicloud
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudArmsAlertContactGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudArmsAlertContactGroupCreate,
+		Read:   resourceAlicloudArmsAlertContactGroupRead,
+		Update: resourceAlicloudArmsAlertContactGroupUpdate,
+		Delete: resourceAlicloudArmsAlertContactGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alert_contact_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"contact_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+		},
+	}
+}
+
+// The contacts of a group are separated by spaces.
+func buildArmsAlertContactGroupParams(d *schema.ResourceData) map[string]string {
+	return map[string]string{
+		"ContactGroupName": d.Get("alert_contact_group_name").(string),
+		"ContactIds":       strings.Join(expandStringList(d.Get("contact_ids").(*schema.Set).List()), " "),
+	}
+}
+
+func resourceAlicloudArmsAlertContactGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	var resp struct {
+		ContactGroupId int64 `json:"ContactGroupId"`
+	}
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "CreateAlertContactGroup", buildArmsAlertContactGroupParams(d), &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateAlertContactGroup got an error")
+	}
+	d.SetId(strconv.FormatInt(resp.ContactGroupId, 10))
+
+	return resourceAlicloudArmsAlertContactGroupRead(d, meta)
+}
+
+func resourceAlicloudArmsAlertContactGroupRead(d *schema.ResourceData, meta interface{}) error {
+	group, err := meta.(*AliyunClient).DescribeArmsAlertContactGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("alert_contact_group_name", group.ContactGroupName)
+	var ids []string
+	for _, contact := range group.Contacts {
+		ids = append(ids, strconv.FormatInt(contact.ContactId, 10))
+	}
+	d.Set("contact_ids", ids)
+
+	return nil
+}
+
+func resourceAlicloudArmsAlertContactGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := buildArmsAlertContactGroupParams(d)
+	params["ContactGroupId"] = d.Id()
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "UpdateAlertContactGroup", params, nil)
+	}); err != nil {
+		return WrapErrorf(err, "UpdateAlertContactGroup got an error")
+	}
+
+	return resourceAlicloudArmsAlertContactGroupRead(d, meta)
+}
+
+func resourceAlicloudArmsAlertContactGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "DeleteAlertContactGroup", map[string]string{
+			"ContactGroupId": d.Id(),
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "DeleteAlertContactGroup got an error")
+	}
+
+	return nil
+}
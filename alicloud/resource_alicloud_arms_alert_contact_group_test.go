@@ -0,0 +1,127 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudArmsAlertContactGroup_basic(t *testing.T) {
+	var group ArmsAlertContactGroup
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_arms_alert_contact_group.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckArmsAlertContactGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccArmsAlertContactGroupConfig("tf-testAccArmsAlertContactGroup"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArmsAlertContactGroupExists("alicloud_arms_alert_contact_group.foo", &group),
+					resource.TestCheckResourceAttr("alicloud_arms_alert_contact.foo", "alert_contact_name", "tf-testAccArmsAlertContact"),
+					resource.TestCheckResourceAttr("alicloud_arms_alert_contact.foo", "email", "tf-testacc@example.com"),
+					resource.TestCheckResourceAttr("alicloud_arms_alert_contact_group.foo", "alert_contact_group_name", "tf-testAccArmsAlertContactGroup"),
+					resource.TestCheckResourceAttr("alicloud_arms_alert_contact_group.foo", "contact_ids.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccArmsAlertContactGroupConfig("tf-testAccArmsAlertContactGroup-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArmsAlertContactGroupExists("alicloud_arms_alert_contact_group.foo", &group),
+					resource.TestCheckResourceAttr("alicloud_arms_alert_contact_group.foo", "alert_contact_group_name", "tf-testAccArmsAlertContactGroup-update"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudArmsAlertContactGroup_import(t *testing.T) {
+	resourceName := "alicloud_arms_alert_contact_group.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckArmsAlertContactGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccArmsAlertContactGroupConfig("tf-testAccArmsAlertContactGroup"),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckArmsAlertContactGroupExists(n string, group *ArmsAlertContactGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ARMS Alert Contact Group ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		g, err := client.DescribeArmsAlertContactGroup(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*group = g
+
+		return nil
+	}
+}
+
+func testAccCheckArmsAlertContactGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "alicloud_arms_alert_contact_group":
+			if _, err := client.DescribeArmsAlertContactGroup(rs.Primary.ID); err != nil {
+				if NotFoundError(err) {
+					continue
+				}
+				return err
+			}
+			return fmt.Errorf("ARMS Alert Contact Group %s still exist", rs.Primary.ID)
+		case "alicloud_arms_alert_contact":
+			if _, err := client.DescribeArmsAlertContact(rs.Primary.ID); err != nil {
+				if NotFoundError(err) {
+					continue
+				}
+				return err
+			}
+			return fmt.Errorf("ARMS Alert Contact %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccArmsAlertContactGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_arms_alert_contact" "foo" {
+  alert_contact_name = "tf-testAccArmsAlertContact"
+  email              = "tf-testacc@example.com"
+}
+
+resource "alicloud_arms_alert_contact_group" "foo" {
+  alert_contact_group_name = "%s"
+  contact_ids              = ["${alicloud_arms_alert_contact.foo.id}"]
+}
+`, name)
+}
@@ -0,0 +1,202 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudArmsNotificationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudArmsNotificationPolicyCreate,
+		Read:   resourceAlicloudArmsNotificationPolicyRead,
+		Update: resourceAlicloudArmsNotificationPolicyUpdate,
+		Delete: resourceAlicloudArmsNotificationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"matching_rules": armsMatchingRulesSchema(),
+			"group_wait": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+			},
+			"group_interval": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
+			},
+			"grouping_fields": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"notify_channels": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAllowedStringValue([]string{"dingTalk", "email", "sms", "tts", "webhook"}),
+				},
+				Required: true,
+			},
+			"notify_objects": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"notify_object_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"CONTACT", "CONTACT_GROUP", "DING_ROBOT", "WEBHOOK"}),
+						},
+						"notify_object_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"notify_object_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"notify_start_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "00:00",
+			},
+			"notify_end_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "23:59",
+			},
+			"repeat_interval": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600,
+			},
+			"send_recover_message": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAlicloudArmsNotificationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAlicloudArmsNotificationPolicyUpdate(d, meta)
+}
+
+func resourceAlicloudArmsNotificationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	policy, err := meta.(*AliyunClient).DescribeArmsNotificationPolicy(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("matching_rules", flattenArmsMatchingRules(policy.MatchingRules))
+	d.Set("group_wait", policy.GroupRule.GroupWait)
+	d.Set("group_interval", policy.GroupRule.GroupInterval)
+	d.Set("grouping_fields", policy.GroupRule.GroupingFields)
+	d.Set("notify_channels", policy.NotifyRule.NotifyChannels)
+	var objects []map[string]interface{}
+	for _, o := range policy.NotifyRule.NotifyObjects {
+		objects = append(objects, map[string]interface{}{
+			"notify_object_type": o.NotifyObjectType,
+			"notify_object_id":   o.NotifyObjectId,
+			"notify_object_name": o.NotifyObjectName,
+		})
+	}
+	d.Set("notify_objects", objects)
+	d.Set("notify_start_time", policy.NotifyRule.NotifyStartTime)
+	d.Set("notify_end_time", policy.NotifyRule.NotifyEndTime)
+	d.Set("repeat_interval", policy.RepeatInterval)
+	d.Set("send_recover_message", policy.SendRecoverMessage)
+
+	return nil
+}
+
+// CreateOrUpdateNotificationPolicy creates a policy when no Id is given.
+func resourceAlicloudArmsNotificationPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	groupRule := ArmsGroupRule{
+		GroupWait:      d.Get("group_wait").(int),
+		GroupInterval:  d.Get("group_interval").(int),
+		GroupingFields: expandStringList(d.Get("grouping_fields").([]interface{})),
+	}
+	notifyRule := ArmsNotifyRule{
+		NotifyStartTime: d.Get("notify_start_time").(string),
+		NotifyEndTime:   d.Get("notify_end_time").(string),
+		NotifyChannels:  expandStringList(d.Get("notify_channels").(*schema.Set).List()),
+		NotifyObjects:   []ArmsNotifyObject{},
+	}
+	for _, o := range d.Get("notify_objects").([]interface{}) {
+		object := o.(map[string]interface{})
+		notifyRule.NotifyObjects = append(notifyRule.NotifyObjects, ArmsNotifyObject{
+			NotifyObjectType: object["notify_object_type"].(string),
+			NotifyObjectId:   object["notify_object_id"].(string),
+			NotifyObjectName: object["notify_object_name"].(string),
+		})
+	}
+
+	params := map[string]string{
+		"Name":               d.Get("name").(string),
+		"RepeatInterval":     strconv.Itoa(d.Get("repeat_interval").(int)),
+		"SendRecoverMessage": strconv.FormatBool(d.Get("send_recover_message").(bool)),
+	}
+	for key, value := range map[string]interface{}{
+		"MatchingRules": expandArmsMatchingRules(d.Get("matching_rules").([]interface{})),
+		"GroupRule":     groupRule,
+		"NotifyRule":    notifyRule,
+	} {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return WrapError(err)
+		}
+		params[key] = string(b)
+	}
+	if d.Id() != "" {
+		params["Id"] = d.Id()
+	}
+	var resp struct {
+		NotificationPolicy ArmsNotificationPolicy `json:"NotificationPolicy"`
+	}
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "CreateOrUpdateNotificationPolicy", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateOrUpdateNotificationPolicy got an error")
+	}
+	if d.Id() == "" {
+		d.SetId(strconv.FormatInt(resp.NotificationPolicy.Id, 10))
+	}
+
+	return resourceAlicloudArmsNotificationPolicyRead(d, meta)
+}
+
+func resourceAlicloudArmsNotificationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "DeleteNotificationPolicy", map[string]string{
+			"Id": d.Id(),
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "DeleteNotificationPolicy got an error")
+	}
+
+	return nil
+}
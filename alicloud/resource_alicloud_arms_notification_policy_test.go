@@ -0,0 +1,200 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAlicloudArmsNotificationPolicy_schema(t *testing.T) {
+	if err := resourceAlicloudArmsNotificationPolicy().InternalValidate(nil, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudArmsNotificationPolicy_basic(t *testing.T) {
+	var policy ArmsNotificationPolicy
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_arms_notification_policy.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckArmsNotificationPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccArmsNotificationPolicyConfig(600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArmsNotificationPolicyExists("alicloud_arms_notification_policy.foo", &policy),
+					resource.TestCheckResourceAttr("alicloud_arms_notification_policy.foo", "name", "tf-testAccArmsNotificationPolicy"),
+					resource.TestCheckResourceAttr("alicloud_arms_notification_policy.foo", "matching_rules.0.matching_conditions.0.key", "severity"),
+					resource.TestCheckResourceAttr("alicloud_arms_notification_policy.foo", "notify_channels.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_arms_notification_policy.foo", "notify_objects.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_arms_notification_policy.foo", "notify_objects.0.notify_object_type", "CONTACT"),
+					resource.TestCheckResourceAttrPair("alicloud_arms_notification_policy.foo", "notify_objects.0.notify_object_id",
+						"alicloud_arms_alert_contact.foo", "id"),
+					resource.TestCheckResourceAttr("alicloud_arms_notification_policy.foo", "repeat_interval", "600"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccArmsNotificationPolicyConfig(1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArmsNotificationPolicyExists("alicloud_arms_notification_policy.foo", &policy),
+					resource.TestCheckResourceAttr("alicloud_arms_notification_policy.foo", "repeat_interval", "1200"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "alicloud_arms_notification_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestArmsNotificationPolicyCreate(t *testing.T) {
+	client, server := newTestAliyunClient(t, ArmsCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("Action") {
+		case "CreateOrUpdateNotificationPolicy":
+			if id := r.FormValue("Id"); id != "" {
+				t.Errorf("Expected the policy is created without an Id, got %s", id)
+			}
+			expected := map[string]string{
+				"Name":               "tf-test",
+				"RepeatInterval":     "600",
+				"SendRecoverMessage": "true",
+				"MatchingRules":      `[{"matchingConditions":[{"key":"severity","value":"critical","operator":"eq"}]}]`,
+				"GroupRule":          `{"groupWait":5,"groupInterval":30,"groupingFields":["alertname"]}`,
+				"NotifyRule": `{"notifyStartTime":"00:00","notifyEndTime":"23:59","notifyChannels":["email"],` +
+					`"notifyObjects":[{"notifyObjectType":"CONTACT","notifyObjectId":"123","notifyObjectName":"ops"}]}`,
+			}
+			for key, value := range expected {
+				if got := r.FormValue(key); got != value {
+					t.Errorf("Expected the parameter %s is %s, got %s", key, value, got)
+				}
+			}
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","NotificationPolicy":{"Id":42}}`))
+		case "ListNotificationPolicies":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","PageBean":{"NotificationPolicies":[{"Id":42,"Name":"tf-test",` +
+				`"MatchingRules":[{"MatchingConditions":[{"Key":"severity","Value":"critical","Operator":"eq"}]}],` +
+				`"GroupRule":{"GroupWait":5,"GroupInterval":30,"GroupingFields":["alertname"]},` +
+				`"NotifyRule":{"NotifyStartTime":"00:00","NotifyEndTime":"23:59","NotifyChannels":["email"],` +
+				`"NotifyObjects":[{"NotifyObjectType":"CONTACT","NotifyObjectId":"123","NotifyObjectName":"ops"}]},` +
+				`"RepeatInterval":600,"SendRecoverMessage":true}]}}`))
+		default:
+			t.Errorf("Unexpected action %s", r.FormValue("Action"))
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAlicloudArmsNotificationPolicy().Schema, map[string]interface{}{
+		"name": "tf-test",
+		"matching_rules": []interface{}{
+			map[string]interface{}{
+				"matching_conditions": []interface{}{
+					map[string]interface{}{"key": "severity", "value": "critical", "operator": "eq"},
+				},
+			},
+		},
+		"grouping_fields": []interface{}{"alertname"},
+		"notify_channels": []interface{}{"email"},
+		"notify_objects": []interface{}{
+			map[string]interface{}{"notify_object_type": "CONTACT", "notify_object_id": "123", "notify_object_name": "ops"},
+		},
+		"send_recover_message": true,
+	})
+	if err := resourceAlicloudArmsNotificationPolicyCreate(d, client); err != nil {
+		t.Fatalf("Creating the policy got an error: %#v", err)
+	}
+
+	expected := map[string]string{
+		"id": "42",
+		"matching_rules.0.matching_conditions.0.operator": "eq",
+		"grouping_fields.0":                   "alertname",
+		"notify_objects.0.notify_object_name": "ops",
+		"send_recover_message":                "true",
+	}
+	state := d.State()
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("Expected %s is %q, got %q", key, value, got)
+		}
+	}
+}
+
+func testAccCheckArmsNotificationPolicyExists(n string, policy *ArmsNotificationPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ARMS Notification Policy ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		p, err := client.DescribeArmsNotificationPolicy(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*policy = p
+
+		return nil
+	}
+}
+
+func testAccCheckArmsNotificationPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_arms_notification_policy" {
+			continue
+		}
+
+		if _, err := client.DescribeArmsNotificationPolicy(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ARMS Notification Policy %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccArmsNotificationPolicyConfig(repeatInterval int) string {
+	return fmt.Sprintf(`
+resource "alicloud_arms_alert_contact" "foo" {
+  alert_contact_name = "tf-testAccArmsNotificationPolicy"
+  email              = "tf-testacc@example.com"
+}
+
+resource "alicloud_arms_notification_policy" "foo" {
+  name = "tf-testAccArmsNotificationPolicy"
+  matching_rules {
+    matching_conditions {
+      key      = "severity"
+      value    = "critical"
+      operator = "eq"
+    }
+  }
+  notify_channels = ["email"]
+  notify_objects {
+    notify_object_type = "CONTACT"
+    notify_object_id   = "${alicloud_arms_alert_contact.foo.id}"
+    notify_object_name = "${alicloud_arms_alert_contact.foo.alert_contact_name}"
+  }
+  repeat_interval = %d
+}
+`, repeatInterval)
+}
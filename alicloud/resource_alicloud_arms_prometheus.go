@@ -0,0 +1,174 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudArmsPrometheus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudArmsPrometheusCreate,
+		Read:   resourceAlicloudArmsPrometheusRead,
+		Delete: resourceAlicloudArmsPrometheusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{ArmsPrometheusRemoteWrite, ArmsPrometheusEcs, ArmsPrometheusKubernetes}),
+			},
+			// The Container Service cluster to integrate when cluster_type is aliyun-cs.
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"grafana_instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"remote_write_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_read_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_api_inter_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_api_intra_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudArmsPrometheusCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	clusterType := d.Get("cluster_type").(string)
+	params := map[string]string{
+		"ClusterType": clusterType,
+	}
+	switch clusterType {
+	case ArmsPrometheusKubernetes:
+		if v, ok := d.GetOk("cluster_id"); ok {
+			params["ClusterId"] = v.(string)
+		} else {
+			return fmt.Errorf("'cluster_id' is required when 'cluster_type' is %s.", clusterType)
+		}
+	case ArmsPrometheusEcs:
+		for _, k := range []string{"vpc_id", "vswitch_id", "security_group_id"} {
+			if _, ok := d.GetOk(k); !ok {
+				return fmt.Errorf("'%s' is required when 'cluster_type' is %s.", k, clusterType)
+			}
+		}
+	}
+	if v, ok := d.GetOk("cluster_name"); ok {
+		params["ClusterName"] = v.(string)
+	}
+	if v, ok := d.GetOk("vpc_id"); ok {
+		params["VpcId"] = v.(string)
+	}
+	if v, ok := d.GetOk("vswitch_id"); ok {
+		params["VSwitchId"] = v.(string)
+	}
+	if v, ok := d.GetOk("security_group_id"); ok {
+		params["SecurityGroupId"] = v.(string)
+	}
+	if v, ok := d.GetOk("grafana_instance_id"); ok {
+		params["GrafanaInstanceId"] = v.(string)
+	}
+
+	var resp struct {
+		Data string `json:"Data"`
+	}
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "CreatePrometheusInstance", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreatePrometheusInstance got an error")
+	}
+	d.SetId(resp.Data)
+
+	return resourceAlicloudArmsPrometheusRead(d, meta)
+}
+
+func resourceAlicloudArmsPrometheusRead(d *schema.ResourceData, meta interface{}) error {
+	prometheus, err := meta.(*AliyunClient).DescribeArmsPrometheus(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("cluster_type", prometheus.ClusterType)
+	d.Set("cluster_id", prometheus.ClusterId)
+	d.Set("cluster_name", prometheus.ClusterName)
+	d.Set("vpc_id", prometheus.VpcId)
+	d.Set("vswitch_id", prometheus.VSwitchId)
+	d.Set("security_group_id", prometheus.SecurityGroupId)
+	d.Set("grafana_instance_id", prometheus.GrafanaInstanceId)
+	d.Set("remote_write_url", prometheus.RemoteWriteUrl)
+	d.Set("remote_read_url", prometheus.RemoteReadUrl)
+	d.Set("http_api_inter_url", prometheus.HttpApiInterUrl)
+	d.Set("http_api_intra_url", prometheus.HttpApiIntraUrl)
+
+	return nil
+}
+
+func resourceAlicloudArmsPrometheusDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "UninstallPromCluster", map[string]string{
+			"ClusterId": d.Id(),
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "UninstallPromCluster got an error")
+	}
+
+	return client.WaitForArmsPrometheusDeleted(d.Id(), timeoutSeconds(d, schema.TimeoutDelete))
+}
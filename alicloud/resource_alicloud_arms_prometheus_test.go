@@ -0,0 +1,121 @@
+package alicloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAlicloudArmsPrometheus_schema(t *testing.T) {
+	if err := resourceAlicloudArmsPrometheus().InternalValidate(nil, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudArmsPrometheus_basic(t *testing.T) {
+	var prometheus ArmsPrometheus
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_arms_prometheus.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckArmsPrometheusDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccArmsPrometheusConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArmsPrometheusExists("alicloud_arms_prometheus.foo", &prometheus),
+					resource.TestCheckResourceAttr("alicloud_arms_prometheus.foo", "cluster_type", ArmsPrometheusRemoteWrite),
+					resource.TestCheckResourceAttr("alicloud_arms_prometheus.foo", "cluster_name", "tf-testAccArmsPrometheus"),
+					resource.TestCheckResourceAttrSet("alicloud_arms_prometheus.foo", "remote_write_url"),
+					resource.TestCheckResourceAttrSet("alicloud_arms_prometheus.foo", "http_api_inter_url"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "alicloud_arms_prometheus.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestArmsPrometheusCreateRequiredArguments(t *testing.T) {
+	cases := []struct {
+		raw      map[string]interface{}
+		expected string
+	}{
+		{
+			raw:      map[string]interface{}{"cluster_type": ArmsPrometheusKubernetes},
+			expected: "'cluster_id' is required",
+		},
+		{
+			raw:      map[string]interface{}{"cluster_type": ArmsPrometheusEcs, "vpc_id": "vpc-abc", "vswitch_id": "vsw-abc"},
+			expected: "'security_group_id' is required",
+		},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceAlicloudArmsPrometheus().Schema, c.raw)
+		err := resourceAlicloudArmsPrometheusCreate(d, &AliyunClient{})
+		if err == nil || !regexp.MustCompile(c.expected).MatchString(err.Error()) {
+			t.Errorf("Expected the error %s for %v, got %v", c.expected, c.raw, err)
+		}
+	}
+}
+
+func testAccCheckArmsPrometheusExists(n string, prometheus *ArmsPrometheus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ARMS Prometheus ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		p, err := client.DescribeArmsPrometheus(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*prometheus = p
+
+		return nil
+	}
+}
+
+func testAccCheckArmsPrometheusDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_arms_prometheus" {
+			continue
+		}
+
+		if _, err := client.DescribeArmsPrometheus(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ARMS Prometheus %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccArmsPrometheusConfig = `
+resource "alicloud_arms_prometheus" "foo" {
+  cluster_type = "remote-write"
+  cluster_name = "tf-testAccArmsPrometheus"
+}
+`
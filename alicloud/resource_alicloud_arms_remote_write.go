@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudArmsRemoteWrite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudArmsRemoteWriteCreate,
+		Read:   resourceAlicloudArmsRemoteWriteRead,
+		Update: resourceAlicloudArmsRemoteWriteUpdate,
+		Delete: resourceAlicloudArmsRemoteWriteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remote_write_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remote_write_yaml": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateYamlString,
+				DiffSuppressFunc: yamlDiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceAlicloudArmsRemoteWriteCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("remote_write_name").(string)
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "CreatePrometheusRemoteWrite", map[string]string{
+			"ClusterId":       clusterId,
+			"RemoteWriteYaml": d.Get("remote_write_yaml").(string),
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "CreatePrometheusRemoteWrite got an error")
+	}
+	d.SetId(clusterId + COLON_SEPARATED + name)
+
+	return resourceAlicloudArmsRemoteWriteRead(d, meta)
+}
+
+func resourceAlicloudArmsRemoteWriteRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseArmsRemoteWriteId(d.Id())
+	if err != nil {
+		return err
+	}
+	remoteWrite, err := meta.(*AliyunClient).DescribeArmsRemoteWrite(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("cluster_id", parts[0])
+	d.Set("remote_write_name", remoteWrite.RemoteWriteName)
+	d.Set("remote_write_yaml", remoteWrite.RemoteWriteYaml)
+
+	return nil
+}
+
+func resourceAlicloudArmsRemoteWriteUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseArmsRemoteWriteId(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "UpdatePrometheusRemoteWrite", map[string]string{
+			"ClusterId":       parts[0],
+			"RemoteWriteName": parts[1],
+			"RemoteWriteYaml": d.Get("remote_write_yaml").(string),
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "UpdatePrometheusRemoteWrite got an error")
+	}
+
+	return resourceAlicloudArmsRemoteWriteRead(d, meta)
+}
+
+func resourceAlicloudArmsRemoteWriteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseArmsRemoteWriteId(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "DeletePrometheusRemoteWrites", map[string]string{
+			"ClusterId":        parts[0],
+			"RemoteWriteNames": parts[1],
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "DeletePrometheusRemoteWrites got an error")
+	}
+
+	return nil
+}
+
+func parseArmsRemoteWriteId(id string) ([]string, error) {
+	return ParseResourceId(id, "ARMS remote write", "cluster_id", "remote_write_name")
+}
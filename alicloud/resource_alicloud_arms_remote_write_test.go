@@ -0,0 +1,158 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAlicloudArmsRemoteWrite_schema(t *testing.T) {
+	if err := resourceAlicloudArmsRemoteWrite().InternalValidate(nil, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudArmsRemoteWrite_basic(t *testing.T) {
+	var remoteWrite ArmsRemoteWrite
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_arms_remote_write.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckArmsRemoteWriteDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccArmsRemoteWriteConfig("http://127.0.0.1:9090/api/v1/write"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArmsRemoteWriteExists("alicloud_arms_remote_write.foo", &remoteWrite),
+					resource.TestCheckResourceAttrPair("alicloud_arms_remote_write.foo", "cluster_id", "alicloud_arms_prometheus.foo", "id"),
+					resource.TestCheckResourceAttr("alicloud_arms_remote_write.foo", "remote_write_name", "tf-testAccArmsRemoteWrite"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccArmsRemoteWriteConfig("http://127.0.0.2:9090/api/v1/write"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArmsRemoteWriteExists("alicloud_arms_remote_write.foo", &remoteWrite),
+					resource.TestCheckResourceAttr("alicloud_arms_remote_write.foo", "remote_write_name", "tf-testAccArmsRemoteWrite"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "alicloud_arms_remote_write.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestArmsRemoteWriteUpdate(t *testing.T) {
+	yaml := "remote_write:\n- name: tf-test\n  url: http://127.0.0.1:9090/api/v1/write\n"
+	client, server := newTestAliyunClient(t, ArmsCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if clusterId, name := r.FormValue("ClusterId"), r.FormValue("RemoteWriteName"); clusterId != "c-abc" || name != "tf-test" {
+			t.Errorf("Expected the remote write is identified by its cluster and name, got %s and %s", clusterId, name)
+		}
+		switch r.FormValue("Action") {
+		case "UpdatePrometheusRemoteWrite":
+			if got := r.FormValue("RemoteWriteYaml"); got != yaml {
+				t.Errorf("Expected the yaml %q, got %q", yaml, got)
+			}
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Data":"success"}`))
+		case "GetPrometheusRemoteWrite":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Data":{"RemoteWriteName":"tf-test","RemoteWriteYaml":"remote_write:\n- url: http://127.0.0.1:9090/api/v1/write\n  name: tf-test\n"}}`))
+		default:
+			t.Errorf("Unexpected action %s", r.FormValue("Action"))
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAlicloudArmsRemoteWrite().Schema, map[string]interface{}{
+		"cluster_id":        "c-abc",
+		"remote_write_name": "tf-test",
+		"remote_write_yaml": yaml,
+	})
+	d.SetId("c-abc:tf-test")
+	if err := resourceAlicloudArmsRemoteWriteUpdate(d, client); err != nil {
+		t.Fatalf("Updating the remote write got an error: %#v", err)
+	}
+	if d.Id() != "c-abc:tf-test" || d.Get("cluster_id").(string) != "c-abc" {
+		t.Fatalf("Expected the remote write is read back, got %s", d.Id())
+	}
+}
+
+func testAccCheckArmsRemoteWriteExists(n string, remoteWrite *ArmsRemoteWrite) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ARMS Remote Write ID is set")
+		}
+
+		parts, err := parseArmsRemoteWriteId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := testAccProvider.Meta().(*AliyunClient)
+		r, err := client.DescribeArmsRemoteWrite(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*remoteWrite = r
+
+		return nil
+	}
+}
+
+func testAccCheckArmsRemoteWriteDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_arms_remote_write" {
+			continue
+		}
+
+		parts, err := parseArmsRemoteWriteId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DescribeArmsRemoteWrite(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ARMS Remote Write %s still exist", rs.Primary.ID)
+	}
+
+	return testAccCheckArmsPrometheusDestroy(s)
+}
+
+func testAccArmsRemoteWriteConfig(url string) string {
+	return fmt.Sprintf(`
+resource "alicloud_arms_prometheus" "foo" {
+  cluster_type = "remote-write"
+  cluster_name = "tf-testAccArmsRemoteWrite"
+}
+
+resource "alicloud_arms_remote_write" "foo" {
+  cluster_id        = "${alicloud_arms_prometheus.foo.id}"
+  remote_write_name = "tf-testAccArmsRemoteWrite"
+  remote_write_yaml = <<EOF
+remote_write:
+- name: tf-testAccArmsRemoteWrite
+  url: %s
+EOF
+}
+`, url)
+}
@@ -0,0 +1,162 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudArmsSilencePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudArmsSilencePolicyCreate,
+		Read:   resourceAlicloudArmsSilencePolicyRead,
+		Update: resourceAlicloudArmsSilencePolicyUpdate,
+		Delete: resourceAlicloudArmsSilencePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"matching_rules": armsMatchingRulesSchema(),
+		},
+	}
+}
+
+// armsMatchingRulesSchema is shared by the silence policy and the notification policy.
+// An alert matches a rule when it matches all of the rule's conditions.
+func armsMatchingRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"matching_conditions": &schema.Schema{
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"value": &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"operator": &schema.Schema{
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateAllowedStringValue([]string{"eq", "neq", "in", "nin", "re", "nre"}),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandArmsMatchingRules(l []interface{}) []ArmsMatchingRule {
+	rules := []ArmsMatchingRule{}
+	for _, r := range l {
+		rule := ArmsMatchingRule{MatchingConditions: []ArmsMatchingCondition{}}
+		for _, c := range r.(map[string]interface{})["matching_conditions"].([]interface{}) {
+			condition := c.(map[string]interface{})
+			rule.MatchingConditions = append(rule.MatchingConditions, ArmsMatchingCondition{
+				Key:      condition["key"].(string),
+				Value:    condition["value"].(string),
+				Operator: condition["operator"].(string),
+			})
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenArmsMatchingRules(rules []ArmsMatchingRule) []map[string]interface{} {
+	var l []map[string]interface{}
+	for _, rule := range rules {
+		var conditions []map[string]interface{}
+		for _, c := range rule.MatchingConditions {
+			conditions = append(conditions, map[string]interface{}{
+				"key":      c.Key,
+				"value":    c.Value,
+				"operator": c.Operator,
+			})
+		}
+		l = append(l, map[string]interface{}{
+			"matching_conditions": conditions,
+		})
+	}
+	return l
+}
+
+func resourceAlicloudArmsSilencePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAlicloudArmsSilencePolicyUpdate(d, meta)
+}
+
+func resourceAlicloudArmsSilencePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	policy, err := meta.(*AliyunClient).DescribeArmsSilencePolicy(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("matching_rules", flattenArmsMatchingRules(policy.MatchingRules))
+
+	return nil
+}
+
+// CreateOrUpdateSilencePolicy creates a policy when no Id is given.
+func resourceAlicloudArmsSilencePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	rules, err := json.Marshal(expandArmsMatchingRules(d.Get("matching_rules").([]interface{})))
+	if err != nil {
+		return WrapError(err)
+	}
+	params := map[string]string{
+		"Name":          d.Get("name").(string),
+		"MatchingRules": string(rules),
+	}
+	if d.Id() != "" {
+		params["Id"] = d.Id()
+	}
+	var resp struct {
+		SilencePolicy ArmsSilencePolicy `json:"SilencePolicy"`
+	}
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "CreateOrUpdateSilencePolicy", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateOrUpdateSilencePolicy got an error")
+	}
+	if d.Id() == "" {
+		d.SetId(strconv.FormatInt(resp.SilencePolicy.Id, 10))
+	}
+
+	return resourceAlicloudArmsSilencePolicyRead(d, meta)
+}
+
+func resourceAlicloudArmsSilencePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(ArmsCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "DeleteSilencePolicy", map[string]string{
+			"Id": d.Id(),
+		}, nil)
+	}); err != nil {
+		return WrapErrorf(err, "DeleteSilencePolicy got an error")
+	}
+
+	return nil
+}
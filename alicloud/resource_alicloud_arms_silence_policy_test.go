@@ -0,0 +1,100 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudArmsSilencePolicy_basic(t *testing.T) {
+	var policy ArmsSilencePolicy
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_arms_silence_policy.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckArmsSilencePolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccArmsSilencePolicyConfig("eq"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArmsSilencePolicyExists("alicloud_arms_silence_policy.foo", &policy),
+					resource.TestCheckResourceAttr("alicloud_arms_silence_policy.foo", "name", "tf-testAccArmsSilencePolicy"),
+					resource.TestCheckResourceAttr("alicloud_arms_silence_policy.foo", "matching_rules.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_arms_silence_policy.foo", "matching_rules.0.matching_conditions.0.key", "severity"),
+					resource.TestCheckResourceAttr("alicloud_arms_silence_policy.foo", "matching_rules.0.matching_conditions.0.operator", "eq"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccArmsSilencePolicyConfig("neq"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArmsSilencePolicyExists("alicloud_arms_silence_policy.foo", &policy),
+					resource.TestCheckResourceAttr("alicloud_arms_silence_policy.foo", "matching_rules.0.matching_conditions.0.operator", "neq"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckArmsSilencePolicyExists(n string, policy *ArmsSilencePolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ARMS Silence Policy ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		p, err := client.DescribeArmsSilencePolicy(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*policy = p
+
+		return nil
+	}
+}
+
+func testAccCheckArmsSilencePolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_arms_silence_policy" {
+			continue
+		}
+
+		if _, err := client.DescribeArmsSilencePolicy(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("ARMS Silence Policy %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccArmsSilencePolicyConfig(operator string) string {
+	return fmt.Sprintf(`
+resource "alicloud_arms_silence_policy" "foo" {
+  name = "tf-testAccArmsSilencePolicy"
+  matching_rules {
+    matching_conditions {
+      key      = "severity"
+      value    = "warning"
+      operator = "%s"
+    }
+  }
+}
+`, operator)
+}
@@ -0,0 +1,218 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCddcDedicatedHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCddcDedicatedHostCreate,
+		Read:   resourceAlicloudCddcDedicatedHostRead,
+		Update: resourceAlicloudCddcDedicatedHostUpdate,
+		Delete: resourceAlicloudCddcDedicatedHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dedicated_host_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateInstanceChargeTypePeriod,
+			},
+			"auto_renew": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"host_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"allocation_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{CddcHostAllocatable, CddcHostSuspended}),
+			},
+			"dedicated_host_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expired_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCddcDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	groupId := d.Get("dedicated_host_group_id").(string)
+	params := map[string]string{
+		"DedicatedHostGroupId": groupId,
+		"HostClass":            d.Get("host_class").(string),
+		"PayType":              "prepaid",
+		"AutoRenew":            strconv.FormatBool(d.Get("auto_renew").(bool)),
+		"ClientToken":          buildClientToken("TF-CreateDedicatedHost"),
+	}
+	params["UsedTime"], params["Period"] = prepaidPeriod(d.Get("period").(int))
+
+	vsw, err := client.DescribeVswitch(d.Get("vswitch_id").(string))
+	if err != nil {
+		return WrapErrorf(err, "DescribeVSwitchAttributes got an error")
+	}
+	if v, ok := d.GetOk("zone_id"); ok && v.(string) != vsw.ZoneId {
+		return fmt.Errorf("The specified vswitch %s isn't in the zone %s.", vsw.VSwitchId, v.(string))
+	}
+	params["ZoneId"] = vsw.ZoneId
+	params["VSwitchId"] = vsw.VSwitchId
+
+	var resp struct {
+		DedicateHostList struct {
+			DedicateHostList []struct {
+				DedicatedHostId string `json:"DedicatedHostId"`
+			} `json:"DedicateHostList"`
+		} `json:"DedicateHostList"`
+	}
+	if err := RetryOnError(CddcCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cddcEndpoint(), CddcApiVersion, "CreateDedicatedHost", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateDedicatedHost got an error")
+	}
+	if len(resp.DedicateHostList.DedicateHostList) < 1 {
+		return fmt.Errorf("CreateDedicatedHost got no host in the group %s.", groupId)
+	}
+	hostId := resp.DedicateHostList.DedicateHostList[0].DedicatedHostId
+	d.SetId(groupId + COLON_SEPARATED + hostId)
+
+	if err := client.WaitForCddcDedicatedHost(groupId, hostId, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "WaitForCddcDedicatedHost %s got an error", hostId)
+	}
+
+	return resourceAlicloudCddcDedicatedHostUpdate(d, meta)
+}
+
+func resourceAlicloudCddcDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseCddcDedicatedHostId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	host, err := meta.(*AliyunClient).DescribeCddcDedicatedHost(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("dedicated_host_group_id", parts[0])
+	d.Set("dedicated_host_id", host.DedicatedHostId)
+	d.Set("host_class", host.HostClass)
+	d.Set("zone_id", host.ZoneId)
+	d.Set("vswitch_id", host.VSwitchId)
+	d.Set("host_name", host.HostName)
+	d.Set("allocation_status", host.AllocationStatus)
+	d.Set("ip_address", host.IPAddress)
+	d.Set("expired_time", host.ExpiredTime)
+
+	return nil
+}
+
+func resourceAlicloudCddcDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseCddcDedicatedHostId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The host is named and allocatable by default when it is created.
+	if d.HasChange("host_name") || d.HasChange("allocation_status") {
+		params := map[string]string{
+			"DedicatedHostGroupId": parts[0],
+			"DedicatedHostId":      parts[1],
+		}
+		if v, ok := d.GetOk("host_name"); ok {
+			params["HostName"] = v.(string)
+		}
+		if v, ok := d.GetOk("allocation_status"); ok {
+			params["AllocationStatus"] = v.(string)
+		}
+		if err := RetryOnError(CddcCode, 3*time.Minute, func() error {
+			return client.ProcessRpcRequest(client.cddcEndpoint(), CddcApiVersion, "ModifyDedicatedHostAttribute", params, nil)
+		}); err != nil {
+			return WrapErrorf(err, "ModifyDedicatedHostAttribute got an error")
+		}
+	}
+
+	return resourceAlicloudCddcDedicatedHostRead(d, meta)
+}
+
+// The host can be released only after all the instances on it are migrated or deleted.
+func resourceAlicloudCddcDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseCddcDedicatedHostId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := RetryOnError(CddcCode, 5*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cddcEndpoint(), CddcApiVersion, "ReleaseDedicatedHost", map[string]string{
+			"DedicatedHostId": parts[1],
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, CddcHostNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "ReleaseDedicatedHost got an error")
+	}
+
+	return nil
+}
+
+func parseCddcDedicatedHostId(id string) ([]string, error) {
+	return ParseResourceId(id, "CDDC dedicated host", "dedicated_host_group_id", "dedicated_host_id")
+}
@@ -0,0 +1,176 @@
+package alicloud
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCddcDedicatedHostGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCddcDedicatedHostGroupCreate,
+		Read:   resourceAlicloudCddcDedicatedHostGroupRead,
+		Update: resourceAlicloudCddcDedicatedHostGroupUpdate,
+		Delete: resourceAlicloudCddcDedicatedHostGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"engine": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"mysql", "mssql", "pgsql", "redis"}),
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cpu_allocation_ratio": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(100, 300),
+			},
+			"mem_allocation_ratio": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 100),
+			},
+			"disk_allocation_ratio": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(100, 200),
+			},
+			"allocation_policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Evenly", "Intensively"}),
+			},
+			"host_replace_policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Auto", "Manual"}),
+			},
+			"open_permission": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func buildCddcDedicatedHostGroupParams(d *schema.ResourceData) map[string]string {
+	params := map[string]string{
+		"DedicatedHostGroupDesc": d.Get("description").(string),
+		"OpenPermission":         "0",
+	}
+	if d.Get("open_permission").(bool) {
+		params["OpenPermission"] = "1"
+	}
+	for key, param := range map[string]string{
+		"cpu_allocation_ratio":  "CpuAllocationRatio",
+		"mem_allocation_ratio":  "MemAllocationRatio",
+		"disk_allocation_ratio": "DiskAllocationRatio",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			params[param] = strconv.Itoa(v.(int))
+		}
+	}
+	if v, ok := d.GetOk("allocation_policy"); ok {
+		params["AllocationPolicy"] = v.(string)
+	}
+	if v, ok := d.GetOk("host_replace_policy"); ok {
+		params["HostReplacePolicy"] = v.(string)
+	}
+	return params
+}
+
+func resourceAlicloudCddcDedicatedHostGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := buildCddcDedicatedHostGroupParams(d)
+	params["Engine"] = d.Get("engine").(string)
+	params["VPCID"] = d.Get("vpc_id").(string)
+	params["ClientToken"] = buildClientToken("TF-CreateDedicatedHostGroup")
+
+	var resp struct {
+		DedicatedHostGroupId string `json:"DedicatedHostGroupId"`
+	}
+	if err := RetryOnError(CddcCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cddcEndpoint(), CddcApiVersion, "CreateDedicatedHostGroup", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateDedicatedHostGroup got an error")
+	}
+	d.SetId(resp.DedicatedHostGroupId)
+
+	return resourceAlicloudCddcDedicatedHostGroupRead(d, meta)
+}
+
+func resourceAlicloudCddcDedicatedHostGroupRead(d *schema.ResourceData, meta interface{}) error {
+	group, err := meta.(*AliyunClient).DescribeCddcDedicatedHostGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("engine", group.Engine)
+	d.Set("vpc_id", group.VPCId)
+	d.Set("description", group.DedicatedHostGroupDesc)
+	d.Set("cpu_allocation_ratio", group.CpuAllocationRatio)
+	d.Set("mem_allocation_ratio", group.MemAllocationRatio)
+	d.Set("disk_allocation_ratio", group.DiskAllocationRatio)
+	d.Set("allocation_policy", group.AllocationPolicy)
+	d.Set("host_replace_policy", group.HostReplacePolicy)
+	d.Set("open_permission", group.OpenPermission == "1")
+
+	return nil
+}
+
+func resourceAlicloudCddcDedicatedHostGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// The attributes are modified as a whole.
+	params := buildCddcDedicatedHostGroupParams(d)
+	params["DedicatedHostGroupId"] = d.Id()
+	if err := RetryOnError(CddcCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cddcEndpoint(), CddcApiVersion, "ModifyDedicatedHostGroupAttribute", params, nil)
+	}); err != nil {
+		return WrapErrorf(err, "ModifyDedicatedHostGroupAttribute got an error")
+	}
+
+	return resourceAlicloudCddcDedicatedHostGroupRead(d, meta)
+}
+
+// The group can be deleted only after all its hosts are released.
+func resourceAlicloudCddcDedicatedHostGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(CddcCode, 5*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cddcEndpoint(), CddcApiVersion, "DeleteDedicatedHostGroup", map[string]string{
+			"DedicatedHostGroupId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, CddcHostGroupNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteDedicatedHostGroup got an error")
+	}
+
+	return nil
+}
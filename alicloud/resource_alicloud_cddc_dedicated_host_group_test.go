@@ -0,0 +1,102 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCddcDedicatedHostGroup_basic(t *testing.T) {
+	var group CddcDedicatedHostGroup
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cddc_dedicated_host_group.group",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckCddcDedicatedHostGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCddcDedicatedHostGroupBasic(200, "Evenly"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCddcDedicatedHostGroupExists("alicloud_cddc_dedicated_host_group.group", &group),
+					resource.TestCheckResourceAttr("alicloud_cddc_dedicated_host_group.group", "engine", "mysql"),
+					resource.TestCheckResourceAttr("alicloud_cddc_dedicated_host_group.group", "cpu_allocation_ratio", "200"),
+					resource.TestCheckResourceAttr("alicloud_cddc_dedicated_host_group.group", "allocation_policy", "Evenly"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCddcDedicatedHostGroupBasic(300, "Intensively"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCddcDedicatedHostGroupExists("alicloud_cddc_dedicated_host_group.group", &group),
+					resource.TestCheckResourceAttr("alicloud_cddc_dedicated_host_group.group", "cpu_allocation_ratio", "300"),
+					resource.TestCheckResourceAttr("alicloud_cddc_dedicated_host_group.group", "allocation_policy", "Intensively"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCddcDedicatedHostGroupExists(n string, group *CddcDedicatedHostGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CDDC Dedicated Host Group ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		g, err := client.DescribeCddcDedicatedHostGroup(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*group = g
+
+		return nil
+	}
+}
+
+func testAccCheckCddcDedicatedHostGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cddc_dedicated_host_group" {
+			continue
+		}
+
+		if _, err := client.DescribeCddcDedicatedHostGroup(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("CDDC Dedicated Host Group %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCddcDedicatedHostGroupBasic(cpuRatio int, policy string) string {
+	return fmt.Sprintf(`
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccCddcDedicatedHostGroup"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_cddc_dedicated_host_group" "group" {
+  engine               = "mysql"
+  vpc_id               = "${alicloud_vpc.foo.id}"
+  description          = "tf-testAccCddcDedicatedHostGroup"
+  cpu_allocation_ratio = %d
+  allocation_policy    = "%s"
+}
+`, cpuRatio, policy)
+}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/denverdino/aliyungo/cdn"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -17,6 +16,9 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 		Read:   resourceAlicloudCdnDomainRead,
 		Update: resourceAlicloudCdnDomainUpdate,
 		Delete: resourceAlicloudCdnDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": &schema.Schema{
@@ -258,7 +260,7 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 }
 
 func resourceAlicloudCdnDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).cdnconn
+	conn := meta.(*AliyunClient).cdnconn()
 
 	args := cdn.AddDomainRequest{
 		DomainName: d.Get("domain_name").(string),
@@ -286,7 +288,7 @@ func resourceAlicloudCdnDomainCreate(d *schema.ResourceData, meta interface{}) e
 	}
 	_, err := conn.AddCdnDomain(args)
 	if err != nil {
-		return fmt.Errorf("AddCdnDomain got an error: %#v", err)
+		return WrapErrorf(err, "AddCdnDomain got an error")
 	}
 
 	d.SetId(args.DomainName)
@@ -294,7 +296,7 @@ func resourceAlicloudCdnDomainCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).cdnconn
+	conn := meta.(*AliyunClient).cdnconn()
 
 	d.Partial(true)
 
@@ -323,7 +325,7 @@ func resourceAlicloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) e
 		if attributeUpdate {
 			_, err := conn.ModifyCdnDomain(args)
 			if err != nil {
-				return fmt.Errorf("ModifyCdnDomain got an error: %#v", err)
+				return WrapErrorf(err, "ModifyCdnDomain got an error")
 			}
 		}
 	}
@@ -383,14 +385,14 @@ func resourceAlicloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).cdnconn
+	conn := meta.(*AliyunClient).cdnconn()
 
 	args := cdn.DescribeDomainRequest{
 		DomainName: d.Id(),
 	}
 	response, err := conn.DescribeCdnDomainDetail(args)
 	if err != nil {
-		return fmt.Errorf("DescribeCdnDomainDetail got an error: %#v", err)
+		return WrapErrorf(err, "DescribeCdnDomainDetail got an error")
 	}
 
 	domain := response.GetDomainDetailModel
@@ -406,7 +408,7 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 	}
 	resp, err := conn.DescribeDomainConfigs(describeConfigArgs)
 	if err != nil {
-		return fmt.Errorf("DescribeDomainConfigs got an error: %#v", err)
+		return WrapErrorf(err, "DescribeDomainConfigs got an error")
 	}
 	configs := resp.DomainConfigs
 
@@ -494,20 +496,19 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudCdnDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).cdnconn
+	conn := meta.(*AliyunClient).cdnconn()
 
 	args := cdn.DescribeDomainRequest{
 		DomainName: d.Id(),
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := conn.DeleteCdnDomain(args); err != nil {
-			if IsExceptedError(err, ServiceBusy) {
-				return resource.RetryableError(fmt.Errorf("The specified Domain is configuring, please retry later."))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting cdn domain %s: %#v.", d.Id(), err))
-		}
-		return nil
+	err := RetryOnError(CdnCode, 5*time.Minute, func() error {
+		_, err := conn.DeleteCdnDomain(args)
+		return err
 	})
+	if err != nil {
+		return WrapErrorf(err, "Error deleting cdn domain %s", d.Id())
+	}
+	return nil
 }
 
 func enableConfigUpdate(conn *cdn.CdnClient, d *schema.ResourceData) error {
@@ -701,7 +702,7 @@ func httpHeaderConfigUpdate(conn *cdn.CdnClient, d *schema.ResourceData) error {
 		}
 		_, err := conn.SetHttpHeaderConfig(args)
 		if err != nil {
-			return fmt.Errorf("SetHttpHeaderConfig got an error: %#v", err)
+			return WrapErrorf(err, "SetHttpHeaderConfig got an error")
 		}
 	}
 
@@ -722,7 +723,7 @@ func cacheConfigUpdate(conn *cdn.CdnClient, d *schema.ResourceData) error {
 			CacheType:  val["cache_type"].(string),
 		}
 		if _, err := conn.DeleteCacheExpiredConfig(args); err != nil {
-			return fmt.Errorf("DeleteCacheExpiredConfig got an error: %#v", err)
+			return WrapErrorf(err, "DeleteCacheExpiredConfig got an error")
 		}
 	}
 
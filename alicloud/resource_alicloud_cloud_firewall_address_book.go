@@ -0,0 +1,174 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCloudFirewallAddressBook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCloudFirewallAddressBookCreate,
+		Read:   resourceAlicloudCloudFirewallAddressBookRead,
+		Update: resourceAlicloudCloudFirewallAddressBookUpdate,
+		Delete: resourceAlicloudCloudFirewallAddressBookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"ip", "port", "domain", "tag"}),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"address_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"auto_add_tag_ecs": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tag_relation": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "and",
+				ValidateFunc: validateAllowedStringValue([]string{"and", "or"}),
+			},
+			"ecs_tags": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag_key": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"tag_value": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildCloudFirewallAddressBookParams(d *schema.ResourceData) map[string]string {
+	params := map[string]string{
+		"GroupName":     d.Get("group_name").(string),
+		"Description":   d.Get("description").(string),
+		"AddressList":   strings.Join(expandStringList(d.Get("address_list").(*schema.Set).List()), COMMA_SEPARATED),
+		"AutoAddTagEcs": "0",
+	}
+	if d.Get("group_type").(string) == "tag" {
+		if d.Get("auto_add_tag_ecs").(bool) {
+			params["AutoAddTagEcs"] = "1"
+		}
+		params["TagRelation"] = d.Get("tag_relation").(string)
+		for i, t := range d.Get("ecs_tags").([]interface{}) {
+			tag := t.(map[string]interface{})
+			params[fmt.Sprintf("TagList.%d.TagKey", i+1)] = tag["tag_key"].(string)
+			params[fmt.Sprintf("TagList.%d.TagValue", i+1)] = tag["tag_value"].(string)
+		}
+	}
+	return params
+}
+
+func resourceAlicloudCloudFirewallAddressBookCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := buildCloudFirewallAddressBookParams(d)
+	params["GroupType"] = d.Get("group_type").(string)
+
+	var resp struct {
+		GroupUuid string `json:"GroupUuid"`
+	}
+	if err := RetryOnError(CloudFirewallCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cloudFirewallEndpoint(), CloudFirewallApiVersion, "AddAddressBook", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "AddAddressBook got an error")
+	}
+	d.SetId(resp.GroupUuid)
+
+	return resourceAlicloudCloudFirewallAddressBookRead(d, meta)
+}
+
+func resourceAlicloudCloudFirewallAddressBookRead(d *schema.ResourceData, meta interface{}) error {
+	book, err := meta.(*AliyunClient).DescribeCloudFirewallAddressBook(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group_name", book.GroupName)
+	d.Set("group_type", book.GroupType)
+	d.Set("description", book.Description)
+	d.Set("address_list", book.AddressList)
+	if book.GroupType == "tag" {
+		d.Set("auto_add_tag_ecs", book.AutoAddTagEcs == 1)
+		d.Set("tag_relation", book.TagRelation)
+		var tags []map[string]interface{}
+		for _, tag := range book.TagList {
+			tags = append(tags, map[string]interface{}{
+				"tag_key":   tag.TagKey,
+				"tag_value": tag.TagValue,
+			})
+		}
+		if err := d.Set("ecs_tags", tags); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAlicloudCloudFirewallAddressBookUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// The address book is modified as a whole.
+	params := buildCloudFirewallAddressBookParams(d)
+	params["GroupUuid"] = d.Id()
+	if err := RetryOnError(CloudFirewallCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cloudFirewallEndpoint(), CloudFirewallApiVersion, "ModifyAddressBook", params, nil)
+	}); err != nil {
+		return WrapErrorf(err, "ModifyAddressBook got an error")
+	}
+
+	return resourceAlicloudCloudFirewallAddressBookRead(d, meta)
+}
+
+func resourceAlicloudCloudFirewallAddressBookDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(CloudFirewallCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cloudFirewallEndpoint(), CloudFirewallApiVersion, "DeleteAddressBook", map[string]string{
+			"GroupUuid": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, CloudFirewallGroupNotExist) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteAddressBook got an error")
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCloudFirewallAddressBook_basic(t *testing.T) {
+	var book CloudFirewallAddressBook
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cloud_firewall_address_book.book",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckCloudFirewallAddressBookDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCloudFirewallAddressBookBasic(`"10.0.0.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFirewallAddressBookExists("alicloud_cloud_firewall_address_book.book", &book),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_address_book.book", "group_name", "tf-testacc-book"),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_address_book.book", "group_type", "ip"),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_address_book.book", "address_list.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCloudFirewallAddressBookBasic(`"10.0.0.0/24", "10.0.1.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFirewallAddressBookExists("alicloud_cloud_firewall_address_book.book", &book),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_address_book.book", "address_list.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudCloudFirewallAddressBook_import(t *testing.T) {
+	resourceName := "alicloud_cloud_firewall_address_book.book"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFirewallAddressBookDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCloudFirewallAddressBookBasic(`"10.0.0.0/24"`),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFirewallAddressBookExists(n string, book *CloudFirewallAddressBook) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cloud Firewall Address Book ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		b, err := client.DescribeCloudFirewallAddressBook(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*book = b
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFirewallAddressBookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cloud_firewall_address_book" {
+			continue
+		}
+
+		if _, err := client.DescribeCloudFirewallAddressBook(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Cloud Firewall Address Book %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCloudFirewallAddressBookBasic(addresses string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cloud_firewall_address_book" "book" {
+  group_name   = "tf-testacc-book"
+  group_type   = "ip"
+  description  = "Terraform acc test"
+  address_list = [%s]
+}
+`, addresses)
+}
@@ -0,0 +1,237 @@
+package alicloud
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCloudFirewallControlPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCloudFirewallControlPolicyCreate,
+		Read:   resourceAlicloudCloudFirewallControlPolicyRead,
+		Update: resourceAlicloudCloudFirewallControlPolicyUpdate,
+		Delete: resourceAlicloudCloudFirewallControlPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"direction": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{CloudFirewallDirectionIn, CloudFirewallDirectionOut}),
+			},
+			"acl_action": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"accept", "drop", "log"}),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"proto": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"ANY", "TCP", "UDP", "ICMP"}),
+			},
+			"application_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ANY",
+			},
+			"source": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"net", "group", "location"}),
+			},
+			"destination": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"net", "group", "domain", "location"}),
+			},
+			"dest_port_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "port",
+				ValidateFunc: validateAllowedStringValue([]string{"port", "group"}),
+			},
+			"dest_port": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"dest_port_group": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"priority": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 10000),
+			},
+			"acl_uuid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildCloudFirewallControlPolicyParams(d *schema.ResourceData) map[string]string {
+	params := map[string]string{
+		"Direction":       d.Get("direction").(string),
+		"AclAction":       d.Get("acl_action").(string),
+		"Description":     d.Get("description").(string),
+		"Proto":           d.Get("proto").(string),
+		"ApplicationName": d.Get("application_name").(string),
+		"Source":          d.Get("source").(string),
+		"SourceType":      d.Get("source_type").(string),
+		"Destination":     d.Get("destination").(string),
+		"DestinationType": d.Get("destination_type").(string),
+		"DestPortType":    d.Get("dest_port_type").(string),
+	}
+	if v, ok := d.GetOk("dest_port"); ok {
+		params["DestPort"] = v.(string)
+	}
+	if v, ok := d.GetOk("dest_port_group"); ok {
+		params["DestPortGroup"] = v.(string)
+	}
+	return params
+}
+
+func resourceAlicloudCloudFirewallControlPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := buildCloudFirewallControlPolicyParams(d)
+	// The policy is appended as the lowest priority unless the priority is specified.
+	params["NewOrder"] = "-1"
+	if v, ok := d.GetOk("priority"); ok {
+		params["NewOrder"] = strconv.Itoa(v.(int))
+	}
+
+	var resp struct {
+		AclUuid string `json:"AclUuid"`
+	}
+	if err := RetryOnError(CloudFirewallCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cloudFirewallEndpoint(), CloudFirewallApiVersion, "AddControlPolicy", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "AddControlPolicy got an error")
+	}
+	d.SetId(resp.AclUuid + COLON_SEPARATED + params["Direction"])
+
+	return resourceAlicloudCloudFirewallControlPolicyRead(d, meta)
+}
+
+func resourceAlicloudCloudFirewallControlPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseCloudFirewallControlPolicyId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policy, err := meta.(*AliyunClient).DescribeCloudFirewallControlPolicy(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("direction", parts[1])
+	d.Set("acl_uuid", policy.AclUuid)
+	d.Set("acl_action", policy.AclAction)
+	d.Set("description", policy.Description)
+	d.Set("proto", policy.Proto)
+	d.Set("application_name", policy.ApplicationName)
+	d.Set("source", policy.Source)
+	d.Set("source_type", policy.SourceType)
+	d.Set("destination", policy.Destination)
+	d.Set("destination_type", policy.DestinationType)
+	d.Set("dest_port_type", policy.DestPortType)
+	d.Set("dest_port", policy.DestPort)
+	d.Set("dest_port_group", policy.DestPortGroup)
+	d.Set("priority", policy.Order)
+
+	return nil
+}
+
+func resourceAlicloudCloudFirewallControlPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	uuid := d.Get("acl_uuid").(string)
+	if d.HasChange("acl_action") || d.HasChange("description") || d.HasChange("proto") || d.HasChange("application_name") ||
+		d.HasChange("source") || d.HasChange("source_type") || d.HasChange("destination") || d.HasChange("destination_type") ||
+		d.HasChange("dest_port_type") || d.HasChange("dest_port") || d.HasChange("dest_port_group") {
+		params := buildCloudFirewallControlPolicyParams(d)
+		params["AclUuid"] = uuid
+		if err := RetryOnError(CloudFirewallCode, 3*time.Minute, func() error {
+			return client.ProcessRpcRequest(client.cloudFirewallEndpoint(), CloudFirewallApiVersion, "ModifyControlPolicy", params, nil)
+		}); err != nil {
+			return WrapErrorf(err, "ModifyControlPolicy got an error")
+		}
+		for _, k := range []string{"acl_action", "description", "proto", "application_name", "source", "source_type",
+			"destination", "destination_type", "dest_port_type", "dest_port", "dest_port_group"} {
+			d.SetPartial(k)
+		}
+	}
+
+	// Moving the policy shifts the priorities of the policies between its old and new positions.
+	if d.HasChange("priority") {
+		if err := RetryOnError(CloudFirewallCode, 3*time.Minute, func() error {
+			return client.ProcessRpcRequest(client.cloudFirewallEndpoint(), CloudFirewallApiVersion, "ModifyControlPolicyPriority", map[string]string{
+				"AclUuid":   uuid,
+				"Direction": d.Get("direction").(string),
+				"Order":     strconv.Itoa(d.Get("priority").(int)),
+			}, nil)
+		}); err != nil {
+			return WrapErrorf(err, "ModifyControlPolicyPriority got an error")
+		}
+		d.SetPartial("priority")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudCloudFirewallControlPolicyRead(d, meta)
+}
+
+func resourceAlicloudCloudFirewallControlPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseCloudFirewallControlPolicyId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := RetryOnError(CloudFirewallCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.cloudFirewallEndpoint(), CloudFirewallApiVersion, "DeleteControlPolicy", map[string]string{
+			"AclUuid":   parts[0],
+			"Direction": parts[1],
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, CloudFirewallAclNotExist) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteControlPolicy got an error")
+	}
+
+	return nil
+}
+
+func parseCloudFirewallControlPolicyId(id string) ([]string, error) {
+	return ParseResourceId(id, "Cloud Firewall control policy", "acl_uuid", "direction")
+}
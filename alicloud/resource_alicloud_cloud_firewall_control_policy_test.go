@@ -0,0 +1,127 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCloudFirewallControlPolicy_basic(t *testing.T) {
+	var policy CloudFirewallControlPolicy
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cloud_firewall_control_policy.policy",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckCloudFirewallControlPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCloudFirewallControlPolicyBasic("accept", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFirewallControlPolicyExists("alicloud_cloud_firewall_control_policy.policy", &policy),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_control_policy.policy", "direction", "in"),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_control_policy.policy", "acl_action", "accept"),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_control_policy.policy", "priority", "1"),
+					resource.TestCheckResourceAttrSet("alicloud_cloud_firewall_control_policy.policy", "acl_uuid"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCloudFirewallControlPolicyBasic("drop", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFirewallControlPolicyExists("alicloud_cloud_firewall_control_policy.policy", &policy),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_control_policy.policy", "acl_action", "drop"),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_control_policy.policy", "priority", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudCloudFirewallControlPolicy_import(t *testing.T) {
+	resourceName := "alicloud_cloud_firewall_control_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFirewallControlPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCloudFirewallControlPolicyBasic("accept", 1),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFirewallControlPolicyExists(n string, policy *CloudFirewallControlPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cloud Firewall Control Policy ID is set")
+		}
+
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		client := testAccProvider.Meta().(*AliyunClient)
+		p, err := client.DescribeCloudFirewallControlPolicy(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*policy = p
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFirewallControlPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cloud_firewall_control_policy" {
+			continue
+		}
+
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		if _, err := client.DescribeCloudFirewallControlPolicy(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Cloud Firewall Control Policy %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCloudFirewallControlPolicyBasic(action string, priority int) string {
+	return fmt.Sprintf(`
+resource "alicloud_cloud_firewall_control_policy" "policy" {
+  direction        = "in"
+  acl_action       = "%s"
+  description      = "tf-testacc-policy"
+  proto            = "TCP"
+  source           = "0.0.0.0/0"
+  source_type      = "net"
+  destination      = "10.0.0.0/8"
+  destination_type = "net"
+  dest_port        = "443/443"
+  priority         = %d
+}
+`, action, priority)
+}
@@ -0,0 +1,202 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCloudFirewallInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCloudFirewallInstanceCreate,
+		Read:   resourceAlicloudCloudFirewallInstanceRead,
+		Update: resourceAlicloudCloudFirewallInstanceUpdate,
+		Delete: resourceAlicloudCloudFirewallInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"spec": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					CloudFirewallPremiumVersion, CloudFirewallEnterpriseVersion, CloudFirewallUltimateVersion,
+				}),
+			},
+			"ip_number": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(20, 4000),
+			},
+			"band_width": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(10, 15000),
+			},
+			"cfw_log": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cfw_log_storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return !d.Get("cfw_log").(bool)
+				},
+			},
+			"fw_vpc_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedIntValue([]int{1, 3, 6, 12, 24, 36}),
+			},
+			// The specification is upgraded unless it is explicitly downgraded, which takes effect in the next period.
+			"modify_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Upgrade",
+				ValidateFunc: validateAllowedStringValue([]string{"Upgrade", "Downgrade"}),
+			},
+			"renewal_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{RenewAutoRenewal, RenewManualRenewal, RenewNotRenewal}),
+			},
+			"auto_renew_period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedIntValue([]int{1, 3, 6, 12, 24, 36}),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("renewal_status").(string) != RenewAutoRenewal
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildCloudFirewallInstanceParameters(d *schema.ResourceData) map[string]string {
+	parameters := map[string]string{
+		"Spec":      d.Get("spec").(string),
+		"IpNumber":  strconv.Itoa(d.Get("ip_number").(int)),
+		"BandWidth": strconv.Itoa(d.Get("band_width").(int)),
+		"CfwLog":    strconv.FormatBool(d.Get("cfw_log").(bool)),
+	}
+	if d.Get("cfw_log").(bool) {
+		parameters["CfwLogStorage"] = strconv.Itoa(d.Get("cfw_log_storage").(int))
+	}
+	if v, ok := d.GetOk("fw_vpc_number"); ok {
+		parameters["FwVpcNumber"] = strconv.Itoa(v.(int))
+	}
+	return parameters
+}
+
+func resourceAlicloudCloudFirewallInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.Get("cfw_log").(bool) && d.Get("cfw_log_storage").(int) == 0 {
+		return fmt.Errorf("'cfw_log_storage' is required when 'cfw_log' is true.")
+	}
+
+	id, err := client.CreateBssInstance(BssProductCfw, d.Get("period").(int), buildCloudFirewallInstanceParameters(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	if err := client.WaitForBssInstance(BssProductCfw, id, BssInstanceNormal, 300); err != nil {
+		return WrapErrorf(err, "WaitForBssInstance got an error")
+	}
+
+	if status := d.Get("renewal_status").(string); status != "" {
+		period := d.Get("auto_renew_period").(int)
+		if status == RenewAutoRenewal && period == 0 {
+			return fmt.Errorf("'auto_renew_period' is required when 'renewal_status' is %s.", RenewAutoRenewal)
+		}
+		if err := client.SetRenewal(BssProductCfw, id, status, period); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudCloudFirewallInstanceRead(d, meta)
+}
+
+func resourceAlicloudCloudFirewallInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	instance, err := meta.(*AliyunClient).DescribeBssInstance(BssProductCfw, d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("status", instance.Status)
+	d.Set("end_time", instance.EndTime)
+	d.Set("renewal_status", instance.RenewStatus)
+	if instance.RenewStatus == RenewAutoRenewal {
+		d.Set("auto_renew_period", instance.RenewalDuration)
+	}
+
+	return nil
+}
+
+func resourceAlicloudCloudFirewallInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	if d.HasChange("spec") || d.HasChange("ip_number") || d.HasChange("band_width") || d.HasChange("cfw_log") ||
+		d.HasChange("cfw_log_storage") || d.HasChange("fw_vpc_number") {
+		if err := client.ModifyBssInstance(BssProductCfw, d.Id(), d.Get("modify_type").(string), buildCloudFirewallInstanceParameters(d)); err != nil {
+			return err
+		}
+		if err := client.WaitForBssInstance(BssProductCfw, d.Id(), BssInstanceNormal, 300); err != nil {
+			return WrapErrorf(err, "WaitForBssInstance got an error")
+		}
+		for _, k := range []string{"spec", "ip_number", "band_width", "cfw_log", "cfw_log_storage", "fw_vpc_number"} {
+			d.SetPartial(k)
+		}
+	}
+
+	if d.HasChange("renewal_status") || d.HasChange("auto_renew_period") {
+		status := d.Get("renewal_status").(string)
+		period := d.Get("auto_renew_period").(int)
+		if status == RenewAutoRenewal && period == 0 {
+			return fmt.Errorf("'auto_renew_period' is required when 'renewal_status' is %s.", RenewAutoRenewal)
+		}
+		if err := client.SetRenewal(BssProductCfw, d.Id(), status, period); err != nil {
+			return err
+		}
+		d.SetPartial("renewal_status")
+		d.SetPartial("auto_renew_period")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudCloudFirewallInstanceRead(d, meta)
+}
+
+// The subscription can not be released by the API, so it is only removed from the state and it keeps running until
+// it expires. Set renewal_status to NotRenewal before deleting it to stop the renewal.
+func resourceAlicloudCloudFirewallInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Cloud Firewall instance %s can not be released and it is only removed from the state.", d.Id())
+	return nil
+}
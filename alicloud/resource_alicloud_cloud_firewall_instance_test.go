@@ -0,0 +1,134 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAlicloudCloudFirewallInstance_schema(t *testing.T) {
+	if err := resourceAlicloudCloudFirewallInstance().InternalValidate(nil, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+// The subscription can not be released, so the instance keeps running until it expires after the test.
+func TestAccAlicloudCloudFirewallInstance_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cloud_firewall_instance.instance",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCloudFirewallInstanceBasic(20, RenewManualRenewal),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("alicloud_cloud_firewall_instance.instance", "end_time"),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_instance.instance", "status", BssInstanceNormal),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_instance.instance", "renewal_status", RenewManualRenewal),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCloudFirewallInstanceBasic(50, RenewNotRenewal),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_instance.instance", "ip_number", "50"),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_instance.instance", "status", BssInstanceNormal),
+					resource.TestCheckResourceAttr("alicloud_cloud_firewall_instance.instance", "renewal_status", RenewNotRenewal),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildCloudFirewallInstanceParameters(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAlicloudCloudFirewallInstance().Schema, map[string]interface{}{
+		"spec":            CloudFirewallPremiumVersion,
+		"ip_number":       20,
+		"band_width":      10,
+		"cfw_log_storage": 1000,
+		"period":          1,
+	})
+	expected := map[string]string{
+		"Spec":      CloudFirewallPremiumVersion,
+		"IpNumber":  "20",
+		"BandWidth": "10",
+		"CfwLog":    "false",
+	}
+	if parameters := buildCloudFirewallInstanceParameters(d); !reflect.DeepEqual(parameters, expected) {
+		t.Fatalf("Expected the log storage is not ordered without the log, got %#v", parameters)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceAlicloudCloudFirewallInstance().Schema, map[string]interface{}{
+		"spec":            CloudFirewallPremiumVersion,
+		"ip_number":       20,
+		"band_width":      10,
+		"cfw_log":         true,
+		"cfw_log_storage": 1000,
+		"fw_vpc_number":   2,
+		"period":          1,
+	})
+	expected["CfwLog"] = "true"
+	expected["CfwLogStorage"] = "1000"
+	expected["FwVpcNumber"] = "2"
+	if parameters := buildCloudFirewallInstanceParameters(d); !reflect.DeepEqual(parameters, expected) {
+		t.Fatalf("Expected the parameters %#v, got %#v", expected, parameters)
+	}
+}
+
+func TestCloudFirewallInstanceRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, BssCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("Action") != "QueryAvailableInstances" || r.FormValue("ProductCode") != BssProductCfw.Code {
+			t.Errorf("Unexpected request %s", r.Form.Encode())
+		}
+		switch r.FormValue("InstanceIDs") {
+		case "vipcloudfw-cn-abc":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Success":true,"Code":"Success","Data":{"InstanceList":[` +
+				`{"InstanceID":"vipcloudfw-cn-abc","Status":"Normal","RenewStatus":"AutoRenewal","RenewalDuration":1,` +
+				`"RenewalDurationUnit":"Y","EndTime":"2027-01-01T00:00:00Z"}]}}`))
+		default:
+			w.Write([]byte(`{"RequestId":"E5F6G7H8","Success":true,"Code":"Success","Data":{"InstanceList":[]}}`))
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAlicloudCloudFirewallInstance().Schema, map[string]interface{}{})
+	d.SetId("vipcloudfw-cn-abc")
+	if err := resourceAlicloudCloudFirewallInstanceRead(d, client); err != nil {
+		t.Fatalf("Reading the instance got an error: %#v", err)
+	}
+	if d.Get("status").(string) != BssInstanceNormal || d.Get("end_time").(string) != "2027-01-01T00:00:00Z" {
+		t.Fatalf("Expected the status and the end time are read from the subscription, got %q and %q", d.Get("status"), d.Get("end_time"))
+	}
+	if d.Get("renewal_status").(string) != RenewAutoRenewal || d.Get("auto_renew_period").(int) != 12 {
+		t.Fatalf("Expected the yearly renewal is read in months, got %q and %d", d.Get("renewal_status"), d.Get("auto_renew_period"))
+	}
+
+	d.SetId("vipcloudfw-cn-unknown")
+	if err := resourceAlicloudCloudFirewallInstanceRead(d, client); err != nil {
+		t.Fatalf("Reading the released instance got an error: %#v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Expected the released instance is removed from the state, got %s", d.Id())
+	}
+}
+
+func testAccCloudFirewallInstanceBasic(ipNumber int, renewalStatus string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cloud_firewall_instance" "instance" {
+  spec = "premium_version"
+  ip_number = %d
+  band_width = 10
+  cfw_log = false
+  period = 1
+  renewal_status = "%s"
+}
+`, ipNumber, renewalStatus)
+}
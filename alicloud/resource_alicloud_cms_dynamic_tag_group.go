@@ -0,0 +1,154 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCmsDynamicTagGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCmsDynamicTagGroupCreate,
+		Read:   resourceAlicloudCmsDynamicTagGroupRead,
+		Delete: resourceAlicloudCmsDynamicTagGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tag_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"contact_group_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"match_express_filter_relation": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{CmsFilterRelationAnd, CmsFilterRelationOr}),
+			},
+			"match_express": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag_value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"tag_value_match_function": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validateAllowedStringValue([]string{
+								"all", "equals", "startWith", "endWith", "contains", "notContains",
+							}),
+						},
+					},
+				},
+			},
+			"template_id_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCmsDynamicTagGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"TagKey": d.Get("tag_key").(string),
+	}
+	for i, name := range expandStringList(d.Get("contact_group_list").(*schema.Set).List()) {
+		params[fmt.Sprintf("ContactGroupList.%d", i+1)] = name
+	}
+	if v, ok := d.GetOk("match_express_filter_relation"); ok {
+		params["MatchExpressFilterRelation"] = v.(string)
+	}
+	for i, e := range d.Get("match_express").([]interface{}) {
+		express := e.(map[string]interface{})
+		params[fmt.Sprintf("MatchExpress.%d.TagValue", i+1)] = express["tag_value"].(string)
+		params[fmt.Sprintf("MatchExpress.%d.TagValueMatchFunction", i+1)] = express["tag_value_match_function"].(string)
+	}
+	for i, id := range expandStringList(d.Get("template_id_list").(*schema.Set).List()) {
+		params[fmt.Sprintf("TemplateIdList.%d", i+1)] = id
+	}
+
+	var resp struct {
+		Id string `json:"Id"`
+	}
+	if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+		return client.ProcessCmsRequest("CreateDynamicTagGroup", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateDynamicTagGroup got an error")
+	}
+	d.SetId(resp.Id)
+
+	return resourceAlicloudCmsDynamicTagGroupRead(d, meta)
+}
+
+func resourceAlicloudCmsDynamicTagGroupRead(d *schema.ResourceData, meta interface{}) error {
+	group, err := meta.(*AliyunClient).DescribeCmsDynamicTagGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var express []map[string]interface{}
+	for _, e := range group.MatchExpress.MatchExpress {
+		express = append(express, map[string]interface{}{
+			"tag_value":                e.TagValue,
+			"tag_value_match_function": e.TagValueMatchFunction,
+		})
+	}
+	d.Set("tag_key", group.TagKey)
+	d.Set("contact_group_list", group.ContactGroupList.ContactGroupList)
+	d.Set("match_express_filter_relation", group.MatchExpressFilterRelation)
+	if err := d.Set("match_express", express); err != nil {
+		return WrapError(err)
+	}
+	d.Set("template_id_list", group.TemplateIdList.TemplateIdList)
+	d.Set("status", group.Status)
+
+	return nil
+}
+
+func resourceAlicloudCmsDynamicTagGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+		return client.ProcessCmsRequest("DeleteDynamicTagGroup", map[string]string{"DynamicTagRuleId": d.Id()}, nil)
+	}); err != nil {
+		if IsExceptedError(err, CmsResourceNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteDynamicTagGroup got an error")
+	}
+
+	return nil
+}
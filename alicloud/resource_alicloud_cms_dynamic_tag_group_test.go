@@ -0,0 +1,90 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCmsDynamicTagGroup_basic(t *testing.T) {
+	var group CmsDynamicTagGroup
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cms_dynamic_tag_group.group",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckCmsDynamicTagGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCmsDynamicTagGroupBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsDynamicTagGroupExists("alicloud_cms_dynamic_tag_group.group", &group),
+					resource.TestCheckResourceAttr("alicloud_cms_dynamic_tag_group.group", "tag_key", "tf-testacc-service"),
+					resource.TestCheckResourceAttr("alicloud_cms_dynamic_tag_group.group", "match_express_filter_relation", "and"),
+					resource.TestCheckResourceAttr("alicloud_cms_dynamic_tag_group.group", "match_express.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cms_dynamic_tag_group.group", "match_express.0.tag_value_match_function", "startWith"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCmsDynamicTagGroupExists(n string, group *CmsDynamicTagGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CMS Dynamic Tag Group ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		g, err := client.DescribeCmsDynamicTagGroup(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*group = g
+
+		return nil
+	}
+}
+
+func testAccCheckCmsDynamicTagGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cms_dynamic_tag_group" {
+			continue
+		}
+
+		if _, err := client.DescribeCmsDynamicTagGroup(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("CMS Dynamic Tag Group %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccCmsDynamicTagGroupBasic = `
+resource "alicloud_cms_dynamic_tag_group" "group" {
+  tag_key = "tf-testacc-service"
+  contact_group_list = ["云账号报警联系人"]
+  match_express_filter_relation = "and"
+  match_express {
+    tag_value = "tf-testacc"
+    tag_value_match_function = "startWith"
+  }
+}
+`
@@ -0,0 +1,271 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCmsMetricRuleTemplate() *schema.Resource {
+	escalations := make(map[string]*schema.Schema)
+	for _, level := range CmsAlarmLevels {
+		escalations[level] = cmsEscalationSchema()
+	}
+
+	return &schema.Resource{
+		Create: resourceAlicloudCmsMetricRuleTemplateCreate,
+		Read:   resourceAlicloudCmsMetricRuleTemplateRead,
+		Update: resourceAlicloudCmsMetricRuleTemplateUpdate,
+		Delete: resourceAlicloudCmsMetricRuleTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"alert_templates": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"category": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"namespace": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"period": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  60,
+						},
+						"selector": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"webhook": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"escalations": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: escalations,
+							},
+						},
+					},
+				},
+			},
+			"rest_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func cmsEscalationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison_operator": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validateAllowedStringValue([]string{
+						"GreaterThanOrEqualToThreshold", "GreaterThanThreshold", "LessThanOrEqualToThreshold", "LessThanThreshold",
+						"NotEqualToThreshold", "GreaterThanYesterday", "LessThanYesterday", "GreaterThanLastWeek", "LessThanLastWeek",
+						"GreaterThanLastPeriod", "LessThanLastPeriod",
+					}),
+				},
+				"statistics": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"threshold": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"times": &schema.Schema{
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  3,
+				},
+			},
+		},
+	}
+}
+
+// buildCmsAlertTemplateParams converts the alert_templates into the AlertTemplates.N parameters of the template APIs.
+func buildCmsAlertTemplateParams(params map[string]string, alertTemplates []interface{}) {
+	for i, t := range alertTemplates {
+		template := t.(map[string]interface{})
+		prefix := fmt.Sprintf("AlertTemplates.%d.", i+1)
+		params[prefix+"RuleName"] = template["rule_name"].(string)
+		params[prefix+"Category"] = template["category"].(string)
+		params[prefix+"Namespace"] = template["namespace"].(string)
+		params[prefix+"MetricName"] = template["metric_name"].(string)
+		params[prefix+"Period"] = strconv.Itoa(template["period"].(int))
+		if v := template["selector"].(string); v != "" {
+			params[prefix+"Selector"] = v
+		}
+		if v := template["webhook"].(string); v != "" {
+			params[prefix+"Webhook"] = v
+		}
+		escalations := template["escalations"].([]interface{})
+		if len(escalations) < 1 || escalations[0] == nil {
+			continue
+		}
+		for _, level := range CmsAlarmLevels {
+			list := escalations[0].(map[string]interface{})[level].([]interface{})
+			if len(list) < 1 || list[0] == nil {
+				continue
+			}
+			escalation := list[0].(map[string]interface{})
+			key := prefix + "Escalations." + strings.Title(level) + "."
+			params[key+"Comparison"] = escalation["comparison_operator"].(string)
+			params[key+"Statistics"] = escalation["statistics"].(string)
+			params[key+"Threshold"] = escalation["threshold"].(string)
+			params[key+"Times"] = strconv.Itoa(escalation["times"].(int))
+		}
+	}
+}
+
+func flattenCmsAlertTemplates(list []CmsAlertTemplate) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, t := range list {
+		escalations := make(map[string]interface{})
+		for level, e := range map[string]CmsEscalation{
+			"critical": t.Escalations.Critical,
+			"warn":     t.Escalations.Warn,
+			"info":     t.Escalations.Info,
+		} {
+			if e.Comparison == "" {
+				continue
+			}
+			escalations[level] = []map[string]interface{}{{
+				"comparison_operator": e.Comparison,
+				"statistics":          e.Statistics,
+				"threshold":           e.Threshold,
+				"times":               e.Times,
+			}}
+		}
+		result = append(result, map[string]interface{}{
+			"rule_name":   t.RuleName,
+			"category":    t.Category,
+			"namespace":   t.Namespace,
+			"metric_name": t.MetricName,
+			"period":      t.Period,
+			"selector":    t.Selector,
+			"webhook":     t.Webhook,
+			"escalations": []map[string]interface{}{escalations},
+		})
+	}
+	return result
+}
+
+func resourceAlicloudCmsMetricRuleTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"Name": d.Get("name").(string),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params["Description"] = v.(string)
+	}
+	buildCmsAlertTemplateParams(params, d.Get("alert_templates").([]interface{}))
+
+	var resp struct {
+		Id int64 `json:"Id"`
+	}
+	if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+		return client.ProcessCmsRequest("CreateMetricRuleTemplate", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateMetricRuleTemplate got an error")
+	}
+	d.SetId(strconv.FormatInt(resp.Id, 10))
+
+	return resourceAlicloudCmsMetricRuleTemplateRead(d, meta)
+}
+
+func resourceAlicloudCmsMetricRuleTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	template, err := meta.(*AliyunClient).DescribeCmsMetricRuleTemplate(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", template.Name)
+	d.Set("description", template.Description)
+	if err := d.Set("alert_templates", flattenCmsAlertTemplates(template.AlertTemplates.AlertTemplate)); err != nil {
+		return WrapError(err)
+	}
+	d.Set("rest_version", strconv.FormatInt(template.RestVersion, 10))
+
+	return nil
+}
+
+func resourceAlicloudCmsMetricRuleTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("alert_templates") {
+		// The template is modified optimistically, and the modification is rejected if the version is outdated.
+		params := map[string]string{
+			"TemplateId":  d.Id(),
+			"RestVersion": d.Get("rest_version").(string),
+			"Name":        d.Get("name").(string),
+			"Description": d.Get("description").(string),
+		}
+		buildCmsAlertTemplateParams(params, d.Get("alert_templates").([]interface{}))
+		if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+			return client.ProcessCmsRequest("ModifyMetricRuleTemplate", params, nil)
+		}); err != nil {
+			return WrapErrorf(err, "ModifyMetricRuleTemplate got an error")
+		}
+	}
+
+	return resourceAlicloudCmsMetricRuleTemplateRead(d, meta)
+}
+
+func resourceAlicloudCmsMetricRuleTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+		return client.ProcessCmsRequest("DeleteMetricRuleTemplate", map[string]string{"TemplateId": d.Id()}, nil)
+	}); err != nil {
+		if IsExceptedError(err, CmsResourceNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteMetricRuleTemplate got an error")
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCmsMetricRuleTemplateApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCmsMetricRuleTemplateApplicationCreate,
+		Read:   resourceAlicloudCmsMetricRuleTemplateApplicationRead,
+		Delete: resourceAlicloudCmsMetricRuleTemplateApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"template_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"apply_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      CmsApplyModeGroupInstanceFirst,
+				ValidateFunc: validateAllowedStringValue([]string{CmsApplyModeGroupInstanceFirst, CmsApplyModeAlarmTemplateFirst}),
+			},
+			"silence_time": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      86400,
+				ValidateFunc: validateIntegerInRange(3600, 86400),
+			},
+			"notify_level": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      4,
+				ValidateFunc: validateAllowedIntValue([]int{2, 3, 4}),
+			},
+			"enable_start_time": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validateIntegerInRange(0, 23),
+			},
+			"enable_end_time": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      23,
+				ValidateFunc: validateIntegerInRange(0, 23),
+			},
+		},
+	}
+}
+
+func resourceAlicloudCmsMetricRuleTemplateApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	groupId := d.Get("group_id").(string)
+	templateId := d.Get("template_id").(string)
+	params := map[string]string{
+		"GroupId":         groupId,
+		"TemplateIds":     templateId,
+		"ApplyMode":       d.Get("apply_mode").(string),
+		"SilenceTime":     strconv.Itoa(d.Get("silence_time").(int)),
+		"NotifyLevel":     strconv.Itoa(d.Get("notify_level").(int)),
+		"EnableStartTime": strconv.Itoa(d.Get("enable_start_time").(int)),
+		"EnableEndTime":   strconv.Itoa(d.Get("enable_end_time").(int)),
+	}
+	if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+		return client.ProcessCmsRequest("ApplyMetricRuleTemplate", params, nil)
+	}); err != nil {
+		return WrapErrorf(err, "ApplyMetricRuleTemplate got an error")
+	}
+	d.SetId(groupId + COLON_SEPARATED + templateId)
+
+	return resourceAlicloudCmsMetricRuleTemplateApplicationRead(d, meta)
+}
+
+func resourceAlicloudCmsMetricRuleTemplateApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseCmsMetricRuleTemplateApplicationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	group, err := meta.(*AliyunClient).DescribeCmsMonitorGroup(parts[0])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	applied := false
+	for _, id := range group.TemplateIds.TemplateId {
+		if id == parts[1] {
+			applied = true
+			break
+		}
+	}
+	if !applied {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_id", parts[0])
+	d.Set("template_id", parts[1])
+
+	return nil
+}
+
+// The application of a template is removed by deleting the metric rules of the group which are named after the alert
+// templates, as CloudMonitor has no API to withdraw a template.
+func resourceAlicloudCmsMetricRuleTemplateApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseCmsMetricRuleTemplateApplicationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	template, err := client.DescribeCmsMetricRuleTemplate(parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	ruleIds, err := client.DescribeCmsMetricRuleIds(parts[0])
+	if err != nil {
+		return err
+	}
+
+	params := make(map[string]string)
+	for _, t := range template.AlertTemplates.AlertTemplate {
+		if id, ok := ruleIds[t.RuleName]; ok {
+			params[fmt.Sprintf("Id.%d", len(params)+1)] = id
+		}
+	}
+	if len(params) < 1 {
+		return nil
+	}
+	if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+		return client.ProcessCmsRequest("DeleteMetricRules", params, nil)
+	}); err != nil {
+		if IsExceptedError(err, CmsResourceNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteMetricRules got an error")
+	}
+
+	return nil
+}
+
+func parseCmsMetricRuleTemplateApplicationId(id string) ([]string, error) {
+	return ParseResourceId(id, "CMS metric rule template application", "group_id", "template_id")
+}
@@ -0,0 +1,122 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAlicloudCmsMetricRuleTemplateApplication_schema(t *testing.T) {
+	if err := resourceAlicloudCmsMetricRuleTemplateApplication().InternalValidate(nil, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudCmsMetricRuleTemplateApplication_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cms_metric_rule_template_application.application",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckCmsMetricRuleTemplateApplicationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCmsMetricRuleTemplateBasic("90") + testAccCmsMetricRuleTemplateApplication,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("alicloud_cms_metric_rule_template_application.application", "group_id",
+						"alicloud_cms_monitor_group.group", "id"),
+					resource.TestCheckResourceAttrPair("alicloud_cms_metric_rule_template_application.application", "template_id",
+						"alicloud_cms_metric_rule_template.template", "id"),
+					resource.TestCheckResourceAttr("alicloud_cms_metric_rule_template_application.application", "silence_time", "3600"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "alicloud_cms_metric_rule_template_application.application",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_mode", "silence_time", "notify_level", "enable_start_time", "enable_end_time"},
+			},
+		},
+	})
+}
+
+func TestCmsMetricRuleTemplateApplicationDelete(t *testing.T) {
+	var deleted map[string]string
+	client, server := newTestAliyunClient(t, CmsCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("Action") {
+		case "DescribeMetricRuleTemplateAttribute":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Success":true,"Code":200,"Resource":{"TemplateId":789,"Name":"web",` +
+				`"AlertTemplates":{"AlertTemplate":[{"RuleName":"cpu"},{"RuleName":"memory"}]}}}`))
+		case "DescribeMetricRuleList":
+			// The memory rule of the template has been removed, and the disk rule is not created by the template.
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Success":true,"Code":"200","Alarms":{"Alarm":[` +
+				`{"RuleId":"rule-cpu","RuleName":"cpu"},{"RuleId":"rule-disk","RuleName":"disk"}]}}`))
+		case "DeleteMetricRules":
+			deleted = map[string]string{}
+			for key := range r.Form {
+				if strings.HasPrefix(key, "Id.") {
+					deleted[key] = r.FormValue(key)
+				}
+			}
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Success":true,"Code":"200"}`))
+		default:
+			t.Errorf("Unexpected action %s", r.FormValue("Action"))
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAlicloudCmsMetricRuleTemplateApplication().Schema, map[string]interface{}{})
+	d.SetId("123:789")
+	if err := resourceAlicloudCmsMetricRuleTemplateApplicationDelete(d, client); err != nil {
+		t.Fatalf("Deleting the application got an error: %#v", err)
+	}
+	if expected := map[string]string{"Id.1": "rule-cpu"}; !reflect.DeepEqual(deleted, expected) {
+		t.Fatalf("Expected the metric rules %#v of the template are deleted, got %#v", expected, deleted)
+	}
+}
+
+func testAccCheckCmsMetricRuleTemplateApplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cms_metric_rule_template_application" {
+			continue
+		}
+
+		ruleIds, err := client.DescribeCmsMetricRuleIds(rs.Primary.Attributes["group_id"])
+		if err != nil {
+			if NotFoundError(err) || IsExceptedError(err, CmsResourceNotFound) {
+				continue
+			}
+			return err
+		}
+		if _, ok := ruleIds["tf-testacc-cpu"]; ok {
+			return fmt.Errorf("The metric rules of the CMS Metric Rule Template Application %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return testAccCheckCmsMetricRuleTemplateDestroy(s)
+}
+
+const testAccCmsMetricRuleTemplateApplication = `
+resource "alicloud_cms_monitor_group" "group" {
+  monitor_group_name = "tf-testacc-template-group"
+  contact_groups = ["云账号报警联系人"]
+}
+
+resource "alicloud_cms_metric_rule_template_application" "application" {
+  group_id = "${alicloud_cms_monitor_group.group.id}"
+  template_id = "${alicloud_cms_metric_rule_template.template.id}"
+  silence_time = 3600
+}
+`
@@ -0,0 +1,153 @@
+package alicloud
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCmsMetricRuleTemplate_basic(t *testing.T) {
+	var template CmsMetricRuleTemplate
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cms_metric_rule_template.template",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckCmsMetricRuleTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCmsMetricRuleTemplateBasic("90"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsMetricRuleTemplateExists("alicloud_cms_metric_rule_template.template", &template),
+					resource.TestCheckResourceAttr("alicloud_cms_metric_rule_template.template", "name", "tf-testacc-template"),
+					resource.TestCheckResourceAttr("alicloud_cms_metric_rule_template.template", "alert_templates.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cms_metric_rule_template.template", "alert_templates.0.escalations.0.critical.0.threshold", "90"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCmsMetricRuleTemplateBasic("80"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsMetricRuleTemplateExists("alicloud_cms_metric_rule_template.template", &template),
+					resource.TestCheckResourceAttr("alicloud_cms_metric_rule_template.template", "alert_templates.0.escalations.0.critical.0.threshold", "80"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildCmsAlertTemplateParams(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAlicloudCmsMetricRuleTemplate().Schema, map[string]interface{}{
+		"name": "web",
+		"alert_templates": []interface{}{
+			map[string]interface{}{
+				"rule_name":   "cpu",
+				"category":    "ecs",
+				"namespace":   "acs_ecs_dashboard",
+				"metric_name": "CPUUtilization",
+				"escalations": []interface{}{
+					map[string]interface{}{
+						"warn": []interface{}{
+							map[string]interface{}{
+								"comparison_operator": "GreaterThanThreshold",
+								"statistics":          "Average",
+								"threshold":           "80",
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	params := make(map[string]string)
+	buildCmsAlertTemplateParams(params, d.Get("alert_templates").([]interface{}))
+
+	expected := map[string]string{
+		"AlertTemplates.1.RuleName":                    "cpu",
+		"AlertTemplates.1.Category":                    "ecs",
+		"AlertTemplates.1.Namespace":                   "acs_ecs_dashboard",
+		"AlertTemplates.1.MetricName":                  "CPUUtilization",
+		"AlertTemplates.1.Period":                      "60",
+		"AlertTemplates.1.Escalations.Warn.Comparison": "GreaterThanThreshold",
+		"AlertTemplates.1.Escalations.Warn.Statistics": "Average",
+		"AlertTemplates.1.Escalations.Warn.Threshold":  "80",
+		"AlertTemplates.1.Escalations.Warn.Times":      "3",
+	}
+	if !reflect.DeepEqual(params, expected) {
+		t.Fatalf("Expected the parameters %#v, got %#v.", expected, params)
+	}
+}
+
+func testAccCheckCmsMetricRuleTemplateExists(n string, template *CmsMetricRuleTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CMS Metric Rule Template ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		tpl, err := client.DescribeCmsMetricRuleTemplate(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*template = tpl
+
+		return nil
+	}
+}
+
+func testAccCheckCmsMetricRuleTemplateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cms_metric_rule_template" {
+			continue
+		}
+
+		if _, err := client.DescribeCmsMetricRuleTemplate(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("CMS Metric Rule Template %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCmsMetricRuleTemplateBasic(threshold string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cms_metric_rule_template" "template" {
+  name = "tf-testacc-template"
+  description = "Terraform acc test"
+
+  alert_templates {
+    rule_name = "tf-testacc-cpu"
+    category = "ecs"
+    namespace = "acs_ecs_dashboard"
+    metric_name = "cpu_total"
+
+    escalations {
+      critical {
+        comparison_operator = "GreaterThanThreshold"
+        statistics = "Average"
+        threshold = "%s"
+        times = 3
+      }
+    }
+  }
+}
+`, threshold)
+}
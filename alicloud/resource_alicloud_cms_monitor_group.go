@@ -0,0 +1,116 @@
+package alicloud
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCmsMonitorGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCmsMonitorGroupCreate,
+		Read:   resourceAlicloudCmsMonitorGroupRead,
+		Update: resourceAlicloudCmsMonitorGroupUpdate,
+		Delete: resourceAlicloudCmsMonitorGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"monitor_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"contact_groups": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCmsMonitorGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"GroupName": d.Get("monitor_group_name").(string),
+	}
+	if v, ok := d.GetOk("contact_groups"); ok {
+		params["ContactGroups"] = strings.Join(expandStringList(v.(*schema.Set).List()), ",")
+	}
+
+	var resp struct {
+		GroupId int64 `json:"GroupId"`
+	}
+	if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+		return client.ProcessCmsRequest("CreateMonitorGroup", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateMonitorGroup got an error")
+	}
+	d.SetId(strconv.FormatInt(resp.GroupId, 10))
+
+	return resourceAlicloudCmsMonitorGroupRead(d, meta)
+}
+
+func resourceAlicloudCmsMonitorGroupRead(d *schema.ResourceData, meta interface{}) error {
+	group, err := meta.(*AliyunClient).DescribeCmsMonitorGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var contacts []string
+	for _, c := range group.ContactGroups.ContactGroup {
+		contacts = append(contacts, c.Name)
+	}
+	d.Set("monitor_group_name", group.GroupName)
+	d.Set("contact_groups", contacts)
+	d.Set("type", group.Type)
+
+	return nil
+}
+
+func resourceAlicloudCmsMonitorGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("monitor_group_name") || d.HasChange("contact_groups") {
+		params := map[string]string{
+			"GroupId":       d.Id(),
+			"GroupName":     d.Get("monitor_group_name").(string),
+			"ContactGroups": strings.Join(expandStringList(d.Get("contact_groups").(*schema.Set).List()), ","),
+		}
+		if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+			return client.ProcessCmsRequest("ModifyMonitorGroup", params, nil)
+		}); err != nil {
+			return WrapErrorf(err, "ModifyMonitorGroup got an error")
+		}
+	}
+
+	return resourceAlicloudCmsMonitorGroupRead(d, meta)
+}
+
+func resourceAlicloudCmsMonitorGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(CmsCode, 3*time.Minute, func() error {
+		return client.ProcessCmsRequest("DeleteMonitorGroup", map[string]string{"GroupId": d.Id()}, nil)
+	}); err != nil {
+		if IsExceptedError(err, CmsResourceNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteMonitorGroup got an error")
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCmsMonitorGroup_basic(t *testing.T) {
+	var group CmsMonitorGroup
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_cms_monitor_group.group",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckCmsMonitorGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCmsMonitorGroupBasic("tf-testacc-monitor-group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsMonitorGroupExists("alicloud_cms_monitor_group.group", &group),
+					resource.TestCheckResourceAttr("alicloud_cms_monitor_group.group", "monitor_group_name", "tf-testacc-monitor-group"),
+					resource.TestCheckResourceAttr("alicloud_cms_monitor_group.group", "contact_groups.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCmsMonitorGroupBasic("tf-testacc-monitor-group-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCmsMonitorGroupExists("alicloud_cms_monitor_group.group", &group),
+					resource.TestCheckResourceAttr("alicloud_cms_monitor_group.group", "monitor_group_name", "tf-testacc-monitor-group-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudCmsMonitorGroup_import(t *testing.T) {
+	resourceName := "alicloud_cms_monitor_group.group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCmsMonitorGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCmsMonitorGroupBasic("tf-testacc-monitor-group"),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDescribeCmsMonitorGroup(t *testing.T) {
+	client, server := newTestAliyunClient(t, CmsCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("Action") != "DescribeMonitorGroups" || r.FormValue("IncludeTemplateHistory") != "true" {
+			t.Errorf("Unexpected request %s", r.Form.Encode())
+		}
+		switch r.FormValue("GroupId") {
+		case "123":
+			// CloudMonitor responds the code of a successful call as a number.
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Success":true,"Code":200,"Resources":{"Resource":[` +
+				`{"GroupId":123,"GroupName":"tf-testAcc","Type":"custom","ContactGroups":{"ContactGroup":[{"Name":"ops"}]}}]}}`))
+		case "456":
+			w.Write([]byte(`{"RequestId":"E5F6G7H8","Success":false,"Code":"ResourceNotFound","Message":"The group does not exist."}`))
+		default:
+			w.Write([]byte(`{"RequestId":"I9J0K1L2","Success":false,"Code":500,"Message":"Internal error."}`))
+		}
+	})
+	defer server.Close()
+
+	group, err := client.DescribeCmsMonitorGroup("123")
+	if err != nil {
+		t.Fatalf("Describing the monitor group got an error: %#v", err)
+	}
+	if group.GroupId != 123 || group.GroupName != "tf-testAcc" || len(group.ContactGroups.ContactGroup) != 1 {
+		t.Fatalf("Expected the monitor group is decoded from the response, got %#v", group)
+	}
+
+	if _, err := client.DescribeCmsMonitorGroup("456"); !NotFoundError(err) {
+		t.Fatalf("Expected ResourceNotFound is a not found error, got %#v", err)
+	}
+	if _, err := client.DescribeCmsMonitorGroup("789"); err == nil || NotFoundError(err) || GetRequestId(err) != "I9J0K1L2" {
+		t.Fatalf("Expected the numeric code 500 is an error with its request id, got %#v", err)
+	}
+}
+
+func testAccCheckCmsMonitorGroupExists(n string, group *CmsMonitorGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CMS Monitor Group ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		g, err := client.DescribeCmsMonitorGroup(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*group = g
+
+		return nil
+	}
+}
+
+func testAccCheckCmsMonitorGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cms_monitor_group" {
+			continue
+		}
+
+		if _, err := client.DescribeCmsMonitorGroup(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("CMS Monitor Group %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCmsMonitorGroupBasic(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_cms_monitor_group" "group" {
+  monitor_group_name = "%s"
+  contact_groups = ["云账号报警联系人"]
+}
+`, name)
+}
@@ -20,6 +20,12 @@ func resourceAlicloudCSApplication() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cluster_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -126,13 +132,13 @@ func resourceAlicloudCSApplicationCreate(d *schema.ResourceData, meta interface{
 	}
 
 	if err := conn.CreateProject(args); err != nil {
-		return fmt.Errorf("Creating container application got an error: %#v", err)
+		return WrapErrorf(err, "Creating container application got an error")
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", clusterName, COLON_SEPARATED, args.Name))
 
-	if err = client.WaitForContainerApplication(clusterName, args.Name, Running, DefaultTimeoutMedium); err != nil {
-		return fmt.Errorf("Waitting for container application %#v got an error: %#v", cs.Running, err)
+	if err = client.WaitForContainerApplication(clusterName, args.Name, Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "Waitting for container application %#v got an error", cs.Running)
 	}
 
 	return resourceAlicloudCSApplicationRead(d, meta)
@@ -239,21 +245,21 @@ func resourceAlicloudCSApplicationUpdate(d *schema.ResourceData, meta interface{
 
 	if !d.HasChange("version") && !blue_green {
 		if err := conn.RollBackBlueGreenProject(parts[1], true); err != nil {
-			return fmt.Errorf("Rollbacking container application blue-green got an error: %#v", err)
+			return WrapErrorf(err, "Rollbacking container application blue-green got an error")
 		}
 	} else if update {
 		for {
 			if err := conn.UpdateProject(args); err != nil {
 				if IsExceptedError(err, ApplicationConfirmConflict) {
 					if err := conn.RollBackBlueGreenProject(parts[1], true); err != nil {
-						return fmt.Errorf("Rollbacking container application blue-green got an error: %#v", err)
+						return WrapErrorf(err, "Rollbacking container application blue-green got an error")
 					}
-					if err := client.WaitForContainerApplication(parts[0], parts[1], Running, DefaultTimeoutMedium); err != nil {
-						return fmt.Errorf("Waitting for container application %#v got an error: %#v", Running, err)
+					if err := client.WaitForContainerApplication(parts[0], parts[1], Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+						return WrapErrorf(err, "Waitting for container application %#v got an error", Running)
 					}
 					continue
 				}
-				return fmt.Errorf("Updating container application got an error: %#v", err)
+				return WrapErrorf(err, "Updating container application got an error")
 			} else {
 				break
 			}
@@ -262,12 +268,12 @@ func resourceAlicloudCSApplicationUpdate(d *schema.ResourceData, meta interface{
 
 	if d.Get("blue_green_confirm").(bool) {
 		if err := conn.ConfirmBlueGreenProject(parts[1], true); err != nil {
-			return fmt.Errorf("Confirmming container application blue-green got an error: %#v", err)
+			return WrapErrorf(err, "Confirmming container application blue-green got an error")
 		}
 	}
 
-	if err := client.WaitForContainerApplication(parts[0], parts[1], Running, DefaultTimeoutMedium); err != nil {
-		return fmt.Errorf("Waitting for container application %#v got an error: %#v", Running, err)
+	if err := client.WaitForContainerApplication(parts[0], parts[1], Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+		return WrapErrorf(err, "Waitting for container application %#v got an error", Running)
 	}
 
 	d.Partial(false)
@@ -288,14 +294,14 @@ func resourceAlicloudCSApplicationDelete(d *schema.ResourceData, meta interface{
 
 	appName := parts[1]
 
-	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := conn.DeleteProject(appName, true, false)
 		if err != nil {
 			if IsExceptedError(err, ApplicationNotFound) {
 				return nil
 			}
 			if !IsExceptedError(err, ApplicationErrorIgnore) && !IsExceptedError(err, AliyunGoClientFailure) {
-				return resource.NonRetryableError(fmt.Errorf("Deleting container application %s got an error: %#v.", appName, err))
+				return resource.NonRetryableError(WrapErrorf(err, "Deleting container application %s got an error", appName))
 			}
 		}
 
@@ -304,12 +310,12 @@ func resourceAlicloudCSApplicationDelete(d *schema.ResourceData, meta interface{
 			if IsExceptedError(deserr, ApplicationNotFound) || IsExceptedError(err, ApplicationErrorIgnore) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Getting container application %s got an error: %#v.", appName, deserr))
+			return resource.NonRetryableError(WrapErrorf(deserr, "Getting container application %s got an error", appName))
 		}
 		if resp.Name == "" {
 			return nil
 		}
 
-		return resource.RetryableError(fmt.Errorf("Deleting container application %s timeout: %#v.", appName, err))
+		return resource.RetryableError(fmt.Errorf("Deleting container application %s timeout.", appName))
 	})
 }
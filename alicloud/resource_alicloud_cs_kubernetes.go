@@ -4,11 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/cs"
 	"github.com/denverdino/aliyungo/ecs"
-	"github.com/denverdino/aliyungo/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -23,6 +23,12 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:          schema.TypeString,
@@ -174,7 +180,7 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 
 func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.csconn
+	conn := client.csconn()
 
 	args, err := buildKunernetesArgs(d, meta)
 	if err != nil {
@@ -184,20 +190,20 @@ func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}
 	cluster, err := conn.CreateKubernetesCluster(getRegion(d, meta), args)
 
 	if err != nil {
-		return fmt.Errorf("Creating Kubernetes Cluster got an error: %#v", err)
+		return WrapErrorf(err, "Creating Kubernetes Cluster got an error")
 	}
 
 	d.SetId(cluster.ClusterID)
 
-	if err := conn.WaitForClusterAsyn(cluster.ClusterID, cs.Running, 1800); err != nil {
-		return fmt.Errorf("Waitting for kubernetes cluster %#v got an error: %#v", cs.Running, err)
+	if err := conn.WaitForClusterAsyn(cluster.ClusterID, cs.Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "Waitting for kubernetes cluster %#v got an error", cs.Running)
 	}
 
 	return resourceAlicloudCSKubernetesUpdate(d, meta)
 }
 
 func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).csconn
+	conn := meta.(*AliyunClient).csconn()
 	d.Partial(true)
 	if d.HasChange("worker_number") && !d.IsNewResource() {
 		// Ensure instance_type is generation three
@@ -206,13 +212,13 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 			return err
 		}
 		if err := conn.ResizeKubernetes(d.Id(), args); err != nil {
-			return fmt.Errorf("Resize Cluster got an error: %#v", err)
+			return WrapErrorf(err, "Resize Cluster got an error")
 		}
 
-		err = conn.WaitForClusterAsyn(d.Id(), cs.Running, 500)
+		err = conn.WaitForClusterAsyn(d.Id(), cs.Running, timeoutSeconds(d, schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("Waitting for container Cluster %#v got an error: %#v", cs.Running, err)
+			return WrapErrorf(err, "Waitting for container Cluster %#v got an error", cs.Running)
 		}
 		d.SetPartial("worker_number")
 	}
@@ -225,7 +231,7 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 			clusterName = resource.PrefixedUniqueId(d.Get("name_prefix").(string))
 		}
 		if err := conn.ModifyClusterName(d.Id(), clusterName); err != nil && !IsExceptedError(err, ErrorClusterNameAlreadyExist) {
-			return fmt.Errorf("Modify Cluster Name got an error: %#v", err)
+			return WrapErrorf(err, "Modify Cluster Name got an error")
 		}
 		d.SetPartial("name")
 		d.SetPartial("name_prefix")
@@ -238,7 +244,7 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
-	cluster, err := client.csconn.DescribeCluster(d.Id())
+	cluster, err := client.csconn().DescribeCluster(d.Id())
 
 	if err != nil {
 		if NotFoundError(err) || IsExceptedError(err, ErrorClusterNotFound) {
@@ -261,9 +267,9 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 
 	pageNumber := 1
 	for {
-		result, pagination, err := client.csconn.GetKubernetesClusterNodes(d.Id(), common.Pagination{PageNumber: pageNumber, PageSize: 50})
+		result, pagination, err := client.csconn().GetKubernetesClusterNodes(d.Id(), common.Pagination{PageNumber: pageNumber, PageSize: 50})
 		if err != nil {
-			return fmt.Errorf("[ERROR] GetKubernetesClusterNodes got an error: %#v.", err)
+			return WrapErrorf(err, "[ERROR] GetKubernetesClusterNodes got an error")
 		}
 
 		for _, node := range result {
@@ -289,54 +295,52 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 	d.Set("nodes", nodes)
 
 	d.Set("master_instance_type", master.InstanceType)
-	if disks, _, err := client.ecsconn.DescribeDisks(&ecs.DescribeDisksArgs{
-		RegionId:   getRegion(d, meta),
-		InstanceId: master.InstanceId,
-		DiskType:   ecs.DiskTypeAllSystem,
-	}); err != nil {
-		return fmt.Errorf("[ERROR] DescribeDisks By Id %s: %#v.", master.InstanceId, err)
-	} else if len(disks) > 0 {
-		d.Set("master_disk_size", disks[0].Size)
-		d.Set("master_disk_category", disks[0].Category)
-		d.Set("availability_zone", disks[0].ZoneId)
+	if disk, err := client.QueryInstanceSystemDisk(master.InstanceId); err != nil {
+		if !NotFoundError(err) {
+			return WrapErrorf(err, "[ERROR] DescribeDisks By Id %s", master.InstanceId)
+		}
+	} else {
+		d.Set("master_disk_size", disk.Size)
+		d.Set("master_disk_category", disk.Category)
+		d.Set("availability_zone", disk.ZoneId)
 	}
 
 	d.Set("worker_instance_type", worker.InstanceType)
-	if disks, _, err := client.ecsconn.DescribeDisks(&ecs.DescribeDisksArgs{
-		RegionId:   getRegion(d, meta),
-		InstanceId: worker.InstanceId,
-		DiskType:   ecs.DiskTypeAllSystem,
-	}); err != nil {
-		return fmt.Errorf("[ERROR] DescribeDisks By Id %s: %#v.", worker.InstanceId, err)
-	} else if len(disks) > 0 {
-		d.Set("worker_disk_size", disks[0].Size)
-		d.Set("worker_disk_category", disks[0].Category)
+	if disk, err := client.QueryInstanceSystemDisk(worker.InstanceId); err != nil {
+		if !NotFoundError(err) {
+			return WrapErrorf(err, "[ERROR] DescribeDisks By Id %s", worker.InstanceId)
+		}
+	} else {
+		d.Set("worker_disk_size", disk.Size)
+		d.Set("worker_disk_category", disk.Category)
 	}
 
 	if cluster.SecurityGroupID == "" {
 		if inst, err := client.QueryInstancesById(worker.InstanceId); err != nil {
-			return fmt.Errorf("[ERROR] QueryInstanceById %s got an error: %#v.", worker.InstanceId, err)
+			return WrapErrorf(err, "[ERROR] QueryInstanceById %s got an error", worker.InstanceId)
 		} else {
 			d.Set("security_group_id", inst.SecurityGroupIds.SecurityGroupId[0])
 		}
 	}
 
 	if cluster.ExternalLoadbalancerID == "" {
-		if lb, err := client.slbconn.DescribeLoadBalancers(&slb.DescribeLoadBalancersArgs{
-			RegionId: getRegion(d, meta),
-			ServerId: master.InstanceId,
-		}); err != nil {
-			return fmt.Errorf("[ERROR] DescribeLoadBalancers by server id %s got an error: %#v.", worker.InstanceId, err)
-		} else {
-			d.Set("slb_id", lb[0].LoadBalancerId)
+		request := slb.CreateDescribeLoadBalancersRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.ServerId = master.InstanceId
+		lb := slb.CreateDescribeLoadBalancersResponse()
+		if err := client.doAction(client.slbconn(), request, lb); err != nil {
+			return WrapErrorf(err, "[ERROR] DescribeLoadBalancers by server id %s got an error", worker.InstanceId)
+		} else if len(lb.LoadBalancers.LoadBalancer) > 0 {
+			d.Set("slb_id", lb.LoadBalancers.LoadBalancer[0].LoadBalancerId)
 		}
 	}
 
 	req := vpc.CreateDescribeNatGatewaysRequest()
 	req.VpcId = cluster.VPCID
-	if nat, err := client.vpcconn.DescribeNatGateways(req); err != nil {
-		return fmt.Errorf("[ERROR] DescribeNatGateways by VPC Id %s: %#v.", cluster.VPCID, err)
-	} else if nat != nil {
+	nat := vpc.CreateDescribeNatGatewaysResponse()
+	if err := client.doAction(client.vpcconn, req, nat); err != nil {
+		return WrapErrorf(err, "[ERROR] DescribeNatGateways by VPC Id %s", cluster.VPCID)
+	} else if len(nat.NatGateways.NatGateway) > 0 {
 		d.Set("nat_gateway_id", nat.NatGateways.NatGateway[0].NatGatewayId)
 	}
 
@@ -344,15 +348,15 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAlicloudCSKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).csconn
+	conn := meta.(*AliyunClient).csconn()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := conn.DeleteCluster(d.Id())
 		if err != nil {
 			if NotFoundError(err) || IsExceptedError(err, ErrorClusterNotFound) {
 				return nil
 			}
-			return resource.RetryableError(fmt.Errorf("Delete Kubernetes Cluster timeout and get an error: %#v.", err))
+			return resource.RetryableError(WrapErrorf(err, "Delete Kubernetes Cluster timeout and get an error"))
 		}
 
 		resp, err := conn.DescribeCluster(d.Id())
@@ -360,17 +364,13 @@ func resourceAlicloudCSKubernetesDelete(d *schema.ResourceData, meta interface{}
 			if NotFoundError(err) || IsExceptedError(err, ErrorClusterNotFound) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Describing Kubernetes Cluster got an error: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "Describing Kubernetes Cluster got an error"))
 		}
 		if resp.ClusterID == "" {
 			return nil
 		}
 
-		if string(resp.State) == string(Deleting) {
-			time.Sleep(5 * time.Second)
-		}
-
-		return resource.RetryableError(fmt.Errorf("Delete Kubernetes Cluster timeout and get an error: %#v.", err))
+		return resource.RetryableError(fmt.Errorf("Delete Kubernetes Cluster timeout."))
 	})
 }
 
@@ -20,6 +20,12 @@ func resourceAlicloudCSSwarm() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:          schema.TypeString,
@@ -146,7 +152,7 @@ func resourceAlicloudCSSwarm() *schema.Resource {
 
 func resourceAlicloudCSSwarmCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.csconn
+	conn := client.csconn()
 
 	// Ensure instance_type is generation three
 	_, err := meta.(*AliyunClient).CheckParameterValidity(d, meta)
@@ -177,7 +183,7 @@ func resourceAlicloudCSSwarmCreate(d *schema.ResourceData, meta interface{}) err
 
 	vsw, err := client.DescribeVswitch(args.VSwitchID)
 	if err != nil {
-		return fmt.Errorf("Error DescribeVSwitches: %#v", err)
+		return WrapErrorf(err, "Error DescribeVSwitches")
 	}
 
 	if vsw.CidrBlock == args.SubnetCIDR {
@@ -187,12 +193,7 @@ func resourceAlicloudCSSwarmCreate(d *schema.ResourceData, meta interface{}) err
 	args.VPCID = vsw.VpcId
 
 	if imageId, ok := d.GetOk("image_id"); ok {
-		connection := client.ecsconn
-		argsImage := &ecs.DescribeImagesArgs{
-			RegionId: getRegion(d, meta),
-			ImageId:  imageId.(string),
-		}
-		if _, _, err := connection.DescribeImages(argsImage); err != nil {
+		if _, err := client.DescribeEcsImages(map[string]string{"ImageId": imageId.(string)}); err != nil {
 			return err
 		}
 
@@ -203,22 +204,22 @@ func resourceAlicloudCSSwarmCreate(d *schema.ResourceData, meta interface{}) err
 	cluster, err := conn.CreateCluster(region, args)
 
 	if err != nil {
-		return fmt.Errorf("Creating container Cluster got an error: %#v", err)
+		return WrapErrorf(err, "Creating container Cluster got an error")
 	}
 
 	d.SetId(cluster.ClusterID)
 
-	err = conn.WaitForClusterAsyn(cluster.ClusterID, cs.Running, 500)
+	err = conn.WaitForClusterAsyn(cluster.ClusterID, cs.Running, timeoutSeconds(d, schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("Waitting for container Cluster %#v got an error: %#v", cs.Running, err)
+		return WrapErrorf(err, "Waitting for container Cluster %#v got an error", cs.Running)
 	}
 
 	return resourceAlicloudCSSwarmUpdate(d, meta)
 }
 
 func resourceAlicloudCSSwarmUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).csconn
+	conn := meta.(*AliyunClient).csconn()
 	d.Partial(true)
 	if d.HasChange("node_number") && !d.IsNewResource() {
 		o, n := d.GetChange("node_number")
@@ -238,13 +239,13 @@ func resourceAlicloudCSSwarmUpdate(d *schema.ResourceData, meta interface{}) err
 			IOOptimized:      ecs.IoOptimized("true"),
 		})
 		if err != nil {
-			return fmt.Errorf("Resize Cluster got an error: %#v", err)
+			return WrapErrorf(err, "Resize Cluster got an error")
 		}
 
-		err = conn.WaitForClusterAsyn(d.Id(), cs.Running, 500)
+		err = conn.WaitForClusterAsyn(d.Id(), cs.Running, timeoutSeconds(d, schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("Waitting for container Cluster %#v got an error: %#v", cs.Running, err)
+			return WrapErrorf(err, "Waitting for container Cluster %#v got an error", cs.Running)
 		}
 	}
 
@@ -256,7 +257,7 @@ func resourceAlicloudCSSwarmUpdate(d *schema.ResourceData, meta interface{}) err
 			clusterName = resource.PrefixedUniqueId(d.Get("name_prefix").(string))
 		}
 		if err := conn.ModifyClusterName(d.Id(), clusterName); err != nil && !IsExceptedError(err, ErrorClusterNameAlreadyExist) {
-			return fmt.Errorf("Modify Cluster Name got an error: %#v", err)
+			return WrapErrorf(err, "Modify Cluster Name got an error")
 		}
 		d.SetPartial("name")
 		d.SetPartial("name_prefix")
@@ -270,7 +271,7 @@ func resourceAlicloudCSSwarmUpdate(d *schema.ResourceData, meta interface{}) err
 func resourceAlicloudCSSwarmRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
-	cluster, err := client.csconn.DescribeCluster(d.Id())
+	cluster, err := client.csconn().DescribeCluster(d.Id())
 
 	if err != nil {
 		if NotFoundError(err) {
@@ -294,7 +295,7 @@ func resourceAlicloudCSSwarmRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	var nodes []map[string]interface{}
-	var oneNode ecs.InstanceAttributesType
+	var instanceId, instanceType string
 
 	for _, node := range resp {
 		mapping := map[string]interface{}{
@@ -304,10 +305,11 @@ func resourceAlicloudCSSwarmRead(d *schema.ResourceData, meta interface{}) error
 			"status":     node.Status,
 		}
 		if inst, err := client.QueryInstancesById(node.InstanceId); err != nil {
-			return fmt.Errorf("[ERROR] QueryInstancesById %s: %#v.", node.InstanceId, err)
+			return WrapErrorf(err, "[ERROR] QueryInstancesById %s", node.InstanceId)
 		} else {
 			mapping["eip"] = inst.EipAddress.IpAddress
-			oneNode = *inst
+			instanceId = inst.InstanceId
+			instanceType = inst.InstanceType
 		}
 
 		nodes = append(nodes, mapping)
@@ -316,13 +318,9 @@ func resourceAlicloudCSSwarmRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("nodes", nodes)
 
 	//d.Set("image_id", oneNode.ImageId)
-	d.Set("instance_type", oneNode.InstanceType)
-	if disks, _, err := client.ecsconn.DescribeDisks(&ecs.DescribeDisksArgs{
-		RegionId:   getRegion(d, meta),
-		InstanceId: oneNode.InstanceId,
-		DiskType:   ecs.DiskTypeAllData,
-	}); err != nil {
-		return fmt.Errorf("[ERROR] DescribeDisks By Id %s: %#v.", resp[0].InstanceId, err)
+	d.Set("instance_type", instanceType)
+	if disks, err := client.QueryInstanceDisks(instanceId, DiskTypeData); err != nil {
+		return WrapErrorf(err, "[ERROR] DescribeDisks By Id %s", resp[0].InstanceId)
 	} else {
 		for _, disk := range disks {
 			d.Set("disk_size", disk.Size)
@@ -334,15 +332,15 @@ func resourceAlicloudCSSwarmRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAlicloudCSSwarmDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).csconn
+	conn := meta.(*AliyunClient).csconn()
 
-	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := conn.DeleteCluster(d.Id())
 		if err != nil {
 			if NotFoundError(err) || IsExceptedError(err, ErrorClusterNotFound) {
 				return nil
 			}
-			return resource.RetryableError(fmt.Errorf("Deleting container cluster got an error: %#v", err))
+			return resource.RetryableError(WrapErrorf(err, "Deleting container cluster got an error"))
 		}
 
 		resp, err := conn.DescribeCluster(d.Id())
@@ -350,12 +348,12 @@ func resourceAlicloudCSSwarmDelete(d *schema.ResourceData, meta interface{}) err
 			if NotFoundError(err) || IsExceptedError(err, ErrorClusterNotFound) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Describe container cluster got an error: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "Describe container cluster got an error"))
 		}
 		if resp.ClusterID == "" {
 			return nil
 		}
 
-		return resource.RetryableError(fmt.Errorf("Deleting container cluster got an error: %#v", err))
+		return resource.RetryableError(fmt.Errorf("Deleting container cluster timeout."))
 	})
 }
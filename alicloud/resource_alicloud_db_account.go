@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
@@ -20,6 +19,11 @@ func resourceAlicloudDBAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -35,10 +39,21 @@ func resourceAlicloudDBAccount() *schema.Resource {
 
 			"password": &schema.Schema{
 				Type:      schema.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
 
+			"kms_encrypted_password": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"kms_encryption_context": &schema.Schema{
+				Type:             schema.TypeMap,
+				Optional:         true,
+				DiffSuppressFunc: kmsDiffSuppressFunc,
+			},
+
 			"type": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -59,39 +74,38 @@ func resourceAlicloudDBAccountCreate(d *schema.ResourceData, meta interface{}) e
 	request := rds.CreateCreateAccountRequest()
 	request.DBInstanceId = d.Get("instance_id").(string)
 	request.AccountName = d.Get("name").(string)
-	request.AccountPassword = d.Get("password").(string)
+	password, err := getPassword(d, client)
+	if err != nil {
+		return err
+	}
+	if password == "" {
+		return fmt.Errorf("One of the 'password' and 'kms_encrypted_password' should be set.")
+	}
+	request.AccountPassword = password
 	request.AccountType = d.Get("type").(string)
 
 	if v, ok := d.GetOk("description"); ok && v.(string) != "" {
 		request.AccountDescription = v.(string)
 	}
 	// wait instance running before modifying
-	if err := client.WaitForDBInstance(request.DBInstanceId, Running, 500); err != nil {
-		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	if err := client.WaitForDBInstance(request.DBInstanceId, Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", request.DBInstanceId)
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		args := request
-		if _, err := client.rdsconn.CreateAccount(args); err != nil {
-			if IsExceptedError(err, InvalidAccountNameDuplicate) {
-				return resource.NonRetryableError(fmt.Errorf("The account %s has already existed. Please import it using ID '%s:%s' or specify a new 'name' and try again.",
-					args.AccountName, args.DBInstanceId, args.AccountName))
-			} else if IsExceptedError(err, OperationDeniedDBInstanceStatus) {
-				return resource.RetryableError(fmt.Errorf("Create db account got an error: %#v.", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Create db account got an error: %#v.", err))
-		}
-
-		return nil
+	err = RetryOnError(RdsCode, d.Timeout(schema.TimeoutCreate), func() error {
+		return client.doAction(client.rdsconn, request, rds.CreateCreateAccountResponse())
 	})
-
 	if err != nil {
-		return err
+		if IsExceptedError(err, InvalidAccountNameDuplicate) {
+			return fmt.Errorf("The account %s has already existed. Please import it using ID '%s:%s' or specify a new 'name' and try again.",
+				request.AccountName, request.DBInstanceId, request.AccountName)
+		}
+		return WrapApiError(err, request.GetActionName(), request.DBInstanceId)
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.DBInstanceId, COLON_SEPARATED, request.AccountName))
 
-	if err := client.WaitForAccount(request.DBInstanceId, request.AccountName, Available, 500); err != nil {
-		return fmt.Errorf("Wait db account %s got an error: %#v.", Available, err)
+	if err := client.WaitForAccount(request.DBInstanceId, request.AccountName, Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForAccount %s got an error", Available), "", d.Id())
 	}
 
 	return resourceAlicloudDBAccountUpdate(d, meta)
@@ -99,14 +113,17 @@ func resourceAlicloudDBAccountCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceAlicloudDBAccountRead(d *schema.ResourceData, meta interface{}) error {
 
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	parts, err := ParseResourceId(d.Id(), "RDS account", "instance_id", "account_name")
+	if err != nil {
+		return err
+	}
 	account, err := meta.(*AliyunClient).DescribeDatabaseAccount(parts[0], parts[1])
 	if err != nil {
 		if NotFoundDBInstance(err) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Describe db account got an error: %#v", err)
+		return WrapApiError(err, "DescribeAccounts", d.Id())
 	}
 
 	d.Set("instance_id", account.DBInstanceId)
@@ -120,7 +137,10 @@ func resourceAlicloudDBAccountRead(d *schema.ResourceData, meta interface{}) err
 func resourceAlicloudDBAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 	d.Partial(true)
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	parts, err := ParseResourceId(d.Id(), "RDS account", "instance_id", "account_name")
+	if err != nil {
+		return err
+	}
 	instanceId := parts[0]
 	accountName := parts[1]
 
@@ -131,23 +151,32 @@ func resourceAlicloudDBAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		request.AccountName = accountName
 		request.AccountDescription = d.Get("description").(string)
 
-		if _, err := meta.(*AliyunClient).rdsconn.ModifyAccountDescription(request); err != nil {
-			return fmt.Errorf("ModifyAccountDescription got an error: %#v", err)
+		if err := client.doAction(client.rdsconn, request, rds.CreateModifyAccountDescriptionResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 		d.SetPartial("description")
 	}
 
-	if d.HasChange("password") && !d.IsNewResource() {
+	if (d.HasChange("password") || d.HasChange("kms_encrypted_password") || d.HasChange("kms_encryption_context")) && !d.IsNewResource() {
+		password, err := getPassword(d, client)
+		if err != nil {
+			return err
+		}
+		if password == "" {
+			return fmt.Errorf("One of the 'password' and 'kms_encrypted_password' should be set.")
+		}
 
 		request := rds.CreateResetAccountPasswordRequest()
 		request.DBInstanceId = instanceId
 		request.AccountName = accountName
-		request.AccountPassword = d.Get("password").(string)
+		request.AccountPassword = password
 
-		if _, err := client.rdsconn.ResetAccountPassword(request); err != nil {
-			return fmt.Errorf("Error reset db account password error: %#v", err)
+		if err := client.doAction(client.rdsconn, request, rds.CreateResetAccountPasswordResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 		d.SetPartial("password")
+		d.SetPartial("kms_encrypted_password")
+		d.SetPartial("kms_encryption_context")
 	}
 
 	d.Partial(false)
@@ -155,20 +184,27 @@ func resourceAlicloudDBAccountUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	client := meta.(*AliyunClient)
+	parts, err := ParseResourceId(d.Id(), "RDS account", "instance_id", "account_name")
+	if err != nil {
+		return err
+	}
 
 	request := rds.CreateDeleteAccountRequest()
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := meta.(*AliyunClient).rdsconn.DeleteAccount(request); err != nil {
-			if IsExceptedError(err, InvalidAccountNameNotFound) {
-				return nil
-			}
-			return resource.RetryableError(fmt.Errorf("Delete database account got an error: %#v.", err))
+	err = RetryOnError(RdsCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.doAction(client.rdsconn, request, rds.CreateDeleteAccountResponse())
+	})
+	if err != nil {
+		if IsExceptedError(err, InvalidAccountNameNotFound) {
+			return nil
 		}
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
 
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		resp, err := meta.(*AliyunClient).DescribeDatabaseAccount(parts[0], parts[1])
 		if err != nil {
 			if NotFoundDBInstance(err) {
@@ -179,6 +215,6 @@ func resourceAlicloudDBAccountDelete(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return resource.RetryableError(fmt.Errorf("Delete database account got an error: %#v.", err))
+		return resource.RetryableError(fmt.Errorf("Delete database account %s timeout.", d.Id()))
 	})
 }
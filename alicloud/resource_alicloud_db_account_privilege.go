@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -19,6 +18,12 @@ func resourceAlicloudDBAccountPrivilege() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -56,13 +61,13 @@ func resourceAlicloudDBAccountPrivilegeCreate(d *schema.ResourceData, meta inter
 	privilege := d.Get("privilege").(string)
 	dbList := d.Get("db_names").(*schema.Set).List()
 	// wait instance running before granting
-	if err := meta.(*AliyunClient).WaitForDBInstance(instanceId, Running, 500); err != nil {
-		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	if err := meta.(*AliyunClient).WaitForDBInstance(instanceId, Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", instanceId)
 	}
 	if len(dbList) > 0 {
 		for _, db := range dbList {
 			if err := meta.(*AliyunClient).GrantAccountPrivilege(instanceId, account, db.(string), privilege); err != nil {
-				return fmt.Errorf("Grant Account %s Privilege %s got an error: %#v", account, privilege, err)
+				return WrapApiError(err, "GrantAccountPrivilege", instanceId)
 			}
 		}
 	}
@@ -74,14 +79,17 @@ func resourceAlicloudDBAccountPrivilegeCreate(d *schema.ResourceData, meta inter
 
 func resourceAlicloudDBAccountPrivilegeRead(d *schema.ResourceData, meta interface{}) error {
 
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	parts, err := ParseResourceId(d.Id(), "RDS account privilege", "instance_id", "account_name", "privilege")
+	if err != nil {
+		return err
+	}
 	account, err := meta.(*AliyunClient).DescribeDatabaseAccount(parts[0], parts[1])
 	if err != nil {
 		if NotFoundDBInstance(err) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Describe db account got an error: %#v", err)
+		return WrapApiError(err, "DescribeAccounts", d.Id())
 	}
 
 	d.Set("instance_id", account.DBInstanceId)
@@ -103,7 +111,10 @@ func resourceAlicloudDBAccountPrivilegeUpdate(d *schema.ResourceData, meta inter
 	d.Partial(true)
 
 	if d.HasChange("db_names") && !d.IsNewResource() {
-		parts := strings.Split(d.Id(), COLON_SEPARATED)
+		parts, err := ParseResourceId(d.Id(), "RDS account privilege", "instance_id", "account_name", "privilege")
+		if err != nil {
+			return err
+		}
 
 		o, n := d.GetChange("db_names")
 		os := o.(*schema.Set)
@@ -113,24 +124,24 @@ func resourceAlicloudDBAccountPrivilegeUpdate(d *schema.ResourceData, meta inter
 
 		if len(remove) > 0 {
 			// wait instance running before revoking
-			if err := client.WaitForDBInstance(parts[0], Running, 500); err != nil {
-				return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+			if err := client.WaitForDBInstance(parts[0], Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+				return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", d.Id())
 			}
 			for _, db := range remove {
 				if err := client.RevokeAccountPrivilege(parts[0], parts[1], db.(string)); err != nil {
-					return err
+					return WrapApiError(err, "RevokeAccountPrivilege", d.Id())
 				}
 			}
 		}
 
 		if len(add) > 0 {
 			// wait instance running before granting
-			if err := client.WaitForDBInstance(parts[0], Running, 500); err != nil {
-				return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+			if err := client.WaitForDBInstance(parts[0], Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+				return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", d.Id())
 			}
 			for _, db := range add {
 				if err := client.GrantAccountPrivilege(parts[0], parts[1], db.(string), parts[2]); err != nil {
-					return err
+					return WrapApiError(err, "GrantAccountPrivilege", d.Id())
 				}
 			}
 		}
@@ -143,22 +154,25 @@ func resourceAlicloudDBAccountPrivilegeUpdate(d *schema.ResourceData, meta inter
 
 func resourceAlicloudDBAccountPrivilegeDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	parts, err := ParseResourceId(d.Id(), "RDS account privilege", "instance_id", "account_name", "privilege")
+	if err != nil {
+		return err
+	}
 
 	account, err := client.DescribeDatabaseAccount(parts[0], parts[1])
 	if err != nil {
 		if NotFoundDBInstance(err) {
 			return nil
 		}
-		return fmt.Errorf("Describe db account got an error: %#v", err)
+		return WrapApiError(err, "DescribeAccounts", d.Id())
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
 		if len(account.DatabasePrivileges.DatabasePrivilege) > 0 {
 			for _, pri := range account.DatabasePrivileges.DatabasePrivilege {
 				if pri.AccountPrivilege == parts[2] {
 					if err := client.RevokeAccountPrivilege(parts[0], parts[1], pri.DBName); err != nil {
-						return resource.NonRetryableError(fmt.Errorf("Revoke DB %s account %s privilege got an error: %#v.", pri.DBName, account, err))
+						return resource.NonRetryableError(WrapApiError(err, "RevokeAccountPrivilege", d.Id()))
 					}
 				}
 			}
@@ -168,10 +182,10 @@ func resourceAlicloudDBAccountPrivilegeDelete(d *schema.ResourceData, meta inter
 			if NotFoundDBInstance(err) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Describe db account got an error: %#v", err))
+			return resource.NonRetryableError(WrapApiError(err, "DescribeAccounts", d.Id()))
 		}
 		if len(account.DatabasePrivileges.DatabasePrivilege) > 0 {
-			return resource.RetryableError(fmt.Errorf("Revoke account %s privilege timeout and got an error: %#v.", account, err))
+			return resource.RetryableError(fmt.Errorf("Revoke account %s privilege timeout.", parts[1]))
 		}
 		return nil
 	})
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -20,6 +19,11 @@ func resourceAlicloudDBBackupPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -82,7 +86,7 @@ func resourceAlicloudDBBackupPolicyRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe DB backup policy: %#v", err)
+		return WrapApiError(err, "DescribeBackupPolicy", d.Id())
 	}
 
 	d.Set("instance_id", d.Id())
@@ -141,20 +145,13 @@ func resourceAlicloudDBBackupPolicyUpdate(d *schema.ResourceData, meta interface
 
 	if update {
 		// wait instance running before modifying
-		if err := client.WaitForDBInstance(d.Id(), Running, 500); err != nil {
-			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		if err := client.WaitForDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", d.Id())
 		}
-		if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			if err := client.ModifyDBBackupPolicy(d.Id(), backupTime, backupPeriod, retentionPeriod, backupLog, logBackupRetentionPeriod); err != nil {
-				if IsExceptedError(err, OperationDeniedDBInstanceStatus) || IsExceptedError(err, DBInternalError) {
-					return resource.RetryableError(fmt.Errorf("ModifyBackupPolicy got an error: %#v.", err))
-				}
-				return resource.NonRetryableError(fmt.Errorf("ModifyBackupPolicy got an error: %#v.", err))
-			}
-
-			return nil
+		if err := RetryOnError(RdsCode, d.Timeout(schema.TimeoutUpdate), func() error {
+			return client.ModifyDBBackupPolicy(d.Id(), backupTime, backupPeriod, retentionPeriod, backupLog, logBackupRetentionPeriod)
 		}); err != nil {
-			return err
+			return WrapApiError(err, "ModifyBackupPolicy", d.Id())
 		}
 	}
 
@@ -170,11 +167,8 @@ func resourceAlicloudDBBackupPolicyDelete(d *schema.ResourceData, meta interface
 	backupLog := "Enable"
 	logBackupRetentionPeriod := "7"
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if err := meta.(*AliyunClient).ModifyDBBackupPolicy(d.Id(), backupTime, backupPeriod, retentionPeriod, backupLog, logBackupRetentionPeriod); err != nil {
-			return resource.RetryableError(fmt.Errorf("ModifyBackupPolicy got an error: %#v", err))
-		}
-
-		return nil
+	err := RetryOnError(RdsCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return meta.(*AliyunClient).ModifyDBBackupPolicy(d.Id(), backupTime, backupPeriod, retentionPeriod, backupLog, logBackupRetentionPeriod)
 	})
+	return WrapApiError(err, "ModifyBackupPolicy", d.Id())
 }
@@ -70,9 +70,7 @@ func testAccCheckDBBackupPolicyDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := client.rdsconn.DescribeBackupPolicy(&rds.DescribeBackupPolicyRequest{
-			DBInstanceId: rs.Primary.ID,
-		})
+		_, err := client.DescribeBackupPolicy(rs.Primary.ID)
 		if err != nil {
 			if IsExceptedError(err, InvalidDBInstanceIdNotFound) || IsExceptedError(err, InvalidDBInstanceNameNotFound) {
 				return nil
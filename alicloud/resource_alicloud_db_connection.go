@@ -20,6 +20,11 @@ func resourceAlicloudDBConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -60,7 +65,7 @@ func resourceAlicloudDBConnectionCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if err := client.AllocateDBPublicConnection(instance_id, prefix.(string), d.Get("port").(string)); err != nil {
-		return fmt.Errorf("AllocateInstancePublicConnection got an error: %#v", err)
+		return WrapApiError(err, "AllocateInstancePublicConnection", instance_id)
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", instance_id, COLON_SEPARATED, prefix.(string)))
@@ -73,7 +78,10 @@ func resourceAlicloudDBConnectionRead(d *schema.ResourceData, meta interface{})
 		d.SetId(strings.Replace(d.Id(), DBConnectionSuffix, "", -1))
 	}
 
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	parts, err := ParseResourceId(d.Id(), "RDS connection", "instance_id", "connection_prefix")
+	if err != nil {
+		return err
+	}
 
 	conn, err := meta.(*AliyunClient).DescribeDBInstanceNetInfoByIpType(parts[0], Public)
 
@@ -82,7 +90,7 @@ func resourceAlicloudDBConnectionRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return err
+		return WrapApiError(err, "DescribeDBInstanceNetInfo", d.Id())
 	}
 
 	d.Set("instance_id", parts[0])
@@ -102,7 +110,10 @@ func resourceAlicloudDBConnectionUpdate(d *schema.ResourceData, meta interface{}
 		d.SetId(strings.Replace(d.Id(), DBConnectionSuffix, "", -1))
 	}
 
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	parts, err := ParseResourceId(d.Id(), "RDS connection", "instance_id", "connection_prefix")
+	if err != nil {
+		return err
+	}
 
 	if d.HasChange("port") && !d.IsNewResource() {
 		request := rds.CreateModifyDBInstanceConnectionStringRequest()
@@ -112,25 +123,19 @@ func resourceAlicloudDBConnectionUpdate(d *schema.ResourceData, meta interface{}
 		request.Port = d.Get("port").(string)
 
 		// wait instance running before modifying
-		if err := client.WaitForDBInstance(request.DBInstanceId, Running, 500); err != nil {
-			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		if err := client.WaitForDBInstance(request.DBInstanceId, Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", d.Id())
 		}
 
-		if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-			if _, err := client.rdsconn.ModifyDBInstanceConnectionString(request); err != nil {
-				if IsExceptedError(err, OperationDeniedDBInstanceStatus) || IsExceptedError(err, DBInternalError) {
-					return resource.RetryableError(fmt.Errorf("Modify DBInstance Connection Port got an error: %#v.", err))
-				}
-				return resource.NonRetryableError(fmt.Errorf("Modify DBInstance Connection Port got an error: %#v.", err))
-			}
-			return nil
+		if err := RetryOnError(RdsCode, d.Timeout(schema.TimeoutUpdate), func() error {
+			return client.doAction(client.rdsconn, request, rds.CreateModifyDBInstanceConnectionStringResponse())
 		}); err != nil {
-			return err
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 
 		// wait instance running after modifying
-		if err := client.WaitForDBInstance(request.DBInstanceId, Running, 500); err != nil {
-			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		if err := client.WaitForDBInstance(request.DBInstanceId, Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", d.Id())
 		}
 
 		d.SetPartial("port")
@@ -147,24 +152,29 @@ func resourceAlicloudDBConnectionDelete(d *schema.ResourceData, meta interface{}
 		d.SetId(strings.Replace(d.Id(), DBConnectionSuffix, "", -1))
 	}
 
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
-
-	return resource.Retry(3*time.Minute, func() *resource.RetryError {
-		err := client.ReleaseDBPublicConnection(parts[0], fmt.Sprintf("%s%s", parts[1], DBConnectionSuffix))
+	parts, err := ParseResourceId(d.Id(), "RDS connection", "instance_id", "connection_prefix")
+	if err != nil {
+		return err
+	}
 
-		if err != nil {
-			if IsExceptedError(err, InvalidCurrentConnectionStringNotFound) || IsExceptedError(err, AtLeastOneNetTypeExists) {
-				return nil
-			}
-			return resource.RetryableError(fmt.Errorf("Release DB connection timeout and got an error: %#v.", err))
+	err = RetryOnError(RdsCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.ReleaseDBPublicConnection(parts[0], fmt.Sprintf("%s%s", parts[1], DBConnectionSuffix))
+	})
+	if err != nil {
+		if IsExceptedError(err, InvalidCurrentConnectionStringNotFound) || IsExceptedError(err, AtLeastOneNetTypeExists) {
+			return nil
 		}
+		return WrapApiError(err, "ReleaseInstancePublicConnection", d.Id())
+	}
+
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		conn, err := meta.(*AliyunClient).DescribeDBInstanceNetInfoByIpType(parts[0], Public)
 
 		if err != nil {
 			if NotFoundDBInstance(err) || IsExceptedError(err, InvalidCurrentConnectionStringNotFound) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Release DB connection got an error: %#v.", err))
+			return resource.NonRetryableError(WrapApiError(err, "DescribeDBInstanceNetInfo", d.Id()))
 		}
 
 		if conn == nil {
@@ -172,6 +182,6 @@ func resourceAlicloudDBConnectionDelete(d *schema.ResourceData, meta interface{}
 			return nil
 		}
 
-		return resource.RetryableError(fmt.Errorf("Release DB connection timeout."))
+		return resource.RetryableError(fmt.Errorf("Release DB connection %s timeout.", d.Id()))
 	})
 }
@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
@@ -62,25 +61,16 @@ func resourceAlicloudDBDatabaseCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if inst, err := client.DescribeDBInstanceById(request.DBInstanceId); err != nil {
-		return fmt.Errorf("DescribeDBInstance got an error: %#v", err)
+		return WrapApiError(err, "DescribeDBInstanceAttribute", request.DBInstanceId)
 	} else if inst.Engine == string(PostgreSQL) || inst.Engine == string(PPAS) {
 		return fmt.Errorf("At present, it does not support creating 'PostgreSQL' and 'PPAS' database. Please login DB instance to create.")
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		ag := request
-		if _, err := client.rdsconn.CreateDatabase(ag); err != nil {
-			if IsExceptedError(err, OperationDeniedDBInstanceStatus) {
-				return resource.RetryableError(fmt.Errorf("Create database got an error: %#v.", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Create database got an error: %#v.", err))
-		}
-
-		return nil
+	err := RetryOnError(RdsCode, 5*time.Minute, func() error {
+		return client.doAction(client.rdsconn, request, rds.CreateCreateDatabaseResponse())
 	})
-
 	if err != nil {
-		return err
+		return WrapApiError(err, request.GetActionName(), request.DBInstanceId)
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.DBInstanceId, COLON_SEPARATED, request.DBName))
@@ -89,14 +79,17 @@ func resourceAlicloudDBDatabaseCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAlicloudDBDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	parts, err := ParseResourceId(d.Id(), "RDS database", "instance_id", "database_name")
+	if err != nil {
+		return err
+	}
 	db, err := meta.(*AliyunClient).DescribeDatabaseByName(parts[0], parts[1])
 	if err != nil {
 		if NotFoundDBInstance(err) || IsExceptedError(err, InvalidDBNameNotFound) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err)
+		return WrapApiError(err, "DescribeDatabases", d.Id())
 	}
 
 	if db == nil {
@@ -113,18 +106,22 @@ func resourceAlicloudDBDatabaseRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAlicloudDBDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
 
 	d.Partial(true)
 
 	if d.HasChange("description") && !d.IsNewResource() {
-		parts := strings.Split(d.Id(), COLON_SEPARATED)
+		parts, err := ParseResourceId(d.Id(), "RDS database", "instance_id", "database_name")
+		if err != nil {
+			return err
+		}
 		request := rds.CreateModifyDBDescriptionRequest()
 		request.DBInstanceId = parts[0]
 		request.DBName = parts[1]
 		request.DBDescription = d.Get("description").(string)
 
-		if _, err := meta.(*AliyunClient).rdsconn.ModifyDBDescription(request); err != nil {
-			return fmt.Errorf("ModifyDatabaseDescription got an error: %#v", err)
+		if err := client.doAction(client.rdsconn, request, rds.CreateModifyDBDescriptionResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 		d.SetPartial("description")
 	}
@@ -134,28 +131,35 @@ func resourceAlicloudDBDatabaseUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAlicloudDBDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).rdsconn
-	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	client := meta.(*AliyunClient)
+	conn := client.rdsconn
+	parts, err := ParseResourceId(d.Id(), "RDS database", "instance_id", "database_name")
+	if err != nil {
+		return err
+	}
 	request := rds.CreateDeleteDatabaseRequest()
 	request.DBInstanceId = parts[0]
 	request.DBName = parts[1]
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := conn.DeleteDatabase(request); err != nil {
-			if NotFoundDBInstance(err) || IsExceptedError(err, InvalidDBNameNotFound) {
-				return nil
-			}
-			return resource.RetryableError(fmt.Errorf("Delete database %s timeout and got an error: %#v.", parts[1], err))
+	err = RetryOnError(RdsCode, 5*time.Minute, func() error {
+		return client.doAction(conn, request, rds.CreateDeleteDatabaseResponse())
+	})
+	if err != nil {
+		if NotFoundDBInstance(err) || IsExceptedError(err, InvalidDBNameNotFound) {
+			return nil
 		}
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
 
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		db, err := meta.(*AliyunClient).DescribeDatabaseByName(parts[0], parts[1])
 		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err))
+			return resource.NonRetryableError(WrapApiError(err, "DescribeDatabases", d.Id()))
 		}
 		if db == nil {
 			return nil
 		}
 
-		return resource.RetryableError(fmt.Errorf("Delete database %s timeout and got an error: %#v.", parts[1], err))
+		return resource.RetryableError(fmt.Errorf("Delete database %s timeout.", parts[1]))
 	})
 }
@@ -5,11 +5,8 @@ import (
 	"strings"
 	"time"
 
-	"strconv"
-
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
-	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -24,6 +21,12 @@ func resourceAlicloudDBInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"engine": &schema.Schema{
 				Type:         schema.TypeString,
@@ -34,9 +37,14 @@ func resourceAlicloudDBInstance() *schema.Resource {
 			"engine_version": &schema.Schema{
 				Type:         schema.TypeString,
 				ValidateFunc: validateAllowedStringValue([]string{"5.5", "5.6", "5.7", "2008r2", "2012", "9.4", "9.3"}),
-				ForceNew:     true,
 				Required:     true,
 			},
+			"engine_version_effective_time": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{RdsEffectiveImmediate, RdsEffectiveMaintainTime}),
+				Optional:     true,
+				Default:      RdsEffectiveImmediate,
+			},
 			"db_instance_class": &schema.Schema{
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -219,6 +227,45 @@ func resourceAlicloudDBInstance() *schema.Resource {
 				},
 				Deprecated: "Field 'db_mappings' has been deprecated from provider version 1.5.0. New resource 'alicloud_db_database' replaces it.",
 			},
+
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"dedicated_host_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"target_dedicated_host_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_db_instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"backup_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"restore_time"},
+			},
+			"restore_time": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateRdsRestoreTime,
+				ConflictsWith: []string{"backup_id"},
+			},
+
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -227,22 +274,38 @@ func resourceAlicloudDBInstanceCreate(d *schema.ResourceData, meta interface{})
 	client := meta.(*AliyunClient)
 	conn := client.rdsconn
 
-	request, err := buildDBCreateRequest(d, meta)
-	if err != nil {
-		return err
-	}
+	if _, ok := d.GetOk("source_db_instance_id"); ok {
+		request, err := buildDBCloneRequest(d, meta)
+		if err != nil {
+			return err
+		}
 
-	resp, err := conn.CreateDBInstance(request)
+		resp := rds.CreateCloneDBInstanceResponse()
+		err = client.doAction(conn, request, resp)
+		if err != nil {
+			return WrapErrorf(WrapApiError(err, request.GetActionName(), ""), "CloneDBInstance from %s got an error", request.DBInstanceId)
+		}
 
-	if err != nil {
-		return fmt.Errorf("Error creating Alicloud db instance: %#v", err)
-	}
+		d.SetId(resp.DBInstanceId)
+	} else {
+		request, err := buildDBCreateRequest(d, meta)
+		if err != nil {
+			return err
+		}
+
+		resp := rds.CreateCreateDBInstanceResponse()
+		err = client.doAction(conn, request, resp)
+
+		if err != nil {
+			return WrapApiError(err, request.GetActionName(), "")
+		}
 
-	d.SetId(resp.DBInstanceId)
+		d.SetId(resp.DBInstanceId)
+	}
 
 	// wait instance status change from Creating to running
-	if err := client.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
-		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	if err := client.WaitForDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", d.Id())
 	}
 
 	return resourceAlicloudDBInstanceUpdate(d, meta)
@@ -263,7 +326,7 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		if err := client.ModifyDBSecurityIps(d.Id(), ipstr); err != nil {
-			return fmt.Errorf("Moodify DB security ips %s got an error: %#v", ipstr, err)
+			return WrapApiError(err, "ModifySecurityIps", d.Id())
 		}
 		d.SetPartial("security_ips")
 	}
@@ -287,16 +350,23 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	if update {
 		// wait instance status is running before modifying
-		if err := client.WaitForDBInstance(d.Id(), Running, 500); err != nil {
-			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		if err := client.WaitForDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", d.Id())
 		}
-		if _, err := conn.ModifyDBInstanceSpec(request); err != nil {
-			return err
+		if err := client.doAction(conn, request, rds.CreateModifyDBInstanceSpecResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 		// wait instance status is running after modifying
-		if err := client.WaitForDBInstance(d.Id(), Running, 500); err != nil {
-			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		if err := client.WaitForDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", d.Id())
+		}
+	}
+
+	if d.HasChange("engine_version") && !d.IsNewResource() {
+		if err := upgradeDBInstanceEngineVersion(d, client); err != nil {
+			return err
 		}
+		d.SetPartial("engine_version")
 	}
 
 	if d.HasChange("instance_name") {
@@ -304,11 +374,23 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		request.DBInstanceId = d.Id()
 		request.DBInstanceDescription = d.Get("instance_name").(string)
 
-		if _, err := conn.ModifyDBInstanceDescription(request); err != nil {
-			return fmt.Errorf("ModifyDBInstanceDescription got an error: %#v", err)
+		if err := client.doAction(conn, request, rds.CreateModifyDBInstanceDescriptionResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 	}
 
+	if d.HasChange("deletion_protection") {
+		if err := client.ModifyDBInstanceDeletionProtection(d.Id(), d.Get("deletion_protection").(bool)); err != nil {
+			return WrapApiError(err, "ModifyDBInstanceDeletionProtection", d.Id())
+		}
+		d.SetPartial("deletion_protection")
+	}
+
+	if err := setRdsTags(client, d); err != nil {
+		return WrapErrorf(err, "Set tags for db instance got an error")
+	}
+	d.SetPartial("tags")
+
 	d.Partial(false)
 	return resourceAlicloudDBInstanceRead(d, meta)
 }
@@ -322,18 +404,21 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err)
+		return WrapApiError(err, "DescribeDBInstanceAttribute", d.Id())
 	}
 
 	ips, err := client.GetSecurityIps(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Describe DB security ips error: %#v", err)
+		return WrapApiError(err, "DescribeDBInstanceIPArrayList", d.Id())
 	}
 
 	d.Set("security_ips", ips)
 
 	d.Set("engine", instance.Engine)
-	d.Set("engine_version", instance.EngineVersion)
+	// The upgrade taking effect in the maintenance window is pending, and the target version is kept until it is done.
+	if d.Get("engine_version_effective_time").(string) != RdsEffectiveMaintainTime || !rdsEngineVersionUpgradePending(instance.EngineVersion, d.Get("engine_version").(string)) {
+		d.Set("engine_version", instance.EngineVersion)
+	}
 	d.Set("instance_type", instance.DBInstanceClass)
 	d.Set("port", instance.Port)
 	d.Set("instance_storage", instance.DBInstanceStorage)
@@ -344,6 +429,19 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("connection_string", instance.ConnectionString)
 	d.Set("instance_name", instance.DBInstanceDescription)
 
+	attr, err := client.DescribeDBInstanceExtraAttribute(d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeDBInstanceAttribute", d.Id())
+	}
+	d.Set("deletion_protection", attr.DeletionProtection)
+	d.Set("dedicated_host_group_id", attr.DedicatedHostGroupId)
+
+	tags, err := client.describeRdsTags(d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeTags", d.Id())
+	}
+	d.Set("tags", client.ignoreDefaultTags(d, rdsTagsToMap(tags)))
+
 	return nil
 }
 
@@ -355,7 +453,7 @@ func resourceAlicloudDBInstanceDelete(d *schema.ResourceData, meta interface{})
 		if NotFoundDBInstance(err) {
 			return nil
 		}
-		return fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err)
+		return WrapApiError(err, "DescribeDBInstanceAttribute", d.Id())
 	}
 	if PayType(instance.PayType) == Prepaid {
 		return fmt.Errorf("At present, 'Prepaid' instance cannot be deleted and must wait it to be expired and release it automatically.")
@@ -364,28 +462,32 @@ func resourceAlicloudDBInstanceDelete(d *schema.ResourceData, meta interface{})
 	request := rds.CreateDeleteDBInstanceRequest()
 	request.DBInstanceId = d.Id()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		_, err := client.rdsconn.DeleteDBInstance(request)
-
-		if err != nil {
-			if NotFoundDBInstance(err) {
-				return nil
-			}
-			return resource.RetryableError(fmt.Errorf("Delete DB instance timeout and got an error: %#v.", err))
+	err = RetryOnError(RdsCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.doAction(client.rdsconn, request, rds.CreateDeleteDBInstanceResponse())
+	})
+	if err != nil {
+		if NotFoundDBInstance(err) {
+			return nil
+		}
+		if IsDeletionProtectionError(err) {
+			return WrapDeletionProtectionError(err, "db instance", d.Id())
 		}
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
 
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, err := client.DescribeDBInstanceById(d.Id())
 		if err != nil {
 			if NotFoundError(err) || IsExceptedError(err, InvalidDBInstanceNameNotFound) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err))
+			return resource.NonRetryableError(WrapApiError(err, "DescribeDBInstanceAttribute", d.Id()))
 		}
 		if instance == nil {
 			return nil
 		}
 
-		return resource.RetryableError(fmt.Errorf("Delete DB instance timeout and got an error: %#v.", err))
+		return resource.RetryableError(fmt.Errorf("Delete DB instance %s timeout.", d.Id()))
 	})
 }
 
@@ -414,7 +516,7 @@ func buildDBCreateRequest(d *schema.ResourceData, meta interface{}) (*rds.Create
 		// check vswitchId in zone
 		vsw, err := client.DescribeVswitch(vswitchId)
 		if err != nil {
-			return nil, fmt.Errorf("DescribeVSwitche got an error: %#v.", err)
+			return nil, WrapApiError(err, "DescribeVSwitchAttributes", vswitchId)
 		}
 
 		if request.ZoneId == "" {
@@ -439,14 +541,7 @@ func buildDBCreateRequest(d *schema.ResourceData, meta interface{}) (*rds.Create
 	// 'Month': valid period ranges [1-9]; 'Year': valid period range [1-3]
 	// This resource only supports to input Month period [1-9, 12, 24, 36] and the values need to be converted before using them.
 	if PayType(request.PayType) == Prepaid {
-
-		period := d.Get("period").(int)
-		request.UsedTime = strconv.Itoa(period)
-		request.Period = string(Month)
-		if period > 9 {
-			request.UsedTime = strconv.Itoa(period / 12)
-			request.Period = string(Year)
-		}
+		request.UsedTime, request.Period = prepaidPeriod(d.Get("period").(int))
 	}
 
 	request.SecurityIPList = LOCAL_HOST_IP
@@ -454,11 +549,112 @@ func buildDBCreateRequest(d *schema.ResourceData, meta interface{}) (*rds.Create
 		request.SecurityIPList = strings.Join(expandStringList(d.Get("security_ips").(*schema.Set).List())[:], COMMA_SEPARATED)
 	}
 
-	uuid, err := uuid.GenerateUUID()
+	// The instance is created on the hosts of the dedicated host group, which is not supported by the request of the SDK.
+	if v, ok := d.GetOk("dedicated_host_group_id"); ok {
+		request.QueryParams["DedicatedHostGroupId"] = v.(string)
+		if host, ok := d.GetOk("target_dedicated_host_id"); ok {
+			request.QueryParams["TargetDedicatedHostIdForMaster"] = host.(string)
+		}
+	}
+
+	request.ClientToken = buildClientToken("TF-CreateDBInstance")
+
+	return request, nil
+}
+
+// buildDBCloneRequest builds the request to clone a new instance from the backup set or the point in time of
+// the source instance. The engine of the new instance is inherited from the source instance.
+func buildDBCloneRequest(d *schema.ResourceData, meta interface{}) (*rds.CloneDBInstanceRequest, error) {
+	client := meta.(*AliyunClient)
+	sourceId := d.Get("source_db_instance_id").(string)
+
+	source, err := client.DescribeDBInstanceById(sourceId)
 	if err != nil {
-		uuid = resource.UniqueId()
+		return nil, WrapApiError(err, "DescribeDBInstanceAttribute", sourceId)
+	}
+	if source.Engine != d.Get("engine").(string) || source.EngineVersion != d.Get("engine_version").(string) {
+		return nil, fmt.Errorf("'engine' and 'engine_version' must be the same as the ones of the source instance %s: %s %s.",
+			sourceId, source.Engine, source.EngineVersion)
+	}
+
+	request := rds.CreateCloneDBInstanceRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.DBInstanceId = sourceId
+	request.DBInstanceStorage = requests.NewInteger(d.Get("instance_storage").(int))
+	request.DBInstanceClass = Trim(d.Get("instance_type").(string))
+	request.DBInstanceDescription = d.Get("instance_name").(string)
+
+	// CloneDBInstance requires the type of the data to restore, which is not supported by the request of the SDK.
+	if v, ok := d.GetOk("backup_id"); ok {
+		request.BackupId = v.(string)
+		request.QueryParams["RestoreType"] = RdsRestoreByBackup
+	} else if v, ok := d.GetOk("restore_time"); ok {
+		request.RestoreTime = v.(string)
+		request.QueryParams["RestoreType"] = RdsRestoreByTime
+	} else {
+		return nil, fmt.Errorf("One of 'backup_id' and 'restore_time' must be specified when 'source_db_instance_id' is specified.")
 	}
-	request.ClientToken = fmt.Sprintf("Terraform-Alicloud-%d-%s", time.Now().Unix(), uuid)
+
+	request.InstanceNetworkType = string(Classic)
+	if vswitchId := Trim(d.Get("vswitch_id").(string)); vswitchId != "" {
+		vsw, err := client.DescribeVswitch(vswitchId)
+		if err != nil {
+			return nil, WrapApiError(err, "DescribeVSwitchAttributes", vswitchId)
+		}
+		request.InstanceNetworkType = string(VPC)
+		request.VSwitchId = vswitchId
+		request.VPCId = vsw.VpcId
+	}
+
+	request.PayType = Trim(d.Get("instance_charge_type").(string))
+	if PayType(request.PayType) == Prepaid {
+		request.UsedTime, request.Period = prepaidPeriod(d.Get("period").(int))
+	}
+
+	request.ClientToken = buildClientToken("TF-CloneDBInstance")
 
 	return request, nil
 }
+
+// upgradeDBInstanceEngineVersion upgrades the engine version of the instance in place, which keeps its data and
+// connection strings. Only MySQL and PostgreSQL can be upgraded and the version can not be downgraded.
+func upgradeDBInstanceEngineVersion(d *schema.ResourceData, client *AliyunClient) error {
+	o, n := d.GetChange("engine_version")
+	engine := Engine(d.Get("engine").(string))
+	if engine != MySQL && engine != PostgreSQL {
+		return fmt.Errorf("The engine version of %s can not be upgraded in place. Please recreate the instance to change 'engine_version'.", engine)
+	}
+	if c, err := compareEngineVersion(o.(string), n.(string)); err != nil {
+		return err
+	} else if c > 0 {
+		return fmt.Errorf("The engine version can not be downgraded from %s to %s. Please recreate the instance to change 'engine_version'.", o, n)
+	}
+
+	// wait instance status is running before upgrading
+	if err := client.WaitForDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForDBInstance %s got an error", Running), "", d.Id())
+	}
+
+	request := rds.CreateUpgradeDBInstanceEngineVersionRequest()
+	request.DBInstanceId = d.Id()
+	request.EngineVersion = n.(string)
+	request.EffectiveTime = d.Get("engine_version_effective_time").(string)
+	request.ClientToken = buildClientToken("TF-UpgradeDBInstanceEngineVersion")
+	if err := client.doAction(client.rdsconn, request, rds.CreateUpgradeDBInstanceEngineVersionResponse()); err != nil {
+		return WrapErrorf(WrapApiError(err, request.GetActionName(), d.Id()), "UpgradeDBInstanceEngineVersion to %s got an error", request.EngineVersion)
+	}
+
+	if request.EffectiveTime == RdsEffectiveMaintainTime {
+		return nil
+	}
+	if err := client.WaitForDBInstanceEngineVersion(d.Id(), request.EngineVersion, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForDBInstanceEngineVersion %s got an error", request.EngineVersion), "", d.Id())
+	}
+	return nil
+}
+
+// rdsEngineVersionUpgradePending returns whether the current version is lower than the target version.
+func rdsEngineVersionUpgradePending(current, target string) bool {
+	c, err := compareEngineVersion(current, target)
+	return err == nil && c < 0
+}
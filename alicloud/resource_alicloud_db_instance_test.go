@@ -11,6 +11,25 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestRdsEngineVersionUpgradePending(t *testing.T) {
+	cases := []struct {
+		current string
+		target  string
+		pending bool
+	}{
+		{"5.6", "5.7", true},
+		{"9.4", "10.0", true},
+		{"5.7", "5.7", false},
+		{"5.7", "5.6", false},
+		{"2008r2", "2012", false},
+	}
+	for _, c := range cases {
+		if pending := rdsEngineVersionUpgradePending(c.current, c.target); pending != c.pending {
+			t.Fatalf("Expected the upgrade from %s to %s is pending: %t, got %t.", c.current, c.target, c.pending, pending)
+		}
+	}
+}
+
 func TestAccAlicloudDBInstance_basic(t *testing.T) {
 	var instance rds.DBInstanceAttribute
 
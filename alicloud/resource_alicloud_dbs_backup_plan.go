@@ -0,0 +1,344 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDbsBackupPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDbsBackupPlanCreate,
+		Read:   resourceAlicloudDbsBackupPlanRead,
+		Update: resourceAlicloudDbsBackupPlanUpdate,
+		Delete: resourceAlicloudDbsBackupPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_plan_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_class": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "small",
+				ValidateFunc: validateAllowedStringValue([]string{"micro", "small", "medium", "large", "xlarge"}),
+			},
+			"database_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"MySQL", "MSSQL", "Oracle", "PostgreSQL", "MongoDB", "Redis"}),
+			},
+			"backup_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "logical",
+				ValidateFunc: validateAllowedStringValue([]string{"logical", "physical"}),
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(PostPaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(PrePaid), string(PostPaid)}),
+			},
+			"period": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateFunc:     validateInstanceChargeTypePeriod,
+				DiffSuppressFunc: prePaidDiffSuppressFunc,
+			},
+			"source_endpoint_instance_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"RDS", "ECS", "Express", "Agent", "DDS", "Other"}),
+			},
+			"source_endpoint_region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_endpoint_instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_endpoint_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_endpoint_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"source_endpoint_database_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_endpoint_user_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_endpoint_password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"backup_objects": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonDocument,
+				DiffSuppressFunc: jsonDiffSuppressFunc,
+			},
+			"backup_period": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAllowedStringValue([]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}),
+				},
+				Required: true,
+			},
+			"backup_start_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// The incremental changes are backed up from the logs between the full backups.
+			"enable_backup_log": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"oss_bucket_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"backup_retention_period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      730,
+				ValidateFunc: validateIntegerInRange(0, 1825),
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildDbsSourceEndpointParams returns the parameters of the source database, which are shared by ConfigureBackupPlan
+// and ModifyBackupSourceEndpoint.
+func buildDbsSourceEndpointParams(d *schema.ResourceData) map[string]string {
+	params := map[string]string{
+		"SourceEndpointInstanceType": d.Get("source_endpoint_instance_type").(string),
+		"SourceEndpointUserName":     d.Get("source_endpoint_user_name").(string),
+		"SourceEndpointPassword":     d.Get("source_endpoint_password").(string),
+	}
+	if v, ok := d.GetOk("source_endpoint_region"); ok {
+		params["SourceEndpointRegion"] = v.(string)
+	}
+	if v, ok := d.GetOk("source_endpoint_instance_id"); ok {
+		params["SourceEndpointInstanceID"] = v.(string)
+	}
+	if v, ok := d.GetOk("source_endpoint_ip"); ok {
+		params["SourceEndpointIP"] = v.(string)
+	}
+	if v, ok := d.GetOk("source_endpoint_port"); ok {
+		params["SourceEndpointPort"] = strconv.Itoa(v.(int))
+	}
+	if v, ok := d.GetOk("source_endpoint_database_name"); ok {
+		params["SourceEndpointDatabaseName"] = v.(string)
+	}
+	if v, ok := d.GetOk("backup_objects"); ok {
+		params["BackupObjects"] = v.(string)
+	}
+	return params
+}
+
+func resourceAlicloudDbsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"Region":        string(client.Region),
+		"InstanceClass": d.Get("instance_class").(string),
+		"DatabaseType":  d.Get("database_type").(string),
+		"BackupMethod":  d.Get("backup_method").(string),
+		"PayType":       "postpay",
+		"ClientToken":   buildClientToken("TF-CreateBackupPlan"),
+	}
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		params["PayType"] = "prepay"
+		params["UsedTime"], params["Period"] = prepaidPeriod(d.Get("period").(int))
+	}
+	var resp struct {
+		BackupPlanId string `json:"BackupPlanId"`
+	}
+	if err := RetryOnError(DbsCode, 3*time.Minute, func() error {
+		return client.ProcessDbsRequest("CreateBackupPlan", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateBackupPlan got an error")
+	}
+	d.SetId(resp.BackupPlanId)
+
+	params = buildDbsSourceEndpointParams(d)
+	params["BackupPlanId"] = d.Id()
+	params["BackupPeriod"] = strings.Join(expandStringList(d.Get("backup_period").(*schema.Set).List()), COMMA_SEPARATED)
+	params["BackupStartTime"] = d.Get("backup_start_time").(string)
+	params["EnableBackupLog"] = strconv.FormatBool(d.Get("enable_backup_log").(bool))
+	params["BackupRetentionPeriod"] = strconv.Itoa(d.Get("backup_retention_period").(int))
+	if v, ok := d.GetOk("backup_plan_name"); ok {
+		params["BackupPlanName"] = v.(string)
+	}
+	if v, ok := d.GetOk("oss_bucket_name"); ok {
+		params["OSSBucketName"] = v.(string)
+	}
+	if err := client.ProcessDbsRequest("ConfigureBackupPlan", params, nil); err != nil {
+		return WrapErrorf(err, "ConfigureBackupPlan got an error")
+	}
+
+	if err := client.ProcessDbsRequest("StartBackupPlan", map[string]string{
+		"BackupPlanId": d.Id(),
+	}, nil); err != nil {
+		return WrapErrorf(err, "StartBackupPlan got an error")
+	}
+	if err := client.WaitForDbsBackupPlan(d.Id(), DbsBackupPlanRunning, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "WaitForDbsBackupPlan %s got an error", d.Id())
+	}
+
+	return resourceAlicloudDbsBackupPlanRead(d, meta)
+}
+
+func resourceAlicloudDbsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
+	plan, err := meta.(*AliyunClient).DescribeDbsBackupPlan(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("backup_plan_name", plan.BackupPlanName)
+	d.Set("instance_class", plan.InstanceClass)
+	d.Set("database_type", plan.DatabaseType)
+	d.Set("backup_method", plan.BackupMethod)
+	d.Set("source_endpoint_instance_type", plan.SourceEndpointInstanceType)
+	d.Set("source_endpoint_region", plan.SourceEndpointRegion)
+	d.Set("source_endpoint_instance_id", plan.SourceEndpointInstanceID)
+	if i := strings.LastIndex(plan.SourceEndpointIpPort, ":"); i > 0 {
+		d.Set("source_endpoint_ip", plan.SourceEndpointIpPort[:i])
+		if port, err := strconv.Atoi(plan.SourceEndpointIpPort[i+1:]); err == nil {
+			d.Set("source_endpoint_port", port)
+		}
+	}
+	d.Set("source_endpoint_database_name", plan.SourceEndpointDatabaseName)
+	d.Set("source_endpoint_user_name", plan.SourceEndpointUserName)
+	d.Set("backup_objects", plan.BackupObjects)
+	if plan.BackupPeriod != "" {
+		d.Set("backup_period", strings.Split(plan.BackupPeriod, COMMA_SEPARATED))
+	}
+	d.Set("backup_start_time", plan.BackupStartTime)
+	d.Set("enable_backup_log", plan.EnableBackupLog)
+	d.Set("oss_bucket_name", plan.OSSBucketName)
+	d.Set("backup_retention_period", plan.BackupRetentionPeriod)
+	d.Set("status", plan.BackupPlanStatus)
+
+	return nil
+}
+
+func resourceAlicloudDbsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if d.HasChange("backup_plan_name") {
+		if err := client.ProcessDbsRequest("ModifyBackupPlanName", map[string]string{
+			"BackupPlanId":   d.Id(),
+			"BackupPlanName": d.Get("backup_plan_name").(string),
+		}, nil); err != nil {
+			return WrapErrorf(err, "ModifyBackupPlanName got an error")
+		}
+		d.SetPartial("backup_plan_name")
+	}
+
+	if d.HasChange("source_endpoint_instance_type") || d.HasChange("source_endpoint_region") || d.HasChange("source_endpoint_instance_id") ||
+		d.HasChange("source_endpoint_ip") || d.HasChange("source_endpoint_port") || d.HasChange("source_endpoint_database_name") ||
+		d.HasChange("source_endpoint_user_name") || d.HasChange("source_endpoint_password") || d.HasChange("backup_objects") {
+		params := buildDbsSourceEndpointParams(d)
+		params["BackupPlanId"] = d.Id()
+		if err := client.ProcessDbsRequest("ModifyBackupSourceEndpoint", params, nil); err != nil {
+			return WrapErrorf(err, "ModifyBackupSourceEndpoint got an error")
+		}
+		for _, k := range []string{"source_endpoint_instance_type", "source_endpoint_region", "source_endpoint_instance_id", "source_endpoint_ip",
+			"source_endpoint_port", "source_endpoint_database_name", "source_endpoint_user_name", "source_endpoint_password", "backup_objects"} {
+			d.SetPartial(k)
+		}
+	}
+
+	if d.HasChange("backup_period") || d.HasChange("backup_start_time") {
+		if err := client.ProcessDbsRequest("ModifyBackupStrategy", map[string]string{
+			"BackupPlanId":       d.Id(),
+			"BackupStrategyType": "simple",
+			"BackupPeriod":       strings.Join(expandStringList(d.Get("backup_period").(*schema.Set).List()), COMMA_SEPARATED),
+			"BackupStartTime":    d.Get("backup_start_time").(string),
+		}, nil); err != nil {
+			return WrapErrorf(err, "ModifyBackupStrategy got an error")
+		}
+		d.SetPartial("backup_period")
+		d.SetPartial("backup_start_time")
+	}
+
+	if d.HasChange("backup_retention_period") {
+		if err := client.ProcessDbsRequest("ModifyStorageStrategy", map[string]string{
+			"BackupPlanId":          d.Id(),
+			"BackupRetentionPeriod": strconv.Itoa(d.Get("backup_retention_period").(int)),
+		}, nil); err != nil {
+			return WrapErrorf(err, "ModifyStorageStrategy got an error")
+		}
+		d.SetPartial("backup_retention_period")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudDbsBackupPlanRead(d, meta)
+}
+
+func resourceAlicloudDbsBackupPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		return fmt.Errorf("At present, 'PrePaid' backup plan cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+
+	if err := RetryOnError(DbsCode, 3*time.Minute, func() error {
+		return client.ProcessDbsRequest("ReleaseBackupPlan", map[string]string{
+			"BackupPlanId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, DbsBackupPlanNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "ReleaseBackupPlan got an error")
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDbsBackupPlan_basic(t *testing.T) {
+	var plan DbsBackupPlan
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dbs_backup_plan.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckDbsBackupPlanDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDbsBackupPlanConfig("01:00", 730),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbsBackupPlanExists("alicloud_dbs_backup_plan.foo", &plan),
+					resource.TestCheckResourceAttr("alicloud_dbs_backup_plan.foo", "backup_plan_name", "tf-testAccDbsBackupPlan"),
+					resource.TestCheckResourceAttr("alicloud_dbs_backup_plan.foo", "backup_period.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_dbs_backup_plan.foo", "backup_start_time", "01:00"),
+					resource.TestCheckResourceAttr("alicloud_dbs_backup_plan.foo", "status", "running"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDbsBackupPlanConfig("03:00", 365),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbsBackupPlanExists("alicloud_dbs_backup_plan.foo", &plan),
+					resource.TestCheckResourceAttr("alicloud_dbs_backup_plan.foo", "backup_start_time", "03:00"),
+					resource.TestCheckResourceAttr("alicloud_dbs_backup_plan.foo", "backup_retention_period", "365"),
+				),
+			},
+		},
+	})
+}
+
+func TestDbsBackupPlanSourceEndpointParams(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAlicloudDbsBackupPlan().Schema, map[string]interface{}{
+		"source_endpoint_instance_type": "other",
+		"source_endpoint_region":        "cn-hangzhou",
+		"source_endpoint_ip":            "10.0.0.1",
+		"source_endpoint_port":          3306,
+		"source_endpoint_database_name": "db",
+		"source_endpoint_user_name":     "user",
+		"source_endpoint_password":      "password",
+		"backup_objects":                `[{"DBName":"db"}]`,
+	})
+	expected := map[string]string{
+		"SourceEndpointInstanceType": "other",
+		"SourceEndpointRegion":       "cn-hangzhou",
+		"SourceEndpointIP":           "10.0.0.1",
+		"SourceEndpointPort":         "3306",
+		"SourceEndpointDatabaseName": "db",
+		"SourceEndpointUserName":     "user",
+		"SourceEndpointPassword":     "password",
+		"BackupObjects":              `[{"DBName":"db"}]`,
+	}
+	if params := buildDbsSourceEndpointParams(d); !reflect.DeepEqual(params, expected) {
+		t.Fatalf("Expected the source endpoint params %#v, got %#v", expected, params)
+	}
+}
+
+func TestDbsBackupPlanRead(t *testing.T) {
+	client, server := newTestAliyunClient(t, DbsCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("Action") != "DescribeBackupPlanList" || r.FormValue("Region") != string(common.Hangzhou) {
+			t.Errorf("Unexpected request %s", r.Form.Encode())
+		}
+		switch r.FormValue("BackupPlanId") {
+		case "dbsabc123":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Success":true,"Items":{"BackupPlanDetail":[{"BackupPlanId":"dbsabc123",` +
+				`"BackupPlanName":"tf-testAcc","BackupPlanStatus":"running","BackupMethod":"logical","InstanceClass":"small",` +
+				`"DatabaseType":"MySQL","SourceEndpointInstanceType":"other","SourceEndpointIpPort":"10.0.0.1:3306",` +
+				`"SourceEndpointUserName":"user","BackupPeriod":"Monday,Friday","BackupStartTime":"14:22",` +
+				`"EnableBackupLog":true,"BackupRetentionPeriod":730}]}}`))
+		default:
+			// DBS reports the failure by the ErrCode and the ErrMessage.
+			w.Write([]byte(`{"RequestId":"E5F6G7H8","Success":false,"ErrCode":"InvalidJobId.NotFound","ErrMessage":"The job does not exist."}`))
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAlicloudDbsBackupPlan().Schema, map[string]interface{}{})
+	d.SetId("dbsabc123")
+	if err := resourceAlicloudDbsBackupPlanRead(d, client); err != nil {
+		t.Fatalf("Reading the backup plan got an error: %#v", err)
+	}
+	expected := map[string]interface{}{
+		"backup_plan_name":        "tf-testAcc",
+		"status":                  "running",
+		"database_type":           "MySQL",
+		"source_endpoint_ip":      "10.0.0.1",
+		"source_endpoint_port":    3306,
+		"backup_start_time":       "14:22",
+		"enable_backup_log":       true,
+		"backup_retention_period": 730,
+	}
+	for k, v := range expected {
+		if d.Get(k) != v {
+			t.Fatalf("Expected %s is %v, got %v", k, v, d.Get(k))
+		}
+	}
+	if period := d.Get("backup_period").(*schema.Set); period.Len() != 2 || !period.Contains("Monday") || !period.Contains("Friday") {
+		t.Fatalf("Expected the backup period is split by comma, got %#v", period.List())
+	}
+
+	d.SetId("dbsunknown")
+	if err := resourceAlicloudDbsBackupPlanRead(d, client); err != nil || d.Id() != "" {
+		t.Fatalf("Expected the backup plan is removed when DBS responds %s, got id %q and error %#v", DbsBackupPlanNotFound, d.Id(), err)
+	}
+}
+
+func testAccCheckDbsBackupPlanExists(n string, plan *DbsBackupPlan) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DBS Backup Plan ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		p, err := client.DescribeDbsBackupPlan(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*plan = p
+
+		return nil
+	}
+}
+
+func testAccCheckDbsBackupPlanDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_dbs_backup_plan" {
+			continue
+		}
+
+		if _, err := client.DescribeDbsBackupPlan(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("DBS Backup Plan %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDbsBackupPlanConfig(startTime string, retention int) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+  available_resource_creation = "Rds"
+}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccDbsBackupPlan"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_db_instance" "foo" {
+  engine           = "MySQL"
+  engine_version   = "5.7"
+  instance_type    = "rds.mysql.t1.small"
+  instance_storage = "20"
+  vswitch_id       = "${alicloud_vswitch.foo.id}"
+}
+
+resource "alicloud_db_account" "foo" {
+  instance_id = "${alicloud_db_instance.foo.id}"
+  name        = "tf_backup"
+  password    = "Test12345"
+}
+
+resource "alicloud_dbs_backup_plan" "foo" {
+  backup_plan_name              = "tf-testAccDbsBackupPlan"
+  database_type                 = "MySQL"
+  source_endpoint_instance_type = "RDS"
+  source_endpoint_instance_id   = "${alicloud_db_instance.foo.id}"
+  source_endpoint_user_name     = "${alicloud_db_account.foo.name}"
+  source_endpoint_password      = "${alicloud_db_account.foo.password}"
+  backup_period                 = ["Monday", "Thursday"]
+  backup_start_time             = "%s"
+  backup_retention_period       = %d
+}
+`, startTime, retention)
+}
@@ -5,9 +5,8 @@ import (
 	"log"
 	"time"
 
-	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ecs"
-	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -51,19 +50,37 @@ func resourceAliyunDisk() *schema.Resource {
 			"size": &schema.Schema{
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
 			},
 
 			"snapshot_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				ForceNew: true,
 			},
 
 			"encrypted": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"kms_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
+			"performance_level": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateAllowedStringValue([]string{DiskPerformanceLevel0, DiskPerformanceLevel1, DiskPerformanceLevel2, DiskPerformanceLevel3}),
+				DiffSuppressFunc: essdDiffSuppressFunc,
+			},
+
 			"status": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -77,94 +94,105 @@ func resourceAliyunDisk() *schema.Resource {
 func resourceAliyunDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
-	conn := client.ecsconn
-
 	availabilityZone, err := client.DescribeZone(d.Get("availability_zone").(string))
 	if err != nil {
 		return err
 	}
 
-	args := &ecs.CreateDiskArgs{
-		RegionId: getRegion(d, meta),
-		ZoneId:   availabilityZone.ZoneId,
-	}
+	request := ecs.CreateCreateDiskRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.ZoneId = availabilityZone.ZoneId
 
+	var category DiskCategory
 	if v, ok := d.GetOk("category"); ok && v.(string) != "" {
-		category := ecs.DiskCategory(v.(string))
+		category = DiskCategory(v.(string))
 		if err := client.DiskAvailable(availabilityZone, category); err != nil {
 			return err
 		}
-		args.DiskCategory = category
+		request.DiskCategory = string(category)
 	}
 
+	size := 0
 	if v, ok := d.GetOk("size"); ok {
-		size := v.(int)
-		if args.DiskCategory == ecs.DiskCategoryCloud && (size < 5 || size > 2000) {
+		size = v.(int)
+		if category == DiskCategoryCloud && (size < 5 || size > 2000) {
 			return fmt.Errorf("the size of cloud disk must between 5 to 2000")
 		}
 
-		if (args.DiskCategory == ecs.DiskCategoryCloudEfficiency ||
-			args.DiskCategory == ecs.DiskCategoryCloudSSD) && (size < 20 || size > 32768) {
-			return fmt.Errorf("the size of %s disk must between 20 to 32768", args.DiskCategory)
+		if (category == DiskCategoryCloudEfficiency || category == DiskCategoryCloudSSD ||
+			category == DiskCategoryCloudESSD) && (size < 20 || size > 32768) {
+			return fmt.Errorf("the size of %s disk must between 20 to 32768", category)
 		}
-		args.Size = size
+		request.Size = requests.NewInteger(size)
 
-		d.Set("size", args.Size)
+		d.Set("size", size)
 	}
 
 	if v, ok := d.GetOk("snapshot_id"); ok && v.(string) != "" {
-		args.SnapshotId = v.(string)
+		request.SnapshotId = v.(string)
 	}
 
-	if args.Size <= 0 && args.SnapshotId == "" {
+	if size <= 0 && request.SnapshotId == "" {
 		return fmt.Errorf("One of size or snapshot_id is required when specifying an ECS disk.")
 	}
 
 	if v, ok := d.GetOk("name"); ok && v.(string) != "" {
-		args.DiskName = v.(string)
+		request.DiskName = v.(string)
 	}
 
 	if v, ok := d.GetOk("description"); ok && v.(string) != "" {
-		args.Description = v.(string)
+		request.Description = v.(string)
 	}
 
-	if v, ok := d.GetOk("encrypted"); ok {
-		args.Encrypted = v.(bool)
+	encrypted := d.Get("encrypted").(bool)
+	if encrypted {
+		request.Encrypted = requests.NewBoolean(encrypted)
 	}
 
-	diskID, err := conn.CreateDisk(args)
-	if err != nil {
-		return fmt.Errorf("CreateDisk got a error: %#v", err)
+	// A disk created from an encrypted snapshot is encrypted by the key of the snapshot, and the key can only be
+	// specified for a new encrypted disk.
+	if v, ok := d.GetOk("kms_key_id"); ok && v.(string) != "" {
+		if !encrypted {
+			return fmt.Errorf("'kms_key_id' can only be set when 'encrypted' is true.")
+		}
+		if request.SnapshotId != "" {
+			return fmt.Errorf("'kms_key_id' can not be set when creating a disk from 'snapshot_id'.")
+		}
+		request.KMSKeyId = v.(string)
+	}
+
+	if v, ok := d.GetOk("performance_level"); ok && v.(string) != "" {
+		if category != DiskCategoryCloudESSD {
+			return fmt.Errorf("'performance_level' can only be set when 'category' is %s.", DiskCategoryCloudESSD)
+		}
+		request.PerformanceLevel = v.(string)
+	}
+
+	request.ClientToken = buildClientToken("TF-CreateDisk")
+
+	response := ecs.CreateCreateDiskResponse()
+	if err := client.doAction(client.ecsconn(), request, response); err != nil {
+		return WrapErrorf(err, "CreateDisk got a error")
 	}
 
-	d.SetId(diskID)
+	d.SetId(response.DiskId)
 
 	return resourceAliyunDiskUpdate(d, meta)
 }
 
 func resourceAliyunDiskRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
-
-	disks, _, err := conn.DescribeDisks(&ecs.DescribeDisksArgs{
-		RegionId: getRegion(d, meta),
-		DiskIds:  []string{d.Id()},
-	})
+	disk, err := meta.(*AliyunClient).DescribeEcsDisk(d.Id())
 
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error DescribeDiskAttribute: %#v", err)
+		return WrapErrorf(err, "Error DescribeDiskAttribute")
 	}
 
-	log.Printf("[DEBUG] DescribeDiskAttribute for instance: %#v", disks)
-
-	if disks == nil || len(disks) <= 0 {
-		return fmt.Errorf("No disks found.")
-	}
+	log.Printf("[DEBUG] DescribeDiskAttribute for instance: %#v", disk)
 
-	disk := disks[0]
 	d.Set("availability_zone", disk.ZoneId)
 	d.Set("category", disk.Category)
 	d.Set("size", disk.Size)
@@ -173,38 +201,34 @@ func resourceAliyunDiskRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", disk.Description)
 	d.Set("snapshot_id", disk.SourceSnapshotId)
 	d.Set("encrypted", disk.Encrypted)
+	d.Set("kms_key_id", disk.KMSKeyId)
+	d.Set("performance_level", disk.PerformanceLevel)
 
-	tags, _, err := conn.DescribeTags(&ecs.DescribeTagsArgs{
-		RegionId:     getRegion(d, meta),
-		ResourceType: ecs.TagResourceDisk,
-		ResourceId:   d.Id(),
-	})
+	tags, err := meta.(*AliyunClient).describeEcsTags(getRegion(d, meta), TagResourceDisk, d.Id())
 
 	if err != nil {
 		log.Printf("[DEBUG] DescribeTags for disk got error: %#v", err)
 	}
 
-	d.Set("tags", tagsToMap(tags))
+	d.Set("tags", meta.(*AliyunClient).ignoreDefaultTags(d, tagsToMap(tags)))
 
 	return nil
 }
 
 func resourceAliyunDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
 
 	d.Partial(true)
 
-	if err := setTags(client, ecs.TagResourceDisk, d); err != nil {
+	if err := setTags(client, TagResourceDisk, d); err != nil {
 		log.Printf("[DEBUG] Set tags for instance got error: %#v", err)
-		return fmt.Errorf("Set tags for instance got error: %#v", err)
+		return WrapErrorf(err, "Set tags for instance got error")
 	} else {
 		d.SetPartial("tags")
 	}
 	attributeUpdate := false
-	args := &ecs.ModifyDiskAttributeArgs{
-		DiskId: d.Id(),
-	}
+	args := ecs.CreateModifyDiskAttributeRequest()
+	args.DiskId = d.Id()
 
 	if d.HasChange("name") {
 		d.SetPartial("name")
@@ -222,41 +246,40 @@ func resourceAliyunDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		attributeUpdate = true
 	}
 	if attributeUpdate {
-		if err := conn.ModifyDiskAttribute(args); err != nil {
+		if err := client.doAction(client.ecsconn(), args, ecs.CreateModifyDiskAttributeResponse()); err != nil {
 			return err
 		}
 	}
 
+	if d.HasChange("performance_level") && !d.IsNewResource() {
+		request := ecs.CreateModifyDiskSpecRequest()
+		request.DiskId = d.Id()
+		request.PerformanceLevel = d.Get("performance_level").(string)
+		if err := client.doAction(client.ecsconn(), request, ecs.CreateModifyDiskSpecResponse()); err != nil {
+			return WrapErrorf(err, "ModifyDiskSpec got an error")
+		}
+		d.SetPartial("performance_level")
+	}
+
 	d.Partial(false)
 
 	return resourceAliyunDiskRead(d, meta)
 }
 
 func resourceAliyunDiskDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
-
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := conn.DeleteDisk(d.Id())
-		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == DiskIncorrectStatus || e.ErrorResponse.Code == DiskCreatingSnapshot {
-				return resource.RetryableError(fmt.Errorf("Disk in use - trying again while it is deleted."))
-			}
-		}
+	client := meta.(*AliyunClient)
 
-		disks, _, descErr := conn.DescribeDisks(&ecs.DescribeDisksArgs{
-			RegionId: getRegion(d, meta),
-			DiskIds:  []string{d.Id()},
-		})
+	request := ecs.CreateDeleteDiskRequest()
+	request.DiskId = d.Id()
 
-		if descErr != nil {
-			log.Printf("[ERROR] Delete disk is failed.")
-			return resource.NonRetryableError(descErr)
-		}
-		if disks == nil || len(disks) < 1 {
+	err := RetryOnError(EcsCode, 5*time.Minute, func() error {
+		return client.doAction(client.ecsconn(), request, ecs.CreateDeleteDiskResponse())
+	})
+	if err != nil {
+		if IsExceptedError(err, InvalidDiskIdNotFound) {
 			return nil
 		}
-
-		return resource.RetryableError(fmt.Errorf("Disk in use - trying again while it is deleted."))
-	})
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
+	return nil
 }
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -16,6 +16,9 @@ func resourceAliyunDiskAttachment() *schema.Resource {
 		Create: resourceAliyunDiskAttachmentCreate,
 		Read:   resourceAliyunDiskAttachmentRead,
 		Delete: resourceAliyunDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
@@ -58,19 +61,18 @@ func resourceAliyunDiskAttachmentRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	conn := meta.(*AliyunClient).ecsconn
-	disks, _, err := conn.DescribeDisks(&ecs.DescribeDisksArgs{
-		RegionId:   getRegion(d, meta),
-		InstanceId: instanceId,
-		DiskIds:    []string{diskId},
-	})
+	request := ecs.CreateDescribeDisksRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.InstanceId = instanceId
+	request.DiskIds = convertListToJsonString([]interface{}{diskId})
+	disks, err := meta.(*AliyunClient).DescribeEcsDisks(request)
 
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error DescribeDiskAttribute: %#v", err)
+		return WrapErrorf(err, "Error DescribeDiskAttribute")
 	}
 
 	log.Printf("[DEBUG] DescribeDiskAttribute for instance: %#v", disks)
@@ -87,35 +89,37 @@ func resourceAliyunDiskAttachmentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAliyunDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 	diskID, instanceID, err := getDiskIDAndInstanceID(d, meta)
 	if err != nil {
 		return err
 	}
 
+	request := ecs.CreateDetachDiskRequest()
+	request.InstanceId = instanceID
+	request.DiskId = diskID
+
+	err = RetryOnError(EcsCode, 5*time.Minute, func() error {
+		return client.doAction(client.ecsconn(), request, ecs.CreateDetachDiskResponse())
+	})
+	if err != nil {
+		if IsExceptedError(err, InvalidDiskIdNotFound) {
+			return nil
+		}
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
+
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := conn.DetachDisk(instanceID, diskID)
+		disk, err := client.DescribeEcsDisk(diskID)
 		if err != nil {
-			if IsExceptedError(err, DiskIncorrectStatus) || IsExceptedError(err, InstanceLockedForSecurity) ||
-				IsExceptedError(err, DiskInvalidOperation) {
-				return resource.RetryableError(fmt.Errorf("Detach Disk timeout and got an error: %#v", err))
+			if NotFoundError(err) {
+				return nil
 			}
+			return resource.NonRetryableError(WrapError(err))
 		}
 
-		disks, _, descErr := conn.DescribeDisks(&ecs.DescribeDisksArgs{
-			RegionId: getRegion(d, meta),
-			DiskIds:  []string{diskID},
-		})
-
-		if descErr != nil {
-			log.Printf("[ERROR] Disk %s is not detached.", diskID)
-			return resource.NonRetryableError(err)
-		}
-
-		for _, disk := range disks {
-			if disk.Status != ecs.DiskStatusAvailable {
-				return resource.RetryableError(fmt.Errorf("Detach Disk timeout and got an error: %#v", err))
-			}
+		if disk.Status != string(Available) {
+			return resource.RetryableError(fmt.Errorf("Disk %s is not detached.", diskID))
 		}
 		return nil
 	})
@@ -130,45 +134,35 @@ func getDiskIDAndInstanceID(d *schema.ResourceData, meta interface{}) (string, s
 	return parts[0], parts[1], nil
 }
 func diskAttachment(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 
 	diskID := d.Get("disk_id").(string)
 	instanceID := d.Get("instance_id").(string)
 
-	args := &ecs.AttachDiskArgs{
-		InstanceId: instanceID,
-		DiskId:     diskID,
+	args := ecs.CreateAttachDiskRequest()
+	args.InstanceId = instanceID
+	args.DiskId = diskID
+
+	err := RetryOnError(EcsCode, 5*time.Minute, func() error {
+		return client.doAction(client.ecsconn(), args, ecs.CreateAttachDiskResponse())
+	})
+	if err != nil {
+		return WrapApiError(err, args.GetActionName(), diskID)
 	}
 
+	request := ecs.CreateDescribeDisksRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.InstanceId = instanceID
+	request.DiskIds = convertListToJsonString([]interface{}{diskID})
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := conn.AttachDisk(args)
-		log.Printf("error : %s", err)
-
+		disks, err := client.DescribeEcsDisks(request)
 		if err != nil {
-			if IsExceptedError(err, DiskIncorrectStatus) || IsExceptedError(err, InstanceIncorrectStatus) ||
-				IsExceptedError(err, DiskOperationConflict) || IsExceptedError(err, DiskInternalError) ||
-				IsExceptedError(err, DiskInvalidOperation) {
-				return resource.RetryableError(fmt.Errorf("Attach Disk timeout and got an error: %#v", err))
-			}
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapError(err))
 		}
 
-		disks, _, descErr := conn.DescribeDisks(&ecs.DescribeDisksArgs{
-			RegionId:   getRegion(d, meta),
-			InstanceId: instanceID,
-			DiskIds:    []string{diskID},
-		})
-
-		if descErr != nil {
-			log.Printf("[ERROR] Disk %s is not attached.", diskID)
-			return resource.NonRetryableError(err)
-		}
-
-		if disks == nil || len(disks) <= 0 {
-			return resource.RetryableError(fmt.Errorf("Attach Disk timeout and got an error: %#v", err))
+		if len(disks) <= 0 {
+			return resource.RetryableError(fmt.Errorf("Disk %s is not attached.", diskID))
 		}
-
 		return nil
-
 	})
 }
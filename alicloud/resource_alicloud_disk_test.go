@@ -5,13 +5,13 @@ import (
 	"log"
 	"testing"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAlicloudDisk_basic(t *testing.T) {
-	var v ecs.DiskItemType
+	var v ecs.Disk
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -48,7 +48,7 @@ func TestAccAlicloudDisk_basic(t *testing.T) {
 }
 
 func TestAccAlicloudDisk_withTags(t *testing.T) {
-	var v ecs.DiskItemType
+	var v ecs.Disk
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -76,7 +76,7 @@ func TestAccAlicloudDisk_withTags(t *testing.T) {
 }
 
 func TestAccAlicloudDisk_encrypted(t *testing.T) {
-	var v ecs.DiskItemType
+	var v ecs.Disk
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -112,7 +112,83 @@ func TestAccAlicloudDisk_encrypted(t *testing.T) {
 	})
 }
 
-func testAccCheckDiskExists(n string, disk *ecs.DiskItemType) resource.TestCheckFunc {
+func TestAccAlicloudDisk_kmsKey(t *testing.T) {
+	var v ecs.Disk
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_disk.encrypted",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDiskConfigKmsKey,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists(
+						"alicloud_disk.encrypted", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_disk.encrypted",
+						"encrypted",
+						"true"),
+					resource.TestCheckResourceAttrPair(
+						"alicloud_disk.encrypted", "kms_key_id",
+						"alicloud_kms_key.key", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudDisk_performanceLevel(t *testing.T) {
+	var v ecs.Disk
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_disk.essd",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDiskConfigPerformanceLevel("PL1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists(
+						"alicloud_disk.essd", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_disk.essd",
+						"category",
+						"cloud_essd"),
+					resource.TestCheckResourceAttr(
+						"alicloud_disk.essd",
+						"performance_level",
+						"PL1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDiskConfigPerformanceLevel("PL2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists(
+						"alicloud_disk.essd", &v),
+					resource.TestCheckResourceAttr(
+						"alicloud_disk.essd",
+						"performance_level",
+						"PL2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDiskExists(n string, disk *ecs.Disk) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -124,14 +200,12 @@ func testAccCheckDiskExists(n string, disk *ecs.DiskItemType) resource.TestCheck
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
 
-		request := &ecs.DescribeDisksArgs{
-			RegionId: client.Region,
-			DiskIds:  []string{rs.Primary.ID},
-		}
+		request := ecs.CreateDescribeDisksRequest()
+		request.RegionId = string(client.Region)
+		request.DiskIds = convertListToJsonString([]interface{}{rs.Primary.ID})
 
-		response, _, err := conn.DescribeDisks(request)
+		response, err := client.DescribeEcsDisks(request)
 		log.Printf("[WARN] disk ids %#v", rs.Primary.ID)
 
 		if err == nil {
@@ -153,14 +227,12 @@ func testAccCheckDiskDestroy(s *terraform.State) error {
 
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
 
-		request := &ecs.DescribeDisksArgs{
-			RegionId: client.Region,
-			DiskIds:  []string{rs.Primary.ID},
-		}
+		request := ecs.CreateDescribeDisksRequest()
+		request.RegionId = string(client.Region)
+		request.DiskIds = convertListToJsonString([]interface{}{rs.Primary.ID})
 
-		response, _, err := conn.DescribeDisks(request)
+		response, err := client.DescribeEcsDisks(request)
 
 		if response != nil && len(response) > 0 {
 			return fmt.Errorf("Error ECS Disk still exist")
@@ -219,3 +291,39 @@ resource "alicloud_disk" "encrypted" {
 	encrypted = true
 }
 `
+
+const testAccDiskConfigKmsKey = `
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+}
+
+resource "alicloud_kms_key" "key" {
+	description = "tf-testacc-disk-kms-key"
+	deletion_window_in_days = 7
+}
+
+resource "alicloud_disk" "encrypted" {
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	name = "New-Encrypted-disk"
+	category = "cloud_efficiency"
+	size = "30"
+	encrypted = true
+	kms_key_id = "${alicloud_kms_key.key.id}"
+}
+`
+
+func testAccDiskConfigPerformanceLevel(level string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_essd"
+}
+
+resource "alicloud_disk" "essd" {
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	name = "New-ESSD-disk"
+	category = "cloud_essd"
+	size = "40"
+	performance_level = "%s"
+}
+`, level)
+}
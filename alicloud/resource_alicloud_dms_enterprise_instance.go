@@ -0,0 +1,275 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDmsEnterpriseInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDmsEnterpriseInstanceCreate,
+		Read:   resourceAlicloudDmsEnterpriseInstanceRead,
+		Update: resourceAlicloudDmsEnterpriseInstanceUpdate,
+		Delete: resourceAlicloudDmsEnterpriseInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tid": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_source": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"RDS", "ECS_OWN", "PUBLIC_OWN", "VPC_ID", "GATEWAY"}),
+			},
+			"network_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"VPC", "CLASSIC"}),
+			},
+			"env_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"product", "dev", "test", "pre", "sit", "uat", "pet", "stag", "online", "offline"}),
+			},
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"database_user": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"database_password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"instance_alias": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dba_uid": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"safe_rule": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(1, 86400),
+			},
+			"export_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(1, 86400),
+			},
+			"ecs_instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ecs_region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"data_link_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ddl_online": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateIntegerInRange(0, 2),
+			},
+			"use_dsql": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateIntegerInRange(0, 1),
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dba_nick_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDmsEnterpriseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := buildDmsEnterpriseInstanceParams(d)
+	if err := client.ProcessDmsEnterpriseRequest("RegisterInstance", params, nil); err != nil {
+		return WrapErrorf(err, "RegisterInstance got an error")
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%d", d.Get("host").(string), COLON_SEPARATED, d.Get("port").(int)))
+
+	return resourceAlicloudDmsEnterpriseInstanceRead(d, meta)
+}
+
+func resourceAlicloudDmsEnterpriseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	host, port, err := parseDmsEnterpriseInstanceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	instance, err := meta.(*AliyunClient).DescribeDmsEnterpriseInstance(host, port)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, "Describe DMS Enterprise instance got an error")
+	}
+
+	d.Set("host", instance.Host)
+	d.Set("port", instance.Port)
+	d.Set("sid", instance.Sid)
+	d.Set("instance_type", instance.InstanceType)
+	d.Set("instance_source", instance.InstanceSource)
+	d.Set("env_type", instance.EnvType)
+	d.Set("database_user", instance.DatabaseUser)
+	d.Set("instance_alias", instance.InstanceAlias)
+	d.Set("dba_uid", instance.DbaId)
+	d.Set("safe_rule", instance.SafeRuleId)
+	d.Set("query_timeout", instance.QueryTimeout)
+	d.Set("export_timeout", instance.ExportTimeout)
+	d.Set("ecs_instance_id", instance.EcsInstanceId)
+	d.Set("ecs_region", instance.EcsRegion)
+	d.Set("vpc_id", instance.VpcId)
+	d.Set("data_link_name", instance.DataLinkName)
+	d.Set("ddl_online", instance.DdlOnline)
+	d.Set("use_dsql", instance.UseDsql)
+	d.Set("instance_id", instance.InstanceId)
+	d.Set("dba_nick_name", instance.DbaNickName)
+	d.Set("state", instance.State)
+
+	return nil
+}
+
+func resourceAlicloudDmsEnterpriseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	// UpdateInstance overwrites all of the attributes, so the whole configuration is sent.
+	params := buildDmsEnterpriseInstanceParams(d)
+	params["InstanceId"] = d.Get("instance_id").(string)
+	if err := client.ProcessDmsEnterpriseRequest("UpdateInstance", params, nil); err != nil {
+		return WrapErrorf(err, "UpdateInstance got an error")
+	}
+
+	return resourceAlicloudDmsEnterpriseInstanceRead(d, meta)
+}
+
+func resourceAlicloudDmsEnterpriseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	host, port, err := parseDmsEnterpriseInstanceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{
+		"Host": host,
+		"Port": strconv.Itoa(port),
+	}
+	if v, ok := d.GetOk("sid"); ok {
+		params["Sid"] = v.(string)
+	}
+	if v, ok := d.GetOk("tid"); ok {
+		params["Tid"] = v.(string)
+	}
+	if err := client.ProcessDmsEnterpriseRequest("DeleteInstance", params, nil); err != nil {
+		if IsExceptedError(err, DmsEnterpriseInstanceNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteInstance got an error")
+	}
+
+	return nil
+}
+
+func buildDmsEnterpriseInstanceParams(d *schema.ResourceData) map[string]string {
+	params := map[string]string{
+		"InstanceType":     d.Get("instance_type").(string),
+		"InstanceSource":   d.Get("instance_source").(string),
+		"NetworkType":      d.Get("network_type").(string),
+		"EnvType":          d.Get("env_type").(string),
+		"Host":             d.Get("host").(string),
+		"Port":             strconv.Itoa(d.Get("port").(int)),
+		"DatabaseUser":     d.Get("database_user").(string),
+		"DatabasePassword": d.Get("database_password").(string),
+		"InstanceAlias":    d.Get("instance_alias").(string),
+		"DbaUid":           d.Get("dba_uid").(string),
+		"SafeRule":         d.Get("safe_rule").(string),
+		"QueryTimeout":     strconv.Itoa(d.Get("query_timeout").(int)),
+		"ExportTimeout":    strconv.Itoa(d.Get("export_timeout").(int)),
+		"DdlOnline":        strconv.Itoa(d.Get("ddl_online").(int)),
+		"UseDsql":          strconv.Itoa(d.Get("use_dsql").(int)),
+	}
+
+	for key, param := range map[string]string{
+		"tid":             "Tid",
+		"sid":             "Sid",
+		"ecs_instance_id": "EcsInstanceId",
+		"ecs_region":      "EcsRegion",
+		"vpc_id":          "VpcId",
+		"data_link_name":  "DataLinkName",
+	} {
+		if v, ok := d.GetOk(key); ok && v.(string) != "" {
+			params[param] = v.(string)
+		}
+	}
+
+	return params
+}
+
+func parseDmsEnterpriseInstanceId(id string) (string, int, error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+	if len(parts) != 2 {
+		return "", 0, fmt.Errorf("Invalid DMS Enterprise instance id %s. Expected format is <host>:<port>.", id)
+	}
+	port, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, WrapErrorf(err, "Invalid DMS Enterprise instance port %s got an error", parts[1])
+	}
+	return parts[0], port, nil
+}
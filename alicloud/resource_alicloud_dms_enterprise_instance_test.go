@@ -0,0 +1,153 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDmsEnterpriseInstance_basic(t *testing.T) {
+	var instance DmsEnterpriseInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWithDmsEnterpriseUser(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dms_enterprise_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDmsEnterpriseInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDmsEnterpriseInstanceConfig(os.Getenv("ALICLOUD_DMS_USER_UID"), 70),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsEnterpriseInstanceExists("alicloud_dms_enterprise_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_dms_enterprise_instance.foo", "instance_alias", "tf-testacc-dms-instance"),
+					resource.TestCheckResourceAttr("alicloud_dms_enterprise_instance.foo", "query_timeout", "70"),
+					resource.TestCheckResourceAttrSet("alicloud_dms_enterprise_instance.foo", "instance_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDmsEnterpriseInstanceConfig(os.Getenv("ALICLOUD_DMS_USER_UID"), 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsEnterpriseInstanceExists("alicloud_dms_enterprise_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_dms_enterprise_instance.foo", "query_timeout", "80"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDmsEnterpriseInstanceExists(n string, instance *DmsEnterpriseInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DMS Enterprise instance ID is set")
+		}
+
+		host, port, err := parseDmsEnterpriseInstanceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := testAccProvider.Meta().(*AliyunClient).DescribeDmsEnterpriseInstance(host, port)
+		if err != nil {
+			return err
+		}
+
+		*instance = resp
+		return nil
+	}
+}
+
+func testAccCheckDmsEnterpriseInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_dms_enterprise_instance" {
+			continue
+		}
+
+		host, port, err := parseDmsEnterpriseInstanceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeDmsEnterpriseInstance(host, port); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("DMS Enterprise instance %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDmsEnterpriseInstanceConfig(uid string, queryTimeout int) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {
+  "available_resource_creation" = "Rds"
+}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testacc-dms-instance"
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_db_instance" "foo" {
+  engine           = "MySQL"
+  engine_version   = "5.6"
+  instance_type    = "rds.mysql.t1.small"
+  instance_storage = "10"
+  vswitch_id       = "${alicloud_vswitch.foo.id}"
+}
+
+resource "alicloud_db_account" "foo" {
+  instance_id = "${alicloud_db_instance.foo.id}"
+  name        = "tf_dms"
+  password    = "Test12345"
+}
+
+resource "alicloud_dms_enterprise_user" "dba" {
+  uid        = "%s"
+  role_names = ["DBA"]
+}
+
+resource "alicloud_dms_enterprise_instance" "foo" {
+  instance_type     = "mysql"
+  instance_source   = "RDS"
+  network_type      = "VPC"
+  env_type          = "test"
+  host              = "${alicloud_db_instance.foo.connection_string}"
+  port              = "${alicloud_db_instance.foo.port}"
+  database_user     = "${alicloud_db_account.foo.name}"
+  database_password = "${alicloud_db_account.foo.password}"
+  instance_alias    = "tf-testacc-dms-instance"
+  dba_uid           = "${alicloud_dms_enterprise_user.dba.uid}"
+  safe_rule         = "自由操作"
+  query_timeout     = %d
+  export_timeout    = 600
+  ecs_instance_id   = "${alicloud_db_instance.foo.id}"
+  vpc_id            = "${alicloud_vpc.foo.id}"
+}
+`, uid, queryTimeout)
+}
@@ -0,0 +1,180 @@
+package alicloud
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDmsEnterpriseUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDmsEnterpriseUserCreate,
+		Read:   resourceAlicloudDmsEnterpriseUserRead,
+		Update: resourceAlicloudDmsEnterpriseUserUpdate,
+		Delete: resourceAlicloudDmsEnterpriseUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"uid": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tid": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"mobile": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_names": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAllowedStringValue([]string{"USER", "DBA", "ADMIN", "SECURITY_ADMIN"}),
+				},
+				Set: schema.HashString,
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(DmsUserNormal),
+				ValidateFunc: validateAllowedStringValue([]string{string(DmsUserNormal), string(DmsUserDisable)}),
+			},
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDmsEnterpriseUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	uid := d.Get("uid").(string)
+	if err := client.ProcessDmsEnterpriseRequest("RegisterUser", buildDmsEnterpriseUserParams(d), nil); err != nil {
+		return WrapErrorf(err, "RegisterUser got an error")
+	}
+
+	d.SetId(uid)
+
+	if DmsUserStatus(d.Get("status").(string)) == DmsUserDisable {
+		if err := switchDmsEnterpriseUserStatus(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudDmsEnterpriseUserRead(d, meta)
+}
+
+func resourceAlicloudDmsEnterpriseUserRead(d *schema.ResourceData, meta interface{}) error {
+	user, err := meta.(*AliyunClient).DescribeDmsEnterpriseUser(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, "Describe DMS Enterprise user got an error")
+	}
+
+	d.Set("uid", user.Uid)
+	d.Set("user_name", user.NickName)
+	d.Set("mobile", user.Mobile)
+	d.Set("role_names", user.RoleNames.RoleNames)
+	d.Set("status", user.State)
+	d.Set("user_id", user.UserId)
+
+	return nil
+}
+
+func resourceAlicloudDmsEnterpriseUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	if d.HasChange("user_name") || d.HasChange("mobile") || d.HasChange("role_names") {
+		if err := client.ProcessDmsEnterpriseRequest("UpdateUser", buildDmsEnterpriseUserParams(d), nil); err != nil {
+			return WrapErrorf(err, "UpdateUser got an error")
+		}
+		d.SetPartial("user_name")
+		d.SetPartial("mobile")
+		d.SetPartial("role_names")
+	}
+
+	if d.HasChange("status") {
+		if err := switchDmsEnterpriseUserStatus(d, meta); err != nil {
+			return err
+		}
+		d.SetPartial("status")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudDmsEnterpriseUserRead(d, meta)
+}
+
+func resourceAlicloudDmsEnterpriseUserDelete(d *schema.ResourceData, meta interface{}) error {
+	params := map[string]string{
+		"Uid": d.Id(),
+	}
+	if v, ok := d.GetOk("tid"); ok {
+		params["Tid"] = v.(string)
+	}
+
+	if err := meta.(*AliyunClient).ProcessDmsEnterpriseRequest("DeleteUser", params, nil); err != nil {
+		if IsExceptedError(err, DmsEnterpriseUserNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteUser got an error")
+	}
+
+	return nil
+}
+
+func buildDmsEnterpriseUserParams(d *schema.ResourceData) map[string]string {
+	params := map[string]string{
+		"Uid": d.Get("uid").(string),
+	}
+	if v, ok := d.GetOk("tid"); ok {
+		params["Tid"] = v.(string)
+	}
+	if v, ok := d.GetOk("user_name"); ok {
+		params["UserNick"] = v.(string)
+	}
+	if v, ok := d.GetOk("mobile"); ok {
+		params["Mobile"] = v.(string)
+	}
+	if v, ok := d.GetOk("role_names"); ok {
+		params["RoleNames"] = strings.Join(expandStringList(v.(*schema.Set).List()), ",")
+	}
+	return params
+}
+
+func switchDmsEnterpriseUserStatus(d *schema.ResourceData, meta interface{}) error {
+	action := "EnableUser"
+	if DmsUserStatus(d.Get("status").(string)) == DmsUserDisable {
+		action = "DisableUser"
+	}
+
+	params := map[string]string{
+		"Uid": d.Id(),
+	}
+	if v, ok := d.GetOk("tid"); ok {
+		params["Tid"] = v.(string)
+	}
+	if err := meta.(*AliyunClient).ProcessDmsEnterpriseRequest(action, params, nil); err != nil {
+		return WrapErrorf(err, "%s got an error", action)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDmsEnterpriseUser_basic(t *testing.T) {
+	var user DmsEnterpriseUser
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWithDmsEnterpriseUser(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_dms_enterprise_user.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDmsEnterpriseUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDmsEnterpriseUserConfig(os.Getenv("ALICLOUD_DMS_USER_UID"), "NORMAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsEnterpriseUserExists("alicloud_dms_enterprise_user.foo", &user),
+					resource.TestCheckResourceAttr("alicloud_dms_enterprise_user.foo", "user_name", "tf-testacc-dms-user"),
+					resource.TestCheckResourceAttr("alicloud_dms_enterprise_user.foo", "role_names.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_dms_enterprise_user.foo", "status", "NORMAL"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDmsEnterpriseUserConfig(os.Getenv("ALICLOUD_DMS_USER_UID"), "DISABLE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsEnterpriseUserExists("alicloud_dms_enterprise_user.foo", &user),
+					resource.TestCheckResourceAttr("alicloud_dms_enterprise_user.foo", "status", "DISABLE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckWithDmsEnterpriseUser(t *testing.T) {
+	if v := os.Getenv("ALICLOUD_DMS_USER_UID"); v == "" {
+		t.Fatal("ALICLOUD_DMS_USER_UID must be set for DMS Enterprise acceptance tests")
+	}
+}
+
+func testAccCheckDmsEnterpriseUserExists(n string, user *DmsEnterpriseUser) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DMS Enterprise user ID is set")
+		}
+
+		resp, err := testAccProvider.Meta().(*AliyunClient).DescribeDmsEnterpriseUser(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*user = resp
+		return nil
+	}
+}
+
+func testAccCheckDmsEnterpriseUserDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_dms_enterprise_user" {
+			continue
+		}
+
+		if _, err := client.DescribeDmsEnterpriseUser(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("DMS Enterprise user %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDmsEnterpriseUserConfig(uid, status string) string {
+	return fmt.Sprintf(`
+resource "alicloud_dms_enterprise_user" "foo" {
+  uid        = "%s"
+  user_name  = "tf-testacc-dms-user"
+  role_names = ["DBA"]
+  status     = "%s"
+}
+`, uid, status)
+}
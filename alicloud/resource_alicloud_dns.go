@@ -1,12 +1,9 @@
 package alicloud
 
 import (
-	"fmt"
 	"time"
 
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/dns"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -42,7 +39,7 @@ func resourceAlicloudDns() *schema.Resource {
 }
 
 func resourceAlicloudDnsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.AddDomainArgs{
 		DomainName: d.Get("name").(string),
@@ -50,7 +47,7 @@ func resourceAlicloudDnsCreate(d *schema.ResourceData, meta interface{}) error {
 
 	response, err := conn.AddDomain(args)
 	if err != nil {
-		return fmt.Errorf("AddDomain got an error: %#v", err)
+		return WrapErrorf(err, "AddDomain got an error")
 	}
 
 	d.SetId(response.DomainName)
@@ -58,7 +55,7 @@ func resourceAlicloudDnsCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAlicloudDnsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	d.Partial(true)
 
@@ -72,7 +69,7 @@ func resourceAlicloudDnsUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, err := conn.ChangeDomainGroup(args)
 		if err != nil {
-			return fmt.Errorf("ChangeDomainGroup got an error: %#v", err)
+			return WrapErrorf(err, "ChangeDomainGroup got an error")
 		}
 	}
 
@@ -81,7 +78,7 @@ func resourceAlicloudDnsUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAlicloudDnsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainInfoArgs{
 		DomainName: d.Id(),
@@ -93,7 +90,7 @@ func resourceAlicloudDnsRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("DescribeDomainInfo got an error: %#v", err)
+		return WrapErrorf(err, "DescribeDomainInfo got an error")
 	}
 
 	d.Set("group_id", domain.GroupId)
@@ -103,21 +100,18 @@ func resourceAlicloudDnsRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAlicloudDnsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DeleteDomainArgs{
 		DomainName: d.Id(),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := RetryOnError(DnsCode, 5*time.Minute, func() error {
 		_, err := conn.DeleteDomain(args)
-		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == RecordForbiddenDNSChange {
-				return resource.RetryableError(fmt.Errorf("Operation forbidden because DNS is changing - trying again after change complete."))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting domain %s: %#v", d.Id(), err))
-		}
-		return nil
+		return err
 	})
+	if err != nil {
+		return WrapErrorf(err, "Error deleting domain %s", d.Id())
+	}
+	return nil
 }
@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/dns"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -16,6 +14,9 @@ func resourceAlicloudDnsGroup() *schema.Resource {
 		Read:   resourceAlicloudDnsGroupRead,
 		Update: resourceAlicloudDnsGroupUpdate,
 		Delete: resourceAlicloudDnsGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -27,14 +28,14 @@ func resourceAlicloudDnsGroup() *schema.Resource {
 }
 
 func resourceAlicloudDnsGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 	args := &dns.AddDomainGroupArgs{
 		GroupName: d.Get("name").(string),
 	}
 
 	response, err := conn.AddDomainGroup(args)
 	if err != nil {
-		return fmt.Errorf("AddDomainGroup got a error: %#v", err)
+		return WrapErrorf(err, "AddDomainGroup got a error")
 	}
 
 	d.SetId(response.GroupId)
@@ -43,7 +44,7 @@ func resourceAlicloudDnsGroupCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAlicloudDnsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	d.Partial(true)
 	args := &dns.UpdateDomainGroupArgs{
@@ -54,7 +55,7 @@ func resourceAlicloudDnsGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		d.SetPartial("name")
 		args.GroupName = d.Get("name").(string)
 		if _, err := conn.UpdateDomainGroup(args); err != nil {
-			return fmt.Errorf("UpdateDomainGroup got an error: %#v", err)
+			return WrapErrorf(err, "UpdateDomainGroup got an error")
 		}
 	}
 
@@ -63,7 +64,7 @@ func resourceAlicloudDnsGroupUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAlicloudDnsGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainGroupsArgs{
 		KeyWord: d.Get("name").(string),
@@ -78,7 +79,7 @@ func resourceAlicloudDnsGroupRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("No domain groups found.")
 	}
 	for _, v := range groups {
-		if v.GroupName == d.Get("name").(string) {
+		if v.GroupId == d.Id() {
 			d.Set("name", v.GroupName)
 			return nil
 		}
@@ -89,21 +90,18 @@ func resourceAlicloudDnsGroupRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAlicloudDnsGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DeleteDomainGroupArgs{
 		GroupId: d.Id(),
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := RetryOnError(DnsCode, 5*time.Minute, func() error {
 		_, err := conn.DeleteDomainGroup(args)
-		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == FobiddenNotEmptyGroup {
-				return resource.RetryableError(fmt.Errorf("The domain group can’t be deleted because it is not empty - trying again after it empty."))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting group %s: %#v", d.Id(), err))
-		}
-		return nil
+		return err
 	})
+	if err != nil {
+		return WrapErrorf(err, "Error deleting group %s", d.Id())
+	}
+	return nil
 }
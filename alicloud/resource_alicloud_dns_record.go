@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/dns"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -69,7 +67,7 @@ func resourceAlicloudDnsRecord() *schema.Resource {
 }
 
 func resourceAlicloudDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.AddDomainRecordArgs{
 		DomainName: d.Get("name").(string),
@@ -89,14 +87,14 @@ func resourceAlicloudDnsRecordCreate(d *schema.ResourceData, meta interface{}) e
 
 	response, err := conn.AddDomainRecord(args)
 	if err != nil {
-		return fmt.Errorf("AddDomainRecord got a error: %#v", err)
+		return WrapErrorf(err, "AddDomainRecord got a error")
 	}
 	d.SetId(response.RecordId)
 	return resourceAlicloudDnsRecordUpdate(d, meta)
 }
 
 func resourceAlicloudDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	d.Partial(true)
 	attributeUpdate := false
@@ -136,7 +134,7 @@ func resourceAlicloudDnsRecordUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if attributeUpdate {
 		if _, err := conn.UpdateDomainRecord(args); err != nil {
-			return fmt.Errorf("UpdateDomainRecord got an error: %#v", err)
+			return WrapErrorf(err, "UpdateDomainRecord got an error")
 		}
 	}
 
@@ -146,7 +144,7 @@ func resourceAlicloudDnsRecordUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 
 	args := &dns.DescribeDomainRecordInfoNewArgs{
 		RecordId: d.Id(),
@@ -175,34 +173,19 @@ func resourceAlicloudDnsRecordRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).dnsconn
+	conn := meta.(*AliyunClient).dnsconn()
 	args := &dns.DeleteDomainRecordArgs{
 		RecordId: d.Id(),
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := RetryOnError(DnsCode, 5*time.Minute, func() error {
 		_, err := conn.DeleteDomainRecord(args)
-		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code == RecordForbiddenDNSChange {
-				return resource.RetryableError(fmt.Errorf("Operation forbidden because DNS is changing - trying again after change complete."))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting domain record %s: %#v", d.Id(), err))
-		}
-
-		response, err := conn.DescribeDomainRecordInfoNew(&dns.DescribeDomainRecordInfoNewArgs{
-			RecordId: d.Id(),
-		})
-		if err != nil {
-			if NotFoundError(err) || IsExceptedError(err, DomainRecordNotBelongToUser) {
-				return nil
-			}
-			return resource.NonRetryableError(fmt.Errorf("Describe domain record got an error: %#v.", err))
-		}
-
-		if response == nil {
+		return err
+	})
+	if err != nil {
+		if NotFoundError(err) || IsExceptedError(err, DomainRecordNotBelongToUser) {
 			return nil
 		}
-
-		return nil
-	})
+		return WrapErrorf(err, "Error deleting domain record %s", d.Id())
+	}
+	return nil
 }
@@ -0,0 +1,108 @@
+package alicloud
+
+import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEcsDeploymentSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEcsDeploymentSetCreate,
+		Read:   resourceAlicloudEcsDeploymentSetRead,
+		Update: resourceAlicloudEcsDeploymentSetUpdate,
+		Delete: resourceAlicloudEcsDeploymentSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"strategy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  DeploymentSetAvailability,
+				ValidateFunc: validateAllowedStringValue([]string{DeploymentSetAvailability,
+					DeploymentSetAvailabilityGroup, DeploymentSetLowLatency}),
+			},
+		},
+	}
+}
+
+func resourceAlicloudEcsDeploymentSetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	request := ecs.CreateCreateDeploymentSetRequest()
+	request.RegionId = string(client.Region)
+	request.DeploymentSetName = d.Get("name").(string)
+	request.Description = d.Get("description").(string)
+	request.Strategy = d.Get("strategy").(string)
+	request.ClientToken = buildClientToken("TF-CreateDeploymentSet")
+
+	response := ecs.CreateCreateDeploymentSetResponse()
+	if err := client.doAction(client.ecsconn(), request, response); err != nil {
+		return WrapApiError(err, request.GetActionName(), "")
+	}
+
+	d.SetId(response.DeploymentSetId)
+
+	return resourceAlicloudEcsDeploymentSetRead(d, meta)
+}
+
+func resourceAlicloudEcsDeploymentSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	set, err := client.DescribeEcsDeploymentSet(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", set.DeploymentSetName)
+	d.Set("description", set.DeploymentSetDescription)
+	d.Set("strategy", set.DeploymentStrategy)
+
+	return nil
+}
+
+func resourceAlicloudEcsDeploymentSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		request := ecs.CreateModifyDeploymentSetAttributeRequest()
+		request.RegionId = string(client.Region)
+		request.DeploymentSetId = d.Id()
+		request.DeploymentSetName = d.Get("name").(string)
+		request.Description = d.Get("description").(string)
+		if err := client.doAction(client.ecsconn(), request, ecs.CreateModifyDeploymentSetAttributeResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
+		}
+	}
+
+	return resourceAlicloudEcsDeploymentSetRead(d, meta)
+}
+
+func resourceAlicloudEcsDeploymentSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	request := ecs.CreateDeleteDeploymentSetRequest()
+	request.RegionId = string(client.Region)
+	request.DeploymentSetId = d.Id()
+	if err := client.doAction(client.ecsconn(), request, ecs.CreateDeleteDeploymentSetResponse()); err != nil {
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
+
+	return nil
+}
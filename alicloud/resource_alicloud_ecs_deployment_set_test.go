@@ -0,0 +1,98 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEcsDeploymentSet_basic(t *testing.T) {
+	var set ecs.DeploymentSet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ecs_deployment_set.default",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckEcsDeploymentSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEcsDeploymentSetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsDeploymentSetExists("alicloud_ecs_deployment_set.default", &set),
+					resource.TestCheckResourceAttr("alicloud_ecs_deployment_set.default", "name", "tf-test-deployment-set"),
+					resource.TestCheckResourceAttr("alicloud_ecs_deployment_set.default", "strategy", DeploymentSetAvailability),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEcsDeploymentSetConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsDeploymentSetExists("alicloud_ecs_deployment_set.default", &set),
+					resource.TestCheckResourceAttr("alicloud_ecs_deployment_set.default", "name", "tf-test-deployment-set-update"),
+					resource.TestCheckResourceAttr("alicloud_ecs_deployment_set.default", "description", "update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEcsDeploymentSetExists(n string, set *ecs.DeploymentSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Deployment Set ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		v, err := client.DescribeEcsDeploymentSet(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*set = v
+		return nil
+	}
+}
+
+func testAccCheckEcsDeploymentSetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ecs_deployment_set" {
+			continue
+		}
+
+		if _, err := client.DescribeEcsDeploymentSet(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Deployment Set %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccEcsDeploymentSetConfig = `
+resource "alicloud_ecs_deployment_set" "default" {
+	name = "tf-test-deployment-set"
+}
+`
+
+const testAccEcsDeploymentSetConfigUpdate = `
+resource "alicloud_ecs_deployment_set" "default" {
+	name = "tf-test-deployment-set-update"
+	description = "update"
+}
+`
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -33,6 +34,23 @@ func resourceAliyunEip() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateInternetChargeType,
 			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Default:      string(PostPaid),
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(PrePaid), string(PostPaid)}),
+			},
+			"period": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateFunc:     validateInstanceChargeTypePeriod,
+				DiffSuppressFunc: prePaidDiffSuppressFunc,
+			},
+			"renewal_status":    renewalStatusSchema(),
+			"auto_renew_period": autoRenewPeriodSchema(),
 
 			"ip_address": &schema.Schema{
 				Type:     schema.TypeString,
@@ -60,18 +78,33 @@ func resourceAliyunEipCreate(d *schema.ResourceData, meta interface{}) error {
 	request.RegionId = string(getRegion(d, meta))
 	request.Bandwidth = strconv.Itoa(d.Get("bandwidth").(int))
 	request.InternetChargeType = d.Get("internet_charge_type").(string)
+	request.InstanceChargeType = d.Get("instance_charge_type").(string)
+	if PayType(request.InstanceChargeType) == PrePaid {
+		usedTime, unit := prepaidPeriod(d.Get("period").(int))
+		request.Period = requests.Integer(usedTime)
+		request.PricingCycle = unit
+		request.AutoPay = requests.NewBoolean(true)
+	}
+	request.ClientToken = buildClientToken("TF-AllocateEip")
 
-	eip, err := client.vpcconn.AllocateEipAddress(request)
-	if err != nil {
+	var eip *vpc.AllocateEipAddressResponse
+	if err := RetryOnError(VpcCode, 3*time.Minute, func() error {
+		resp := vpc.CreateAllocateEipAddressResponse()
+		err := client.doAction(client.vpcconn, request, resp)
+		if err != nil {
+			return err
+		}
+		eip = resp
+		return nil
+	}); err != nil {
 		if IsExceptedError(err, COMMODITYINVALID_COMPONENT) && request.InternetChargeType == string(PayByBandwidth) {
 			return fmt.Errorf("Your account is international and it can only create '%s' elastic IP. Please change it and try again.", PayByTraffic)
 		}
-		return err
+		return WrapApiError(err, request.GetActionName(), "")
 	}
 
-	err = client.WaitForEip(eip.AllocationId, Available, 60)
-	if err != nil {
-		return fmt.Errorf("Error Waitting for EIP available: %#v", err)
+	if err := client.WaitForEip(eip.AllocationId, Available, 60); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForEip %s got an error", Available), "", eip.AllocationId)
 	}
 
 	d.SetId(eip.AllocationId)
@@ -88,7 +121,7 @@ func resourceAliyunEipRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe Eip Attribute: %#v", err)
+		return WrapApiError(err, "DescribeEipAddresses", d.Id())
 	}
 
 	// Output parameter 'instance' would be deprecated in the next version.
@@ -101,6 +134,10 @@ func resourceAliyunEipRead(d *schema.ResourceData, meta interface{}) error {
 	bandwidth, _ := strconv.Atoi(eip.Bandwidth)
 	d.Set("bandwidth", bandwidth)
 	d.Set("internet_charge_type", eip.InternetChargeType)
+	d.Set("instance_charge_type", eip.ChargeType)
+	if err := readRenewal(client, BssProductEip, d); err != nil {
+		return err
+	}
 	d.Set("ip_address", eip.IpAddress)
 	d.Set("status", eip.Status)
 
@@ -108,6 +145,7 @@ func resourceAliyunEipRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAliyunEipUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
 
 	d.Partial(true)
 
@@ -115,13 +153,17 @@ func resourceAliyunEipUpdate(d *schema.ResourceData, meta interface{}) error {
 		request := vpc.CreateModifyEipAddressAttributeRequest()
 		request.AllocationId = d.Id()
 		request.Bandwidth = strconv.Itoa(d.Get("bandwidth").(int))
-		if _, err := meta.(*AliyunClient).vpcconn.ModifyEipAddressAttribute(request); err != nil {
-			return err
+		if err := client.doAction(client.vpcconn, request, vpc.CreateModifyEipAddressAttributeResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 
 		d.SetPartial("bandwidth")
 	}
 
+	if err := setRenewal(meta.(*AliyunClient), BssProductEip, d); err != nil {
+		return err
+	}
+
 	d.Partial(false)
 
 	return resourceAliyunEipRead(d, meta)
@@ -130,25 +172,27 @@ func resourceAliyunEipUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceAliyunEipDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		return fmt.Errorf("At present, 'PrePaid' EIP cannot be released and must wait it to be expired and release it automatically.")
+	}
+
 	request := vpc.CreateReleaseEipAddressRequest()
 	request.AllocationId = d.Id()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := client.vpcconn.ReleaseEipAddress(request); err != nil {
-			if IsExceptedError(err, EipIncorrectStatus) {
-				return resource.RetryableError(fmt.Errorf("Delete EIP timeout and got an error:%#v.", err))
-			}
-			return resource.NonRetryableError(err)
-
-		}
+	if err := RetryOnError(VpcCode, 5*time.Minute, func() error {
+		return client.doAction(client.vpcconn, request, vpc.CreateReleaseEipAddressResponse())
+	}); err != nil {
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
 
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		eip, descErr := client.DescribeEipAddress(d.Id())
 
 		if descErr != nil {
 			if NotFoundError(descErr) {
 				return nil
 			}
-			return resource.NonRetryableError(descErr)
+			return resource.NonRetryableError(WrapApiError(descErr, "DescribeEipAddresses", d.Id()))
 		} else if eip.AllocationId == d.Id() {
 			return resource.RetryableError(fmt.Errorf("Delete EIP timeout and it still exists."))
 		}
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -15,6 +14,9 @@ func resourceAliyunEipAssociation() *schema.Resource {
 		Create: resourceAliyunEipAssociationCreate,
 		Read:   resourceAliyunEipAssociationRead,
 		Delete: resourceAliyunEipAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allocation_id": &schema.Schema{
@@ -30,6 +32,29 @@ func resourceAliyunEipAssociation() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+
+			"instance_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{EcsInstance, SlbInstance, Nat, HaVip, NetworkInterface}),
+			},
+
+			"private_ip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{EipAssociationModeNat, EipAssociationModeMultiBinded, EipAssociationModeBinded}),
+			},
 		},
 	}
 }
@@ -38,40 +63,34 @@ func resourceAliyunEipAssociationCreate(d *schema.ResourceData, meta interface{}
 
 	client := meta.(*AliyunClient)
 
-	args := vpc.CreateAssociateEipAddressRequest()
-	args.AllocationId = Trim(d.Get("allocation_id").(string))
-	args.InstanceId = Trim(d.Get("instance_id").(string))
-	args.InstanceType = EcsInstance
-
-	if strings.HasPrefix(args.InstanceId, "lb-") {
-		args.InstanceType = SlbInstance
+	allocationId := Trim(d.Get("allocation_id").(string))
+	instanceId := Trim(d.Get("instance_id").(string))
+	instanceType := d.Get("instance_type").(string)
+	if instanceType == "" {
+		instanceType = eipAssociationInstanceType(instanceId)
 	}
-	if strings.HasPrefix(args.InstanceId, "ngw-") {
-		args.InstanceType = Nat
+	privateIpAddress := d.Get("private_ip_address").(string)
+	mode := d.Get("mode").(string)
+
+	if (privateIpAddress != "" || mode != "") && instanceType != NetworkInterface {
+		return fmt.Errorf("'private_ip_address' and 'mode' can only be set when 'instance_type' is %s.", NetworkInterface)
 	}
 
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		ar := args
-		if _, err := client.vpcconn.AssociateEipAddress(ar); err != nil {
-			if IsExceptedError(err, TaskConflict) {
-				return resource.RetryableError(fmt.Errorf("AssociateEip got an error: %#v", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("AssociateEip got an error: %#v", err))
-		}
-		return nil
+	if err := RetryOnError(VpcCode, 3*time.Minute, func() error {
+		return client.AssociateEip(allocationId, instanceId, instanceType, privateIpAddress, mode)
 	}); err != nil {
-		return err
+		return WrapApiError(err, "AssociateEipAddress", allocationId)
 	}
 
-	if err := client.WaitForEip(args.AllocationId, InUse, 60); err != nil {
-		return fmt.Errorf("Error Waitting for EIP allocated: %#v", err)
+	if err := client.WaitForEip(allocationId, InUse, 60); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForEip %s got an error", InUse), "", allocationId)
 	}
 	// There is at least 30 seconds delay for ecs instance
-	if args.InstanceType == EcsInstance {
+	if instanceType == EcsInstance {
 		time.Sleep(30 * time.Second)
 	}
 
-	d.SetId(args.AllocationId + ":" + args.InstanceId)
+	d.SetId(allocationId + ":" + instanceId)
 
 	return resourceAliyunEipAssociationRead(d, meta)
 }
@@ -84,23 +103,26 @@ func resourceAliyunEipAssociationRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	eip, err := client.DescribeEipAddress(allocationId)
+	association, err := client.DescribeEipAssociation(allocationId)
 
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe Eip Attribute: %#v", err)
+		return WrapApiError(err, "DescribeEipAddresses", d.Id())
 	}
 
-	if eip.InstanceId != instanceId {
+	if association.InstanceId != instanceId {
 		d.SetId("")
 		return nil
 	}
 
-	d.Set("instance_id", eip.InstanceId)
+	d.Set("instance_id", association.InstanceId)
 	d.Set("allocation_id", allocationId)
+	d.Set("instance_type", association.InstanceType)
+	d.Set("private_ip_address", association.PrivateIpAddress)
+	d.Set("mode", association.Mode)
 	return nil
 }
 
@@ -113,42 +135,52 @@ func resourceAliyunEipAssociationDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	request := vpc.CreateUnassociateEipAddressRequest()
-	request.AllocationId = allocationId
-	request.InstanceId = instanceId
-	request.InstanceType = EcsInstance
-
-	if strings.HasPrefix(instanceId, "lb-") {
-		request.InstanceType = SlbInstance
+	instanceType := d.Get("instance_type").(string)
+	if instanceType == "" {
+		instanceType = eipAssociationInstanceType(instanceId)
 	}
-	if strings.HasPrefix(instanceId, "ngw-") {
-		request.InstanceType = Nat
+	privateIpAddress := d.Get("private_ip_address").(string)
+
+	err = RetryOnError(VpcCode, 3*time.Minute, func() error {
+		return client.UnassociateEip(allocationId, instanceId, instanceType, privateIpAddress)
+	})
+	if err != nil && !NotFoundError(err) {
+		return WrapApiError(err, "UnassociateEipAddress", d.Id())
 	}
-	return resource.Retry(3*time.Minute, func() *resource.RetryError {
-		if _, err := client.vpcconn.UnassociateEipAddress(request); err != nil {
-			if IsExceptedError(err, InstanceIncorrectStatus) ||
-				IsExceptedError(err, HaVipIncorrectStatus) ||
-				IsExceptedError(err, TaskConflict) {
-				return resource.RetryableError(fmt.Errorf("Unassociate EIP timeout and got an error:%#v.", err))
-			}
-		}
 
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
 		eip, descErr := client.DescribeEipAddress(allocationId)
 		if descErr != nil {
-			if NotFoundError(err) {
+			if NotFoundError(descErr) {
 				return nil
 			}
-			return resource.NonRetryableError(descErr)
+			return resource.NonRetryableError(WrapApiError(descErr, "DescribeEipAddresses", d.Id()))
 		}
 
 		if eip.InstanceId == instanceId {
-			return resource.RetryableError(fmt.Errorf("Unassociate EIP timeout and got an error:%#v.", err))
+			return resource.RetryableError(fmt.Errorf("Unassociate EIP %s timeout.", allocationId))
 		}
 
 		return nil
 	})
 }
 
+// eipAssociationInstanceType infers the type of the instance from the prefix of its id, and the
+// instance without a known prefix is regarded as an ECS instance.
+func eipAssociationInstanceType(instanceId string) string {
+	switch {
+	case strings.HasPrefix(instanceId, "lb-"):
+		return SlbInstance
+	case strings.HasPrefix(instanceId, "ngw-"):
+		return Nat
+	case strings.HasPrefix(instanceId, "havip-"):
+		return HaVip
+	case strings.HasPrefix(instanceId, "eni-"):
+		return NetworkInterface
+	}
+	return EcsInstance
+}
+
 func getAllocationIdAndInstanceId(d *schema.ResourceData, meta interface{}) (string, string, error) {
 	parts := strings.Split(d.Id(), ":")
 
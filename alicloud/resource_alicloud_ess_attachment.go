@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -58,13 +59,13 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("instance_ids") {
 		group, err := client.DescribeScalingGroupById(groupId)
 		if err != nil {
-			return fmt.Errorf("DescribeScalingGroupById %s error: %#v", groupId, err)
+			return WrapApiError(err, "DescribeScalingGroups", groupId)
 		}
 		if group.LifecycleState == ess.Inacitve {
 			return fmt.Errorf("Scaling group current status is %s, please active it before attaching or removing ECS instances.", group.LifecycleState)
 		} else {
-			if err := client.essconn.WaitForScalingGroup(getRegion(d, meta), group.ScalingGroupId, ess.Active, DefaultTimeout); err != nil {
-				return fmt.Errorf("WaitForScalingGroup is %#v got an error: %#v.", ess.Active, err)
+			if err := client.essconn().WaitForScalingGroup(getRegion(d, meta), group.ScalingGroupId, ess.Active, DefaultTimeout); err != nil {
+				return WrapApiError(WrapErrorf(err, "WaitForScalingGroup %s got an error", ess.Active), "", groupId)
 			}
 		}
 		o, n := d.GetChange("instance_ids")
@@ -77,17 +78,17 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 
 			if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 
-				if _, err := client.essconn.AttachInstances(&ess.AttachInstancesArgs{
+				if _, err := client.essconn().AttachInstances(&ess.AttachInstancesArgs{
 					ScalingGroupId: groupId,
 					InstanceId:     convertArrayInterfaceToArrayString(add),
 				}); err != nil {
 					if IsExceptedError(err, IncorrectCapacityMaxSize) {
-						instances, _, err := client.essconn.DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
+						instances, _, err := client.essconn().DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
 							RegionId:       getRegion(d, meta),
 							ScalingGroupId: d.Id(),
 						})
 						if err != nil {
-							return resource.NonRetryableError(fmt.Errorf("DescribeScalingInstances got an error: %#v", err))
+							return resource.NonRetryableError(WrapApiError(err, "DescribeScalingInstances", groupId))
 						}
 						var autoAdded, attached []string
 						if len(instances) > 0 {
@@ -107,10 +108,9 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 						if len(autoAdded) > 0 {
 							if d.Get("force").(bool) {
 								if err := client.EssRemoveInstances(groupId, autoAdded); err != nil {
-									return resource.NonRetryableError(err)
+									return resource.NonRetryableError(WrapApiError(err, "RemoveInstances", groupId))
 								}
-								time.Sleep(5)
-								return resource.RetryableError(fmt.Errorf("Autocreated result in attaching instances got an error: %#v", err))
+								return resource.RetryableError(fmt.Errorf("Attaching instances to scaling group %s after removing the autocreated ones.", groupId))
 							} else {
 								return resource.NonRetryableError(fmt.Errorf("To attach the instances, the total capacity will be greater than the scaling group max size %d."+
 									"Please enlarge scaling group max size or set 'force' to true to remove autocreated instances: %#v.", group.MaxSize, autoAdded))
@@ -123,10 +123,9 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 						}
 					}
 					if IsExceptedError(err, ScalingActivityInProgress) {
-						time.Sleep(5)
-						return resource.RetryableError(fmt.Errorf("Progress results in Attaching instances got an error: %#v", err))
+						return resource.RetryableError(WrapApiError(err, "AttachInstances", groupId))
 					}
-					return resource.NonRetryableError(fmt.Errorf("Attaching instances got an error: %#v", err))
+					return resource.NonRetryableError(WrapApiError(err, "AttachInstances", groupId))
 				}
 				return nil
 			}); err != nil {
@@ -135,13 +134,13 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 
 			if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 
-				instances, _, err := client.essconn.DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
+				instances, _, err := client.essconn().DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
 					RegionId:       getRegion(d, meta),
 					ScalingGroupId: d.Id(),
 					InstanceId:     convertArrayInterfaceToArrayString(add),
 				})
 				if err != nil {
-					return resource.NonRetryableError(err)
+					return resource.NonRetryableError(WrapApiError(err, "DescribeScalingInstances", groupId))
 				}
 				if len(instances) < 0 {
 					return resource.RetryableError(fmt.Errorf("There are no ECS instances have been attached."))
@@ -159,7 +158,7 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 		}
 		if len(remove) > 0 {
 			if err := client.EssRemoveInstances(groupId, convertArrayInterfaceToArrayString(remove)); err != nil {
-				return err
+				return WrapApiError(err, "RemoveInstances", groupId)
 			}
 		}
 
@@ -173,10 +172,16 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceAliyunEssAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 
-	instances, _, err := meta.(*AliyunClient).essconn.DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
-		RegionId:       getRegion(d, meta),
-		ScalingGroupId: d.Id(),
-		CreationType:   "Attached",
+	var instances []ess.ScalingInstanceItemType
+	err := describeAllPages(func(pagination common.Pagination) (*common.PaginationResult, error) {
+		items, result, err := meta.(*AliyunClient).essconn().DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
+			RegionId:       getRegion(d, meta),
+			ScalingGroupId: d.Id(),
+			CreationType:   "Attached",
+			Pagination:     pagination,
+		})
+		instances = append(instances, items...)
+		return result, err
 	})
 
 	if err != nil {
@@ -184,7 +189,7 @@ func resourceAliyunEssAttachmentRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe ESS scaling instances: %#v", err)
+		return WrapApiError(err, "DescribeScalingInstances", d.Id())
 	}
 
 	if len(instances) < 1 {
@@ -208,13 +213,16 @@ func resourceAliyunEssAttachmentDelete(d *schema.ResourceData, meta interface{})
 
 	group, err := client.DescribeScalingGroupById(d.Id())
 	if err != nil {
-		return fmt.Errorf("DescribeScalingGroupById %s error: %#v", d.Id(), err)
+		return WrapApiError(err, "DescribeScalingGroups", d.Id())
 	}
 	if group.LifecycleState != ess.Active {
 		return fmt.Errorf("Scaling group current status is %s, please active it before attaching or removing ECS instances.", group.LifecycleState)
 	}
 
-	return client.EssRemoveInstances(d.Id(), convertArrayInterfaceToArrayString(d.Get("instance_ids").(*schema.Set).List()))
+	if err := client.EssRemoveInstances(d.Id(), convertArrayInterfaceToArrayString(d.Get("instance_ids").(*schema.Set).List())); err != nil {
+		return WrapApiError(err, "RemoveInstances", d.Id())
+	}
+	return nil
 }
 
 func convertArrayInterfaceToArrayString(elm []interface{}) (arr []string) {
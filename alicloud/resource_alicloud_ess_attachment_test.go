@@ -58,7 +58,7 @@ func testAccCheckEssAttachmentExists(n string, d *ess.ScalingGroupItemType) reso
 			return fmt.Errorf("Scaling group not found")
 		}
 
-		instances, _, err := client.essconn.DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
+		instances, _, err := client.essconn().DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
 			RegionId:       client.Region,
 			ScalingGroupId: rs.Primary.ID,
 			CreationType:   "Attached",
@@ -97,7 +97,7 @@ func testAccCheckEssAttachmentDestroy(s *terraform.State) error {
 			return fmt.Errorf("Scaling group still existed.")
 		}
 
-		instances, _, err := client.essconn.DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
+		instances, _, err := client.essconn().DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
 			RegionId:       client.Region,
 			ScalingGroupId: rs.Primary.ID,
 			CreationType:   "Attached",
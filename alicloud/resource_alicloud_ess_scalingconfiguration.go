@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,6 +19,14 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 		Read:   resourceAliyunEssScalingConfigurationRead,
 		Update: resourceAliyunEssScalingConfigurationUpdate,
 		Delete: resourceAliyunEssScalingConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"active": &schema.Schema{
@@ -40,10 +49,42 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 				Required: true,
 			},
 			"instance_type": &schema.Schema{
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateInstanceType,
+				ConflictsWith: []string{"instance_types"},
+			},
+			"instance_types": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      10,
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: validateInstanceType},
+				ConflictsWith: []string{"instance_type"},
+			},
+			"spot_strategy": &schema.Schema{
 				Type:         schema.TypeString,
-				ForceNew:     true,
-				Required:     true,
-				ValidateFunc: validateInstanceType,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateInstanceSpotStrategy,
+			},
+			"spot_price_limit": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateInstanceType,
+						},
+						"price_limit": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
 			},
 			"io_optimized": &schema.Schema{
 				Type:       schema.TypeString,
@@ -185,25 +226,28 @@ func resourceAliyunEssScalingConfigurationCreate(d *schema.ResourceData, meta in
 	if err != nil {
 		return err
 	}
+	// The configuration is created with the first of the instance types, and all of them are set by the update.
+	if v, ok := d.GetOk("instance_types"); ok {
+		args.InstanceType = v.([]interface{})[0].(string)
+	} else if args.InstanceType == "" {
+		return fmt.Errorf("One of instance_type and instance_types must be set.")
+	}
 
-	if validData[IoOptimizedKey].(ecs.IoOptimized) == ecs.IoOptimizedOptimized {
+	if validData[IoOptimizedKey].(IoOptimizedType) == IoOptimizedOptimized {
 		args.IoOptimized = ecs.IoOptimizedOptimized
 	}
 
-	essconn := meta.(*AliyunClient).essconn
+	essconn := meta.(*AliyunClient).essconn()
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := RetryOnError(EssCode, d.Timeout(schema.TimeoutCreate), func() error {
 		scaling, err := essconn.CreateScalingConfiguration(args)
 		if err != nil {
-			if IsExceptedError(err, EssThrottling) || IsExceptedError(err, IncorrectScalingGroupStatus) {
-				return resource.RetryableError(fmt.Errorf("Error Create Scaling Configuration: %#v.", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error Create Scaling Configuration: %#v.", err))
+			return err
 		}
 		d.SetId(scaling.ScalingConfigurationId)
 		return nil
 	}); err != nil {
-		return err
+		return WrapApiError(err, "CreateScalingConfiguration", args.ScalingGroupId)
 	}
 
 	return resourceAliyunEssScalingConfigurationUpdate(d, meta)
@@ -223,7 +267,7 @@ func resourceAliyunEssScalingConfigurationUpdate(d *schema.ResourceData, meta in
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("Error Describe ESS scaling configuration Attribute: %#v", err)
+			return WrapApiError(err, "DescribeScalingConfigurations", d.Id())
 		}
 
 		active := d.Get("active").(bool)
@@ -233,7 +277,7 @@ func resourceAliyunEssScalingConfigurationUpdate(d *schema.ResourceData, meta in
 
 				err := client.ActiveScalingConfigurationById(c.ScalingGroupId, d.Id())
 				if err != nil {
-					return fmt.Errorf("Active scaling configuration %s err: %#v", d.Id(), err)
+					return WrapApiError(err, "ModifyScalingGroup", c.ScalingGroupId)
 				}
 			}
 		} else {
@@ -247,6 +291,30 @@ func resourceAliyunEssScalingConfigurationUpdate(d *schema.ResourceData, meta in
 		d.SetPartial("active")
 	}
 
+	// The instance types and the spot strategy are not supported by the aliyungo client.
+	if d.HasChange("instance_types") || d.HasChange("spot_strategy") || d.HasChange("spot_price_limit") {
+		params := map[string]string{
+			"ScalingConfigurationId": d.Id(),
+		}
+		for i, instanceType := range expandStringList(d.Get("instance_types").([]interface{})) {
+			params[fmt.Sprintf("InstanceTypes.%d", i+1)] = instanceType
+		}
+		if v := d.Get("spot_strategy").(string); v != "" {
+			params["SpotStrategy"] = v
+		}
+		for i, v := range d.Get("spot_price_limit").(*schema.Set).List() {
+			limit := v.(map[string]interface{})
+			params[fmt.Sprintf("SpotPriceLimit.%d.InstanceType", i+1)] = limit["instance_type"].(string)
+			params[fmt.Sprintf("SpotPriceLimit.%d.PriceLimit", i+1)] = strconv.FormatFloat(limit["price_limit"].(float64), 'f', -1, 64)
+		}
+		if err := client.ProcessRpcRequest(client.essEndpoint(), EssApiVersion, "ModifyScalingConfiguration", params, nil); err != nil {
+			return WrapApiError(err, "ModifyScalingConfiguration", d.Id())
+		}
+		for _, k := range []string{"instance_types", "spot_strategy", "spot_price_limit"} {
+			d.SetPartial(k)
+		}
+	}
+
 	if err := enableEssScalingConfiguration(d, meta); err != nil {
 		return err
 	}
@@ -263,21 +331,21 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 		sgId := d.Get("scaling_group_id").(string)
 		group, err := client.DescribeScalingGroupById(sgId)
 		if err != nil {
-			return fmt.Errorf("DescribeScalingGroupById %s error: %#v", sgId, err)
+			return WrapApiError(err, "DescribeScalingGroups", sgId)
 		}
 		enable := d.Get("enable").(bool)
 
 		if enable {
 			if group.LifecycleState == ess.Inacitve {
 
-				cs, _, err := client.essconn.DescribeScalingConfigurations(&ess.DescribeScalingConfigurationsArgs{
+				cs, _, err := client.essconn().DescribeScalingConfigurations(&ess.DescribeScalingConfigurationsArgs{
 					RegionId:       getRegion(d, meta),
 					ScalingGroupId: sgId,
 					Pagination:     getPagination(1, 50),
 				})
 
 				if err != nil {
-					return fmt.Errorf("Describe ScalingConfigurations by scaling group %s got an error: %#v", sgId, err)
+					return WrapApiError(err, "DescribeScalingConfigurations", sgId)
 				}
 				activeConfig := ""
 				var csIds []string
@@ -293,27 +361,27 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 						"Its all scaling configuration are %s.", sgId, strings.Join(csIds, ","))
 				}
 
-				if _, err := client.essconn.EnableScalingGroup(&ess.EnableScalingGroupArgs{
+				if _, err := client.essconn().EnableScalingGroup(&ess.EnableScalingGroupArgs{
 					ScalingGroupId:               sgId,
 					ActiveScalingConfigurationId: activeConfig,
 				}); err != nil {
-					return fmt.Errorf("EnableScalingGroup %s got an error: %#v", sgId, err)
+					return WrapApiError(err, "EnableScalingGroup", sgId)
 				}
-				if err := client.essconn.WaitForScalingGroup(getRegion(d, meta), sgId, ess.Active, DefaultTimeout); err != nil {
-					return fmt.Errorf("WaitForScalingGroup is %#v got an error: %#v.", ess.Active, err)
+				if err := client.essconn().WaitForScalingGroup(getRegion(d, meta), sgId, ess.Active, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+					return WrapApiError(WrapErrorf(err, "WaitForScalingGroup %s got an error", ess.Active), "", sgId)
 				}
 
 				d.SetPartial("scaling_configuration_id")
 			}
 		} else {
 			if group.LifecycleState == ess.Active {
-				if _, err := client.essconn.DisableScalingGroup(&ess.DisableScalingGroupArgs{
+				if _, err := client.essconn().DisableScalingGroup(&ess.DisableScalingGroupArgs{
 					ScalingGroupId: sgId,
 				}); err != nil {
-					return fmt.Errorf("DisableScalingGroup %s got an error: %#v", sgId, err)
+					return WrapApiError(err, "DisableScalingGroup", sgId)
 				}
-				if err := client.essconn.WaitForScalingGroup(getRegion(d, meta), sgId, ess.Inacitve, DefaultTimeout); err != nil {
-					return fmt.Errorf("WaitForScalingGroup is %#v got an error: %#v.", ess.Inacitve, err)
+				if err := client.essconn().WaitForScalingGroup(getRegion(d, meta), sgId, ess.Inacitve, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+					return WrapApiError(WrapErrorf(err, "WaitForScalingGroup %s got an error", ess.Inacitve), "", sgId)
 				}
 			}
 		}
@@ -335,7 +403,7 @@ func resourceAliyunEssScalingConfigurationRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe ESS scaling configuration Attribute: %#v", err)
+		return WrapApiError(err, "DescribeScalingConfigurations", d.Id())
 	}
 
 	d.Set("scaling_group_id", c.ScalingGroupId)
@@ -353,9 +421,27 @@ func resourceAliyunEssScalingConfigurationRead(d *schema.ResourceData, meta inte
 	d.Set("key_name", c.KeyPairName)
 	d.Set("user_data", userDataHashSum(c.UserData))
 	d.Set("force_delete", d.Get("force_delete").(bool))
-	d.Set("tags", essTagsToMap(c.Tags.Tag))
+	d.Set("tags", client.ignoreDefaultTags(d, essTagsToMap(c.Tags.Tag)))
 	d.Set("instance_name", c.InstanceName)
 
+	spot, err := client.DescribeEssScalingConfigurationSpot(d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeScalingConfigurations", d.Id())
+	}
+	// A configuration with a single instance type also returns it in the instance types.
+	if _, ok := d.GetOk("instance_types"); ok || len(spot.InstanceTypes.InstanceType) > 1 {
+		d.Set("instance_types", spot.InstanceTypes.InstanceType)
+	}
+	d.Set("spot_strategy", spot.SpotStrategy)
+	var limits []map[string]interface{}
+	for _, model := range spot.SpotPriceLimit.SpotPriceModel {
+		limits = append(limits, map[string]interface{}{
+			"instance_type": model.InstanceType,
+			"price_limit":   model.PriceLimit,
+		})
+	}
+	d.Set("spot_price_limit", limits)
+
 	return nil
 }
 
@@ -372,7 +458,10 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 			return nil
 		}
 		if d.Get("force_delete").(bool) {
-			return client.DeleteScalingGroupById(configs[0].ScalingGroupId)
+			if err := client.DeleteScalingGroupById(configs[0].ScalingGroupId); err != nil {
+				return WrapApiError(err, "DeleteScalingGroup", configs[0].ScalingGroupId)
+			}
+			return nil
 		}
 		return fmt.Errorf("Current scaling configuration %s is the last configuration for the scaling group %s. Please launch a new "+
 			"active scaling configuration or set 'force_delete' to 'true' to delete it with deleting its scaling group.", d.Id(), configs[0].ScalingGroupId)
@@ -380,7 +469,7 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 
-		_, err := client.essconn.DeleteScalingConfiguration(&ess.DeleteScalingConfigurationArgs{
+		_, err := client.essconn().DeleteScalingConfiguration(&ess.DeleteScalingConfigurationArgs{
 			ScalingConfigurationId: d.Id(),
 		})
 
@@ -391,8 +480,7 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 					fmt.Errorf("Scaling configuration is active. Please active another one before deleting it and trying again."))
 			}
 			if e.ErrorResponse.Code != InvalidScalingGroupIdNotFound {
-				return resource.RetryableError(
-					fmt.Errorf("Delete scaling configuration timeout and got an error:%#v.", err))
+				return resource.RetryableError(WrapApiError(err, "DeleteScalingConfiguration", d.Id()))
 			}
 		}
 
@@ -401,23 +489,22 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 			if NotFoundError(err) {
 				return nil
 			}
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapApiError(err, "DescribeScalingConfigurations", d.Id()))
 		}
 
-		instances, _, err := client.essconn.DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
+		instances, _, err := client.essconn().DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
 			RegionId:               getRegion(d, meta),
 			ScalingGroupId:         c.ScalingGroupId,
 			ScalingConfigurationId: d.Id(),
 		})
 		if err != nil {
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapApiError(err, "DescribeScalingInstances", d.Id()))
 		}
 		if len(instances) > 0 {
 			return resource.NonRetryableError(fmt.Errorf("There are still ECS instances in the scaling configuration - please remove them and try again."))
 		}
 
-		return resource.RetryableError(
-			fmt.Errorf("Delete scaling configuration timeout and got an error:%#v.", err))
+		return resource.RetryableError(fmt.Errorf("Delete scaling configuration %s timeout.", d.Id()))
 	})
 }
 
@@ -486,9 +573,9 @@ func buildAlicloudEssScalingConfigurationArgs(d *schema.ResourceData, meta inter
 		args.UserData = v.(string)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v := meta.(*AliyunClient).mergeDefaultTags(d.Get("tags").(map[string]interface{})); len(v) > 0 {
 		tags := "{"
-		for key, value := range v.(map[string]interface{}) {
+		for key, value := range v {
 			tags += "\"" + key + "\"" + ":" + "\"" + value.(string) + "\"" + ","
 		}
 		args.Tags = strings.TrimSuffix(tags, ",") + "}"
@@ -507,15 +594,15 @@ func activeSubstituteScalingConfiguration(d *schema.ResourceData, meta interface
 
 	c, err := client.DescribeScalingConfigurationById(d.Id())
 	if err != nil {
-		return nil, fmt.Errorf("DescribeScalingConfigurationById error: %#v", err)
+		return nil, WrapApiError(err, "DescribeScalingConfigurations", d.Id())
 	}
 
-	cs, _, err := client.essconn.DescribeScalingConfigurations(&ess.DescribeScalingConfigurationsArgs{
+	cs, _, err := client.essconn().DescribeScalingConfigurations(&ess.DescribeScalingConfigurationsArgs{
 		RegionId:       getRegion(d, meta),
 		ScalingGroupId: c.ScalingGroupId,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("DescribeScalingConfigurations error: %#v", err)
+		return nil, WrapApiError(err, "DescribeScalingConfigurations", c.ScalingGroupId)
 	}
 
 	if !ok || substitute_id.(string) == "" {
@@ -536,8 +623,8 @@ func activeSubstituteScalingConfiguration(d *schema.ResourceData, meta interface
 
 	err = client.ActiveScalingConfigurationById(c.ScalingGroupId, substitute_id.(string))
 	if err != nil {
-		return cs, fmt.Errorf("Inactive scaling configuration %s err: %#v. Substitute scaling configuration ID: %s",
-			d.Id(), err, substitute_id.(string))
+		return cs, WrapErrorf(WrapApiError(err, "ModifyScalingGroup", c.ScalingGroupId),
+			"Inactive scaling configuration %s by the substitute %s got an error", d.Id(), substitute_id.(string))
 	}
 
 	return cs, nil
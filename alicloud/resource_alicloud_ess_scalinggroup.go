@@ -2,12 +2,10 @@ package alicloud
 
 import (
 	"fmt"
-
+	"strconv"
 	"time"
 
 	"github.com/denverdino/aliyungo/ess"
-	"github.com/denverdino/aliyungo/slb"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -21,6 +19,11 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"min_size": &schema.Schema{
 				Type:         schema.TypeInt,
@@ -78,32 +81,94 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 				MaxItems: 5,
 				MinItems: 1,
 			},
+			"instance_refresh": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"triggers": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"batch_size": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validateIntegerInRange(1, 100),
+						},
+						"pause_time": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateIntegerInRange(0, 3600),
+						},
+						"health_check_wait": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validateIntegerInRange(0, 3600),
+						},
+					},
+				},
+			},
+			"multi_az_policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      EssMultiAZPolicyPriority,
+				ValidateFunc: validateAllowedStringValue([]string{EssMultiAZPolicyPriority, EssMultiAZPolicyCostOptimized, EssMultiAZPolicyBalance}),
+			},
+			"on_demand_base_capacity": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 1000),
+			},
+			"on_demand_percentage_above_base_capacity": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 100),
+			},
+			"spot_instance_pools": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 10),
+			},
+			"spot_instance_remedy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"active_scaling_configuration_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceAliyunEssScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
 
-	args, err := buildAlicloudEssScalingGroupArgs(d, meta)
+	params, err := buildAlicloudEssScalingGroupParams(d, meta)
 	if err != nil {
 		return err
 	}
 
-	essconn := meta.(*AliyunClient).essconn
+	client := meta.(*AliyunClient)
+	params["ClientToken"] = buildClientToken("TF-CreateScalingGroup")
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		scaling, err := essconn.CreateScalingGroup(args)
-		if err != nil {
-			if IsExceptedError(err, EssThrottling) {
-				return resource.RetryableError(fmt.Errorf("CreateScalingGroup timeout and got an error: %#v.", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("CreateScalingGroup got an error: %#v.", err))
-		}
-		d.SetId(scaling.ScalingGroupId)
-		return nil
-	}); err != nil {
-		return err
+	// The aliyungo client does not support the multi-zone policy, so the scaling group is created by the common request.
+	// The throttling is retried by ProcessRpcRequest.
+	var resp struct {
+		ScalingGroupId string `json:"ScalingGroupId"`
+	}
+	if err := client.ProcessRpcRequest(client.essEndpoint(), EssApiVersion, "CreateScalingGroup", params, &resp); err != nil {
+		return WrapApiError(err, "CreateScalingGroup", "")
 	}
+	d.SetId(resp.ScalingGroupId)
 
 	return resourceAliyunEssScalingGroupUpdate(d, meta)
 }
@@ -118,13 +183,14 @@ func resourceAliyunEssScalingGroupRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe ESS scaling group Attribute: %#v", err)
+		return WrapApiError(err, "DescribeScalingGroups", d.Id())
 	}
 
 	d.Set("min_size", scaling.MinSize)
 	d.Set("max_size", scaling.MaxSize)
 	d.Set("scaling_group_name", scaling.ScalingGroupName)
 	d.Set("default_cooldown", scaling.DefaultCooldown)
+	d.Set("active_scaling_configuration_id", scaling.ActiveScalingConfigurationId)
 	var polices []string
 	if len(scaling.RemovalPolicies.RemovalPolicy) > 0 {
 		for _, v := range scaling.RemovalPolicies.RemovalPolicy {
@@ -156,12 +222,24 @@ func resourceAliyunEssScalingGroupRead(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("vswitch_ids", vswitchIds)
 
+	allocation, err := client.DescribeEssScalingGroupAllocation(d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeScalingGroups", d.Id())
+	}
+	if allocation.MultiAZPolicy != "" {
+		d.Set("multi_az_policy", allocation.MultiAZPolicy)
+	}
+	d.Set("on_demand_base_capacity", allocation.OnDemandBaseCapacity)
+	d.Set("on_demand_percentage_above_base_capacity", allocation.OnDemandPercentageAboveBaseCapacity)
+	d.Set("spot_instance_pools", allocation.SpotInstancePools)
+	d.Set("spot_instance_remedy", allocation.SpotInstanceRemedy)
+
 	return nil
 }
 
 func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).essconn
+	conn := meta.(*AliyunClient).essconn()
 	args := &ess.ModifyScalingGroupArgs{
 		ScalingGroupId: d.Id(),
 	}
@@ -197,7 +275,47 @@ func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	if _, err := conn.ModifyScalingGroup(args); err != nil {
-		return err
+		return WrapApiError(err, "ModifyScalingGroup", d.Id())
+	}
+
+	// The on-demand and spot split of the cost optimized policy is not supported by the aliyungo client.
+	if d.HasChange("on_demand_base_capacity") || d.HasChange("on_demand_percentage_above_base_capacity") ||
+		d.HasChange("spot_instance_pools") || d.HasChange("spot_instance_remedy") {
+		params := map[string]string{
+			"ScalingGroupId": d.Id(),
+		}
+		if d.HasChange("on_demand_base_capacity") {
+			params["OnDemandBaseCapacity"] = strconv.Itoa(d.Get("on_demand_base_capacity").(int))
+		}
+		if d.HasChange("on_demand_percentage_above_base_capacity") {
+			params["OnDemandPercentageAboveBaseCapacity"] = strconv.Itoa(d.Get("on_demand_percentage_above_base_capacity").(int))
+		}
+		if d.HasChange("spot_instance_pools") {
+			params["SpotInstancePools"] = strconv.Itoa(d.Get("spot_instance_pools").(int))
+		}
+		if d.HasChange("spot_instance_remedy") {
+			params["SpotInstanceRemedy"] = strconv.FormatBool(d.Get("spot_instance_remedy").(bool))
+		}
+		client := meta.(*AliyunClient)
+		if err := client.ProcessRpcRequest(client.essEndpoint(), EssApiVersion, "ModifyScalingGroup", params, nil); err != nil {
+			return WrapApiError(err, "ModifyScalingGroup", d.Id())
+		}
+		for _, k := range []string{"on_demand_base_capacity", "on_demand_percentage_above_base_capacity", "spot_instance_pools", "spot_instance_remedy"} {
+			d.SetPartial(k)
+		}
+	}
+
+	// A change of the instance refresh, mostly its triggers, replaces the instances launched by the former configurations.
+	if !d.IsNewResource() && d.HasChange("instance_refresh") {
+		if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			refresh := v.([]interface{})[0].(map[string]interface{})
+			if err := meta.(*AliyunClient).RefreshEssScalingGroupInstances(d.Id(), refresh["batch_size"].(int),
+				time.Duration(refresh["pause_time"].(int))*time.Second, time.Duration(refresh["health_check_wait"].(int))*time.Second,
+				timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("instance_refresh")
 	}
 
 	d.Partial(false)
@@ -207,52 +325,53 @@ func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{
 
 func resourceAliyunEssScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
 
-	return meta.(*AliyunClient).DeleteScalingGroupById(d.Id())
+	if err := meta.(*AliyunClient).DeleteScalingGroupById(d.Id()); err != nil {
+		return WrapApiError(err, "DeleteScalingGroup", d.Id())
+	}
+	return nil
 }
 
-func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingGroupArgs, error) {
+func buildAlicloudEssScalingGroupParams(d *schema.ResourceData, meta interface{}) (map[string]string, error) {
 	client := meta.(*AliyunClient)
-	args := &ess.CreateScalingGroupArgs{
-		RegionId: getRegion(d, meta),
+	params := map[string]string{
+		"MinSize":         strconv.Itoa(d.Get("min_size").(int)),
+		"MaxSize":         strconv.Itoa(d.Get("max_size").(int)),
+		"DefaultCooldown": strconv.Itoa(d.Get("default_cooldown").(int)),
+		"MultiAZPolicy":   d.Get("multi_az_policy").(string),
 	}
 
-	minsize := d.Get("min_size").(int)
-	maxsize := d.Get("max_size").(int)
-	cooldown := d.Get("default_cooldown").(int)
-	args.MinSize = &minsize
-	args.MaxSize = &maxsize
-	args.DefaultCooldown = &cooldown
-
 	if v := d.Get("scaling_group_name").(string); v != "" {
-		args.ScalingGroupName = v
+		params["ScalingGroupName"] = v
 	}
 
 	if v, ok := d.GetOk("vswitch_ids"); ok {
-		args.VSwitchIds = expandStringList(v.(*schema.Set).List())
+		for i, id := range expandStringList(v.(*schema.Set).List()) {
+			params[fmt.Sprintf("VSwitchIds.%d", i+1)] = id
+		}
 
 		// get vpcId
 		vsw, err := client.DescribeVswitch((v.(*schema.Set).List()[0].(string)))
 
 		if err != nil {
-			return nil, fmt.Errorf("DescribeVpc got an error: %#v.", err)
+			return nil, WrapApiError(err, "DescribeVSwitchAttributes", v.(*schema.Set).List()[0].(string))
 		}
 		// fill vpcId by vswitchId
-		args.VpcId = vsw.VpcId
+		params["VpcId"] = vsw.VpcId
 
 	}
 
 	if dbs, ok := d.GetOk("db_instance_ids"); ok {
-		args.DBInstanceIds = convertListToJsonString(dbs.(*schema.Set).List())
+		params["DBInstanceIds"] = convertListToJsonString(dbs.(*schema.Set).List())
 	}
 
 	if lbs, ok := d.GetOk("loadbalancer_ids"); ok {
 		for _, lb := range lbs.(*schema.Set).List() {
-			if err := client.slbconn.WaitForLoadBalancerAsyn(lb.(string), slb.ActiveStatus, DefaultTimeout); err != nil {
-				return nil, fmt.Errorf("WaitForLoadbalancer %s %s got error: %#v", lb.(string), slb.ActiveStatus, err)
+			if err := client.WaitForLoadBalancer(lb.(string), SlbActive, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+				return nil, WrapApiError(WrapErrorf(err, "WaitForLoadBalancer %s got an error", SlbActive), "", lb.(string))
 			}
 		}
-		args.LoadBalancerIds = convertListToJsonString(lbs.(*schema.Set).List())
+		params["LoadBalancerIds"] = convertListToJsonString(lbs.(*schema.Set).List())
 	}
 
-	return args, nil
+	return params, nil
 }
@@ -5,9 +5,9 @@ import (
 	"log"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ess"
-	"github.com/denverdino/aliyungo/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -133,7 +133,7 @@ func TestAccAlicloudEssScalingGroup_vpc(t *testing.T) {
 
 func TestAccAlicloudEssScalingGroup_slb(t *testing.T) {
 	var sg ess.ScalingGroupItemType
-	var slb slb.LoadBalancerType
+	var slb slb.DescribeLoadBalancerAttributeResponse
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
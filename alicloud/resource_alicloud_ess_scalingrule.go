@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/denverdino/aliyungo/ess"
@@ -16,6 +15,9 @@ func resourceAlicloudEssScalingRule() *schema.Resource {
 		Read:   resourceAliyunEssScalingRuleRead,
 		Update: resourceAliyunEssScalingRuleUpdate,
 		Delete: resourceAliyunEssScalingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": &schema.Schema{
@@ -57,11 +59,11 @@ func resourceAliyunEssScalingRuleCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	essconn := meta.(*AliyunClient).essconn
+	essconn := meta.(*AliyunClient).essconn()
 
 	rule, err := essconn.CreateScalingRule(args)
 	if err != nil {
-		return err
+		return WrapApiError(err, "CreateScalingRule", args.ScalingGroupId)
 	}
 
 	d.SetId(d.Get("scaling_group_id").(string) + COLON_SEPARATED + rule.ScalingRuleId)
@@ -72,7 +74,10 @@ func resourceAliyunEssScalingRuleCreate(d *schema.ResourceData, meta interface{}
 func resourceAliyunEssScalingRuleRead(d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*AliyunClient)
-	ids := strings.Split(d.Id(), COLON_SEPARATED)
+	ids, err := ParseResourceId(d.Id(), "ESS scaling rule", "scaling_group_id", "scaling_rule_id")
+	if err != nil {
+		return err
+	}
 
 	rule, err := client.DescribeScalingRuleById(ids[0], ids[1])
 	if err != nil {
@@ -80,7 +85,7 @@ func resourceAliyunEssScalingRuleRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe ESS scaling rule Attribute: %#v", err)
+		return WrapApiError(err, "DescribeScalingRules", d.Id())
 	}
 
 	d.Set("scaling_group_id", rule.ScalingGroupId)
@@ -95,13 +100,16 @@ func resourceAliyunEssScalingRuleRead(d *schema.ResourceData, meta interface{})
 
 func resourceAliyunEssScalingRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	ids := strings.Split(d.Id(), COLON_SEPARATED)
+	ids, err := ParseResourceId(d.Id(), "ESS scaling rule", "scaling_group_id", "scaling_rule_id")
+	if err != nil {
+		return err
+	}
 
 	return resource.Retry(2*time.Minute, func() *resource.RetryError {
 		err := client.DeleteScalingRuleById(ids[1])
 
 		if err != nil {
-			return resource.RetryableError(fmt.Errorf("Delete scaling rule timeout and got an error:%#v.", err))
+			return resource.RetryableError(WrapApiError(err, "DeleteScalingRule", d.Id()))
 		}
 
 		_, err = client.DescribeScalingRuleById(ids[0], ids[1])
@@ -109,17 +117,20 @@ func resourceAliyunEssScalingRuleDelete(d *schema.ResourceData, meta interface{}
 			if NotFoundError(err) {
 				return nil
 			}
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapApiError(err, "DescribeScalingRules", d.Id()))
 		}
 
-		return resource.RetryableError(fmt.Errorf("Delete scaling rule timeout and got an error:%#v.", err))
+		return resource.RetryableError(fmt.Errorf("Delete scaling rule %s timeout.", d.Id()))
 	})
 }
 
 func resourceAliyunEssScalingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).essconn
-	ids := strings.Split(d.Id(), COLON_SEPARATED)
+	conn := meta.(*AliyunClient).essconn()
+	ids, err := ParseResourceId(d.Id(), "ESS scaling rule", "scaling_group_id", "scaling_rule_id")
+	if err != nil {
+		return err
+	}
 
 	args := &ess.ModifyScalingRuleArgs{
 		ScalingRuleId: ids[1],
@@ -142,7 +153,7 @@ func resourceAliyunEssScalingRuleUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	if _, err := conn.ModifyScalingRule(args); err != nil {
-		return err
+		return WrapApiError(err, "ModifyScalingRule", d.Id())
 	}
 
 	return resourceAliyunEssScalingRuleRead(d, meta)
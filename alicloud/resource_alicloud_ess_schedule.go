@@ -76,11 +76,11 @@ func resourceAliyunEssScheduleCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	essconn := meta.(*AliyunClient).essconn
+	essconn := meta.(*AliyunClient).essconn()
 
 	rule, err := essconn.CreateScheduledTask(args)
 	if err != nil {
-		return err
+		return WrapApiError(err, "CreateScheduledTask", "")
 	}
 
 	d.SetId(rule.ScheduledTaskId)
@@ -98,7 +98,7 @@ func resourceAliyunEssScheduleRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Describe ESS schedule Attribute: %#v", err)
+		return WrapApiError(err, "DescribeScheduledTasks", d.Id())
 	}
 
 	d.Set("scheduled_action", rule.ScheduledAction)
@@ -116,7 +116,7 @@ func resourceAliyunEssScheduleRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceAliyunEssScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).essconn
+	conn := meta.(*AliyunClient).essconn()
 
 	args := &ess.ModifyScheduledTaskArgs{
 		ScheduledTaskId: d.Id(),
@@ -159,7 +159,7 @@ func resourceAliyunEssScheduleUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if _, err := conn.ModifyScheduledTask(args); err != nil {
-		return err
+		return WrapApiError(err, "ModifyScheduledTask", d.Id())
 	}
 
 	return resourceAliyunEssScheduleRead(d, meta)
@@ -172,7 +172,7 @@ func resourceAliyunEssScheduleDelete(d *schema.ResourceData, meta interface{}) e
 		err := client.DeleteScheduleById(d.Id())
 
 		if err != nil {
-			return resource.RetryableError(fmt.Errorf("Delete scaling schedule timeout and got an error:%#v.", err))
+			return resource.RetryableError(WrapApiError(err, "DeleteScheduledTask", d.Id()))
 		}
 
 		_, err = client.DescribeScheduleById(d.Id())
@@ -180,10 +180,10 @@ func resourceAliyunEssScheduleDelete(d *schema.ResourceData, meta interface{}) e
 			if NotFoundError(err) {
 				return nil
 			}
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapApiError(err, "DescribeScheduledTasks", d.Id()))
 		}
 
-		return resource.RetryableError(fmt.Errorf("Delete scaling schedule timeout and got an error:%#v.", err))
+		return resource.RetryableError(fmt.Errorf("Delete scaling schedule %s timeout.", d.Id()))
 	})
 }
 
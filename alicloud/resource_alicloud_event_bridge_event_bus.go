@@ -0,0 +1,89 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEventBridgeEventBus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEventBridgeEventBusCreate,
+		Read:   resourceAlicloudEventBridgeEventBusRead,
+		Delete: resourceAlicloudEventBridgeEventBusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"event_bus_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudEventBridgeEventBusCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	name := d.Get("event_bus_name").(string)
+	params := map[string]string{
+		"EventBusName": name,
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params["Description"] = v.(string)
+	}
+	if err := RetryOnError(EventBridgeCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.eventBridgeEndpoint(), EventBridgeApiVersion, "CreateEventBus", params, nil)
+	}); err != nil {
+		return WrapErrorf(err, "CreateEventBus got an error")
+	}
+	d.SetId(name)
+
+	return resourceAlicloudEventBridgeEventBusRead(d, meta)
+}
+
+func resourceAlicloudEventBridgeEventBusRead(d *schema.ResourceData, meta interface{}) error {
+	bus, err := meta.(*AliyunClient).DescribeEventBridgeEventBus(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("event_bus_name", bus.EventBusName)
+	d.Set("description", bus.Description)
+	d.Set("arn", bus.EventBusARN)
+
+	return nil
+}
+
+func resourceAlicloudEventBridgeEventBusDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(EventBridgeCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.eventBridgeEndpoint(), EventBridgeApiVersion, "DeleteEventBus", map[string]string{
+			"EventBusName": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, EventBridgeEventBusNotExist) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteEventBus got an error")
+	}
+
+	return nil
+}
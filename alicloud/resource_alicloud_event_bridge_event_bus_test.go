@@ -0,0 +1,105 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEventBridgeEventBus_basic(t *testing.T) {
+	var bus EventBridgeEventBus
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_event_bridge_event_bus.bus",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckEventBridgeEventBusDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEventBridgeEventBusBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventBridgeEventBusExists("alicloud_event_bridge_event_bus.bus", &bus),
+					resource.TestCheckResourceAttr("alicloud_event_bridge_event_bus.bus", "event_bus_name", "tf-testacc-bus"),
+					resource.TestCheckResourceAttr("alicloud_event_bridge_event_bus.bus", "description", "Terraform acc test"),
+					resource.TestCheckResourceAttrSet("alicloud_event_bridge_event_bus.bus", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudEventBridgeEventBus_import(t *testing.T) {
+	resourceName := "alicloud_event_bridge_event_bus.bus"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEventBridgeEventBusDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEventBridgeEventBusBasic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEventBridgeEventBusExists(n string, bus *EventBridgeEventBus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Event Bus ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		b, err := client.DescribeEventBridgeEventBus(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*bus = b
+
+		return nil
+	}
+}
+
+func testAccCheckEventBridgeEventBusDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_event_bridge_event_bus" {
+			continue
+		}
+
+		if _, err := client.DescribeEventBridgeEventBus(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("EventBridge Event Bus %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccEventBridgeEventBusBasic = `
+resource "alicloud_event_bridge_event_bus" "bus" {
+  event_bus_name = "tf-testacc-bus"
+  description = "Terraform acc test"
+}
+`
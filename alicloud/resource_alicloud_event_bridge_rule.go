@@ -0,0 +1,316 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEventBridgeRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEventBridgeRuleCreate,
+		Read:   resourceAlicloudEventBridgeRuleRead,
+		Update: resourceAlicloudEventBridgeRuleUpdate,
+		Delete: resourceAlicloudEventBridgeRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"event_bus_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_pattern": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonDocument,
+				DiffSuppressFunc: jsonDiffSuppressFunc,
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      EventBridgeRuleEnable,
+				ValidateFunc: validateAllowedStringValue([]string{EventBridgeRuleEnable, EventBridgeRuleDisable}),
+			},
+			"targets": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateAllowedStringValue([]string{
+								"acs.fc.function", "acs.mns.queue", "acs.mns.topic", "acs.sls", "http", "https",
+							}),
+						},
+						"endpoint": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"push_retry_strategy": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "BACKOFF_RETRY",
+							ValidateFunc: validateAllowedStringValue([]string{"BACKOFF_RETRY", "EXPONENTIAL_DECAY_RETRY"}),
+						},
+						"param_list": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_key": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"form": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAllowedStringValue([]string{"ORIGINAL", "TEMPLATE", "JSONPATH", "CONSTANT"}),
+									},
+									"value": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"template": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandEventBridgeTargets(list []interface{}) []EventBridgeTarget {
+	targets := make([]EventBridgeTarget, 0, len(list))
+	for _, t := range list {
+		target := t.(map[string]interface{})
+		params := make([]EventBridgeTargetParam, 0)
+		for _, p := range target["param_list"].([]interface{}) {
+			param := p.(map[string]interface{})
+			params = append(params, EventBridgeTargetParam{
+				ResourceKey: param["resource_key"].(string),
+				Form:        param["form"].(string),
+				Value:       param["value"].(string),
+				Template:    param["template"].(string),
+			})
+		}
+		targets = append(targets, EventBridgeTarget{
+			Id:                target["target_id"].(string),
+			Type:              target["type"].(string),
+			Endpoint:          target["endpoint"].(string),
+			PushRetryStrategy: target["push_retry_strategy"].(string),
+			ParamList:         params,
+		})
+	}
+	return targets
+}
+
+func flattenEventBridgeTargets(targets []EventBridgeTarget) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, target := range targets {
+		var params []map[string]interface{}
+		for _, param := range target.ParamList {
+			params = append(params, map[string]interface{}{
+				"resource_key": param.ResourceKey,
+				"form":         param.Form,
+				"value":        param.Value,
+				"template":     param.Template,
+			})
+		}
+		result = append(result, map[string]interface{}{
+			"target_id":           target.Id,
+			"type":                target.Type,
+			"endpoint":            target.Endpoint,
+			"push_retry_strategy": target.PushRetryStrategy,
+			"param_list":          params,
+		})
+	}
+	return result
+}
+
+func resourceAlicloudEventBridgeRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	busName := d.Get("event_bus_name").(string)
+	ruleName := d.Get("rule_name").(string)
+	targets, err := json.Marshal(expandEventBridgeTargets(d.Get("targets").(*schema.Set).List()))
+	if err != nil {
+		return WrapError(err)
+	}
+	params := map[string]string{
+		"EventBusName":  busName,
+		"RuleName":      ruleName,
+		"FilterPattern": d.Get("filter_pattern").(string),
+		"Status":        d.Get("status").(string),
+		"EventTargets":  string(targets),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params["Description"] = v.(string)
+	}
+	if err := RetryOnError(EventBridgeCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.eventBridgeEndpoint(), EventBridgeApiVersion, "CreateRule", params, nil)
+	}); err != nil {
+		return WrapErrorf(err, "CreateRule got an error")
+	}
+	d.SetId(busName + COLON_SEPARATED + ruleName)
+
+	return resourceAlicloudEventBridgeRuleRead(d, meta)
+}
+
+func resourceAlicloudEventBridgeRuleRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseEventBridgeRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule, err := meta.(*AliyunClient).DescribeEventBridgeRule(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("event_bus_name", parts[0])
+	d.Set("rule_name", rule.RuleName)
+	d.Set("description", rule.Description)
+	d.Set("filter_pattern", rule.FilterPattern)
+	d.Set("status", rule.Status)
+	if err := d.Set("targets", flattenEventBridgeTargets(rule.Targets)); err != nil {
+		return WrapError(err)
+	}
+	d.Set("arn", rule.RuleARN)
+
+	return nil
+}
+
+func resourceAlicloudEventBridgeRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	busName, ruleName := d.Get("event_bus_name").(string), d.Get("rule_name").(string)
+	invoke := func(action string, params map[string]string) error {
+		params["EventBusName"] = busName
+		params["RuleName"] = ruleName
+		if err := RetryOnError(EventBridgeCode, 3*time.Minute, func() error {
+			return client.ProcessRpcRequest(client.eventBridgeEndpoint(), EventBridgeApiVersion, action, params, nil)
+		}); err != nil {
+			return WrapErrorf(err, "%s got an error", action)
+		}
+		return nil
+	}
+
+	if d.HasChange("description") || d.HasChange("filter_pattern") {
+		if err := invoke("UpdateRule", map[string]string{
+			"Description":   d.Get("description").(string),
+			"FilterPattern": d.Get("filter_pattern").(string),
+		}); err != nil {
+			return err
+		}
+		d.SetPartial("description")
+		d.SetPartial("filter_pattern")
+	}
+
+	if d.HasChange("targets") {
+		o, n := d.GetChange("targets")
+		targets := expandEventBridgeTargets(n.(*schema.Set).List())
+		// The targets are put by their IDs, so only the ones which are no longer present are deleted.
+		ids := make(map[string]bool)
+		for _, target := range targets {
+			ids[target.Id] = true
+		}
+		var removed []string
+		for _, target := range expandEventBridgeTargets(o.(*schema.Set).List()) {
+			if !ids[target.Id] {
+				removed = append(removed, target.Id)
+			}
+		}
+		if len(removed) > 0 {
+			bs, _ := json.Marshal(removed)
+			if err := invoke("DeleteTargets", map[string]string{"TargetIds": string(bs)}); err != nil {
+				return err
+			}
+		}
+		if len(targets) > 0 {
+			bs, err := json.Marshal(targets)
+			if err != nil {
+				return WrapError(err)
+			}
+			if err := invoke("PutTargets", map[string]string{"Targets": string(bs)}); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("targets")
+	}
+
+	if d.HasChange("status") {
+		action := "EnableRule"
+		if d.Get("status").(string) == EventBridgeRuleDisable {
+			action = "DisableRule"
+		}
+		if err := invoke(action, map[string]string{}); err != nil {
+			return err
+		}
+		d.SetPartial("status")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudEventBridgeRuleRead(d, meta)
+}
+
+func resourceAlicloudEventBridgeRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseEventBridgeRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := RetryOnError(EventBridgeCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.eventBridgeEndpoint(), EventBridgeApiVersion, "DeleteRule", map[string]string{
+			"EventBusName": parts[0],
+			"RuleName":     parts[1],
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, EventBridgeRuleNotExist) || IsExceptedError(err, EventBridgeEventBusNotExist) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteRule got an error")
+	}
+
+	return nil
+}
+
+func parseEventBridgeRuleId(id string) ([]string, error) {
+	return ParseResourceId(id, "EventBridge rule", "event_bus_name", "rule_name")
+}
@@ -0,0 +1,157 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEventBridgeRule_basic(t *testing.T) {
+	var rule EventBridgeRule
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_event_bridge_rule.rule",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckEventBridgeRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEventBridgeRuleBasic("ENABLE", "https://www.example.com/events"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventBridgeRuleExists("alicloud_event_bridge_rule.rule", &rule),
+					resource.TestCheckResourceAttr("alicloud_event_bridge_rule.rule", "rule_name", "tf-testacc-rule"),
+					resource.TestCheckResourceAttr("alicloud_event_bridge_rule.rule", "status", "ENABLE"),
+					resource.TestCheckResourceAttr("alicloud_event_bridge_rule.rule", "targets.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEventBridgeRuleBasic("DISABLE", "https://www.example.com/audit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventBridgeRuleExists("alicloud_event_bridge_rule.rule", &rule),
+					resource.TestCheckResourceAttr("alicloud_event_bridge_rule.rule", "status", "DISABLE"),
+					resource.TestCheckResourceAttr("alicloud_event_bridge_rule.rule", "targets.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandEventBridgeTargets(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAlicloudEventBridgeRule().Schema, map[string]interface{}{
+		"event_bus_name": "default",
+		"rule_name":      "audit",
+		"filter_pattern": `{"source":["acs.ecs"]}`,
+		"targets": []interface{}{
+			map[string]interface{}{
+				"target_id": "fc",
+				"type":      "acs.fc.function",
+				"endpoint":  "acs:fc:cn-hangzhou:123456:services/audit.LATEST/functions/handle",
+				"param_list": []interface{}{
+					map[string]interface{}{
+						"resource_key": "serviceName",
+						"form":         "CONSTANT",
+						"value":        "audit",
+					},
+				},
+			},
+		},
+	})
+	bs, err := json.Marshal(expandEventBridgeTargets(d.Get("targets").(*schema.Set).List()))
+	if err != nil {
+		t.Fatalf("Marshalling the targets got an error: %#v", err)
+	}
+	expected := `[{"Id":"fc","Type":"acs.fc.function","Endpoint":"acs:fc:cn-hangzhou:123456:services/audit.LATEST/functions/handle",` +
+		`"PushRetryStrategy":"BACKOFF_RETRY","ParamList":[{"ResourceKey":"serviceName","Form":"CONSTANT","Value":"audit"}]}]`
+	if string(bs) != expected {
+		t.Fatalf("Expected the targets %s, got %s.", expected, string(bs))
+	}
+}
+
+func testAccCheckEventBridgeRuleExists(n string, rule *EventBridgeRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Rule ID is set")
+		}
+
+		parts, err := parseEventBridgeRuleId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := testAccProvider.Meta().(*AliyunClient)
+		r, err := client.DescribeEventBridgeRule(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*rule = r
+
+		return nil
+	}
+}
+
+func testAccCheckEventBridgeRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_event_bridge_rule" {
+			continue
+		}
+
+		parts, err := parseEventBridgeRuleId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DescribeEventBridgeRule(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("EventBridge Rule %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEventBridgeRuleBasic(status, endpoint string) string {
+	return fmt.Sprintf(`
+resource "alicloud_event_bridge_event_bus" "bus" {
+  event_bus_name = "tf-testacc-rule-bus"
+}
+
+resource "alicloud_event_bridge_rule" "rule" {
+  event_bus_name = "${alicloud_event_bridge_event_bus.bus.id}"
+  rule_name = "tf-testacc-rule"
+  description = "Terraform acc test"
+  status = "%s"
+  filter_pattern = <<PATTERN
+{
+  "source": ["acs.ecs"]
+}
+PATTERN
+
+  targets {
+    target_id = "tf-testacc-http"
+    type = "https"
+    endpoint = "%s"
+
+    param_list {
+      resource_key = "body"
+      form = "ORIGINAL"
+    }
+  }
+}
+`, status, endpoint)
+}
@@ -0,0 +1,137 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFnfFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFnfFlowCreate,
+		Read:   resourceAlicloudFnfFlowRead,
+		Update: resourceAlicloudFnfFlowUpdate,
+		Delete: resourceAlicloudFnfFlowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"definition": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateYamlString,
+				DiffSuppressFunc: yamlDiffSuppressFunc,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      FnfFlowTypeFDL,
+				ValidateFunc: validateAllowedStringValue([]string{FnfFlowTypeFDL}),
+			},
+			"role_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"flow_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildFnfFlowParams(d *schema.ResourceData) map[string]string {
+	params := map[string]string{
+		"Name":        d.Get("name").(string),
+		"Definition":  d.Get("definition").(string),
+		"Description": d.Get("description").(string),
+		"Type":        d.Get("type").(string),
+	}
+	if v, ok := d.GetOk("role_arn"); ok {
+		params["RoleArn"] = v.(string)
+	}
+	return params
+}
+
+func resourceAlicloudFnfFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	var flow FnfFlow
+	if err := RetryOnError(FnfCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.fnfEndpoint(), FnfApiVersion, "CreateFlow", buildFnfFlowParams(d), &flow)
+	}); err != nil {
+		return WrapErrorf(err, "CreateFlow got an error")
+	}
+	d.SetId(flow.Name)
+
+	return resourceAlicloudFnfFlowRead(d, meta)
+}
+
+func resourceAlicloudFnfFlowRead(d *schema.ResourceData, meta interface{}) error {
+	flow, err := meta.(*AliyunClient).DescribeFnfFlow(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", flow.Name)
+	d.Set("definition", flow.Definition)
+	d.Set("description", flow.Description)
+	d.Set("type", flow.Type)
+	d.Set("role_arn", flow.RoleArn)
+	d.Set("flow_id", flow.Id)
+	d.Set("last_modified_time", flow.LastModifiedTime)
+
+	return nil
+}
+
+// The running executions keep the definition they started with.
+func resourceAlicloudFnfFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("definition") || d.HasChange("description") || d.HasChange("role_arn") {
+		if err := RetryOnError(FnfCode, 3*time.Minute, func() error {
+			return client.ProcessRpcRequest(client.fnfEndpoint(), FnfApiVersion, "UpdateFlow", buildFnfFlowParams(d), nil)
+		}); err != nil {
+			return WrapErrorf(err, "UpdateFlow got an error")
+		}
+	}
+
+	return resourceAlicloudFnfFlowRead(d, meta)
+}
+
+// The flow can be deleted only after all its schedules are deleted.
+func resourceAlicloudFnfFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(FnfCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.fnfEndpoint(), FnfApiVersion, "DeleteFlow", map[string]string{
+			"Name": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, FnfFlowNotExists) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteFlow got an error")
+	}
+
+	return nil
+}
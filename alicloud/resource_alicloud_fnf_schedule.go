@@ -0,0 +1,147 @@
+package alicloud
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFnfSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFnfScheduleCreate,
+		Read:   resourceAlicloudFnfScheduleRead,
+		Update: resourceAlicloudFnfScheduleUpdate,
+		Delete: resourceAlicloudFnfScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"flow_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schedule_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cron_expression": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"payload": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonDocument,
+				DiffSuppressFunc: jsonDiffSuppressFunc,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"schedule_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildFnfScheduleParams(d *schema.ResourceData) map[string]string {
+	return map[string]string{
+		"FlowName":       d.Get("flow_name").(string),
+		"ScheduleName":   d.Get("schedule_name").(string),
+		"CronExpression": d.Get("cron_expression").(string),
+		"Payload":        d.Get("payload").(string),
+		"Description":    d.Get("description").(string),
+		"Enable":         strconv.FormatBool(d.Get("enable").(bool)),
+	}
+}
+
+func resourceAlicloudFnfScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(FnfCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.fnfEndpoint(), FnfApiVersion, "CreateSchedule", buildFnfScheduleParams(d), nil)
+	}); err != nil {
+		return WrapErrorf(err, "CreateSchedule got an error")
+	}
+	d.SetId(d.Get("flow_name").(string) + COLON_SEPARATED + d.Get("schedule_name").(string))
+
+	return resourceAlicloudFnfScheduleRead(d, meta)
+}
+
+func resourceAlicloudFnfScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseFnfScheduleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	schedule, err := meta.(*AliyunClient).DescribeFnfSchedule(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("flow_name", parts[0])
+	d.Set("schedule_name", schedule.ScheduleName)
+	d.Set("cron_expression", schedule.CronExpression)
+	d.Set("payload", schedule.Payload)
+	d.Set("description", schedule.Description)
+	d.Set("enable", schedule.Enable)
+	d.Set("schedule_id", schedule.ScheduleId)
+
+	return nil
+}
+
+func resourceAlicloudFnfScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("cron_expression") || d.HasChange("payload") || d.HasChange("description") || d.HasChange("enable") {
+		if err := RetryOnError(FnfCode, 3*time.Minute, func() error {
+			return client.ProcessRpcRequest(client.fnfEndpoint(), FnfApiVersion, "UpdateSchedule", buildFnfScheduleParams(d), nil)
+		}); err != nil {
+			return WrapErrorf(err, "UpdateSchedule got an error")
+		}
+	}
+
+	return resourceAlicloudFnfScheduleRead(d, meta)
+}
+
+func resourceAlicloudFnfScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	parts, err := parseFnfScheduleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := RetryOnError(FnfCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.fnfEndpoint(), FnfApiVersion, "DeleteSchedule", map[string]string{
+			"FlowName":     parts[0],
+			"ScheduleName": parts[1],
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, FnfScheduleNotExists) || IsExceptedError(err, FnfFlowNotExists) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteSchedule got an error")
+	}
+
+	return nil
+}
+
+func parseFnfScheduleId(id string) ([]string, error) {
+	return ParseResourceId(id, "FnF schedule", "flow_name", "schedule_name")
+}
@@ -0,0 +1,150 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudFnfSchedule_basic(t *testing.T) {
+	var schedule FnfSchedule
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_fnf_schedule.schedule",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckFnfScheduleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFnfScheduleConfig("0 0 * * * *", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFnfScheduleExists("alicloud_fnf_schedule.schedule", &schedule),
+					resource.TestCheckResourceAttr("alicloud_fnf_flow.flow", "name", "tf-testacc-fnf-flow"),
+					resource.TestCheckResourceAttr("alicloud_fnf_flow.flow", "type", "FDL"),
+					resource.TestCheckResourceAttrSet("alicloud_fnf_flow.flow", "flow_id"),
+					resource.TestCheckResourceAttr("alicloud_fnf_schedule.schedule", "schedule_name", "tf-testacc-fnf-schedule"),
+					resource.TestCheckResourceAttr("alicloud_fnf_schedule.schedule", "cron_expression", "0 0 * * * *"),
+					resource.TestCheckResourceAttr("alicloud_fnf_schedule.schedule", "enable", "true"),
+					resource.TestCheckResourceAttrSet("alicloud_fnf_schedule.schedule", "schedule_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFnfScheduleConfig("0 30 * * * *", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFnfScheduleExists("alicloud_fnf_schedule.schedule", &schedule),
+					resource.TestCheckResourceAttr("alicloud_fnf_schedule.schedule", "cron_expression", "0 30 * * * *"),
+					resource.TestCheckResourceAttr("alicloud_fnf_schedule.schedule", "enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudFnfSchedule_import(t *testing.T) {
+	resourceName := "alicloud_fnf_schedule.schedule"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFnfScheduleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFnfScheduleConfig("0 0 * * * *", true),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFnfScheduleExists(n string, schedule *FnfSchedule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FnF Schedule ID is set")
+		}
+
+		parts, err := parseFnfScheduleId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		sc, err := client.DescribeFnfSchedule(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*schedule = sc
+
+		return nil
+	}
+}
+
+func testAccCheckFnfScheduleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "alicloud_fnf_schedule":
+			parts, err := parseFnfScheduleId(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if _, err := client.DescribeFnfSchedule(parts[0], parts[1]); err != nil {
+				if NotFoundError(err) {
+					continue
+				}
+				return err
+			}
+			return fmt.Errorf("FnF Schedule %s still exist", rs.Primary.ID)
+		case "alicloud_fnf_flow":
+			if _, err := client.DescribeFnfFlow(rs.Primary.ID); err != nil {
+				if NotFoundError(err) {
+					continue
+				}
+				return err
+			}
+			return fmt.Errorf("FnF Flow %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccFnfScheduleConfig(cron string, enable bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_fnf_flow" "flow" {
+  name = "tf-testacc-fnf-flow"
+  description = "Terraform acc test"
+  definition = <<EOF
+version: v1
+type: flow
+steps:
+  - type: pass
+    name: hello
+EOF
+}
+
+resource "alicloud_fnf_schedule" "schedule" {
+  flow_name = "${alicloud_fnf_flow.flow.name}"
+  schedule_name = "tf-testacc-fnf-schedule"
+  cron_expression = "%s"
+  payload = "{\"greeting\": \"hello\"}"
+  enable = %t
+}
+`, cron, enable)
+}
@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
@@ -16,6 +15,9 @@ func resourceAliyunForwardEntry() *schema.Resource {
 		Read:   resourceAliyunForwardEntryRead,
 		Update: resourceAliyunForwardEntryUpdate,
 		Delete: resourceAliyunForwardEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunForwardEntryImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": &schema.Schema{
@@ -52,7 +54,8 @@ func resourceAliyunForwardEntry() *schema.Resource {
 }
 
 func resourceAliyunForwardEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
 
 	args := vpc.CreateCreateForwardEntryRequest()
 	args.RegionId = string(getRegion(d, meta))
@@ -63,21 +66,13 @@ func resourceAliyunForwardEntryCreate(d *schema.ResourceData, meta interface{})
 	args.InternalIp = d.Get("internal_ip").(string)
 	args.InternalPort = d.Get("internal_port").(string)
 
-	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		ar := args
-		resp, err := conn.CreateForwardEntry(ar)
-		if err != nil {
-			if IsExceptedError(err, InvalidIpNotInNatgw) {
-				return resource.RetryableError(fmt.Errorf("CreateForwardEntry timeout and got error: %#v", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("CreateNatGateway got error: %#v", err))
-		}
-		d.SetId(resp.ForwardEntryId)
-		d.Set("forward_table_id", d.Get("forward_table_id").(string))
-		return nil
+	resp := vpc.CreateCreateForwardEntryResponse()
+	if err := RetryOnError(VpcCode, 2*time.Minute, func() error {
+		return client.doAction(conn, args, resp)
 	}); err != nil {
-		return err
+		return WrapApiError(err, args.GetActionName(), args.ForwardTableId)
 	}
+	d.SetId(resp.ForwardEntryId)
 
 	return resourceAliyunForwardEntryRead(d, meta)
 }
@@ -92,7 +87,7 @@ func resourceAliyunForwardEntryRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return err
+		return WrapApiError(err, "DescribeForwardTableEntries", d.Id())
 	}
 
 	d.Set("forward_table_id", forwardEntry.ForwardTableId)
@@ -110,7 +105,7 @@ func resourceAliyunForwardEntryUpdate(d *schema.ResourceData, meta interface{})
 
 	forwardEntry, err := client.DescribeForwardEntry(d.Get("forward_table_id").(string), d.Id())
 	if err != nil {
-		return err
+		return WrapApiError(err, "DescribeForwardTableEntries", d.Id())
 	}
 
 	d.Partial(true)
@@ -144,8 +139,8 @@ func resourceAliyunForwardEntryUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if attributeUpdate {
-		if _, err := client.vpcconn.ModifyForwardEntry(args); err != nil {
-			return err
+		if err := client.doAction(client.vpcconn, args, vpc.CreateModifyForwardEntryResponse()); err != nil {
+			return WrapApiError(err, args.GetActionName(), d.Id())
 		}
 	}
 
@@ -162,28 +157,41 @@ func resourceAliyunForwardEntryDelete(d *schema.ResourceData, meta interface{})
 	args.ForwardTableId = d.Get("forward_table_id").(string)
 	args.ForwardEntryId = d.Id()
 
-	return resource.Retry(3*time.Minute, func() *resource.RetryError {
-		if _, err := client.vpcconn.DeleteForwardEntry(args); err != nil {
-			if IsExceptedError(err, InvalidForwardEntryIdNotFound) ||
-				IsExceptedError(err, InvalidForwardTableIdNotFound) {
-				return nil
-			}
-			return resource.NonRetryableError(err)
+	if err := RetryOnError(VpcCode, 3*time.Minute, func() error {
+		return client.doAction(client.vpcconn, args, vpc.CreateDeleteForwardEntryResponse())
+	}); err != nil {
+		if IsExceptedError(err, InvalidForwardEntryIdNotFound) ||
+			IsExceptedError(err, InvalidForwardTableIdNotFound) {
+			return nil
 		}
+		return WrapApiError(err, args.GetActionName(), d.Id())
+	}
 
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
 		forwardEntry, err := client.DescribeForwardEntry(d.Get("forward_table_id").(string), d.Id())
-
 		if err != nil {
 			if NotFoundError(err) {
 				return nil
 			}
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapApiError(err, "DescribeForwardTableEntries", d.Id()))
 		}
 
 		if forwardEntry.ForwardEntryId == d.Id() {
-			return resource.RetryableError(fmt.Errorf("Delete Forward Entry timeout and got an error:%#v.", err))
+			return resource.RetryableError(fmt.Errorf("Delete Forward Entry %s timeout.", d.Id()))
 		}
 
 		return nil
 	})
 }
+
+// resourceAliyunForwardEntryImportState imports a forward entry by <forward_table_id>:<forward_entry_id>
+// because the forward table id is required to describe the entry.
+func resourceAliyunForwardEntryImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), "forward entry", "forward_table_id", "forward_entry_id")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("forward_table_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,297 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHbrEcsBackupPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudHbrEcsBackupPlanCreate,
+		Read:   resourceAlicloudHbrEcsBackupPlanRead,
+		Update: resourceAlicloudHbrEcsBackupPlanUpdate,
+		Delete: resourceAlicloudHbrEcsBackupPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: hbrBackupPlanSchema(map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"paths": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"include": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"exclude": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			// The limits are separated by commas, and each one is formatted "{start}:{end}:{bandwidth}" in hours and KB/s,
+			// like "0:24:5120".
+			"speed_limit": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"options": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonDocument,
+				DiffSuppressFunc: jsonDiffSuppressFunc,
+			},
+		}),
+	}
+}
+
+// hbrBackupPlanSchema returns the schema of a backup plan with the fields of its source.
+func hbrBackupPlanSchema(source map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"vault_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"plan_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		// The schedule is formatted "I|{startTime}|{interval}", like "I|1602673264|P1D" to back up daily.
+		"schedule": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"retention": &schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validateIntegerInRange(1, 36500),
+		},
+		"backup_type": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "COMPLETE",
+			ValidateFunc: validateAllowedStringValue([]string{"COMPLETE"}),
+		},
+		"disabled": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+	for k, v := range source {
+		s[k] = v
+	}
+	return s
+}
+
+// buildHbrBackupPlanParams returns the parameters shared by CreateBackupPlan and UpdateBackupPlan.
+func buildHbrBackupPlanParams(d *schema.ResourceData, sourceType string) map[string]string {
+	return map[string]string{
+		"VaultId":    d.Get("vault_id").(string),
+		"SourceType": sourceType,
+		"PlanName":   d.Get("plan_name").(string),
+		"Schedule":   d.Get("schedule").(string),
+		"Retention":  strconv.Itoa(d.Get("retention").(int)),
+	}
+}
+
+// setHbrBackupPlanPaths sets the paths to back up as the repeated parameter Path.
+func setHbrBackupPlanPaths(params map[string]string, paths []interface{}) {
+	for i, p := range expandStringList(paths) {
+		params[fmt.Sprintf("Path.%d", i+1)] = p
+	}
+}
+
+func createHbrBackupPlan(d *schema.ResourceData, meta interface{}, params map[string]string) error {
+	client := meta.(*AliyunClient)
+
+	params["BackupType"] = d.Get("backup_type").(string)
+	var resp struct {
+		PlanId string `json:"PlanId"`
+	}
+	if err := RetryOnError(HbrCode, 3*time.Minute, func() error {
+		return client.ProcessHbrRequest("CreateBackupPlan", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateBackupPlan got an error")
+	}
+	d.SetId(resp.PlanId)
+
+	if d.Get("disabled").(bool) {
+		return switchHbrBackupPlan(d, meta, params["SourceType"])
+	}
+	return nil
+}
+
+func switchHbrBackupPlan(d *schema.ResourceData, meta interface{}, sourceType string) error {
+	action := "EnableBackupPlan"
+	if d.Get("disabled").(bool) {
+		action = "DisableBackupPlan"
+	}
+	if err := meta.(*AliyunClient).ProcessHbrRequest(action, map[string]string{
+		"PlanId":     d.Id(),
+		"VaultId":    d.Get("vault_id").(string),
+		"SourceType": sourceType,
+	}, nil); err != nil {
+		return WrapErrorf(err, "%s got an error", action)
+	}
+	return nil
+}
+
+// describeHbrBackupPlan describes the backup plan and sets the shared fields. It clears the id and returns nil if the
+// plan is not found.
+func describeHbrBackupPlan(d *schema.ResourceData, meta interface{}, sourceType string) (*HbrBackupPlan, error) {
+	plan, err := meta.(*AliyunClient).DescribeHbrBackupPlan(d.Id(), sourceType)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	d.Set("vault_id", plan.VaultId)
+	d.Set("plan_name", plan.PlanName)
+	d.Set("schedule", plan.Schedule)
+	d.Set("retention", plan.Retention)
+	d.Set("backup_type", plan.BackupType)
+	d.Set("disabled", plan.Disabled)
+
+	return &plan, nil
+}
+
+// updateHbrBackupPlan updates the plan with the params when any of the keys changes, and then enables or disables it.
+func updateHbrBackupPlan(d *schema.ResourceData, meta interface{}, params map[string]string, keys ...string) error {
+	d.Partial(true)
+
+	keys = append([]string{"plan_name", "schedule", "retention"}, keys...)
+	changed := false
+	for _, k := range keys {
+		changed = changed || d.HasChange(k)
+	}
+	if changed {
+		params["PlanId"] = d.Id()
+		if err := meta.(*AliyunClient).ProcessHbrRequest("UpdateBackupPlan", params, nil); err != nil {
+			return WrapErrorf(err, "UpdateBackupPlan got an error")
+		}
+		for _, k := range keys {
+			d.SetPartial(k)
+		}
+	}
+
+	if d.HasChange("disabled") {
+		if err := switchHbrBackupPlan(d, meta, params["SourceType"]); err != nil {
+			return err
+		}
+		d.SetPartial("disabled")
+	}
+
+	d.Partial(false)
+	return nil
+}
+
+func deleteHbrBackupPlan(d *schema.ResourceData, meta interface{}, sourceType string) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(HbrCode, 3*time.Minute, func() error {
+		return client.ProcessHbrRequest("DeleteBackupPlan", map[string]string{
+			"PlanId":     d.Id(),
+			"VaultId":    d.Get("vault_id").(string),
+			"SourceType": sourceType,
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, HbrPlanNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteBackupPlan got an error")
+	}
+
+	return nil
+}
+
+func buildHbrEcsBackupPlanParams(d *schema.ResourceData) (map[string]string, error) {
+	params := buildHbrBackupPlanParams(d, HbrSourceEcsFile)
+	setHbrBackupPlanPaths(params, d.Get("paths").([]interface{}))
+	for key, field := range map[string]string{"Include": "include", "Exclude": "exclude"} {
+		if v, ok := d.GetOk(field); ok {
+			b, err := json.Marshal(expandStringList(v.([]interface{})))
+			if err != nil {
+				return nil, WrapError(err)
+			}
+			params[key] = string(b)
+		}
+	}
+	if v, ok := d.GetOk("speed_limit"); ok {
+		params["SpeedLimit"] = v.(string)
+	}
+	if v, ok := d.GetOk("options"); ok {
+		params["Options"] = v.(string)
+	}
+	return params, nil
+}
+
+// The HBR client should be installed on the instance before backing it up.
+func resourceAlicloudHbrEcsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error {
+	params, err := buildHbrEcsBackupPlanParams(d)
+	if err != nil {
+		return err
+	}
+	params["InstanceId"] = d.Get("instance_id").(string)
+	if err := createHbrBackupPlan(d, meta, params); err != nil {
+		return err
+	}
+
+	return resourceAlicloudHbrEcsBackupPlanRead(d, meta)
+}
+
+func resourceAlicloudHbrEcsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
+	plan, err := describeHbrBackupPlan(d, meta, HbrSourceEcsFile)
+	if err != nil || plan == nil {
+		return err
+	}
+
+	d.Set("instance_id", plan.InstanceId)
+	d.Set("paths", plan.Paths.Path)
+	for field, value := range map[string]string{"include": plan.Include, "exclude": plan.Exclude} {
+		var l []string
+		if value != "" {
+			if err := json.Unmarshal([]byte(value), &l); err != nil {
+				return WrapError(err)
+			}
+		}
+		d.Set(field, l)
+	}
+	d.Set("speed_limit", plan.SpeedLimit)
+	d.Set("options", plan.Options)
+
+	return nil
+}
+
+func resourceAlicloudHbrEcsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	params, err := buildHbrEcsBackupPlanParams(d)
+	if err != nil {
+		return err
+	}
+	if err := updateHbrBackupPlan(d, meta, params, "paths", "include", "exclude", "speed_limit", "options"); err != nil {
+		return err
+	}
+
+	return resourceAlicloudHbrEcsBackupPlanRead(d, meta)
+}
+
+func resourceAlicloudHbrEcsBackupPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteHbrBackupPlan(d, meta, HbrSourceEcsFile)
+}
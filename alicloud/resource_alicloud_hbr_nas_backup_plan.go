@@ -0,0 +1,80 @@
+package alicloud
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHbrNasBackupPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudHbrNasBackupPlanCreate,
+		Read:   resourceAlicloudHbrNasBackupPlanRead,
+		Update: resourceAlicloudHbrNasBackupPlanUpdate,
+		Delete: resourceAlicloudHbrNasBackupPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: hbrBackupPlanSchema(map[string]*schema.Schema{
+			"file_system_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The file system is identified by its id and the time it was created, in seconds since the epoch.
+			"create_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"paths": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+		}),
+	}
+}
+
+func buildHbrNasBackupPlanParams(d *schema.ResourceData) map[string]string {
+	params := buildHbrBackupPlanParams(d, HbrSourceNas)
+	setHbrBackupPlanPaths(params, d.Get("paths").([]interface{}))
+	return params
+}
+
+func resourceAlicloudHbrNasBackupPlanCreate(d *schema.ResourceData, meta interface{}) error {
+	params := buildHbrNasBackupPlanParams(d)
+	params["FileSystemId"] = d.Get("file_system_id").(string)
+	params["CreateTime"] = strconv.Itoa(d.Get("create_time").(int))
+	if err := createHbrBackupPlan(d, meta, params); err != nil {
+		return err
+	}
+
+	return resourceAlicloudHbrNasBackupPlanRead(d, meta)
+}
+
+func resourceAlicloudHbrNasBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
+	plan, err := describeHbrBackupPlan(d, meta, HbrSourceNas)
+	if err != nil || plan == nil {
+		return err
+	}
+
+	d.Set("file_system_id", plan.FileSystemId)
+	d.Set("create_time", int(plan.CreateTime))
+	d.Set("paths", plan.Paths.Path)
+
+	return nil
+}
+
+func resourceAlicloudHbrNasBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateHbrBackupPlan(d, meta, buildHbrNasBackupPlanParams(d), "paths"); err != nil {
+		return err
+	}
+
+	return resourceAlicloudHbrNasBackupPlanRead(d, meta)
+}
+
+func resourceAlicloudHbrNasBackupPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteHbrBackupPlan(d, meta, HbrSourceNas)
+}
@@ -0,0 +1,71 @@
+package alicloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHbrOssBackupPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudHbrOssBackupPlanCreate,
+		Read:   resourceAlicloudHbrOssBackupPlanRead,
+		Update: resourceAlicloudHbrOssBackupPlanUpdate,
+		Delete: resourceAlicloudHbrOssBackupPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: hbrBackupPlanSchema(map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		}),
+	}
+}
+
+func buildHbrOssBackupPlanParams(d *schema.ResourceData) map[string]string {
+	params := buildHbrBackupPlanParams(d, HbrSourceOss)
+	if v, ok := d.GetOk("prefix"); ok {
+		params["Prefix"] = v.(string)
+	}
+	return params
+}
+
+func resourceAlicloudHbrOssBackupPlanCreate(d *schema.ResourceData, meta interface{}) error {
+	params := buildHbrOssBackupPlanParams(d)
+	params["Bucket"] = d.Get("bucket").(string)
+	if err := createHbrBackupPlan(d, meta, params); err != nil {
+		return err
+	}
+
+	return resourceAlicloudHbrOssBackupPlanRead(d, meta)
+}
+
+func resourceAlicloudHbrOssBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
+	plan, err := describeHbrBackupPlan(d, meta, HbrSourceOss)
+	if err != nil || plan == nil {
+		return err
+	}
+
+	d.Set("bucket", plan.Bucket)
+	d.Set("prefix", plan.Prefix)
+
+	return nil
+}
+
+func resourceAlicloudHbrOssBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateHbrBackupPlan(d, meta, buildHbrOssBackupPlanParams(d), "prefix"); err != nil {
+		return err
+	}
+
+	return resourceAlicloudHbrOssBackupPlanRead(d, meta)
+}
+
+func resourceAlicloudHbrOssBackupPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteHbrBackupPlan(d, meta, HbrSourceOss)
+}
@@ -0,0 +1,175 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudHbrOssBackupPlan_basic(t *testing.T) {
+	var plan HbrBackupPlan
+	rand := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_hbr_oss_backup_plan.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckHbrOssBackupPlanDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccHbrOssBackupPlanConfig(rand, 7, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrOssBackupPlanExists("alicloud_hbr_oss_backup_plan.foo", &plan),
+					resource.TestCheckResourceAttr("alicloud_hbr_vault.foo", "status", "CREATED"),
+					resource.TestCheckResourceAttr("alicloud_hbr_oss_backup_plan.foo", "plan_name", "tf-testAccHbrOssBackupPlan"),
+					resource.TestCheckResourceAttr("alicloud_hbr_oss_backup_plan.foo", "retention", "7"),
+					resource.TestCheckResourceAttr("alicloud_hbr_oss_backup_plan.foo", "disabled", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccHbrOssBackupPlanConfig(rand, 30, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHbrOssBackupPlanExists("alicloud_hbr_oss_backup_plan.foo", &plan),
+					resource.TestCheckResourceAttr("alicloud_hbr_oss_backup_plan.foo", "retention", "30"),
+					resource.TestCheckResourceAttr("alicloud_hbr_oss_backup_plan.foo", "disabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestHbrOssBackupPlanFailure(t *testing.T) {
+	var actions []string
+	client, server := newTestAliyunClient(t, HbrCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		actions = append(actions, r.FormValue("Action"))
+		switch r.FormValue("Action") {
+		case "CreateBackupPlan":
+			// HBR answers the failure with the http code 200, and it is reported by the Success and the Code.
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Success":false,"Code":"VaultNotExist","Message":"The vault does not exist."}`))
+		case "DescribeBackupPlans":
+			if r.FormValue("SourceType") != HbrSourceOss || r.FormValue("Filters.1.Key") != "planId" {
+				t.Errorf("Unexpected request %s", r.Form.Encode())
+			}
+			if r.FormValue("Filters.1.Values.1") == "po-abc" {
+				w.Write([]byte(`{"RequestId":"E5F6G7H8","Success":true,"Code":"200","BackupPlans":{"BackupPlan":[{"PlanId":"po-abc",` +
+					`"PlanName":"tf-testAcc","VaultId":"v-abc","SourceType":"OSS","Schedule":"I|1602673264|P1D","Retention":7,` +
+					`"BackupType":"COMPLETE","Disabled":true,"Bucket":"bucket","Prefix":"logs/"}]}}`))
+				return
+			}
+			w.Write([]byte(`{"RequestId":"I9J0K1L2","Success":false,"Code":"BackupPlanNotExist","Message":"The plan does not exist."}`))
+		default:
+			t.Errorf("Unexpected action %s", r.FormValue("Action"))
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAlicloudHbrOssBackupPlan().Schema, map[string]interface{}{
+		"vault_id":  "v-unknown",
+		"plan_name": "tf-testAcc",
+		"bucket":    "bucket",
+		"schedule":  "I|1602673264|P1D",
+		"retention": 7,
+	})
+	err := resourceAlicloudHbrOssBackupPlanCreate(d, client)
+	if err == nil || !IsExceptedError(err, HbrVaultNotFound) || GetRequestId(err) != "A1B2C3D4" {
+		t.Fatalf("Expected the create fails with %s, got %#v", HbrVaultNotFound, err)
+	}
+	if d.Id() != "" || len(actions) != 1 {
+		t.Fatalf("Expected no plan is created or read after the failure, got id %q and actions %v", d.Id(), actions)
+	}
+
+	d.SetId("po-abc")
+	if err := resourceAlicloudHbrOssBackupPlanRead(d, client); err != nil {
+		t.Fatalf("Reading the plan got an error: %#v", err)
+	}
+	if d.Get("bucket") != "bucket" || d.Get("prefix") != "logs/" || d.Get("retention") != 7 || d.Get("disabled") != true {
+		t.Fatalf("Expected the plan is decoded from the response, got %#v", d.State())
+	}
+
+	d.SetId("po-unknown")
+	if err := resourceAlicloudHbrOssBackupPlanRead(d, client); err != nil || d.Id() != "" {
+		t.Fatalf("Expected the plan is removed when HBR responds %s, got id %q and error %#v", HbrPlanNotFound, d.Id(), err)
+	}
+}
+
+func testAccCheckHbrOssBackupPlanExists(n string, plan *HbrBackupPlan) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HBR Backup Plan ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		p, err := client.DescribeHbrBackupPlan(rs.Primary.ID, HbrSourceOss)
+		if err != nil {
+			return err
+		}
+
+		*plan = p
+
+		return nil
+	}
+}
+
+func testAccCheckHbrOssBackupPlanDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "alicloud_hbr_oss_backup_plan":
+			if _, err := client.DescribeHbrBackupPlan(rs.Primary.ID, HbrSourceOss); err != nil {
+				if NotFoundError(err) {
+					continue
+				}
+				return err
+			}
+			return fmt.Errorf("HBR Backup Plan %s still exist", rs.Primary.ID)
+		case "alicloud_hbr_vault":
+			if _, err := client.DescribeHbrVault(rs.Primary.ID); err != nil {
+				if NotFoundError(err) {
+					continue
+				}
+				return err
+			}
+			return fmt.Errorf("HBR Vault %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccHbrOssBackupPlanConfig(rand, retention int, disabled bool) string {
+	return fmt.Sprintf(`
+resource "alicloud_oss_bucket" "foo" {
+  bucket = "tf-testacc-hbr-%d"
+}
+
+resource "alicloud_hbr_vault" "foo" {
+  vault_name  = "tf-testAccHbrVault"
+  description = "Terraform acc test"
+}
+
+resource "alicloud_hbr_oss_backup_plan" "foo" {
+  vault_id  = "${alicloud_hbr_vault.foo.id}"
+  plan_name = "tf-testAccHbrOssBackupPlan"
+  bucket    = "${alicloud_oss_bucket.foo.bucket}"
+  prefix    = "data/"
+  schedule  = "I|1602673264|P1D"
+  retention = %d
+  disabled  = %t
+}
+`, rand, retention, disabled)
+}
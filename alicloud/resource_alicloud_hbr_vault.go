@@ -0,0 +1,121 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudHbrVault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudHbrVaultCreate,
+		Read:   resourceAlicloudHbrVaultRead,
+		Update: resourceAlicloudHbrVaultUpdate,
+		Delete: resourceAlicloudHbrVaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vault_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vault_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "STANDARD",
+				ValidateFunc: validateAllowedStringValue([]string{"STANDARD"}),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudHbrVaultCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"VaultName":   d.Get("vault_name").(string),
+		"VaultType":   d.Get("vault_type").(string),
+		"Description": d.Get("description").(string),
+		"ClientToken": buildClientToken("TF-CreateVault"),
+	}
+	var resp struct {
+		VaultId string `json:"VaultId"`
+	}
+	if err := RetryOnError(HbrCode, 3*time.Minute, func() error {
+		return client.ProcessHbrRequest("CreateVault", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateVault got an error")
+	}
+	d.SetId(resp.VaultId)
+
+	if err := client.WaitForHbrVault(d.Id(), timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "WaitForHbrVault %s got an error", d.Id())
+	}
+
+	return resourceAlicloudHbrVaultRead(d, meta)
+}
+
+func resourceAlicloudHbrVaultRead(d *schema.ResourceData, meta interface{}) error {
+	vault, err := meta.(*AliyunClient).DescribeHbrVault(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("vault_name", vault.VaultName)
+	d.Set("vault_type", vault.VaultType)
+	d.Set("description", vault.Description)
+	d.Set("status", vault.Status)
+
+	return nil
+}
+
+func resourceAlicloudHbrVaultUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := client.ProcessHbrRequest("UpdateVault", map[string]string{
+		"VaultId":     d.Id(),
+		"VaultName":   d.Get("vault_name").(string),
+		"Description": d.Get("description").(string),
+	}, nil); err != nil {
+		return WrapErrorf(err, "UpdateVault got an error")
+	}
+
+	return resourceAlicloudHbrVaultRead(d, meta)
+}
+
+// A vault can not be deleted before its backup plans.
+func resourceAlicloudHbrVaultDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(HbrCode, 3*time.Minute, func() error {
+		return client.ProcessHbrRequest("DeleteVault", map[string]string{
+			"VaultId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, HbrVaultNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteVault got an error")
+	}
+
+	return nil
+}
@@ -6,9 +6,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ecs"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -22,6 +22,12 @@ func resourceAliyunInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": &schema.Schema{
 				Type:     schema.TypeString,
@@ -36,9 +42,20 @@ func resourceAliyunInstance() *schema.Resource {
 			},
 
 			"instance_type": &schema.Schema{
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInstanceType,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateInstanceType,
+				ConflictsWith: []string{"instance_type_candidates"},
+			},
+
+			// The candidates are only used when creating the instance, and the chosen one is exported by instance_type.
+			"instance_type_candidates": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: validateInstanceType},
+				ConflictsWith: []string{"instance_type"},
 			},
 
 			"security_groups": &schema.Schema{
@@ -96,6 +113,16 @@ func resourceAliyunInstance() *schema.Resource {
 				Optional:  true,
 				Sensitive: true,
 			},
+			"kms_encrypted_password": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"kms_encryption_context": &schema.Schema{
+				Type:             schema.TypeMap,
+				Optional:         true,
+				DiffSuppressFunc: kmsDiffSuppressFunc,
+			},
 			"io_optimized": &schema.Schema{
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -107,7 +134,7 @@ func resourceAliyunInstance() *schema.Resource {
 			},
 			"system_disk_category": &schema.Schema{
 				Type:         schema.TypeString,
-				Default:      ecs.DiskCategoryCloudEfficiency,
+				Default:      DiskCategoryCloudEfficiency,
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validateDiskCategory,
@@ -159,6 +186,8 @@ func resourceAliyunInstance() *schema.Resource {
 				ValidateFunc:     validateInstanceChargeTypePeriodUnit,
 				DiffSuppressFunc: ecsPostPaidDiffSuppressFunc,
 			},
+			"renewal_status":    renewalStatusSchema(),
+			"auto_renew_period": autoRenewPeriodSchema(),
 			"include_data_disks": &schema.Schema{
 				Type:             schema.TypeBool,
 				Optional:         true,
@@ -177,9 +206,12 @@ func resourceAliyunInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			// The declared status is reconciled by the update when the instance is started or stopped out of band.
 			"status": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(Running), string(Stopped)}),
 			},
 
 			"user_data": &schema.Schema{
@@ -205,7 +237,7 @@ func resourceAliyunInstance() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ForceNew:         true,
-				Default:          ecs.NoSpot,
+				Default:          NoSpot,
 				ValidateFunc:     validateInstanceSpotStrategy,
 				DiffSuppressFunc: ecsSpotStrategyDiffSuppressFunc,
 			},
@@ -217,13 +249,75 @@ func resourceAliyunInstance() *schema.Resource {
 				DiffSuppressFunc: ecsSpotPriceLimitDiffSuppressFunc,
 			},
 
+			"stopped_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      StoppedModeKeepCharging,
+				ValidateFunc: validateAllowedStringValue([]string{StoppedModeKeepCharging, StoppedModeStopCharging}),
+			},
+
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// It is only valid for the burstable instance types, such as ecs.t5 and ecs.t6.
+			"credit_specification": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{CreditSpecificationStandard, CreditSpecificationUnlimited}),
+			},
+
+			"deployment_set_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"primary_network_interface_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mac_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_interfaces": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_interface_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary_ip_address": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 
 	// Ensure instance_type is generation three
 	validData, err := meta.(*AliyunClient).CheckParameterValidity(d, meta)
@@ -235,33 +329,75 @@ func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
-	args.IoOptimized = validData[IoOptimizedKey].(ecs.IoOptimized)
-
-	instanceID, err := conn.CreateInstance(args)
-	if err != nil {
-		return fmt.Errorf("Error creating Aliyun ecs instance: %#v", err)
+	args.IoOptimized = string(validData[IoOptimizedKey].(IoOptimizedType))
+
+	instanceTypes := []string{args.InstanceType}
+	if v, ok := d.GetOk("instance_type_candidates"); ok {
+		instanceTypes = expandStringList(v.([]interface{}))
+	} else if args.InstanceType == "" {
+		return fmt.Errorf("One of instance_type and instance_type_candidates must be set.")
+	}
+
+	// Try the instance types in order. If the zone is pinned neither by availability_zone nor by the vswitch, try each type
+	// in the zones where it is available. Fall back to the next attempt if the type is out of stock or not offered.
+	// Every attempt has a ClientToken of its own, which is only reused by the retries of the same attempt, since the
+	// service returns the result of the first request for a token whatever the type and the zone of the later ones.
+	var instanceID string
+	var capacityErr error
+	pinnedZoneId := args.ZoneId
+Attempts:
+	for _, instanceType := range instanceTypes {
+		zoneIds := []string{pinnedZoneId}
+		if pinnedZoneId == "" && args.VSwitchId == "" {
+			available, err := client.DescribeAvailableInstanceZones(instanceType, args.InstanceChargeType, args.SpotStrategy)
+			if err != nil {
+				return WrapError(err)
+			}
+			if len(available) > 0 {
+				zoneIds = available
+			}
+		}
+		for _, zoneId := range zoneIds {
+			args.InstanceType = instanceType
+			args.ZoneId = zoneId
+			args.ClientToken = buildClientToken("TF-CreateInstance")
+			response := ecs.CreateCreateInstanceResponse()
+			err = client.doAction(client.ecsconn(), args, response)
+			if err == nil {
+				instanceID = response.InstanceId
+				break Attempts
+			}
+			if !IsInstanceCapacityError(err) {
+				return WrapApiError(err, "CreateInstance", "")
+			}
+			log.Printf("[WARN] Creating instance with the type %s in the zone %s got an error: %#v. Trying the next candidate.", instanceType, zoneId, err)
+			capacityErr = err
+		}
+	}
+	if instanceID == "" {
+		return WrapErrorf(WrapApiError(capacityErr, "CreateInstance", ""), "None of the instance types and zones has capacity")
 	}
 
 	d.SetId(instanceID)
 
 	// after instance created, its status is pending,
 	// so we need to wait it become to stopped and then start it
-	if err := conn.WaitForInstanceAsyn(d.Id(), ecs.Stopped, DefaultTimeout); err != nil {
-		return fmt.Errorf("WaitForInstance %s got error: %#v", ecs.Stopped, err)
+	if err := client.WaitForInstance(d.Id(), Stopped, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForInstance %s got an error", Stopped), "", d.Id())
 	}
 
-	if args.InternetMaxBandwidthOut > 0 {
-		if _, err := conn.AllocatePublicIpAddress(d.Id()); err != nil {
-			return fmt.Errorf("[DEBUG] AllocatePublicIpAddress for instance got error: %#v", err)
+	if d.Get("internet_max_bandwidth_out").(int) > 0 {
+		if err := client.AllocatePublicIpAddress(d.Id()); err != nil {
+			return WrapApiError(err, "AllocatePublicIpAddress", d.Id())
 		}
 	}
 
-	if err := conn.StartInstance(d.Id()); err != nil {
-		return fmt.Errorf("Start instance got error: %#v", err)
+	if err := client.StartInstance(d.Id()); err != nil {
+		return WrapApiError(err, "StartInstance", d.Id())
 	}
 
-	if err := conn.WaitForInstanceAsyn(d.Id(), ecs.Running, 500); err != nil {
-		return fmt.Errorf("WaitForInstance %s got error: %#v", ecs.Running, err)
+	if err := client.WaitForInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForInstance %s got an error", Running), "", d.Id())
 	}
 
 	return resourceAliyunInstanceUpdate(d, meta)
@@ -269,16 +405,15 @@ func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
 
-	instance, err := client.QueryInstancesById(d.Id())
+	instance, err := client.DescribeEcsInstance(d.Id())
 
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error DescribeInstanceAttribute: %#v", err)
+		return WrapApiError(err, "DescribeInstances", d.Id())
 	}
 
 	disk, diskErr := client.QueryInstanceSystemDisk(d.Id())
@@ -288,7 +423,7 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error DescribeSystemDisk: %#v", err)
+		return WrapApiError(diskErr, "DescribeDisks", d.Id())
 	}
 
 	d.Set("instance_name", instance.InstanceName)
@@ -304,6 +439,14 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("internet_max_bandwidth_out", instance.InternetMaxBandwidthOut)
 	d.Set("internet_max_bandwidth_in", instance.InternetMaxBandwidthIn)
 	d.Set("instance_charge_type", instance.InstanceChargeType)
+	d.Set("deletion_protection", instance.DeletionProtection)
+	if instance.CreditSpecification != "" {
+		d.Set("credit_specification", instance.CreditSpecification)
+	}
+	d.Set("deployment_set_id", instance.DeploymentSetId)
+	if err := readRenewal(client, BssProductEcs, d); err != nil {
+		return err
+	}
 	d.Set("key_name", instance.KeyPairName)
 	d.Set("spot_strategy", instance.SpotStrategy)
 	d.Set("spot_price_limit", instance.SpotPriceLimit)
@@ -323,7 +466,7 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	if len(instance.VpcAttributes.PrivateIpAddress.IpAddress) > 0 {
 		d.Set("private_ip", instance.VpcAttributes.PrivateIpAddress.IpAddress[0])
 	} else {
-		d.Set("private_ip", strings.Join(ecs.IpAddressSetType(instance.InnerIpAddress).IpAddress, ","))
+		d.Set("private_ip", strings.Join(instance.InnerIpAddress.IpAddress, ","))
 	}
 
 	sgs := make([]string, 0, len(instance.SecurityGroupIds.SecurityGroupId))
@@ -335,25 +478,28 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if d.Get("user_data").(string) != "" {
-		ud, err := conn.DescribeUserdata(&ecs.DescribeUserdataArgs{
-			RegionId:   getRegion(d, meta),
-			InstanceId: d.Id(),
-		})
+	if err := setInstanceNetworkInterfaces(d, client); err != nil {
+		return err
+	}
 
-		if err != nil {
+	if d.Get("user_data").(string) != "" {
+		request := ecs.CreateDescribeUserDataRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.InstanceId = d.Id()
+		ud := ecs.CreateDescribeUserDataResponse()
+		if err := client.doAction(client.ecsconn(), request, ud); err != nil {
 			log.Printf("[ERROR] DescribeUserData for instance got error: %#v", err)
 		}
 		d.Set("user_data", userDataHashSum(ud.UserData))
 	}
 
 	if len(instance.VpcAttributes.VSwitchId) > 0 {
+		request := ecs.CreateDescribeInstanceRamRoleRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.InstanceIds = convertListToJsonString([]interface{}{d.Id()})
 		for {
-			response, err := conn.DescribeInstanceRamRole(&ecs.AttachInstancesArgs{
-				RegionId:    getRegion(d, meta),
-				InstanceIds: convertListToJsonString([]interface{}{d.Id()}),
-			})
-			if err != nil {
+			response := ecs.CreateDescribeInstanceRamRoleResponse()
+			if err := client.doAction(client.ecsconn(), request, response); err != nil {
 				if IsExceptedError(err, RoleAttachmentUnExpectedJson) {
 					continue
 				}
@@ -368,34 +514,36 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	tags, _, err := conn.DescribeTags(&ecs.DescribeTagsArgs{
-		RegionId:     getRegion(d, meta),
-		ResourceType: ecs.TagResourceInstance,
-		ResourceId:   d.Id(),
-	})
+	tags, err := client.describeEcsTags(getRegion(d, meta), TagResourceInstance, d.Id())
 
 	if err != nil {
 		log.Printf("[ERROR] DescribeTags for instance got error: %#v", err)
 	}
-	d.Set("tags", tagsToMap(tags))
+	d.Set("tags", client.ignoreDefaultTags(d, tagsToMap(tags)))
 
 	return nil
 }
 
 func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
 
 	d.Partial(true)
 
-	if err := setTags(client, ecs.TagResourceInstance, d); err != nil {
+	if err := setTags(client, TagResourceInstance, d); err != nil {
 		log.Printf("[DEBUG] Set tags for instance got error: %#v", err)
-		return fmt.Errorf("Set tags for instance got error: %#v", err)
+		return WrapApiError(err, "AddTags", d.Id())
 	} else {
 		d.SetPartial("tags")
 	}
 
-	if d.HasChange("security_groups") {
+	// Moving the instance to another VPC, including migrating a classic network instance into a VPC, replaces its
+	// security groups with the ones of the new VPC, which can not be joined before it is moved.
+	vpcId, err := instanceVpcMigration(d, client)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("security_groups") && vpcId == "" {
 		o, n := d.GetChange("security_groups")
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
@@ -425,7 +573,7 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	vpcUpdate, err := modifyVpcAttribute(d, meta, run)
+	vpcUpdate, err := modifyVpcAttribute(d, meta, vpcId, run)
 	if err != nil {
 		return err
 	}
@@ -442,26 +590,26 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 	if imageUpdate || vpcUpdate || passwordUpdate || typeUpdate {
 		run = true
 		log.Printf("[INFO] Need rebooting to make all changes valid.")
-		instance, errDesc := conn.DescribeInstanceAttribute(d.Id())
+		instance, errDesc := client.DescribeEcsInstance(d.Id())
 		if errDesc != nil {
-			return fmt.Errorf("Describe instance got an error: %#v", errDesc)
+			return WrapApiError(errDesc, "DescribeInstances", d.Id())
 		}
-		if instance.Status == ecs.Running {
+		if instance.Status == string(Running) {
 			log.Printf("[DEBUG] Stop instance when changing image or password or vpc attribute")
-			if err := conn.StopInstance(d.Id(), false); err != nil {
-				return fmt.Errorf("StopInstance got error: %#v", err)
+			if err := client.StopInstance(d.Id(), false, d.Get("stopped_mode").(string)); err != nil {
+				return WrapApiError(err, "StopInstance", d.Id())
 			}
 		}
 
-		if err := conn.WaitForInstanceAsyn(d.Id(), ecs.Stopped, DefaultTimeout); err != nil {
-			return fmt.Errorf("WaitForInstance %s got error: %#v", ecs.Stopped, err)
+		if err := client.WaitForInstance(d.Id(), Stopped, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForInstance %s got an error", Stopped), "", d.Id())
 		}
 
 		if _, err := modifyInstanceImage(d, meta, run); err != nil {
 			return err
 		}
 
-		if _, err := modifyVpcAttribute(d, meta, run); err != nil {
+		if _, err := modifyVpcAttribute(d, meta, vpcId, run); err != nil {
 			return err
 		}
 
@@ -469,14 +617,17 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			return err
 		}
 
-		log.Printf("[DEBUG] Start instance after changing image or password or vpc attribute")
-		if err := conn.StartInstance(d.Id()); err != nil {
-			return fmt.Errorf("StartInstance got error: %#v", err)
-		}
+		// The instance is left stopped if it is declared so.
+		if d.Get("status").(string) != string(Stopped) {
+			log.Printf("[DEBUG] Start instance after changing image or password or vpc attribute")
+			if err := client.StartInstance(d.Id()); err != nil {
+				return WrapApiError(err, "StartInstance", d.Id())
+			}
 
-		// Start instance sometimes costs more than 8 minutes when os type is centos.
-		if err := conn.WaitForInstance(d.Id(), ecs.Running, 500); err != nil {
-			return fmt.Errorf("WaitForInstance got error: %#v", err)
+			// Start instance sometimes costs more than 8 minutes when os type is centos.
+			if err := client.WaitForInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+				return WrapApiError(WrapErrorf(err, "WaitForInstance %s got an error", Running), "", d.Id())
+			}
 		}
 	}
 
@@ -484,64 +635,129 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if err := modifyInstanceProtectionAndCredit(d, meta); err != nil {
+		return err
+	}
+
+	if err := modifyInstanceStatus(d, meta); err != nil {
+		return err
+	}
+
 	if err := modifyInstanceChargeType(d, meta); err != nil {
 		return err
 	}
 
+	if err := setRenewal(client, BssProductEcs, d); err != nil {
+		return err
+	}
+
 	d.Partial(false)
 	return resourceAliyunInstanceRead(d, meta)
 }
 
-func resourceAliyunInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+// modifyInstanceStatus starts or stops the instance when its status differs from the declared one, for example
+// after it was stopped in the console.
+func modifyInstanceStatus(d *schema.ResourceData, meta interface{}) error {
+	status := d.Get("status").(string)
+	if !d.HasChange("status") || status == "" {
+		return nil
+	}
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
-	if common.InstanceChargeType(d.Get("instance_charge_type").(string)) == common.PrePaid {
-		return fmt.Errorf("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically.")
+	instance, err := client.DescribeEcsInstance(d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeInstances", d.Id())
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		instance, err := client.QueryInstancesById(d.Id())
-		if err != nil {
-			if NotFoundError(err) {
-				return nil
+	if instance.Status != status {
+		log.Printf("[INFO] The status of instance %s is %s, and it is changed to the declared %s.", d.Id(), instance.Status, status)
+		if status == string(Running) {
+			if err := client.StartInstance(d.Id()); err != nil {
+				return WrapApiError(err, "StartInstance", d.Id())
 			}
+		} else if err := client.StopInstance(d.Id(), false, d.Get("stopped_mode").(string)); err != nil {
+			return WrapApiError(err, "StopInstance", d.Id())
+		}
+		if err := client.WaitForInstance(d.Id(), Status(status), timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForInstance %s got an error", status), "", d.Id())
 		}
+	}
+	d.SetPartial("status")
+	return nil
+}
 
-		if instance == nil {
+// modifyInstanceProtectionAndCredit sets the deletion protection and the credit specification of the instance.
+func modifyInstanceProtectionAndCredit(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	args := ecs.CreateModifyInstanceAttributeRequest()
+	args.InstanceId = d.Id()
+	update := false
+	if d.HasChange("deletion_protection") {
+		args.DeletionProtection = requests.NewBoolean(d.Get("deletion_protection").(bool))
+		update = true
+	}
+	if d.HasChange("credit_specification") {
+		args.CreditSpecification = d.Get("credit_specification").(string)
+		update = args.CreditSpecification != "" || update
+	}
+	if !update {
+		return nil
+	}
+	if err := client.doAction(client.ecsconn(), args, ecs.CreateModifyInstanceAttributeResponse()); err != nil {
+		return WrapApiError(err, "ModifyInstanceAttribute", d.Id())
+	}
+	d.SetPartial("deletion_protection")
+	d.SetPartial("credit_specification")
+	return nil
+}
+
+func resourceAliyunInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	if common.InstanceChargeType(d.Get("instance_charge_type").(string)) == common.PrePaid {
+		return fmt.Errorf("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+	instance, err := client.QueryInstancesById(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
 			return nil
 		}
+		return WrapApiError(err, "DescribeInstances", d.Id())
+	}
 
-		if instance.Status != ecs.Stopped {
-			if err := conn.StopInstance(d.Id(), true); err != nil {
-				return resource.RetryableError(fmt.Errorf("Stop instance timeout and got an error: %#v.", err))
-			}
-
-			if err := conn.WaitForInstance(d.Id(), ecs.Stopped, DefaultTimeout); err != nil {
-				return resource.RetryableError(fmt.Errorf("Waiting for ecs stopped timeout and got an error: %#v.", err))
-			}
+	if instance.Status != string(Stopped) {
+		if err := client.StopInstance(d.Id(), true, ""); err != nil {
+			return WrapApiError(err, "StopInstance", d.Id())
 		}
 
-		if err := conn.DeleteInstance(d.Id()); err != nil {
-			return resource.RetryableError(fmt.Errorf("Delete instance timeout and got an error: %#v.", err))
+		if err := client.WaitForInstance(d.Id(), Stopped, timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForInstance %s got an error", Stopped), "", d.Id())
 		}
+	}
 
-		return nil
-	})
+	request := ecs.CreateDeleteInstanceRequest()
+	request.InstanceId = d.Id()
+	if err := RetryOnError(EcsCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.doAction(client.ecsconn(), request, ecs.CreateDeleteInstanceResponse())
+	}); err != nil {
+		if IsDeletionProtectionError(err) {
+			return WrapDeletionProtectionError(err, "instance", d.Id())
+		}
+		return WrapApiError(err, "DeleteInstance", d.Id())
+	}
 
+	return nil
 }
 
-func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.CreateInstanceArgs, error) {
+func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.CreateInstanceRequest, error) {
 	client := meta.(*AliyunClient)
 
-	args := &ecs.CreateInstanceArgs{
-		RegionId:     getRegion(d, meta),
-		InstanceType: d.Get("instance_type").(string),
-	}
+	args := ecs.CreateCreateInstanceRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.InstanceType = d.Get("instance_type").(string)
 
 	imageID := d.Get("image_id").(string)
 
 	args.ImageId = imageID
 
-	systemDiskCategory := ecs.DiskCategory(d.Get("system_disk_category").(string))
+	systemDiskCategory := DiskCategory(d.Get("system_disk_category").(string))
 	systemDiskSize := d.Get("system_disk_size").(int)
 
 	zoneID := d.Get("availability_zone").(string)
@@ -552,7 +768,7 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Cre
 			return nil, err
 		}
 
-		if err := client.ResourceAvailable(zone, ecs.ResourceTypeInstance); err != nil {
+		if err := client.ResourceAvailable(zone, ResourceTypeInstance); err != nil {
 			return nil, err
 		}
 
@@ -564,9 +780,9 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Cre
 
 	}
 
-	args.SystemDisk = ecs.SystemDiskType{
-		Category: systemDiskCategory,
-		Size:     systemDiskSize,
+	args.SystemDiskCategory = string(systemDiskCategory)
+	if systemDiskSize > 0 {
+		args.SystemDiskSize = requests.NewInteger(systemDiskSize)
 	}
 
 	sgs, ok := d.GetOk("security_groups")
@@ -590,19 +806,23 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Cre
 	}
 
 	if v := d.Get("internet_charge_type").(string); v != "" {
-		args.InternetChargeType = common.InternetChargeType(v)
+		args.InternetChargeType = v
 	}
 
 	if v := d.Get("internet_max_bandwidth_out").(int); v != 0 {
-		args.InternetMaxBandwidthOut = v
+		args.InternetMaxBandwidthOut = requests.NewInteger(v)
 	}
 
 	if v := d.Get("host_name").(string); v != "" {
 		args.HostName = v
 	}
 
-	if v := d.Get("password").(string); v != "" {
-		args.Password = v
+	password, err := getPassword(d, client)
+	if err != nil {
+		return nil, err
+	}
+	if password != "" {
+		args.Password = password
 	}
 
 	vswitchValue := d.Get("subnet_id").(string)
@@ -617,18 +837,18 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Cre
 	}
 
 	if v := d.Get("instance_charge_type").(string); v != "" {
-		args.InstanceChargeType = common.InstanceChargeType(v)
+		args.InstanceChargeType = v
 	}
 
-	if args.InstanceChargeType == common.PrePaid {
-		args.Period = d.Get("period").(int)
-		args.PeriodUnit = common.TimeType(d.Get("period_unit").(string))
+	if args.InstanceChargeType == string(common.PrePaid) {
+		args.Period = requests.NewInteger(d.Get("period").(int))
+		args.PeriodUnit = d.Get("period_unit").(string)
 	} else {
 		if v := d.Get("spot_strategy").(string); v != "" {
-			args.SpotStrategy = ecs.SpotStrategyType(v)
+			args.SpotStrategy = v
 		}
 		if v := d.Get("spot_price_limit").(float64); v > 0 {
-			args.SpotPriceLimit = v
+			args.SpotPriceLimit = requests.NewFloat(v)
 		}
 	}
 
@@ -647,6 +867,10 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Cre
 		args.KeyPairName = v
 	}
 
+	if v := d.Get("deployment_set_id").(string); v != "" {
+		args.DeploymentSetId = v
+	}
+
 	return args, nil
 }
 
@@ -655,25 +879,24 @@ func modifyInstanceChargeType(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 
 	if d.HasChange("instance_charge_type") {
 		chargeType := d.Get("instance_charge_type").(string)
 		if common.InstanceChargeType(chargeType) == common.PostPaid {
 			return fmt.Errorf("Instance can't support to modify its charge type to 'PostPaid'.")
 		}
-		args := &ecs.ModifyInstanceChargeTypeArgs{
-			InstanceIds:      convertListToJsonString(append(make([]interface{}, 0, 1), d.Id())),
-			RegionId:         getRegion(d, meta),
-			Period:           d.Get("period").(int),
-			PeriodUnit:       common.TimeType(d.Get("period_unit").(string)),
-			IncludeDataDisks: d.Get("include_data_disks").(bool),
-			AutoPay:          true,
-			DryRun:           d.Get("dry_run").(bool),
-			ClientToken:      fmt.Sprintf("terraform-modify-instance-charge-type-%s", d.Id()),
-		}
-		if _, err := conn.ModifyInstanceChargeType(args); err != nil {
-			return fmt.Errorf("ModifyInstanceChareType got an error:%#v.", err)
+		args := ecs.CreateModifyInstanceChargeTypeRequest()
+		args.InstanceIds = convertListToJsonString(append(make([]interface{}, 0, 1), d.Id()))
+		args.RegionId = string(getRegion(d, meta))
+		args.Period = requests.NewInteger(d.Get("period").(int))
+		args.PeriodUnit = d.Get("period_unit").(string)
+		args.IncludeDataDisks = requests.NewBoolean(d.Get("include_data_disks").(bool))
+		args.AutoPay = requests.NewBoolean(true)
+		args.DryRun = requests.NewBoolean(d.Get("dry_run").(bool))
+		args.ClientToken = fmt.Sprintf("terraform-modify-instance-charge-type-%s", d.Id())
+		if err := client.doAction(client.ecsconn(), args, ecs.CreateModifyInstanceChargeTypeResponse()); err != nil {
+			return WrapApiError(err, "ModifyInstanceChargeType", d.Id())
 		}
 		d.SetPartial("instance_charge_type")
 		return nil
@@ -686,7 +909,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 	if d.IsNewResource() {
 		return false, nil
 	}
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 	update := false
 	if d.HasChange("image_id") {
 		update = true
@@ -694,36 +917,28 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 			return update, nil
 		}
 		log.Printf("[DEBUG] Replace instance system disk via changing image_id")
-		replaceSystemArgs := &ecs.ReplaceSystemDiskArgs{
-			InstanceId: d.Id(),
-			ImageId:    d.Get("image_id").(string),
-			SystemDisk: ecs.SystemDiskType{
-				Size: d.Get("system_disk_size").(int),
-			},
+		replaceSystemArgs := ecs.CreateReplaceSystemDiskRequest()
+		replaceSystemArgs.InstanceId = d.Id()
+		replaceSystemArgs.ImageId = d.Get("image_id").(string)
+		if size := d.Get("system_disk_size").(int); size > 0 {
+			replaceSystemArgs.SystemDiskSize = requests.NewInteger(size)
 		}
 
-		_, err := conn.ReplaceSystemDisk(replaceSystemArgs)
+		err := client.doAction(client.ecsconn(), replaceSystemArgs, ecs.CreateReplaceSystemDiskResponse())
 		if err != nil {
-			return update, fmt.Errorf("Replace system disk got an error: %#v", err)
+			return update, WrapApiError(err, "ReplaceSystemDisk", d.Id())
 		}
 
 		// Ensure instance's image has been replaced successfully.
-		timeout := ecs.InstanceDefaultTimeout
-		for {
-			instance, errDesc := conn.DescribeInstanceAttribute(d.Id())
-			if errDesc != nil {
-				return update, fmt.Errorf("Describe instance got an error: %#v", errDesc)
-			}
-
-			if instance.ImageId == d.Get("image_id") {
-				break
-			}
-			time.Sleep(ecs.DefaultWaitForInterval * time.Second)
-
-			timeout = timeout - ecs.DefaultWaitForInterval
-			if timeout <= 0 {
-				return update, common.GetClientErrorFromString("Timeout")
+		oldImage, newImage := d.GetChange("image_id")
+		if err := waitForStatus("Instance image", func() (interface{}, string, error) {
+			instance, err := client.DescribeEcsInstance(d.Id())
+			if err != nil {
+				return nil, "", WrapError(err)
 			}
+			return instance, instance.ImageId, nil
+		}, []string{oldImage.(string)}, newImage.(string), timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return update, WrapApiError(WrapErrorf(err, "Waiting for replacing the image got an error"), "", d.Id())
 		}
 
 		d.SetPartial("system_disk_size")
@@ -743,9 +958,8 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 
 	update := false
 	reboot := false
-	args := &ecs.ModifyInstanceAttributeArgs{
-		InstanceId: d.Id(),
-	}
+	args := ecs.CreateModifyInstanceAttributeRequest()
+	args.InstanceId = d.Id()
 
 	if d.HasChange("instance_name") {
 		log.Printf("[DEBUG] ModifyInstanceAttribute instance_name")
@@ -768,32 +982,66 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 		update = true
 	}
 
-	if d.HasChange("password") {
+	if d.HasChange("password") || d.HasChange("kms_encrypted_password") || d.HasChange("kms_encryption_context") {
 		log.Printf("[DEBUG] ModifyInstanceAttribute password")
+		password, err := getPassword(d, meta.(*AliyunClient))
+		if err != nil {
+			return reboot, err
+		}
 		d.SetPartial("password")
-		args.Password = d.Get("password").(string)
+		d.SetPartial("kms_encrypted_password")
+		d.SetPartial("kms_encryption_context")
+		args.Password = password
 		update = true
 		reboot = true
 	}
 
 	if update {
-		if err := meta.(*AliyunClient).ecsconn.ModifyInstanceAttribute(args); err != nil {
-			return reboot, fmt.Errorf("Modify instance attribute got error: %#v", err)
+		client := meta.(*AliyunClient)
+		if err := client.doAction(client.ecsconn(), args, ecs.CreateModifyInstanceAttributeResponse()); err != nil {
+			return reboot, WrapApiError(err, "ModifyInstanceAttribute", d.Id())
 		}
 	}
 	return reboot, nil
 }
 
-func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+// instanceVpcMigration returns the ID of the VPC of the new vswitch if it is not the VPC of the instance, which means
+// the instance is moved to another VPC or it is migrated from the classic network. Otherwise it returns empty.
+func instanceVpcMigration(d *schema.ResourceData, client *AliyunClient) (string, error) {
+	if d.IsNewResource() || !(d.HasChange("vswitch_id") || d.HasChange("subnet_id")) {
+		return "", nil
+	}
+	vswitchId := d.Get("vswitch_id").(string)
+	if d.HasChange("subnet_id") {
+		vswitchId = d.Get("subnet_id").(string)
+	}
+	if vswitchId == "" {
+		return "", nil
+	}
+
+	instance, err := client.DescribeEcsInstance(d.Id())
+	if err != nil {
+		return "", WrapApiError(err, "DescribeInstances", d.Id())
+	}
+	vsw, err := client.DescribeVswitch(vswitchId)
+	if err != nil {
+		return "", WrapApiError(err, "DescribeVSwitchAttributes", vswitchId)
+	}
+	if vsw.VpcId == instance.VpcAttributes.VpcId {
+		return "", nil
+	}
+	return vsw.VpcId, nil
+}
+
+func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, vpcId string, run bool) (bool, error) {
 	if d.IsNewResource() {
 		return false, nil
 	}
 
 	update := false
-	vpcArgs := &ecs.ModifyInstanceVpcAttributeArgs{
-		InstanceId: d.Id(),
-		VSwitchId:  d.Get("vswitch_id").(string),
-	}
+	vpcArgs := ecs.CreateModifyInstanceVpcAttributeRequest()
+	vpcArgs.InstanceId = d.Id()
+	vpcArgs.VSwitchId = d.Get("vswitch_id").(string)
 
 	if d.HasChange("vswitch_id") {
 		update = true
@@ -822,9 +1070,28 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 		return update, nil
 	}
 
-	if update {
-		if err := meta.(*AliyunClient).ecsconn.ModifyInstanceVpcAttribute(vpcArgs); err != nil {
-			return update, fmt.Errorf("ModifyInstanceVPCAttribute got an error: %#v.", err)
+	if update && vpcId != "" {
+		// The vendored ECS client does not support moving the instance to another VPC with its new security groups.
+		client := meta.(*AliyunClient)
+		params := map[string]string{
+			"InstanceId": vpcArgs.InstanceId,
+			"VSwitchId":  vpcArgs.VSwitchId,
+			"VpcId":      vpcId,
+		}
+		if vpcArgs.PrivateIpAddress != "" {
+			params["PrivateIpAddress"] = vpcArgs.PrivateIpAddress
+		}
+		for i, id := range expandStringList(d.Get("security_groups").(*schema.Set).List()) {
+			params[fmt.Sprintf("SecurityGroupId.%d", i+1)] = id
+		}
+		if err := client.ProcessRpcRequest(client.ecsEndpoint(), EcsApiVersion20140526, "ModifyInstanceVpcAttribute", params, nil); err != nil {
+			return update, WrapErrorf(err, "ModifyInstanceVpcAttribute to VPC %s got an error", vpcId)
+		}
+		d.SetPartial("security_groups")
+	} else if update {
+		client := meta.(*AliyunClient)
+		if err := client.doAction(client.ecsconn(), vpcArgs, ecs.CreateModifyInstanceVpcAttributeResponse()); err != nil {
+			return update, WrapApiError(err, "ModifyInstanceVpcAttribute", d.Id())
 		}
 	}
 	return update, nil
@@ -853,20 +1120,13 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 		d.SetPartial("instance_type")
 
 		//An instance that was successfully modified once cannot be modified again within 5 minutes.
-		err = resource.Retry(6*time.Minute, func() *resource.RetryError {
-			if err := client.ecsconn.ModifyInstanceSpec(&ecs.ModifyInstanceSpecArgs{
-				InstanceId:   d.Id(),
-				InstanceType: d.Get("instance_type").(string),
-			}); err != nil {
-				if IsExceptedError(err, EcsThrottling) {
-					time.Sleep(10 * time.Second)
-					return resource.RetryableError(fmt.Errorf("Modify instance type timeout and got an error; %#v", err))
-				}
-				return resource.NonRetryableError(fmt.Errorf("Modify instance type got an error: %#v", err))
-			}
-			return nil
+		request := ecs.CreateModifyInstanceSpecRequest()
+		request.InstanceId = d.Id()
+		request.InstanceType = d.Get("instance_type").(string)
+		err = RetryOnError(EcsCode, 6*time.Minute, func() error {
+			return client.doAction(client.ecsconn(), request, ecs.CreateModifyInstanceSpecResponse())
 		})
-		return update, err
+		return update, WrapApiError(err, "ModifyInstanceSpec", d.Id())
 	}
 	return update, nil
 }
@@ -876,13 +1136,13 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	client := meta.(*AliyunClient)
 	allocate := false
 	update := false
-	args := &ecs.ModifyInstanceNetworkSpec{
-		InstanceId: d.Id(),
-	}
+	args := ecs.CreateModifyInstanceNetworkSpecRequest()
+	args.InstanceId = d.Id()
 	if d.HasChange("internet_charge_type") {
-		args.NetworkChargeType = common.InternetChargeType(d.Get("internet_charge_type").(string))
+		args.NetworkChargeType = d.Get("internet_charge_type").(string)
 		update = true
 		d.SetPartial("internet_charge_type")
 	}
@@ -892,41 +1152,58 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 		if o.(int) <= 0 && n.(int) > 0 {
 			allocate = true
 		}
-		out := n.(int)
-		args.InternetMaxBandwidthOut = &out
+		args.InternetMaxBandwidthOut = requests.NewInteger(n.(int))
 		update = true
 		d.SetPartial("internet_max_bandwidth_out")
 	}
 
 	if d.HasChange("internet_max_bandwidth_in") {
-		in := d.Get("internet_max_bandwidth_in").(int)
-		args.InternetMaxBandwidthIn = &in
+		args.InternetMaxBandwidthIn = requests.NewInteger(d.Get("internet_max_bandwidth_in").(int))
 		update = true
 		d.SetPartial("internet_max_bandwidth_in")
 	}
 
 	//An instance that was successfully modified once cannot be modified again within 5 minutes.
 	if update {
-		if err := resource.Retry(6*time.Minute, func() *resource.RetryError {
-			if err := meta.(*AliyunClient).ecsconn.ModifyInstanceNetworkSpec(args); err != nil {
-				if IsExceptedError(err, EcsThrottling) {
-					time.Sleep(10 * time.Second)
-					return resource.RetryableError(fmt.Errorf("Modify instance network bandwidth timeout and got an error; %#v", err))
-				}
-				if IsExceptedError(err, EcsInternalError) {
-					return resource.RetryableError(fmt.Errorf("Modify instance network bandwidth timeout and got an error; %#v", err))
-				}
-				return resource.NonRetryableError(fmt.Errorf("Modify instance network bandwidth got an error: %#v", err))
-			}
-			return nil
+		if err := RetryOnError(EcsCode, 6*time.Minute, func() error {
+			return client.doAction(client.ecsconn(), args, ecs.CreateModifyInstanceNetworkSpecResponse())
 		}); err != nil {
-			return err
+			return WrapApiError(err, "ModifyInstanceNetworkSpec", d.Id())
 		}
 		if allocate {
-			if _, err := meta.(*AliyunClient).ecsconn.AllocatePublicIpAddress(d.Id()); err != nil {
-				return fmt.Errorf("[DEBUG] AllocatePublicIpAddress for instance got error: %#v", err)
+			if err := client.AllocatePublicIpAddress(d.Id()); err != nil {
+				return WrapApiError(err, "AllocatePublicIpAddress", d.Id())
 			}
 		}
 	}
 	return nil
 }
+
+// setInstanceNetworkInterfaces sets the network interfaces attached to the instance. Only the instances
+// in VPC have network interfaces, and the attributes are empty for the classic network instances.
+func setInstanceNetworkInterfaces(d *schema.ResourceData, client *AliyunClient) error {
+	var enis []map[string]interface{}
+	primaryId, primaryMac := "", ""
+	for _, eniType := range []string{NetworkInterfaceTypePrimary, NetworkInterfaceTypeSecondary} {
+		items, err := client.DescribeInstanceNetworkInterfaces(d.Id(), eniType)
+		if err != nil {
+			return WrapApiError(err, "DescribeNetworkInterfaces", d.Id())
+		}
+		for _, eni := range items {
+			primary := eniType == NetworkInterfaceTypePrimary
+			if primary {
+				primaryId, primaryMac = eni.NetworkInterfaceId, eni.MacAddress
+			}
+			enis = append(enis, map[string]interface{}{
+				"network_interface_id": eni.NetworkInterfaceId,
+				"mac_address":          eni.MacAddress,
+				"primary_ip_address":   eni.PrivateIpAddress,
+				"primary":              primary,
+			})
+		}
+	}
+
+	d.Set("primary_network_interface_id", primaryId)
+	d.Set("mac_address", primaryMac)
+	return d.Set("network_interfaces", enis)
+}
@@ -3,16 +3,115 @@ package alicloud
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/denverdino/aliyungo/common"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("alicloud_instance", &resource.Sweeper{
+		Name: "alicloud_instance",
+		F:    testSweepInstances,
+	})
+}
+
+func testSweepInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+
+	args := ecs.CreateDescribeInstancesRequest()
+	args.RegionId = string(client.Region)
+	instances, err := client.DescribeEcsInstances(args)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Instances: %s", err)
+	}
+
+	for _, v := range instances {
+		if !isTestSweepName(v.InstanceName) {
+			log.Printf("[INFO] Skipping Instance: %s (%s)", v.InstanceName, v.InstanceId)
+			continue
+		}
+		// the prepaid instances can not be released before they expire
+		if v.InstanceChargeType == string(common.PrePaid) {
+			log.Printf("[INFO] Skipping PrePaid Instance: %s (%s)", v.InstanceName, v.InstanceId)
+			continue
+		}
+		log.Printf("[INFO] Deleting Instance: %s (%s)", v.InstanceName, v.InstanceId)
+		if v.Status != string(Stopped) {
+			if err := client.StopInstance(v.InstanceId, true, ""); err != nil {
+				log.Printf("[ERROR] Failed to stop Instance (%s (%s)): %s", v.InstanceName, v.InstanceId, err)
+				continue
+			}
+			if err := client.WaitForInstance(v.InstanceId, Stopped, DefaultTimeout); err != nil {
+				log.Printf("[ERROR] Failed to wait Instance (%s (%s)) to be stopped: %s", v.InstanceName, v.InstanceId, err)
+				continue
+			}
+		}
+		request := ecs.CreateDeleteInstanceRequest()
+		request.InstanceId = v.InstanceId
+		if err := client.doAction(client.ecsconn(), request, ecs.CreateDeleteInstanceResponse()); err != nil {
+			log.Printf("[ERROR] Failed to delete Instance (%s (%s)): %s", v.InstanceName, v.InstanceId, err)
+		}
+	}
+	return nil
+}
+
+func TestInstanceReadSystemDiskError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("Action") {
+		case "DescribeInstances":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Instances":{"Instance":[{"InstanceId":"i-abc123"}]}}`))
+		case "DescribeDisks":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"RequestId":"E5F6G7H8","Code":"Forbidden.RAM","Message":"User not authorized to operate on the specified resource."}`))
+		default:
+			w.Write([]byte(`{"RequestId":"A1B2C3D4"}`))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		AccessKey: "ak",
+		SecretKey: "sk",
+		Region:    common.Hangzhou,
+		RegionId:  string(common.Hangzhou),
+		Endpoints: map[string]string{
+			EcsCode: server.URL,
+		},
+	}
+	config.rewriter = config.newRequestRewriter()
+	conn, err := config.ecsConn()
+	if err != nil {
+		t.Fatalf("Building the ECS client got an error: %#v", err)
+	}
+	client := &AliyunClient{Region: config.Region, ecsClient: conn, config: config}
+
+	d := schema.TestResourceDataRaw(t, resourceAliyunInstance().Schema, map[string]interface{}{})
+	d.SetId("i-abc123")
+	err = resourceAliyunInstanceRead(d, client)
+	if err == nil {
+		t.Fatalf("Expected the error of DescribeDisks is returned.")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "Forbidden.RAM") || !strings.Contains(msg, "DescribeDisks") {
+		t.Fatalf("Expected the error of DescribeDisks is wrapped, got %s", msg)
+	}
+	if d.Id() != "i-abc123" {
+		t.Fatalf("Expected the instance is kept in the state, got the id %q.", d.Id())
+	}
+}
+
 func TestAccAlicloudInstance_basic(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	testCheck := func(*terraform.State) error {
 		log.Printf("[WARN] instances: %#v", instance)
@@ -82,7 +181,7 @@ func TestAccAlicloudInstance_basic(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_vpc(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -112,7 +211,7 @@ func TestAccAlicloudInstance_vpc(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_userData(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -146,7 +245,7 @@ func TestAccAlicloudInstance_userData(t *testing.T) {
 }
 
 func SkipTestAccAlicloudInstance_multipleRegions(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	// multi provideris
 	var providers []*schema.Provider
@@ -179,7 +278,7 @@ func SkipTestAccAlicloudInstance_multipleRegions(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_multiSecurityGroup(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	testCheck := func(sgCount int) resource.TestCheckFunc {
 		return func(*terraform.State) error {
@@ -260,7 +359,7 @@ func TestAccAlicloudInstance_multiSecurityGroup(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_multiSecurityGroupByCount(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	testCheck := func(sgCount int) resource.TestCheckFunc {
 		return func(*terraform.State) error {
@@ -309,7 +408,7 @@ func TestAccAlicloudInstance_multiSecurityGroupByCount(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_NetworkInstanceSecurityGroups(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -331,7 +430,7 @@ func TestAccAlicloudInstance_NetworkInstanceSecurityGroups(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_tags(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -366,7 +465,7 @@ func TestAccAlicloudInstance_tags(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_update(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -409,7 +508,7 @@ func TestAccAlicloudInstance_update(t *testing.T) {
 }
 
 func TestAccAlicloudInstanceImage_update(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -444,7 +543,7 @@ func TestAccAlicloudInstanceImage_update(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_privateIP(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	testCheckPrivateIP := func() resource.TestCheckFunc {
 		return func(*terraform.State) error {
@@ -477,7 +576,7 @@ func TestAccAlicloudInstance_privateIP(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_associatePublicIP(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	testCheckPrivateIP := func() resource.TestCheckFunc {
 		return func(*terraform.State) error {
@@ -522,7 +621,7 @@ func TestAccAlicloudInstance_associatePublicIP(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_vpcRule(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -551,7 +650,7 @@ func TestAccAlicloudInstance_vpcRule(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_keyPair(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -576,7 +675,7 @@ func TestAccAlicloudInstance_keyPair(t *testing.T) {
 }
 
 func TestAccAlicloudInstancePrivateIp_update(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -610,8 +709,43 @@ func TestAccAlicloudInstancePrivateIp_update(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudInstance_migrateToVpc(t *testing.T) {
+	var classic, migrated ecs.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckInstanceClassic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("alicloud_instance.migrate", &classic),
+					resource.TestCheckResourceAttr("alicloud_instance.migrate", "vswitch_id", ""),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccCheckInstanceMigrateToVpc,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("alicloud_instance.migrate", &migrated),
+					resource.TestCheckResourceAttrPair("alicloud_instance.migrate", "vswitch_id", "alicloud_vswitch.foo", "id"),
+					func(*terraform.State) error {
+						if classic.InstanceId != migrated.InstanceId {
+							return fmt.Errorf("Expected the instance %s is migrated into the VPC, got a new instance %s.", classic.InstanceId, migrated.InstanceId)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccAlicloudInstanceChargeType_update(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -644,7 +778,7 @@ func TestAccAlicloudInstanceChargeType_update(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_spot(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -670,8 +804,41 @@ func TestAccAlicloudInstance_spot(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudInstanceStatus_update(t *testing.T) {
+	var instance ecs.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccCheckInstanceStatus, "Stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("alicloud_instance.status", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_instance.status",
+						"status", "Stopped"),
+				),
+			},
+
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccCheckInstanceStatus, "Running"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("alicloud_instance.status", &instance),
+					resource.TestCheckResourceAttr(
+						"alicloud_instance.status",
+						"status", "Running"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAlicloudInstanceType_update(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -704,7 +871,7 @@ func TestAccAlicloudInstanceType_update(t *testing.T) {
 }
 
 func TestAccAlicloudInstanceNetworkSpec_update(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -749,7 +916,7 @@ func TestAccAlicloudInstanceNetworkSpec_update(t *testing.T) {
 }
 
 func TestAccAlicloudInstance_ramrole(t *testing.T) {
-	var instance ecs.InstanceAttributesType
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -773,12 +940,12 @@ func TestAccAlicloudInstance_ramrole(t *testing.T) {
 	})
 }
 
-func testAccCheckInstanceExists(n string, i *ecs.InstanceAttributesType) resource.TestCheckFunc {
+func testAccCheckInstanceExists(n string, i *ecs.Instance) resource.TestCheckFunc {
 	providers := []*schema.Provider{testAccProvider}
 	return testAccCheckInstanceExistsWithProviders(n, i, &providers)
 }
 
-func testAccCheckInstanceExistsWithProviders(n string, i *ecs.InstanceAttributesType, providers *[]*schema.Provider) resource.TestCheckFunc {
+func testAccCheckInstanceExistsWithProviders(n string, i *ecs.Instance, providers *[]*schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -1660,6 +1827,71 @@ resource "alicloud_instance" "private_ip" {
 }
 `
 
+const testAccCheckInstanceClassic = `
+data "alicloud_images" "ubuntu" {
+	most_recent = true
+	owners = "system"
+	name_regex = "^ubuntu_14\\w{1,5}[64]{1}.*"
+}
+
+data "alicloud_zones" "zones" {
+	available_resource_creation = "VSwitch"
+}
+
+resource "alicloud_security_group" "classic" {
+	name = "tf_test_migrate_classic"
+}
+
+resource "alicloud_instance" "migrate" {
+	image_id = "${data.alicloud_images.ubuntu.images.0.id}"
+	availability_zone = "${data.alicloud_zones.zones.zones.0.id}"
+	instance_type = "ecs.n4.small"
+	instance_name = "tf_test_migrate_to_vpc"
+	security_groups = ["${alicloud_security_group.classic.id}"]
+}
+`
+
+const testAccCheckInstanceMigrateToVpc = `
+data "alicloud_images" "ubuntu" {
+	most_recent = true
+	owners = "system"
+	name_regex = "^ubuntu_14\\w{1,5}[64]{1}.*"
+}
+
+data "alicloud_zones" "zones" {
+	available_resource_creation = "VSwitch"
+}
+
+resource "alicloud_security_group" "classic" {
+	name = "tf_test_migrate_classic"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_migrate_to_vpc"
+	cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+	vpc_id = "${alicloud_vpc.foo.id}"
+	cidr_block = "10.1.1.0/24"
+	availability_zone = "${data.alicloud_zones.zones.zones.0.id}"
+}
+
+resource "alicloud_security_group" "vpc" {
+	name = "tf_test_migrate_vpc"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_instance" "migrate" {
+	image_id = "${data.alicloud_images.ubuntu.images.0.id}"
+	availability_zone = "${data.alicloud_zones.zones.zones.0.id}"
+	instance_type = "ecs.n4.small"
+	instance_name = "tf_test_migrate_to_vpc"
+	security_groups = ["${alicloud_security_group.vpc.id}"]
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+`
+
 const testAccCheckInstanceChargeType = `
 data "alicloud_images" "ubuntu" {
 	most_recent = true
@@ -1775,6 +2007,39 @@ resource "alicloud_instance" "spot" {
   spot_price_limit = "1.002"
 }
 `
+const testAccCheckInstanceStatus = `
+data "alicloud_zones" "default" {
+  available_disk_category= "cloud_efficiency"
+  available_resource_creation= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "172.16.0.0/21"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "tf_test_foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_instance" "status" {
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  image_id = "ubuntu_140405_32_40G_cloudinit_20161115.vhd"
+
+  # series III
+  instance_type = "ecs.n4.small"
+  system_disk_category = "cloud_efficiency"
+
+  security_groups = ["${alicloud_security_group.tf_test_foo.id}"]
+  instance_name = "test_for_status"
+  status = "%s"
+}
+`
 const testAccCheckInstanceType = `
 data "alicloud_images" "ubuntu" {
 	most_recent = true
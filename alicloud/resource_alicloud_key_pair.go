@@ -3,10 +3,11 @@ package alicloud
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"strings"
 	"time"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -15,6 +16,7 @@ func resourceAlicloudKeyPair() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAlicloudKeyPairCreate,
 		Read:   resourceAlicloudKeyPairRead,
+		Update: resourceAlicloudKeyPairUpdate,
 		Delete: resourceAlicloudKeyPairDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -57,13 +59,23 @@ func resourceAlicloudKeyPair() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			// The private key is only returned when the key pair is created, and it can not be read later.
+			"private_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tags":   tagsSchema(),
+			"region": regionSchema(),
 		},
 	}
 }
 
 func resourceAlicloudKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
-
+	client, err := regionClient(d, meta)
+	if err != nil {
+		return err
+	}
 	var keyName string
 	if v, ok := d.GetOk("key_name"); ok {
 		keyName = v.(string)
@@ -74,105 +86,140 @@ func resourceAlicloudKeyPairCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if publicKey, ok := d.GetOk("public_key"); ok {
-		keypair, err := conn.ImportKeyPair(&ecs.ImportKeyPairArgs{
-			RegionId:      getRegion(d, meta),
-			KeyPairName:   keyName,
-			PublicKeyBody: publicKey.(string),
-		})
-		if err != nil {
-			return fmt.Errorf("Error Import KeyPair: %s", err)
+		request := ecs.CreateImportKeyPairRequest()
+		request.RegionId = string(client.Region)
+		request.KeyPairName = keyName
+		request.PublicKeyBody = publicKey.(string)
+		keypair := ecs.CreateImportKeyPairResponse()
+		if err := client.doAction(client.ecsconn(), request, keypair); err != nil {
+			return WrapErrorf(err, "Error Import KeyPair")
 		}
 
 		d.SetId(keypair.KeyPairName)
 	} else {
-		keypair, err := conn.CreateKeyPair(&ecs.CreateKeyPairArgs{
-			RegionId:    getRegion(d, meta),
-			KeyPairName: keyName,
-		})
-		if err != nil {
-			return fmt.Errorf("Error Create KeyPair: %s", err)
+		request := ecs.CreateCreateKeyPairRequest()
+		request.RegionId = string(client.Region)
+		request.KeyPairName = keyName
+		keypair := ecs.CreateCreateKeyPairResponse()
+		if err := client.doAction(client.ecsconn(), request, keypair); err != nil {
+			return WrapErrorf(err, "Error Create KeyPair")
 		}
 
 		d.SetId(keypair.KeyPairName)
+		d.Set("private_key", keypair.PrivateKeyBody)
 		if file, ok := d.GetOk("key_file"); ok {
-			ioutil.WriteFile(file.(string), []byte(keypair.PrivateKeyBody), 400)
+			if err := writePrivateKeyFile(file.(string), keypair.PrivateKeyBody); err != nil {
+				return WrapErrorf(err, "Writing the private key of key pair %s to %s got an error", keyName, file.(string))
+			}
 		}
 	}
 
-	return resourceAlicloudKeyPairRead(d, meta)
+	return resourceAlicloudKeyPairUpdate(d, meta)
 }
 
 func resourceAlicloudKeyPairRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
-
-	keypairs, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
-		RegionId:    getRegion(d, meta),
-		KeyPairName: d.Id(),
-	})
+	client, err := regionClient(d, meta)
+	if err != nil {
+		return err
+	}
+	request := ecs.CreateDescribeKeyPairsRequest()
+	request.RegionId = string(client.Region)
+	request.KeyPairName = d.Id()
+	keypairs, err := client.DescribeEcsKeyPairs(request)
 	if err != nil {
 		if IsExceptedError(err, KeyPairNotFound) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Retrieving KeyPair: %s", err)
+		return WrapErrorf(err, "Error Retrieving KeyPair")
 	}
 
 	if len(keypairs) > 0 {
 		d.Set("key_name", keypairs[0].KeyPairName)
+		d.Set("region", string(client.Region))
 		d.Set("fingerprint", keypairs[0].KeyPairFingerPrint)
+
+		tags, err := client.describeEcsTags(client.Region, TagResourceKeyPair, d.Id())
+		if err != nil {
+			return WrapErrorf(err, "DescribeTags for key pair got an error")
+		}
+		d.Set("tags", client.ignoreDefaultTags(d, tagsToMap(tags)))
 		return nil
 	}
 
 	return fmt.Errorf("Unable to find key pair within: %#v", keypairs)
 }
 
-func resourceAlicloudKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AliyunClient)
+func resourceAlicloudKeyPairUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := regionClient(d, meta)
+	if err != nil {
+		return err
+	}
+	if err := setTags(client, TagResourceKeyPair, d); err != nil {
+		return WrapErrorf(err, "Set tags for key pair got an error")
+	}
+
+	return resourceAlicloudKeyPairRead(d, meta)
+}
 
-	instance_ids, _, err := client.QueryInstancesWithKeyPair(getRegion(d, meta), "", d.Id())
+func resourceAlicloudKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := regionClient(d, meta)
 	if err != nil {
 		return err
 	}
-	detachArgs := &ecs.DetachKeyPairArgs{
-		RegionId:    getRegion(d, meta),
-		KeyPairName: d.Id(),
+
+	instance_ids, _, err := client.QueryInstancesWithKeyPair(client.Region, "", d.Id())
+	if err != nil {
+		return err
 	}
+	detachArgs := ecs.CreateDetachKeyPairRequest()
+	detachArgs.RegionId = string(client.Region)
+	detachArgs.KeyPairName = d.Id()
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 
 		// Detach keypair from its all instances before removing it.
 		if len(instance_ids) > 0 {
 			detachArgs.InstanceIds = convertListToJsonString(instance_ids)
-			if err := client.ecsconn.DetachKeyPair(detachArgs); err != nil {
-				return resource.NonRetryableError(fmt.Errorf("Error DetachKeyPair:%#v", err))
+			if err := client.doAction(client.ecsconn(), detachArgs, ecs.CreateDetachKeyPairResponse()); err != nil {
+				return resource.NonRetryableError(WrapErrorf(err, "Error DetachKeyPair"))
 			}
 		}
-		instance_ids, _, err = client.QueryInstancesWithKeyPair(getRegion(d, meta), "", d.Id())
+		instance_ids, _, err = client.QueryInstancesWithKeyPair(client.Region, "", d.Id())
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
 		if len(instance_ids) > 0 {
-			return resource.RetryableError(fmt.Errorf("Delete Key Pair timeout and got an error: %#v.", err))
+			return resource.RetryableError(fmt.Errorf("Delete Key Pair timeout."))
 		}
 
-		err := client.ecsconn.DeleteKeyPairs(&ecs.DeleteKeyPairsArgs{
-			RegionId:     getRegion(d, meta),
-			KeyPairNames: convertListToJsonString(append(make([]interface{}, 0, 1), d.Id())),
-		})
+		request := ecs.CreateDeleteKeyPairsRequest()
+		request.RegionId = string(client.Region)
+		request.KeyPairNames = convertListToJsonString(append(make([]interface{}, 0, 1), d.Id()))
+		err := client.doAction(client.ecsconn(), request, ecs.CreateDeleteKeyPairsResponse())
 		if err != nil {
 			if IsExceptedError(err, KeyPairNotFound) {
 				return nil
 			}
 		}
 
-		keypairs, _, err := client.ecsconn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
-			RegionId:    getRegion(d, meta),
-			KeyPairName: d.Id(),
-		})
+		describeRequest := ecs.CreateDescribeKeyPairsRequest()
+		describeRequest.RegionId = string(client.Region)
+		describeRequest.KeyPairName = d.Id()
+		keypairs, err := client.DescribeEcsKeyPairs(describeRequest)
 		if len(keypairs) > 0 {
-			return resource.RetryableError(fmt.Errorf("Delete Key Pair timeout and got an error: %#v.", err))
+			return resource.RetryableError(fmt.Errorf("Delete Key Pair timeout."))
 		}
 
 		return nil
 	})
 }
+
+// writePrivateKeyFile writes the private key to the file which can only be read and written by the owner.
+// The permission is also changed if the file exists, which is not done by ioutil.WriteFile.
+func writePrivateKeyFile(file, privateKey string) error {
+	if err := ioutil.WriteFile(file, []byte(privateKey), 0600); err != nil {
+		return err
+	}
+	return os.Chmod(file, 0600)
+}
@@ -5,7 +5,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -37,26 +37,18 @@ func resourceAlicloudKeyPairAttachment() *schema.Resource {
 }
 
 func resourceAlicloudKeyPairAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 	instanceIds := convertListToJsonString(d.Get("instance_ids").(*schema.Set).List())
 
-	args := &ecs.AttachKeyPairArgs{
-		RegionId:    getRegion(d, meta),
-		KeyPairName: d.Get("key_name").(string),
-		InstanceIds: instanceIds,
-	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if er := conn.AttachKeyPair(args); er != nil {
-			if IsExceptedError(er, KeyPairServiceUnavailable) {
-				return resource.RetryableError(fmt.Errorf("Attach Key Pair timeout and got an error: %#v.", er))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error Attach KeyPair: %#v", er))
-		}
-		return nil
+	args := ecs.CreateAttachKeyPairRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.KeyPairName = d.Get("key_name").(string)
+	args.InstanceIds = instanceIds
+	err := RetryOnError(EcsCode, 5*time.Minute, func() error {
+		return client.doAction(client.ecsconn(), args, ecs.CreateAttachKeyPairResponse())
 	})
-
 	if err != nil {
-		return err
+		return WrapApiError(err, args.GetActionName(), args.KeyPairName)
 	}
 	d.SetId(d.Get("key_name").(string) + ":" + instanceIds)
 
@@ -64,18 +56,17 @@ func resourceAlicloudKeyPairAttachmentCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAlicloudKeyPairAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
 	keyname := strings.Split(d.Id(), ":")[0]
-	keypairs, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
-		RegionId:    getRegion(d, meta),
-		KeyPairName: keyname,
-	})
+	request := ecs.CreateDescribeKeyPairsRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.KeyPairName = keyname
+	keypairs, err := meta.(*AliyunClient).DescribeEcsKeyPairs(request)
 	if err != nil {
 		if IsExceptedError(err, KeyPairNotFound) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Retrieving KeyPair: %s", err)
+		return WrapErrorf(err, "Error Retrieving KeyPair")
 	}
 
 	if len(keypairs) > 0 {
@@ -93,13 +84,13 @@ func resourceAlicloudKeyPairAttachmentDelete(d *schema.ResourceData, meta interf
 	instanceIds := strings.Split(d.Id(), ":")[1]
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := client.ecsconn.DetachKeyPair(&ecs.DetachKeyPairArgs{
-			RegionId:    getRegion(d, meta),
-			KeyPairName: keyname,
-			InstanceIds: instanceIds,
-		})
+		request := ecs.CreateDetachKeyPairRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.KeyPairName = keyname
+		request.InstanceIds = instanceIds
+		err := client.doAction(client.ecsconn(), request, ecs.CreateDetachKeyPairResponse())
 		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("Error DetachKeyPair:%#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "Error DetachKeyPair"))
 		}
 
 		instance_ids, _, err := client.QueryInstancesWithKeyPair(getRegion(d, meta), instanceIds, d.Id())
@@ -108,7 +99,7 @@ func resourceAlicloudKeyPairAttachmentDelete(d *schema.ResourceData, meta interf
 		}
 		if len(instance_ids) > 0 {
 			instanceIds = convertListToJsonString(instance_ids)
-			return resource.RetryableError(fmt.Errorf("Detach Key Pair timeout and got an error: %#v.", err))
+			return resource.RetryableError(fmt.Errorf("Detach Key Pair timeout."))
 		}
 
 		return nil
@@ -4,14 +4,14 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAlicloudKeyPairAttachment_basic(t *testing.T) {
-	var keypair ecs.KeyPairItemType
-	var instance ecs.InstanceAttributesType
+	var keypair ecs.KeyPair
+	var instance ecs.Instance
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -39,7 +39,7 @@ func TestAccAlicloudKeyPairAttachment_basic(t *testing.T) {
 
 }
 
-func testAccCheckKeyPairAttachmentExists(n string, instance *ecs.InstanceAttributesType, keypair *ecs.KeyPairItemType) resource.TestCheckFunc {
+func testAccCheckKeyPairAttachmentExists(n string, instance *ecs.Instance, keypair *ecs.KeyPair) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
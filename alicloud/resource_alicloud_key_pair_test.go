@@ -2,18 +2,47 @@ package alicloud
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestWritePrivateKeyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-test-key-pair")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "key.pem")
+	if err := ioutil.WriteFile(file, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := writePrivateKeyFile(file, "private key"); err != nil {
+		t.Fatalf("Expected no error, got %#v", err)
+	}
+
+	info, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected the permission 0600, got %o", info.Mode().Perm())
+	}
+	if bs, _ := ioutil.ReadFile(file); string(bs) != "private key" {
+		t.Fatalf("Expected the private key is written, got %s", string(bs))
+	}
+}
+
 func TestAccAlicloudKeyPair_basic(t *testing.T) {
-	var keypair ecs.KeyPairItemType
+	var keypair ecs.KeyPair
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -38,7 +67,7 @@ func TestAccAlicloudKeyPair_basic(t *testing.T) {
 }
 
 func TestAccAlicloudKeyPair_prefix(t *testing.T) {
-	var keypair ecs.KeyPairItemType
+	var keypair ecs.KeyPair
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -65,7 +94,7 @@ func TestAccAlicloudKeyPair_prefix(t *testing.T) {
 }
 
 func TestAccAlicloudKeyPair_publicKey(t *testing.T) {
-	var keypair ecs.KeyPairItemType
+	var keypair ecs.KeyPair
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -91,7 +120,26 @@ func TestAccAlicloudKeyPair_publicKey(t *testing.T) {
 
 }
 
-func testAccCheckKeyPairExists(n string, keypair *ecs.KeyPairItemType) resource.TestCheckFunc {
+// The key pair is created in a region other than the provider's one, so it is checked by its attributes only.
+func TestAccAlicloudKeyPair_region(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyPairConfigRegion,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("alicloud_key_pair.region", "region", "cn-shanghai"),
+					resource.TestCheckResourceAttr("alicloud_key_pair.region", "key_name", "terraform-test-key-pair-region"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyPairExists(n string, keypair *ecs.KeyPair) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -103,12 +151,11 @@ func testAccCheckKeyPairExists(n string, keypair *ecs.KeyPairItemType) resource.
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
 
-		response, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
-			RegionId:    client.Region,
-			KeyPairName: rs.Primary.ID,
-		})
+		request := ecs.CreateDescribeKeyPairsRequest()
+		request.RegionId = string(client.Region)
+		request.KeyPairName = rs.Primary.ID
+		response, err := client.DescribeEcsKeyPairs(request)
 
 		log.Printf("[WARN] disk ids %#v", rs.Primary.ID)
 
@@ -122,7 +169,7 @@ func testAccCheckKeyPairExists(n string, keypair *ecs.KeyPairItemType) resource.
 	}
 }
 
-func testAccCheckKeyPairHasPrefix(n string, keypair *ecs.KeyPairItemType, prefix string) resource.TestCheckFunc {
+func testAccCheckKeyPairHasPrefix(n string, keypair *ecs.KeyPair, prefix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -134,12 +181,11 @@ func testAccCheckKeyPairHasPrefix(n string, keypair *ecs.KeyPairItemType, prefix
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
 
-		response, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
-			RegionId:    client.Region,
-			KeyPairName: rs.Primary.ID,
-		})
+		request := ecs.CreateDescribeKeyPairsRequest()
+		request.RegionId = string(client.Region)
+		request.KeyPairName = rs.Primary.ID
+		response, err := client.DescribeEcsKeyPairs(request)
 
 		log.Printf("[WARN] disk ids %#v", rs.Primary.ID)
 
@@ -163,12 +209,11 @@ func testAccCheckKeyPairDestroy(s *terraform.State) error {
 
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
 
-		response, _, err := conn.DescribeKeyPairs(&ecs.DescribeKeyPairsArgs{
-			RegionId:    client.Region,
-			KeyPairName: rs.Primary.ID,
-		})
+		request := ecs.CreateDescribeKeyPairsRequest()
+		request.RegionId = string(client.Region)
+		request.KeyPairName = rs.Primary.ID
+		response, err := client.DescribeEcsKeyPairs(request)
 
 		if response != nil && len(response) > 0 {
 			return fmt.Errorf("Error Key Pair still exist")
@@ -200,3 +245,10 @@ resource "alicloud_key_pair" "publickey" {
   	public_key = "ssh-rsa AAAAB3Nza12345678qwertyuudsfsg"
 }
 `
+
+const testAccKeyPairConfigRegion = `
+resource "alicloud_key_pair" "region" {
+	key_name = "terraform-test-key-pair-region"
+	region   = "cn-shanghai"
+}
+`
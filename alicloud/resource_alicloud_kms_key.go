@@ -62,7 +62,7 @@ func resourceAlicloudKmsKey() *schema.Resource {
 }
 
 func resourceAlicloudKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).kmsconn
+	conn := meta.(*AliyunClient).kmsconn()
 
 	args := kms.CreateKeyArgs{
 		KeyUsage: kms.KeyUsage(d.Get("key_usage").(string)),
@@ -73,7 +73,7 @@ func resourceAlicloudKmsKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	resp, err := conn.CreateKey(&args)
 	if err != nil {
-		return fmt.Errorf("CreateKey got an error: %#v.", err)
+		return WrapErrorf(err, "CreateKey got an error")
 	}
 
 	d.SetId(resp.KeyMetadata.KeyId)
@@ -82,14 +82,14 @@ func resourceAlicloudKmsKeyCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAlicloudKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).kmsconn
+	conn := meta.(*AliyunClient).kmsconn()
 
 	key, err := conn.DescribeKey(d.Id())
 	if err != nil {
 		if IsExceptedError(err, ForbiddenKeyNotFound) {
 			return nil
 		}
-		return fmt.Errorf("DescribeKey got an error: %#v.", err)
+		return WrapErrorf(err, "DescribeKey got an error")
 	}
 
 	if KeyState(key.KeyMetadata.KeyState) == PendingDeletion {
@@ -108,25 +108,25 @@ func resourceAlicloudKmsKeyRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAlicloudKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).kmsconn
+	conn := meta.(*AliyunClient).kmsconn()
 
 	d.Partial(true)
 
 	if d.HasChange("is_enabled") {
 		key, err := conn.DescribeKey(d.Id())
 		if err != nil {
-			return fmt.Errorf("DescribeKey got an error: %#v.", err)
+			return WrapErrorf(err, "DescribeKey got an error")
 		}
 
 		if d.Get("is_enabled").(bool) && KeyState(key.KeyMetadata.KeyState) == Disabled {
 			if _, err := conn.EnableKey(d.Id()); err != nil {
-				return fmt.Errorf("Enable key got an error: %#v.", err)
+				return WrapErrorf(err, "Enable key got an error")
 			}
 		}
 
 		if !d.Get("is_enabled").(bool) && KeyState(key.KeyMetadata.KeyState) == Enabled {
 			if _, err := conn.DisableKey(d.Id()); err != nil {
-				return fmt.Errorf("Disable key got an error: %#v.", err)
+				return WrapErrorf(err, "Disable key got an error")
 			}
 		}
 		d.SetPartial("is_enabled")
@@ -138,7 +138,7 @@ func resourceAlicloudKmsKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAlicloudKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).kmsconn
+	conn := meta.(*AliyunClient).kmsconn()
 
 	if _, err := conn.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionArgs{
 		KeyId:               d.Id(),
@@ -153,7 +153,7 @@ func resourceAlicloudKmsKeyDelete(d *schema.ResourceData, meta interface{}) erro
 			if IsExceptedError(err, ForbiddenKeyNotFound) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("DescribeKey got an error: %#v.", err))
+			return resource.NonRetryableError(WrapErrorf(err, "DescribeKey got an error"))
 		}
 
 		if key == nil || KeyState(key.KeyMetadata.KeyState) == PendingDeletion {
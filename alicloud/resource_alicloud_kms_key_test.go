@@ -46,7 +46,7 @@ func testAccCheckAlicloudKmsKeyExists(name string, key *kms.KeyMetadata) resourc
 			return fmt.Errorf("No KMS Key ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AliyunClient).kmsconn
+		conn := testAccProvider.Meta().(*AliyunClient).kmsconn()
 
 		o, err := conn.DescribeKey(rs.Primary.ID)
 		if err != nil {
@@ -61,7 +61,7 @@ func testAccCheckAlicloudKmsKeyExists(name string, key *kms.KeyMetadata) resourc
 }
 
 func testAccCheckAlicloudKmsKeyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AliyunClient).kmsconn
+	conn := testAccProvider.Meta().(*AliyunClient).kmsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alicloud_kms_key" {
@@ -0,0 +1,358 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudKVStoreInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudKVStoreInstanceCreate,
+		Read:   resourceAlicloudKVStoreInstanceRead,
+		Update: resourceAlicloudKVStoreInstanceUpdate,
+		Delete: resourceAlicloudKVStoreInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(KVStoreRedis),
+				ValidateFunc: validateAllowedStringValue([]string{string(KVStoreRedis), string(KVStoreMemcache)}),
+			},
+			"engine_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"engine_version_effective_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      KVStoreEffectiveImmediately,
+				ValidateFunc: validateAllowedStringValue([]string{KVStoreEffectiveImmediately, KVStoreEffectiveMaintainTime}),
+			},
+			"instance_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(PostPaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(PostPaid), string(PrePaid)}),
+			},
+			"period": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateFunc:     validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+				DiffSuppressFunc: kvstorePostPaidDiffSuppressFunc,
+			},
+			"security_ips": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"architecture_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAlicloudKVStoreInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"InstanceType":  d.Get("instance_type").(string),
+		"InstanceClass": d.Get("instance_class").(string),
+		"ChargeType":    d.Get("instance_charge_type").(string),
+		"NetworkType":   strings.ToUpper(string(Classic)),
+		"Token":         buildClientToken("TF-CreateKVStoreInstance"),
+	}
+	if PayType(params["ChargeType"]) == PrePaid {
+		params["Period"] = strconv.Itoa(d.Get("period").(int))
+	}
+	if v, ok := d.GetOk("instance_name"); ok {
+		params["InstanceName"] = v.(string)
+	}
+	if v, ok := d.GetOk("engine_version"); ok {
+		params["EngineVersion"] = v.(string)
+	}
+	if v, ok := d.GetOk("password"); ok {
+		params["Password"] = v.(string)
+	}
+	if v, ok := d.GetOk("zone_id"); ok {
+		params["ZoneId"] = v.(string)
+	}
+	if v, ok := d.GetOk("vswitch_id"); ok {
+		vsw, err := client.DescribeVswitch(v.(string))
+		if err != nil {
+			return WrapApiError(err, "DescribeVSwitchAttributes", v.(string))
+		}
+		if params["ZoneId"] == "" {
+			params["ZoneId"] = vsw.ZoneId
+		} else if params["ZoneId"] != vsw.ZoneId {
+			return fmt.Errorf("The specified vswitch %s isn't in the zone %s.", vsw.VSwitchId, params["ZoneId"])
+		}
+		params["NetworkType"] = strings.ToUpper(string(VPC))
+		params["VpcId"] = vsw.VpcId
+		params["VSwitchId"] = vsw.VSwitchId
+	}
+
+	var resp struct {
+		InstanceId string `json:"InstanceId"`
+	}
+	if err := client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "CreateInstance", params, &resp); err != nil {
+		return WrapApiError(err, "CreateInstance", "")
+	}
+	d.SetId(resp.InstanceId)
+
+	if err := client.WaitForKVStoreInstance(d.Id(), nil, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForKVStoreInstance got an error"), "", d.Id())
+	}
+
+	return resourceAlicloudKVStoreInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudKVStoreInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	instance, err := client.DescribeKVStoreInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapApiError(err, "DescribeInstanceAttribute", d.Id())
+	}
+
+	d.Set("instance_name", instance.InstanceName)
+	d.Set("instance_type", instance.InstanceType)
+	d.Set("instance_class", instance.InstanceClass)
+	// The upgrade taking effect in the maintenance window is pending, and the target version is kept until it is done.
+	if d.Get("engine_version_effective_time").(string) != KVStoreEffectiveMaintainTime || !kvstoreEngineVersionUpgradePending(instance.EngineVersion, d.Get("engine_version").(string)) {
+		d.Set("engine_version", instance.EngineVersion)
+	}
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VSwitchId)
+	d.Set("instance_charge_type", instance.ChargeType)
+	d.Set("architecture_type", instance.ArchitectureType)
+	d.Set("connection_domain", instance.ConnectionDomain)
+	d.Set("port", instance.Port)
+	d.Set("deletion_protection", instance.InstanceReleaseProtection)
+
+	ips, err := client.DescribeKVStoreSecurityIps(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("security_ips", ips)
+
+	tags, err := client.DescribeKVStoreTags(d.Id())
+	if err != nil {
+		return WrapApiError(err, "ListTagResources", d.Id())
+	}
+	d.Set("tags", client.ignoreDefaultTags(d, tagResourcesToMap(tags)))
+
+	return nil
+}
+
+func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if d.HasChange("security_ips") {
+		ips := expandStringList(d.Get("security_ips").(*schema.Set).List())
+		if len(ips) > 0 {
+			if err := client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "ModifySecurityIps", map[string]string{
+				"InstanceId":          d.Id(),
+				"SecurityIps":         strings.Join(ips, COMMA_SEPARATED),
+				"SecurityIpGroupName": "default",
+				"ModifyMode":          "Cover",
+			}, nil); err != nil {
+				return WrapApiError(err, "ModifySecurityIps", d.Id())
+			}
+		}
+		d.SetPartial("security_ips")
+	}
+
+	if err := setTagResources(client, client.kvstoreEndpoint(), KVStoreApiVersion, TagResourceKVStoreInstance, d); err != nil {
+		return WrapApiError(err, "TagResources", d.Id())
+	}
+	d.SetPartial("tags")
+
+	if d.HasChange("deletion_protection") {
+		if err := client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "ModifyInstanceAttribute", map[string]string{
+			"InstanceId":                d.Id(),
+			"InstanceReleaseProtection": strconv.FormatBool(d.Get("deletion_protection").(bool)),
+		}, nil); err != nil {
+			return WrapApiError(err, "ModifyInstanceAttribute", d.Id())
+		}
+		d.SetPartial("deletion_protection")
+	}
+
+	if d.IsNewResource() {
+		d.Partial(false)
+		return resourceAlicloudKVStoreInstanceRead(d, meta)
+	}
+
+	if d.HasChange("instance_name") || d.HasChange("password") {
+		params := map[string]string{
+			"InstanceId": d.Id(),
+		}
+		if d.HasChange("instance_name") {
+			params["InstanceName"] = d.Get("instance_name").(string)
+		}
+		if d.HasChange("password") {
+			params["NewPassword"] = d.Get("password").(string)
+		}
+		if err := client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "ModifyInstanceAttribute", params, nil); err != nil {
+			return WrapApiError(err, "ModifyInstanceAttribute", d.Id())
+		}
+		d.SetPartial("instance_name")
+		d.SetPartial("password")
+	}
+
+	// Changing the instance class can also change the architecture, like from standard to cluster, and the data is kept.
+	if d.HasChange("instance_class") {
+		class := d.Get("instance_class").(string)
+		if err := client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "ModifyInstanceSpec", map[string]string{
+			"InstanceId":    d.Id(),
+			"InstanceClass": class,
+			"EffectiveTime": KVStoreEffectiveImmediately,
+		}, nil); err != nil {
+			return WrapApiError(WrapErrorf(err, "Changing the instance class to %s got an error", class), "ModifyInstanceSpec", d.Id())
+		}
+		if err := client.WaitForKVStoreInstance(d.Id(), func(instance KVStoreInstance) bool {
+			return instance.InstanceClass == class
+		}, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForKVStoreInstance got an error"), "", d.Id())
+		}
+		d.SetPartial("instance_class")
+	}
+
+	if d.HasChange("engine_version") {
+		if err := upgradeKVStoreInstanceEngineVersion(d, client); err != nil {
+			return err
+		}
+		d.SetPartial("engine_version")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudKVStoreInstanceRead(d, meta)
+}
+
+func resourceAlicloudKVStoreInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		return fmt.Errorf("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+
+	if err := RetryOnError(KVStoreCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "DeleteInstance", map[string]string{
+			"InstanceId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, KVStoreInstanceNotFound) {
+			return nil
+		}
+		if IsDeletionProtectionError(err) || d.Get("deletion_protection").(bool) {
+			return WrapDeletionProtectionError(err, "KVStore instance", d.Id())
+		}
+		return WrapApiError(err, "DeleteInstance", d.Id())
+	}
+
+	return client.WaitForKVStoreInstanceDeleted(d.Id(), timeoutSeconds(d, schema.TimeoutDelete))
+}
+
+// upgradeKVStoreInstanceEngineVersion upgrades the major version of a Redis instance in place, which keeps its data and
+// connection domain. The version can not be downgraded.
+func upgradeKVStoreInstanceEngineVersion(d *schema.ResourceData, client *AliyunClient) error {
+	o, n := d.GetChange("engine_version")
+	if KVStoreEngine(d.Get("instance_type").(string)) != KVStoreRedis {
+		return fmt.Errorf("The engine version of %s can not be upgraded in place.", d.Get("instance_type"))
+	}
+	if c, err := compareEngineVersion(o.(string), n.(string)); err != nil {
+		return err
+	} else if c > 0 {
+		return fmt.Errorf("The engine version can not be downgraded from %s to %s. Please recreate the instance to change 'engine_version'.", o, n)
+	}
+
+	version := n.(string)
+	effectiveTime := d.Get("engine_version_effective_time").(string)
+	if err := client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "ModifyInstanceMajorVersion", map[string]string{
+		"InstanceId":    d.Id(),
+		"MajorVersion":  version,
+		"EffectiveTime": effectiveTime,
+	}, nil); err != nil {
+		return WrapApiError(WrapErrorf(err, "Upgrading the engine version to %s got an error", version), "ModifyInstanceMajorVersion", d.Id())
+	}
+
+	if effectiveTime == KVStoreEffectiveMaintainTime {
+		return nil
+	}
+	if err := client.WaitForKVStoreInstance(d.Id(), func(instance KVStoreInstance) bool {
+		return instance.EngineVersion == version
+	}, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForKVStoreInstance got an error"), "", d.Id())
+	}
+	return nil
+}
+
+// kvstoreEngineVersionUpgradePending returns whether the current version is lower than the target version.
+func kvstoreEngineVersionUpgradePending(current, target string) bool {
+	c, err := compareEngineVersion(current, target)
+	return err == nil && c < 0
+}
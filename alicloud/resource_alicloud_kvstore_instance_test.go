@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestKVStoreEngineVersionUpgradePending(t *testing.T) {
+	if !kvstoreEngineVersionUpgradePending("4.0", "5.0") {
+		t.Fatalf("Expected the upgrade from 4.0 to 5.0 is pending.")
+	}
+	if kvstoreEngineVersionUpgradePending("5.0", "5.0") || kvstoreEngineVersionUpgradePending("5.0", "4.0") {
+		t.Fatalf("Expected no upgrade is pending when the version is not lower than the target.")
+	}
+}
+
+func TestAccAlicloudKVStoreInstance_basic(t *testing.T) {
+	var instance KVStoreInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_kvstore_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKVStoreInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKVStoreInstanceConfig("redis.master.small.default", "4.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreInstanceExists("alicloud_kvstore_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "engine_version", "4.0"),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "architecture_type", KVStoreArchitectureStandard),
+					resource.TestCheckResourceAttrSet("alicloud_kvstore_instance.foo", "connection_domain"),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "deletion_protection", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKVStoreInstanceConfig("redis.master.mid.default", "5.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreInstanceExists("alicloud_kvstore_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "instance_class", "redis.master.mid.default"),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "engine_version", "5.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKVStoreInstanceExists(n string, instance *KVStoreInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KVStore instance ID is set")
+		}
+
+		resp, err := testAccProvider.Meta().(*AliyunClient).DescribeKVStoreInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*instance = resp
+		return nil
+	}
+}
+
+func testAccCheckKVStoreInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_kvstore_instance" {
+			continue
+		}
+
+		if _, err := client.DescribeKVStoreInstance(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("KVStore instance %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccKVStoreInstanceConfig(class, version string) string {
+	return fmt.Sprintf(`
+data "alicloud_kvstore_zones" "default" {}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccKVStoreInstance"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/24"
+  availability_zone = "${data.alicloud_kvstore_zones.default.zones.0.id}"
+}
+
+resource "alicloud_kvstore_instance" "foo" {
+  instance_name  = "tf-testAccKVStoreInstance"
+  instance_class = "%s"
+  engine_version = "%s"
+  vswitch_id     = "${alicloud_vswitch.foo.id}"
+  security_ips   = ["10.0.0.0/8"]
+  tags {
+    Created = "TF"
+  }
+}
+`, class, version)
+}
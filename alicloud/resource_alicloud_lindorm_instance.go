@@ -0,0 +1,326 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLindormInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLindormInstanceCreate,
+		Read:   resourceAlicloudLindormInstanceRead,
+		Update: resourceAlicloudLindormInstanceUpdate,
+		Delete: resourceAlicloudLindormInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"disk_category": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"cloud_efficiency", "cloud_ssd", "cloud_essd", "capacity_cloud_storage"}),
+			},
+			"instance_storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"cold_storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"wide_table_engine_specification": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"wide_table_engine_node_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"search_engine_specification": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"search_engine_node_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"time_series_engine_specification": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"time_series_engine_node_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(PostPaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(PrePaid), string(PostPaid)}),
+			},
+			"period": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateFunc:     validateInstanceChargeTypePeriod,
+				DiffSuppressFunc: prePaidDiffSuppressFunc,
+			},
+			"ip_white_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLindormInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"DiskCategory": d.Get("disk_category").(string),
+		"PayType":      hitsdbPayType(PayType(d.Get("instance_charge_type").(string))),
+		"ClientToken":  buildClientToken("TF-CreateLindormInstance"),
+	}
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		params["Period"], params["PricingCycle"] = prepaidPeriod(d.Get("period").(int))
+	}
+	if v, ok := d.GetOk("instance_name"); ok {
+		params["InstanceAlias"] = v.(string)
+	}
+	if v, ok := d.GetOk("instance_storage"); ok {
+		params["ClusterStorage"] = strconv.Itoa(v.(int))
+	}
+	if v, ok := d.GetOk("cold_storage"); ok {
+		params["ColdStorage"] = strconv.Itoa(v.(int))
+	}
+	engines := 0
+	for _, e := range lindormEngines {
+		spec, ok := d.GetOk(e.Prefix + "_engine_specification")
+		if !ok {
+			continue
+		}
+		params[e.Param+"Spec"] = spec.(string)
+		params[e.Param+"Num"] = strconv.Itoa(d.Get(e.Prefix + "_engine_node_count").(int))
+		engines++
+	}
+	if engines == 0 {
+		return fmt.Errorf("At least one of 'wide_table_engine_specification', 'search_engine_specification' and 'time_series_engine_specification' is required.")
+	}
+
+	vsw, err := client.DescribeVswitch(d.Get("vswitch_id").(string))
+	if err != nil {
+		return WrapErrorf(err, "DescribeVSwitchAttributes got an error")
+	}
+	if v, ok := d.GetOk("zone_id"); ok && v.(string) != vsw.ZoneId {
+		return fmt.Errorf("The specified vswitch %s isn't in the zone %s.", vsw.VSwitchId, v.(string))
+	}
+	params["ZoneId"] = vsw.ZoneId
+	params["VPCId"] = vsw.VpcId
+	params["VSwitchId"] = vsw.VSwitchId
+
+	var resp struct {
+		InstanceId string `json:"InstanceId"`
+	}
+	if err := RetryOnError(HitsdbCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.hitsdbEndpoint(), LindormApiVersion, "CreateLindormInstance", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateLindormInstance got an error")
+	}
+	d.SetId(resp.InstanceId)
+
+	if err := client.WaitForLindormInstance(d.Id(), nil, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "WaitForLindormInstance %s got an error", d.Id())
+	}
+
+	return resourceAlicloudLindormInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudLindormInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	instance, err := client.DescribeLindormInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("instance_name", instance.InstanceAlias)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VswitchId)
+	d.Set("vpc_id", instance.VpcId)
+	d.Set("disk_category", instance.DiskCategory)
+	if storage, err := strconv.Atoi(instance.InstanceStorage); err == nil {
+		d.Set("instance_storage", storage)
+	}
+	d.Set("cold_storage", instance.ColdStorage)
+	d.Set("instance_charge_type", string(hitsdbChargeType(instance.PayType)))
+	d.Set("deletion_protection", instance.DeletionProtection == "true")
+	d.Set("status", instance.InstanceStatus)
+	for _, e := range lindormEngines {
+		count := 0
+		for _, engine := range instance.EngineList {
+			if engine.Engine == strings.ToLower(e.Param) {
+				count, _ = strconv.Atoi(engine.CoreCount)
+			}
+		}
+		d.Set(e.Prefix+"_engine_node_count", count)
+	}
+
+	ips, err := client.DescribeLindormIpWhiteList(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("ip_white_list", ips)
+
+	return nil
+}
+
+func resourceAlicloudLindormInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if d.HasChange("ip_white_list") {
+		ips := expandStringList(d.Get("ip_white_list").(*schema.Set).List())
+		if len(ips) > 0 {
+			if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), LindormApiVersion, "UpdateInstanceIpWhiteList", map[string]string{
+				"InstanceId":     d.Id(),
+				"SecurityIpList": strings.Join(ips, COMMA_SEPARATED),
+			}, nil); err != nil {
+				return WrapErrorf(err, "UpdateInstanceIpWhiteList got an error")
+			}
+		}
+		d.SetPartial("ip_white_list")
+	}
+
+	if d.HasChange("deletion_protection") || (!d.IsNewResource() && d.HasChange("instance_name")) {
+		if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), LindormApiVersion, "UpdateLindormInstanceAttribute", map[string]string{
+			"InstanceId":         d.Id(),
+			"InstanceAlias":      d.Get("instance_name").(string),
+			"DeletionProtection": strconv.FormatBool(d.Get("deletion_protection").(bool)),
+		}, nil); err != nil {
+			return WrapErrorf(err, "UpdateLindormInstanceAttribute got an error")
+		}
+		d.SetPartial("instance_name")
+		d.SetPartial("deletion_protection")
+	}
+
+	if d.IsNewResource() {
+		d.Partial(false)
+		return resourceAlicloudLindormInstanceRead(d, meta)
+	}
+
+	// The instance accepts one upgrade at a time, so it is waited for after each upgrade.
+	upgrade := func(key, upgradeType, param string) error {
+		if !d.HasChange(key) {
+			return nil
+		}
+		if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), LindormApiVersion, "UpgradeLindormInstance", map[string]string{
+			"InstanceId":  d.Id(),
+			"ZoneId":      d.Get("zone_id").(string),
+			"UpgradeType": upgradeType,
+			param:         strconv.Itoa(d.Get(key).(int)),
+			"ClientToken": buildClientToken("TF-UpgradeLindormInstance"),
+		}, nil); err != nil {
+			return WrapErrorf(err, "UpgradeLindormInstance %s got an error", upgradeType)
+		}
+		if err := client.WaitForLindormInstance(d.Id(), nil, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, "WaitForLindormInstance %s got an error", d.Id())
+		}
+		d.SetPartial(key)
+		return nil
+	}
+	if err := upgrade("instance_storage", "upgrade-disk-size", "ClusterStorage"); err != nil {
+		return err
+	}
+	if err := upgrade("cold_storage", "upgrade-cold-storage", "ColdStorage"); err != nil {
+		return err
+	}
+	for _, e := range lindormEngines {
+		if err := upgrade(e.Prefix+"_engine_node_count", fmt.Sprintf("upgrade-%s-core-num", strings.ToLower(e.Param)), e.Param+"Num"); err != nil {
+			return err
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlicloudLindormInstanceRead(d, meta)
+}
+
+func resourceAlicloudLindormInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		return fmt.Errorf("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("The deletion protection of Lindorm instance %s is enabled. Set deletion_protection to false and apply it before deleting the instance.", d.Id())
+	}
+
+	if err := RetryOnError(HitsdbCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.ProcessRpcRequest(client.hitsdbEndpoint(), LindormApiVersion, "ReleaseLindormInstance", map[string]string{
+			"InstanceId":  d.Id(),
+			"Immediately": "true",
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, HitsdbInstanceNotValid) || IsExceptedError(err, HitsdbInstanceDeleted) {
+			return nil
+		}
+		return WrapErrorf(err, "ReleaseLindormInstance got an error")
+	}
+
+	return client.WaitForLindormInstanceDeleted(d.Id(), timeoutSeconds(d, schema.TimeoutDelete))
+}
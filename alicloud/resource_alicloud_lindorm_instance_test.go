@@ -0,0 +1,122 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestHitsdbPayType(t *testing.T) {
+	if p := hitsdbPayType(PrePaid); p != "PREPAY" {
+		t.Fatalf("Expected the pay type of PrePaid is PREPAY, got %s.", p)
+	}
+	if c := hitsdbChargeType(hitsdbPayType(PostPaid)); c != PostPaid {
+		t.Fatalf("Expected the charge type of POSTPAY is PostPaid, got %s.", c)
+	}
+}
+
+func TestAccAlicloudLindormInstance_basic(t *testing.T) {
+	var instance LindormInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_lindorm_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLindormInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLindormInstanceConfig(2, 800),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLindormInstanceExists("alicloud_lindorm_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_lindorm_instance.foo", "wide_table_engine_node_count", "2"),
+					resource.TestCheckResourceAttr("alicloud_lindorm_instance.foo", "cold_storage", "800"),
+					resource.TestCheckResourceAttr("alicloud_lindorm_instance.foo", "status", HitsdbActivation),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLindormInstanceConfig(4, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLindormInstanceExists("alicloud_lindorm_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_lindorm_instance.foo", "wide_table_engine_node_count", "4"),
+					resource.TestCheckResourceAttr("alicloud_lindorm_instance.foo", "cold_storage", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLindormInstanceExists(n string, instance *LindormInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lindorm instance ID is set")
+		}
+
+		resp, err := testAccProvider.Meta().(*AliyunClient).DescribeLindormInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*instance = resp
+		return nil
+	}
+}
+
+func testAccCheckLindormInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_lindorm_instance" {
+			continue
+		}
+
+		if _, err := client.DescribeLindormInstance(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Lindorm instance %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLindormInstanceConfig(nodes, coldStorage int) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccLindormInstance"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/24"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_lindorm_instance" "foo" {
+  instance_name                   = "tf-testAccLindormInstance"
+  vswitch_id                      = "${alicloud_vswitch.foo.id}"
+  disk_category                   = "cloud_efficiency"
+  instance_storage                = 480
+  cold_storage                    = %d
+  wide_table_engine_specification = "lindorm.g.xlarge"
+  wide_table_engine_node_count    = %d
+  ip_white_list                   = ["10.0.0.0/8"]
+}
+`, coldStorage, nodes)
+}
@@ -0,0 +1,172 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogAudit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogAuditCreate,
+		Read:   resourceAlicloudLogAuditRead,
+		Update: resourceAlicloudLogAuditUpdate,
+		Delete: resourceAlicloudLogAuditDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"aliuid": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"variable_map": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"multi_account": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logstore_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogAuditCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := analyzeLogAudit(d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("display_name").(string))
+
+	return resourceAlicloudLogAuditRead(d, meta)
+}
+
+func resourceAlicloudLogAuditRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	app, err := client.DescribeLogAudit()
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, "Describe log audit got an error")
+	}
+
+	variables := make(map[string]interface{})
+	if app.Config != "" {
+		if err := json.Unmarshal([]byte(app.Config), &variables); err != nil {
+			return WrapErrorf(err, "Parsing log audit config %s got an error", app.Config)
+		}
+	}
+
+	d.Set("display_name", app.DisplayName)
+	if v, ok := variables["aliuid"]; ok {
+		d.Set("aliuid", v)
+	}
+	if v, ok := variables["project"]; ok {
+		d.Set("project_name", v)
+	}
+	if v, ok := variables["logstore"]; ok {
+		d.Set("logstore_name", v)
+	}
+	if v, ok := variables["multi_account"].([]interface{}); ok {
+		d.Set("multi_account", v)
+	}
+
+	// Only the switches and ttl which were declared are written back, the service
+	// returns a full set of collection policies with default values.
+	if declared, ok := d.GetOk("variable_map"); ok {
+		variableMap := make(map[string]interface{})
+		for k := range declared.(map[string]interface{}) {
+			if v, ok := variables[k]; ok {
+				variableMap[k] = fmt.Sprint(v)
+			}
+		}
+		d.Set("variable_map", variableMap)
+	}
+
+	return nil
+}
+
+func resourceAlicloudLogAuditUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("variable_map") || d.HasChange("multi_account") {
+		if err := analyzeLogAudit(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudLogAuditRead(d, meta)
+}
+
+func resourceAlicloudLogAuditDelete(d *schema.ResourceData, meta interface{}) error {
+	// The log audit application can not be released by API, and the collected logs are kept in the
+	// central project. It is only removed from the state.
+	log.Printf("[WARN] Log audit %s can not be deleted and it is removed from the state.", d.Id())
+	return nil
+}
+
+func analyzeLogAudit(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	aliuid := d.Get("aliuid").(string)
+
+	variables := make(map[string]interface{})
+	for k, v := range d.Get("variable_map").(map[string]interface{}) {
+		value := v.(string)
+		// switches of the collection policies are booleans and the ttl are integers
+		if value == "true" || value == "false" {
+			variables[k] = value == "true"
+		} else if strings.HasSuffix(k, "_ttl") {
+			ttl, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("The value of variable_map %s should be an integer, got %s.", k, value)
+			}
+			variables[k] = ttl
+		} else {
+			variables[k] = value
+		}
+	}
+	variables["region"] = string(client.Region)
+	variables["aliuid"] = aliuid
+	variables["project"] = fmt.Sprintf(LogAuditProjectName, aliuid, client.Region)
+	variables["logstore"] = LogAuditLogstore
+	if v, ok := d.GetOk("multi_account"); ok {
+		variables["multi_account"] = expandStringList(v.(*schema.Set).List())
+	}
+
+	bs, err := json.Marshal(variables)
+	if err != nil {
+		return err
+	}
+
+	if err := client.ProcessRpcRequest(client.logPopEndpoint(), LogPopApiVersion, "AnalyzeAppLog", map[string]string{
+		"AppType":     LogAuditAppType,
+		"DisplayName": d.Get("display_name").(string),
+		"VariableMap": string(bs),
+	}, nil); err != nil {
+		return WrapErrorf(err, "AnalyzeAppLog got an error")
+	}
+	return nil
+}
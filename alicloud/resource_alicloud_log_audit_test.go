@@ -0,0 +1,97 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudLogAudit_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWithAccountId(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_log_audit.foo",
+
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLogAuditBasic(os.Getenv("ALICLOUD_ACCOUNT_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogAuditExists("alicloud_log_audit.foo"),
+					resource.TestCheckResourceAttr("alicloud_log_audit.foo", "display_name", "tf-testacc-audit"),
+					resource.TestCheckResourceAttr("alicloud_log_audit.foo", "variable_map.actiontrail_enabled", "true"),
+					resource.TestCheckResourceAttr("alicloud_log_audit.foo", "logstore_name", LogAuditLogstore),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLogAuditUpdate(os.Getenv("ALICLOUD_ACCOUNT_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogAuditExists("alicloud_log_audit.foo"),
+					resource.TestCheckResourceAttr("alicloud_log_audit.foo", "variable_map.actiontrail_ttl", "180"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckWithAccountId(t *testing.T) {
+	if v := os.Getenv("ALICLOUD_ACCOUNT_ID"); v == "" {
+		t.Fatal("ALICLOUD_ACCOUNT_ID must be set for this acceptance test")
+	}
+}
+
+func testAccCheckLogAuditExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No log audit ID is set")
+		}
+
+		app, err := testAccProvider.Meta().(*AliyunClient).DescribeLogAudit()
+		if err != nil {
+			return err
+		}
+
+		if app.DisplayName != rs.Primary.ID {
+			return fmt.Errorf("Log audit %s is not found.", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccLogAuditBasic(aliuid string) string {
+	return fmt.Sprintf(`
+resource "alicloud_log_audit" "foo" {
+  display_name = "tf-testacc-audit"
+  aliuid       = "%s"
+  variable_map = {
+    "actiontrail_enabled" = "true"
+    "actiontrail_ttl"     = "90"
+  }
+}
+`, aliuid)
+}
+
+func testAccLogAuditUpdate(aliuid string) string {
+	return fmt.Sprintf(`
+resource "alicloud_log_audit" "foo" {
+  display_name = "tf-testacc-audit"
+  aliuid       = "%s"
+  variable_map = {
+    "actiontrail_enabled" = "true"
+    "actiontrail_ttl"     = "180"
+  }
+}
+`, aliuid)
+}
@@ -0,0 +1,292 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogOssShipper() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogOssShipperCreate,
+		Read:   resourceAlicloudLogOssShipperRead,
+		Update: resourceAlicloudLogOssShipperUpdate,
+		Delete: resourceAlicloudLogOssShipperDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"logstore_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"shipper_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"oss_bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"oss_prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"buffer_interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validateIntegerInRange(300, 900),
+			},
+			"buffer_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      256,
+				ValidateFunc: validateIntegerInRange(5, 256),
+			},
+			"compress_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      LogCompressSnappy,
+				ValidateFunc: validateAllowedStringValue([]string{LogCompressSnappy, LogCompressNone}),
+			},
+			"path_format": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "%Y/%m/%d/%H/%M",
+			},
+			"format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      LogShipperFormatJson,
+				ValidateFunc: validateAllowedStringValue([]string{LogShipperFormatJson, LogShipperFormatCsv, LogShipperFormatParquet}),
+			},
+			"json_enable_tag": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"csv_config_columns": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"csv_config_delimiter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ",",
+			},
+			"csv_config_header": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"csv_config_nullidentifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parquet_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"string", "boolean", "int32", "int64", "int96", "float", "double"}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogOssShipperCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	project := d.Get("project_name").(string)
+	logstore := d.Get("logstore_name").(string)
+
+	shipper := buildLogOssShipper(d)
+	if err := RetryOnError(LogCode, 2*time.Minute, func() error {
+		return client.logconn.CreateShipper(project, logstore, shipper)
+	}); err != nil {
+		return WrapErrorf(err, "Creating log oss shipper got an error")
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", project, COLON_SEPARATED, logstore, COLON_SEPARATED, shipper.ShipperName))
+
+	return resourceAlicloudLogOssShipperRead(d, meta)
+}
+
+func resourceAlicloudLogOssShipperRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseLogOssShipperId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	shipper, err := meta.(*AliyunClient).DescribeLogOssShipper(parts[0], parts[1], parts[2])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, "Describe log oss shipper got an error")
+	}
+
+	target := shipper.TargetConfiguration
+	d.Set("project_name", parts[0])
+	d.Set("logstore_name", parts[1])
+	d.Set("shipper_name", shipper.ShipperName)
+	d.Set("oss_bucket", target.OssBucket)
+	d.Set("oss_prefix", target.OssPrefix)
+	d.Set("role_arn", target.RoleArn)
+	d.Set("buffer_interval", target.BufferInterval)
+	d.Set("buffer_size", target.BufferSize)
+	d.Set("compress_type", target.CompressType)
+	d.Set("path_format", target.PathFormat)
+	d.Set("format", target.Storage.Format)
+
+	detail := target.Storage.Detail
+	switch target.Storage.Format {
+	case LogShipperFormatJson:
+		if v, ok := detail["enableTag"].(bool); ok {
+			d.Set("json_enable_tag", v)
+		}
+	case LogShipperFormatCsv:
+		if v, ok := detail["columns"].([]interface{}); ok {
+			d.Set("csv_config_columns", v)
+		}
+		if v, ok := detail["delimiter"].(string); ok {
+			d.Set("csv_config_delimiter", v)
+		}
+		if v, ok := detail["header"].(bool); ok {
+			d.Set("csv_config_header", v)
+		}
+		if v, ok := detail["nullIdentifier"].(string); ok {
+			d.Set("csv_config_nullidentifier", v)
+		}
+	case LogShipperFormatParquet:
+		var columns []map[string]interface{}
+		if v, ok := detail["columns"].([]interface{}); ok {
+			for _, c := range v {
+				if column, ok := c.(map[string]interface{}); ok {
+					columns = append(columns, map[string]interface{}{
+						"name": column["name"],
+						"type": column["type"],
+					})
+				}
+			}
+		}
+		d.Set("parquet_config", columns)
+	}
+
+	return nil
+}
+
+func resourceAlicloudLogOssShipperUpdate(d *schema.ResourceData, meta interface{}) error {
+	parts, err := parseLogOssShipperId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := meta.(*AliyunClient).logconn.UpdateShipper(parts[0], parts[1], buildLogOssShipper(d)); err != nil {
+		return WrapErrorf(err, "Updating log oss shipper got an error")
+	}
+
+	return resourceAlicloudLogOssShipperRead(d, meta)
+}
+
+func resourceAlicloudLogOssShipperDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts, err := parseLogOssShipperId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := RetryOnError(LogCode, 3*time.Minute, func() error {
+		return client.logconn.DeleteShipper(parts[0], parts[1], parts[2])
+	}); err != nil {
+		if IsExceptedError(err, LogShipperNotExist) || IsExceptedError(err, LogProjectNotExist) || IsExceptedError(err, LogStoreNotExist) {
+			return nil
+		}
+		return WrapErrorf(err, "Deleting log oss shipper got an error")
+	}
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		if _, err := client.DescribeLogOssShipper(parts[0], parts[1], parts[2]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Deleting log oss shipper timeout and it still exists."))
+	})
+}
+
+func buildLogOssShipper(d *schema.ResourceData) *LogShipper {
+	storage := LogShipperStorage{
+		Format: d.Get("format").(string),
+		Detail: make(map[string]interface{}),
+	}
+	switch storage.Format {
+	case LogShipperFormatJson:
+		storage.Detail["enableTag"] = d.Get("json_enable_tag").(bool)
+	case LogShipperFormatCsv:
+		storage.Detail["columns"] = expandStringList(d.Get("csv_config_columns").([]interface{}))
+		storage.Detail["delimiter"] = d.Get("csv_config_delimiter").(string)
+		storage.Detail["header"] = d.Get("csv_config_header").(bool)
+		storage.Detail["nullIdentifier"] = d.Get("csv_config_nullidentifier").(string)
+		storage.Detail["quote"] = "\""
+		storage.Detail["escape"] = "\""
+		storage.Detail["lineFeed"] = "\n"
+	case LogShipperFormatParquet:
+		var columns []map[string]interface{}
+		for _, c := range d.Get("parquet_config").([]interface{}) {
+			column := c.(map[string]interface{})
+			columns = append(columns, map[string]interface{}{
+				"name": column["name"].(string),
+				"type": column["type"].(string),
+			})
+		}
+		storage.Detail["columns"] = columns
+	}
+
+	return &LogShipper{
+		ShipperName: d.Get("shipper_name").(string),
+		TargetType:  LogShipperTargetOss,
+		TargetConfiguration: LogShipperTargetConfiguration{
+			OssBucket:      d.Get("oss_bucket").(string),
+			OssPrefix:      d.Get("oss_prefix").(string),
+			RoleArn:        d.Get("role_arn").(string),
+			BufferInterval: d.Get("buffer_interval").(int),
+			BufferSize:     d.Get("buffer_size").(int),
+			CompressType:   d.Get("compress_type").(string),
+			PathFormat:     d.Get("path_format").(string),
+			Storage:        storage,
+		},
+	}
+}
+
+func parseLogOssShipperId(id string) ([]string, error) {
+	return ParseResourceId(id, "log oss shipper", "project_name", "logstore_name", "shipper_name")
+}
@@ -0,0 +1,214 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudLogOssShipper_basic(t *testing.T) {
+	var shipper LogShipper
+	rand := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWithLogProject(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_log_oss_shipper.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLogOssShipperDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLogOssShipperBasic(rand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogOssShipperExists("alicloud_log_oss_shipper.foo", &shipper),
+					resource.TestCheckResourceAttr("alicloud_log_oss_shipper.foo", "format", "json"),
+					resource.TestCheckResourceAttr("alicloud_log_oss_shipper.foo", "buffer_interval", "300"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLogOssShipperCsv(rand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogOssShipperExists("alicloud_log_oss_shipper.foo", &shipper),
+					resource.TestCheckResourceAttr("alicloud_log_oss_shipper.foo", "format", "csv"),
+					resource.TestCheckResourceAttr("alicloud_log_oss_shipper.foo", "csv_config_columns.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_log_oss_shipper.foo", "buffer_interval", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestLogClientSignature(t *testing.T) {
+	client := &LogClient{
+		AccessKeyId:     "mockAccessKeyId",
+		AccessKeySecret: "mockAccessKeySecret",
+	}
+	headers := map[string]string{
+		"x-log-apiversion":      LogApiVersion,
+		"x-log-signaturemethod": LogSignatureMethod,
+		"x-log-bodyrawsize":     "0",
+		"Date":                  "Mon, 09 Nov 2015 06:03:03 GMT",
+		"User-Agent":            "terraform",
+	}
+
+	first := signLogRequest(client.AccessKeySecret, "GET", "/logstores/test/shipper/tf?b=2&a=1", headers)
+	second := signLogRequest(client.AccessKeySecret, "GET", "/logstores/test/shipper/tf?a=1&b=2", headers)
+	if first == "" || first != second {
+		t.Fatalf("Expected the signature to be independent of the query order, got %q and %q", first, second)
+	}
+
+	headers["User-Agent"] = "another"
+	if third := signLogRequest(client.AccessKeySecret, "GET", "/logstores/test/shipper/tf?a=1&b=2", headers); third != first {
+		t.Fatalf("Expected the signature to ignore the non log headers, got %q and %q", first, third)
+	}
+
+	headers["x-log-bodyrawsize"] = "1"
+	if fourth := signLogRequest(client.AccessKeySecret, "GET", "/logstores/test/shipper/tf?a=1&b=2", headers); fourth == first {
+		t.Fatalf("Expected the signature to cover the log headers")
+	}
+}
+
+func TestLogClientRetry(t *testing.T) {
+	requests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/busy" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"errorCode": "ServerBusy", "errorMessage": "server busy"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errorCode": "ShipperNotExist", "errorMessage": "shipper does not exist"}`))
+	}))
+	defer server.Close()
+
+	client := &LogClient{
+		Endpoint:   strings.TrimPrefix(server.URL, "https://"),
+		MaxRetries: 1,
+		httpClient: server.Client(),
+	}
+
+	if err := client.doRequest("", http.MethodGet, "/busy", nil, nil); !IsExceptedError(err, "ServerBusy") {
+		t.Fatalf("Expected ServerBusy error, got %#v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("Expected the server error is retried once, got %d requests", requests)
+	}
+
+	requests = 0
+	if err := client.doRequest("", http.MethodGet, "/missing", nil, nil); !IsExceptedError(err, LogShipperNotExist) {
+		t.Fatalf("Expected ShipperNotExist error, got %#v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("Expected the client error is not retried, got %d requests", requests)
+	}
+}
+
+func testAccPreCheckWithLogProject(t *testing.T) {
+	if v := os.Getenv("ALICLOUD_LOG_PROJECT"); v == "" {
+		t.Fatal("ALICLOUD_LOG_PROJECT must be set for log acceptance tests")
+	}
+	if v := os.Getenv("ALICLOUD_LOG_STORE"); v == "" {
+		t.Fatal("ALICLOUD_LOG_STORE must be set for log acceptance tests")
+	}
+}
+
+func testAccCheckLogOssShipperExists(n string, shipper *LogShipper) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No log oss shipper ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		parts, err := parseLogOssShipperId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.DescribeLogOssShipper(parts[0], parts[1], parts[2])
+		if err != nil {
+			return err
+		}
+
+		*shipper = *resp
+		return nil
+	}
+}
+
+func testAccCheckLogOssShipperDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_log_oss_shipper" {
+			continue
+		}
+
+		parts, err := parseLogOssShipperId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.DescribeLogOssShipper(parts[0], parts[1], parts[2]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Log oss shipper %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLogOssShipperBasic(rand int) string {
+	return fmt.Sprintf(`
+resource "alicloud_oss_bucket" "foo" {
+  bucket = "tf-testacc-log-shipper-%d"
+}
+
+resource "alicloud_log_oss_shipper" "foo" {
+  project_name  = "%s"
+  logstore_name = "%s"
+  shipper_name  = "tf-testacc-shipper-%d"
+  oss_bucket    = "${alicloud_oss_bucket.foo.bucket}"
+  oss_prefix    = "root"
+}
+`, rand, os.Getenv("ALICLOUD_LOG_PROJECT"), os.Getenv("ALICLOUD_LOG_STORE"), rand)
+}
+
+func testAccLogOssShipperCsv(rand int) string {
+	return fmt.Sprintf(`
+resource "alicloud_oss_bucket" "foo" {
+  bucket = "tf-testacc-log-shipper-%d"
+}
+
+resource "alicloud_log_oss_shipper" "foo" {
+  project_name       = "%s"
+  logstore_name      = "%s"
+  shipper_name       = "tf-testacc-shipper-%d"
+  oss_bucket         = "${alicloud_oss_bucket.foo.bucket}"
+  oss_prefix         = "root"
+  buffer_interval    = 600
+  format             = "csv"
+  csv_config_columns = ["method", "status"]
+  csv_config_header  = true
+}
+`, rand, os.Getenv("ALICLOUD_LOG_PROJECT"), os.Getenv("ALICLOUD_LOG_STORE"), rand)
+}
@@ -0,0 +1,136 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudMarketImageSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMarketImageSubscriptionCreate,
+		Read:   resourceAlicloudMarketImageSubscriptionRead,
+		Delete: resourceAlicloudMarketImageSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sku_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(PostPaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(PrePaid), string(PostPaid)}),
+			},
+			"period": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateFunc:     validateInstanceChargeTypePeriod,
+				DiffSuppressFunc: prePaidDiffSuppressFunc,
+			},
+			"order_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pay_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Ordering the image accepts its agreement, and the order is paid automatically.
+func resourceAlicloudMarketImageSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	commodity := MarketCommodity{
+		ProductCode: d.Get("product_code").(string),
+		SkuCode:     d.Get("sku_code").(string),
+		ChargeType:  "POSTPAY",
+		Quantity:    1,
+		Components:  map[string]string{},
+	}
+	if v, ok := d.GetOk("package_version"); ok {
+		commodity.Components["package_version"] = v.(string)
+	}
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		commodity.ChargeType = "PREPAY"
+		duration, cycle := prepaidPeriod(d.Get("period").(int))
+		commodity.Duration, _ = strconv.Atoi(duration)
+		commodity.PricingCycle = cycle
+	}
+	b, err := json.Marshal(commodity)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var resp struct {
+		OrderId int64 `json:"OrderId"`
+	}
+	if err := RetryOnError(MarketCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.marketEndpoint(), MarketApiVersion, "CreateOrder", map[string]string{
+			"OrderType":   "INSTANCE_BUY",
+			"PaymentType": "AUTO",
+			"OrderSouce":  "market",
+			"Commodity":   string(b),
+			"ClientToken": buildClientToken("TF-CreateOrder"),
+		}, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateOrder got an error")
+	}
+	d.SetId(strconv.FormatInt(resp.OrderId, 10))
+
+	return resourceAlicloudMarketImageSubscriptionRead(d, meta)
+}
+
+func resourceAlicloudMarketImageSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	order, err := meta.(*AliyunClient).DescribeMarketOrder(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("product_code", order.ProductCode)
+	d.Set("sku_code", order.ProductSkuCode)
+	d.Set("order_status", order.OrderStatus)
+	d.Set("pay_status", order.PayStatus)
+	d.Set("instance_ids", order.InstanceIds)
+
+	return nil
+}
+
+// A subscription can not be cancelled by the API, so it is only removed from the state.
+func resourceAlicloudMarketImageSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] The market order %s can not be cancelled, and it is only removed from the state.", d.Id())
+	return nil
+}
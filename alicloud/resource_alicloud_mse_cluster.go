@@ -0,0 +1,240 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudMseCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMseClusterCreate,
+		Read:   resourceAlicloudMseClusterRead,
+		Update: resourceAlicloudMseClusterUpdate,
+		Delete: resourceAlicloudMseClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{MseNacos, MseZooKeeper, MseEureka}),
+			},
+			"cluster_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_specification": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"net_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      MsePrivateNet,
+				ValidateFunc: validateAllowedStringValue([]string{MsePrivateNet, MsePublicNet}),
+			},
+			"pub_network_flow": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  0,
+			},
+			"disk_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"private_slb_specification": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pub_slb_specification": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"cluster_alias_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"acl_entry_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"intranet_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"internet_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudMseClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	netType, flow := d.Get("net_type").(string), d.Get("pub_network_flow").(int)
+	if netType == MsePublicNet && flow <= 0 {
+		return fmt.Errorf("'pub_network_flow' is required when 'net_type' is %s.", MsePublicNet)
+	}
+
+	vsw, err := client.DescribeVswitch(d.Get("vswitch_id").(string))
+	if err != nil {
+		return WrapErrorf(err, "DescribeVSwitchAttributes got an error")
+	}
+	params := map[string]string{
+		"ClusterType":          d.Get("cluster_type").(string),
+		"ClusterVersion":       d.Get("cluster_version").(string),
+		"ClusterSpecification": d.Get("cluster_specification").(string),
+		"InstanceCount":        strconv.Itoa(d.Get("instance_count").(int)),
+		"NetType":              netType,
+		"PubNetworkFlow":       strconv.Itoa(flow),
+		"VpcId":                vsw.VpcId,
+		"VSwitchId":            vsw.VSwitchId,
+	}
+	for key, param := range map[string]string{
+		"disk_type":                 "DiskType",
+		"private_slb_specification": "PrivateSlbSpecification",
+		"pub_slb_specification":     "PubSlbSpecification",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			params[param] = v.(string)
+		}
+	}
+
+	var resp struct {
+		InstanceId string `json:"InstanceId"`
+	}
+	if err := RetryOnError(MseCode, 3*time.Minute, func() error {
+		return client.ProcessMseRequest("CreateCluster", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateCluster got an error")
+	}
+	d.SetId(resp.InstanceId)
+
+	if err := client.WaitForMseCluster(d.Id(), timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "WaitForMseCluster %s got an error", d.Id())
+	}
+
+	return resourceAlicloudMseClusterUpdate(d, meta)
+}
+
+// The ACL entries are not read back, because the cluster detail doesn't contain them.
+func resourceAlicloudMseClusterRead(d *schema.ResourceData, meta interface{}) error {
+	cluster, err := meta.(*AliyunClient).DescribeMseCluster(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("cluster_type", cluster.ClusterType)
+	d.Set("cluster_version", cluster.ClusterVersion)
+	d.Set("instance_count", cluster.InstanceCount)
+	d.Set("vswitch_id", cluster.VSwitchId)
+	d.Set("net_type", cluster.NetType)
+	if flow, err := strconv.Atoi(cluster.PubNetworkFlow); err == nil {
+		d.Set("pub_network_flow", flow)
+	}
+	d.Set("disk_type", cluster.DiskType)
+	d.Set("cluster_alias_name", cluster.ClusterAliasName)
+	d.Set("cluster_id", cluster.ClusterId)
+	d.Set("vpc_id", cluster.VpcId)
+	d.Set("intranet_domain", cluster.IntranetDomain)
+	d.Set("internet_domain", cluster.InternetDomain)
+	d.Set("status", cluster.InitStatus)
+
+	return nil
+}
+
+func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if d.HasChange("cluster_alias_name") {
+		if err := client.ProcessMseRequest("UpdateCluster", map[string]string{
+			"InstanceId":       d.Id(),
+			"ClusterAliasName": d.Get("cluster_alias_name").(string),
+		}, nil); err != nil {
+			return WrapErrorf(err, "UpdateCluster got an error")
+		}
+		d.SetPartial("cluster_alias_name")
+	}
+
+	if d.HasChange("acl_entry_list") {
+		entries := expandStringList(d.Get("acl_entry_list").(*schema.Set).List())
+		if err := client.ProcessMseRequest("UpdateAcl", map[string]string{
+			"InstanceId":   d.Id(),
+			"AclEntryList": strings.Join(entries, COMMA_SEPARATED),
+		}, nil); err != nil {
+			return WrapErrorf(err, "UpdateAcl got an error")
+		}
+		d.SetPartial("acl_entry_list")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudMseClusterRead(d, meta)
+}
+
+func resourceAlicloudMseClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(MseCode, 3*time.Minute, func() error {
+		return client.ProcessMseRequest("DeleteCluster", map[string]string{
+			"InstanceId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, MseInstanceNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteCluster got an error")
+	}
+
+	return client.WaitForMseClusterDeleted(d.Id(), timeoutSeconds(d, schema.TimeoutDelete))
+}
@@ -0,0 +1,145 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudMseCluster_basic(t *testing.T) {
+	var cluster MseCluster
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_mse_cluster.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckMseClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMseClusterConfig("tf-testAccMseCluster"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMseClusterExists("alicloud_mse_cluster.foo", &cluster),
+					resource.TestCheckResourceAttr("alicloud_mse_cluster.foo", "cluster_type", "Nacos-Ans"),
+					resource.TestCheckResourceAttr("alicloud_mse_cluster.foo", "instance_count", "3"),
+					resource.TestCheckResourceAttr("alicloud_mse_cluster.foo", "net_type", "privatenet"),
+					resource.TestCheckResourceAttr("alicloud_mse_cluster.foo", "cluster_alias_name", "tf-testAccMseCluster"),
+					resource.TestCheckResourceAttr("alicloud_mse_cluster.foo", "status", "INIT_SUCCESS"),
+					resource.TestCheckResourceAttrSet("alicloud_mse_cluster.foo", "intranet_domain"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMseClusterConfig("tf-testAccMseCluster-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMseClusterExists("alicloud_mse_cluster.foo", &cluster),
+					resource.TestCheckResourceAttr("alicloud_mse_cluster.foo", "cluster_alias_name", "tf-testAccMseCluster-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestDescribeMseCluster(t *testing.T) {
+	client, server := newTestAliyunClient(t, MseCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("Action") != "QueryClusterDetail" {
+			t.Errorf("Unexpected action %s", r.FormValue("Action"))
+		}
+		switch r.FormValue("InstanceId") {
+		case "mse-cn-abc":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Success":true,"Code":200,"Data":{"InstanceId":"mse-cn-abc",` +
+				`"ClusterAliasName":"tf-testAcc","ClusterType":"Nacos-Ans","InstanceCount":3,"InitStatus":"INIT_SUCCESS"}}`))
+		default:
+			// MSE responds the code 200 even when the call fails, and the failure is reported by the ErrorCode.
+			w.Write([]byte(`{"RequestId":"E5F6G7H8","Success":false,"Code":200,"ErrorCode":"mse-200-021","Message":"The instance does not exist."}`))
+		}
+	})
+	defer server.Close()
+
+	cluster, err := client.DescribeMseCluster("mse-cn-abc")
+	if err != nil {
+		t.Fatalf("Describing the cluster got an error: %#v", err)
+	}
+	if cluster.ClusterAliasName != "tf-testAcc" || cluster.InstanceCount != 3 || cluster.InitStatus != "INIT_SUCCESS" {
+		t.Fatalf("Expected the cluster is decoded from the Data of the response, got %#v", cluster)
+	}
+
+	if _, err := client.DescribeMseCluster("mse-cn-unknown"); !NotFoundError(err) {
+		t.Fatalf("Expected the ErrorCode %s is a not found error, got %#v", MseInstanceNotFound, err)
+	}
+}
+
+func testAccCheckMseClusterExists(n string, cluster *MseCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSE Cluster ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		c, err := client.DescribeMseCluster(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*cluster = c
+
+		return nil
+	}
+}
+
+func testAccCheckMseClusterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_mse_cluster" {
+			continue
+		}
+
+		if _, err := client.DescribeMseCluster(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("MSE Cluster %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMseClusterConfig(name string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccMseCluster"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/24"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_mse_cluster" "foo" {
+  cluster_type          = "Nacos-Ans"
+  cluster_version       = "NACOS_ANS_1_2_1"
+  cluster_specification = "MSE_SC_1_2_200_c"
+  instance_count        = 3
+  vswitch_id            = "${alicloud_vswitch.foo.id}"
+  cluster_alias_name    = "%s"
+  acl_entry_list        = ["127.0.0.1/32"]
+}
+`, name)
+}
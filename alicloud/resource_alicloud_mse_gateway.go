@@ -0,0 +1,189 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudMseGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMseGatewayCreate,
+		Read:   resourceAlicloudMseGatewayRead,
+		Update: resourceAlicloudMseGatewayUpdate,
+		Delete: resourceAlicloudMseGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gateway_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"spec": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"replica": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(2, 30),
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"slb_spec": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"internet_slb_spec": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_security_group": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"delete_slb": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudMseGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	vsw, err := client.DescribeVswitch(d.Get("vswitch_id").(string))
+	if err != nil {
+		return WrapErrorf(err, "DescribeVSwitchAttributes got an error")
+	}
+	params := map[string]string{
+		"Spec":                    d.Get("spec").(string),
+		"Replica":                 strconv.Itoa(d.Get("replica").(int)),
+		"Vpc":                     vsw.VpcId,
+		"VSwitchId":               vsw.VSwitchId,
+		"EnterpriseSecurityGroup": strconv.FormatBool(d.Get("enterprise_security_group").(bool)),
+	}
+	if v, ok := d.GetOk("backup_vswitch_id"); ok {
+		backup, err := client.DescribeVswitch(v.(string))
+		if err != nil {
+			return WrapErrorf(err, "DescribeVSwitchAttributes got an error")
+		}
+		if backup.VpcId != vsw.VpcId {
+			return fmt.Errorf("The backup vswitch %s isn't in the vpc %s.", backup.VSwitchId, vsw.VpcId)
+		}
+		params["VSwitchId2"] = backup.VSwitchId
+	}
+	for key, param := range map[string]string{
+		"gateway_name":      "Name",
+		"slb_spec":          "SlbSpec",
+		"internet_slb_spec": "InternetSlbSpec",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			params[param] = v.(string)
+		}
+	}
+
+	var resp struct {
+		Data string `json:"Data"`
+	}
+	if err := RetryOnError(MseCode, 3*time.Minute, func() error {
+		return client.ProcessMseRequest("AddGateway", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "AddGateway got an error")
+	}
+	d.SetId(resp.Data)
+
+	if err := client.WaitForMseGateway(d.Id(), timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "WaitForMseGateway %s got an error", d.Id())
+	}
+
+	return resourceAlicloudMseGatewayRead(d, meta)
+}
+
+func resourceAlicloudMseGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	gateway, err := meta.(*AliyunClient).DescribeMseGateway(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("gateway_name", gateway.Name)
+	d.Set("spec", gateway.Spec)
+	d.Set("replica", gateway.Replica)
+	d.Set("vswitch_id", gateway.VSwitchId)
+	d.Set("backup_vswitch_id", gateway.BackupVSwitchId)
+	d.Set("vpc_id", gateway.VpcId)
+	d.Set("status", gateway.StatusDesc)
+
+	return nil
+}
+
+func resourceAlicloudMseGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("gateway_name") {
+		if err := client.ProcessMseRequest("UpdateGatewayName", map[string]string{
+			"GatewayUniqueId": d.Id(),
+			"Name":            d.Get("gateway_name").(string),
+		}, nil); err != nil {
+			return WrapErrorf(err, "UpdateGatewayName got an error")
+		}
+	}
+
+	return resourceAlicloudMseGatewayRead(d, meta)
+}
+
+func resourceAlicloudMseGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(MseCode, 3*time.Minute, func() error {
+		return client.ProcessMseRequest("DeleteGateway", map[string]string{
+			"GatewayUniqueId": d.Id(),
+			"DeleteSlb":       strconv.FormatBool(d.Get("delete_slb").(bool)),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, MseInstanceNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteGateway got an error")
+	}
+
+	return client.WaitForMseGatewayDeleted(d.Id(), timeoutSeconds(d, schema.TimeoutDelete))
+}
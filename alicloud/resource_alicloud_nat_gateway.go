@@ -21,6 +21,11 @@ func resourceAliyunNatGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -92,17 +97,43 @@ func resourceAliyunNatGateway() *schema.Resource {
 					return true
 				},
 			},
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(PostPaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(PrePaid), string(PostPaid)}),
+			},
+			"period": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateFunc:     validateInstanceChargeTypePeriod,
+				DiffSuppressFunc: prePaidDiffSuppressFunc,
+			},
+			"renewal_status":    renewalStatusSchema(),
+			"auto_renew_period": autoRenewPeriodSchema(),
+
+			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
 
 	args := vpc.CreateCreateNatGatewayRequest()
 	args.RegionId = string(getRegion(d, meta))
 	args.VpcId = string(d.Get("vpc_id").(string))
 	args.Spec = string(d.Get("specification").(string))
+	args.ClientToken = buildClientToken("TF-CreateNatGateway")
 
 	if v, ok := d.GetOk("name"); ok {
 		args.Name = v.(string)
@@ -112,18 +143,38 @@ func resourceAliyunNatGatewayCreate(d *schema.ResourceData, meta interface{}) er
 		args.Description = v.(string)
 	}
 
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		ar := args
-		resp, err := conn.CreateNatGateway(ar)
+	// The request of the vendored SDK has no subscription parameters.
+	args.QueryParams["InstanceChargeType"] = d.Get("instance_charge_type").(string)
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		usedTime, unit := prepaidPeriod(d.Get("period").(int))
+		args.QueryParams["Duration"] = usedTime
+		args.QueryParams["PricingCycle"] = unit
+		args.QueryParams["AutoPay"] = "true"
+	}
+
+	if err := RetryOnError(VpcCode, d.Timeout(schema.TimeoutCreate), func() error {
+		resp := vpc.CreateCreateNatGatewayResponse()
+		err := client.doAction(conn, args, resp)
 		if err != nil {
-			if IsExceptedError(err, VswitchStatusError) || IsExceptedError(err, TaskConflict) {
-				return resource.RetryableError(fmt.Errorf("CreateNatGateway got error: %#v", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("CreateNatGateway got error: %#v", err))
+			return err
 		}
 		d.SetId(resp.NatGatewayId)
 		return nil
 	}); err != nil {
+		return WrapApiError(err, args.GetActionName(), "")
+	}
+
+	if d.Get("deletion_protection").(bool) {
+		if err := meta.(*AliyunClient).SetVpcDeletionProtection(DeletionProtectionNatGateway, d.Id(), true); err != nil {
+			return WrapApiError(err, "DeletionProtection", d.Id())
+		}
+	}
+
+	if err := setVpcTags(meta.(*AliyunClient), TagResourceNatGateway, d); err != nil {
+		return WrapErrorf(err, "Set tags for nat gateway got an error")
+	}
+
+	if err := setRenewal(meta.(*AliyunClient), BssProductNat, d); err != nil {
 		return err
 	}
 
@@ -140,7 +191,7 @@ func resourceAliyunNatGatewayRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return err
+		return WrapApiError(err, "DescribeNatGateways", d.Id())
 	}
 
 	d.Set("name", natGateway.Name)
@@ -150,6 +201,22 @@ func resourceAliyunNatGatewayRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("forward_table_ids", strings.Join(natGateway.ForwardTableIds.ForwardTableId, ","))
 	d.Set("description", natGateway.Description)
 	d.Set("vpc_id", natGateway.VpcId)
+	d.Set("instance_charge_type", natGateway.InstanceChargeType)
+	if err := readRenewal(client, BssProductNat, d); err != nil {
+		return err
+	}
+
+	protection, err := client.DescribeNatGatewayDeletionProtection(d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeNatGateways", d.Id())
+	}
+	d.Set("deletion_protection", protection)
+
+	tags, err := client.DescribeVpcTags(TagResourceNatGateway, d.Id())
+	if err != nil {
+		return WrapApiError(err, "ListTagResources", d.Id())
+	}
+	d.Set("tags", client.ignoreDefaultTags(d, tagResourcesToMap(tags)))
 
 	return nil
 }
@@ -161,7 +228,7 @@ func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 
 	natGateway, err := client.DescribeNatGateway(d.Id())
 	if err != nil {
-		return err
+		return WrapApiError(err, "DescribeNatGateways", d.Id())
 	}
 
 	d.Partial(true)
@@ -198,8 +265,8 @@ func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if attributeUpdate {
-		if _, err := conn.ModifyNatGatewayAttribute(args); err != nil {
-			return err
+		if err := client.doAction(conn, args, vpc.CreateModifyNatGatewayAttributeResponse()); err != nil {
+			return WrapApiError(err, args.GetActionName(), d.Id())
 		}
 	}
 
@@ -210,11 +277,28 @@ func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 		request.NatGatewayId = natGateway.NatGatewayId
 		request.Spec = d.Get("specification").(string)
 
-		if _, err := conn.ModifyNatGatewaySpec(request); err != nil {
-			return fmt.Errorf("ModifyNatGatewaySpec got an error: %#v with args: %#v", err, *args)
+		if err := client.doAction(conn, request, vpc.CreateModifyNatGatewaySpecResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
+		}
+
+	}
+
+	if d.HasChange("deletion_protection") {
+		if err := client.SetVpcDeletionProtection(DeletionProtectionNatGateway, d.Id(), d.Get("deletion_protection").(bool)); err != nil {
+			return WrapApiError(err, "DeletionProtection", d.Id())
 		}
+		d.SetPartial("deletion_protection")
+	}
 
+	if err := setVpcTags(client, TagResourceNatGateway, d); err != nil {
+		return WrapErrorf(err, "Set tags for nat gateway got an error")
 	}
+	d.SetPartial("tags")
+
+	if err := setRenewal(client, BssProductNat, d); err != nil {
+		return err
+	}
+
 	d.Partial(false)
 
 	return resourceAliyunNatGatewayRead(d, meta)
@@ -225,15 +309,20 @@ func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 	client := meta.(*AliyunClient)
 	conn := client.vpcconn
 
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		return fmt.Errorf("At present, 'PrePaid' nat gateway cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+
 	packRequest := vpc.CreateDescribeBandwidthPackagesRequest()
 	packRequest.RegionId = string(getRegion(d, meta))
 	packRequest.NatGatewayId = d.Id()
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
-		resp, err := conn.DescribeBandwidthPackages(packRequest)
+		resp := vpc.CreateDescribeBandwidthPackagesResponse()
+		err := client.doAction(conn, packRequest, resp)
 		if err != nil {
 			log.Printf("[ERROR] Describe bandwidth package is failed, natGateway Id: %s", d.Id())
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapApiError(err, packRequest.GetActionName(), d.Id()))
 		}
 
 		retry := false
@@ -242,10 +331,10 @@ func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 				request := vpc.CreateDeleteBandwidthPackageRequest()
 				request.RegionId = string(getRegion(d, meta))
 				request.BandwidthPackageId = pack.BandwidthPackageId
-				if _, err := conn.DeleteBandwidthPackage(request); err != nil {
+				if err := client.doAction(conn, request, vpc.CreateDeleteBandwidthPackageResponse()); err != nil {
 					if IsExceptedError(err, NatGatewayInvalidRegionId) {
 						log.Printf("[ERROR] Delete bandwidth package is failed, bandwidthPackageId: %#v", pack.BandwidthPackageId)
-						return resource.NonRetryableError(err)
+						return resource.NonRetryableError(WrapApiError(err, request.GetActionName(), pack.BandwidthPackageId))
 					}
 					retry = true
 				}
@@ -253,21 +342,24 @@ func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 		}
 
 		if retry {
-			return resource.RetryableError(fmt.Errorf("Delete bandwidth package timeout and got an error: %#v.", err))
+			return resource.RetryableError(fmt.Errorf("Delete the bandwidth packages of nat gateway %s timeout.", d.Id()))
 		}
 
 		args := vpc.CreateDeleteNatGatewayRequest()
 		args.RegionId = string(getRegion(d, meta))
 		args.NatGatewayId = d.Id()
 
-		if _, err := conn.DeleteNatGateway(args); err != nil {
-			if IsExceptedError(err, DependencyViolationBandwidthPackages) {
-				return resource.RetryableError(fmt.Errorf("Delete nat gateway timeout and got an error: %#v.", err))
+		if err := client.doAction(conn, args, vpc.CreateDeleteNatGatewayResponse()); err != nil {
+			if IsExceptedError(err, DependencyViolationBandwidthPackages) || IsRetryableError(VpcCode, err) {
+				return resource.RetryableError(WrapApiError(err, args.GetActionName(), d.Id()))
 			}
 			if IsExceptedError(err, InvalidNatGatewayIdNotFound) {
 				return nil
 			}
-			return resource.NonRetryableError(err)
+			if IsDeletionProtectionError(err) {
+				return resource.NonRetryableError(WrapDeletionProtectionError(err, "nat gateway", d.Id()))
+			}
+			return resource.NonRetryableError(WrapApiError(err, args.GetActionName(), d.Id()))
 		}
 
 		nat, err := client.DescribeNatGateway(d.Id())
@@ -277,11 +369,11 @@ func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 				return nil
 			}
 			log.Printf("[ERROR] Describe NatGateways failed.")
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapApiError(err, "DescribeNatGateways", d.Id()))
 		} else if nat.NatGatewayId != d.Id() {
 			return nil
 		}
 
-		return resource.RetryableError(fmt.Errorf("Delete nat gateway timeout and got an error: %#v.", err))
+		return resource.RetryableError(fmt.Errorf("Delete nat gateway %s timeout.", d.Id()))
 	})
 }
@@ -0,0 +1,181 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudOosExecution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudOosExecutionCreate,
+		Read:   resourceAlicloudOosExecutionRead,
+		Delete: resourceAlicloudOosExecutionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"template_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"parameters": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "{}",
+				ValidateFunc:     validateJsonDocument,
+				DiffSuppressFunc: jsonDiffSuppressFunc,
+			},
+			"mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      OosExecutionAutomatic,
+				ValidateFunc: validateAllowedStringValue([]string{OosExecutionAutomatic, OosExecutionDebug}),
+			},
+			"safety_check": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ConfirmEveryHighRiskAction",
+				ValidateFunc: validateAllowedStringValue([]string{"ConfirmEveryHighRiskAction", "Skip"}),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outputs": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudOosExecutionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"TemplateName": d.Get("template_name").(string),
+		"Parameters":   d.Get("parameters").(string),
+		"Mode":         d.Get("mode").(string),
+		"SafetyCheck":  d.Get("safety_check").(string),
+		"ClientToken":  buildClientToken("TF-StartExecution"),
+	}
+	if v, ok := d.GetOk("template_version"); ok {
+		params["TemplateVersion"] = v.(string)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params["Description"] = v.(string)
+	}
+
+	var resp struct {
+		Execution OosExecution `json:"Execution"`
+	}
+	if err := RetryOnError(OosCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.oosEndpoint(), OosApiVersion, "StartExecution", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "StartExecution got an error")
+	}
+	d.SetId(resp.Execution.ExecutionId)
+
+	return resourceAlicloudOosExecutionRead(d, meta)
+}
+
+// The parameters are not read back, because the service fills the ones with default values in.
+func resourceAlicloudOosExecutionRead(d *schema.ResourceData, meta interface{}) error {
+	execution, err := meta.(*AliyunClient).DescribeOosExecution(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("template_name", execution.TemplateName)
+	d.Set("template_version", execution.TemplateVersion)
+	d.Set("mode", execution.Mode)
+	d.Set("safety_check", execution.SafetyCheck)
+	d.Set("description", execution.Description)
+	d.Set("status", execution.Status)
+	d.Set("status_message", execution.StatusMessage)
+	d.Set("outputs", execution.Outputs)
+	d.Set("start_date", execution.StartDate)
+	d.Set("end_date", execution.EndDate)
+
+	return nil
+}
+
+// The execution which has not ended is cancelled before it is deleted.
+func resourceAlicloudOosExecutionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	execution, err := client.DescribeOosExecution(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	switch execution.Status {
+	case OosExecutionSuccess, OosExecutionFailed, OosExecutionCancelled:
+	default:
+		if err := client.ProcessRpcRequest(client.oosEndpoint(), OosApiVersion, "CancelExecution", map[string]string{
+			"ExecutionId": d.Id(),
+		}, nil); err != nil {
+			return WrapErrorf(err, "CancelExecution got an error")
+		}
+		if err := client.WaitForOosExecution(d.Id(), timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
+			return WrapErrorf(err, "WaitForOosExecution %s got an error", d.Id())
+		}
+	}
+
+	ids, err := json.Marshal([]string{d.Id()})
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := RetryOnError(OosCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.oosEndpoint(), OosApiVersion, "DeleteExecutions", map[string]string{
+			"ExecutionIds": string(ids),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, OosExecutionNotExists) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteExecutions got an error")
+	}
+
+	return nil
+}
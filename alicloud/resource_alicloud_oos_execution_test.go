@@ -0,0 +1,167 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAlicloudOosExecution_schema(t *testing.T) {
+	if err := resourceAlicloudOosExecution().InternalValidate(nil, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudOosExecution_basic(t *testing.T) {
+	var execution OosExecution
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_oos_execution.execution",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckOosExecutionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOosExecutionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOosExecutionExists("alicloud_oos_execution.execution", &execution),
+					resource.TestCheckResourceAttr("alicloud_oos_execution.execution", "template_name", "tf-testacc-oos-execution"),
+					resource.TestCheckResourceAttr("alicloud_oos_execution.execution", "template_version", "v1"),
+					resource.TestCheckResourceAttr("alicloud_oos_execution.execution", "mode", OosExecutionAutomatic),
+					resource.TestCheckResourceAttr("alicloud_oos_execution.execution", "description", "Terraform acc test"),
+					resource.TestCheckResourceAttrSet("alicloud_oos_execution.execution", "status"),
+					resource.TestCheckResourceAttrSet("alicloud_oos_execution.execution", "start_date"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "alicloud_oos_execution.execution",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters", "status", "status_message", "outputs", "end_date"},
+			},
+		},
+	})
+}
+
+func TestOosExecutionDelete(t *testing.T) {
+	var actions []string
+	status := OosExecutionRunning
+	client, server := newTestAliyunClient(t, OosCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		action := r.FormValue("Action")
+		actions = append(actions, action)
+		switch action {
+		case "ListExecutions":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Executions":[{"ExecutionId":"exec-abc","Status":"` + status + `"}]}`))
+		case "CancelExecution":
+			status = OosExecutionCancelled
+			w.Write([]byte(`{"RequestId":"A1B2C3D4"}`))
+		case "DeleteExecutions":
+			if ids := r.FormValue("ExecutionIds"); ids != `["exec-abc"]` {
+				t.Errorf("Expected the execution IDs are a JSON array, got %s", ids)
+			}
+			w.Write([]byte(`{"RequestId":"A1B2C3D4"}`))
+		default:
+			t.Errorf("Unexpected action %s", action)
+		}
+	})
+	defer server.Close()
+
+	// The execution is destroyed by applying the diff, which sets the timeout of the deletion up.
+	destroy := func() {
+		if _, err := resourceAlicloudOosExecution().Apply(&terraform.InstanceState{ID: "exec-abc"}, &terraform.InstanceDiff{Destroy: true}, client); err != nil {
+			t.Fatalf("Deleting the execution got an error: %#v", err)
+		}
+	}
+	destroy()
+	expected := []string{"ListExecutions", "CancelExecution", "ListExecutions", "DeleteExecutions"}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("Expected the running execution is cancelled before it is deleted by %v, got %v", expected, actions)
+	}
+
+	// The execution which has ended is deleted directly.
+	actions = nil
+	destroy()
+	if expected := []string{"ListExecutions", "DeleteExecutions"}; !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("Expected the cancelled execution is deleted by %v, got %v", expected, actions)
+	}
+}
+
+func testAccCheckOosExecutionExists(n string, execution *OosExecution) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OOS Execution ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		e, err := client.DescribeOosExecution(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*execution = e
+
+		return nil
+	}
+}
+
+func testAccCheckOosExecutionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_oos_execution" {
+			continue
+		}
+
+		if _, err := client.DescribeOosExecution(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("OOS Execution %s still exist", rs.Primary.ID)
+	}
+
+	return testAccCheckOosTemplateDestroy(s)
+}
+
+const testAccOosExecutionConfig = `
+resource "alicloud_oos_template" "template" {
+  template_name = "tf-testacc-oos-execution"
+  content = <<EOF
+FormatVersion: OOS-2019-06-01
+Description: Terraform acc test
+Parameters:
+  duration:
+    Type: String
+    Default: PT1S
+Tasks:
+  - Name: Sleep
+    Action: ACS::Sleep
+    Properties:
+      Duration: '{{ duration }}'
+EOF
+}
+
+resource "alicloud_oos_execution" "execution" {
+  template_name = "${alicloud_oos_template.template.template_name}"
+  parameters = <<EOF
+{
+  "duration": "PT10S"
+}
+EOF
+  description = "Terraform acc test"
+}
+`
@@ -0,0 +1,145 @@
+package alicloud
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudOosTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudOosTemplateCreate,
+		Read:   resourceAlicloudOosTemplateRead,
+		Update: resourceAlicloudOosTemplateUpdate,
+		Delete: resourceAlicloudOosTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateYamlString,
+				DiffSuppressFunc: yamlDiffSuppressFunc,
+			},
+			"version_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auto_delete_executions": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"template_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_format": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudOosTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	name := d.Get("template_name").(string)
+	params := map[string]string{
+		"TemplateName": name,
+		"Content":      d.Get("content").(string),
+	}
+	if v, ok := d.GetOk("version_name"); ok {
+		params["VersionName"] = v.(string)
+	}
+	if err := RetryOnError(OosCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.oosEndpoint(), OosApiVersion, "CreateTemplate", params, nil)
+	}); err != nil {
+		return WrapErrorf(err, "CreateTemplate got an error")
+	}
+	d.SetId(name)
+
+	return resourceAlicloudOosTemplateRead(d, meta)
+}
+
+func resourceAlicloudOosTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	template, content, err := meta.(*AliyunClient).DescribeOosTemplate(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("template_name", template.TemplateName)
+	d.Set("content", content)
+	d.Set("template_id", template.TemplateId)
+	d.Set("template_version", template.TemplateVersion)
+	d.Set("template_format", template.TemplateFormat)
+	d.Set("description", template.Description)
+	d.Set("hash", template.Hash)
+
+	return nil
+}
+
+// Each update of the content creates a new version of the template, and the executions keep the version they started with.
+func resourceAlicloudOosTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("content") || d.HasChange("version_name") {
+		params := map[string]string{
+			"TemplateName": d.Id(),
+			"Content":      d.Get("content").(string),
+		}
+		if v, ok := d.GetOk("version_name"); ok {
+			params["VersionName"] = v.(string)
+		}
+		if err := RetryOnError(OosCode, 3*time.Minute, func() error {
+			return client.ProcessRpcRequest(client.oosEndpoint(), OosApiVersion, "UpdateTemplate", params, nil)
+		}); err != nil {
+			return WrapErrorf(err, "UpdateTemplate got an error")
+		}
+	}
+
+	return resourceAlicloudOosTemplateRead(d, meta)
+}
+
+func resourceAlicloudOosTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(OosCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.oosEndpoint(), OosApiVersion, "DeleteTemplate", map[string]string{
+			"TemplateName":         d.Id(),
+			"AutoDeleteExecutions": strconv.FormatBool(d.Get("auto_delete_executions").(bool)),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, OosTemplateNotExists) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteTemplate got an error")
+	}
+
+	return nil
+}
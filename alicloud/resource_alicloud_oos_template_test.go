@@ -0,0 +1,130 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudOosTemplate_basic(t *testing.T) {
+	var template OosTemplate
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_oos_template.template",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckOosTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOosTemplateConfig("Stop"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOosTemplateExists("alicloud_oos_template.template", &template),
+					resource.TestCheckResourceAttr("alicloud_oos_template.template", "template_name", "tf-testacc-oos-template"),
+					resource.TestCheckResourceAttr("alicloud_oos_template.template", "version_name", "Stop"),
+					resource.TestCheckResourceAttr("alicloud_oos_template.template", "template_version", "v1"),
+					resource.TestCheckResourceAttr("alicloud_oos_template.template", "template_format", "YAML"),
+					resource.TestCheckResourceAttrSet("alicloud_oos_template.template", "template_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOosTemplateConfig("Reboot"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOosTemplateExists("alicloud_oos_template.template", &template),
+					resource.TestCheckResourceAttr("alicloud_oos_template.template", "version_name", "Reboot"),
+					resource.TestCheckResourceAttr("alicloud_oos_template.template", "template_version", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudOosTemplate_import(t *testing.T) {
+	resourceName := "alicloud_oos_template.template"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOosTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOosTemplateConfig("Stop"),
+			},
+
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version_name", "auto_delete_executions"},
+			},
+		},
+	})
+}
+
+func testAccCheckOosTemplateExists(n string, template *OosTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OOS Template ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		t, _, err := client.DescribeOosTemplate(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*template = t
+
+		return nil
+	}
+}
+
+func testAccCheckOosTemplateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_oos_template" {
+			continue
+		}
+
+		if _, _, err := client.DescribeOosTemplate(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("OOS Template %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOosTemplateConfig(action string) string {
+	return fmt.Sprintf(`
+resource "alicloud_oos_template" "template" {
+  template_name = "tf-testacc-oos-template"
+  version_name = "%s"
+  content = <<EOF
+FormatVersion: OOS-2019-06-01
+Description: Terraform acc test
+Parameters:
+  instanceId:
+    Type: String
+Tasks:
+  - Name: %sInstance
+    Action: ACS::ECS::%sInstance
+    Properties:
+      instanceId: '{{ instanceId }}'
+EOF
+}
+`, action, action, action)
+}
@@ -213,7 +213,10 @@ func resourceAlicloudOssBucket() *schema.Resource {
 }
 
 func resourceAlicloudOssBucketCreate(d *schema.ResourceData, meta interface{}) error {
-	ossconn := meta.(*AliyunClient).ossconn
+	ossconn, err := meta.(*AliyunClient).ossconn()
+	if err != nil {
+		return WrapError(err)
+	}
 
 	bucket := d.Get("bucket").(string)
 	isExist, err := ossconn.IsBucketExist(bucket)
@@ -228,7 +231,7 @@ func resourceAlicloudOssBucketCreate(d *schema.ResourceData, meta interface{}) e
 
 	err = ossconn.CreateBucket(bucket)
 	if err != nil {
-		return fmt.Errorf("Error creating OSS bucket: %#v", err)
+		return WrapErrorf(err, "Error creating OSS bucket")
 	}
 
 	retryErr := resource.Retry(3*time.Minute, func() *resource.RetryError {
@@ -245,7 +248,7 @@ func resourceAlicloudOssBucketCreate(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if retryErr != nil {
-		return fmt.Errorf("Error creating OSS bucket: %#v", retryErr)
+		return WrapErrorf(retryErr, "Error creating OSS bucket")
 	}
 
 	// Assign the bucket name as the resource ID
@@ -255,7 +258,10 @@ func resourceAlicloudOssBucketCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) error {
-	ossconn := meta.(*AliyunClient).ossconn
+	ossconn, err := meta.(*AliyunClient).ossconn()
+	if err != nil {
+		return WrapError(err)
+	}
 
 	info, err := ossconn.GetBucketInfo(d.Id())
 	if err != nil {
@@ -308,7 +314,7 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 			log.Printf("[WARN] OSS bucket: %s, no website could be found.", d.Id())
 			return nil
 		}
-		return fmt.Errorf("Error getting bucket website: %#v", err)
+		return WrapErrorf(err, "Error getting bucket website")
 	}
 	var websites []map[string]interface{}
 	w := make(map[string]interface{})
@@ -332,7 +338,7 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 			log.Printf("[WARN] OSS bucket: %s, no logging could be found.", d.Id())
 			return nil
 		}
-		return fmt.Errorf("Error getting bucket logging: %#v", err)
+		return WrapErrorf(err, "Error getting bucket logging")
 	}
 
 	if isEnable, ok := d.GetOk("logging_isenable"); ok {
@@ -368,7 +374,7 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 			log.Printf("[WARN] OSS bucket: %s, no referer configuration could be found.", d.Id())
 			return nil
 		}
-		return fmt.Errorf("Error getting bucket referer: %#v", err)
+		return WrapErrorf(err, "Error getting bucket referer")
 	}
 	rf := make(map[string]interface{})
 	// Allow empty
@@ -392,7 +398,7 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 			log.Printf("[WARN] OSS bucket: %s, no lifecycle could be found.", d.Id())
 			return nil
 		}
-		return fmt.Errorf("Error getting bucket lifecycle: %#v", err)
+		return WrapErrorf(err, "Error getting bucket lifecycle")
 	}
 	if len(lifecycle.Rules) > 0 {
 		rules := make([]map[string]interface{}, 0, len(lifecycle.Rules))
@@ -430,13 +436,16 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) error {
-	ossconn := meta.(*AliyunClient).ossconn
+	ossconn, err := meta.(*AliyunClient).ossconn()
+	if err != nil {
+		return WrapError(err)
+	}
 
 	d.Partial(true)
 
 	if d.HasChange("acl") {
 		if err := ossconn.SetBucketACL(d.Id(), oss.ACLType(d.Get("acl").(string))); err != nil {
-			return fmt.Errorf("Error setting OSS bucket ACL: %#v", err)
+			return WrapErrorf(err, "Error setting OSS bucket ACL")
 		}
 		d.SetPartial("acl")
 	}
@@ -482,14 +491,11 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 func resourceAlicloudOssBucketCorsUpdate(ossconn *oss.Client, d *schema.ResourceData) error {
 	cors := d.Get("cors_rule").([]interface{})
 	if cors == nil || len(cors) == 0 {
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-			if err := ossconn.DeleteBucketCORS(d.Id()); err != nil {
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		err := RetryOnError(OssCode, 3*time.Minute, func() error {
+			return ossconn.DeleteBucketCORS(d.Id())
 		})
 		if err != nil {
-			return fmt.Errorf("Error removing OSS bucket cors_rule: %#v", err)
+			return WrapErrorf(err, "Error removing OSS bucket cors_rule")
 		}
 		return nil
 	}
@@ -525,7 +531,7 @@ func resourceAlicloudOssBucketCorsUpdate(ossconn *oss.Client, d *schema.Resource
 	log.Printf("[DEBUG] Oss bucket: %s, put CORS: %#v", d.Id(), cors)
 	err := ossconn.SetBucketCORS(d.Id(), rules)
 	if err != nil {
-		return fmt.Errorf("Error putting oss CORS: %s", err)
+		return WrapErrorf(err, "Error putting oss CORS")
 	}
 
 	return nil
@@ -533,14 +539,11 @@ func resourceAlicloudOssBucketCorsUpdate(ossconn *oss.Client, d *schema.Resource
 func resourceAlicloudOssBucketWebsiteUpdate(ossconn *oss.Client, d *schema.ResourceData) error {
 	ws := d.Get("website").(*schema.Set)
 	if ws == nil || ws.Len() == 0 {
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-			if err := ossconn.DeleteBucketWebsite(d.Id()); err != nil {
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		err := RetryOnError(OssCode, 3*time.Minute, func() error {
+			return ossconn.DeleteBucketWebsite(d.Id())
 		})
 		if err != nil {
-			return fmt.Errorf("Error removing OSS bucket logging: %#v", err)
+			return WrapErrorf(err, "Error removing OSS bucket logging")
 		}
 		return nil
 	}
@@ -555,7 +558,7 @@ func resourceAlicloudOssBucketWebsiteUpdate(ossconn *oss.Client, d *schema.Resou
 		error_document = v.(string)
 	}
 	if err := ossconn.SetBucketWebsite(d.Id(), index_document, error_document); err != nil {
-		return fmt.Errorf("Error putting OSS bucket website: %#v", err)
+		return WrapErrorf(err, "Error putting OSS bucket website")
 	}
 
 	return nil
@@ -564,14 +567,11 @@ func resourceAlicloudOssBucketWebsiteUpdate(ossconn *oss.Client, d *schema.Resou
 func resourceAlicloudOssBucketLoggingUpdate(ossconn *oss.Client, d *schema.ResourceData) error {
 	logging := d.Get("logging").(*schema.Set)
 	if logging == nil || logging.Len() == 0 {
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-			if err := ossconn.DeleteBucketLogging(d.Id()); err != nil {
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		err := RetryOnError(OssCode, 3*time.Minute, func() error {
+			return ossconn.DeleteBucketLogging(d.Id())
 		})
 		if err != nil {
-			return fmt.Errorf("Error removing OSS bucket logging: %#v", err)
+			return WrapErrorf(err, "Error removing OSS bucket logging")
 		}
 		return nil
 	}
@@ -585,7 +585,7 @@ func resourceAlicloudOssBucketLoggingUpdate(ossconn *oss.Client, d *schema.Resou
 		target_prefix = v.(string)
 	}
 	if err := ossconn.SetBucketLogging(d.Id(), target_bucket, target_prefix, d.Get("logging_isenable").(bool)); err != nil {
-		return fmt.Errorf("Error putting OSS bucket logging: %#v", err)
+		return WrapErrorf(err, "Error putting OSS bucket logging")
 	}
 
 	return nil
@@ -596,7 +596,7 @@ func resourceAlicloudOssBucketRefererUpdate(ossconn *oss.Client, d *schema.Resou
 	if config == nil || config.Len() == 0 {
 		log.Printf("[DEBUG] OSS set bucket referer as nil")
 		if err := ossconn.SetBucketReferer(d.Id(), nil, true); err != nil {
-			return fmt.Errorf("Error deleting OSS website: %#v", err)
+			return WrapErrorf(err, "Error deleting OSS website")
 		}
 		return nil
 	}
@@ -614,7 +614,7 @@ func resourceAlicloudOssBucketRefererUpdate(ossconn *oss.Client, d *schema.Resou
 		}
 	}
 	if err := ossconn.SetBucketReferer(d.Id(), referers, allow); err != nil {
-		return fmt.Errorf("Error putting OSS bucket referer configuration: %#v", err)
+		return WrapErrorf(err, "Error putting OSS bucket referer configuration")
 	}
 
 	return nil
@@ -624,14 +624,11 @@ func resourceAlicloudOssBucketLifecycleRuleUpdate(ossconn *oss.Client, d *schema
 	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
 
 	if lifecycleRules == nil || len(lifecycleRules) == 0 {
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-			if err := ossconn.DeleteBucketLifecycle(bucket); err != nil {
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		err := RetryOnError(OssCode, 3*time.Minute, func() error {
+			return ossconn.DeleteBucketLifecycle(bucket)
 		})
 		if err != nil {
-			return fmt.Errorf("Error removing OSS bucket lifecycle: %#v", err)
+			return WrapErrorf(err, "Error removing OSS bucket lifecycle")
 		}
 		return nil
 	}
@@ -672,7 +669,7 @@ func resourceAlicloudOssBucketLifecycleRuleUpdate(ossconn *oss.Client, d *schema
 			if valDate != "" {
 				t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", valDate))
 				if err != nil {
-					return fmt.Errorf("Error Parsing Alicloud OSS Bucket Lifecycle Expiration Date: %s", err.Error())
+					return WrapErrorf(err, "Error Parsing Alicloud OSS Bucket Lifecycle Expiration Date")
 				}
 				i.Date = time.Time(t)
 			}
@@ -684,25 +681,25 @@ func resourceAlicloudOssBucketLifecycleRuleUpdate(ossconn *oss.Client, d *schema
 		rules = append(rules, rule)
 	}
 
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		if err := ossconn.SetBucketLifecycle(bucket, rules); err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	err := RetryOnError(OssCode, 3*time.Minute, func() error {
+		return ossconn.SetBucketLifecycle(bucket, rules)
 	})
 	if err != nil {
-		return fmt.Errorf("Error putting OSS lifecycle rule: %#v", err)
+		return WrapErrorf(err, "Error putting OSS lifecycle rule")
 	}
 
 	return nil
 }
 func resourceAlicloudOssBucketDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AliyunClient).ossconn
+	client, err := meta.(*AliyunClient).ossconn()
+	if err != nil {
+		return WrapError(err)
+	}
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		exist, err := client.IsBucketExist(d.Id())
 		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("OSS delete bucket got an error: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "OSS delete bucket got an error"))
 		}
 
 		if !exist {
@@ -20,6 +20,9 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 		Read:   resourceAlicloudOssBucketObjectRead,
 		Update: resourceAlicloudOssBucketObjectPut,
 		Delete: resourceAlicloudOssBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudOssBucketObjectImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -106,9 +109,9 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 
 func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 
-	bucket, err := meta.(*AliyunClient).ossconn.Bucket(d.Get("bucket").(string))
+	bucket, err := meta.(*AliyunClient).ossBucket(d.Get("bucket").(string))
 	if err != nil {
-		return fmt.Errorf("Error getting bucket: %#v", err)
+		return WrapErrorf(err, "Error getting bucket")
 	}
 
 	var filePath string
@@ -118,7 +121,7 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 		source := v.(string)
 		path, err := homedir.Expand(source)
 		if err != nil {
-			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+			return WrapErrorf(err, "Error expanding homedir in source (%s)", source)
 		}
 
 		filePath = path
@@ -143,7 +146,7 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error putting object in Oss bucket (%#v): %s", bucket, err)
+		return WrapErrorf(err, "Error putting object in Oss bucket (%#v)", bucket)
 	}
 
 	d.SetId(key)
@@ -151,14 +154,14 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
-	bucket, err := meta.(*AliyunClient).ossconn.Bucket(d.Get("bucket").(string))
+	bucket, err := meta.(*AliyunClient).ossBucket(d.Get("bucket").(string))
 	if err != nil {
-		return fmt.Errorf("Error getting bucket: %#v", err)
+		return WrapErrorf(err, "Error getting bucket")
 	}
 
 	options, err := buildObjectHeaderOptions(d)
 	if err != nil {
-		return fmt.Errorf("Error building object header options: %#v", err)
+		return WrapErrorf(err, "Error building object header options")
 	}
 
 	object, err := bucket.GetObjectDetailedMeta(d.Get("key").(string), options...)
@@ -168,7 +171,7 @@ func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("To get the Object: %#v but it is not exist in the specified bucket %s.", d.Get("key").(string), d.Get("bucket").(string))
 		}
 
-		return fmt.Errorf("Error Reading Object: %#v", err)
+		return WrapErrorf(err, "Error Reading Object")
 	}
 
 	log.Printf("[DEBUG] Reading Oss Bucket Object meta: %s", object)
@@ -186,14 +189,14 @@ func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAlicloudOssBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	bucket, err := meta.(*AliyunClient).ossconn.Bucket(d.Get("bucket").(string))
+	bucket, err := meta.(*AliyunClient).ossBucket(d.Get("bucket").(string))
 	if err != nil {
-		return fmt.Errorf("Error getting bucket: %#v", err)
+		return WrapErrorf(err, "Error getting bucket")
 	}
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		exist, err := bucket.IsObjectExist(d.Id())
 		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("OSS delete object got an error: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "OSS delete object got an error"))
 		}
 
 		if !exist {
@@ -247,3 +250,16 @@ func buildObjectHeaderOptions(d *schema.ResourceData) (options []oss.Option, err
 	}
 	return options, nil
 }
+
+// resourceAlicloudOssBucketObjectImportState imports an object by <bucket>:<key>. A bucket name
+// can not contain colons, so the key is everything after the first one.
+func resourceAlicloudOssBucketObjectImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), COLON_SEPARATED, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid oss bucket object import id %s. Expected format is <bucket>:<key>.", d.Id())
+	}
+	d.Set("bucket", parts[0])
+	d.Set("key", parts[1])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,156 @@
+package alicloud
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudQuotasQuotaApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudQuotasQuotaApplicationCreate,
+		Read:   resourceAlicloudQuotasQuotaApplicationRead,
+		Delete: resourceAlicloudQuotasQuotaApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"quota_action_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"quota_category": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"CommonQuota", "FlowControl"}),
+			},
+			"dimensions": &schema.Schema{
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"desire_value": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Required: true,
+				ForceNew: true,
+			},
+			"reason": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// 0 means no notice, and 3 means noticing by the message center, email and sms.
+			"notice_type": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validateAllowedIntValue([]int{0, 1, 2, 3}),
+			},
+			"audit_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Async",
+				ValidateFunc: validateAllowedStringValue([]string{"Sync", "Async"}),
+			},
+			"quota_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"approve_value": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"audit_reason": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudQuotasQuotaApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"ProductCode":     d.Get("product_code").(string),
+		"QuotaActionCode": d.Get("quota_action_code").(string),
+		"DesireValue":     strconv.FormatFloat(d.Get("desire_value").(float64), 'f', -1, 64),
+		"Reason":          d.Get("reason").(string),
+		"NoticeType":      strconv.Itoa(d.Get("notice_type").(int)),
+		"AuditMode":       d.Get("audit_mode").(string),
+	}
+	if v, ok := d.GetOk("quota_category"); ok {
+		params["QuotaCategory"] = v.(string)
+	}
+	setQuotasDimensionParams(params, d.Get("dimensions").(map[string]interface{}))
+
+	var resp struct {
+		ApplicationId string `json:"ApplicationId"`
+	}
+	if err := RetryOnError(QuotasCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.quotasEndpoint(), QuotasApiVersion, "CreateQuotaApplication", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateQuotaApplication got an error")
+	}
+	d.SetId(resp.ApplicationId)
+
+	return resourceAlicloudQuotasQuotaApplicationRead(d, meta)
+}
+
+func resourceAlicloudQuotasQuotaApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	application, err := meta.(*AliyunClient).DescribeQuotasQuotaApplication(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("product_code", application.ProductCode)
+	d.Set("quota_action_code", application.QuotaActionCode)
+	d.Set("dimensions", application.Dimension)
+	d.Set("desire_value", application.DesireValue)
+	d.Set("reason", application.Reason)
+	d.Set("notice_type", application.NoticeType)
+	d.Set("quota_name", application.QuotaName)
+	d.Set("status", application.Status)
+	d.Set("approve_value", application.ApproveValue)
+	d.Set("audit_reason", application.AuditReason)
+	d.Set("effective_time", application.EffectiveTime)
+	d.Set("expire_time", application.ExpireTime)
+
+	return nil
+}
+
+// An application can not be withdrawn, so it is only removed from the state.
+func resourceAlicloudQuotasQuotaApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] The quota application %s can not be deleted, and it is only removed from the state.", d.Id())
+	return nil
+}
@@ -39,7 +39,7 @@ func resourceAlicloudRamAccessKey() *schema.Resource {
 }
 
 func resourceAlicloudRamAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{}
 	if v, ok := d.GetOk("user_name"); ok && v.(string) != "" {
@@ -48,12 +48,12 @@ func resourceAlicloudRamAccessKeyCreate(d *schema.ResourceData, meta interface{}
 
 	response, err := conn.CreateAccessKey(args)
 	if err != nil {
-		return fmt.Errorf("CreateAccessKey got an error: %#v", err)
+		return WrapErrorf(err, "CreateAccessKey got an error")
 	}
 
 	// create a secret_file and write access key to it.
 	if output, ok := d.GetOk("secret_file"); ok && output != nil {
-		writeToFile(output.(string), response.AccessKey)
+		writeToFile(output.(string), response.AccessKey, OutputFileJson)
 	}
 
 	d.SetId(response.AccessKey.AccessKeyId)
@@ -61,7 +61,7 @@ func resourceAlicloudRamAccessKeyCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAlicloudRamAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -76,7 +76,7 @@ func resourceAlicloudRamAccessKeyUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange("status") {
 		d.SetPartial("status")
 		if _, err := conn.UpdateAccessKey(args); err != nil {
-			return fmt.Errorf("UpdateAccessKey got an error: %#v", err)
+			return WrapErrorf(err, "UpdateAccessKey got an error")
 		}
 	}
 
@@ -85,7 +85,7 @@ func resourceAlicloudRamAccessKeyUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAlicloudRamAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{}
 	if v, ok := d.GetOk("user_name"); ok && v.(string) != "" {
@@ -94,7 +94,7 @@ func resourceAlicloudRamAccessKeyRead(d *schema.ResourceData, meta interface{})
 
 	response, err := conn.ListAccessKeys(args)
 	if err != nil {
-		return fmt.Errorf("Get list access keys got an error: %#v", err)
+		return WrapErrorf(err, "Get list access keys got an error")
 	}
 
 	accessKeys := response.AccessKeys.AccessKey
@@ -114,7 +114,7 @@ func resourceAlicloudRamAccessKeyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAlicloudRamAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UpdateAccessKeyRequest{
 		UserAccessKeyId: d.Id(),
@@ -132,7 +132,7 @@ func resourceAlicloudRamAccessKeyDelete(d *schema.ResourceData, meta interface{}
 			if RamEntityNotExist(err) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting access key: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "Error deleting access key"))
 		}
 
 		response, err := conn.ListAccessKeys(queryArgs)
@@ -1,8 +1,6 @@
 package alicloud
 
 import (
-	"fmt"
-
 	"github.com/denverdino/aliyungo/ram"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -12,6 +10,9 @@ func resourceAlicloudRamAccountAlias() *schema.Resource {
 		Create: resourceAlicloudRamAccountAliasCreate,
 		Read:   resourceAlicloudRamAccountAliasRead,
 		Delete: resourceAlicloudRamAccountAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"account_alias": &schema.Schema{
@@ -25,14 +26,14 @@ func resourceAlicloudRamAccountAlias() *schema.Resource {
 }
 
 func resourceAlicloudRamAccountAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.AccountAliasRequest{
 		AccountAlias: d.Get("account_alias").(string),
 	}
 
 	if _, err := conn.SetAccountAlias(args); err != nil {
-		return fmt.Errorf("SetAccountAlias got an error: %#v", err)
+		return WrapErrorf(err, "SetAccountAlias got an error")
 	}
 
 	d.SetId(args.AccountAlias)
@@ -40,11 +41,11 @@ func resourceAlicloudRamAccountAliasCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAlicloudRamAccountAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	response, err := conn.GetAccountAlias()
 	if err != nil {
-		return fmt.Errorf("GetAccountAlias got an error: %#v", err)
+		return WrapErrorf(err, "GetAccountAlias got an error")
 	}
 
 	d.Set("account_alias", response.AccountAlias)
@@ -52,10 +53,10 @@ func resourceAlicloudRamAccountAliasRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAlicloudRamAccountAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	if _, err := conn.ClearAccountAlias(); err != nil {
-		return fmt.Errorf("ClearAccountAlias got an error: %#v", err)
+		return WrapErrorf(err, "ClearAccountAlias got an error")
 	}
 	return nil
 }
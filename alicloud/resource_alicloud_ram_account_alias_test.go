@@ -50,7 +50,7 @@ func testAccCheckRamAccountAliasExists(n string, alias *string) resource.TestChe
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		response, err := conn.GetAccountAlias()
 
@@ -71,7 +71,7 @@ func testAccCheckRamAccountAliasDestroy(s *terraform.State) error {
 
 		// Try to find the alias
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		_, err := conn.GetAccountAlias()
 
@@ -1,11 +1,9 @@
 package alicloud
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -40,7 +38,7 @@ func resourceAlicloudRamGroup() *schema.Resource {
 }
 
 func resourceAlicloudRamGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.GroupRequest{
 		Group: ram.Group{
@@ -50,7 +48,7 @@ func resourceAlicloudRamGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	response, err := conn.CreateGroup(args)
 	if err != nil {
-		return fmt.Errorf("CreateGroup got an error: %#v", err)
+		return WrapErrorf(err, "CreateGroup got an error")
 	}
 
 	d.SetId(response.Group.GroupName)
@@ -58,7 +56,7 @@ func resourceAlicloudRamGroupCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAlicloudRamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -83,7 +81,7 @@ func resourceAlicloudRamGroupUpdate(d *schema.ResourceData, meta interface{}) er
 
 	if attributeUpdate {
 		if _, err := conn.UpdateGroup(args); err != nil {
-			return fmt.Errorf("UpdateGroup got an error: %v", err)
+			return WrapErrorf(err, "UpdateGroup got an error")
 		}
 	}
 
@@ -92,7 +90,7 @@ func resourceAlicloudRamGroupUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAlicloudRamGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.GroupQueryRequest{
 		GroupName: d.Id(),
@@ -103,7 +101,7 @@ func resourceAlicloudRamGroupRead(d *schema.ResourceData, meta interface{}) erro
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("GetGroup got an error: %#v", err)
+		return WrapErrorf(err, "GetGroup got an error")
 	}
 
 	group := response.Group
@@ -113,7 +111,7 @@ func resourceAlicloudRamGroupRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAlicloudRamGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.GroupQueryRequest{
 		GroupName: d.Id(),
@@ -123,7 +121,7 @@ func resourceAlicloudRamGroupDelete(d *schema.ResourceData, meta interface{}) er
 		// list and delete users which in this group
 		listUserResp, err := conn.ListUsersForGroup(args)
 		if err != nil {
-			return fmt.Errorf("Error while listing users for group %s: %#v", d.Id(), err)
+			return WrapErrorf(err, "Error while listing users for group %s", d.Id())
 		}
 		users := listUserResp.Users.User
 		if len(users) > 0 {
@@ -133,7 +131,7 @@ func resourceAlicloudRamGroupDelete(d *schema.ResourceData, meta interface{}) er
 					GroupName: args.GroupName,
 				})
 				if err != nil && !RamEntityNotExist(err) {
-					return fmt.Errorf("Error while deleting user %s from group %s: %#v", v.UserName, d.Id(), err)
+					return WrapErrorf(err, "Error while deleting user %s from group %s", v.UserName, d.Id())
 				}
 			}
 		}
@@ -141,7 +139,7 @@ func resourceAlicloudRamGroupDelete(d *schema.ResourceData, meta interface{}) er
 		// list and detach policies which attach this group
 		listPolicyResp, err := conn.ListPoliciesForGroup(args)
 		if err != nil {
-			return fmt.Errorf("Error while listing policies for group %s: %#v", d.Id(), err)
+			return WrapErrorf(err, "Error while listing policies for group %s", d.Id())
 		}
 		policies := listPolicyResp.Policies.Policy
 		if len(policies) > 0 {
@@ -154,19 +152,18 @@ func resourceAlicloudRamGroupDelete(d *schema.ResourceData, meta interface{}) er
 					GroupName: args.GroupName,
 				})
 				if err != nil && !RamEntityNotExist(err) {
-					return fmt.Errorf("Error while detaching policy %s from group %s: %#v", v.PolicyName, d.Id(), err)
+					return WrapErrorf(err, "Error while detaching policy %s from group %s", v.PolicyName, d.Id())
 				}
 			}
 		}
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := conn.DeleteGroup(args); err != nil {
-			if IsExceptedError(err, DeleteConflictGroupUser) || IsExceptedError(err, DeleteConflictGroupPolicy) {
-				return resource.RetryableError(fmt.Errorf("The group can not has any user member or any attached policy while deleting the group.- you can set force with true to force delete the group."))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting group %s: %#v, you can set force with true to force delete the group.", d.Id(), err))
-		}
-		return nil
+	err := RetryOnError(RamCode, 5*time.Minute, func() error {
+		_, err := conn.DeleteGroup(args)
+		return err
 	})
+	if err != nil {
+		return WrapErrorf(err, "Error deleting group %s, you can set force with true to force delete the group", d.Id())
+	}
+	return nil
 }
@@ -16,6 +16,9 @@ func resourceAlicloudRamGroupMembership() *schema.Resource {
 		Read:   resourceAlicloudRamGroupMembershipRead,
 		Update: resourceAlicloudRamGroupMembershipUpdate,
 		Delete: resourceAlicloudRamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamGroupMembershipImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_name": &schema.Schema{
@@ -38,14 +41,14 @@ func resourceAlicloudRamGroupMembership() *schema.Resource {
 }
 
 func resourceAlicloudRamGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	group := d.Get("group_name").(string)
 	users := expandStringList(d.Get("user_names").(*schema.Set).List())
 
 	err := addUsersToGroup(conn, users, group)
 	if err != nil {
-		return fmt.Errorf("AddUserToGroup got an error: %#v", err)
+		return WrapErrorf(err, "AddUserToGroup got an error")
 	}
 
 	var buf bytes.Buffer
@@ -58,7 +61,7 @@ func resourceAlicloudRamGroupMembershipCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAlicloudRamGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -79,11 +82,11 @@ func resourceAlicloudRamGroupMembershipUpdate(d *schema.ResourceData, meta inter
 		group := d.Get("group_name").(string)
 
 		if err := removeUsersFromGroup(conn, remove, group); err != nil {
-			return fmt.Errorf("removeUsersFromGroup got an error: %#v", err)
+			return WrapErrorf(err, "removeUsersFromGroup got an error")
 		}
 
 		if err := addUsersToGroup(conn, add, group); err != nil {
-			return fmt.Errorf("addUsersToGroup got an error: %#v", err)
+			return WrapErrorf(err, "addUsersToGroup got an error")
 		}
 	}
 
@@ -92,7 +95,7 @@ func resourceAlicloudRamGroupMembershipUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAlicloudRamGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.GroupQueryRequest{
 		GroupName: d.Get("group_name").(string),
@@ -103,7 +106,7 @@ func resourceAlicloudRamGroupMembershipRead(d *schema.ResourceData, meta interfa
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("ListUsersForGroup got an error: %#v", err)
+		return WrapErrorf(err, "ListUsersForGroup got an error")
 	}
 
 	var users []string
@@ -115,20 +118,20 @@ func resourceAlicloudRamGroupMembershipRead(d *schema.ResourceData, meta interfa
 
 	d.Set("group_name", args.GroupName)
 	if err := d.Set("user_names", users); err != nil {
-		return fmt.Errorf("Error setting user list from group membership (%s), error: %#v", args.GroupName, err)
+		return WrapErrorf(err, "Error setting user list from group membership (%s)", args.GroupName)
 	}
 
 	return nil
 }
 
 func resourceAlicloudRamGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	users := expandStringList(d.Get("user_names").(*schema.Set).List())
 	group := d.Get("group_name").(string)
 
 	if err := removeUsersFromGroup(conn, users, group); err != nil {
-		return fmt.Errorf("removeUsersFromGroup got an error: %#v", err)
+		return WrapErrorf(err, "removeUsersFromGroup got an error")
 	}
 
 	return nil
@@ -161,3 +164,9 @@ func removeUsersFromGroup(conn ram.RamClientInterface, users []string, group str
 	}
 	return nil
 }
+
+// resourceAlicloudRamGroupMembershipImportState imports all of the members of the group by the group name.
+func resourceAlicloudRamGroupMembershipImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("group_name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
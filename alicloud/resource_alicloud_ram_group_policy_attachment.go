@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
@@ -14,6 +15,9 @@ func resourceAlicloudRamGroupPolicyAtatchment() *schema.Resource {
 		Create: resourceAlicloudRamGroupPolicyAttachmentCreate,
 		Read:   resourceAlicloudRamGroupPolicyAttachmentRead,
 		Delete: resourceAlicloudRamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamGroupPolicyAttachmentImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_name": &schema.Schema{
@@ -39,7 +43,7 @@ func resourceAlicloudRamGroupPolicyAtatchment() *schema.Resource {
 }
 
 func resourceAlicloudRamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.AttachPolicyToGroupRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -50,7 +54,7 @@ func resourceAlicloudRamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	if _, err := conn.AttachPolicyToGroup(args); err != nil {
-		return fmt.Errorf("AttachPolicyToGroup got an error: %#v", err)
+		return WrapErrorf(err, "AttachPolicyToGroup got an error")
 	}
 	d.SetId("group" + args.PolicyName + string(args.PolicyType) + args.GroupName)
 
@@ -58,7 +62,7 @@ func resourceAlicloudRamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta
 }
 
 func resourceAlicloudRamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.GroupQueryRequest{
 		GroupName: d.Get("group_name").(string),
@@ -69,7 +73,7 @@ func resourceAlicloudRamGroupPolicyAttachmentRead(d *schema.ResourceData, meta i
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("Get list policies for group got an error: %#v", err)
+		return WrapErrorf(err, "Get list policies for group got an error")
 	}
 
 	if len(response.Policies.Policy) > 0 {
@@ -88,7 +92,7 @@ func resourceAlicloudRamGroupPolicyAttachmentRead(d *schema.ResourceData, meta i
 }
 
 func resourceAlicloudRamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.AttachPolicyToGroupRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -103,7 +107,7 @@ func resourceAlicloudRamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta
 			if RamEntityNotExist(err) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting group policy attachment: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "Error deleting group policy attachment"))
 		}
 
 		response, err := conn.ListPoliciesForGroup(ram.GroupQueryRequest{GroupName: args.GroupName})
@@ -121,3 +125,16 @@ func resourceAlicloudRamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("Error deleting group policy attachment - trying again while it is deleted."))
 	})
 }
+
+// resourceAlicloudRamGroupPolicyAttachmentImportState imports an attachment by <group_name>:<policy_name>:<policy_type>.
+func resourceAlicloudRamGroupPolicyAttachmentImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid ram group policy attachment import id %s. Expected format is <group_name>:<policy_name>:<policy_type>.", d.Id())
+	}
+	d.Set("group_name", parts[0])
+	d.Set("policy_name", parts[1])
+	d.Set("policy_type", parts[2])
+	d.SetId("group" + parts[1] + parts[2] + parts[0])
+	return []*schema.ResourceData{d}, nil
+}
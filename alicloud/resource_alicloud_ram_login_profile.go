@@ -46,7 +46,7 @@ func resourceAlicloudRamLoginProfile() *schema.Resource {
 }
 
 func resourceAlicloudRamLoginProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.ProfileRequest{
 		UserName:              d.Get("user_name").(string),
@@ -56,7 +56,7 @@ func resourceAlicloudRamLoginProfileCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if _, err := conn.CreateLoginProfile(args); err != nil {
-		return fmt.Errorf("CreateLoginProfile got an error: %#v", err)
+		return WrapErrorf(err, "CreateLoginProfile got an error")
 	}
 
 	d.SetId(args.UserName)
@@ -64,7 +64,7 @@ func resourceAlicloudRamLoginProfileCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAlicloudRamLoginProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -93,7 +93,7 @@ func resourceAlicloudRamLoginProfileUpdate(d *schema.ResourceData, meta interfac
 
 	if attributeUpdate && !d.IsNewResource() {
 		if _, err := conn.UpdateLoginProfile(args); err != nil {
-			return fmt.Errorf("UpdateLoginProfile got an error: %v", err)
+			return WrapErrorf(err, "UpdateLoginProfile got an error")
 		}
 	}
 
@@ -102,7 +102,7 @@ func resourceAlicloudRamLoginProfileUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAlicloudRamLoginProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{
 		UserName: d.Id(),
@@ -113,7 +113,7 @@ func resourceAlicloudRamLoginProfileRead(d *schema.ResourceData, meta interface{
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("GetLoginProfile got an error: %#v", err)
+		return WrapErrorf(err, "GetLoginProfile got an error")
 	}
 
 	profile := response.LoginProfile
@@ -124,7 +124,7 @@ func resourceAlicloudRamLoginProfileRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAlicloudRamLoginProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{
 		UserName: d.Id(),
@@ -135,7 +135,7 @@ func resourceAlicloudRamLoginProfileDelete(d *schema.ResourceData, meta interfac
 			if RamEntityNotExist(err) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting login profile: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "Error deleting login profile"))
 		}
 
 		response, err := conn.GetLoginProfile(args)
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -63,10 +62,11 @@ func resourceAlicloudRamPolicy() *schema.Resource {
 				ConflictsWith: []string{"document"},
 			},
 			"document": &schema.Schema{
-				Type:          schema.TypeString,
-				Optional:      true,
-				Computed:      true,
-				ConflictsWith: []string{"statement", "version"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"statement", "version"},
+				DiffSuppressFunc: jsonDiffSuppressFunc,
 				ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
 					value := v.(string)
 					if len(value) > 2048 {
@@ -93,6 +93,21 @@ func resourceAlicloudRamPolicy() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"default_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"keep_versions": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      RamPolicyVersionLimit,
+				ValidateFunc: validateIntegerInRange(1, RamPolicyVersionLimit),
+			},
+			"version_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"type": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -106,7 +121,7 @@ func resourceAlicloudRamPolicy() *schema.Resource {
 }
 
 func resourceAlicloudRamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args, err := buildAlicloudRamPolicyCreateArgs(d, meta)
 	if err != nil {
@@ -115,7 +130,7 @@ func resourceAlicloudRamPolicyCreate(d *schema.ResourceData, meta interface{}) e
 
 	response, err := conn.CreatePolicy(args)
 	if err != nil {
-		return fmt.Errorf("CreatePolicy got an error: %#v", err)
+		return WrapErrorf(err, "CreatePolicy got an error")
 	}
 
 	d.SetId(response.Policy.PolicyName)
@@ -123,18 +138,59 @@ func resourceAlicloudRamPolicyCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudRamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	client := meta.(*AliyunClient)
+	conn := client.ramconn()
 	d.Partial(true)
 
+	// Rolling back to an existing version sets it as the default one.
+	if d.HasChange("default_version") && !d.IsNewResource() {
+		if v, ok := d.GetOk("default_version"); ok && v.(string) != "" {
+			if _, err := conn.SetDefaultPolicyVersion(ram.PolicyRequest{
+				PolicyName: d.Id(),
+				VersionId:  v.(string),
+			}); err != nil {
+				return WrapErrorf(err, "SetDefaultPolicyVersion got an error")
+			}
+		}
+		d.SetPartial("default_version")
+	}
+
 	args, attributeUpdate, err := buildAlicloudRamPolicyUpdateArgs(d, meta)
 	if err != nil {
 		return err
 	}
 
 	if !d.IsNewResource() && attributeUpdate {
-		if _, err := conn.CreatePolicyVersion(args); err != nil {
-			return fmt.Errorf("Error updating policy %s: %#v", d.Id(), err)
+		// No new version is needed when the default version has the document, like after rolling back.
+		policyResp, err := conn.GetPolicy(ram.PolicyRequest{PolicyName: d.Id(), PolicyType: ram.Custom})
+		if err != nil {
+			return WrapErrorf(err, "GetPolicy got an error")
 		}
+		versionResp, err := conn.GetPolicyVersionNew(ram.PolicyRequest{
+			PolicyName: d.Id(),
+			PolicyType: ram.Custom,
+			VersionId:  policyResp.Policy.DefaultVersion,
+		})
+		if err != nil {
+			return WrapErrorf(err, "GetPolicyVersion got an error")
+		}
+
+		if !jsonStringEqual(versionResp.PolicyVersion.PolicyDocument, args.PolicyDocument) {
+			// Leave room for the new version, because a policy can not have more than 5 versions.
+			if err := client.PruneRamPolicyVersions(d.Id(), RamPolicyVersionLimit-1); err != nil {
+				return err
+			}
+			if _, err := conn.CreatePolicyVersion(args); err != nil {
+				return WrapErrorf(err, "Error updating policy %s", d.Id())
+			}
+		}
+	}
+
+	if attributeUpdate || d.HasChange("keep_versions") {
+		if err := client.PruneRamPolicyVersions(d.Id(), d.Get("keep_versions").(int)); err != nil {
+			return err
+		}
+		d.SetPartial("keep_versions")
 	}
 
 	d.Partial(false)
@@ -143,7 +199,7 @@ func resourceAlicloudRamPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAlicloudRamPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.PolicyRequest{
 		PolicyName: d.Id(),
@@ -155,14 +211,14 @@ func resourceAlicloudRamPolicyRead(d *schema.ResourceData, meta interface{}) err
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("GetPolicy got an error: %#v", err)
+		return WrapErrorf(err, "GetPolicy got an error")
 	}
 	policy := policyResp.Policy
 
 	args.VersionId = policy.DefaultVersion
 	policyVersionResp, err := conn.GetPolicyVersionNew(args)
 	if err != nil {
-		return fmt.Errorf("GetPolicyVersion got an error: %#v", err)
+		return WrapErrorf(err, "GetPolicyVersion got an error")
 	}
 
 	statement, version, err := ParsePolicyDocument(policyVersionResp.PolicyVersion.PolicyDocument)
@@ -177,12 +233,14 @@ func resourceAlicloudRamPolicyRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("version", version)
 	d.Set("statement", statement)
 	d.Set("document", policyVersionResp.PolicyVersion.PolicyDocument)
+	d.Set("default_version", policy.DefaultVersion)
+	d.Set("version_id", policy.DefaultVersion)
 
 	return nil
 }
 
 func resourceAlicloudRamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.PolicyRequest{
 		PolicyName: d.Id(),
@@ -194,7 +252,7 @@ func resourceAlicloudRamPolicyDelete(d *schema.ResourceData, meta interface{}) e
 		// list and detach entities for this policy
 		response, err := conn.ListEntitiesForPolicy(args)
 		if err != nil {
-			return fmt.Errorf("Error listing entities for policy %s when trying to delete: %#v", d.Id(), err)
+			return WrapErrorf(err, "Error listing entities for policy %s when trying to delete", d.Id())
 		}
 
 		if len(response.Users.User) > 0 {
@@ -204,7 +262,7 @@ func resourceAlicloudRamPolicyDelete(d *schema.ResourceData, meta interface{}) e
 					UserName:      v.UserName,
 				})
 				if err != nil && !RamEntityNotExist(err) {
-					return fmt.Errorf("Error detaching policy %s from user %s:%#v", d.Id(), v.UserId, err)
+					return WrapErrorf(err, "Error detaching policy %s from user %s", d.Id(), v.UserId)
 				}
 			}
 		}
@@ -216,7 +274,7 @@ func resourceAlicloudRamPolicyDelete(d *schema.ResourceData, meta interface{}) e
 					GroupName:     v.GroupName,
 				})
 				if err != nil && !RamEntityNotExist(err) {
-					return fmt.Errorf("Error detaching policy %s from group %s:%#v", d.Id(), v.GroupName, err)
+					return WrapErrorf(err, "Error detaching policy %s from group %s", d.Id(), v.GroupName)
 				}
 			}
 		}
@@ -228,7 +286,7 @@ func resourceAlicloudRamPolicyDelete(d *schema.ResourceData, meta interface{}) e
 					RoleName:      v.RoleName,
 				})
 				if err != nil && !RamEntityNotExist(err) {
-					return fmt.Errorf("Error detaching policy %s from role %s:%#v", d.Id(), v.RoleId, err)
+					return WrapErrorf(err, "Error detaching policy %s from role %s", d.Id(), v.RoleId)
 				}
 			}
 		}
@@ -236,32 +294,28 @@ func resourceAlicloudRamPolicyDelete(d *schema.ResourceData, meta interface{}) e
 		// list and delete policy version which are not default
 		pvResp, err := conn.ListPolicyVersionsNew(args)
 		if err != nil {
-			return fmt.Errorf("Error listing policy versions for policy %s:%#v", d.Id(), err)
+			return WrapErrorf(err, "Error listing policy versions for policy %s", d.Id())
 		}
 		if len(pvResp.PolicyVersions.PolicyVersion) > 1 {
 			for _, v := range pvResp.PolicyVersions.PolicyVersion {
 				if !v.IsDefaultVersion {
 					args.VersionId = v.VersionId
 					if _, err = conn.DeletePolicyVersion(args); err != nil && !RamEntityNotExist(err) {
-						return fmt.Errorf("Error delete policy version %s for policy %s:%#v", v.VersionId, d.Id(), err)
+						return WrapErrorf(err, "Error delete policy version %s for policy %s", v.VersionId, d.Id())
 					}
 				}
 			}
 		}
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := conn.DeletePolicy(args); err != nil {
-			if IsExceptedError(err, DeleteConflictPolicyUser) || IsExceptedError(err, DeleteConflictPolicyGroup) || IsExceptedError(err, DeleteConflictRolePolicy) {
-				return resource.RetryableError(fmt.Errorf("The policy can not been attached to any user or group or role while deleting the policy. - you can set force with true to force delete the policy."))
-			}
-			if IsExceptedError(err, DeleteConflictPolicyVersion) {
-				return resource.RetryableError(fmt.Errorf("The policy can not has any version except the defaul version. - you can set force with true to force delete the policy."))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting policy %s: %#v", d.Id(), err))
-		}
-		return nil
+	err := RetryOnError(RamCode, 5*time.Minute, func() error {
+		_, err := conn.DeletePolicy(args)
+		return err
 	})
+	if err != nil {
+		return WrapErrorf(err, "Error deleting policy %s, you can set force with true to force delete the policy", d.Id())
+	}
+	return nil
 }
 
 func buildAlicloudRamPolicyCreateArgs(d *schema.ResourceData, meta interface{}) (ram.PolicyRequest, error) {
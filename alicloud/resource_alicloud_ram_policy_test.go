@@ -44,6 +44,51 @@ func TestAccAlicloudRamPolicy_basic(t *testing.T) {
 
 }
 
+func TestAccAlicloudRamPolicy_versions(t *testing.T) {
+	var v ram.Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ram_policy.policy",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRamPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRamPolicyVersionConfig("oss:ListObjects", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRamPolicyExists("alicloud_ram_policy.policy", &v),
+					resource.TestCheckResourceAttr("alicloud_ram_policy.policy", "version_id", "v1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRamPolicyVersionConfig("oss:GetObject", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRamPolicyExists("alicloud_ram_policy.policy", &v),
+					resource.TestCheckResourceAttr("alicloud_ram_policy.policy", "version_id", "v2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRamPolicyVersionConfig("oss:ListObjects", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRamPolicyExists("alicloud_ram_policy.policy", &v),
+					resource.TestCheckResourceAttr("alicloud_ram_policy.policy", "version_id", "v1"),
+				),
+			},
+		},
+	})
+}
+
+func TestRamPolicyVersionNumber(t *testing.T) {
+	if n := ramPolicyVersionNumber("v12"); n != 12 {
+		t.Fatalf("Expected the version number 12, got %d.", n)
+	}
+}
+
 func testAccCheckRamPolicyExists(n string, policy *ram.Policy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -56,7 +101,7 @@ func testAccCheckRamPolicyExists(n string, policy *ram.Policy) resource.TestChec
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.PolicyRequest{
 			PolicyName: rs.Primary.ID,
@@ -83,7 +128,7 @@ func testAccCheckRamPolicyDestroy(s *terraform.State) error {
 
 		// Try to find the policy
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ramconn
+		conn := client.ramconn()
 
 		request := ram.PolicyRequest{
 			PolicyName: rs.Primary.ID,
@@ -118,3 +163,22 @@ resource "alicloud_ram_policy" "policy" {
   description = "this is a policy test"
   force = true
 }`
+
+func testAccRamPolicyVersionConfig(action, defaultVersion string) string {
+	if defaultVersion != "" {
+		defaultVersion = fmt.Sprintf("default_version = \"%s\"", defaultVersion)
+	}
+	return fmt.Sprintf(`
+resource "alicloud_ram_policy" "policy" {
+  name = "tf-testacc-policy-versions"
+  document = <<EOF
+  {
+    "Statement": [{"Action": ["%s"], "Effect": "Allow", "Resource": ["acs:oss:*:*:mybucket"]}],
+    "Version": "1"
+  }
+  EOF
+  %s
+  keep_versions = 2
+  force = true
+}`, action, defaultVersion)
+}
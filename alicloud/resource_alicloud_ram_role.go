@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -79,7 +78,7 @@ func resourceAlicloudRamRole() *schema.Resource {
 }
 
 func resourceAlicloudRamRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args, err := buildAlicloudRamRoleCreateArgs(d, meta)
 	if err != nil {
@@ -88,7 +87,7 @@ func resourceAlicloudRamRoleCreate(d *schema.ResourceData, meta interface{}) err
 
 	response, err := conn.CreateRole(args)
 	if err != nil {
-		return fmt.Errorf("CreateRole got an error: %#v", err)
+		return WrapErrorf(err, "CreateRole got an error")
 	}
 
 	d.SetId(response.Role.RoleName)
@@ -96,7 +95,7 @@ func resourceAlicloudRamRoleCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudRamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -107,7 +106,7 @@ func resourceAlicloudRamRoleUpdate(d *schema.ResourceData, meta interface{}) err
 
 	if !d.IsNewResource() && attributeUpdate {
 		if _, err := conn.UpdateRole(args); err != nil {
-			return fmt.Errorf("UpdateRole got an error: %v", err)
+			return WrapErrorf(err, "UpdateRole got an error")
 		}
 	}
 
@@ -116,7 +115,7 @@ func resourceAlicloudRamRoleUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudRamRoleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.RoleQueryRequest{
 		RoleName: d.Id(),
@@ -127,7 +126,7 @@ func resourceAlicloudRamRoleRead(d *schema.ResourceData, meta interface{}) error
 		if RamEntityNotExist(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("GetRole got an error: %v", err)
+		return WrapErrorf(err, "GetRole got an error")
 	}
 
 	role := response.Role
@@ -150,7 +149,7 @@ func resourceAlicloudRamRoleRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAlicloudRamRoleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.RoleQueryRequest{
 		RoleName: d.Id(),
@@ -159,7 +158,7 @@ func resourceAlicloudRamRoleDelete(d *schema.ResourceData, meta interface{}) err
 	if d.Get("force").(bool) {
 		resp, err := conn.ListPoliciesForRole(args)
 		if err != nil {
-			return fmt.Errorf("Error listing Policies for Role (%s) when trying to delete: %#v", d.Id(), err)
+			return WrapErrorf(err, "Error listing Policies for Role (%s) when trying to delete", d.Id())
 		}
 
 		// Loop and remove the Policies from the Role
@@ -173,20 +172,19 @@ func resourceAlicloudRamRoleDelete(d *schema.ResourceData, meta interface{}) err
 					RoleName: d.Id(),
 				})
 				if err != nil && !RamEntityNotExist(err) {
-					return fmt.Errorf("Error detach Policy from Role %s: %#v", d.Id(), err)
+					return WrapErrorf(err, "Error detach Policy from Role %s", d.Id())
 				}
 			}
 		}
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := conn.DeleteRole(args); err != nil {
-			if IsExceptedError(err, DeleteConflictRolePolicy) {
-				return resource.RetryableError(fmt.Errorf("The role can not has any attached policy while deleting the role. - you can set force with true to force delete the role."))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting role %s: %#v, you can set force with true to force delete the role.", d.Id(), err))
-		}
-		return nil
+	err := RetryOnError(RamCode, 5*time.Minute, func() error {
+		_, err := conn.DeleteRole(args)
+		return err
 	})
+	if err != nil {
+		return WrapErrorf(err, "Error deleting role %s, you can set force with true to force delete the role", d.Id())
+	}
+	return nil
 }
 
 func buildAlicloudRamRoleCreateArgs(d *schema.ResourceData, meta interface{}) (ram.RoleRequest, error) {
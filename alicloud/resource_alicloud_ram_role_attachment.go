@@ -7,7 +7,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -17,6 +17,9 @@ func resourceAlicloudRamRoleAttachment() *schema.Resource {
 		Create: resourceAlicloudInstanceRoleAttachmentCreate,
 		Read:   resourceAlicloudInstanceRoleAttachmentRead,
 		Delete: resourceAlicloudInstanceRoleAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": &schema.Schema{
@@ -37,15 +40,13 @@ func resourceAlicloudRamRoleAttachment() *schema.Resource {
 
 func resourceAlicloudInstanceRoleAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
 
 	instanceIds := convertListToJsonString(d.Get("instance_ids").(*schema.Set).List())
 
-	args := ecs.AttachInstancesArgs{
-		RegionId:    getRegion(d, meta),
-		InstanceIds: instanceIds,
-		RamRoleName: d.Get("role_name").(string),
-	}
+	args := ecs.CreateAttachInstanceRamRoleRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.InstanceIds = instanceIds
+	args.RamRoleName = d.Get("role_name").(string)
 
 	err := client.JudgeRolePolicyPrincipal(args.RamRoleName)
 	if err != nil {
@@ -53,11 +54,11 @@ func resourceAlicloudInstanceRoleAttachmentCreate(d *schema.ResourceData, meta i
 	}
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if err := conn.AttachInstanceRamRole(&args); err != nil {
+		if err := client.doAction(client.ecsconn(), args, ecs.CreateAttachInstanceRamRoleResponse()); err != nil {
 			if IsExceptedError(err, RoleAttachmentUnExpectedJson) {
 				return resource.RetryableError(fmt.Errorf("Please trying again."))
 			}
-			return resource.NonRetryableError(fmt.Errorf("AttachInstanceRamRole got an error: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "AttachInstanceRamRole got an error"))
 		}
 		d.SetId(d.Get("role_name").(string) + ":" + instanceIds)
 		return resource.NonRetryableError(resourceAlicloudInstanceRoleAttachmentRead(d, meta))
@@ -65,18 +66,17 @@ func resourceAlicloudInstanceRoleAttachmentCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAlicloudInstanceRoleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 	roleName := strings.Split(d.Id(), ":")[0]
 	instanceIds := strings.Split(d.Id(), ":")[1]
 
-	args := ecs.AttachInstancesArgs{
-		RegionId:    getRegion(d, meta),
-		InstanceIds: instanceIds,
-	}
+	args := ecs.CreateDescribeInstanceRamRoleRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.InstanceIds = instanceIds
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		resp, err := conn.DescribeInstanceRamRole(&args)
-		if err != nil {
+		resp := ecs.CreateDescribeInstanceRamRoleResponse()
+		if err := client.doAction(client.ecsconn(), args, resp); err != nil {
 			if IsExceptedError(err, RoleAttachmentUnExpectedJson) {
 				return resource.RetryableError(fmt.Errorf("Please trying again."))
 			}
@@ -84,7 +84,7 @@ func resourceAlicloudInstanceRoleAttachmentRead(d *schema.ResourceData, meta int
 				d.SetId("")
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("DescribeInstanceRamRole got an error: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "DescribeInstanceRamRole got an error"))
 		}
 
 		instRoleSets := resp.InstanceRamRoleSets.InstanceRamRoleSet
@@ -109,22 +109,23 @@ func resourceAlicloudInstanceRoleAttachmentRead(d *schema.ResourceData, meta int
 }
 
 func resourceAlicloudInstanceRoleAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 	roleName := strings.Split(d.Id(), ":")[0]
 	instanceIds := strings.Split(d.Id(), ":")[1]
 
+	request := ecs.CreateDetachInstanceRamRoleRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.RamRoleName = roleName
+	request.InstanceIds = instanceIds
+
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := conn.DetachInstanceRamRole(&ecs.AttachInstancesArgs{
-			RegionId:    getRegion(d, meta),
-			RamRoleName: roleName,
-			InstanceIds: instanceIds,
-		})
+		err := client.doAction(client.ecsconn(), request, ecs.CreateDetachInstanceRamRoleResponse())
 
 		if err != nil {
 			if IsExceptedError(err, RoleAttachmentUnExpectedJson) {
 				return resource.RetryableError(fmt.Errorf("Please trying again."))
 			}
-			return resource.NonRetryableError(fmt.Errorf("Error DetachInstanceRamRole:%#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "Error DetachInstanceRamRole"))
 		}
 		return nil
 	})
@@ -5,15 +5,15 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/denverdino/aliyungo/ram"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAlicloudRamRoleAttachment_basic(t *testing.T) {
-	var instanceA ecs.InstanceAttributesType
-	var instanceB ecs.InstanceAttributesType
+	var instanceA ecs.Instance
+	var instanceB ecs.Instance
 	var role ram.Role
 
 	resource.Test(t, resource.TestCase{
@@ -45,7 +45,7 @@ func TestAccAlicloudRamRoleAttachment_basic(t *testing.T) {
 
 }
 
-func testAccCheckRamRoleAttachmentExists(n string, instanceA *ecs.InstanceAttributesType, instanceB *ecs.InstanceAttributesType, role *ram.Role) resource.TestCheckFunc {
+func testAccCheckRamRoleAttachmentExists(n string, instanceA *ecs.Instance, instanceB *ecs.Instance, role *ram.Role) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -57,15 +57,14 @@ func testAccCheckRamRoleAttachmentExists(n string, instanceA *ecs.InstanceAttrib
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
 
-		request := &ecs.AttachInstancesArgs{
-			RegionId:    client.Region,
-			InstanceIds: convertListToJsonString([]interface{}{instanceA.InstanceId, instanceB.InstanceId}),
-		}
+		request := ecs.CreateDescribeInstanceRamRoleRequest()
+		request.RegionId = string(client.Region)
+		request.InstanceIds = convertListToJsonString([]interface{}{instanceA.InstanceId, instanceB.InstanceId})
 
 		for {
-			response, err := conn.DescribeInstanceRamRole(request)
+			response := ecs.CreateDescribeInstanceRamRoleResponse()
+			err := client.doAction(client.ecsconn(), request, response)
 			if IsExceptedError(err, RoleAttachmentUnExpectedJson) {
 				continue
 			}
@@ -93,15 +92,14 @@ func testAccCheckRamRoleAttachmentDestroy(s *terraform.State) error {
 
 		// Try to find the attachment
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
 
-		request := &ecs.AttachInstancesArgs{
-			RegionId:    client.Region,
-			InstanceIds: strings.Split(rs.Primary.ID, ":")[1],
-		}
+		request := ecs.CreateDescribeInstanceRamRoleRequest()
+		request.RegionId = string(client.Region)
+		request.InstanceIds = strings.Split(rs.Primary.ID, ":")[1]
 
 		for {
-			response, err := conn.DescribeInstanceRamRole(request)
+			response := ecs.CreateDescribeInstanceRamRoleResponse()
+			err := client.doAction(client.ecsconn(), request, response)
 			if IsExceptedError(err, RoleAttachmentUnExpectedJson) {
 				continue
 			}
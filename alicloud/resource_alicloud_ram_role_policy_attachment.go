@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
@@ -15,6 +16,9 @@ func resourceAlicloudRamRolePolicyAttachment() *schema.Resource {
 		Read:   resourceAlicloudRamRolePolicyAttachmentRead,
 		//Update: resourceAlicloudRamRolePolicyAttachmentUpdate,
 		Delete: resourceAlicloudRamRolePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamRolePolicyAttachmentImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": &schema.Schema{
@@ -40,7 +44,7 @@ func resourceAlicloudRamRolePolicyAttachment() *schema.Resource {
 }
 
 func resourceAlicloudRamRolePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 	args := ram.AttachPolicyToRoleRequest{
 		PolicyRequest: ram.PolicyRequest{
 			PolicyName: d.Get("policy_name").(string),
@@ -50,7 +54,7 @@ func resourceAlicloudRamRolePolicyAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	if _, err := conn.AttachPolicyToRole(args); err != nil {
-		return fmt.Errorf("AttachPolicyToRole got an error: %#v", err)
+		return WrapErrorf(err, "AttachPolicyToRole got an error")
 	}
 	d.SetId("role" + args.PolicyName + string(args.PolicyType) + args.RoleName)
 
@@ -58,7 +62,7 @@ func resourceAlicloudRamRolePolicyAttachmentCreate(d *schema.ResourceData, meta
 }
 
 func resourceAlicloudRamRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.RoleQueryRequest{
 		RoleName: d.Get("role_name").(string),
@@ -66,7 +70,7 @@ func resourceAlicloudRamRolePolicyAttachmentRead(d *schema.ResourceData, meta in
 
 	response, err := conn.ListPoliciesForRole(args)
 	if err != nil {
-		return fmt.Errorf("Get list policies for role got an error: %v", err)
+		return WrapErrorf(err, "Get list policies for role got an error")
 	}
 
 	if len(response.Policies.Policy) > 0 {
@@ -85,7 +89,7 @@ func resourceAlicloudRamRolePolicyAttachmentRead(d *schema.ResourceData, meta in
 }
 
 func resourceAlicloudRamRolePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.AttachPolicyToRoleRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -100,7 +104,7 @@ func resourceAlicloudRamRolePolicyAttachmentDelete(d *schema.ResourceData, meta
 			if RamEntityNotExist(err) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting role policy attachment: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "Error deleting role policy attachment"))
 		}
 
 		response, err := conn.ListPoliciesForRole(ram.RoleQueryRequest{RoleName: args.RoleName})
@@ -117,3 +121,16 @@ func resourceAlicloudRamRolePolicyAttachmentDelete(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("Error deleting role policy attachment - trying again while it is deleted."))
 	})
 }
+
+// resourceAlicloudRamRolePolicyAttachmentImportState imports an attachment by <role_name>:<policy_name>:<policy_type>.
+func resourceAlicloudRamRolePolicyAttachmentImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid ram role policy attachment import id %s. Expected format is <role_name>:<policy_name>:<policy_type>.", d.Id())
+	}
+	d.Set("role_name", parts[0])
+	d.Set("policy_name", parts[1])
+	d.Set("policy_type", parts[2])
+	d.SetId("role" + parts[1] + parts[2] + parts[0])
+	return []*schema.ResourceData{d}, nil
+}
@@ -1,11 +1,9 @@
 package alicloud
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -53,7 +51,7 @@ func resourceAlicloudRamUser() *schema.Resource {
 }
 
 func resourceAlicloudRamUserCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserRequest{
 		User: ram.User{
@@ -63,7 +61,7 @@ func resourceAlicloudRamUserCreate(d *schema.ResourceData, meta interface{}) err
 
 	response, err := conn.CreateUser(args)
 	if err != nil {
-		return fmt.Errorf("CreateUser got an error: %#v", err)
+		return WrapErrorf(err, "CreateUser got an error")
 	}
 
 	d.SetId(response.User.UserName)
@@ -71,7 +69,7 @@ func resourceAlicloudRamUserCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudRamUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	d.Partial(true)
 
@@ -115,7 +113,7 @@ func resourceAlicloudRamUserUpdate(d *schema.ResourceData, meta interface{}) err
 
 	if attributeUpdate {
 		if _, err := conn.UpdateUser(args); err != nil {
-			return fmt.Errorf("Update user got an error: %v", err)
+			return WrapErrorf(err, "Update user got an error")
 		}
 	}
 
@@ -124,7 +122,7 @@ func resourceAlicloudRamUserUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAlicloudRamUserRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{
 		UserName: d.Id(),
@@ -136,7 +134,7 @@ func resourceAlicloudRamUserRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("GetUser got an error: %#v", err)
+		return WrapErrorf(err, "GetUser got an error")
 	}
 
 	user := response.User
@@ -149,7 +147,7 @@ func resourceAlicloudRamUserRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	userName := d.Id()
 	args := ram.UserQueryRequest{
@@ -160,7 +158,7 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 		// list and delete access keys for this user
 		akResp, err := conn.ListAccessKeys(args)
 		if err != nil {
-			return fmt.Errorf("Error listing access keys for User (%s) when trying to delete: %#v", d.Id(), err)
+			return WrapErrorf(err, "Error listing access keys for User (%s) when trying to delete", d.Id())
 		}
 		if len(akResp.AccessKeys.AccessKey) > 0 {
 			for _, v := range akResp.AccessKeys.AccessKey {
@@ -169,7 +167,7 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 					UserName:        userName,
 				})
 				if err != nil && !RamEntityNotExist(err) {
-					return fmt.Errorf("Error deleting access key %s: %#v", v.AccessKeyId, err)
+					return WrapErrorf(err, "Error deleting access key %s", v.AccessKeyId)
 				}
 			}
 		}
@@ -177,7 +175,7 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 		// list and delete policies for this user
 		policyResp, err := conn.ListPoliciesForUser(args)
 		if err != nil {
-			return fmt.Errorf("Error listing policies for User (%s) when trying to delete: %#v", d.Id(), err)
+			return WrapErrorf(err, "Error listing policies for User (%s) when trying to delete", d.Id())
 		}
 		if len(policyResp.Policies.Policy) > 0 {
 			for _, v := range policyResp.Policies.Policy {
@@ -189,7 +187,7 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 					UserName: userName,
 				})
 				if err != nil && !RamEntityNotExist(err) {
-					return fmt.Errorf("Error deleting policy %s: %#v", v.PolicyName, err)
+					return WrapErrorf(err, "Error deleting policy %s", v.PolicyName)
 				}
 			}
 		}
@@ -197,7 +195,7 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 		// list and delete groups for this user
 		groupResp, err := conn.ListGroupsForUser(args)
 		if err != nil {
-			return fmt.Errorf("Error listing groups for User (%s) when trying to delete: %#v", d.Id(), err)
+			return WrapErrorf(err, "Error listing groups for User (%s) when trying to delete", d.Id())
 		}
 		if len(groupResp.Groups.Group) > 0 {
 			for _, v := range groupResp.Groups.Group {
@@ -206,32 +204,29 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 					GroupName: v.GroupName,
 				})
 				if err != nil && !RamEntityNotExist(err) {
-					return fmt.Errorf("Error deleting group %s: %#v", v.GroupName, err)
+					return WrapErrorf(err, "Error deleting group %s", v.GroupName)
 				}
 			}
 		}
 
 		// delete login profile for this user
 		if _, err = conn.DeleteLoginProfile(args); err != nil && !RamEntityNotExist(err) {
-			return fmt.Errorf("Error deleting login profile for User (%s): %#v", d.Id(), err)
+			return WrapErrorf(err, "Error deleting login profile for User (%s)", d.Id())
 		}
 
 		// unbind MFA device for this user
 		if _, err = conn.UnbindMFADevice(args); err != nil && !RamEntityNotExist(err) {
-			return fmt.Errorf("Error deleting login profile for User (%s): %#v", d.Id(), err)
+			return WrapErrorf(err, "Error deleting login profile for User (%s)", d.Id())
 		}
 
 	}
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := conn.DeleteUser(args); err != nil {
-			if IsExceptedError(err, DeleteConflictUserAccessKey) || IsExceptedError(err, DeleteConflictUserGroup) ||
-				IsExceptedError(err, DeleteConflictUserPolicy) || IsExceptedError(err, DeleteConflictUserLoginProfile) ||
-				IsExceptedError(err, DeleteConflictUserMFADevice) {
-				return resource.RetryableError(fmt.Errorf("The user can not has any access keys or login profile or attached group or attached policies or attached mfa device while deleting the user.- you can set force with true to force delete the user."))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting user %s: %#v, you can set force with true to force delete the user.", d.Id(), err))
-		}
-		return nil
+	err := RetryOnError(RamCode, 5*time.Minute, func() error {
+		_, err := conn.DeleteUser(args)
+		return err
 	})
+	if err != nil {
+		return WrapErrorf(err, "Error deleting user %s, you can set force with true to force delete the user", d.Id())
+	}
+	return nil
 }
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
@@ -14,6 +15,9 @@ func resourceAlicloudRamUserPolicyAtatchment() *schema.Resource {
 		Create: resourceAlicloudRamUserPolicyAttachmentCreate,
 		Read:   resourceAlicloudRamUserPolicyAttachmentRead,
 		Delete: resourceAlicloudRamUserPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamUserPolicyAttachmentImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_name": &schema.Schema{
@@ -39,7 +43,7 @@ func resourceAlicloudRamUserPolicyAtatchment() *schema.Resource {
 }
 
 func resourceAlicloudRamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.AttachPolicyRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -50,7 +54,7 @@ func resourceAlicloudRamUserPolicyAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	if _, err := conn.AttachPolicyToUser(args); err != nil {
-		return fmt.Errorf("AttachPolicyToUser got an error: %#v", err)
+		return WrapErrorf(err, "AttachPolicyToUser got an error")
 	}
 
 	d.SetId("user" + args.PolicyName + string(args.PolicyType) + args.UserName)
@@ -58,7 +62,7 @@ func resourceAlicloudRamUserPolicyAttachmentCreate(d *schema.ResourceData, meta
 }
 
 func resourceAlicloudRamUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.UserQueryRequest{
 		UserName: d.Get("user_name").(string),
@@ -66,7 +70,7 @@ func resourceAlicloudRamUserPolicyAttachmentRead(d *schema.ResourceData, meta in
 
 	response, err := conn.ListPoliciesForUser(args)
 	if err != nil {
-		return fmt.Errorf("Get list policies for user got an error: %#v", err)
+		return WrapErrorf(err, "Get list policies for user got an error")
 	}
 
 	if len(response.Policies.Policy) > 0 {
@@ -85,7 +89,7 @@ func resourceAlicloudRamUserPolicyAttachmentRead(d *schema.ResourceData, meta in
 }
 
 func resourceAlicloudRamUserPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ramconn
+	conn := meta.(*AliyunClient).ramconn()
 
 	args := ram.AttachPolicyRequest{
 		PolicyRequest: ram.PolicyRequest{
@@ -100,7 +104,7 @@ func resourceAlicloudRamUserPolicyAttachmentDelete(d *schema.ResourceData, meta
 			if RamEntityNotExist(err) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting user policy attachment: %#v", err))
+			return resource.NonRetryableError(WrapErrorf(err, "Error deleting user policy attachment"))
 		}
 
 		response, err := conn.ListPoliciesForUser(ram.UserQueryRequest{UserName: args.UserName})
@@ -118,3 +122,16 @@ func resourceAlicloudRamUserPolicyAttachmentDelete(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("Error deleting user policy attachment - trying again while it is deleted."))
 	})
 }
+
+// resourceAlicloudRamUserPolicyAttachmentImportState imports an attachment by <user_name>:<policy_name>:<policy_type>.
+func resourceAlicloudRamUserPolicyAttachmentImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid ram user policy attachment import id %s. Expected format is <user_name>:<policy_name>:<policy_type>.", d.Id())
+	}
+	d.Set("user_name", parts[0])
+	d.Set("policy_name", parts[1])
+	d.Set("policy_type", parts[2])
+	d.SetId("user" + parts[1] + parts[2] + parts[0])
+	return []*schema.ResourceData{d}, nil
+}
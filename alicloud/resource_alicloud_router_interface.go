@@ -7,7 +7,6 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -17,6 +16,9 @@ func resourceAlicloudRouterInterface() *schema.Resource {
 		Read:   resourceAlicloudRouterInterfaceRead,
 		Update: resourceAlicloudRouterInterfaceUpdate,
 		Delete: resourceAlicloudRouterInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"opposite_region": &schema.Schema{
@@ -107,22 +109,24 @@ func resourceAlicloudRouterInterfaceCreate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	response, err := client.vpcconn.CreateRouterInterface(args)
+	response := vpc.CreateCreateRouterInterfaceResponse()
+	err = client.doAction(client.vpcconn, args, response)
 	if err != nil {
-		return fmt.Errorf("CreateRouterInterface got an error: %#v", err)
+		return WrapApiError(err, args.GetActionName(), "")
 	}
 
 	d.SetId(response.RouterInterfaceId)
 
 	if err := client.WaitForRouterInterface(d.Id(), Idle, 300); err != nil {
-		return fmt.Errorf("WaitForRouterInterface %s got error: %#v", Idle, err)
+		return WrapApiError(WrapErrorf(err, "WaitForRouterInterface %s got an error", Idle), "", d.Id())
 	}
 
 	return resourceAlicloudRouterInterfaceUpdate(d, meta)
 }
 
 func resourceAlicloudRouterInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
 
 	d.Partial(true)
 
@@ -132,8 +136,8 @@ func resourceAlicloudRouterInterfaceUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if attributeUpdate {
-		if _, err := conn.ModifyRouterInterfaceAttribute(args); err != nil {
-			return fmt.Errorf("ModifyRouterInterfaceAttribute got an error: %#v", err)
+		if err := client.doAction(conn, args, vpc.CreateModifyRouterInterfaceAttributeResponse()); err != nil {
+			return WrapApiError(err, args.GetActionName(), d.Id())
 		}
 	}
 
@@ -143,8 +147,8 @@ func resourceAlicloudRouterInterfaceUpdate(d *schema.ResourceData, meta interfac
 		request.RegionId = string(getRegion(d, meta))
 		request.RouterInterfaceId = d.Id()
 		request.Spec = d.Get("specification").(string)
-		if _, err := conn.ModifyRouterInterfaceSpec(request); err != nil {
-			return fmt.Errorf("ModifyRouterInterfaceSpec got an error: %#v", err)
+		if err := client.doAction(conn, request, vpc.CreateModifyRouterInterfaceSpecResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 	}
 
@@ -160,6 +164,7 @@ func resourceAlicloudRouterInterfaceRead(d *schema.ResourceData, meta interface{
 			d.SetId("")
 			return nil
 		}
+		return WrapApiError(err, "DescribeRouterInterfaces", d.Id())
 	}
 
 	d.Set("role", ri.Role)
@@ -182,22 +187,19 @@ func resourceAlicloudRouterInterfaceRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAlicloudRouterInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
 
 	args := vpc.CreateDeleteRouterInterfaceRequest()
 	args.RegionId = string(getRegion(d, meta))
 	args.RouterInterfaceId = d.Id()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := conn.DeleteRouterInterface(args); err != nil {
-			if IsExceptedError(err, RouterInterfaceIncorrectStatus) || IsExceptedError(err, DependencyViolationRouterInterfaceReferedByRouteEntry) {
-				time.Sleep(5 * time.Second)
-				return resource.RetryableError(fmt.Errorf("Delete router interface timeout and got an error: %#v.", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting interface %s: %#v", d.Id(), err))
-		}
-		return nil
-	})
+	if err := RetryOnError(VpcCode, 5*time.Minute, func() error {
+		return client.doAction(conn, args, vpc.CreateDeleteRouterInterfaceResponse())
+	}); err != nil {
+		return WrapApiError(err, args.GetActionName(), d.Id())
+	}
+	return nil
 }
 
 func buildAlicloudRouterInterfaceCreateArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateRouterInterfaceRequest, error) {
@@ -216,6 +218,7 @@ func buildAlicloudRouterInterfaceCreateArgs(d *schema.ResourceData, meta interfa
 	request.Spec = d.Get("specification").(string)
 	request.OppositeRegionId = string(oppositeRegion)
 	request.OppositeRouterType = d.Get("opposite_router_type").(string)
+	request.ClientToken = buildClientToken("TF-CreateRouterInterface")
 
 	if request.RouterType == string(VBR) {
 		if request.Role != string(InitiatingSide) {
@@ -0,0 +1,649 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSaeApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSaeApplicationCreate,
+		Read:   resourceAlicloudSaeApplicationRead,
+		Update: resourceAlicloudSaeApplicationUpdate,
+		Delete: resourceAlicloudSaeApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"app_description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"namespace_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{SaePackageImage, SaePackageFatJar, SaePackageWar}),
+			},
+			"image_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"package_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"package_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"jdk": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"replicas": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(0, 50),
+			},
+			"cpu": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedIntValue([]int{500, 1000, 2000, 4000, 8000, 16000, 32000}),
+			},
+			"memory": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedIntValue([]int{1024, 2048, 4096, 8192, 12288, 16384, 24576, 32768, 65536, 131072}),
+			},
+			"vswitch_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				ForceNew: true,
+			},
+			"envs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"internet": saeSlbPortsSchema(),
+			"intranet": saeSlbPortsSchema(),
+			"scaling_rules": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{SaeScalingRuleTiming, SaeScalingRuleMetric}),
+						},
+						"period": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"begin_date": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"end_date": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"schedules": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"at_time": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"target_replicas": &schema.Schema{
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+						"min_replicas": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"max_replicas": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"metrics": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_type": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAllowedStringValue([]string{"CPU", "MEMORY", "tcpActiveConn", "QPS", "RT"}),
+									},
+									"target_average_utilization": &schema.Schema{
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"internet_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"intranet_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func saeSlbPortsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"port": &schema.Schema{
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validateIntegerInRange(1, 65535),
+				},
+				"target_port": &schema.Schema{
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validateIntegerInRange(1, 65535),
+				},
+				"protocol": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "TCP",
+					ValidateFunc: validateAllowedStringValue([]string{"TCP", "HTTP", "HTTPS"}),
+				},
+			},
+		},
+	}
+}
+
+// expandSaeEnvs converts the envs into the JSON list expected by the API, ordered by the names.
+func expandSaeEnvs(m map[string]interface{}) (string, error) {
+	envs := make([]SaeEnv, 0, len(m))
+	for k, v := range m {
+		envs = append(envs, SaeEnv{Name: k, Value: v.(string)})
+	}
+	sort.Slice(envs, func(i, j int) bool { return envs[i].Name < envs[j].Name })
+	bs, err := json.Marshal(envs)
+	if err != nil {
+		return "", WrapError(err)
+	}
+	return string(bs), nil
+}
+
+func flattenSaeEnvs(s string) (map[string]string, error) {
+	m := make(map[string]string)
+	if s == "" {
+		return m, nil
+	}
+	var envs []SaeEnv
+	if err := json.Unmarshal([]byte(s), &envs); err != nil {
+		return nil, WrapError(err)
+	}
+	for _, env := range envs {
+		m[env.Name] = env.Value
+	}
+	return m, nil
+}
+
+func expandSaeSlbPorts(list []interface{}) []SaeSlbPort {
+	ports := make([]SaeSlbPort, 0, len(list))
+	for _, p := range list {
+		port := p.(map[string]interface{})
+		ports = append(ports, SaeSlbPort{
+			Port:       port["port"].(int),
+			TargetPort: port["target_port"].(int),
+			Protocol:   port["protocol"].(string),
+		})
+	}
+	return ports
+}
+
+func flattenSaeSlbPorts(ports []SaeSlbPort) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, port := range ports {
+		result = append(result, map[string]interface{}{
+			"port":        port.Port,
+			"target_port": port.TargetPort,
+			"protocol":    port.Protocol,
+		})
+	}
+	return result
+}
+
+func expandSaeScalingRules(list []interface{}) []SaeScalingRule {
+	rules := make([]SaeScalingRule, 0, len(list))
+	for _, r := range list {
+		rule := r.(map[string]interface{})
+		scalingRule := SaeScalingRule{
+			ScaleRuleName: rule["name"].(string),
+			ScaleRuleType: rule["type"].(string),
+		}
+		if scalingRule.ScaleRuleType == SaeScalingRuleTiming {
+			timer := &SaeScalingTimer{
+				Period:    rule["period"].(string),
+				BeginDate: rule["begin_date"].(string),
+				EndDate:   rule["end_date"].(string),
+				Schedules: make([]SaeScalingSchedule, 0),
+			}
+			for _, s := range rule["schedules"].([]interface{}) {
+				schedule := s.(map[string]interface{})
+				timer.Schedules = append(timer.Schedules, SaeScalingSchedule{
+					AtTime:         schedule["at_time"].(string),
+					TargetReplicas: schedule["target_replicas"].(int),
+				})
+			}
+			scalingRule.Timer = timer
+		} else {
+			metric := &SaeScalingMetric{
+				MinReplicas: rule["min_replicas"].(int),
+				MaxReplicas: rule["max_replicas"].(int),
+				Metrics:     make([]SaeScalingMetricTarget, 0),
+			}
+			for _, m := range rule["metrics"].([]interface{}) {
+				target := m.(map[string]interface{})
+				metric.Metrics = append(metric.Metrics, SaeScalingMetricTarget{
+					MetricType:                     target["metric_type"].(string),
+					MetricTargetAverageUtilization: target["target_average_utilization"].(int),
+				})
+			}
+			scalingRule.Metric = metric
+		}
+		rules = append(rules, scalingRule)
+	}
+	return rules
+}
+
+func flattenSaeScalingRules(rules []SaeScalingRule) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, rule := range rules {
+		m := map[string]interface{}{
+			"name": rule.ScaleRuleName,
+			"type": rule.ScaleRuleType,
+		}
+		if rule.Timer != nil {
+			var schedules []map[string]interface{}
+			for _, schedule := range rule.Timer.Schedules {
+				schedules = append(schedules, map[string]interface{}{
+					"at_time":         schedule.AtTime,
+					"target_replicas": schedule.TargetReplicas,
+				})
+			}
+			m["period"] = rule.Timer.Period
+			m["begin_date"] = rule.Timer.BeginDate
+			m["end_date"] = rule.Timer.EndDate
+			m["schedules"] = schedules
+		}
+		if rule.Metric != nil {
+			var metrics []map[string]interface{}
+			for _, target := range rule.Metric.Metrics {
+				metrics = append(metrics, map[string]interface{}{
+					"metric_type":                target.MetricType,
+					"target_average_utilization": target.MetricTargetAverageUtilization,
+				})
+			}
+			m["min_replicas"] = rule.Metric.MinReplicas
+			m["max_replicas"] = rule.Metric.MaxReplicas
+			m["metrics"] = metrics
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func buildSaeScalingRuleParams(appId string, rule SaeScalingRule) (map[string]string, error) {
+	params := map[string]string{
+		"AppId":           appId,
+		"ScalingRuleName": rule.ScaleRuleName,
+		"ScalingRuleType": rule.ScaleRuleType,
+	}
+	if rule.Timer != nil {
+		bs, err := json.Marshal(rule.Timer)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		params["ScalingRuleTimer"] = string(bs)
+	}
+	if rule.Metric != nil {
+		bs, err := json.Marshal(rule.Metric)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		params["ScalingRuleMetric"] = string(bs)
+	}
+	return params, nil
+}
+
+// buildSaeDeployParams returns the package and the envs, which are deployed as a whole.
+func buildSaeDeployParams(d *schema.ResourceData) (map[string]string, error) {
+	packageType := d.Get("package_type").(string)
+	params := make(map[string]string)
+	if packageType == SaePackageImage {
+		v, ok := d.GetOk("image_url")
+		if !ok {
+			return nil, fmt.Errorf("'image_url' is required when 'package_type' is %s.", SaePackageImage)
+		}
+		params["ImageUrl"] = v.(string)
+	} else {
+		v, ok := d.GetOk("package_url")
+		if !ok {
+			return nil, fmt.Errorf("'package_url' is required when 'package_type' is %s.", packageType)
+		}
+		params["PackageUrl"] = v.(string)
+	}
+	for key, param := range map[string]string{
+		"package_version": "PackageVersion",
+		"jdk":             "Jdk",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			params[param] = v.(string)
+		}
+	}
+	envs, err := expandSaeEnvs(d.Get("envs").(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	params["Envs"] = envs
+	return params, nil
+}
+
+func resourceAlicloudSaeApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params, err := buildSaeDeployParams(d)
+	if err != nil {
+		return err
+	}
+	vswitchIds := expandStringList(d.Get("vswitch_ids").(*schema.Set).List())
+	vsw, err := client.DescribeVswitch(vswitchIds[0])
+	if err != nil {
+		return WrapErrorf(err, "DescribeVSwitchAttributes got an error")
+	}
+	params["AppName"] = d.Get("app_name").(string)
+	params["NamespaceId"] = d.Get("namespace_id").(string)
+	params["PackageType"] = d.Get("package_type").(string)
+	params["Replicas"] = strconv.Itoa(d.Get("replicas").(int))
+	params["Cpu"] = strconv.Itoa(d.Get("cpu").(int))
+	params["Memory"] = strconv.Itoa(d.Get("memory").(int))
+	params["VpcId"] = vsw.VpcId
+	params["VSwitchId"] = strings.Join(vswitchIds, COMMA_SEPARATED)
+	if v, ok := d.GetOk("app_description"); ok {
+		params["AppDescription"] = v.(string)
+	}
+
+	var resp struct {
+		Data struct {
+			AppId string `json:"AppId"`
+		} `json:"Data"`
+	}
+	if err := RetryOnError(SaeCode, 3*time.Minute, func() error {
+		return client.ProcessSaeRequest(http.MethodPost, "/pop/v1/sam/app/createApplication", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateApplication got an error")
+	}
+	d.SetId(resp.Data.AppId)
+
+	if err := client.WaitForSaeApplication(d.Id(), timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "WaitForSaeApplication %s got an error", d.Id())
+	}
+
+	return resourceAlicloudSaeApplicationUpdate(d, meta)
+}
+
+func resourceAlicloudSaeApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	app, err := client.DescribeSaeApplication(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("app_name", app.AppName)
+	d.Set("app_description", app.AppDescription)
+	d.Set("namespace_id", app.NamespaceId)
+	d.Set("package_type", app.PackageType)
+	d.Set("image_url", app.ImageUrl)
+	d.Set("package_url", app.PackageUrl)
+	d.Set("package_version", app.PackageVersion)
+	d.Set("jdk", app.Jdk)
+	d.Set("replicas", app.Replicas)
+	d.Set("cpu", app.Cpu)
+	d.Set("memory", app.Memory)
+	d.Set("vpc_id", app.VpcId)
+	d.Set("vswitch_ids", strings.Split(app.VSwitchId, COMMA_SEPARATED))
+	envs, err := flattenSaeEnvs(app.Envs)
+	if err != nil {
+		return err
+	}
+	d.Set("envs", envs)
+
+	status, err := client.DescribeSaeApplicationStatus(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("status", status.CurrentStatus)
+
+	slb, err := client.DescribeSaeApplicationSlb(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := d.Set("internet", flattenSaeSlbPorts(slb.Internet)); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("intranet", flattenSaeSlbPorts(slb.Intranet)); err != nil {
+		return WrapError(err)
+	}
+	d.Set("internet_ip", slb.InternetIp)
+	d.Set("intranet_ip", slb.IntranetIp)
+
+	rules, err := client.DescribeSaeScalingRules(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := d.Set("scaling_rules", flattenSaeScalingRules(rules)); err != nil {
+		return WrapError(err)
+	}
+
+	return nil
+}
+
+func resourceAlicloudSaeApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	// Each change of the application is a change order, which should end before the next one starts.
+	invoke := func(method, path string, params map[string]string, wait bool) error {
+		params["AppId"] = d.Id()
+		if err := RetryOnError(SaeCode, 3*time.Minute, func() error {
+			return client.ProcessSaeRequest(method, path, params, nil)
+		}); err != nil {
+			return WrapErrorf(err, "%s %s got an error", method, path)
+		}
+		if !wait {
+			return nil
+		}
+		if err := client.WaitForSaeApplication(d.Id(), timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, "WaitForSaeApplication %s got an error", d.Id())
+		}
+		return nil
+	}
+
+	if !d.IsNewResource() && (d.HasChange("image_url") || d.HasChange("package_url") || d.HasChange("package_version") ||
+		d.HasChange("jdk") || d.HasChange("envs")) {
+		params, err := buildSaeDeployParams(d)
+		if err != nil {
+			return err
+		}
+		if err := invoke(http.MethodPost, "/pop/v1/sam/app/deployApplication", params, true); err != nil {
+			return err
+		}
+		d.SetPartial("image_url")
+		d.SetPartial("package_url")
+		d.SetPartial("package_version")
+		d.SetPartial("jdk")
+		d.SetPartial("envs")
+	}
+
+	if !d.IsNewResource() && d.HasChange("replicas") {
+		if err := invoke(http.MethodPut, "/pop/v1/sam/app/rescaleApplication", map[string]string{
+			"Replicas": strconv.Itoa(d.Get("replicas").(int)),
+		}, true); err != nil {
+			return err
+		}
+		d.SetPartial("replicas")
+	}
+
+	if d.HasChange("internet") || d.HasChange("intranet") {
+		params := make(map[string]string)
+		unbind := make(map[string]string)
+		for key, param := range map[string]string{"internet": "Internet", "intranet": "Intranet"} {
+			o, n := d.GetChange(key)
+			ports := expandSaeSlbPorts(n.(*schema.Set).List())
+			if len(ports) > 0 {
+				bs, err := json.Marshal(ports)
+				if err != nil {
+					return WrapError(err)
+				}
+				params[param] = string(bs)
+			} else if o.(*schema.Set).Len() > 0 {
+				unbind[param] = "true"
+			}
+		}
+		if len(unbind) > 0 {
+			if err := invoke(http.MethodDelete, "/pop/v1/sam/app/slb", unbind, true); err != nil {
+				return err
+			}
+		}
+		if len(params) > 0 {
+			if err := invoke(http.MethodPost, "/pop/v1/sam/app/slb", params, true); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("internet")
+		d.SetPartial("intranet")
+	}
+
+	if d.HasChange("scaling_rules") {
+		o, n := d.GetChange("scaling_rules")
+		existing := make(map[string]SaeScalingRule)
+		for _, rule := range expandSaeScalingRules(o.(*schema.Set).List()) {
+			existing[rule.ScaleRuleName] = rule
+		}
+		news := expandSaeScalingRules(n.(*schema.Set).List())
+		names := make(map[string]bool)
+		for _, rule := range news {
+			names[rule.ScaleRuleName] = true
+		}
+		// The rules are identified by their names, so the ones which are no longer present are deleted first.
+		for name := range existing {
+			if names[name] {
+				continue
+			}
+			if err := invoke(http.MethodDelete, "/pop/v1/sam/scale/applicationScalingRule", map[string]string{
+				"ScalingRuleName": name,
+			}, false); err != nil {
+				return err
+			}
+		}
+		for _, rule := range news {
+			method := http.MethodPost
+			if old, ok := existing[rule.ScaleRuleName]; ok {
+				if reflect.DeepEqual(old, rule) {
+					continue
+				}
+				method = http.MethodPut
+			}
+			params, err := buildSaeScalingRuleParams(d.Id(), rule)
+			if err != nil {
+				return err
+			}
+			if err := invoke(method, "/pop/v1/sam/scale/applicationScalingRule", params, false); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("scaling_rules")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudSaeApplicationRead(d, meta)
+}
+
+func resourceAlicloudSaeApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(SaeCode, 3*time.Minute, func() error {
+		return client.ProcessSaeRequest(http.MethodDelete, "/pop/v1/sam/app/deleteApplication", map[string]string{
+			"AppId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, SaeAppNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteApplication got an error")
+	}
+
+	return client.WaitForSaeApplicationDeleted(d.Id(), timeoutSeconds(d, schema.TimeoutDelete))
+}
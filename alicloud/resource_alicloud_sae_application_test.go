@@ -0,0 +1,194 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSaeApplication_basic(t *testing.T) {
+	var app SaeApplication
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sae_application.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSaeApplicationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSaeApplicationConfig(1, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSaeApplicationExists("alicloud_sae_application.foo", &app),
+					resource.TestCheckResourceAttr("alicloud_sae_application.foo", "app_name", "tf-testacc-sae-app"),
+					resource.TestCheckResourceAttr("alicloud_sae_application.foo", "package_type", "Image"),
+					resource.TestCheckResourceAttr("alicloud_sae_application.foo", "replicas", "1"),
+					resource.TestCheckResourceAttr("alicloud_sae_application.foo", "envs.%", "1"),
+					resource.TestCheckResourceAttr("alicloud_sae_application.foo", "envs.VERSION", "v1"),
+					resource.TestCheckResourceAttr("alicloud_sae_application.foo", "intranet.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_sae_application.foo", "scaling_rules.#", "1"),
+					resource.TestCheckResourceAttrSet("alicloud_sae_application.foo", "intranet_ip"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSaeApplicationConfig(2, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSaeApplicationExists("alicloud_sae_application.foo", &app),
+					resource.TestCheckResourceAttr("alicloud_sae_application.foo", "replicas", "2"),
+					resource.TestCheckResourceAttr("alicloud_sae_application.foo", "envs.VERSION", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestSaeEnvs(t *testing.T) {
+	envs, err := expandSaeEnvs(map[string]interface{}{"B": "2", "A": "1"})
+	if err != nil {
+		t.Fatalf("Expanding the envs got an error: %#v", err)
+	}
+	if envs != `[{"name":"A","value":"1"},{"name":"B","value":"2"}]` {
+		t.Fatalf("Expected the envs are ordered by the names, got %s", envs)
+	}
+	m, err := flattenSaeEnvs(envs)
+	if err != nil {
+		t.Fatalf("Flattening the envs got an error: %#v", err)
+	}
+	if !reflect.DeepEqual(m, map[string]string{"A": "1", "B": "2"}) {
+		t.Fatalf("Expected the envs are flattened, got %#v", m)
+	}
+}
+
+func TestDescribeSaeApplication(t *testing.T) {
+	client, server := newTestAliyunClient(t, SaeCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/pop/v1/sam/app/describeApplicationConfig" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		switch r.URL.Query().Get("AppId") {
+		case "app-abc":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","Success":true,"Code":"200","Data":{"AppId":"app-abc",` +
+				`"AppName":"tf-testAcc","NamespaceId":"cn-hangzhou:test","PackageType":"Image","Replicas":2,"Cpu":500,"Memory":1024}}`))
+		default:
+			// SAE responds the http status as the Code, and the failure is identified by the ErrorCode.
+			w.Write([]byte(`{"RequestId":"E5F6G7H8","Success":false,"Code":"404","ErrorCode":"InvalidAppId.NotFound","Message":"The app does not exist."}`))
+		}
+	})
+	defer server.Close()
+
+	app, err := client.DescribeSaeApplication("app-abc")
+	if err != nil {
+		t.Fatalf("Describing the application got an error: %#v", err)
+	}
+	if app.AppName != "tf-testAcc" || app.NamespaceId != "cn-hangzhou:test" || app.Replicas != 2 || app.Memory != 1024 {
+		t.Fatalf("Expected the application is decoded from the Data of the response, got %#v", app)
+	}
+
+	if _, err := client.DescribeSaeApplication("app-unknown"); !NotFoundError(err) {
+		t.Fatalf("Expected the ErrorCode %s is a not found error, got %#v", SaeAppNotFound, err)
+	}
+}
+
+func testAccCheckSaeApplicationExists(n string, app *SaeApplication) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAE Application ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		a, err := client.DescribeSaeApplication(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*app = a
+
+		return nil
+	}
+}
+
+func testAccCheckSaeApplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_sae_application" {
+			continue
+		}
+
+		if _, err := client.DescribeSaeApplication(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SAE Application %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSaeApplicationConfig(replicas int, version string) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccSaeApplication"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/24"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_sae_namespace" "foo" {
+  namespace_id   = "cn-hangzhou:tfacctest"
+  namespace_name = "tf-testAccSaeApplication"
+}
+
+resource "alicloud_sae_application" "foo" {
+  app_name     = "tf-testacc-sae-app"
+  namespace_id = "${alicloud_sae_namespace.foo.id}"
+  package_type = "Image"
+  image_url    = "registry.cn-hangzhou.aliyuncs.com/google_containers/nginx-slim:0.9"
+  replicas     = %d
+  cpu          = 500
+  memory       = 1024
+  vswitch_ids  = ["${alicloud_vswitch.foo.id}"]
+  envs = {
+    VERSION = "%s"
+  }
+
+  intranet {
+    port        = 80
+    target_port = 80
+  }
+
+  scaling_rules {
+    name   = "daytime"
+    type   = "timing"
+    period = "* * *"
+    schedules {
+      at_time         = "08:00"
+      target_replicas = 2
+    }
+    schedules {
+      at_time         = "20:00"
+      target_replicas = 1
+    }
+  }
+}
+`, replicas, version)
+}
@@ -0,0 +1,145 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSaeConfigMap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSaeConfigMapCreate,
+		Read:   resourceAlicloudSaeConfigMapRead,
+		Update: resourceAlicloudSaeConfigMapUpdate,
+		Delete: resourceAlicloudSaeConfigMapDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"namespace_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data": &schema.Schema{
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildSaeConfigMapData(d *schema.ResourceData) (string, error) {
+	data := make(map[string]string)
+	for k, v := range d.Get("data").(map[string]interface{}) {
+		data[k] = v.(string)
+	}
+	bs, err := json.Marshal(data)
+	if err != nil {
+		return "", WrapError(err)
+	}
+	return string(bs), nil
+}
+
+func resourceAlicloudSaeConfigMapCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	data, err := buildSaeConfigMapData(d)
+	if err != nil {
+		return err
+	}
+	params := map[string]string{
+		"NamespaceId": d.Get("namespace_id").(string),
+		"Name":        d.Get("name").(string),
+		"Data":        data,
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params["Description"] = v.(string)
+	}
+
+	var resp struct {
+		Data struct {
+			ConfigMapId int64 `json:"ConfigMapId"`
+		} `json:"Data"`
+	}
+	if err := RetryOnError(SaeCode, 3*time.Minute, func() error {
+		return client.ProcessSaeRequest(http.MethodPost, "/pop/v1/sam/configmap/configMap", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateConfigMap got an error")
+	}
+	d.SetId(strconv.FormatInt(resp.Data.ConfigMapId, 10))
+
+	return resourceAlicloudSaeConfigMapRead(d, meta)
+}
+
+func resourceAlicloudSaeConfigMapRead(d *schema.ResourceData, meta interface{}) error {
+	configMap, err := meta.(*AliyunClient).DescribeSaeConfigMap(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("namespace_id", configMap.NamespaceId)
+	d.Set("name", configMap.Name)
+	d.Set("data", configMap.Data)
+	d.Set("description", configMap.Description)
+
+	return nil
+}
+
+// The applications which mount the config map should be redeployed to load the changes.
+func resourceAlicloudSaeConfigMapUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("data") || d.HasChange("description") {
+		data, err := buildSaeConfigMapData(d)
+		if err != nil {
+			return err
+		}
+		if err := RetryOnError(SaeCode, 3*time.Minute, func() error {
+			return client.ProcessSaeRequest(http.MethodPut, "/pop/v1/sam/configmap/configMap", map[string]string{
+				"ConfigMapId": d.Id(),
+				"Data":        data,
+				"Description": d.Get("description").(string),
+			}, nil)
+		}); err != nil {
+			return WrapErrorf(err, "UpdateConfigMap got an error")
+		}
+	}
+
+	return resourceAlicloudSaeConfigMapRead(d, meta)
+}
+
+func resourceAlicloudSaeConfigMapDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(SaeCode, 3*time.Minute, func() error {
+		return client.ProcessSaeRequest(http.MethodDelete, "/pop/v1/sam/configmap/configMap", map[string]string{
+			"ConfigMapId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, SaeConfigMapNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteConfigMap got an error")
+	}
+
+	return nil
+}
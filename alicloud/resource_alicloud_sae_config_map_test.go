@@ -0,0 +1,132 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSaeConfigMap_basic(t *testing.T) {
+	var configMap SaeConfigMap
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_sae_config_map.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSaeConfigMapDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSaeConfigMapConfig("info"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSaeConfigMapExists("alicloud_sae_config_map.foo", &configMap),
+					resource.TestCheckResourceAttr("alicloud_sae_namespace.foo", "namespace_name", "tf-testAccSaeConfigMap"),
+					resource.TestCheckResourceAttr("alicloud_sae_config_map.foo", "name", "tf-testacc-config"),
+					resource.TestCheckResourceAttr("alicloud_sae_config_map.foo", "data.%", "1"),
+					resource.TestCheckResourceAttr("alicloud_sae_config_map.foo", "data.log_level", "info"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSaeConfigMapConfig("debug"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSaeConfigMapExists("alicloud_sae_config_map.foo", &configMap),
+					resource.TestCheckResourceAttr("alicloud_sae_config_map.foo", "data.log_level", "debug"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudSaeConfigMap_import(t *testing.T) {
+	resourceName := "alicloud_sae_config_map.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSaeConfigMapDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSaeConfigMapConfig("info"),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSaeConfigMapExists(n string, configMap *SaeConfigMap) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SAE Config Map ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		c, err := client.DescribeSaeConfigMap(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*configMap = c
+
+		return nil
+	}
+}
+
+func testAccCheckSaeConfigMapDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "alicloud_sae_config_map":
+			if _, err := client.DescribeSaeConfigMap(rs.Primary.ID); err != nil {
+				if NotFoundError(err) {
+					continue
+				}
+				return err
+			}
+			return fmt.Errorf("SAE Config Map %s still exist", rs.Primary.ID)
+		case "alicloud_sae_namespace":
+			if _, err := client.DescribeSaeNamespace(rs.Primary.ID); err != nil {
+				if NotFoundError(err) {
+					continue
+				}
+				return err
+			}
+			return fmt.Errorf("SAE Namespace %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccSaeConfigMapConfig(level string) string {
+	return fmt.Sprintf(`
+resource "alicloud_sae_namespace" "foo" {
+  namespace_id          = "cn-hangzhou:tfacccm"
+  namespace_name        = "tf-testAccSaeConfigMap"
+  namespace_description = "Terraform acc test"
+}
+
+resource "alicloud_sae_config_map" "foo" {
+  namespace_id = "${alicloud_sae_namespace.foo.id}"
+  name         = "tf-testacc-config"
+  description  = "Terraform acc test"
+  data = {
+    log_level = "%s"
+  }
+}
+`, level)
+}
@@ -0,0 +1,113 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSaeNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSaeNamespaceCreate,
+		Read:   resourceAlicloudSaeNamespaceRead,
+		Update: resourceAlicloudSaeNamespaceUpdate,
+		Delete: resourceAlicloudSaeNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"namespace_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"namespace_description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildSaeNamespaceParams(d *schema.ResourceData) map[string]string {
+	return map[string]string{
+		"NamespaceId":          d.Get("namespace_id").(string),
+		"NamespaceName":        d.Get("namespace_name").(string),
+		"NamespaceDescription": d.Get("namespace_description").(string),
+	}
+}
+
+func resourceAlicloudSaeNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	id := d.Get("namespace_id").(string)
+	if !strings.HasPrefix(id, string(client.Region)+":") {
+		return fmt.Errorf("The namespace id %s should be prefixed with the region, like %s:test.", id, client.Region)
+	}
+
+	if err := RetryOnError(SaeCode, 3*time.Minute, func() error {
+		return client.ProcessSaeRequest(http.MethodPost, "/pop/v1/paas/namespace", buildSaeNamespaceParams(d), nil)
+	}); err != nil {
+		return WrapErrorf(err, "CreateNamespace got an error")
+	}
+	d.SetId(id)
+
+	return resourceAlicloudSaeNamespaceRead(d, meta)
+}
+
+func resourceAlicloudSaeNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	namespace, err := meta.(*AliyunClient).DescribeSaeNamespace(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("namespace_id", namespace.NamespaceId)
+	d.Set("namespace_name", namespace.NamespaceName)
+	d.Set("namespace_description", namespace.NamespaceDescription)
+
+	return nil
+}
+
+func resourceAlicloudSaeNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("namespace_name") || d.HasChange("namespace_description") {
+		if err := RetryOnError(SaeCode, 3*time.Minute, func() error {
+			return client.ProcessSaeRequest(http.MethodPut, "/pop/v1/paas/namespace", buildSaeNamespaceParams(d), nil)
+		}); err != nil {
+			return WrapErrorf(err, "UpdateNamespace got an error")
+		}
+	}
+
+	return resourceAlicloudSaeNamespaceRead(d, meta)
+}
+
+// The namespace can be deleted only after all its applications are deleted.
+func resourceAlicloudSaeNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if err := RetryOnError(SaeCode, 3*time.Minute, func() error {
+		return client.ProcessSaeRequest(http.MethodDelete, "/pop/v1/paas/namespace", map[string]string{
+			"NamespaceId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, SaeNamespaceNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteNamespace got an error")
+	}
+
+	return nil
+}
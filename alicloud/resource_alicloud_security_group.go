@@ -1,11 +1,9 @@
 package alicloud
 
 import (
-	"fmt"
 	"time"
 
-	"github.com/denverdino/aliyungo/ecs"
-	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -38,82 +36,89 @@ func resourceAliyunSecurityGroup() *schema.Resource {
 				ForceNew: true,
 			},
 			"inner_access": &schema.Schema{
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "Field 'inner_access' has been deprecated from provider version 1.9.1. New field 'inner_access_policy' replaces it.",
+				ConflictsWith: []string{"inner_access_policy"},
+			},
+			"inner_access_policy": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateAllowedStringValue([]string{string(GroupInnerAccept), string(GroupInnerDrop)}),
+				ConflictsWith: []string{"inner_access"},
+			},
+			"security_group_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      SecurityGroupTypeNormal,
+				ValidateFunc: validateAllowedStringValue([]string{SecurityGroupTypeNormal, SecurityGroupTypeEnterprise}),
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 
-	args, err := buildAliyunSecurityGroupArgs(d, meta)
-	if err != nil {
-		return err
-	}
-
-	securityGroupID, err := conn.CreateSecurityGroup(args)
-	if err != nil {
-		return err
+	request := buildAliyunSecurityGroupArgs(d, meta)
+	resp := ecs.CreateCreateSecurityGroupResponse()
+	if err := client.doAction(client.ecsconn(), request, resp); err != nil {
+		return WrapErrorf(err, "CreateSecurityGroup got an error")
 	}
 
-	d.SetId(securityGroupID)
+	d.SetId(resp.SecurityGroupId)
 	return resourceAliyunSecurityGroupUpdate(d, meta)
 }
 
 func resourceAliyunSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).ecsconn
-
-	args := &ecs.DescribeSecurityGroupAttributeArgs{
-		SecurityGroupId: d.Id(),
-		RegionId:        getRegion(d, meta),
-	}
-	//err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-	var sg *ecs.DescribeSecurityGroupAttributeResponse
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		group, e := conn.DescribeSecurityGroupAttribute(args)
-		if e != nil {
-			if IsExceptedError(e, InvalidSecurityGroupIdNotFound) {
-				sg = nil
-				return nil
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error DescribeSecurityGroupAttribute: %#v", e))
-		}
-		if group != nil {
-			sg = group
+	client := meta.(*AliyunClient)
+
+	args := ecs.CreateDescribeSecurityGroupAttributeRequest()
+	args.SecurityGroupId = d.Id()
+	args.RegionId = string(getRegion(d, meta))
+	sg := ecs.CreateDescribeSecurityGroupAttributeResponse()
+	if err := client.doAction(client.ecsconn(), args, sg); err != nil {
+		if IsExceptedError(err, InvalidSecurityGroupIdNotFound) {
+			d.SetId("")
 			return nil
 		}
-		return resource.RetryableError(fmt.Errorf("Create security group timeout and got an error: %#v", e))
-	})
-
-	if err != nil {
-		return err
-	}
-	if sg == nil {
-		d.SetId("")
-		return nil
+		return WrapApiError(err, args.GetActionName(), d.Id())
 	}
 
 	d.Set("name", sg.SecurityGroupName)
 	d.Set("description", sg.Description)
 	d.Set("vpc_id", sg.VpcId)
-	d.Set("inner_access", sg.InnerAccessPolicy == ecs.GroupInnerAccept)
+	d.Set("inner_access", sg.InnerAccessPolicy == string(GroupInnerAccept))
+	d.Set("inner_access_policy", sg.InnerAccessPolicy)
+
+	groupType, err := meta.(*AliyunClient).DescribeSecurityGroupType(d.Id())
+	if err != nil {
+		return WrapErrorf(err, "DescribeSecurityGroupType got an error")
+	}
+	d.Set("security_group_type", groupType)
+
+	tags, err := meta.(*AliyunClient).describeEcsTags(getRegion(d, meta), TagResourceSecurityGroup, d.Id())
+	if err != nil {
+		return WrapErrorf(err, "DescribeTags for security group got an error")
+	}
+	d.Set("tags", meta.(*AliyunClient).ignoreDefaultTags(d, tagsToMap(tags)))
 
 	return nil
 }
 
 func resourceAliyunSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).ecsconn
+	client := meta.(*AliyunClient)
 
 	d.Partial(true)
 	attributeUpdate := false
-	args := &ecs.ModifySecurityGroupAttributeArgs{
-		SecurityGroupId: d.Id(),
-		RegionId:        getRegion(d, meta),
-	}
+	args := ecs.CreateModifySecurityGroupAttributeRequest()
+	args.SecurityGroupId = d.Id()
+	args.RegionId = string(getRegion(d, meta))
 
 	if d.HasChange("name") && !d.IsNewResource() {
 		d.SetPartial("name")
@@ -129,26 +134,36 @@ func resourceAliyunSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		attributeUpdate = true
 	}
 	if attributeUpdate {
-		if err := conn.ModifySecurityGroupAttribute(args); err != nil {
+		if err := client.doAction(client.ecsconn(), args, ecs.CreateModifySecurityGroupAttributeResponse()); err != nil {
 			return err
 		}
 	}
 
-	if d.HasChange("inner_access") {
-		policy := ecs.GroupInnerAccept
+	var policy GroupInnerAccessPolicy
+	if d.HasChange("inner_access_policy") {
+		policy = GroupInnerAccessPolicy(d.Get("inner_access_policy").(string))
+	} else if d.HasChange("inner_access") {
+		policy = GroupInnerAccept
 		if !d.Get("inner_access").(bool) {
-			policy = ecs.GroupInnerDrop
+			policy = GroupInnerDrop
 		}
-		if err := conn.ModifySecurityGroupPolicy(&ecs.ModifySecurityGroupPolicyArgs{
-			RegionId:          getRegion(d, meta),
-			SecurityGroupId:   d.Id(),
-			InnerAccessPolicy: policy,
-		}); err != nil {
-			return fmt.Errorf("ModifySecurityGroupPolicy got an error: %#v.", err)
+	}
+	if policy != "" {
+		request := ecs.CreateModifySecurityGroupPolicyRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.SecurityGroupId = d.Id()
+		request.InnerAccessPolicy = string(policy)
+		if err := client.doAction(client.ecsconn(), request, ecs.CreateModifySecurityGroupPolicyResponse()); err != nil {
+			return WrapErrorf(err, "ModifySecurityGroupPolicy got an error")
 		}
 
 	}
 
+	if err := setTags(client, TagResourceSecurityGroup, d); err != nil {
+		return WrapErrorf(err, "Set tags for security group got an error")
+	}
+	d.SetPartial("tags")
+
 	d.Partial(false)
 
 	return resourceAliyunSecurityGroupRead(d, meta)
@@ -156,41 +171,30 @@ func resourceAliyunSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceAliyunSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).ecsconn
-
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := conn.DeleteSecurityGroup(getRegion(d, meta), d.Id())
+	client := meta.(*AliyunClient)
 
-		if err != nil {
-			if IsExceptedError(err, SgDependencyViolation) {
-				return resource.RetryableError(fmt.Errorf("Delete security group timeout and got an error: %#v", err))
-			}
-		}
+	request := ecs.CreateDeleteSecurityGroupRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.SecurityGroupId = d.Id()
 
-		sg, err := conn.DescribeSecurityGroupAttribute(&ecs.DescribeSecurityGroupAttributeArgs{
-			RegionId:        getRegion(d, meta),
-			SecurityGroupId: d.Id(),
-		})
-
-		if err != nil {
-			if IsExceptedError(err, InvalidSecurityGroupIdNotFound) {
-				return nil
-			}
-			return resource.NonRetryableError(err)
-		} else if sg == nil {
+	err := RetryOnError(EcsCode, 5*time.Minute, func() error {
+		return client.doAction(client.ecsconn(), request, ecs.CreateDeleteSecurityGroupResponse())
+	})
+	if err != nil {
+		if IsExceptedError(err, InvalidSecurityGroupIdNotFound) {
 			return nil
 		}
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
 
-		return resource.RetryableError(fmt.Errorf("Delete security group timeout and got an error: %#v", err))
-	})
-
+	return nil
 }
 
-func buildAliyunSecurityGroupArgs(d *schema.ResourceData, meta interface{}) (*ecs.CreateSecurityGroupArgs, error) {
+func buildAliyunSecurityGroupArgs(d *schema.ResourceData, meta interface{}) *ecs.CreateSecurityGroupRequest {
 
-	args := &ecs.CreateSecurityGroupArgs{
-		RegionId: getRegion(d, meta),
-	}
+	args := ecs.CreateCreateSecurityGroupRequest()
+	args.RegionId = string(getRegion(d, meta))
+	args.SecurityGroupType = d.Get("security_group_type").(string)
 
 	if v := d.Get("name").(string); v != "" {
 		args.SecurityGroupName = v
@@ -204,5 +208,7 @@ func buildAliyunSecurityGroupArgs(d *schema.ResourceData, meta interface{}) (*ec
 		args.VpcId = v
 	}
 
-	return args, nil
+	args.ClientToken = buildClientToken("TF-CreateSecurityGroup")
+
+	return args
 }
@@ -6,8 +6,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/denverdino/aliyungo/ecs"
-	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -16,6 +15,9 @@ func resourceAliyunSecurityGroupRule() *schema.Resource {
 		Create: resourceAliyunSecurityGroupRuleCreate,
 		Read:   resourceAliyunSecurityGroupRuleRead,
 		Delete: resourceAliyunSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"type": &schema.Schema{
@@ -95,7 +97,6 @@ func resourceAliyunSecurityGroupRule() *schema.Resource {
 
 func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
-	conn := client.ecsconn
 
 	direction := d.Get("type").(string)
 	sgId := d.Get("security_group_id").(string)
@@ -115,19 +116,19 @@ func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interfac
 	}
 
 	var autherr error
-	switch ecs.Direction(direction) {
-	case ecs.DirectionIngress:
+	switch Direction(direction) {
+	case DirectionIngress:
 		args, err := buildAliyunSecurityIngressArgs(d, meta)
 		if err != nil {
 			return err
 		}
-		autherr = conn.AuthorizeSecurityGroup(args)
-	case ecs.DirectionEgress:
+		autherr = client.doAction(client.ecsconn(), args, ecs.CreateAuthorizeSecurityGroupResponse())
+	case DirectionEgress:
 		args, err := buildAliyunSecurityEgressArgs(d, meta)
 		if err != nil {
 			return err
 		}
-		autherr = conn.AuthorizeSecurityGroupEgress(args)
+		autherr = client.doAction(client.ecsconn(), args, ecs.CreateAuthorizeSecurityGroupEgressResponse())
 	default:
 		return fmt.Errorf("Security Group Rule must be type 'ingress' or type 'egress'")
 	}
@@ -162,7 +163,7 @@ func resourceAliyunSecurityGroupRuleRead(d *schema.ResourceData, meta interface{
 	} else {
 		prior, err := strconv.Atoi(strPriority)
 		if err != nil {
-			return fmt.Errorf("SecrityGroupRuleRead parse rule id gets an error: %#v", err)
+			return WrapErrorf(err, "SecrityGroupRuleRead parse rule id gets an error")
 		}
 		priority = prior
 	}
@@ -175,7 +176,7 @@ func resourceAliyunSecurityGroupRuleRead(d *schema.ResourceData, meta interface{
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error describing security group rule: %#v", err)
+		return WrapErrorf(err, "Error describing security group rule")
 	}
 
 	d.Set("type", rule.Direction)
@@ -183,10 +184,11 @@ func resourceAliyunSecurityGroupRuleRead(d *schema.ResourceData, meta interface{
 	d.Set("nic_type", rule.NicType)
 	d.Set("policy", strings.ToLower(string(rule.Policy)))
 	d.Set("port_range", rule.PortRange)
-	d.Set("priority", rule.Priority)
+	priority, _ = strconv.Atoi(rule.Priority)
+	d.Set("priority", priority)
 	d.Set("security_group_id", sgId)
 	//support source and desc by type
-	if ecs.Direction(direction) == ecs.DirectionIngress {
+	if Direction(direction) == DirectionIngress {
 		d.Set("cidr_ip", rule.SourceCidrIp)
 		d.Set("source_security_group_id", rule.SourceGroupId)
 		d.Set("source_group_owner_account", rule.SourceGroupOwnerAccount)
@@ -202,15 +204,23 @@ func deleteSecurityGroupRule(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 	ruleType := d.Get("type").(string)
 
-	if ecs.Direction(ruleType) == ecs.DirectionIngress {
+	if Direction(ruleType) == DirectionIngress {
 		args, err := buildAliyunSecurityIngressArgs(d, meta)
 		if err != nil {
 			return err
 		}
-		revokeArgs := &ecs.RevokeSecurityGroupArgs{
-			AuthorizeSecurityGroupArgs: *args,
-		}
-		return client.RevokeSecurityGroup(revokeArgs)
+		request := ecs.CreateRevokeSecurityGroupRequest()
+		request.RegionId = args.RegionId
+		request.SecurityGroupId = args.SecurityGroupId
+		request.IpProtocol = args.IpProtocol
+		request.PortRange = args.PortRange
+		request.NicType = args.NicType
+		request.Policy = args.Policy
+		request.Priority = args.Priority
+		request.SourceCidrIp = args.SourceCidrIp
+		request.SourceGroupId = args.SourceGroupId
+		request.SourceGroupOwnerAccount = args.SourceGroupOwnerAccount
+		return client.RevokeSecurityGroup(request)
 	}
 
 	args, err := buildAliyunSecurityEgressArgs(d, meta)
@@ -218,64 +228,43 @@ func deleteSecurityGroupRule(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	revokeArgs := &ecs.RevokeSecurityGroupEgressArgs{
-		AuthorizeSecurityGroupEgressArgs: *args,
-	}
-	return client.RevokeSecurityGroupEgress(revokeArgs)
+	request := ecs.CreateRevokeSecurityGroupEgressRequest()
+	request.RegionId = args.RegionId
+	request.SecurityGroupId = args.SecurityGroupId
+	request.IpProtocol = args.IpProtocol
+	request.PortRange = args.PortRange
+	request.NicType = args.NicType
+	request.Policy = args.Policy
+	request.Priority = args.Priority
+	request.DestCidrIp = args.DestCidrIp
+	request.DestGroupId = args.DestGroupId
+	request.DestGroupOwnerAccount = args.DestGroupOwnerAccount
+	return client.RevokeSecurityGroupEgress(request)
 }
 
 func resourceAliyunSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AliyunClient)
-	parts := strings.Split(d.Id(), ":")
-	policy := parseSecurityRuleId(d, meta, 6)
-	strPriority := parseSecurityRuleId(d, meta, 7)
-	var priority int
-	if policy == "" || strPriority == "" {
-		policy = d.Get("policy").(string)
-		priority = d.Get("priority").(int)
-		d.SetId(d.Id() + ":" + policy + ":" + strconv.Itoa(priority))
-	} else {
-		prior, err := strconv.Atoi(strPriority)
-		if err != nil {
-			return fmt.Errorf("SecrityGroupRuleRead parse rule id gets an error: %#v", err)
+	err := RetryOnError(EcsCode, 5*time.Minute, func() error {
+		return deleteSecurityGroupRule(d, meta)
+	})
+	if err != nil {
+		if NotFoundError(err) || IsExceptedError(err, InvalidSecurityGroupIdNotFound) {
+			return nil
 		}
-		priority = prior
+		return WrapErrorf(err, "Delete security group rule got an error")
 	}
-
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := deleteSecurityGroupRule(d, meta)
-
-		if err != nil {
-			if NotFoundError(err) || IsExceptedError(err, InvalidSecurityGroupIdNotFound) {
-				return nil
-			}
-			resource.RetryableError(fmt.Errorf("Delete security group rule timeout and got an error: %#v", err))
-		}
-
-		_, err = client.DescribeSecurityGroupRule(parts[0], parts[1], parts[2], parts[3], parts[4], parts[5], policy, priority)
-		if err != nil {
-			if NotFoundError(err) || IsExceptedError(err, InvalidSecurityGroupIdNotFound) {
-				return nil
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return resource.RetryableError(fmt.Errorf("Delete security group rule timeout and got an error: %#v", err))
-	})
-
+	return nil
 }
 
-func buildAliyunSecurityIngressArgs(d *schema.ResourceData, meta interface{}) (*ecs.AuthorizeSecurityGroupArgs, error) {
-	conn := meta.(*AliyunClient).ecsconn
+func buildAliyunSecurityIngressArgs(d *schema.ResourceData, meta interface{}) (*ecs.AuthorizeSecurityGroupRequest, error) {
+	client := meta.(*AliyunClient)
 
-	args := &ecs.AuthorizeSecurityGroupArgs{
-		RegionId: getRegion(d, meta),
-	}
+	args := ecs.CreateAuthorizeSecurityGroupRequest()
+	args.RegionId = string(getRegion(d, meta))
 	if v, ok := d.GetOk("ip_protocol"); ok {
-		args.IpProtocol = ecs.IpProtocol(v.(string))
+		args.IpProtocol = v.(string)
 	}
 
-	if args.IpProtocol == ecs.IpProtocolTCP || args.IpProtocol == ecs.IpProtocolUDP {
+	if IpProtocol(args.IpProtocol) == IpProtocolTCP || IpProtocol(args.IpProtocol) == IpProtocolUDP {
 		if v, ok := d.GetOk("port_range"); ok {
 			args.PortRange = v.(string)
 		}
@@ -284,11 +273,11 @@ func buildAliyunSecurityIngressArgs(d *schema.ResourceData, meta interface{}) (*
 	}
 
 	if v, ok := d.GetOk("policy"); ok {
-		args.Policy = ecs.PermissionPolicy(v.(string))
+		args.Policy = v.(string)
 	}
 
 	if v, ok := d.GetOk("priority"); ok {
-		args.Priority = v.(int)
+		args.Priority = strconv.Itoa(v.(int))
 	}
 
 	if v, ok := d.GetOk("cidr_ip"); ok {
@@ -305,14 +294,9 @@ func buildAliyunSecurityIngressArgs(d *schema.ResourceData, meta interface{}) (*
 
 	sgId := d.Get("security_group_id").(string)
 
-	sgArgs := &ecs.DescribeSecurityGroupAttributeArgs{
-		SecurityGroupId: sgId,
-		RegionId:        getRegion(d, meta),
-	}
-
-	group, err := conn.DescribeSecurityGroupAttribute(sgArgs)
+	group, err := client.DescribeSecurity(sgId)
 	if err != nil {
-		return nil, fmt.Errorf("Error get security group %s error: %#v", sgId, err)
+		return nil, WrapErrorf(err, "Error get security group %s", sgId)
 	}
 
 	if v, ok := d.GetOk("nic_type"); ok {
@@ -322,7 +306,7 @@ func buildAliyunSecurityIngressArgs(d *schema.ResourceData, meta interface{}) (*
 					"the nic_type must be 'intranet'.")
 			}
 		}
-		args.NicType = ecs.NicType(v.(string))
+		args.NicType = v.(string)
 	}
 
 	args.SecurityGroupId = sgId
@@ -330,15 +314,14 @@ func buildAliyunSecurityIngressArgs(d *schema.ResourceData, meta interface{}) (*
 	return args, nil
 }
 
-func buildAliyunSecurityEgressArgs(d *schema.ResourceData, meta interface{}) (*ecs.AuthorizeSecurityGroupEgressArgs, error) {
-	conn := meta.(*AliyunClient).ecsconn
+func buildAliyunSecurityEgressArgs(d *schema.ResourceData, meta interface{}) (*ecs.AuthorizeSecurityGroupEgressRequest, error) {
+	client := meta.(*AliyunClient)
 
-	args := &ecs.AuthorizeSecurityGroupEgressArgs{
-		RegionId: getRegion(d, meta),
-	}
+	args := ecs.CreateAuthorizeSecurityGroupEgressRequest()
+	args.RegionId = string(getRegion(d, meta))
 
 	if v, ok := d.GetOk("ip_protocol"); ok {
-		args.IpProtocol = ecs.IpProtocol(v.(string))
+		args.IpProtocol = v.(string)
 	}
 
 	if v, ok := d.GetOk("port_range"); ok {
@@ -346,11 +329,11 @@ func buildAliyunSecurityEgressArgs(d *schema.ResourceData, meta interface{}) (*e
 	}
 
 	if v, ok := d.GetOk("policy"); ok {
-		args.Policy = ecs.PermissionPolicy(v.(string))
+		args.Policy = v.(string)
 	}
 
 	if v, ok := d.GetOk("priority"); ok {
-		args.Priority = v.(int)
+		args.Priority = strconv.Itoa(v.(int))
 	}
 
 	if v, ok := d.GetOk("cidr_ip"); ok {
@@ -367,14 +350,9 @@ func buildAliyunSecurityEgressArgs(d *schema.ResourceData, meta interface{}) (*e
 
 	sgId := d.Get("security_group_id").(string)
 
-	sgArgs := &ecs.DescribeSecurityGroupAttributeArgs{
-		SecurityGroupId: sgId,
-		RegionId:        getRegion(d, meta),
-	}
-
-	group, err := conn.DescribeSecurityGroupAttribute(sgArgs)
+	group, err := client.DescribeSecurity(sgId)
 	if err != nil {
-		return nil, fmt.Errorf("Error get security group %s error: %#v", sgId, err)
+		return nil, WrapErrorf(err, "Error get security group %s", sgId)
 	}
 
 	if v, ok := d.GetOk("nic_type"); ok {
@@ -384,7 +362,7 @@ func buildAliyunSecurityEgressArgs(d *schema.ResourceData, meta interface{}) (*e
 					"the nic_type must be 'intranet'.")
 			}
 		}
-		args.NicType = ecs.NicType(v.(string))
+		args.NicType = v.(string)
 	}
 
 	args.SecurityGroupId = sgId
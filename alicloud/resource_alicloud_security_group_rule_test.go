@@ -8,14 +8,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAlicloudSecurityGroupRule_Ingress(t *testing.T) {
-	var pt ecs.PermissionType
+	var pt ecs.Permission
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -52,7 +52,7 @@ func TestAccAlicloudSecurityGroupRule_Ingress(t *testing.T) {
 }
 
 func TestAccAlicloudSecurityGroupRule_Egress(t *testing.T) {
-	var pt ecs.PermissionType
+	var pt ecs.Permission
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -85,7 +85,7 @@ func TestAccAlicloudSecurityGroupRule_Egress(t *testing.T) {
 }
 
 func TestAccAlicloudSecurityGroupRule_EgressDefaultNicType(t *testing.T) {
-	var pt ecs.PermissionType
+	var pt ecs.Permission
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -118,7 +118,7 @@ func TestAccAlicloudSecurityGroupRule_EgressDefaultNicType(t *testing.T) {
 }
 
 func TestAccAlicloudSecurityGroupRule_Vpc_Ingress(t *testing.T) {
-	var pt ecs.PermissionType
+	var pt ecs.Permission
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -151,7 +151,7 @@ func TestAccAlicloudSecurityGroupRule_Vpc_Ingress(t *testing.T) {
 }
 
 func TestAccAlicloudSecurityGroupRule_MissParameterSourceCidrIp(t *testing.T) {
-	var pt ecs.PermissionType
+	var pt ecs.Permission
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -188,7 +188,7 @@ func TestAccAlicloudSecurityGroupRule_MissParameterSourceCidrIp(t *testing.T) {
 }
 
 func TestAccAlicloudSecurityGroupRule_SourceSecurityGroup(t *testing.T) {
-	var pt ecs.PermissionType
+	var pt ecs.Permission
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -225,7 +225,7 @@ func TestAccAlicloudSecurityGroupRule_SourceSecurityGroup(t *testing.T) {
 }
 
 func TestAccAlicloudSecurityGroupRule_Multi(t *testing.T) {
-	var pt ecs.PermissionType
+	var pt ecs.Permission
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -273,7 +273,7 @@ func TestAccAlicloudSecurityGroupRule_Multi(t *testing.T) {
 }
 
 func TestAccAlicloudSecurityGroupRule_MultiAttri(t *testing.T) {
-	var pt ecs.PermissionType
+	var pt ecs.Permission
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -323,7 +323,7 @@ func TestAccAlicloudSecurityGroupRule_MultiAttri(t *testing.T) {
 
 }
 
-func testAccCheckSecurityGroupRuleExists(n string, m *ecs.PermissionType) resource.TestCheckFunc {
+func testAccCheckSecurityGroupRuleExists(n string, m *ecs.Permission) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -0,0 +1,250 @@
+package alicloud
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAliyunSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunSecurityGroupRulesCreate,
+		Read:   resourceAliyunSecurityGroupRulesRead,
+		Update: resourceAliyunSecurityGroupRulesUpdate,
+		Delete: resourceAliyunSecurityGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ingress": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     securityGroupRulesElem(),
+				Set:      securityGroupRuleHash,
+			},
+			"egress": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     securityGroupRulesElem(),
+				Set:      securityGroupRuleHash,
+			},
+		},
+	}
+}
+
+func securityGroupRulesElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip_protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSecurityRuleIpProtocol,
+			},
+			"port_range": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  AllPortRange,
+			},
+			"nic_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(GroupRuleIntranet),
+				ValidateFunc: validateSecurityRuleNicType,
+			},
+			"policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(GroupRulePolicyAccept),
+				ValidateFunc: validateSecurityRulePolicy,
+			},
+			"priority": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateSecurityPriority,
+			},
+			"cidr_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_group_owner_account": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunSecurityGroupRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	groupId := d.Get("security_group_id").(string)
+
+	for _, direction := range []Direction{DirectionIngress, DirectionEgress} {
+		rules, err := expandSecurityGroupRules(d.Get(string(direction)).(*schema.Set))
+		if err != nil {
+			return err
+		}
+		if err := client.AuthorizeSecurityGroupRules(groupId, direction, rules); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(groupId)
+
+	return resourceAliyunSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAliyunSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	permissions, err := meta.(*AliyunClient).DescribeSecurityGroupRules(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, "DescribeSecurityGroupRules got an error")
+	}
+
+	var ingress, egress []interface{}
+	for _, p := range permissions {
+		if Direction(p.Direction) == DirectionEgress {
+			egress = append(egress, flattenSecurityGroupRule(p))
+		} else {
+			ingress = append(ingress, flattenSecurityGroupRule(p))
+		}
+	}
+
+	d.Set("security_group_id", d.Id())
+	if err := d.Set("ingress", schema.NewSet(securityGroupRuleHash, ingress)); err != nil {
+		return err
+	}
+	if err := d.Set("egress", schema.NewSet(securityGroupRuleHash, egress)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAliyunSecurityGroupRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+
+	for _, direction := range []Direction{DirectionIngress, DirectionEgress} {
+		key := string(direction)
+		if !d.HasChange(key) {
+			continue
+		}
+		o, n := d.GetChange(key)
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		// A rule can not be modified, and the changed one is revoked and authorized again.
+		remove, err := expandSecurityGroupRules(os.Difference(ns))
+		if err != nil {
+			return err
+		}
+		if err := client.RevokeSecurityGroupRules(d.Id(), direction, remove); err != nil {
+			return err
+		}
+		add, err := expandSecurityGroupRules(ns.Difference(os))
+		if err != nil {
+			return err
+		}
+		if err := client.AuthorizeSecurityGroupRules(d.Id(), direction, add); err != nil {
+			return err
+		}
+		d.SetPartial(key)
+	}
+
+	d.Partial(false)
+
+	return resourceAliyunSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAliyunSecurityGroupRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	for _, direction := range []Direction{DirectionIngress, DirectionEgress} {
+		rules, err := expandSecurityGroupRules(d.Get(string(direction)).(*schema.Set))
+		if err != nil {
+			return err
+		}
+		if err := client.RevokeSecurityGroupRules(d.Id(), direction, rules); err != nil {
+			if IsExceptedError(err, InvalidSecurityGroupIdNotFound) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func expandSecurityGroupRules(set *schema.Set) ([]map[string]interface{}, error) {
+	var rules []map[string]interface{}
+	for _, v := range set.List() {
+		rule := v.(map[string]interface{})
+		if rule["cidr_ip"].(string) == "" && rule["source_security_group_id"].(string) == "" {
+			return nil, fmt.Errorf("Either 'cidr_ip' or 'source_security_group_id' must be specified in the rule %s %s.",
+				rule["ip_protocol"], rule["port_range"])
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func flattenSecurityGroupRule(p ecs.Permission) map[string]interface{} {
+	rule := map[string]interface{}{
+		"ip_protocol": strings.ToLower(string(p.IpProtocol)),
+		"port_range":  p.PortRange,
+		"nic_type":    string(p.NicType),
+		"policy":      strings.ToLower(string(p.Policy)),
+		"priority":    p.Priority,
+		"description": p.Description,
+	}
+	if Direction(p.Direction) == DirectionEgress {
+		rule["cidr_ip"] = p.DestCidrIp
+		rule["source_security_group_id"] = p.DestGroupId
+		rule["source_group_owner_account"] = p.DestGroupOwnerAccount
+	} else {
+		rule["cidr_ip"] = p.SourceCidrIp
+		rule["source_security_group_id"] = p.SourceGroupId
+		rule["source_group_owner_account"] = p.SourceGroupOwnerAccount
+	}
+	return rule
+}
+
+// securityGroupRuleHash identifies a rule by the attributes which are saved by the API, so that the
+// rules which are read back are equal to the configured ones.
+func securityGroupRuleHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	protocol := strings.ToLower(m["ip_protocol"].(string))
+	buf.WriteString(fmt.Sprintf("%s-", protocol))
+	buf.WriteString(fmt.Sprintf("%s-", securityGroupRulePortRange(protocol, m["port_range"].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", m["nic_type"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["policy"].(string))))
+	buf.WriteString(fmt.Sprintf("%d-", m["priority"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["cidr_ip"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["source_security_group_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["source_group_owner_account"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["description"].(string)))
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,168 @@
+package alicloud
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSecurityGroupRules_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_security_group_rules.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSecurityGroupRulesConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount("alicloud_security_group_rules.foo", 3),
+					resource.TestCheckResourceAttr("alicloud_security_group_rules.foo", "ingress.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_security_group_rules.foo", "egress.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSecurityGroupRulesConfig(150),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount("alicloud_security_group_rules.foo", 151),
+					resource.TestCheckResourceAttr("alicloud_security_group_rules.foo", "ingress.#", "150"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildSecurityGroupRulesParams(t *testing.T) {
+	rules := []map[string]interface{}{
+		{
+			"ip_protocol":                "tcp",
+			"port_range":                 "22/22",
+			"nic_type":                   "intranet",
+			"policy":                     "accept",
+			"priority":                   1,
+			"cidr_ip":                    "10.0.0.0/8",
+			"source_security_group_id":   "",
+			"source_group_owner_account": "",
+			"description":                "ssh",
+		},
+		{
+			"ip_protocol":                "icmp",
+			"port_range":                 "22/22",
+			"nic_type":                   "intranet",
+			"policy":                     "drop",
+			"priority":                   2,
+			"cidr_ip":                    "",
+			"source_security_group_id":   "sg-abc",
+			"source_group_owner_account": "",
+			"description":                "",
+		},
+	}
+
+	params := buildSecurityGroupRulesParams(DirectionEgress, rules)
+	expected := map[string]string{
+		"Permissions.1.IpProtocol":  "tcp",
+		"Permissions.1.PortRange":   "22/22",
+		"Permissions.1.NicType":     "intranet",
+		"Permissions.1.Policy":      "accept",
+		"Permissions.1.Priority":    "1",
+		"Permissions.1.DestCidrIp":  "10.0.0.0/8",
+		"Permissions.1.Description": "ssh",
+		"Permissions.2.IpProtocol":  "icmp",
+		"Permissions.2.PortRange":   AllPortRange,
+		"Permissions.2.NicType":     "intranet",
+		"Permissions.2.Policy":      "drop",
+		"Permissions.2.Priority":    "2",
+		"Permissions.2.DestGroupId": "sg-abc",
+	}
+	if len(params) != len(expected) {
+		t.Fatalf("expected %d params, got %d: %v", len(expected), len(params), params)
+	}
+	for k, v := range expected {
+		if params[k] != v {
+			t.Fatalf("expected %s to be %q, got %q", k, v, params[k])
+		}
+	}
+}
+
+func testAccCheckSecurityGroupRulesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		rules, err := client.DescribeSecurityGroupRules(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(rules) != count {
+			return fmt.Errorf("Expected %d rules in security group %s, got %d.", count, rs.Primary.ID, len(rules))
+		}
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_security_group_rules" {
+			continue
+		}
+
+		rules, err := client.DescribeSecurityGroupRules(rs.Primary.ID)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if len(rules) > 0 {
+			return fmt.Errorf("Security group %s still has %d rules.", rs.Primary.ID, len(rules))
+		}
+	}
+
+	return nil
+}
+
+// testAccSecurityGroupRulesConfig builds a config with count tcp ingress rules, which need two batches when count is over 100.
+func testAccSecurityGroupRulesConfig(count int) string {
+	var ingress bytes.Buffer
+	for i := 0; i < count; i++ {
+		ingress.WriteString(fmt.Sprintf(`
+  ingress {
+    ip_protocol = "tcp"
+    port_range  = "%d/%d"
+    cidr_ip     = "10.0.0.0/8"
+  }
+`, 8000+i, 8000+i))
+	}
+	return fmt.Sprintf(`
+resource "alicloud_vpc" "vpc" {
+  name       = "tf-test-sg-rules"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_security_group" "foo" {
+  name   = "tf-test-sg-rules"
+  vpc_id = "${alicloud_vpc.vpc.id}"
+}
+
+resource "alicloud_security_group_rules" "foo" {
+  security_group_id = "${alicloud_security_group.foo.id}"
+%s
+  egress {
+    ip_protocol = "all"
+    cidr_ip     = "0.0.0.0/0"
+  }
+}
+`, ingress.String())
+}
@@ -5,12 +5,52 @@ import (
 	"log"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("alicloud_security_group", &resource.Sweeper{
+		Name: "alicloud_security_group",
+		F:    testSweepSecurityGroups,
+		// the security groups can only be deleted after the instances in them
+		Dependencies: []string{
+			"alicloud_instance",
+		},
+	})
+}
+
+func testSweepSecurityGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+
+	args := ecs.CreateDescribeSecurityGroupsRequest()
+	args.RegionId = string(client.Region)
+	groups, err := client.DescribeEcsSecurityGroups(args)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Security Groups: %s", err)
+	}
+
+	for _, v := range groups {
+		if !isTestSweepName(v.SecurityGroupName) {
+			log.Printf("[INFO] Skipping Security Group: %s (%s)", v.SecurityGroupName, v.SecurityGroupId)
+			continue
+		}
+		log.Printf("[INFO] Deleting Security Group: %s (%s)", v.SecurityGroupName, v.SecurityGroupId)
+		request := ecs.CreateDeleteSecurityGroupRequest()
+		request.RegionId = string(client.Region)
+		request.SecurityGroupId = v.SecurityGroupId
+		if err := client.doAction(client.ecsconn(), request, ecs.CreateDeleteSecurityGroupResponse()); err != nil {
+			log.Printf("[ERROR] Failed to delete Security Group (%s (%s)): %s", v.SecurityGroupName, v.SecurityGroupId, err)
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudSecurityGroup_basic(t *testing.T) {
 	var sg ecs.DescribeSecurityGroupAttributeResponse
 
@@ -74,6 +114,69 @@ func TestAccAlicloudSecurityGroup_withVpc(t *testing.T) {
 
 }
 
+func TestAccAlicloudSecurityGroup_innerAccessPolicy(t *testing.T) {
+	var sg ecs.DescribeSecurityGroupAttributeResponse
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_security_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSecurityGroupConfig_innerAccessPolicy("Accept"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists(
+						"alicloud_security_group.foo", &sg),
+					resource.TestCheckResourceAttr("alicloud_security_group.foo", "inner_access_policy", "Accept"),
+					resource.TestCheckResourceAttr("alicloud_security_group.foo", "security_group_type", "normal"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSecurityGroupConfig_innerAccessPolicy("Drop"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists(
+						"alicloud_security_group.foo", &sg),
+					resource.TestCheckResourceAttr("alicloud_security_group.foo", "inner_access_policy", "Drop"),
+					resource.TestCheckResourceAttr("alicloud_security_group.foo", "inner_access", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudSecurityGroup_enterprise(t *testing.T) {
+	var sg ecs.DescribeSecurityGroupAttributeResponse
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_security_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSecurityGroupConfig_enterprise,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists(
+						"alicloud_security_group.foo", &sg),
+					resource.TestCheckResourceAttr("alicloud_security_group.foo", "security_group_type", "enterprise"),
+					resource.TestCheckResourceAttr("alicloud_security_group.foo", "inner_access_policy", "Drop"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSecurityGroupExists(n string, sg *ecs.DescribeSecurityGroupAttributeResponse) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -86,12 +189,7 @@ func testAccCheckSecurityGroupExists(n string, sg *ecs.DescribeSecurityGroupAttr
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		conn := client.ecsconn
-		args := &ecs.DescribeSecurityGroupAttributeArgs{
-			RegionId:        client.Region,
-			SecurityGroupId: rs.Primary.ID,
-		}
-		d, err := conn.DescribeSecurityGroupAttribute(args)
+		d, err := client.DescribeSecurity(rs.Primary.ID)
 
 		log.Printf("[WARN] security group id %#v", rs.Primary.ID)
 
@@ -110,7 +208,6 @@ func testAccCheckSecurityGroupExists(n string, sg *ecs.DescribeSecurityGroupAttr
 
 func testAccCheckSecurityGroupDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*AliyunClient)
-	conn := client.ecsconn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alicloud_security_group" {
@@ -118,11 +215,10 @@ func testAccCheckSecurityGroupDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the SecurityGroup
-		args := &ecs.DescribeSecurityGroupsArgs{
-			RegionId: client.Region,
-		}
+		args := ecs.CreateDescribeSecurityGroupsRequest()
+		args.RegionId = string(client.Region)
 
-		groups, _, err := conn.DescribeSecurityGroups(args)
+		groups, err := client.DescribeEcsSecurityGroups(args)
 
 		for _, sg := range groups {
 			if sg.SecurityGroupId == rs.Primary.ID {
@@ -154,3 +250,31 @@ resource "alicloud_vpc" "vpc" {
   cidr_block = "10.1.0.0/21"
 }
 `
+
+func testAccSecurityGroupConfig_innerAccessPolicy(policy string) string {
+	return fmt.Sprintf(`
+resource "alicloud_vpc" "vpc" {
+  name       = "tf-test-sg-inner-access"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_security_group" "foo" {
+  name                = "tf-test-sg-inner-access"
+  vpc_id              = "${alicloud_vpc.vpc.id}"
+  inner_access_policy = "%s"
+}
+`, policy)
+}
+
+const testAccSecurityGroupConfig_enterprise = `
+resource "alicloud_vpc" "vpc" {
+  name       = "tf-test-sg-enterprise"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_security_group" "foo" {
+  name                = "tf-test-sg-enterprise"
+  vpc_id              = "${alicloud_vpc.vpc.id}"
+  security_group_type = "enterprise"
+}
+`
@@ -2,9 +2,11 @@ package alicloud
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/denverdino/aliyungo/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -19,6 +21,11 @@ func resourceAliyunSlb() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -44,7 +51,7 @@ func resourceAliyunSlb() *schema.Resource {
 			"internet_charge_type": &schema.Schema{
 				Type:             schema.TypeString,
 				Optional:         true,
-				Default:          slb.PayByTraffic,
+				Default:          SlbPayByTraffic,
 				ValidateFunc:     validateSlbInternetChargeType,
 				DiffSuppressFunc: slbInternetChargeTypeDiffSuppressFunc,
 			},
@@ -212,70 +219,108 @@ func resourceAliyunSlb() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"deletion_protection": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(PostPaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(PrePaid), string(PostPaid)}),
+			},
+
+			"period": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateFunc:     validateInstanceChargeTypePeriod,
+				DiffSuppressFunc: prePaidDiffSuppressFunc,
+			},
+
+			"renewal_status":    renewalStatusSchema(),
+			"auto_renew_period": autoRenewPeriodSchema(),
+
+			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceAliyunSlbCreate(d *schema.ResourceData, meta interface{}) error {
-	slbconn := meta.(*AliyunClient).slbconn
-	args := &slb.CreateLoadBalancerArgs{
-		RegionId:           getRegion(d, meta),
-		LoadBalancerName:   d.Get("name").(string),
-		AddressType:        slb.IntranetAddressType,
-		InternetChargeType: slb.PayByTraffic,
-	}
+	client := meta.(*AliyunClient)
+	request := slb.CreateCreateLoadBalancerRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.LoadBalancerName = d.Get("name").(string)
+	request.AddressType = SlbIntranetAddressType
+	request.InternetChargeType = SlbPayByTraffic
 	if d.Get("internet").(bool) {
-		args.AddressType = slb.InternetAddressType
+		request.AddressType = SlbInternetAddressType
 	}
 
 	if v, ok := d.GetOk("internet_charge_type"); ok && v.(string) != "" {
-		args.InternetChargeType = slb.InternetChargeType(v.(string))
+		request.InternetChargeType = v.(string)
 	}
 
 	if v, ok := d.GetOk("vswitch_id"); ok && v.(string) != "" {
-		args.VSwitchId = v.(string)
+		request.VSwitchId = v.(string)
 	}
 
 	if v, ok := d.GetOk("bandwidth"); ok && v.(int) != 0 {
-		args.Bandwidth = v.(int)
+		request.Bandwidth = requests.NewInteger(v.(int))
 	}
 
 	if v, ok := d.GetOk("specification"); ok && v.(string) != "" {
-		args.LoadBalancerSpec = slb.LoadBalancerSpecType(v.(string))
+		request.LoadBalancerSpec = v.(string)
 	}
 
-	lb, err := slbconn.CreateLoadBalancer(args)
+	request.ClientToken = buildClientToken("TF-CreateLoadBalancer")
+
+	request.PayType = SlbPayOnDemand
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		usedTime, unit := prepaidPeriod(d.Get("period").(int))
+		request.PayType = SlbPrePay
+		request.Duration = requests.Integer(usedTime)
+		request.PricingCycle = strings.ToLower(unit)
+		request.AutoPay = requests.NewBoolean(true)
+	}
+	lb := slb.CreateCreateLoadBalancerResponse()
+	err := client.doAction(client.slbconn(), request, lb)
 
 	if err != nil {
 		if IsExceptedError(err, SlbOrderFailed) {
 			return fmt.Errorf("Your account may not support to create 'paybybandwidth' load balancer. Please change it to 'paybytraffic' and try again.")
 		}
-		return fmt.Errorf("Create load balancer got an error: %#v", err)
+		return WrapApiError(err, request.GetActionName(), "")
 	}
 
 	d.SetId(lb.LoadBalancerId)
 
-	if err := slbconn.WaitForLoadBalancerAsyn(lb.LoadBalancerId, slb.ActiveStatus, DefaultTimeout); err != nil {
-		return fmt.Errorf("WaitForLoadbalancer %s got error: %#v", slb.ActiveStatus, err)
+	if err := client.WaitForLoadBalancer(lb.LoadBalancerId, SlbActive, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForLoadBalancer %s got an error", SlbActive), "", d.Id())
 	}
 
 	return resourceAliyunSlbUpdate(d, meta)
 }
 
 func resourceAliyunSlbRead(d *schema.ResourceData, meta interface{}) error {
-	loadBalancer, err := meta.(*AliyunClient).DescribeLoadBalancerAttribute(d.Id())
+	client := meta.(*AliyunClient)
+	loadBalancer, err := client.DescribeLoadBalancerAttribute(d.Id())
 	if err != nil {
+		if IsExceptedError(err, LoadBalancerNotFound) {
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
-	if loadBalancer == nil {
-		d.SetId("")
-		return nil
-	}
-
 	d.Set("name", loadBalancer.LoadBalancerName)
 
-	if loadBalancer.AddressType == slb.InternetAddressType {
+	if loadBalancer.AddressType == SlbInternetAddressType {
 		d.Set("internet", true)
 	} else {
 		d.Set("internet", false)
@@ -286,85 +331,127 @@ func resourceAliyunSlbRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("address", loadBalancer.Address)
 	d.Set("specification", loadBalancer.LoadBalancerSpec)
 
+	d.Set("deletion_protection", loadBalancer.DeleteProtection == SlbDeleteProtectionOn)
+	if loadBalancer.PayType == SlbPrePay {
+		d.Set("instance_charge_type", string(PrePaid))
+	} else {
+		d.Set("instance_charge_type", string(PostPaid))
+	}
+	if err := readRenewal(client, BssProductSlb, d); err != nil {
+		return err
+	}
+
+	tags, err := client.describeSlbTags(getRegion(d, meta), d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeTags", d.Id())
+	}
+	d.Set("tags", client.ignoreDefaultTags(d, slbTagsToMap(tags)))
+
 	return nil
 }
 
 func resourceAliyunSlbUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
 
 	d.Partial(true)
 
 	if d.HasChange("name") && !d.IsNewResource() {
-		if err := slbconn.SetLoadBalancerName(d.Id(), d.Get("name").(string)); err != nil {
-			return fmt.Errorf("SetLoadBalancerName got an error: %#v", err)
+		request := slb.CreateSetLoadBalancerNameRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.LoadBalancerId = d.Id()
+		request.LoadBalancerName = d.Get("name").(string)
+		if err := client.doAction(client.slbconn(), request, slb.CreateSetLoadBalancerNameResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 
 		d.SetPartial("name")
 	}
 
 	update := false
-	args := &slb.ModifyLoadBalancerInternetSpecArgs{
-		LoadBalancerId: d.Id(),
-	}
+	request := slb.CreateModifyLoadBalancerInternetSpecRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.LoadBalancerId = d.Id()
 	if d.HasChange("internet_charge_type") && !d.IsNewResource() {
-		args.InternetChargeType = slb.InternetChargeType(d.Get("internet_charge_type").(string))
+		request.InternetChargeType = d.Get("internet_charge_type").(string)
 		update = true
 		d.SetPartial("internet_charge_type")
 
 	}
 	if d.HasChange("bandwidth") && !d.IsNewResource() {
-		args.Bandwidth = d.Get("bandwidth").(int)
+		request.Bandwidth = requests.NewInteger(d.Get("bandwidth").(int))
 		update = true
 		d.SetPartial("bandwidth")
 
 	}
 	if update {
-		if err := slbconn.ModifyLoadBalancerInternetSpec(args); err != nil {
-			return fmt.Errorf("ModifyLoadBalancerInternetSpec got an error: %#v", err)
+		if err := client.doAction(client.slbconn(), request, slb.CreateModifyLoadBalancerInternetSpecResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 
 	}
 
 	if d.HasChange("specification") && !d.IsNewResource() {
-		if err := slbconn.ModifyLoadBalancerInstanceSpec(&slb.ModifyLoadBalancerInstanceSpecArgs{
-			RegionId:         getRegion(d, meta),
-			LoadBalancerId:   d.Id(),
-			LoadBalancerSpec: slb.LoadBalancerSpecType(d.Get("specification").(string)),
-		}); err != nil {
-			return fmt.Errorf("ModifyLoadBalancerInstanceSpec got an error: %#v", err)
+		request := slb.CreateModifyLoadBalancerInstanceSpecRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.LoadBalancerId = d.Id()
+		request.LoadBalancerSpec = d.Get("specification").(string)
+		if err := client.doAction(client.slbconn(), request, slb.CreateModifyLoadBalancerInstanceSpecResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 		d.SetPartial("specification")
 	}
 
+	if d.HasChange("deletion_protection") {
+		if err := client.SetSlbDeleteProtection(d.Id(), d.Get("deletion_protection").(bool)); err != nil {
+			return WrapApiError(err, "SetLoadBalancerDeleteProtection", d.Id())
+		}
+		d.SetPartial("deletion_protection")
+	}
+
+	if err := setSlbTags(client, d); err != nil {
+		return WrapErrorf(err, "Set tags for load balancer got an error")
+	}
+	d.SetPartial("tags")
+
+	if err := setRenewal(client, BssProductSlb, d); err != nil {
+		return err
+	}
+
 	d.Partial(false)
 
 	return resourceAliyunSlbRead(d, meta)
 }
 
 func resourceAliyunSlbDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := conn.DeleteLoadBalancer(d.Id())
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		return fmt.Errorf("At present, 'PrePaid' load balancer cannot be deleted and must wait it to be expired and release it automatically.")
+	}
 
-		if err != nil {
-			if IsExceptedError(err, LoadBalancerNotFound) {
-				return nil
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error deleting slb failed: %#v", err))
+	request := slb.CreateDeleteLoadBalancerRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.LoadBalancerId = d.Id()
+	if err := RetryOnError(SlbCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.doAction(client.slbconn(), request, slb.CreateDeleteLoadBalancerResponse())
+	}); err != nil {
+		if IsExceptedError(err, LoadBalancerNotFound) {
+			return nil
 		}
+		if IsDeletionProtectionError(err) {
+			return WrapDeletionProtectionError(err, "load balancer", d.Id())
+		}
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
 
-		loadBalancer, err := conn.DescribeLoadBalancerAttribute(d.Id())
-		if err != nil {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if _, err := client.DescribeLoadBalancerAttribute(d.Id()); err != nil {
 			if IsExceptedError(err, LoadBalancerNotFound) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("Error describing slb failed when deleting SLB: %#v", err))
-		}
-		if loadBalancer != nil {
-			return resource.RetryableError(fmt.Errorf("Delete load balancer timeout and got an error: %#v.", err))
+			return resource.NonRetryableError(err)
 		}
-		return nil
+		return resource.RetryableError(fmt.Errorf("Delete load balancer %s timeout.", d.Id()))
 	})
 }
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -71,13 +72,12 @@ func resourceAliyunSlbAttachmentCreate(d *schema.ResourceData, meta interface{})
 
 	loadBalancer, err := meta.(*AliyunClient).DescribeLoadBalancerAttribute(d.Get("load_balancer_id").(string))
 	if err != nil {
+		if IsExceptedError(err, LoadBalancerNotFound) {
+			d.SetId("")
+			return fmt.Errorf("Specified SLB Id %s is not found in %#v.", d.Get("load_balancer_id").(string), getRegion(d, meta))
+		}
 		return err
 	}
-
-	if loadBalancer == nil {
-		d.SetId("")
-		return fmt.Errorf("Specified SLB Id %s is not found in %#v.", d.Get("load_balancer_id").(string), getRegion(d, meta))
-	}
 	d.SetId(loadBalancer.LoadBalancerId)
 
 	return resourceAliyunSlbAttachmentUpdate(d, meta)
@@ -94,11 +94,6 @@ func resourceAliyunSlbAttachmentRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	if loadBalancer == nil {
-		d.SetId("")
-		return nil
-	}
-
 	backendServerType := loadBalancer.BackendServers
 	servers := backendServerType.BackendServer
 	instanceIds := make([]string, 0, len(servers))
@@ -123,7 +118,7 @@ func resourceAliyunSlbAttachmentRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceAliyunSlbAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
 	update := false
 	weight := d.Get("weight").(int)
 
@@ -136,20 +131,17 @@ func resourceAliyunSlbAttachmentUpdate(d *schema.ResourceData, meta interface{})
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 		remove := os.Difference(ns).List()
-		add := expandBackendServers(ns.Difference(os).List(), weight)
+		add := ns.Difference(os).List()
 
 		if len(add) > 0 {
-			if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				_, err := slbconn.AddBackendServers(d.Id(), add)
-				if err != nil {
-					if IsExceptedError(err, ServiceIsConfiguring) {
-						return resource.RetryableError(fmt.Errorf("Load banalcer adds backend servers timeout and got an error: %#v.", err))
-					}
-					return resource.NonRetryableError(fmt.Errorf("Add backend servers got an error: %#v", err))
-				}
-				return nil
+			request := slb.CreateAddBackendServersRequest()
+			request.RegionId = string(client.Region)
+			request.LoadBalancerId = d.Id()
+			request.BackendServers = expandBackendServers(add, weight)
+			if err := RetryOnError(SlbCode, 2*time.Minute, func() error {
+				return client.doAction(client.slbconn(), request, slb.CreateAddBackendServersResponse())
 			}); err != nil {
-				return err
+				return WrapApiError(err, request.GetActionName(), d.Id())
 			}
 		}
 		if len(remove) > 0 {
@@ -165,16 +157,14 @@ func resourceAliyunSlbAttachmentUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if update {
-		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-			if _, err := slbconn.SetBackendServers(d.Id(), expandBackendServers(d.Get("instance_ids").(*schema.Set).List(), weight)); err != nil {
-				if IsExceptedError(err, ServiceIsConfiguring) {
-					return resource.RetryableError(fmt.Errorf("Load banalcer sets backend servers timeout and got an error: %#v.", err))
-				}
-				return resource.NonRetryableError(fmt.Errorf("Set backend servers got an error: %#v", err))
-			}
-			return nil
+		request := slb.CreateSetBackendServersRequest()
+		request.RegionId = string(client.Region)
+		request.LoadBalancerId = d.Id()
+		request.BackendServers = expandBackendServers(d.Get("instance_ids").(*schema.Set).List(), weight)
+		if err := RetryOnError(SlbCode, 2*time.Minute, func() error {
+			return client.doAction(client.slbconn(), request, slb.CreateSetBackendServersResponse())
 		}); err != nil {
-			return err
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 	}
 
@@ -191,27 +181,24 @@ func removeBackendServers(d *schema.ResourceData, meta interface{}, servers []in
 	client := meta.(*AliyunClient)
 	instanceSet := d.Get("instance_ids").(*schema.Set)
 	if len(servers) > 0 {
+		request := slb.CreateRemoveBackendServersRequest()
+		request.RegionId = string(client.Region)
+		request.LoadBalancerId = d.Id()
+		request.BackendServers = convertListToJsonString(servers)
 
-		return resource.Retry(3*time.Minute, func() *resource.RetryError {
-			_, err := client.slbconn.RemoveBackendServers(d.Id(), convertArrayInterfaceToArrayString(servers))
-			if err != nil {
-				if IsExceptedError(err, BackendServerconfiguring) || IsExceptedError(err, ServiceIsStopping) {
-					return resource.RetryableError(fmt.Errorf("Load balancer removes backend servers timeout and got an error: %#v", err))
-				}
-				return resource.NonRetryableError(fmt.Errorf("Remove backend servers got an error: %#v", err))
-			}
+		if err := RetryOnError(SlbCode, 3*time.Minute, func() error {
+			return client.doAction(client.slbconn(), request, slb.CreateRemoveBackendServersResponse())
+		}); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
+		}
 
+		return resource.Retry(3*time.Minute, func() *resource.RetryError {
 			loadBalancer, err := client.DescribeLoadBalancerAttribute(d.Id())
 			if err != nil {
 				if IsExceptedError(err, LoadBalancerNotFound) {
 					return nil
 				}
-				return resource.NonRetryableError(fmt.Errorf("DescribeLoadBalancerAttribute got an error: %#v", err))
-
-			}
-
-			if loadBalancer == nil {
-				return nil
+				return resource.NonRetryableError(err)
 			}
 
 			servers := loadBalancer.BackendServers.BackendServer
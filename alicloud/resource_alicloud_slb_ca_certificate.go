@@ -0,0 +1,128 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudSlbCACertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudSlbCACertificateCreate,
+		Read:   resourceAlicloudSlbCACertificateRead,
+		Update: resourceAlicloudSlbCACertificateUpdate,
+		Delete: resourceAlicloudSlbCACertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ca_certificate": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			// Computed values
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"common_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudSlbCACertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	request := slb.CreateUploadCACertificateRequest()
+	request.RegionId = string(client.Region)
+	request.CACertificate = d.Get("ca_certificate").(string)
+	request.CACertificateName = d.Get("name").(string)
+	response := slb.CreateUploadCACertificateResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return WrapApiError(err, request.GetActionName(), "")
+	}
+
+	d.SetId(response.CACertificateId)
+
+	return resourceAlicloudSlbCACertificateRead(d, meta)
+}
+
+func resourceAlicloudSlbCACertificateRead(d *schema.ResourceData, meta interface{}) error {
+	cert, err := meta.(*AliyunClient).DescribeSlbCACertificate(d.Id())
+	if err != nil {
+		if NotFoundError(err) || IsExceptedError(err, SlbCACertificateNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", cert.CACertificateName)
+	d.Set("fingerprint", cert.Fingerprint)
+	d.Set("common_name", cert.CommonName)
+	d.Set("expire_time", cert.ExpireTime)
+
+	return nil
+}
+
+func resourceAlicloudSlbCACertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") {
+		request := slb.CreateSetCACertificateNameRequest()
+		request.RegionId = string(client.Region)
+		request.CACertificateId = d.Id()
+		request.CACertificateName = d.Get("name").(string)
+		if err := client.doAction(client.slbconn(), request, slb.CreateSetCACertificateNameResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
+		}
+	}
+
+	return resourceAlicloudSlbCACertificateRead(d, meta)
+}
+
+func resourceAlicloudSlbCACertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	request := slb.CreateDeleteCACertificateRequest()
+	request.RegionId = string(client.Region)
+	request.CACertificateId = d.Id()
+
+	// The certificate can not be deleted until the HTTPS listeners using it are unbound.
+	if err := RetryOnError(SlbCode, 5*time.Minute, func() error {
+		return client.doAction(client.slbconn(), request, slb.CreateDeleteCACertificateResponse())
+	}); err != nil {
+		if IsExceptedError(err, SlbCACertificateNotFound) {
+			return nil
+		}
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if _, err := client.DescribeSlbCACertificate(d.Id()); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Delete SLB CA Certificate %s timeout.", d.Id()))
+	})
+}
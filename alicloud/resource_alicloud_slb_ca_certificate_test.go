@@ -0,0 +1,132 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudSlbCACertificate_basic(t *testing.T) {
+	var cert slb.CACertificate
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_slb_ca_certificate.ca",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSlbCACertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSlbCACertificateBasic("tf-testacc-ca"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlbCACertificateExists("alicloud_slb_ca_certificate.ca", &cert),
+					resource.TestCheckResourceAttr("alicloud_slb_ca_certificate.ca", "name", "tf-testacc-ca"),
+					resource.TestCheckResourceAttr("alicloud_slb_ca_certificate.ca", "common_name", "tf-testacc-ca"),
+					resource.TestCheckResourceAttrSet("alicloud_slb_ca_certificate.ca", "fingerprint"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSlbCACertificateBasic("tf-testacc-ca-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlbCACertificateExists("alicloud_slb_ca_certificate.ca", &cert),
+					resource.TestCheckResourceAttr("alicloud_slb_ca_certificate.ca", "name", "tf-testacc-ca-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudSlbCACertificate_import(t *testing.T) {
+	resourceName := "alicloud_slb_ca_certificate.ca"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSlbCACertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSlbCACertificateBasic("tf-testacc-ca"),
+			},
+
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ca_certificate"},
+			},
+		},
+	})
+}
+
+func testAccCheckSlbCACertificateExists(n string, cert *slb.CACertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SLB CA Certificate ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		c, err := client.DescribeSlbCACertificate(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*cert = c
+
+		return nil
+	}
+}
+
+func testAccCheckSlbCACertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_slb_ca_certificate" {
+			continue
+		}
+
+		if _, err := client.DescribeSlbCACertificate(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("SLB CA Certificate %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSlbCACertificateBasic(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_slb_ca_certificate" "ca" {
+  name = "%s"
+  ca_certificate = <<EOF
+-----BEGIN CERTIFICATE-----
+MIICTjCCAbegAwIBAgIUcvFV3jzU2DCvpWQHaeoj4X3A+5EwDQYJKoZIhvcNAQEL
+BQAwOTELMAkGA1UEBhMCQ04xEjAQBgNVBAoMCVRlcnJhZm9ybTEWMBQGA1UEAwwN
+dGYtdGVzdGFjYy1jYTAeFw0yNjEwMTcwMzI5MjRaFw0zNjEwMTQwMzI5MjRaMDkx
+CzAJBgNVBAYTAkNOMRIwEAYDVQQKDAlUZXJyYWZvcm0xFjAUBgNVBAMMDXRmLXRl
+c3RhY2MtY2EwgZ8wDQYJKoZIhvcNAQEBBQADgY0AMIGJAoGBAO5a5xdXMuo/N0Gx
+mTpE6iDLg7WV2d6xmn6VQ1+WWHqyamEPuYQFQq86dF5597wekTSg0nYGHxB3WYyb
+a8HRC1/xCuSVdVuKSlqDD04xU6ELvxfsHqS1MFrzBq/lWUnOOBAXOzitufXt+JKf
+3BOSlovp8Ht5pG288j4wqNLv+/9HAgMBAAGjUzBRMB0GA1UdDgQWBBTj4k+piKbg
+W/dc3WuRZvJrDdp7ODAfBgNVHSMEGDAWgBTj4k+piKbgW/dc3WuRZvJrDdp7ODAP
+BgNVHRMBAf8EBTADAQH/MA0GCSqGSIb3DQEBCwUAA4GBALNm2duRoXf6WTzrxZmC
+95dvqHMR1goF1gXn/OT3BVC34DkKglKljcBOVVPlRnho3XsXWpBQFsf2cNy0bCzK
+Fwxopmyd3lvzjaKPffobqzTgfsG2Nm6O7Mkm3wA8F5p8W8UY3z3WSQOswFFHf1Qi
+dmE/DZhlJ0MawxJ41wlSnWo4
+-----END CERTIFICATE-----
+EOF
+}
+`, name)
+}
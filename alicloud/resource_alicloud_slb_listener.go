@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/denverdino/aliyungo/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -76,7 +78,7 @@ func resourceAliyunSlbListener() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validateSlbListenerScheduler,
 				Optional:     true,
-				Default:      slb.WRRScheduler,
+				Default:      SlbWRRScheduler,
 			},
 			"server_group_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -86,18 +88,18 @@ func resourceAliyunSlbListener() *schema.Resource {
 			"sticky_session": &schema.Schema{
 				Type: schema.TypeString,
 				ValidateFunc: validateAllowedStringValue([]string{
-					string(slb.OnFlag),
-					string(slb.OffFlag)}),
+					SlbOnFlag,
+					SlbOffFlag}),
 				Optional:         true,
-				Default:          slb.OffFlag,
+				Default:          SlbOffFlag,
 				DiffSuppressFunc: httpHttpsDiffSuppressFunc,
 			},
 			//http & https
 			"sticky_session_type": &schema.Schema{
 				Type: schema.TypeString,
 				ValidateFunc: validateAllowedStringValue([]string{
-					string(slb.InsertStickySessionType),
-					string(slb.ServerStickySessionType)}),
+					SlbInsertStickySessionType,
+					SlbServerStickySessionType}),
 				Optional:         true,
 				DiffSuppressFunc: stickySessionTypeDiffSuppressFunc,
 			},
@@ -127,20 +129,20 @@ func resourceAliyunSlbListener() *schema.Resource {
 			"health_check": &schema.Schema{
 				Type: schema.TypeString,
 				ValidateFunc: validateAllowedStringValue([]string{
-					string(slb.OnFlag),
-					string(slb.OffFlag)}),
+					SlbOnFlag,
+					SlbOffFlag}),
 				Optional:         true,
-				Default:          slb.OnFlag,
+				Default:          SlbOnFlag,
 				DiffSuppressFunc: httpHttpsDiffSuppressFunc,
 			},
 			//tcp
 			"health_check_type": &schema.Schema{
 				Type: schema.TypeString,
 				ValidateFunc: validateAllowedStringValue([]string{
-					string(slb.TCPHealthCheckType),
-					string(slb.HTTPHealthCheckType)}),
+					SlbTCPHealthCheckType,
+					SlbHTTPHealthCheckType}),
 				Optional:         true,
-				Default:          slb.TCPHealthCheckType,
+				Default:          SlbTCPHealthCheckType,
 				DiffSuppressFunc: healthCheckTypeDiffSuppressFunc,
 			},
 			//http & https & tcp
@@ -198,12 +200,12 @@ func resourceAliyunSlbListener() *schema.Resource {
 			"health_check_http_code": &schema.Schema{
 				Type: schema.TypeString,
 				ValidateFunc: validateAllowedSplitStringValue([]string{
-					string(slb.HTTP_2XX),
-					string(slb.HTTP_3XX),
-					string(slb.HTTP_4XX),
-					string(slb.HTTP_5XX)}, ","),
+					SlbHTTP2XX,
+					SlbHTTP3XX,
+					SlbHTTP4XX,
+					SlbHTTP5XX}, ","),
 				Optional:         true,
-				Default:          slb.HTTP_2XX,
+				Default:          SlbHTTP2XX,
 				DiffSuppressFunc: httpHttpsTcpDiffSuppressFunc,
 			},
 			//https
@@ -212,79 +214,120 @@ func resourceAliyunSlbListener() *schema.Resource {
 				Optional:         true,
 				DiffSuppressFunc: sslCertificateIdDiffSuppressFunc,
 			},
+			"tls_cipher_policy": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateAllowedStringValue([]string{TlsCipherPolicy10, TlsCipherPolicy11, TlsCipherPolicy12, TlsCipherPolicy12Strict}),
+				DiffSuppressFunc: httpsDiffSuppressFunc,
+			},
+			"enable_http2": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateAllowedStringValue([]string{SlbOnFlag, SlbOffFlag}),
+				DiffSuppressFunc: httpsDiffSuppressFunc,
+			},
+			"ca_certificate_id": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: httpsDiffSuppressFunc,
+			},
+			//http & https
+			"idle_timeout": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateIntegerInRange(1, 60),
+				DiffSuppressFunc: httpHttpsDiffSuppressFunc,
+			},
+			"request_timeout": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateIntegerInRange(1, 180),
+				DiffSuppressFunc: httpHttpsDiffSuppressFunc,
+			},
 		},
 	}
 }
 
 func resourceAliyunSlbListenerCreate(d *schema.ResourceData, meta interface{}) error {
 
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
 
 	protocol := d.Get("protocol").(string)
 	lb_id := d.Get("load_balancer_id").(string)
 	frontend := d.Get("frontend_port").(int)
-	var err error
 
+	var args slbListenerArgs
+	var request requests.AcsRequest
+	var response responses.AcsResponse
 	switch Protocol(protocol) {
 	case Https:
 		ssl_id, ok := d.GetOk("ssl_certificate_id")
 		if !ok || ssl_id == "" {
 			return fmt.Errorf("'ssl_certificate_id': required field is not set when the protocol is 'https'.")
 		}
-		httpType, buildErr := buildHttpListenerType(d)
+		httpArgs, buildErr := buildHttpListenerArgs(d)
 		if buildErr != nil {
 			return buildErr
 		}
-		args := slb.CreateLoadBalancerHTTPSListenerArgs(slb.HTTPSListenerType{
-			HTTPListenerType:    httpType,
-			ServerCertificateId: ssl_id.(string),
-		})
-		err = slbconn.CreateLoadBalancerHTTPSListener(&args)
+		args = httpArgs
+		args.ServerCertificateId = ssl_id.(string)
+		setListenerExtension(d, &args, true)
+		request, response = slb.CreateCreateLoadBalancerHTTPSListenerRequest(), slb.CreateCreateLoadBalancerHTTPSListenerResponse()
 	case Tcp:
-		args := buildTcpListenerArgs(d)
-		err = slbconn.CreateLoadBalancerTCPListener(&args)
+		args = buildTcpListenerArgs(d)
+		request, response = slb.CreateCreateLoadBalancerTCPListenerRequest(), slb.CreateCreateLoadBalancerTCPListenerResponse()
 	case Udp:
-		args := buildUdpListenerArgs(d)
-		err = slbconn.CreateLoadBalancerUDPListener(&args)
+		args = buildUdpListenerArgs(d)
+		request, response = slb.CreateCreateLoadBalancerUDPListenerRequest(), slb.CreateCreateLoadBalancerUDPListenerResponse()
 	default:
-		httpType, buildErr := buildHttpListenerType(d)
+		httpArgs, buildErr := buildHttpListenerArgs(d)
 		if buildErr != nil {
 			return buildErr
 		}
-		args := slb.CreateLoadBalancerHTTPListenerArgs(httpType)
-		err = slbconn.CreateLoadBalancerHTTPListener(&args)
+		args = httpArgs
+		setListenerExtension(d, &args, false)
+		request, response = slb.CreateCreateLoadBalancerHTTPListenerRequest(), slb.CreateCreateLoadBalancerHTTPListenerResponse()
 	}
 
-	if err != nil {
+	setListenerRequest(request, args)
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
 		if IsExceptedError(err, ListenerAlreadyExists) {
 			return fmt.Errorf("The listener with the frontend port %d already exists. Please define a new 'alicloud_slb_listener' resource and "+
 				"use ID '%s:%d' to import it or modify its frontend port and then try again.", frontend, lb_id, frontend)
 		}
-		return fmt.Errorf("Create %s Listener got an error: %#v", protocol, err)
+		return WrapApiError(err, request.GetActionName(), lb_id)
 	}
 
 	d.SetId(lb_id + ":" + strconv.Itoa(frontend))
 
-	if err := slbconn.WaitForListenerAsyn(lb_id, frontend, slb.ListenerType(protocol), slb.Stopped, DefaultTimeout); err != nil {
-		return fmt.Errorf("WaitForListener %s got error: %#v", slb.Stopped, err)
+	if err := client.WaitForSlbListener(lb_id, protocol, frontend, SlbListenerStopped, DefaultTimeout); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForSlbListener %s got an error", SlbListenerStopped), "", d.Id())
 	}
 
-	if err := slbconn.StartLoadBalancerListener(lb_id, frontend); err != nil {
-		return err
+	start := slb.CreateStartLoadBalancerListenerRequest()
+	start.RegionId = string(client.Region)
+	start.LoadBalancerId = lb_id
+	start.ListenerPort = requests.NewInteger(frontend)
+	if err := client.doAction(client.slbconn(), start, slb.CreateStartLoadBalancerListenerResponse()); err != nil {
+		return WrapApiError(err, start.GetActionName(), d.Id())
 	}
 
-	if err := slbconn.WaitForListenerAsyn(lb_id, frontend, slb.ListenerType(protocol), slb.Running, DefaultTimeout); err != nil {
-		return fmt.Errorf("WaitForListener %s got error: %#v", slb.Running, err)
+	if err := client.WaitForSlbListener(lb_id, protocol, frontend, SlbListenerRunning, DefaultTimeout); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForSlbListener %s got an error", SlbListenerRunning), "", d.Id())
 	}
 
 	return resourceAliyunSlbListenerUpdate(d, meta)
 }
 
 func resourceAliyunSlbListenerRead(d *schema.ResourceData, meta interface{}) error {
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
 	lb_id, protocol, port, err := parseListenerId(d, meta)
 	if err != nil {
-		return fmt.Errorf("Get slb listener got an error: %#v", err)
+		return WrapError(err)
 	}
 
 	if protocol == "" {
@@ -296,39 +339,38 @@ func resourceAliyunSlbListenerRead(d *schema.ResourceData, meta interface{}) err
 
 	switch Protocol(protocol) {
 	case Https:
-		https_ls, err := slbconn.DescribeLoadBalancerHTTPSListenerAttribute(lb_id, port)
+		https_ls, err := client.DescribeSlbHTTPSListener(lb_id, port)
 		return readListenerAttribute(d, protocol, https_ls, err)
 	case Tcp:
-		tcp_ls, err := slbconn.DescribeLoadBalancerTCPListenerAttribute(lb_id, port)
+		tcp_ls, err := client.DescribeSlbTCPListener(lb_id, port)
 		return readListenerAttribute(d, protocol, tcp_ls, err)
 	case Udp:
-		udp_ls, err := slbconn.DescribeLoadBalancerUDPListenerAttribute(lb_id, port)
+		udp_ls, err := client.DescribeSlbUDPListener(lb_id, port)
 		return readListenerAttribute(d, protocol, udp_ls, err)
 	default:
-		http_ls, err := slbconn.DescribeLoadBalancerHTTPListenerAttribute(lb_id, port)
+		http_ls, err := client.DescribeSlbHTTPListener(lb_id, port)
 		return readListenerAttribute(d, protocol, http_ls, err)
 	}
 }
 
 func resourceAliyunSlbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
 	protocol := Protocol(d.Get("protocol").(string))
 
 	d.Partial(true)
 
-	httpType, err := buildHttpListenerType(d)
+	httpArgs, err := buildHttpListenerArgs(d)
 	if (protocol == Https || protocol == Http) && err != nil {
 		return err
 	}
-	tcpArgs := slb.SetLoadBalancerTCPListenerAttributeArgs(buildTcpListenerArgs(d))
-	udpArgs := slb.SetLoadBalancerUDPListenerAttributeArgs(buildUdpListenerArgs(d))
-	httpsArgs := slb.SetLoadBalancerHTTPSListenerAttributeArgs(slb.CreateLoadBalancerHTTPSListenerArgs(slb.HTTPSListenerType{}))
+	tcpArgs := buildTcpListenerArgs(d)
+	udpArgs := buildUdpListenerArgs(d)
 
 	update := false
 	if d.HasChange("scheduler") {
-		scheduler := slb.SchedulerType(d.Get("scheduler").(string))
-		httpType.Scheduler = scheduler
+		scheduler := d.Get("scheduler").(string)
+		httpArgs.Scheduler = scheduler
 		tcpArgs.Scheduler = scheduler
 		udpArgs.Scheduler = scheduler
 		d.SetPartial("scheduler")
@@ -337,7 +379,7 @@ func resourceAliyunSlbListenerUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if d.HasChange("server_group_id") {
 		groupId := d.Get("server_group_id").(string)
-		httpType.VServerGroupId = groupId
+		httpArgs.VServerGroupId = groupId
 		tcpArgs.VServerGroupId = groupId
 		udpArgs.VServerGroupId = groupId
 		d.SetPartial("server_group_id")
@@ -371,7 +413,7 @@ func resourceAliyunSlbListenerUpdate(d *schema.ResourceData, meta interface{}) e
 	// http https tcp
 	if d.HasChange("health_check_domain") {
 		if domain, ok := d.GetOk("health_check_domain"); ok {
-			httpType.HealthCheckDomain = domain.(string)
+			httpArgs.HealthCheckDomain = domain.(string)
 			tcpArgs.HealthCheckDomain = domain.(string)
 			d.SetPartial("health_check_domain")
 			update = true
@@ -383,7 +425,7 @@ func resourceAliyunSlbListenerUpdate(d *schema.ResourceData, meta interface{}) e
 		update = true
 	}
 	if d.HasChange("health_check_http_code") {
-		tcpArgs.HealthCheckHttpCode = slb.HealthCheckHttpCodeType(d.Get("health_check_http_code").(string))
+		tcpArgs.HealthCheckHttpCode = d.Get("health_check_http_code").(string)
 		d.SetPartial("health_check_http_code")
 		update = true
 	}
@@ -416,7 +458,7 @@ func resourceAliyunSlbListenerUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 	if d.HasChange("health_check_connect_port") {
 		if port, ok := d.GetOk("health_check_connect_port"); ok {
-			httpType.HealthCheckConnectPort = port.(int)
+			httpArgs.HealthCheckConnectPort = port.(int)
 			tcpArgs.HealthCheckConnectPort = port.(int)
 			udpArgs.HealthCheckConnectPort = port.(int)
 			d.SetPartial("health_check_connect_port")
@@ -434,7 +476,7 @@ func resourceAliyunSlbListenerUpdate(d *schema.ResourceData, meta interface{}) e
 
 	// tcp
 	if d.HasChange("health_check_type") {
-		tcpArgs.HealthCheckType = slb.HealthCheckType(d.Get("health_check_type").(string))
+		tcpArgs.HealthCheckType = d.Get("health_check_type").(string)
 		d.SetPartial("health_check_type")
 		update = true
 	}
@@ -446,33 +488,47 @@ func resourceAliyunSlbListenerUpdate(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("'ssl_certificate_id': required field is not set when the protocol is 'https'.")
 		}
 
-		httpsArgs.ServerCertificateId = ssl_id.(string)
+		httpArgs.ServerCertificateId = ssl_id.(string)
 		if d.HasChange("ssl_certificate_id") {
 			d.SetPartial("ssl_certificate_id")
 			update = true
 		}
 	}
 
+	// http https
+	if d.HasChange("idle_timeout") || d.HasChange("request_timeout") || d.HasChange("tls_cipher_policy") || d.HasChange("enable_http2") ||
+		d.HasChange("ca_certificate_id") {
+		d.SetPartial("idle_timeout")
+		d.SetPartial("request_timeout")
+		d.SetPartial("tls_cipher_policy")
+		d.SetPartial("enable_http2")
+		d.SetPartial("ca_certificate_id")
+		update = true
+	}
+
 	if update {
+		var args slbListenerArgs
+		var request requests.AcsRequest
+		var response responses.AcsResponse
 		switch protocol {
 		case Https:
-			httpsArgs.HTTPListenerType = httpType
-			if err := slbconn.SetLoadBalancerHTTPSListenerAttribute(&httpsArgs); err != nil {
-				return fmt.Errorf("SetHTTPSListenerAttribute got an error: %#v", err)
-			}
+			args = httpArgs
+			setListenerExtension(d, &args, true)
+			request, response = slb.CreateSetLoadBalancerHTTPSListenerAttributeRequest(), slb.CreateSetLoadBalancerHTTPSListenerAttributeResponse()
 		case Tcp:
-			if err := slbconn.SetLoadBalancerTCPListenerAttribute(&tcpArgs); err != nil {
-				return fmt.Errorf("SetTCPListenerAttribute got an error: %#v", err)
-			}
+			args = tcpArgs
+			request, response = slb.CreateSetLoadBalancerTCPListenerAttributeRequest(), slb.CreateSetLoadBalancerTCPListenerAttributeResponse()
 		case Udp:
-			if err := slbconn.SetLoadBalancerUDPListenerAttribute(&udpArgs); err != nil {
-				return fmt.Errorf("SetTCPListenerAttribute got an error: %#v", err)
-			}
+			args = udpArgs
+			request, response = slb.CreateSetLoadBalancerUDPListenerAttributeRequest(), slb.CreateSetLoadBalancerUDPListenerAttributeResponse()
 		default:
-			httpArgs := slb.SetLoadBalancerHTTPListenerAttributeArgs(slb.CreateLoadBalancerHTTPListenerArgs(httpType))
-			if err := slbconn.SetLoadBalancerHTTPListenerAttribute(&httpArgs); err != nil {
-				return fmt.Errorf("SetHTTPListenerAttribute got an error: %#v", err)
-			}
+			args = httpArgs
+			setListenerExtension(d, &args, false)
+			request, response = slb.CreateSetLoadBalancerHTTPListenerAttributeRequest(), slb.CreateSetLoadBalancerHTTPListenerAttributeResponse()
+		}
+		setListenerRequest(request, args)
+		if err := client.doAction(client.slbconn(), request, response); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 	}
 
@@ -482,128 +538,163 @@ func resourceAliyunSlbListenerUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAliyunSlbListenerDelete(d *schema.ResourceData, meta interface{}) error {
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
 	lb_id, protocol, port, err := parseListenerId(d, meta)
 	if err != nil {
-		return fmt.Errorf("Get slb listener got an error: %#v", err)
+		return WrapError(err)
 	}
 
 	if protocol == "" {
 		d.SetId("")
 		return nil
 	}
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := slbconn.DeleteLoadBalancerListener(lb_id, port)
-
-		if err != nil {
-			if IsExceptedError(err, SystemBusy) {
-				resource.RetryableError(fmt.Errorf("Delete load balancer listener timeout and got an error: %#v.", err))
-			}
-			return resource.NonRetryableError(err)
-		}
+	request := slb.CreateDeleteLoadBalancerListenerRequest()
+	request.RegionId = string(client.Region)
+	request.LoadBalancerId = lb_id
+	request.ListenerPort = requests.NewInteger(port)
+	if err := RetryOnError(SlbCode, 5*time.Minute, func() error {
+		return client.doAction(client.slbconn(), request, slb.CreateDeleteLoadBalancerListenerResponse())
+	}); err != nil {
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
 
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		switch Protocol(protocol) {
 		case Https:
-			https_ls, err := slbconn.DescribeLoadBalancerHTTPSListenerAttribute(lb_id, port)
+			https_ls, err := client.DescribeSlbHTTPSListener(lb_id, port)
 			return ensureListenerAbsent(d, protocol, https_ls, err)
 		case Tcp:
-			tcp_ls, err := slbconn.DescribeLoadBalancerTCPListenerAttribute(lb_id, port)
+			tcp_ls, err := client.DescribeSlbTCPListener(lb_id, port)
 			return ensureListenerAbsent(d, protocol, tcp_ls, err)
 		case Udp:
-			udp_ls, err := slbconn.DescribeLoadBalancerUDPListenerAttribute(lb_id, port)
+			udp_ls, err := client.DescribeSlbUDPListener(lb_id, port)
 			return ensureListenerAbsent(d, protocol, udp_ls, err)
 		default:
-			http_ls, err := slbconn.DescribeLoadBalancerHTTPListenerAttribute(lb_id, port)
+			http_ls, err := client.DescribeSlbHTTPListener(lb_id, port)
 			return ensureListenerAbsent(d, protocol, http_ls, err)
 		}
 	})
 }
 
-func buildHttpListenerType(d *schema.ResourceData) (slb.HTTPListenerType, error) {
+func buildHttpListenerArgs(d *schema.ResourceData) (slbListenerArgs, error) {
 
-	httpType := slb.HTTPListenerType{
+	httpArgs := slbListenerArgs{
 		LoadBalancerId:    d.Get("load_balancer_id").(string),
 		ListenerPort:      d.Get("frontend_port").(int),
 		BackendServerPort: d.Get("backend_port").(int),
 		Bandwidth:         d.Get("bandwidth").(int),
-		StickySession:     slb.FlagType(d.Get("sticky_session").(string)),
-		HealthCheck:       slb.FlagType(d.Get("health_check").(string)),
+		StickySession:     d.Get("sticky_session").(string),
+		HealthCheck:       d.Get("health_check").(string),
 		VServerGroupId:    d.Get("server_group_id").(string),
 	}
 
-	if httpType.StickySession == slb.OnFlag {
+	if httpArgs.StickySession == SlbOnFlag {
 		if sessionType, ok := d.GetOk("sticky_session_type"); !ok || sessionType.(string) == "" {
-			return httpType, fmt.Errorf("'sticky_session_type': required field is not set when the StickySession is %s.", slb.OnFlag)
+			return httpArgs, fmt.Errorf("'sticky_session_type': required field is not set when the StickySession is %s.", SlbOnFlag)
 		} else {
-			httpType.StickySessionType = slb.StickySessionType(sessionType.(string))
+			httpArgs.StickySessionType = sessionType.(string)
 
 		}
-		if httpType.StickySessionType == slb.InsertStickySessionType {
+		if httpArgs.StickySessionType == SlbInsertStickySessionType {
 			if timeout, ok := d.GetOk("cookie_timeout"); !ok || timeout == 0 {
-				return httpType, fmt.Errorf("'cookie_timeout': required field is not set when the StickySession is %s "+
-					"and StickySessionType is %s.", slb.OnFlag, slb.InsertStickySessionType)
+				return httpArgs, fmt.Errorf("'cookie_timeout': required field is not set when the StickySession is %s "+
+					"and StickySessionType is %s.", SlbOnFlag, SlbInsertStickySessionType)
 			} else {
-				httpType.CookieTimeout = timeout.(int)
+				httpArgs.CookieTimeout = timeout.(int)
 			}
 		} else {
 			if cookie, ok := d.GetOk("cookie"); !ok || cookie.(string) == "" {
-				return httpType, fmt.Errorf("'cookie': required field is not set when the StickySession is %s "+
-					"and StickySessionType is %s.", slb.OnFlag, slb.ServerStickySessionType)
+				return httpArgs, fmt.Errorf("'cookie': required field is not set when the StickySession is %s "+
+					"and StickySessionType is %s.", SlbOnFlag, SlbServerStickySessionType)
 			} else {
-				httpType.Cookie = cookie.(string)
+				httpArgs.Cookie = cookie.(string)
 			}
 		}
 	}
-	if httpType.HealthCheck == slb.OnFlag {
-		httpType.HealthCheckURI = d.Get("health_check_uri").(string)
+	if httpArgs.HealthCheck == SlbOnFlag {
+		httpArgs.HealthCheckURI = d.Get("health_check_uri").(string)
 		if port, ok := d.GetOk("health_check_connect_port"); !ok || port.(int) == 0 {
-			return httpType, fmt.Errorf("'health_check_connect_port': required field is not set when the HealthCheck is %s.", slb.OnFlag)
+			return httpArgs, fmt.Errorf("'health_check_connect_port': required field is not set when the HealthCheck is %s.", SlbOnFlag)
 		} else {
-			httpType.HealthCheckConnectPort = port.(int)
+			httpArgs.HealthCheckConnectPort = port.(int)
 		}
-		httpType.HealthyThreshold = d.Get("healthy_threshold").(int)
-		httpType.UnhealthyThreshold = d.Get("unhealthy_threshold").(int)
-		httpType.HealthCheckTimeout = d.Get("health_check_timeout").(int)
-		httpType.HealthCheckInterval = d.Get("health_check_interval").(int)
-		httpType.HealthCheckHttpCode = slb.HealthCheckHttpCodeType(d.Get("health_check_http_code").(string))
+		httpArgs.HealthyThreshold = d.Get("healthy_threshold").(int)
+		httpArgs.UnhealthyThreshold = d.Get("unhealthy_threshold").(int)
+		httpArgs.HealthCheckTimeout = d.Get("health_check_timeout").(int)
+		httpArgs.HealthCheckInterval = d.Get("health_check_interval").(int)
+		httpArgs.HealthCheckHttpCode = d.Get("health_check_http_code").(string)
 	}
-	return httpType, nil
+	return httpArgs, nil
 }
 
-func buildTcpListenerArgs(d *schema.ResourceData) slb.CreateLoadBalancerTCPListenerArgs {
+// setListenerExtension sets the timeouts of the HTTP and HTTPS listeners. The TLS cipher policy, HTTP/2 and the CA
+// certificate are only set for an HTTPS listener.
+func setListenerExtension(d *schema.ResourceData, args *slbListenerArgs, https bool) {
+	args.IdleTimeout = d.Get("idle_timeout").(int)
+	args.RequestTimeout = d.Get("request_timeout").(int)
+	if https {
+		args.TLSCipherPolicy = d.Get("tls_cipher_policy").(string)
+		args.EnableHttp2 = d.Get("enable_http2").(string)
+		args.CACertificateId = d.Get("ca_certificate_id").(string)
+	}
+}
 
-	return slb.CreateLoadBalancerTCPListenerArgs(slb.TCPListenerType{
+func buildTcpListenerArgs(d *schema.ResourceData) slbListenerArgs {
+
+	return slbListenerArgs{
 		LoadBalancerId:    d.Get("load_balancer_id").(string),
 		ListenerPort:      d.Get("frontend_port").(int),
 		BackendServerPort: d.Get("backend_port").(int),
 		Bandwidth:         d.Get("bandwidth").(int),
 		VServerGroupId:    d.Get("server_group_id").(string),
-	})
+	}
 }
-func buildUdpListenerArgs(d *schema.ResourceData) slb.CreateLoadBalancerUDPListenerArgs {
+func buildUdpListenerArgs(d *schema.ResourceData) slbListenerArgs {
 
-	return slb.CreateLoadBalancerUDPListenerArgs(slb.UDPListenerType{
+	return slbListenerArgs{
 		LoadBalancerId:    d.Get("load_balancer_id").(string),
 		ListenerPort:      d.Get("frontend_port").(int),
 		BackendServerPort: d.Get("backend_port").(int),
 		Bandwidth:         d.Get("bandwidth").(int),
 		VServerGroupId:    d.Get("server_group_id").(string),
-	})
+	}
+}
+
+// setListenerRequest sets the arguments to the fields of the same names of the request, which creates or modifies a
+// listener. The arguments without such a field are not supported by the protocol of the listener, and the integers
+// which are zero are not set, so that they are not sent.
+func setListenerRequest(request requests.AcsRequest, args slbListenerArgs) {
+	v := reflect.ValueOf(request).Elem()
+	a := reflect.ValueOf(args)
+	for i := 0; i < a.NumField(); i++ {
+		field := v.FieldByName(a.Type().Field(i).Name)
+		if !field.IsValid() {
+			continue
+		}
+		switch value := a.Field(i).Interface().(type) {
+		case int:
+			if value != 0 {
+				field.SetString(strconv.Itoa(value))
+			}
+		case string:
+			field.SetString(value)
+		}
+	}
 }
 
 func parseListenerId(d *schema.ResourceData, meta interface{}) (string, string, int, error) {
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
 	parts := strings.Split(d.Id(), ":")
 	port, err := strconv.Atoi(parts[1])
 	if err != nil {
-		return "", "", 0, fmt.Errorf("Parsing SlbListener's id got an error: %#v", err)
+		return "", "", 0, WrapErrorf(err, "Parsing SlbListener's id %s got an error", d.Id())
 	}
-	loadBalancer, err := slbconn.DescribeLoadBalancerAttribute(parts[0])
+	loadBalancer, err := client.DescribeLoadBalancerAttribute(parts[0])
 	if err != nil {
 		if IsExceptedError(err, LoadBalancerNotFound) {
 			return "", "", 0, nil
 		}
-		return "", "", 0, fmt.Errorf("DescribeLoadBalancerAttribute got an error: %#v", parts[0])
+		return "", "", 0, WrapApiError(err, "DescribeLoadBalancerAttribute", parts[0])
 	}
 	for _, portAndProtocol := range loadBalancer.ListenerPortsAndProtocol.ListenerPortAndProtocol {
 		if portAndProtocol.ListenerPort == port {
@@ -612,7 +703,6 @@ func parseListenerId(d *schema.ResourceData, meta interface{}) (string, string,
 	}
 	return "", "", 0, nil
 }
-
 func readListenerAttribute(d *schema.ResourceData, protocol string, listen interface{}, err error) error {
 	v := reflect.ValueOf(listen).Elem()
 
@@ -621,7 +711,7 @@ func readListenerAttribute(d *schema.ResourceData, protocol string, listen inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("DescribeLoadBalancer%sListenerAttribute got an error: %#v", strings.ToUpper(protocol), err)
+		return WrapApiError(err, fmt.Sprintf("DescribeLoadBalancer%sListenerAttribute", strings.ToUpper(protocol)), d.Id())
 	}
 	if port := v.FieldByName("ListenerPort"); port.IsValid() && port.Interface().(int) > 0 {
 		readListener(d, listen)
@@ -644,19 +734,19 @@ func readListener(d *schema.ResourceData, listen interface{}) {
 		d.Set("bandwidth", val.Interface().(int))
 	}
 	if val := v.FieldByName("Scheduler"); val.IsValid() {
-		d.Set("scheduler", string(val.Interface().(slb.SchedulerType)))
+		d.Set("scheduler", val.Interface().(string))
 	}
 	if val := v.FieldByName("VServerGroupId"); val.IsValid() {
-		d.Set("server_group_id", string(val.Interface().(string)))
+		d.Set("server_group_id", val.Interface().(string))
 	}
 	if val := v.FieldByName("HealthCheck"); val.IsValid() {
-		d.Set("health_check", string(val.Interface().(slb.FlagType)))
+		d.Set("health_check", val.Interface().(string))
 	}
 	if val := v.FieldByName("StickySession"); val.IsValid() {
-		d.Set("sticky_session", string(val.Interface().(slb.FlagType)))
+		d.Set("sticky_session", val.Interface().(string))
 	}
 	if val := v.FieldByName("StickySessionType"); val.IsValid() {
-		d.Set("sticky_session_type", string(val.Interface().(slb.StickySessionType)))
+		d.Set("sticky_session_type", val.Interface().(string))
 	}
 	if val := v.FieldByName("CookieTimeout"); val.IsValid() {
 		d.Set("cookie_timeout", val.Interface().(int))
@@ -668,7 +758,7 @@ func readListener(d *schema.ResourceData, listen interface{}) {
 		d.Set("persistence_timeout", val.Interface().(int))
 	}
 	if val := v.FieldByName("HealthCheckType"); val.IsValid() {
-		d.Set("health_check_type", string(val.Interface().(slb.HealthCheckType)))
+		d.Set("health_check_type", val.Interface().(string))
 	}
 	if val := v.FieldByName("HealthCheckDomain"); val.IsValid() {
 		d.Set("health_check_domain", val.Interface().(string))
@@ -695,11 +785,26 @@ func readListener(d *schema.ResourceData, listen interface{}) {
 		d.Set("health_check_interval", val.Interface().(int))
 	}
 	if val := v.FieldByName("HealthCheckHttpCode"); val.IsValid() {
-		d.Set("health_check_http_code", string(val.Interface().(slb.HealthCheckHttpCodeType)))
+		d.Set("health_check_http_code", val.Interface().(string))
 	}
 	if val := v.FieldByName("ServerCertificateId"); val.IsValid() {
 		d.Set("ssl_certificate_id", val.Interface().(string))
 	}
+	if val := v.FieldByName("IdleTimeout"); val.IsValid() {
+		d.Set("idle_timeout", val.Interface().(int))
+	}
+	if val := v.FieldByName("RequestTimeout"); val.IsValid() {
+		d.Set("request_timeout", val.Interface().(int))
+	}
+	if val := v.FieldByName("TLSCipherPolicy"); val.IsValid() {
+		d.Set("tls_cipher_policy", val.Interface().(string))
+	}
+	if val := v.FieldByName("EnableHttp2"); val.IsValid() {
+		d.Set("enable_http2", val.Interface().(string))
+	}
+	if val := v.FieldByName("CACertificateId"); val.IsValid() {
+		d.Set("ca_certificate_id", val.Interface().(string))
+	}
 
 	return
 }
@@ -712,10 +817,10 @@ func ensureListenerAbsent(d *schema.ResourceData, protocol string, listen interf
 			d.SetId("")
 			return nil
 		}
-		return resource.NonRetryableError(fmt.Errorf("While deleting listener, DescribeLoadBalancer%sListenerAttribute got an error: %#v", protocol, err))
+		return resource.NonRetryableError(WrapApiError(err, fmt.Sprintf("DescribeLoadBalancer%sListenerAttribute", strings.ToUpper(protocol)), d.Id()))
 	}
 	if port := v.FieldByName("ListenerPort"); port.IsValid() && port.Interface().(int) > 0 {
-		return resource.RetryableError(fmt.Errorf("Delete load balancer listener timeout and got an error: %#v.", err))
+		return resource.RetryableError(fmt.Errorf("Delete load balancer listener %s timeout.", d.Id()))
 	}
 	d.SetId("")
 	return nil
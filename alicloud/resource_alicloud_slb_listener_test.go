@@ -6,10 +6,50 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestSlbHTTPSListenerArgs(t *testing.T) {
+	args := slbListenerArgs{
+		LoadBalancerId:      "lb-abc",
+		ListenerPort:        443,
+		ServerCertificateId: "cert-abc",
+		TLSCipherPolicy:     TlsCipherPolicy12,
+		EnableHttp2:         SlbOnFlag,
+		IdleTimeout:         30,
+		CACertificateId:     "ca-abc",
+	}
+	request := slb.CreateCreateLoadBalancerHTTPSListenerRequest()
+	setListenerRequest(request, args)
+	values := map[string]string{
+		"LoadBalancerId":      request.LoadBalancerId,
+		"ListenerPort":        string(request.ListenerPort),
+		"ServerCertificateId": request.ServerCertificateId,
+		"TLSCipherPolicy":     request.TLSCipherPolicy,
+		"EnableHttp2":         request.EnableHttp2,
+		"IdleTimeout":         string(request.IdleTimeout),
+		"CACertificateId":     request.CACertificateId,
+	}
+	for k, v := range map[string]string{
+		"LoadBalancerId":      "lb-abc",
+		"ListenerPort":        "443",
+		"ServerCertificateId": "cert-abc",
+		"TLSCipherPolicy":     TlsCipherPolicy12,
+		"EnableHttp2":         "on",
+		"IdleTimeout":         "30",
+		"CACertificateId":     "ca-abc",
+	} {
+		if values[k] != v {
+			t.Fatalf("Expected the parameter %s is %s, got %s.", k, v, values[k])
+		}
+	}
+	if request.RequestTimeout != "" {
+		t.Fatalf("Expected the unset RequestTimeout is not sent.")
+	}
+}
+
 func TestAccAlicloudSlbListener_http(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -31,6 +71,10 @@ func TestAccAlicloudSlbListener_http(t *testing.T) {
 						"alicloud_slb_listener.http", "backend_port", "80"),
 					resource.TestCheckResourceAttr(
 						"alicloud_slb_listener.http", "health_check", "on"),
+					resource.TestCheckResourceAttr(
+						"alicloud_slb_listener.http", "idle_timeout", "30"),
+					resource.TestCheckResourceAttr(
+						"alicloud_slb_listener.http", "request_timeout", "80"),
 				),
 			},
 		},
@@ -175,6 +219,8 @@ resource "alicloud_slb_listener" "http" {
   health_check_interval = 5
   health_check_http_code = "http_2xx,http_3xx"
   bandwidth = 10
+  idle_timeout = 30
+  request_timeout = 80
 }
 `
 
@@ -2,10 +2,12 @@ package alicloud
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/denverdino/aliyungo/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -84,12 +86,12 @@ func resourceAliyunSlbRuleCreate(d *schema.ResourceData, meta interface{}) error
 		rule = fmt.Sprintf("[{'RuleName':'%s','Domain':'%s','Url':'%s','VServerGroupId':'%s'}]", name, domain, url, group_id)
 	}
 
-	if err := client.slbconn.CreateRules(&slb.CreateRulesArgs{
-		RegionId:       getRegion(d, meta),
-		LoadBalancerId: slb_id,
-		ListenerPort:   port,
-		RuleList:       rule,
-	}); err != nil {
+	request := slb.CreateCreateRulesRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.LoadBalancerId = slb_id
+	request.ListenerPort = requests.NewInteger(port)
+	request.RuleList = rule
+	if err := client.doAction(client.slbconn(), request, slb.CreateCreateRulesResponse()); err != nil {
 		if IsExceptedError(err, RuleDomainExist) {
 			if ruleId, err := client.DescribeLoadBalancerRuleId(slb_id, port, domain, url); err != nil {
 				return err
@@ -98,7 +100,7 @@ func resourceAliyunSlbRuleCreate(d *schema.ResourceData, meta interface{}) error
 					"Please import it using ID '%s' to import it or specify a different 'domain' or 'url' and then try again.", ruleId)
 			}
 		}
-		return fmt.Errorf("CreateRule got an error: %#v", err)
+		return WrapApiError(err, request.GetActionName(), slb_id)
 	}
 
 	ruleId, err := client.DescribeLoadBalancerRuleId(slb_id, port, domain, url)
@@ -117,22 +119,20 @@ func resourceAliyunSlbRuleCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAliyunSlbRuleRead(d *schema.ResourceData, meta interface{}) error {
 
-	rule, err := meta.(*AliyunClient).slbconn.DescribeRuleAttribute(&slb.DescribeRuleAttributeArgs{
-		RegionId: getRegion(d, meta),
-		RuleId:   d.Id(),
-	})
+	rule, err := meta.(*AliyunClient).DescribeSlbRule(d.Id())
 
 	if err != nil {
 		if IsExceptedError(err, InvalidRuleIdNotFound) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("DescribeRuleAttribute got an error: %#v", err)
+		return WrapApiError(err, "DescribeRuleAttribute", d.Id())
 	}
 
 	d.Set("name", rule.RuleName)
 	d.Set("load_balancer_id", rule.LoadBalancerId)
-	d.Set("frontend_port", rule.ListenerPort)
+	port, _ := strconv.Atoi(rule.ListenerPort)
+	d.Set("frontend_port", port)
 	d.Set("domain", rule.Domain)
 	d.Set("url", rule.Url)
 	d.Set("server_group_id", rule.VServerGroupId)
@@ -145,12 +145,13 @@ func resourceAliyunSlbRuleUpdate(d *schema.ResourceData, meta interface{}) error
 	d.Partial(true)
 
 	if d.HasChange("server_group_id") && !d.IsNewResource() {
-		if err := meta.(*AliyunClient).slbconn.SetRule(&slb.SetRuleArgs{
-			RegionId:       getRegion(d, meta),
-			RuleId:         d.Id(),
-			VServerGroupId: d.Get("server_group_id").(string),
-		}); err != nil {
-			return fmt.Errorf("Modify rule %s server group got an error: %#v", d.Id(), err)
+		client := meta.(*AliyunClient)
+		request := slb.CreateSetRuleRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.RuleId = d.Id()
+		request.VServerGroupId = d.Get("server_group_id").(string)
+		if err := client.doAction(client.slbconn(), request, slb.CreateSetRuleResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 		d.SetPartial("server_group_id")
 	}
@@ -161,34 +162,27 @@ func resourceAliyunSlbRuleUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAliyunSlbRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
+	request := slb.CreateDeleteRulesRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.RuleIds = fmt.Sprintf("['%s']", d.Id())
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if err := slbconn.DeleteRules(&slb.DeleteRulesArgs{
-			RegionId: getRegion(d, meta),
-			RuleIds:  fmt.Sprintf("['%s']", d.Id()),
-		}); err != nil {
-			if IsExceptedError(err, InvalidRuleIdNotFound) {
-				return nil
-			}
-			return resource.NonRetryableError(err)
+	if err := RetryOnError(SlbCode, 5*time.Minute, func() error {
+		return client.doAction(client.slbconn(), request, slb.CreateDeleteRulesResponse())
+	}); err != nil {
+		if IsExceptedError(err, InvalidRuleIdNotFound) {
+			return nil
 		}
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
 
-		rule, err := meta.(*AliyunClient).slbconn.DescribeRuleAttribute(&slb.DescribeRuleAttributeArgs{
-			RegionId: getRegion(d, meta),
-			RuleId:   d.Id(),
-		})
-
-		if err != nil {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if _, err := client.DescribeSlbRule(d.Id()); err != nil {
 			if IsExceptedError(err, InvalidRuleIdNotFound) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("While deleting rule, DescribeRuleAttribute got an error: %#v", err))
-		}
-
-		if rule != nil {
-			return resource.RetryableError(fmt.Errorf("DeleteRule got an error: %#v", err))
+			return resource.NonRetryableError(err)
 		}
-		return nil
+		return resource.RetryableError(fmt.Errorf("Delete rule %s timeout.", d.Id()))
 	})
 }
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/denverdino/aliyungo/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -73,16 +73,10 @@ func testAccCheckSlbRuleExists(n string, rule *slb.DescribeRuleAttributeResponse
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		r, err := client.slbconn.DescribeRuleAttribute(&slb.DescribeRuleAttributeArgs{
-			RegionId: client.Region,
-			RuleId:   rs.Primary.ID,
-		})
+		r, err := client.DescribeSlbRule(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("DescribeRuleAttribute got an error: %#v", err)
 		}
-		if r == nil {
-			return fmt.Errorf("Specified Rule not found")
-		}
 
 		*rule = *r
 
@@ -99,18 +93,11 @@ func testAccCheckSlbRuleDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the Slb server group
-		group, err := client.slbconn.DescribeRuleAttribute(&slb.DescribeRuleAttributeArgs{
-			RegionId: client.Region,
-			RuleId:   rs.Primary.ID,
-		})
-		if err != nil {
+		if _, err := client.DescribeSlbRule(rs.Primary.ID); err != nil {
 			if IsExceptedError(err, InvalidRuleIdNotFound) {
 				return nil
 			}
 			return fmt.Errorf("DescribeRuleAttribute got an error: %#v", err)
-		}
-		if group != nil {
-
 		}
 		return fmt.Errorf("SLB Rule still exist")
 	}
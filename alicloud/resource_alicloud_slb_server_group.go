@@ -9,7 +9,7 @@ import (
 
 	"bytes"
 
-	"github.com/denverdino/aliyungo/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -80,37 +80,31 @@ func resourceAliyunSlbServerGroup() *schema.Resource {
 
 func resourceAliyunSlbServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
 
-	var groupId string
-	if group, err := meta.(*AliyunClient).slbconn.CreateVServerGroup(&slb.CreateVServerGroupArgs{
-		RegionId:         getRegion(d, meta),
-		LoadBalancerId:   d.Get("load_balancer_id").(string),
-		VServerGroupName: d.Get("name").(string),
-		BackendServers:   convertServersToString(d.Get("servers").(*schema.Set).List()),
-	}); err != nil {
-		return fmt.Errorf("CreateVServerGroup got an error: %#v", err)
-	} else {
-		groupId = group.VServerGroupId
+	client := meta.(*AliyunClient)
+	request := slb.CreateCreateVServerGroupRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.LoadBalancerId = d.Get("load_balancer_id").(string)
+	request.VServerGroupName = d.Get("name").(string)
+	request.BackendServers = convertServersToString(d.Get("servers").(*schema.Set).List())
+	group := slb.CreateCreateVServerGroupResponse()
+	if err := client.doAction(client.slbconn(), request, group); err != nil {
+		return WrapApiError(err, request.GetActionName(), request.LoadBalancerId)
 	}
 
-	d.SetId(groupId)
+	d.SetId(group.VServerGroupId)
 
 	return resourceAliyunSlbServerGroupUpdate(d, meta)
 }
 
 func resourceAliyunSlbServerGroupRead(d *schema.ResourceData, meta interface{}) error {
-	slbconn := meta.(*AliyunClient).slbconn
-
-	group, err := slbconn.DescribeVServerGroupAttribute(&slb.DescribeVServerGroupAttributeArgs{
-		RegionId:       getRegion(d, meta),
-		VServerGroupId: d.Id(),
-	})
+	group, err := meta.(*AliyunClient).DescribeSlbVServerGroup(d.Id())
 
 	if err != nil {
 		if IsExceptedError(err, VServerGroupNotFoundMessage) || IsExceptedError(err, InvalidParameter) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("DescribeVServerGroupAttribute got an error: %#v", err)
+		return WrapApiError(err, "DescribeVServerGroupAttribute", d.Id())
 	}
 
 	d.Set("name", group.VServerGroupName)
@@ -143,11 +137,10 @@ func resourceAliyunSlbServerGroupRead(d *schema.ResourceData, meta interface{})
 
 func resourceAliyunSlbServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
 
 	d.Partial(true)
 
-	slb_id := d.Get("load_balancer_id").(string)
 	name := d.Get("name").(string)
 	update := false
 
@@ -165,24 +158,22 @@ func resourceAliyunSlbServerGroupUpdate(d *schema.ResourceData, meta interface{}
 
 		if len(remove) > 0 {
 			log.Printf("[INFO] Remove old servers: %#v", remove)
-			if _, err := slbconn.RemoveVServerGroupBackendServers(&slb.RemoveVServerGroupBackendServersArgs{
-				LoadBalancerId: slb_id,
-				RegionId:       getRegion(d, meta),
-				VServerGroupId: d.Id(),
-				BackendServers: convertServersToString(remove),
-			}); err != nil {
-				return fmt.Errorf("RemoveVServerGroupBackendServers got an error: %#v", err)
+			request := slb.CreateRemoveVServerGroupBackendServersRequest()
+			request.RegionId = string(getRegion(d, meta))
+			request.VServerGroupId = d.Id()
+			request.BackendServers = convertServersToString(remove)
+			if err := client.doAction(client.slbconn(), request, slb.CreateRemoveVServerGroupBackendServersResponse()); err != nil {
+				return WrapApiError(err, request.GetActionName(), d.Id())
 			}
 		}
 		if len(add) > 0 {
 			log.Printf("[INFO] Add new servers: %#v", add)
-			if _, err := slbconn.AddVServerGroupBackendServers(&slb.AddVServerGroupBackendServersArgs{
-				LoadBalancerId: slb_id,
-				RegionId:       getRegion(d, meta),
-				VServerGroupId: d.Id(),
-				BackendServers: convertServersToString(add),
-			}); err != nil {
-				return fmt.Errorf("AddVServerGroupBackendServers got an error: %#v", err)
+			request := slb.CreateAddVServerGroupBackendServersRequest()
+			request.RegionId = string(getRegion(d, meta))
+			request.VServerGroupId = d.Id()
+			request.BackendServers = convertServersToString(add)
+			if err := client.doAction(client.slbconn(), request, slb.CreateAddVServerGroupBackendServersResponse()); err != nil {
+				return WrapApiError(err, request.GetActionName(), d.Id())
 			}
 		}
 		if len(add) < 1 && len(remove) < 1 {
@@ -194,14 +185,13 @@ func resourceAliyunSlbServerGroupUpdate(d *schema.ResourceData, meta interface{}
 
 	if update {
 		log.Printf("[INFO] Update attribute: name %s and backend servers %#v", name, d.Get("servers").(*schema.Set).List())
-		if _, err := slbconn.SetVServerGroupAttribute(&slb.SetVServerGroupAttributeArgs{
-			RegionId:         getRegion(d, meta),
-			LoadBalancerId:   slb_id,
-			VServerGroupId:   d.Id(),
-			VServerGroupName: name,
-			BackendServers:   convertServersToString(d.Get("servers").(*schema.Set).List()),
-		}); err != nil {
-			return fmt.Errorf("SetVServerGroupAttribute got an error: %#v", err)
+		request := slb.CreateSetVServerGroupAttributeRequest()
+		request.RegionId = string(getRegion(d, meta))
+		request.VServerGroupId = d.Id()
+		request.VServerGroupName = name
+		request.BackendServers = convertServersToString(d.Get("servers").(*schema.Set).List())
+		if err := client.doAction(client.slbconn(), request, slb.CreateSetVServerGroupAttributeResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 	}
 
@@ -211,36 +201,28 @@ func resourceAliyunSlbServerGroupUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAliyunSlbServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	slbconn := meta.(*AliyunClient).slbconn
+	client := meta.(*AliyunClient)
+	request := slb.CreateDeleteVServerGroupRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.VServerGroupId = d.Id()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := slbconn.DeleteVServerGroup(&slb.DeleteVServerGroupArgs{
-			RegionId:       getRegion(d, meta),
-			VServerGroupId: d.Id(),
-		}); err != nil {
-			if IsExceptedError(err, VServerGroupNotFoundMessage) || IsExceptedError(err, InvalidParameter) {
-				return nil
-			}
-			if IsExceptedError(err, RspoolVipExist) {
-				return resource.RetryableError(fmt.Errorf("DeleteVServerGroup got an error: %#v", err))
-			}
-			return resource.NonRetryableError(err)
+	if err := RetryOnError(SlbCode, 5*time.Minute, func() error {
+		return client.doAction(client.slbconn(), request, slb.CreateDeleteVServerGroupResponse())
+	}); err != nil {
+		if IsExceptedError(err, VServerGroupNotFoundMessage) || IsExceptedError(err, InvalidParameter) {
+			return nil
 		}
+		return WrapApiError(err, request.GetActionName(), d.Id())
+	}
 
-		group, err := slbconn.DescribeVServerGroupAttribute(&slb.DescribeVServerGroupAttributeArgs{
-			RegionId:       getRegion(d, meta),
-			VServerGroupId: d.Id(),
-		})
-		if err != nil {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if _, err := client.DescribeSlbVServerGroup(d.Id()); err != nil {
 			if IsExceptedError(err, VServerGroupNotFoundMessage) || IsExceptedError(err, InvalidParameter) {
 				return nil
 			}
-			return resource.NonRetryableError(fmt.Errorf("While deleting VServer Group, DescribeVServerGroupAttribute got an error: %#v", err))
-		}
-		if group != nil {
-			return resource.RetryableError(fmt.Errorf("DeleteVServerGroup got an error: %#v", err))
+			return resource.NonRetryableError(err)
 		}
-		return nil
+		return resource.RetryableError(fmt.Errorf("Delete VServer Group %s timeout.", d.Id()))
 	})
 }
 
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/denverdino/aliyungo/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -73,16 +73,10 @@ func testAccCheckSlbServerGroupExists(n string, group *slb.DescribeVServerGroupA
 		}
 
 		client := testAccProvider.Meta().(*AliyunClient)
-		gr, err := client.slbconn.DescribeVServerGroupAttribute(&slb.DescribeVServerGroupAttributeArgs{
-			RegionId:       client.Region,
-			VServerGroupId: rs.Primary.ID,
-		})
+		gr, err := client.DescribeSlbVServerGroup(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("DescribeVServerGroupAttribute got an error: %#v", err)
 		}
-		if gr == nil {
-			return fmt.Errorf("Specified VServer Group not found")
-		}
 
 		*group = *gr
 
@@ -99,18 +93,11 @@ func testAccCheckSlbServerGroupDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the Slb server group
-		group, err := client.slbconn.DescribeVServerGroupAttribute(&slb.DescribeVServerGroupAttributeArgs{
-			RegionId:       client.Region,
-			VServerGroupId: rs.Primary.ID,
-		})
-		if err != nil {
+		if _, err := client.DescribeSlbVServerGroup(rs.Primary.ID); err != nil {
 			if IsExceptedError(err, VServerGroupNotFoundMessage) || IsExceptedError(err, InvalidParameter) {
 				return nil
 			}
 			return fmt.Errorf("DescribeVServerGroupAttribute got an error: %#v", err)
-		}
-		if group != nil {
-
 		}
 		return fmt.Errorf("SLB Server Group still exist")
 	}
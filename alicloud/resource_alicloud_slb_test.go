@@ -2,17 +2,52 @@ package alicloud
 
 import (
 	"fmt"
-
 	"log"
 	"testing"
 
-	"github.com/denverdino/aliyungo/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("alicloud_slb", &resource.Sweeper{
+		Name: "alicloud_slb",
+		F:    testSweepSLBs,
+	})
+}
+
+func testSweepSLBs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+
+	request := slb.CreateDescribeLoadBalancersRequest()
+	request.RegionId = string(client.Region)
+	lbs := slb.CreateDescribeLoadBalancersResponse()
+	if err := client.doAction(client.slbconn(), request, lbs); err != nil {
+		return fmt.Errorf("Error retrieving SLBs: %s", err)
+	}
+
+	for _, lb := range lbs.LoadBalancers.LoadBalancer {
+		if !isTestSweepName(lb.LoadBalancerName) {
+			log.Printf("[INFO] Skipping SLB: %s (%s)", lb.LoadBalancerName, lb.LoadBalancerId)
+			continue
+		}
+		log.Printf("[INFO] Deleting SLB: %s (%s)", lb.LoadBalancerName, lb.LoadBalancerId)
+		req := slb.CreateDeleteLoadBalancerRequest()
+		req.RegionId = string(client.Region)
+		req.LoadBalancerId = lb.LoadBalancerId
+		if err := client.doAction(client.slbconn(), req, slb.CreateDeleteLoadBalancerResponse()); err != nil {
+			log.Printf("[ERROR] Failed to delete SLB (%s (%s)): %s", lb.LoadBalancerName, lb.LoadBalancerId, err)
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudSlb_basic(t *testing.T) {
-	var slb slb.LoadBalancerType
+	var slb slb.DescribeLoadBalancerAttributeResponse
 
 	testCheckAttr := func() resource.TestCheckFunc {
 		return func(*terraform.State) error {
@@ -47,7 +82,7 @@ func TestAccAlicloudSlb_basic(t *testing.T) {
 }
 
 func TestAccAlicloudSlb_traffic(t *testing.T) {
-	var slb slb.LoadBalancerType
+	var slb slb.DescribeLoadBalancerAttributeResponse
 
 	testCheckAttr := func() resource.TestCheckFunc {
 		return func(*terraform.State) error {
@@ -81,7 +116,7 @@ func TestAccAlicloudSlb_traffic(t *testing.T) {
 }
 
 func TestAccAlicloudSlb_vpc(t *testing.T) {
-	var slb slb.LoadBalancerType
+	var slb slb.DescribeLoadBalancerAttributeResponse
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -106,7 +141,7 @@ func TestAccAlicloudSlb_vpc(t *testing.T) {
 }
 
 func TestAccAlicloudSlb_spec(t *testing.T) {
-	var slb slb.LoadBalancerType
+	var slb slb.DescribeLoadBalancerAttributeResponse
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -139,7 +174,7 @@ func TestAccAlicloudSlb_spec(t *testing.T) {
 	})
 }
 
-func testAccCheckSlbExists(n string, slb *slb.LoadBalancerType) resource.TestCheckFunc {
+func testAccCheckSlbExists(n string, slb *slb.DescribeLoadBalancerAttributeResponse) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -156,9 +191,6 @@ func testAccCheckSlbExists(n string, slb *slb.LoadBalancerType) resource.TestChe
 		if err != nil {
 			return err
 		}
-		if instance == nil {
-			return fmt.Errorf("SLB not found")
-		}
 
 		*slb = *instance
 		return nil
@@ -174,18 +206,13 @@ func testAccCheckSlbDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the Slb
-		instance, err := client.DescribeLoadBalancerAttribute(rs.Primary.ID)
-
-		if instance != nil {
-			return fmt.Errorf("SLB still exist")
-		}
-
-		if err != nil {
+		if _, err := client.DescribeLoadBalancerAttribute(rs.Primary.ID); err != nil {
 			if IsExceptedError(err, LoadBalancerNotFound) {
-				return nil
+				continue
 			}
 			return fmt.Errorf("DescribeLoadBalancerAttribute got an error: %#v", err)
 		}
+		return fmt.Errorf("SLB still exist")
 
 	}
 
@@ -2,11 +2,9 @@ package alicloud
 
 import (
 	"fmt"
-
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -16,6 +14,9 @@ func resourceAliyunSnatEntry() *schema.Resource {
 		Read:   resourceAliyunSnatEntryRead,
 		Update: resourceAliyunSnatEntryUpdate,
 		Delete: resourceAliyunSnatEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunSnatEntryImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"snat_table_id": &schema.Schema{
@@ -37,7 +38,8 @@ func resourceAliyunSnatEntry() *schema.Resource {
 }
 
 func resourceAliyunSnatEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).vpcconn
+	client := meta.(*AliyunClient)
+	conn := client.vpcconn
 
 	request := vpc.CreateCreateSnatEntryRequest()
 	request.RegionId = string(getRegion(d, meta))
@@ -45,20 +47,13 @@ func resourceAliyunSnatEntryCreate(d *schema.ResourceData, meta interface{}) err
 	request.SourceVSwitchId = d.Get("source_vswitch_id").(string)
 	request.SnatIp = d.Get("snat_ip").(string)
 
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		ar := request
-		resp, err := conn.CreateSnatEntry(ar)
-		if err != nil {
-			if IsExceptedError(err, EIP_NOT_IN_GATEWAY) {
-				return resource.RetryableError(fmt.Errorf("CreateSnatEntry timeout and got an error: %#v.", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("CreateSnatEntry got error: %#v.", err))
-		}
-		d.SetId(resp.SnatEntryId)
-		return nil
+	resp := vpc.CreateCreateSnatEntryResponse()
+	if err := RetryOnError(VpcCode, 3*time.Minute, func() error {
+		return client.doAction(conn, request, resp)
 	}); err != nil {
-		return err
+		return WrapApiError(err, request.GetActionName(), request.SnatTableId)
 	}
+	d.SetId(resp.SnatEntryId)
 
 	return resourceAliyunSnatEntryRead(d, meta)
 }
@@ -70,9 +65,10 @@ func resourceAliyunSnatEntryRead(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil {
 		if NotFoundError(err) {
+			d.SetId("")
 			return nil
 		}
-		return err
+		return WrapApiError(err, "DescribeSnatTableEntries", d.Id())
 	}
 
 	d.Set("snat_table_id", snatEntry.SnatTableId)
@@ -87,7 +83,7 @@ func resourceAliyunSnatEntryUpdate(d *schema.ResourceData, meta interface{}) err
 
 	snatEntry, err := client.DescribeSnatEntry(d.Get("snat_table_id").(string), d.Id())
 	if err != nil {
-		return err
+		return WrapApiError(err, "DescribeSnatTableEntries", d.Id())
 	}
 
 	d.Partial(true)
@@ -111,8 +107,8 @@ func resourceAliyunSnatEntryUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if attributeUpdate {
-		if _, err := client.vpcconn.ModifySnatEntry(request); err != nil {
-			return err
+		if err := client.doAction(client.vpcconn, request, vpc.CreateModifySnatEntryResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 	}
 
@@ -122,18 +118,31 @@ func resourceAliyunSnatEntryUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAliyunSnatEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
 
 	request := vpc.CreateDeleteSnatEntryRequest()
 	request.RegionId = string(getRegion(d, meta))
 	request.SnatTableId = d.Get("snat_table_id").(string)
 	request.SnatEntryId = d.Id()
 
-	if _, err := meta.(*AliyunClient).vpcconn.DeleteSnatEntry(request); err != nil {
+	if err := client.doAction(client.vpcconn, request, vpc.CreateDeleteSnatEntryResponse()); err != nil {
 		if IsExceptedError(err, InvalidSnatTableIdNotFound) {
 			return nil
 		}
-		return err
+		return WrapApiError(err, request.GetActionName(), d.Id())
 	}
 
 	return nil
 }
+
+// resourceAliyunSnatEntryImportState imports a snat entry by <snat_table_id>:<snat_entry_id>
+// because the snat table id is required to describe the entry.
+func resourceAliyunSnatEntryImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), "snat entry", "snat_table_id", "snat_entry_id")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("snat_table_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,308 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudTsdbInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudTsdbInstanceCreate,
+		Read:   resourceAlicloudTsdbInstanceRead,
+		Update: resourceAlicloudTsdbInstanceUpdate,
+		Delete: resourceAlicloudTsdbInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      TsdbEngineTsdb,
+				ValidateFunc: validateAllowedStringValue([]string{TsdbEngineTsdb, TsdbEngineInfluxdb}),
+			},
+			"instance_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"disk_category": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "cloud_ssd",
+				ValidateFunc: validateAllowedStringValue([]string{"cloud_efficiency", "cloud_ssd", "cloud_essd"}),
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(PostPaid),
+				ValidateFunc: validateAllowedStringValue([]string{string(PrePaid), string(PostPaid)}),
+			},
+			"period": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateFunc:     validateInstanceChargeTypePeriod,
+				DiffSuppressFunc: prePaidDiffSuppressFunc,
+			},
+			"security_ips": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"data_retention": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 3650),
+			},
+			"public_network_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_string": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_connection_string": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudTsdbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"InstanceClass":   d.Get("instance_class").(string),
+		"InstanceStorage": strconv.Itoa(d.Get("instance_storage").(int)),
+		"DiskCategory":    d.Get("disk_category").(string),
+		"EngineType":      d.Get("engine_type").(string),
+		"PayType":         hitsdbPayType(PayType(d.Get("instance_charge_type").(string))),
+		"ClientToken":     buildClientToken("TF-CreateHiTSDBInstance"),
+	}
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		params["Duration"], params["PricingCycle"] = prepaidPeriod(d.Get("period").(int))
+	}
+	if v, ok := d.GetOk("instance_name"); ok {
+		params["InstanceAlias"] = v.(string)
+	}
+
+	vsw, err := client.DescribeVswitch(d.Get("vswitch_id").(string))
+	if err != nil {
+		return WrapErrorf(err, "DescribeVSwitchAttributes got an error")
+	}
+	if v, ok := d.GetOk("zone_id"); ok && v.(string) != vsw.ZoneId {
+		return fmt.Errorf("The specified vswitch %s isn't in the zone %s.", vsw.VSwitchId, v.(string))
+	}
+	params["ZoneId"] = vsw.ZoneId
+	params["VPCId"] = vsw.VpcId
+	params["VSwitchId"] = vsw.VSwitchId
+
+	var resp struct {
+		InstanceId string `json:"InstanceId"`
+	}
+	if err := RetryOnError(HitsdbCode, 3*time.Minute, func() error {
+		return client.ProcessRpcRequest(client.hitsdbEndpoint(), TsdbApiVersion, "CreateHiTSDBInstance", params, &resp)
+	}); err != nil {
+		return WrapErrorf(err, "CreateHiTSDBInstance got an error")
+	}
+	d.SetId(resp.InstanceId)
+
+	if err := client.WaitForTsdbInstance(d.Id(), nil, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, "WaitForTsdbInstance %s got an error", d.Id())
+	}
+
+	return resourceAlicloudTsdbInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudTsdbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	instance, err := client.DescribeTsdbInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("instance_name", instance.InstanceAlias)
+	d.Set("engine_type", instance.EngineType)
+	d.Set("instance_class", instance.InstanceClass)
+	if storage, err := strconv.Atoi(instance.InstanceStorage); err == nil {
+		d.Set("instance_storage", storage)
+	}
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VswitchId)
+	d.Set("vpc_id", instance.VpcId)
+	d.Set("instance_charge_type", string(hitsdbChargeType(instance.PaymentType)))
+	d.Set("status", instance.Status)
+	d.Set("data_retention", instance.DataRetention)
+	d.Set("public_network_enabled", instance.PublicConnectionString != "")
+	d.Set("connection_string", instance.ConnectionString)
+	d.Set("public_connection_string", instance.PublicConnectionString)
+
+	ips, err := client.DescribeTsdbSecurityIps(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("security_ips", ips)
+
+	return nil
+}
+
+func resourceAlicloudTsdbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	d.Partial(true)
+
+	if d.HasChange("security_ips") {
+		ips := expandStringList(d.Get("security_ips").(*schema.Set).List())
+		if len(ips) > 0 {
+			if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), TsdbApiVersion, "ModifyHiTSDBInstanceSecurityIpList", map[string]string{
+				"InstanceId":     d.Id(),
+				"SecurityIpList": strings.Join(ips, COMMA_SEPARATED),
+			}, nil); err != nil {
+				return WrapErrorf(err, "ModifyHiTSDBInstanceSecurityIpList got an error")
+			}
+		}
+		d.SetPartial("security_ips")
+	}
+
+	if d.HasChange("data_retention") {
+		if v, ok := d.GetOk("data_retention"); ok || !d.IsNewResource() {
+			if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), TsdbApiVersion, "ModifyHiTSDBInstanceDataRetention", map[string]string{
+				"InstanceId":    d.Id(),
+				"DataRetention": strconv.Itoa(v.(int)),
+			}, nil); err != nil {
+				return WrapErrorf(err, "ModifyHiTSDBInstanceDataRetention got an error")
+			}
+		}
+		d.SetPartial("data_retention")
+	}
+
+	// The public connection is allocated asynchronously, so wait for its connection string.
+	if d.HasChange("public_network_enabled") {
+		enabled := d.Get("public_network_enabled").(bool)
+		action := "0"
+		if enabled {
+			action = "1"
+		}
+		if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), TsdbApiVersion, "SwitchHiTSDBInstancePublicNet", map[string]string{
+			"InstanceId":   d.Id(),
+			"SwitchAction": action,
+		}, nil); err != nil {
+			return WrapErrorf(err, "SwitchHiTSDBInstancePublicNet got an error")
+		}
+		if err := client.WaitForTsdbInstance(d.Id(), func(instance TsdbInstance) bool {
+			return (instance.PublicConnectionString != "") == enabled
+		}, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, "WaitForTsdbInstance %s got an error", d.Id())
+		}
+		d.SetPartial("public_network_enabled")
+	}
+
+	if d.IsNewResource() {
+		d.Partial(false)
+		return resourceAlicloudTsdbInstanceRead(d, meta)
+	}
+
+	if d.HasChange("instance_name") {
+		if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), TsdbApiVersion, "RenameHiTSDBInstanceAlias", map[string]string{
+			"InstanceId":    d.Id(),
+			"InstanceAlias": d.Get("instance_name").(string),
+		}, nil); err != nil {
+			return WrapErrorf(err, "RenameHiTSDBInstanceAlias got an error")
+		}
+		d.SetPartial("instance_name")
+	}
+
+	// The storage can only be expanded, and the class can be changed with it at the same time.
+	if d.HasChange("instance_class") || d.HasChange("instance_storage") {
+		class, storage := d.Get("instance_class").(string), strconv.Itoa(d.Get("instance_storage").(int))
+		if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), TsdbApiVersion, "ModifyHiTSDBInstanceClass", map[string]string{
+			"InstanceId":      d.Id(),
+			"InstanceClass":   class,
+			"InstanceStorage": storage,
+		}, nil); err != nil {
+			return WrapErrorf(err, "ModifyHiTSDBInstanceClass got an error")
+		}
+		if err := client.WaitForTsdbInstance(d.Id(), func(instance TsdbInstance) bool {
+			return instance.InstanceClass == class && instance.InstanceStorage == storage
+		}, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, "WaitForTsdbInstance %s got an error", d.Id())
+		}
+		d.SetPartial("instance_class")
+		d.SetPartial("instance_storage")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudTsdbInstanceRead(d, meta)
+}
+
+func resourceAlicloudTsdbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+		return fmt.Errorf("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically.")
+	}
+
+	if err := RetryOnError(HitsdbCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.ProcessRpcRequest(client.hitsdbEndpoint(), TsdbApiVersion, "DeleteHiTSDBInstance", map[string]string{
+			"InstanceId": d.Id(),
+		}, nil)
+	}); err != nil {
+		if IsExceptedError(err, HitsdbInstanceNotValid) || IsExceptedError(err, HitsdbInstanceDeleted) {
+			return nil
+		}
+		return WrapErrorf(err, "DeleteHiTSDBInstance got an error")
+	}
+
+	return client.WaitForTsdbInstanceDeleted(d.Id(), timeoutSeconds(d, schema.TimeoutDelete))
+}
@@ -0,0 +1,174 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudTsdbInstance_basic(t *testing.T) {
+	var instance TsdbInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_tsdb_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTsdbInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTsdbInstanceConfig("tsdb.1x.basic", 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTsdbInstanceExists("alicloud_tsdb_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "instance_class", "tsdb.1x.basic"),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "instance_storage", "50"),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "security_ips.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccTsdbInstanceConfig("tsdb.3x.basic", 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTsdbInstanceExists("alicloud_tsdb_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "instance_class", "tsdb.3x.basic"),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "instance_storage", "100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudTsdbInstance_influxdb(t *testing.T) {
+	var instance TsdbInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_tsdb_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTsdbInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTsdbInstanceInfluxdbConfig(30, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTsdbInstanceExists("alicloud_tsdb_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "engine_type", "tsdb_influxdb"),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "data_retention", "30"),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "public_connection_string", ""),
+					resource.TestCheckResourceAttrSet("alicloud_tsdb_instance.foo", "connection_string"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccTsdbInstanceInfluxdbConfig(90, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTsdbInstanceExists("alicloud_tsdb_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "data_retention", "90"),
+					resource.TestCheckResourceAttr("alicloud_tsdb_instance.foo", "public_network_enabled", "true"),
+					resource.TestCheckResourceAttrSet("alicloud_tsdb_instance.foo", "public_connection_string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTsdbInstanceExists(n string, instance *TsdbInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No TSDB instance ID is set")
+		}
+
+		resp, err := testAccProvider.Meta().(*AliyunClient).DescribeTsdbInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*instance = resp
+		return nil
+	}
+}
+
+func testAccCheckTsdbInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_tsdb_instance" {
+			continue
+		}
+
+		if _, err := client.DescribeTsdbInstance(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("TSDB instance %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTsdbInstanceConfig(class string, storage int) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccTsdbInstance"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/24"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_tsdb_instance" "foo" {
+  instance_name    = "tf-testAccTsdbInstance"
+  instance_class   = "%s"
+  instance_storage = %d
+  vswitch_id       = "${alicloud_vswitch.foo.id}"
+  security_ips     = ["10.0.0.0/8"]
+}
+`, class, storage)
+}
+
+func testAccTsdbInstanceInfluxdbConfig(retention int, public bool) string {
+	return fmt.Sprintf(`
+data "alicloud_zones" "default" {}
+
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccTsdbInstanceInfluxdb"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id            = "${alicloud_vpc.foo.id}"
+  cidr_block        = "172.16.0.0/24"
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_tsdb_instance" "foo" {
+  instance_name          = "tf-testAccTsdbInstanceInfluxdb"
+  engine_type            = "tsdb_influxdb"
+  instance_class         = "influxdata.n1.mxlarge"
+  instance_storage       = 50
+  vswitch_id             = "${alicloud_vswitch.foo.id}"
+  data_retention         = %d
+  public_network_enabled = %t
+}
+`, retention, public)
+}
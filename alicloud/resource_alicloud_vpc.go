@@ -61,6 +61,7 @@ func resourceAliyunVpc() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -69,34 +70,27 @@ func resourceAliyunVpcCreate(d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*AliyunClient)
 
-	var vpc *vpc.CreateVpcResponse
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		args, err := buildAliyunVpcArgs(d, meta)
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("Building CreateVpcRequest got an error: %#v", err))
-		}
-		resp, err := client.vpcconn.CreateVpc(args)
-		if err != nil {
-			if IsExceptedError(err, VpcQuotaExceeded) {
-				return resource.NonRetryableError(fmt.Errorf("The number of VPC has quota has reached the quota limit in your account, and please use existing VPCs or remove some of them."))
-			}
-			if IsExceptedError(err, TaskConflict) || IsExceptedError(err, UnknownError) {
-				return resource.RetryableError(fmt.Errorf("Create vpc timeout and got an error: %#v.", err))
-			}
-			return resource.NonRetryableError(err)
-		}
-		vpc = resp
-		return nil
+	args, err := buildAliyunVpcArgs(d, meta)
+	if err != nil {
+		return WrapErrorf(err, "Building CreateVpcRequest got an error")
+	}
+
+	response := vpc.CreateCreateVpcResponse()
+	err = RetryOnError(VpcCode, 3*time.Minute, func() error {
+		return client.doAction(client.vpcconn, args, response)
 	})
 	if err != nil {
-		return fmt.Errorf("Create vpc got an error :%#v", err)
+		if IsExceptedError(err, VpcQuotaExceeded) {
+			return fmt.Errorf("The number of VPC has quota has reached the quota limit in your account, and please use existing VPCs or remove some of them.")
+		}
+		return WrapApiError(err, args.GetActionName(), "")
 	}
 
-	d.SetId(vpc.VpcId)
+	d.SetId(response.VpcId)
 
 	err = client.WaitForVpc(d.Id(), Available, 60)
 	if err != nil {
-		return fmt.Errorf("Timeout when WaitForVpcAvailable")
+		return WrapApiError(WrapErrorf(err, "WaitForVpc %s got an error", Available), "", d.Id())
 	}
 
 	return resourceAliyunVpcUpdate(d, meta)
@@ -112,7 +106,7 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return WrapApiError(err, "DescribeVpcAttribute", d.Id())
 	}
 
 	d.Set("cidr_block", resp.CidrBlock)
@@ -122,9 +116,10 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 	request := vpc.CreateDescribeVRoutersRequest()
 	request.RegionId = string(getRegion(d, meta))
 	request.VRouterId = resp.VRouterId
-	response, err := client.vpcconn.DescribeVRouters(request)
+	response := vpc.CreateDescribeVRoutersResponse()
+	err = client.doAction(client.vpcconn, request, response)
 	if err != nil {
-		return fmt.Errorf("DescribeVRouters got an error: %#v.", err)
+		return WrapApiError(err, request.GetActionName(), d.Id())
 	}
 	if len(response.VRouters.VRouter) > 0 && len(response.VRouters.VRouter[0].RouteTableIds.RouteTableId) > 0 {
 		d.Set("router_table_id", response.VRouters.VRouter[0].RouteTableIds.RouteTableId[0])
@@ -134,10 +129,17 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("route_table_id", "")
 	}
 
+	tags, err := client.DescribeVpcTags(TagResourceVpc, d.Id())
+	if err != nil {
+		return WrapApiError(err, "ListTagResources", d.Id())
+	}
+	d.Set("tags", client.ignoreDefaultTags(d, tagResourcesToMap(tags)))
+
 	return nil
 }
 
 func resourceAliyunVpcUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
 
 	d.Partial(true)
 
@@ -160,11 +162,16 @@ func resourceAliyunVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if attributeUpdate {
-		if _, err := meta.(*AliyunClient).vpcconn.ModifyVpcAttribute(request); err != nil {
-			return err
+		if err := client.doAction(client.vpcconn, request, vpc.CreateModifyVpcAttributeResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 	}
 
+	if err := setVpcTags(meta.(*AliyunClient), TagResourceVpc, d); err != nil {
+		return WrapErrorf(err, "Set tags for vpc got an error")
+	}
+	d.SetPartial("tags")
+
 	d.Partial(false)
 
 	return resourceAliyunVpcRead(d, meta)
@@ -175,20 +182,20 @@ func resourceAliyunVpcDelete(d *schema.ResourceData, meta interface{}) error {
 	request := vpc.CreateDeleteVpcRequest()
 	request.VpcId = d.Id()
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		_, err := client.vpcconn.DeleteVpc(request)
+		err := client.doAction(client.vpcconn, request, vpc.CreateDeleteVpcResponse())
 
 		if err != nil {
 			if IsExceptedError(err, InvalidVpcIDNotFound) || IsExceptedError(err, ForbiddenVpcNotFound) {
 				return nil
 			}
-			return resource.RetryableError(fmt.Errorf("Delete VPC timeout and got an error: %#v.", err))
+			return resource.RetryableError(WrapApiError(err, request.GetActionName(), d.Id()))
 		}
 
 		if _, err := client.DescribeVpc(d.Id()); err != nil {
 			if NotFoundError(err) {
 				return nil
 			}
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapApiError(err, "DescribeVpcAttribute", d.Id()))
 		}
 
 		return nil
@@ -208,5 +215,7 @@ func buildAliyunVpcArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateVp
 		request.Description = v
 	}
 
+	request.ClientToken = buildClientToken("TF-CreateVpc")
+
 	return request, nil
 }
@@ -0,0 +1,190 @@
+package alicloud
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudVpcPeerConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudVpcPeerConnectionCreate,
+		Read:   resourceAlicloudVpcPeerConnectionRead,
+		Update: resourceAlicloudVpcPeerConnectionUpdate,
+		Delete: resourceAlicloudVpcPeerConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"accepting_vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"accepting_region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"accepting_ali_uid": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bandwidth": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudVpcPeerConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"VpcId":             d.Get("vpc_id").(string),
+		"AcceptingVpcId":    d.Get("accepting_vpc_id").(string),
+		"AcceptingRegionId": string(client.Region),
+		"ClientToken":       buildClientToken("TF-CreateVpcPeerConnection"),
+	}
+	if v, ok := d.GetOk("accepting_region_id"); ok {
+		params["AcceptingRegionId"] = v.(string)
+	}
+	// The connection to a VPC of another account waits to be accepted, while the one of the same account is activated by itself.
+	crossAccount := false
+	if v, ok := d.GetOk("accepting_ali_uid"); ok {
+		params["AcceptingAliUid"] = v.(string)
+		crossAccount = true
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params["Name"] = v.(string)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params["Description"] = v.(string)
+	}
+	if v, ok := d.GetOk("bandwidth"); ok {
+		params["Bandwidth"] = strconv.Itoa(v.(int))
+	}
+
+	if err := RetryOnError(VpcPeerCode, d.Timeout(schema.TimeoutCreate), func() error {
+		var resp struct {
+			InstanceId string `json:"InstanceId"`
+		}
+		if err := client.ProcessRpcRequest(client.vpcPeerEndpoint(), VpcPeerApiVersion, "CreateVpcPeerConnection", params, &resp); err != nil {
+			return err
+		}
+		d.SetId(resp.InstanceId)
+		return nil
+	}); err != nil {
+		return WrapApiError(err, "CreateVpcPeerConnection", "")
+	}
+
+	targets := []Status{VpcPeerActivated}
+	if crossAccount {
+		targets = append(targets, VpcPeerAccepting)
+	}
+	if err := client.WaitForVpcPeerConnection(d.Id(), []Status{VpcPeerCreating, VpcPeerAccepting}, targets, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForVpcPeerConnection got an error"), "", d.Id())
+	}
+
+	return resourceAlicloudVpcPeerConnectionRead(d, meta)
+}
+
+func resourceAlicloudVpcPeerConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AliyunClient).DescribeVpcPeerConnection(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapApiError(err, "GetVpcPeerConnectionAttribute", d.Id())
+	}
+
+	d.Set("vpc_id", conn.Vpc.VpcId)
+	d.Set("accepting_vpc_id", conn.AcceptingVpc.VpcId)
+	d.Set("accepting_region_id", conn.AcceptingRegionId)
+	d.Set("accepting_ali_uid", strconv.FormatInt(conn.AcceptingOwnerUid, 10))
+	d.Set("name", conn.Name)
+	d.Set("description", conn.Description)
+	d.Set("bandwidth", conn.Bandwidth)
+	d.Set("status", conn.Status)
+
+	return nil
+}
+
+func resourceAlicloudVpcPeerConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("bandwidth") {
+		params := map[string]string{
+			"InstanceId":  d.Id(),
+			"Name":        d.Get("name").(string),
+			"Description": d.Get("description").(string),
+		}
+		if d.HasChange("bandwidth") {
+			params["Bandwidth"] = strconv.Itoa(d.Get("bandwidth").(int))
+		}
+		if err := RetryOnError(VpcPeerCode, d.Timeout(schema.TimeoutUpdate), func() error {
+			return client.ProcessRpcRequest(client.vpcPeerEndpoint(), VpcPeerApiVersion, "ModifyVpcPeerConnection", params, nil)
+		}); err != nil {
+			return WrapApiError(err, "ModifyVpcPeerConnection", d.Id())
+		}
+		if err := client.WaitForVpcPeerConnection(d.Id(), []Status{VpcPeerUpdating}, []Status{VpcPeerActivated, VpcPeerAccepting},
+			timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapApiError(WrapErrorf(err, "WaitForVpcPeerConnection got an error"), "", d.Id())
+		}
+	}
+
+	return resourceAlicloudVpcPeerConnectionRead(d, meta)
+}
+
+func resourceAlicloudVpcPeerConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	params := map[string]string{
+		"InstanceId":  d.Id(),
+		"ClientToken": buildClientToken("TF-DeleteVpcPeerConnection"),
+	}
+	if err := RetryOnError(VpcPeerCode, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.ProcessRpcRequest(client.vpcPeerEndpoint(), VpcPeerApiVersion, "DeleteVpcPeerConnection", params, nil)
+	}); err != nil {
+		if IsExceptedError(err, VpcPeerConnectionNotFound) {
+			return nil
+		}
+		return WrapApiError(err, "DeleteVpcPeerConnection", d.Id())
+	}
+
+	if err := client.WaitForVpcPeerConnectionDeleted(d.Id(), timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForVpcPeerConnectionDeleted got an error"), "", d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package alicloud
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudVpcPeerConnectionAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudVpcPeerConnectionAccepterCreate,
+		Read:   resourceAlicloudVpcPeerConnectionAccepterRead,
+		Delete: resourceAlicloudVpcPeerConnectionAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"peer_connection_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_ali_uid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": regionSchema(),
+		},
+	}
+}
+
+func resourceAlicloudVpcPeerConnectionAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := regionClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("peer_connection_id").(string)
+	conn, err := client.DescribeVpcPeerConnection(id)
+	if err != nil {
+		return WrapApiError(err, "GetVpcPeerConnectionAttribute", id)
+	}
+
+	// A connection within one account has been activated when it is created, and there is nothing to accept.
+	if conn.Status != string(VpcPeerActivated) {
+		if err := RetryOnError(VpcPeerCode, d.Timeout(schema.TimeoutCreate), func() error {
+			return client.ProcessRpcRequest(client.vpcPeerEndpoint(), VpcPeerApiVersion, "AcceptVpcPeerConnection", map[string]string{
+				"InstanceId": id,
+			}, nil)
+		}); err != nil {
+			return WrapApiError(err, "AcceptVpcPeerConnection", id)
+		}
+	}
+	d.SetId(id)
+
+	if err := client.WaitForVpcPeerConnection(id, []Status{VpcPeerAccepting, VpcPeerUpdating}, []Status{VpcPeerActivated},
+		timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapApiError(WrapErrorf(err, "WaitForVpcPeerConnection got an error"), "", id)
+	}
+
+	return resourceAlicloudVpcPeerConnectionAccepterRead(d, meta)
+}
+
+func resourceAlicloudVpcPeerConnectionAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := regionClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	conn, err := client.DescribeVpcPeerConnection(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapApiError(err, "GetVpcPeerConnectionAttribute", d.Id())
+	}
+
+	d.Set("peer_connection_id", conn.InstanceId)
+	d.Set("vpc_id", conn.AcceptingVpc.VpcId)
+	d.Set("peer_vpc_id", conn.Vpc.VpcId)
+	d.Set("peer_region_id", conn.RegionId)
+	d.Set("peer_ali_uid", strconv.FormatInt(conn.OwnerId, 10))
+	d.Set("name", conn.Name)
+	d.Set("bandwidth", conn.Bandwidth)
+	d.Set("status", conn.Status)
+	d.Set("region", string(client.Region))
+
+	return nil
+}
+
+func resourceAlicloudVpcPeerConnectionAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	// The connection is owned by the initiator, which deletes it by alicloud_vpc_peer_connection.
+	log.Printf("[WARN] The VPC peer connection %s is only removed from the state and it is still active.", d.Id())
+	return nil
+}
@@ -0,0 +1,47 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAlicloudVpcPeerConnectionAccepter_schema(t *testing.T) {
+	if err := resourceAlicloudVpcPeerConnectionAccepter().InternalValidate(nil, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAccAlicloudVpcPeerConnectionAccepter_basic(t *testing.T) {
+	var conn VpcPeerConnection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_vpc_peer_connection_accepter.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcPeerConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcPeerConnectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeerConnectionExists("alicloud_vpc_peer_connection_accepter.foo", &conn),
+					resource.TestCheckResourceAttrPair("alicloud_vpc_peer_connection_accepter.foo", "peer_connection_id", "alicloud_vpc_peer_connection.foo", "id"),
+					resource.TestCheckResourceAttrPair("alicloud_vpc_peer_connection_accepter.foo", "peer_vpc_id", "alicloud_vpc.foo", "id"),
+					resource.TestCheckResourceAttr("alicloud_vpc_peer_connection_accepter.foo", "name", "tf-testAccVpcPeerConnection"),
+					resource.TestCheckResourceAttr("alicloud_vpc_peer_connection_accepter.foo", "status", string(VpcPeerActivated)),
+					resource.TestCheckResourceAttrSet("alicloud_vpc_peer_connection_accepter.foo", "peer_ali_uid"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "alicloud_vpc_peer_connection_accepter.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
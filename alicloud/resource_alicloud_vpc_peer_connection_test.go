@@ -0,0 +1,132 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudVpcPeerConnection_basic(t *testing.T) {
+	var conn VpcPeerConnection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_vpc_peer_connection.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcPeerConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcPeerConnectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeerConnectionExists("alicloud_vpc_peer_connection.foo", &conn),
+					resource.TestCheckResourceAttr("alicloud_vpc_peer_connection.foo", "name", "tf-testAccVpcPeerConnection"),
+					resource.TestCheckResourceAttr("alicloud_vpc_peer_connection.foo", "status", string(VpcPeerActivated)),
+					resource.TestCheckResourceAttrSet("alicloud_vpc_peer_connection.foo", "accepting_region_id"),
+					resource.TestCheckResourceAttrSet("alicloud_vpc_peer_connection.foo", "bandwidth"),
+					resource.TestCheckResourceAttrPair("alicloud_vpc_peer_connection_accepter.foo", "vpc_id", "alicloud_vpc.bar", "id"),
+					resource.TestCheckResourceAttr("alicloud_vpc_peer_connection_accepter.foo", "status", string(VpcPeerActivated)),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcPeerConnectionUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeerConnectionExists("alicloud_vpc_peer_connection.foo", &conn),
+					resource.TestCheckResourceAttr("alicloud_vpc_peer_connection.foo", "description", "updated by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcPeerConnectionExists(n string, conn *VpcPeerConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC peer connection ID is set")
+		}
+
+		resp, err := testAccProvider.Meta().(*AliyunClient).DescribeVpcPeerConnection(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*conn = resp
+		return nil
+	}
+}
+
+func testAccCheckVpcPeerConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_vpc_peer_connection" {
+			continue
+		}
+
+		if _, err := client.DescribeVpcPeerConnection(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("VPC peer connection %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccVpcPeerConnectionConfig = `
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccVpcPeerConnection"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vpc" "bar" {
+  name       = "tf-testAccVpcPeerConnection"
+  cidr_block = "192.168.0.0/16"
+}
+
+resource "alicloud_vpc_peer_connection" "foo" {
+  vpc_id           = "${alicloud_vpc.foo.id}"
+  accepting_vpc_id = "${alicloud_vpc.bar.id}"
+  name             = "tf-testAccVpcPeerConnection"
+}
+
+resource "alicloud_vpc_peer_connection_accepter" "foo" {
+  peer_connection_id = "${alicloud_vpc_peer_connection.foo.id}"
+}
+`
+
+const testAccVpcPeerConnectionUpdate = `
+resource "alicloud_vpc" "foo" {
+  name       = "tf-testAccVpcPeerConnection"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vpc" "bar" {
+  name       = "tf-testAccVpcPeerConnection"
+  cidr_block = "192.168.0.0/16"
+}
+
+resource "alicloud_vpc_peer_connection" "foo" {
+  vpc_id           = "${alicloud_vpc.foo.id}"
+  accepting_vpc_id = "${alicloud_vpc.bar.id}"
+  name             = "tf-testAccVpcPeerConnection"
+  description      = "updated by terraform"
+}
+
+resource "alicloud_vpc_peer_connection_accepter" "foo" {
+  peer_connection_id = "${alicloud_vpc_peer_connection.foo.id}"
+}
+`
@@ -2,13 +2,70 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("alicloud_vpc", &resource.Sweeper{
+		Name: "alicloud_vpc",
+		F:    testSweepVpcs,
+		// the vpcs can only be deleted after the resources in them
+		Dependencies: []string{
+			"alicloud_vswitch",
+			"alicloud_security_group",
+		},
+	})
+}
+
+func testSweepVpcs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+
+	var vpcs []vpc.Vpc
+	req := vpc.CreateDescribeVpcsRequest()
+	req.RegionId = region
+	req.PageSize = requests.NewInteger(PageSizeLarge)
+	req.PageNumber = requests.NewInteger(1)
+	for {
+		resp := vpc.CreateDescribeVpcsResponse()
+		err := client.doAction(client.vpcconn, req, resp)
+		if err != nil {
+			return fmt.Errorf("Error retrieving VPCs: %s", err)
+		}
+		if resp == nil || len(resp.Vpcs.Vpc) < 1 {
+			break
+		}
+		vpcs = append(vpcs, resp.Vpcs.Vpc...)
+
+		if len(resp.Vpcs.Vpc) < PageSizeLarge {
+			break
+		}
+		req.PageNumber = req.PageNumber + requests.NewInteger(1)
+	}
+
+	for _, v := range vpcs {
+		if !isTestSweepName(v.VpcName) {
+			log.Printf("[INFO] Skipping VPC: %s (%s)", v.VpcName, v.VpcId)
+			continue
+		}
+		log.Printf("[INFO] Deleting VPC: %s (%s)", v.VpcName, v.VpcId)
+		req := vpc.CreateDeleteVpcRequest()
+		req.VpcId = v.VpcId
+		if err := client.doAction(client.vpcconn, req, vpc.CreateDeleteVpcResponse()); err != nil {
+			log.Printf("[ERROR] Failed to delete VPC (%s (%s)): %s", v.VpcName, v.VpcId, err)
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudVpc_basic(t *testing.T) {
 	var vpc vpc.DescribeVpcAttributeResponse
 
@@ -63,6 +120,10 @@ func TestAccAlicloudVpc_update(t *testing.T) {
 					testAccCheckVpcExists("alicloud_vpc.foo", &vpc),
 					resource.TestCheckResourceAttr(
 						"alicloud_vpc.foo", "name", "tf_test_bar"),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpc.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_vpc.foo", "tags.Owner", "terraform"),
 				),
 			},
 		},
@@ -154,6 +215,9 @@ const testAccVpcConfigUpdate = `
 resource "alicloud_vpc" "foo" {
 	cidr_block = "172.16.0.0/12"
 	name = "tf_test_bar"
+	tags {
+		Owner = "terraform"
+	}
 }
 `
 
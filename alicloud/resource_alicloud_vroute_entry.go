@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -66,50 +65,44 @@ func resourceAliyunRouteEntryCreate(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error query route table: %#v", err)
+		return WrapApiError(err, "DescribeRouteTables", rtId)
 	}
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	args, err := buildAliyunRouteEntryArgs(d, meta)
+	if err != nil {
+		return WrapError(err)
+	}
 
+	err = RetryOnError(VpcCode, 3*time.Minute, func() error {
+		// It must ensure all the route entries and vswitches' status must be available before creating or deleting route entry.
 		if err := client.WaitForAllRouteEntries(rtId, Available, DefaultTimeout); err != nil {
-			return resource.NonRetryableError(fmt.Errorf("WaitFor route entries got error: %#v", err))
-		}
-
-		args, err := buildAliyunRouteEntryArgs(d, meta)
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("Building CreateRouteEntryArgs got an error: %#v", err))
+			return err
 		}
-
-		if _, err := client.vpcconn.CreateRouteEntry(args); err != nil {
-			// Route Entry does not support concurrence when creating or deleting it;
+		err := client.doAction(client.vpcconn, args, vpc.CreateCreateRouteEntryResponse())
+		if IsRetryableError(VpcCode, err) {
 			// Route Entry does not support creating or deleting within 5 seconds frequently
-			// It must ensure all the route entries and vswitches' status must be available before creating or deleting route entry.
-			if IsExceptedError(err, TaskConflict) || IsExceptedError(err, IncorrectRouteEntryStatus) {
-				time.Sleep(5 * time.Second)
-				return resource.RetryableError(fmt.Errorf("Create route entry timeout and got an error: %#v", err))
-			}
-			if IsExceptedError(err, RouterEntryConflictDuplicated) {
-				en, err := client.QueryRouteEntry(rtId, cidr, nt, ni)
-				if err != nil {
-					return resource.NonRetryableError(err)
-				}
-				return resource.NonRetryableError(fmt.Errorf("The route entry %s has already existed. "+
-					"Please import it using ID '%s:%s:%s:%s:%s' or specify a new 'destination_cidrblock' and try again.",
-					en.DestinationCidrBlock, en.RouteTableId, table.VRouterId, en.DestinationCidrBlock, en.NextHopType, ni))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Creating Route entry got an error: %#v", err))
+			time.Sleep(5 * time.Second)
 		}
-		return nil
+		return err
 	})
 	if err != nil {
-		return fmt.Errorf("Create Vroute Entry got an error :%#v", err)
+		if IsExceptedError(err, RouterEntryConflictDuplicated) {
+			en, err := client.QueryRouteEntry(rtId, cidr, nt, ni)
+			if err != nil {
+				return WrapApiError(err, "DescribeRouteTables", rtId)
+			}
+			return fmt.Errorf("The route entry %s has already existed. "+
+				"Please import it using ID '%s:%s:%s:%s:%s' or specify a new 'destination_cidrblock' and try again.",
+				en.DestinationCidrBlock, en.RouteTableId, table.VRouterId, en.DestinationCidrBlock, en.NextHopType, ni)
+		}
+		return WrapApiError(err, args.GetActionName(), rtId)
 	}
 	// route_table_id:router_id:destination_cidrblock:nexthop_type:nexthop_id
 
 	d.SetId(rtId + ":" + table.VRouterId + ":" + cidr + ":" + nt + ":" + ni)
 
 	if err := client.WaitForAllRouteEntries(rtId, Available, DefaultTimeout); err != nil {
-		return fmt.Errorf("WaitFor route entry got error: %#v", err)
+		return WrapApiError(WrapErrorf(err, "WaitForAllRouteEntries %s got an error", Available), "", d.Id())
 	}
 	return resourceAliyunRouteEntryRead(d, meta)
 }
@@ -130,7 +123,7 @@ func resourceAliyunRouteEntryRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error route entry: %#v", err)
+		return WrapApiError(err, "DescribeRouteTables", d.Id())
 	}
 
 	d.Set("router_id", rId)
@@ -154,31 +147,27 @@ func resourceAliyunRouteEntryDelete(d *schema.ResourceData, meta interface{}) er
 	nexthop_type := parts[3]
 	nexthop_id := parts[4]
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		en, err := client.QueryRouteEntry(rtId, cidr, nexthop_type, nexthop_id)
-		if err != nil {
-			if NotFoundError(err) {
-				return nil
-			}
-			return resource.NonRetryableError(fmt.Errorf("Error route entry: %#v", err))
+	if _, err := client.QueryRouteEntry(rtId, cidr, nexthop_type, nexthop_id); err != nil {
+		if NotFoundError(err) {
+			return nil
 		}
+		return WrapApiError(err, "DescribeRouteTables", d.Id())
+	}
 
-		if en.Status != string(Available) {
-			return resource.RetryableError(fmt.Errorf("Delete route entry timeout and got an error: %#v.", err))
+	if err := RetryOnError(VpcCode, 5*time.Minute, func() error {
+		if err := client.WaitForAllRouteEntries(rtId, Available, DefaultTimeout); err != nil {
+			return err
 		}
-
-		if _, err := client.vpcconn.DeleteRouteEntry(args); err != nil {
-			if IsExceptedError(err, TaskConflict) || IsExceptedError(err, IncorrectRouteEntryStatus) ||
-				IsExceptedError(err, RouterEntryForbbiden) || IsExceptedError(err, UnknownError) {
-				// Route Entry does not support creating or deleting within 5 seconds frequently
-				time.Sleep(5 * time.Second)
-				return resource.RetryableError(fmt.Errorf("Delete route entry timeout and got an error: %#v.", err))
-			}
-			return resource.NonRetryableError(fmt.Errorf("Deleting RouteEntry got an error: %#v", err))
+		err := client.doAction(client.vpcconn, args, vpc.CreateDeleteRouteEntryResponse())
+		if IsRetryableError(VpcCode, err) {
+			// Route Entry does not support creating or deleting within 5 seconds frequently
+			time.Sleep(5 * time.Second)
 		}
-
-		return nil
-	})
+		return err
+	}); err != nil {
+		return WrapApiError(err, args.GetActionName(), d.Id())
+	}
+	return nil
 }
 
 func buildAliyunRouteEntryArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateRouteEntryRequest, error) {
@@ -186,6 +175,7 @@ func buildAliyunRouteEntryArgs(d *schema.ResourceData, meta interface{}) (*vpc.C
 	request := vpc.CreateCreateRouteEntryRequest()
 	request.RouteTableId = d.Get("route_table_id").(string)
 	request.DestinationCidrBlock = d.Get("destination_cidrblock").(string)
+	request.ClientToken = buildClientToken("TF-CreateRouteEntry")
 
 	if v := d.Get("nexthop_type").(string); v != "" {
 		request.NextHopType = v
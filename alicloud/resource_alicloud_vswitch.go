@@ -1,12 +1,10 @@
 package alicloud
 
 import (
-	"fmt"
 	"log"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -54,30 +52,28 @@ func resourceAliyunSwitchCreate(d *schema.ResourceData, meta interface{}) error
 
 	client := meta.(*AliyunClient)
 
-	var vswitchID, vpcID string
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		args, err := buildAliyunSwitchArgs(d, meta)
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("Building CreateVSwitchArgs got an error: %#v", err))
-		}
-		resp, err := client.vpcconn.CreateVSwitch(args)
+	args, err := buildAliyunSwitchArgs(d, meta)
+	if err != nil {
+		return WrapErrorf(err, "Building CreateVSwitchArgs got an error")
+	}
+
+	var vswitchID string
+	if err := RetryOnError(VpcCode, 3*time.Minute, func() error {
+		resp := vpc.CreateCreateVSwitchResponse()
+		err := client.doAction(client.vpcconn, args, resp)
 		if err != nil {
-			if IsExceptedError(err, TaskConflict) || IsExceptedError(err, UnknownError) {
-				return resource.RetryableError(fmt.Errorf("Creating Vswitch got an error: %#v", err))
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		vswitchID = resp.VSwitchId
-		vpcID = args.VpcId
 		return nil
 	}); err != nil {
-		return err
+		return WrapApiError(err, args.GetActionName(), "")
 	}
 
 	d.SetId(vswitchID)
 
 	if err := client.WaitForVSwitch(vswitchID, Available, 300); err != nil {
-		return fmt.Errorf("WaitForVSwitchAvailable got a error: %s", err)
+		return WrapApiError(WrapErrorf(err, "WaitForVSwitch %s got an error", Available), "", d.Id())
 	}
 
 	return resourceAliyunSwitchUpdate(d, meta)
@@ -92,7 +88,7 @@ func resourceAliyunSwitchRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return WrapApiError(err, "DescribeVSwitchAttributes", d.Id())
 	}
 
 	d.Set("availability_zone", vswitch.ZoneId)
@@ -105,6 +101,7 @@ func resourceAliyunSwitchRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAliyunSwitchUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
 
 	d.Partial(true)
 
@@ -126,8 +123,8 @@ func resourceAliyunSwitchUpdate(d *schema.ResourceData, meta interface{}) error
 		attributeUpdate = true
 	}
 	if attributeUpdate {
-		if _, err := meta.(*AliyunClient).vpcconn.ModifyVSwitchAttribute(request); err != nil {
-			return err
+		if err := client.doAction(client.vpcconn, request, vpc.CreateModifyVSwitchAttributeResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 
 	}
@@ -143,25 +140,25 @@ func resourceAliyunSwitchDelete(d *schema.ResourceData, meta interface{}) error
 	request := vpc.CreateDeleteVSwitchRequest()
 	request.VSwitchId = d.Id()
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		_, err := client.vpcconn.DeleteVSwitch(request)
+		err := client.doAction(client.vpcconn, request, vpc.CreateDeleteVSwitchResponse())
 
 		if err != nil {
 			if IsExceptedError(err, VswitcInvalidRegionId) {
 				log.Printf("[ERROR] Delete Switch is failed.")
-				return resource.NonRetryableError(err)
+				return resource.NonRetryableError(WrapApiError(err, request.GetActionName(), d.Id()))
 			}
 			if IsExceptedError(err, InvalidVswitchIDNotFound) {
 				return nil
 			}
 
-			return resource.RetryableError(fmt.Errorf("Delete vswitch timeout and got an error: %#v.", err))
+			return resource.RetryableError(WrapApiError(err, request.GetActionName(), d.Id()))
 		}
 
 		if _, err := client.DescribeVswitch(d.Id()); err != nil {
 			if NotFoundError(err) {
 				return nil
 			}
-			return resource.NonRetryableError(err)
+			return resource.NonRetryableError(WrapApiError(err, "DescribeVSwitchAttributes", d.Id()))
 		}
 
 		return nil
@@ -179,7 +176,7 @@ func buildAliyunSwitchArgs(d *schema.ResourceData, meta interface{}) (*vpc.Creat
 		return nil, err
 	}
 
-	err = client.ResourceAvailable(zone, ecs.ResourceTypeVSwitch)
+	err = client.ResourceAvailable(zone, ResourceTypeVSwitch)
 	if err != nil {
 		return nil, err
 	}
@@ -197,5 +194,7 @@ func buildAliyunSwitchArgs(d *schema.ResourceData, meta interface{}) (*vpc.Creat
 		request.Description = v.(string)
 	}
 
+	request.ClientToken = buildClientToken("TF-CreateVSwitch")
+
 	return request, nil
 }
@@ -2,13 +2,70 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("alicloud_vswitch", &resource.Sweeper{
+		Name: "alicloud_vswitch",
+		F:    testSweepVSwitches,
+		// the vswitches can only be deleted after the resources in them
+		Dependencies: []string{
+			"alicloud_instance",
+			"alicloud_slb",
+		},
+	})
+}
+
+func testSweepVSwitches(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+
+	var vswitches []vpc.VSwitch
+	req := vpc.CreateDescribeVSwitchesRequest()
+	req.RegionId = region
+	req.PageSize = requests.NewInteger(PageSizeLarge)
+	req.PageNumber = requests.NewInteger(1)
+	for {
+		resp := vpc.CreateDescribeVSwitchesResponse()
+		err := client.doAction(client.vpcconn, req, resp)
+		if err != nil {
+			return fmt.Errorf("Error retrieving VSwitches: %s", err)
+		}
+		if resp == nil || len(resp.VSwitches.VSwitch) < 1 {
+			break
+		}
+		vswitches = append(vswitches, resp.VSwitches.VSwitch...)
+
+		if len(resp.VSwitches.VSwitch) < PageSizeLarge {
+			break
+		}
+		req.PageNumber = req.PageNumber + requests.NewInteger(1)
+	}
+
+	for _, v := range vswitches {
+		if !isTestSweepName(v.VSwitchName) {
+			log.Printf("[INFO] Skipping VSwitch: %s (%s)", v.VSwitchName, v.VSwitchId)
+			continue
+		}
+		log.Printf("[INFO] Deleting VSwitch: %s (%s)", v.VSwitchName, v.VSwitchId)
+		req := vpc.CreateDeleteVSwitchRequest()
+		req.VSwitchId = v.VSwitchId
+		if err := client.doAction(client.vpcconn, req, vpc.CreateDeleteVSwitchResponse()); err != nil {
+			log.Printf("[ERROR] Failed to delete VSwitch (%s (%s)): %s", v.VSwitchName, v.VSwitchId, err)
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudVswitch_basic(t *testing.T) {
 	var vsw vpc.DescribeVSwitchAttributesResponse
 
@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type ApiGatewayVpcAccess struct {
+	Name        string `json:"Name"`
+	VpcId       string `json:"VpcId"`
+	InstanceId  string `json:"InstanceId"`
+	Port        int    `json:"Port"`
+	RegionId    string `json:"RegionId"`
+	CreatedTime string `json:"CreatedTime"`
+}
+
+type DescribeVpcAccessesResponse struct {
+	RequestId          string `json:"RequestId"`
+	TotalCount         int    `json:"TotalCount"`
+	VpcAccessAttribute struct {
+		VpcAccessAttribute []ApiGatewayVpcAccess `json:"VpcAccessAttribute"`
+	} `json:"VpcAccessAttributes"`
+}
+
+type ApiGatewayPlugin struct {
+	PluginId     string `json:"PluginId"`
+	PluginName   string `json:"PluginName"`
+	PluginType   string `json:"PluginType"`
+	PluginData   string `json:"PluginData"`
+	Description  string `json:"Description"`
+	RegionId     string `json:"RegionId"`
+	CreatedTime  string `json:"CreatedTime"`
+	ModifiedTime string `json:"ModifiedTime"`
+}
+
+type DescribePluginsResponse struct {
+	RequestId  string `json:"RequestId"`
+	TotalCount int    `json:"TotalCount"`
+	Plugins    struct {
+		PluginAttribute []ApiGatewayPlugin `json:"PluginAttribute"`
+	} `json:"Plugins"`
+}
+
+type CreatePluginResponse struct {
+	RequestId string `json:"RequestId"`
+	PluginId  string `json:"PluginId"`
+}
+
+func (client *AliyunClient) apiGatewayEndpoint() string {
+	return client.config.getEndpoint(ApiGatewayCode, fmt.Sprintf("apigateway.%s.aliyuncs.com", client.Region))
+}
+
+func (client *AliyunClient) DescribeApiGatewayVpcAccess(name, vpcId, instanceId string, port int) (vpcAccess ApiGatewayVpcAccess, err error) {
+	params := map[string]string{
+		"Name":     name,
+		"PageSize": strconv.Itoa(PageSizeLarge),
+	}
+
+	for pageNumber := 1; ; pageNumber++ {
+		params["PageNumber"] = strconv.Itoa(pageNumber)
+
+		var resp DescribeVpcAccessesResponse
+		if err = client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "DescribeVpcAccesses", params, &resp); err != nil {
+			return
+		}
+
+		for _, v := range resp.VpcAccessAttribute.VpcAccessAttribute {
+			if v.Name == name && v.VpcId == vpcId && v.InstanceId == instanceId && v.Port == port {
+				return v, nil
+			}
+		}
+
+		if len(resp.VpcAccessAttribute.VpcAccessAttribute) < PageSizeLarge {
+			break
+		}
+	}
+
+	return vpcAccess, GetNotFoundErrorFromString(GetNotFoundMessage("Api Gateway Vpc Access", name))
+}
+
+func (client *AliyunClient) DescribeApiGatewayPlugin(pluginId string) (plugin ApiGatewayPlugin, err error) {
+	var resp DescribePluginsResponse
+	if err = client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "DescribePlugins", map[string]string{
+		"PluginId": pluginId,
+	}, &resp); err != nil {
+		if IsExceptedError(err, ApiGatewayPluginNotFound) {
+			return plugin, GetNotFoundErrorFromString(GetNotFoundMessage("Api Gateway Plugin", pluginId))
+		}
+		return
+	}
+
+	for _, p := range resp.Plugins.PluginAttribute {
+		if p.PluginId == pluginId {
+			return p, nil
+		}
+	}
+	return plugin, GetNotFoundErrorFromString(GetNotFoundMessage("Api Gateway Plugin", pluginId))
+}
+
+func (client *AliyunClient) DescribeApiGatewayPluginAttachment(groupId, apiId, pluginId, stageName string) (plugin ApiGatewayPlugin, err error) {
+	var resp DescribePluginsResponse
+	if err = client.ProcessRpcRequest(client.apiGatewayEndpoint(), ApiGatewayApiVersion, "DescribePluginsByApi", map[string]string{
+		"GroupId":   groupId,
+		"ApiId":     apiId,
+		"StageName": stageName,
+		"PageSize":  strconv.Itoa(PageSizeLarge),
+	}, &resp); err != nil {
+		if IsExceptedError(err, ApiGatewayApiNotFound) || IsExceptedError(err, ApiGatewayGroupNotFound) {
+			return plugin, GetNotFoundErrorFromString(GetNotFoundMessage("Api Gateway Plugin Attachment", pluginId))
+		}
+		return
+	}
+
+	for _, p := range resp.Plugins.PluginAttribute {
+		if p.PluginId == pluginId {
+			return p, nil
+		}
+	}
+	return plugin, GetNotFoundErrorFromString(GetNotFoundMessage("Api Gateway Plugin Attachment", pluginId))
+}
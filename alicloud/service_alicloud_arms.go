@@ -0,0 +1,147 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func (client *AliyunClient) armsEndpoint() string {
+	return client.config.getEndpoint(ArmsCode, fmt.Sprintf("arms.%s.aliyuncs.com", client.Region))
+}
+
+func (client *AliyunClient) DescribeArmsAlertContact(id string) (contact ArmsAlertContact, err error) {
+	var resp struct {
+		PageBean struct {
+			Contacts []ArmsAlertContact `json:"Contacts"`
+		} `json:"PageBean"`
+	}
+	if err = client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "SearchAlertContact", map[string]string{
+		"ContactIds":  fmt.Sprintf("[%s]", id),
+		"CurrentPage": "1",
+		"PageSize":    strconv.Itoa(PageSizeSmall),
+	}, &resp); err != nil {
+		return contact, WrapErrorf(err, "SearchAlertContact got an error")
+	}
+	for _, c := range resp.PageBean.Contacts {
+		if strconv.FormatInt(c.ContactId, 10) == id {
+			return c, nil
+		}
+	}
+	return contact, GetNotFoundErrorFromString(GetNotFoundMessage("ARMS Alert Contact", id))
+}
+
+func (client *AliyunClient) DescribeArmsAlertContactGroup(id string) (group ArmsAlertContactGroup, err error) {
+	var resp struct {
+		ContactGroups []ArmsAlertContactGroup `json:"ContactGroups"`
+	}
+	if err = client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "SearchAlertContactGroup", map[string]string{
+		"ContactGroupIds": id,
+		"IsDetail":        "true",
+	}, &resp); err != nil {
+		return group, WrapErrorf(err, "SearchAlertContactGroup got an error")
+	}
+	for _, g := range resp.ContactGroups {
+		if strconv.FormatInt(g.ContactGroupId, 10) == id {
+			return g, nil
+		}
+	}
+	return group, GetNotFoundErrorFromString(GetNotFoundMessage("ARMS Alert Contact Group", id))
+}
+
+func (client *AliyunClient) DescribeArmsSilencePolicy(id string) (policy ArmsSilencePolicy, err error) {
+	for page := 1; ; page++ {
+		var resp struct {
+			PageBean struct {
+				SilencePolicies []ArmsSilencePolicy `json:"SilencePolicies"`
+			} `json:"PageBean"`
+		}
+		if err = client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "ListSilencePolicies", map[string]string{
+			"IsDetail": "true",
+			"Page":     strconv.Itoa(page),
+			"Size":     strconv.Itoa(PageSizeLarge),
+		}, &resp); err != nil {
+			return policy, WrapErrorf(err, "ListSilencePolicies got an error")
+		}
+		for _, p := range resp.PageBean.SilencePolicies {
+			if strconv.FormatInt(p.Id, 10) == id {
+				return p, nil
+			}
+		}
+		if len(resp.PageBean.SilencePolicies) < PageSizeLarge {
+			break
+		}
+	}
+	return policy, GetNotFoundErrorFromString(GetNotFoundMessage("ARMS Silence Policy", id))
+}
+
+func (client *AliyunClient) DescribeArmsNotificationPolicy(id string) (policy ArmsNotificationPolicy, err error) {
+	for page := 1; ; page++ {
+		var resp struct {
+			PageBean struct {
+				NotificationPolicies []ArmsNotificationPolicy `json:"NotificationPolicies"`
+			} `json:"PageBean"`
+		}
+		if err = client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "ListNotificationPolicies", map[string]string{
+			"IsDetail": "true",
+			"Page":     strconv.Itoa(page),
+			"Size":     strconv.Itoa(PageSizeLarge),
+		}, &resp); err != nil {
+			return policy, WrapErrorf(err, "ListNotificationPolicies got an error")
+		}
+		for _, p := range resp.PageBean.NotificationPolicies {
+			if strconv.FormatInt(p.Id, 10) == id {
+				return p, nil
+			}
+		}
+		if len(resp.PageBean.NotificationPolicies) < PageSizeLarge {
+			break
+		}
+	}
+	return policy, GetNotFoundErrorFromString(GetNotFoundMessage("ARMS Notification Policy", id))
+}
+
+func (client *AliyunClient) DescribeArmsPrometheus(id string) (prometheus ArmsPrometheus, err error) {
+	var resp struct {
+		Data *ArmsPrometheus `json:"Data"`
+	}
+	if err = client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "GetPrometheusInstance", map[string]string{
+		"ClusterId": id,
+	}, &resp); err != nil {
+		return prometheus, WrapErrorf(err, "GetPrometheusInstance got an error")
+	}
+	if resp.Data == nil || resp.Data.ClusterId != id {
+		return prometheus, GetNotFoundErrorFromString(GetNotFoundMessage("ARMS Prometheus", id))
+	}
+	return *resp.Data, nil
+}
+
+func (client *AliyunClient) DescribeArmsRemoteWrite(clusterId, name string) (remoteWrite ArmsRemoteWrite, err error) {
+	var resp struct {
+		Data *ArmsRemoteWrite `json:"Data"`
+	}
+	if err = client.ProcessRpcRequest(client.armsEndpoint(), ArmsApiVersion, "GetPrometheusRemoteWrite", map[string]string{
+		"ClusterId":       clusterId,
+		"RemoteWriteName": name,
+	}, &resp); err != nil {
+		return remoteWrite, WrapErrorf(err, "GetPrometheusRemoteWrite got an error")
+	}
+	if resp.Data == nil || resp.Data.RemoteWriteName != name {
+		return remoteWrite, GetNotFoundErrorFromString(GetNotFoundMessage("ARMS Remote Write", clusterId+COLON_SEPARATED+name))
+	}
+	return *resp.Data, nil
+}
+
+func (client *AliyunClient) WaitForArmsPrometheusDeleted(id string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		if _, err := client.DescribeArmsPrometheus(id); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("ARMS Prometheus", "Deleted")))
+	})
+}
@@ -0,0 +1,206 @@
+package alicloud
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const BssApiVersion = "2017-12-14"
+
+// The status of the subscription of a PrePaid resource
+const BssInstanceNormal = "Normal"
+
+// The renewal status of a PrePaid resource
+const (
+	RenewAutoRenewal   = "AutoRenewal"
+	RenewManualRenewal = "ManualRenewal"
+	RenewNotRenewal    = "NotRenewal"
+)
+
+// BssProduct identifies the subscription resources of a product in the billing system.
+type BssProduct struct {
+	Code string
+	Type string
+}
+
+var (
+	BssProductEcs = BssProduct{Code: "ecs"}
+	BssProductEip = BssProduct{Code: "eip", Type: "eip_pre"}
+	BssProductSlb = BssProduct{Code: "slb", Type: "slb_pre"}
+	BssProductNat = BssProduct{Code: "nat_gw", Type: "nat_gw_pre"}
+	BssProductCfw = BssProduct{Code: "vipcloudfw", Type: "vipcloudfw"}
+)
+
+// BssInstance is the subscription of a PrePaid resource and its renewal setting. RenewalDuration is in months.
+type BssInstance struct {
+	InstanceId      string
+	Status          string
+	RenewStatus     string
+	RenewalDuration int
+	EndTime         string
+}
+
+func (client *AliyunClient) bssEndpoint() string {
+	return client.config.getEndpoint(BssCode, "business.aliyuncs.com")
+}
+
+func (p BssProduct) params(instanceId string) map[string]string {
+	params := map[string]string{
+		"ProductCode":      p.Code,
+		"SubscriptionType": "Subscription",
+		"InstanceIDs":      instanceId,
+	}
+	if p.Type != "" {
+		params["ProductType"] = p.Type
+	}
+	return params
+}
+
+// DescribeBssInstance returns the subscription of the PrePaid resource of the product.
+func (client *AliyunClient) DescribeBssInstance(product BssProduct, instanceId string) (instance BssInstance, err error) {
+	var resp struct {
+		Success bool   `json:"Success"`
+		Code    string `json:"Code"`
+		Message string `json:"Message"`
+		Data    struct {
+			InstanceList []struct {
+				InstanceID          string `json:"InstanceID"`
+				Status              string `json:"Status"`
+				RenewStatus         string `json:"RenewStatus"`
+				RenewalDuration     int    `json:"RenewalDuration"`
+				RenewalDurationUnit string `json:"RenewalDurationUnit"`
+				EndTime             string `json:"EndTime"`
+			} `json:"InstanceList"`
+		} `json:"Data"`
+	}
+	if err = client.ProcessRpcRequest(client.bssEndpoint(), BssApiVersion, "QueryAvailableInstances", product.params(instanceId), &resp); err != nil {
+		return instance, WrapErrorf(err, "QueryAvailableInstances got an error")
+	}
+	if !resp.Success && resp.Code != "" {
+		return instance, WrapErrorf(fmt.Errorf("%s: %s", resp.Code, resp.Message), "QueryAvailableInstances got an error")
+	}
+	for _, i := range resp.Data.InstanceList {
+		if i.InstanceID != instanceId {
+			continue
+		}
+		instance = BssInstance{
+			InstanceId:      i.InstanceID,
+			Status:          i.Status,
+			RenewStatus:     i.RenewStatus,
+			RenewalDuration: i.RenewalDuration,
+			EndTime:         i.EndTime,
+		}
+		if i.RenewalDurationUnit == "Y" {
+			instance.RenewalDuration *= 12
+		}
+		return instance, nil
+	}
+	return instance, GetNotFoundErrorFromString(GetNotFoundMessage("Subscription", instanceId))
+}
+
+// WaitForBssInstance waits until the subscription of the PrePaid resource is in the status. The subscription can be found
+// only after the order is paid, so it is waited for when it is not found as well. Timeout is in seconds.
+func (client *AliyunClient) WaitForBssInstance(product BssProduct, instanceId, status string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		instance, err := client.DescribeBssInstance(product, instanceId)
+		if err != nil && !NotFoundError(err) {
+			return resource.NonRetryableError(err)
+		}
+		if err == nil && instance.Status == status {
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("Subscription", status)))
+	})
+}
+
+// SetRenewal sets the renewal status of the PrePaid resource of the product. The period, in months, is only used when
+// the status is AutoRenewal.
+func (client *AliyunClient) SetRenewal(product BssProduct, instanceId, status string, period int) error {
+	params := product.params(instanceId)
+	params["RenewalStatus"] = status
+	if status == RenewAutoRenewal && period > 0 {
+		params["RenewalPeriod"] = strconv.Itoa(period)
+		params["RenewalPeriodUnit"] = "M"
+	}
+	var resp struct {
+		Success bool   `json:"Success"`
+		Code    string `json:"Code"`
+		Message string `json:"Message"`
+	}
+	if err := client.ProcessRpcRequest(client.bssEndpoint(), BssApiVersion, "SetRenewal", params, &resp); err != nil {
+		return WrapErrorf(err, "SetRenewal got an error")
+	}
+	if !resp.Success && resp.Code != "" {
+		return WrapErrorf(fmt.Errorf("%s: %s", resp.Code, resp.Message), "SetRenewal got an error")
+	}
+	return nil
+}
+
+// CreateBssInstance buys a subscription of the product for the period in months and pays it automatically. The parameters
+// are the specification of the product, keyed by their codes.
+func (client *AliyunClient) CreateBssInstance(product BssProduct, period int, parameters map[string]string) (string, error) {
+	params := product.params("")
+	delete(params, "InstanceIDs")
+	params["Period"] = strconv.Itoa(period)
+	buildBssParameters(params, parameters)
+	params["ClientToken"] = buildClientToken("TF-CreateInstance")
+
+	var resp struct {
+		Success bool   `json:"Success"`
+		Code    string `json:"Code"`
+		Message string `json:"Message"`
+		Data    struct {
+			OrderId    string `json:"OrderId"`
+			InstanceId string `json:"InstanceId"`
+		} `json:"Data"`
+	}
+	if err := client.ProcessRpcRequest(client.bssEndpoint(), BssApiVersion, "CreateInstance", params, &resp); err != nil {
+		return "", WrapErrorf(err, "CreateInstance got an error")
+	}
+	if !resp.Success && resp.Code != "" {
+		return "", WrapErrorf(fmt.Errorf("%s: %s", resp.Code, resp.Message), "CreateInstance got an error")
+	}
+	return resp.Data.InstanceId, nil
+}
+
+// ModifyBssInstance upgrades or downgrades the specification of the PrePaid resource of the product.
+func (client *AliyunClient) ModifyBssInstance(product BssProduct, instanceId, modifyType string, parameters map[string]string) error {
+	params := product.params("")
+	delete(params, "InstanceIDs")
+	params["InstanceId"] = instanceId
+	params["ModifyType"] = modifyType
+	buildBssParameters(params, parameters)
+	params["ClientToken"] = buildClientToken("TF-ModifyInstance")
+
+	var resp struct {
+		Success bool   `json:"Success"`
+		Code    string `json:"Code"`
+		Message string `json:"Message"`
+	}
+	if err := client.ProcessRpcRequest(client.bssEndpoint(), BssApiVersion, "ModifyInstance", params, &resp); err != nil {
+		return WrapErrorf(err, "ModifyInstance got an error")
+	}
+	if !resp.Success && resp.Code != "" {
+		return WrapErrorf(fmt.Errorf("%s: %s", resp.Code, resp.Message), "ModifyInstance got an error")
+	}
+	return nil
+}
+
+func buildBssParameters(params map[string]string, parameters map[string]string) {
+	codes := make([]string, 0, len(parameters))
+	for code := range parameters {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for i, code := range codes {
+		params[fmt.Sprintf("Parameter.%d.Code", i+1)] = code
+		params[fmt.Sprintf("Parameter.%d.Value", i+1)] = parameters[code]
+	}
+}
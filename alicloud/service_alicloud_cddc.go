@@ -0,0 +1,67 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func (client *AliyunClient) cddcEndpoint() string {
+	return client.config.getEndpoint(CddcCode, "cddc.aliyuncs.com")
+}
+
+func (client *AliyunClient) DescribeCddcDedicatedHostGroup(id string) (group CddcDedicatedHostGroup, err error) {
+	var resp struct {
+		DedicatedHostGroups struct {
+			DedicatedHostGroups []CddcDedicatedHostGroup `json:"DedicatedHostGroups"`
+		} `json:"DedicatedHostGroups"`
+	}
+	if err = client.ProcessRpcRequest(client.cddcEndpoint(), CddcApiVersion, "DescribeDedicatedHostGroups", map[string]string{
+		"DedicatedHostGroupId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, CddcHostGroupNotFound) {
+			return group, GetNotFoundErrorFromString(GetNotFoundMessage("CDDC Dedicated Host Group", id))
+		}
+		return group, WrapErrorf(err, "DescribeDedicatedHostGroups got an error")
+	}
+	for _, g := range resp.DedicatedHostGroups.DedicatedHostGroups {
+		if g.DedicatedHostGroupId == id {
+			return g, nil
+		}
+	}
+	return group, GetNotFoundErrorFromString(GetNotFoundMessage("CDDC Dedicated Host Group", id))
+}
+
+func (client *AliyunClient) DescribeCddcDedicatedHost(groupId, hostId string) (host CddcDedicatedHost, err error) {
+	if err = client.ProcessRpcRequest(client.cddcEndpoint(), CddcApiVersion, "DescribeDedicatedHostAttribute", map[string]string{
+		"DedicatedHostGroupId": groupId,
+		"DedicatedHostId":      hostId,
+	}, &host); err != nil {
+		if IsExceptedError(err, CddcHostNotFound) || IsExceptedError(err, CddcHostGroupNotFound) {
+			return host, GetNotFoundErrorFromString(GetNotFoundMessage("CDDC Dedicated Host", hostId))
+		}
+		return host, WrapErrorf(err, "DescribeDedicatedHostAttribute got an error")
+	}
+	if host.DedicatedHostId != hostId {
+		return host, GetNotFoundErrorFromString(GetNotFoundMessage("CDDC Dedicated Host", hostId))
+	}
+	return host, nil
+}
+
+// WaitForCddcDedicatedHost waits until the host is running. Timeout is in seconds.
+func (client *AliyunClient) WaitForCddcDedicatedHost(groupId, hostId string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		host, err := client.DescribeCddcDedicatedHost(groupId, hostId)
+		if err != nil && !NotFoundError(err) {
+			return resource.NonRetryableError(err)
+		}
+		// The host can be found only after it is created.
+		if err == nil && host.HostStatus == CddcHostRunning {
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("CDDC Dedicated Host", "Running")))
+	})
+}
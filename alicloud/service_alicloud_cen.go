@@ -0,0 +1,201 @@
+package alicloud
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+type CenInstance struct {
+	CenId                  string `json:"CenId"`
+	Name                   string `json:"Name"`
+	Description            string `json:"Description"`
+	Status                 string `json:"Status"`
+	ProtectionLevel        string `json:"ProtectionLevel"`
+	CreationTime           string `json:"CreationTime"`
+	CenBandwidthPackageIds struct {
+		CenBandwidthPackageId []string `json:"CenBandwidthPackageId"`
+	} `json:"CenBandwidthPackageIds"`
+}
+
+type CenChildInstance struct {
+	CenId                   string `json:"CenId"`
+	ChildInstanceId         string `json:"ChildInstanceId"`
+	ChildInstanceType       string `json:"ChildInstanceType"`
+	ChildInstanceRegionId   string `json:"ChildInstanceRegionId"`
+	ChildInstanceOwnerId    int64  `json:"ChildInstanceOwnerId"`
+	ChildInstanceAttachTime string `json:"ChildInstanceAttachTime"`
+	Status                  string `json:"Status"`
+}
+
+type CenRouteEntryConflict struct {
+	DestinationCidrBlock string `json:"DestinationCidrBlock"`
+	RegionId             string `json:"RegionId"`
+	InstanceId           string `json:"InstanceId"`
+	InstanceType         string `json:"InstanceType"`
+	Status               string `json:"Status"`
+}
+
+type CenPublishedRouteEntry struct {
+	DestinationCidrBlock      string `json:"DestinationCidrBlock"`
+	ChildInstanceRouteTableId string `json:"ChildInstanceRouteTableId"`
+	NextHopType               string `json:"NextHopType"`
+	NextHopId                 string `json:"NextHopId"`
+	RouteType                 string `json:"RouteType"`
+	PublishStatus             string `json:"PublishStatus"`
+	OperationalMode           bool   `json:"OperationalMode"`
+	Conflicts                 struct {
+		Conflict []CenRouteEntryConflict `json:"Conflict"`
+	} `json:"Conflicts"`
+}
+
+type CenBandwidthPackage struct {
+	CenBandwidthPackageId      string `json:"CenBandwidthPackageId"`
+	Name                       string `json:"Name"`
+	Description                string `json:"Description"`
+	Bandwidth                  int    `json:"Bandwidth"`
+	BandwidthPackageChargeType string `json:"BandwidthPackageChargeType"`
+	GeographicRegionAId        string `json:"GeographicRegionAId"`
+	GeographicRegionBId        string `json:"GeographicRegionBId"`
+	BusinessStatus             string `json:"BusinessStatus"`
+	Status                     string `json:"Status"`
+	CreationTime               string `json:"CreationTime"`
+	ExpiredTime                string `json:"ExpiredTime"`
+	CenIds                     struct {
+		CenId []string `json:"CenId"`
+	} `json:"CenIds"`
+}
+
+func (client *AliyunClient) cenEndpoint() string {
+	return client.config.getEndpoint(CenCode, "cbn.aliyuncs.com")
+}
+
+// buildCenFilterParams converts the filters into the Filter.N.Key and Filter.N.Value.M parameters of the CEN APIs.
+// The keys are sorted so that the requests are stable.
+func buildCenFilterParams(params map[string]string, filters map[string][]string) {
+	var keys []string
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	n := 1
+	for _, key := range keys {
+		values := filters[key]
+		if len(values) < 1 {
+			continue
+		}
+		params[fmt.Sprintf("Filter.%d.Key", n)] = key
+		for i, v := range values {
+			params[fmt.Sprintf("Filter.%d.Value.%d", n, i+1)] = v
+		}
+		n++
+	}
+}
+
+// processCenPagedRequest invokes the paged CEN API until the page returned by fetch is not full.
+func (client *AliyunClient) processCenPagedRequest(action string, params map[string]string, fetch func() (int, error)) error {
+	params["PageSize"] = strconv.Itoa(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		params["PageNumber"] = strconv.Itoa(pageNumber)
+		count, err := fetch()
+		if err != nil {
+			return WrapErrorf(err, "%s got an error", action)
+		}
+		if count < PageSizeLarge {
+			return nil
+		}
+	}
+}
+
+// DescribeCenInstances returns the CEN instances matching the filters, which are keyed by CenId and Name.
+func (client *AliyunClient) DescribeCenInstances(filters map[string][]string) (cens []CenInstance, err error) {
+	params := make(map[string]string)
+	buildCenFilterParams(params, filters)
+	err = client.processCenPagedRequest("DescribeCens", params, func() (int, error) {
+		var resp struct {
+			Cens struct {
+				Cen []CenInstance `json:"Cen"`
+			} `json:"Cens"`
+		}
+		if err := client.ProcessRpcRequest(client.cenEndpoint(), CenApiVersion, "DescribeCens", params, &resp); err != nil {
+			return 0, err
+		}
+		cens = append(cens, resp.Cens.Cen...)
+		return len(resp.Cens.Cen), nil
+	})
+	return
+}
+
+// DescribeCenAttachedChildInstances returns the child instances attached to the CEN instance. The results can be
+// limited to a region and a type of the child instances.
+func (client *AliyunClient) DescribeCenAttachedChildInstances(cenId, regionId, instanceType string) (children []CenChildInstance, err error) {
+	params := map[string]string{
+		"CenId": cenId,
+	}
+	if regionId != "" {
+		params["ChildInstanceRegionId"] = regionId
+	}
+	if instanceType != "" {
+		params["ChildInstanceType"] = instanceType
+	}
+	err = client.processCenPagedRequest("DescribeCenAttachedChildInstances", params, func() (int, error) {
+		var resp struct {
+			ChildInstances struct {
+				ChildInstance []CenChildInstance `json:"ChildInstance"`
+			} `json:"ChildInstances"`
+		}
+		if err := client.ProcessRpcRequest(client.cenEndpoint(), CenApiVersion, "DescribeCenAttachedChildInstances", params, &resp); err != nil {
+			return 0, err
+		}
+		children = append(children, resp.ChildInstances.ChildInstance...)
+		return len(resp.ChildInstances.ChildInstance), nil
+	})
+	return
+}
+
+// DescribeCenPublishedRouteEntries returns the route entries of the child instance which are published to the CEN instance.
+// The route table is optional and the system route table of the child instance is used when it is empty.
+func (client *AliyunClient) DescribeCenPublishedRouteEntries(cenId, instanceId, instanceType, regionId, routeTableId string) (entries []CenPublishedRouteEntry, err error) {
+	params := map[string]string{
+		"CenId":                 cenId,
+		"ChildInstanceId":       instanceId,
+		"ChildInstanceType":     instanceType,
+		"ChildInstanceRegionId": regionId,
+	}
+	if routeTableId != "" {
+		params["ChildInstanceRouteTableId"] = routeTableId
+	}
+	err = client.processCenPagedRequest("DescribePublishedRouteEntries", params, func() (int, error) {
+		var resp struct {
+			PublishedRouteEntries struct {
+				PublishedRouteEntry []CenPublishedRouteEntry `json:"PublishedRouteEntry"`
+			} `json:"PublishedRouteEntries"`
+		}
+		if err := client.ProcessRpcRequest(client.cenEndpoint(), CenApiVersion, "DescribePublishedRouteEntries", params, &resp); err != nil {
+			return 0, err
+		}
+		entries = append(entries, resp.PublishedRouteEntries.PublishedRouteEntry...)
+		return len(resp.PublishedRouteEntries.PublishedRouteEntry), nil
+	})
+	return
+}
+
+// DescribeCenBandwidthPackages returns the bandwidth packages matching the filters, which are keyed by
+// CenId, CenBandwidthPackageId, Name and Status.
+func (client *AliyunClient) DescribeCenBandwidthPackages(filters map[string][]string) (packages []CenBandwidthPackage, err error) {
+	params := make(map[string]string)
+	buildCenFilterParams(params, filters)
+	err = client.processCenPagedRequest("DescribeCenBandwidthPackages", params, func() (int, error) {
+		var resp struct {
+			CenBandwidthPackages struct {
+				CenBandwidthPackage []CenBandwidthPackage `json:"CenBandwidthPackage"`
+			} `json:"CenBandwidthPackages"`
+		}
+		if err := client.ProcessRpcRequest(client.cenEndpoint(), CenApiVersion, "DescribeCenBandwidthPackages", params, &resp); err != nil {
+			return 0, err
+		}
+		packages = append(packages, resp.CenBandwidthPackages.CenBandwidthPackage...)
+		return len(resp.CenBandwidthPackages.CenBandwidthPackage), nil
+	})
+	return
+}
@@ -0,0 +1,57 @@
+package alicloud
+
+import (
+	"strconv"
+)
+
+func (client *AliyunClient) cloudFirewallEndpoint() string {
+	return client.config.getEndpoint(CloudFirewallCode, "cloudfw.aliyuncs.com")
+}
+
+func (client *AliyunClient) DescribeCloudFirewallControlPolicy(uuid, direction string) (policy CloudFirewallControlPolicy, err error) {
+	var resp struct {
+		Policys []CloudFirewallControlPolicy `json:"Policys"`
+	}
+	if err = client.ProcessRpcRequest(client.cloudFirewallEndpoint(), CloudFirewallApiVersion, "DescribeControlPolicy", map[string]string{
+		"AclUuid":     uuid,
+		"Direction":   direction,
+		"CurrentPage": "1",
+		"PageSize":    "10",
+	}, &resp); err != nil {
+		if IsExceptedError(err, CloudFirewallAclNotExist) {
+			return policy, GetNotFoundErrorFromString(GetNotFoundMessage("Cloud Firewall Control Policy", uuid))
+		}
+		return policy, WrapErrorf(err, "DescribeControlPolicy got an error")
+	}
+	for _, p := range resp.Policys {
+		if p.AclUuid == uuid {
+			return p, nil
+		}
+	}
+	return policy, GetNotFoundErrorFromString(GetNotFoundMessage("Cloud Firewall Control Policy", uuid))
+}
+
+// DescribeCloudFirewallAddressBook returns the address book by its uuid. The address books can not be filtered by
+// the uuid, so all of them are paged through.
+func (client *AliyunClient) DescribeCloudFirewallAddressBook(uuid string) (book CloudFirewallAddressBook, err error) {
+	for page := 1; ; page++ {
+		var resp struct {
+			Acls []CloudFirewallAddressBook `json:"Acls"`
+		}
+		if err = client.ProcessRpcRequest(client.cloudFirewallEndpoint(), CloudFirewallApiVersion, "DescribeAddressBook", map[string]string{
+			"CurrentPage": strconv.Itoa(page),
+			"PageSize":    strconv.Itoa(PageSizeLarge),
+		}, &resp); err != nil {
+			return book, WrapErrorf(err, "DescribeAddressBook got an error")
+		}
+		for _, b := range resp.Acls {
+			if b.GroupUuid == uuid {
+				return b, nil
+			}
+		}
+		if len(resp.Acls) < PageSizeLarge {
+			break
+		}
+	}
+	return book, GetNotFoundErrorFromString(GetNotFoundMessage("Cloud Firewall Address Book", uuid))
+}
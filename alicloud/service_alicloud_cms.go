@@ -0,0 +1,111 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) cmsEndpoint() string {
+	return client.config.getEndpoint(CmsCode, "metrics.aliyuncs.com")
+}
+
+// ProcessCmsRequest invokes the CloudMonitor API and converts an unsuccessful response into successFlagError.
+func (client *AliyunClient) ProcessCmsRequest(action string, params map[string]string, result interface{}) error {
+	return processSuccessFlagRequest(CmsCode, action, func(raw *json.RawMessage) error {
+		return client.ProcessRpcRequest(client.cmsEndpoint(), CmsApiVersion, action, params, raw)
+	}, result)
+}
+
+func (client *AliyunClient) DescribeCmsMonitorGroup(id string) (group CmsMonitorGroup, err error) {
+	var resp struct {
+		Resources struct {
+			Resource []CmsMonitorGroup `json:"Resource"`
+		} `json:"Resources"`
+	}
+	if err = client.ProcessCmsRequest("DescribeMonitorGroups", map[string]string{
+		"GroupId":                id,
+		"IncludeTemplateHistory": "true",
+	}, &resp); err != nil {
+		if IsExceptedError(err, CmsResourceNotFound) {
+			return group, GetNotFoundErrorFromString(GetNotFoundMessage("CMS Monitor Group", id))
+		}
+		return group, WrapErrorf(err, "DescribeMonitorGroups got an error")
+	}
+	for _, g := range resp.Resources.Resource {
+		if fmt.Sprint(g.GroupId) == id {
+			return g, nil
+		}
+	}
+	return group, GetNotFoundErrorFromString(GetNotFoundMessage("CMS Monitor Group", id))
+}
+
+func (client *AliyunClient) DescribeCmsDynamicTagGroup(id string) (group CmsDynamicTagGroup, err error) {
+	var resp struct {
+		TagGroupList struct {
+			TagGroup []CmsDynamicTagGroup `json:"TagGroup"`
+		} `json:"TagGroupList"`
+	}
+	if err = client.ProcessCmsRequest("DescribeDynamicTagRuleList", map[string]string{
+		"DynamicTagRuleId": id,
+		"PageNumber":       "1",
+		"PageSize":         "30",
+	}, &resp); err != nil {
+		if IsExceptedError(err, CmsResourceNotFound) {
+			return group, GetNotFoundErrorFromString(GetNotFoundMessage("CMS Dynamic Tag Group", id))
+		}
+		return group, WrapErrorf(err, "DescribeDynamicTagRuleList got an error")
+	}
+	for _, g := range resp.TagGroupList.TagGroup {
+		if g.DynamicTagRuleId == id {
+			return g, nil
+		}
+	}
+	return group, GetNotFoundErrorFromString(GetNotFoundMessage("CMS Dynamic Tag Group", id))
+}
+
+func (client *AliyunClient) DescribeCmsMetricRuleTemplate(id string) (template CmsMetricRuleTemplate, err error) {
+	var resp struct {
+		Resource CmsMetricRuleTemplate `json:"Resource"`
+	}
+	if err = client.ProcessCmsRequest("DescribeMetricRuleTemplateAttribute", map[string]string{
+		"TemplateId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, CmsResourceNotFound) {
+			return template, GetNotFoundErrorFromString(GetNotFoundMessage("CMS Metric Rule Template", id))
+		}
+		return template, WrapErrorf(err, "DescribeMetricRuleTemplateAttribute got an error")
+	}
+	if fmt.Sprint(resp.Resource.TemplateId) != id {
+		return template, GetNotFoundErrorFromString(GetNotFoundMessage("CMS Metric Rule Template", id))
+	}
+	return resp.Resource, nil
+}
+
+// DescribeCmsMetricRuleIds returns the IDs of the metric rules of the monitor group, keyed by the rule names.
+func (client *AliyunClient) DescribeCmsMetricRuleIds(groupId string) (map[string]string, error) {
+	ids := make(map[string]string)
+	for page := 1; ; page++ {
+		var resp struct {
+			Alarms struct {
+				Alarm []struct {
+					RuleId   string `json:"RuleId"`
+					RuleName string `json:"RuleName"`
+				} `json:"Alarm"`
+			} `json:"Alarms"`
+		}
+		if err := client.ProcessCmsRequest("DescribeMetricRuleList", map[string]string{
+			"GroupId":  groupId,
+			"Page":     strconv.Itoa(page),
+			"PageSize": strconv.Itoa(PageSizeLarge),
+		}, &resp); err != nil {
+			return nil, WrapErrorf(err, "DescribeMetricRuleList got an error")
+		}
+		for _, alarm := range resp.Alarms.Alarm {
+			ids[alarm.RuleName] = alarm.RuleId
+		}
+		if len(resp.Alarms.Alarm) < PageSizeLarge {
+			return ids, nil
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -39,7 +41,7 @@ func ContainerApplicationTemplateAreEquivalent(tem1, tem2 string) (bool, error)
 
 func (client *AliyunClient) GetContainerClusterByName(name string) (cluster cs.ClusterType, err error) {
 	name = Trim(name)
-	clusters, err := client.csconn.DescribeClusters(name)
+	clusters, err := client.csconn().DescribeClusters(name)
 	if err != nil {
 		return cluster, fmt.Errorf("Describe cluster failed by name %s: %#v.", name, err)
 	}
@@ -62,7 +64,7 @@ func (client *AliyunClient) GetApplicationClientByClusterName(name string) (c *c
 		return nil, err
 	}
 
-	certs, err := client.csconn.GetClusterCerts(cluster.ClusterID)
+	certs, err := client.csconn().GetClusterCerts(cluster.ClusterID)
 	if err != nil {
 		return
 	}
@@ -73,7 +75,7 @@ func (client *AliyunClient) GetApplicationClientByClusterName(name string) (c *c
 		return nil, fmt.Errorf("Getting Application Client failed by cluster id %s: %#v.", cluster.ClusterID, err)
 	}
 	c.SetDebug(false)
-	c.SetUserAgent(getUserAgent())
+	c.SetUserAgent(client.config.getUserAgent())
 
 	return
 }
@@ -119,3 +121,94 @@ func (client *AliyunClient) WaitForContainerApplication(clusterName, appName str
 	}
 	return nil
 }
+
+// KubernetesCluster is a cluster returned by DescribeClusters, with the type and the version which are missing from
+// the aliyungo ClusterType.
+type KubernetesCluster struct {
+	ClusterId              string `json:"cluster_id"`
+	Name                   string `json:"name"`
+	ClusterType            string `json:"cluster_type"`
+	CurrentVersion         string `json:"current_version"`
+	State                  string `json:"state"`
+	RegionId               string `json:"region_id"`
+	ZoneId                 string `json:"zone_id"`
+	VpcId                  string `json:"vpc_id"`
+	VSwitchId              string `json:"vswitch_id"`
+	SecurityGroupId        string `json:"security_group_id"`
+	ExternalLoadbalancerId string `json:"external_loadbalancer_id"`
+	MasterUrl              string `json:"master_url"`
+	Size                   int    `json:"size"`
+	Created                string `json:"created"`
+}
+
+// KubernetesClusterConnections are the API server endpoints encoded as JSON in the master_url of a cluster.
+type KubernetesClusterConnections struct {
+	ApiServerInternet string `json:"api_server_endpoint"`
+	ApiServerIntranet string `json:"intranet_api_server_endpoint"`
+}
+
+type KubernetesNodePool struct {
+	NodePoolInfo struct {
+		NodePoolId string `json:"nodepool_id"`
+		Name       string `json:"name"`
+		Type       string `json:"type"`
+	} `json:"nodepool_info"`
+	ScalingGroup struct {
+		InstanceTypes []string `json:"instance_types"`
+		VSwitchIds    []string `json:"vswitch_ids"`
+	} `json:"scaling_group"`
+	Status struct {
+		TotalNodes int    `json:"total_nodes"`
+		State      string `json:"state"`
+	} `json:"status"`
+}
+
+// DescribeKubernetesClusters returns the Kubernetes clusters of the current region, including the managed ones.
+func (client *AliyunClient) DescribeKubernetesClusters() (clusters []KubernetesCluster, err error) {
+	var all []KubernetesCluster
+	if err := client.csconn().Invoke("", http.MethodGet, "/clusters", nil, nil, &all); err != nil {
+		return nil, fmt.Errorf("DescribeClusters got an error: %#v", err)
+	}
+	for _, cluster := range all {
+		if cluster.RegionId != string(client.Region) {
+			continue
+		}
+		if cluster.ClusterType != string(KubernetesClusterType) && cluster.ClusterType != string(ManagedKubernetesClusterType) {
+			continue
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
+
+// describeKubernetesConnections parses the API server endpoints of the cluster.
+func describeKubernetesConnections(cluster KubernetesCluster) (connections KubernetesClusterConnections) {
+	if cluster.MasterUrl == "" {
+		return
+	}
+	if err := json.Unmarshal([]byte(cluster.MasterUrl), &connections); err != nil {
+		log.Printf("[WARN] Parsing the master_url %s of cluster %s got an error: %#v", cluster.MasterUrl, cluster.ClusterId, err)
+	}
+	return
+}
+
+func (client *AliyunClient) DescribeKubernetesNodePools(clusterId string) (nodePools []KubernetesNodePool, err error) {
+	var resp struct {
+		NodePools []KubernetesNodePool `json:"nodepools"`
+	}
+	if err := client.csconn().Invoke("", http.MethodGet, "/clusters/"+clusterId+"/nodepools", nil, nil, &resp); err != nil {
+		return nil, fmt.Errorf("DescribeClusterNodePools got an error: %#v", err)
+	}
+	return resp.NodePools, nil
+}
+
+// DescribeKubernetesUserConfig returns the kubeconfig of the cluster for the current user.
+func (client *AliyunClient) DescribeKubernetesUserConfig(clusterId string) (string, error) {
+	var resp struct {
+		Config string `json:"config"`
+	}
+	if err := client.csconn().Invoke("", http.MethodGet, "/k8s/"+clusterId+"/user_config", nil, nil, &resp); err != nil {
+		return "", fmt.Errorf("DescribeClusterUserKubeconfig got an error: %#v", err)
+	}
+	return resp.Config, nil
+}
@@ -0,0 +1,57 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func (client *AliyunClient) dbsEndpoint() string {
+	return client.config.getEndpoint(DbsCode, fmt.Sprintf("dbs-api.%s.aliyuncs.com", client.Region))
+}
+
+// ProcessDbsRequest invokes the Database Backup API and converts an unsuccessful response into successFlagError.
+func (client *AliyunClient) ProcessDbsRequest(action string, params map[string]string, result interface{}) error {
+	return processSuccessFlagRequest(DbsCode, action, func(raw *json.RawMessage) error {
+		return client.ProcessRpcRequest(client.dbsEndpoint(), DbsApiVersion, action, params, raw)
+	}, result)
+}
+
+func (client *AliyunClient) DescribeDbsBackupPlan(id string) (plan DbsBackupPlan, err error) {
+	var resp struct {
+		Items struct {
+			BackupPlanDetail []DbsBackupPlan `json:"BackupPlanDetail"`
+		} `json:"Items"`
+	}
+	if err = client.ProcessDbsRequest("DescribeBackupPlanList", map[string]string{
+		"BackupPlanId": id,
+		"Region":       string(client.Region),
+	}, &resp); err != nil {
+		if IsExceptedError(err, DbsBackupPlanNotFound) {
+			return plan, GetNotFoundErrorFromString(GetNotFoundMessage("DBS Backup Plan", id))
+		}
+		return plan, WrapErrorf(err, "DescribeBackupPlanList got an error")
+	}
+	for _, p := range resp.Items.BackupPlanDetail {
+		if p.BackupPlanId == id {
+			return p, nil
+		}
+	}
+	return plan, GetNotFoundErrorFromString(GetNotFoundMessage("DBS Backup Plan", id))
+}
+
+// WaitForDbsBackupPlan waits until the backup plan is in the status. Timeout is in seconds.
+func (client *AliyunClient) WaitForDbsBackupPlan(id, status string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		plan, err := client.DescribeDbsBackupPlan(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if plan.BackupPlanStatus == status {
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("DBS Backup Plan", status)))
+	})
+}
@@ -0,0 +1,121 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// DmsEnterpriseError represents a failed DMS Enterprise API call. The API answers most of
+// the failures with http code 200, and the failure is reported by Success and ErrorCode.
+type DmsEnterpriseError struct {
+	RequestId    string `json:"RequestId"`
+	Success      bool   `json:"Success"`
+	ErrorCode    string `json:"ErrorCode"`
+	ErrorMessage string `json:"ErrorMessage"`
+}
+
+func (e *DmsEnterpriseError) Error() string {
+	return fmt.Sprintf("DMS Enterprise Error: Code: %s Message: %s RequestId: %s", e.ErrorCode, e.ErrorMessage, e.RequestId)
+}
+
+type DmsEnterpriseInstance struct {
+	InstanceId     string `json:"InstanceId"`
+	InstanceAlias  string `json:"InstanceAlias"`
+	InstanceType   string `json:"InstanceType"`
+	InstanceSource string `json:"InstanceSource"`
+	EnvType        string `json:"EnvType"`
+	Host           string `json:"Host"`
+	Port           int    `json:"Port"`
+	Sid            string `json:"Sid"`
+	DatabaseUser   string `json:"DatabaseUser"`
+	DbaId          string `json:"DbaId"`
+	DbaNickName    string `json:"DbaNickName"`
+	SafeRuleId     string `json:"SafeRuleId"`
+	QueryTimeout   int    `json:"QueryTimeout"`
+	ExportTimeout  int    `json:"ExportTimeout"`
+	EcsInstanceId  string `json:"EcsInstanceId"`
+	EcsRegion      string `json:"EcsRegion"`
+	VpcId          string `json:"VpcId"`
+	DataLinkName   string `json:"DataLinkName"`
+	DdlOnline      int    `json:"DdlOnline"`
+	UseDsql        int    `json:"UseDsql"`
+	State          string `json:"State"`
+}
+
+type GetDmsEnterpriseInstanceResponse struct {
+	Instance DmsEnterpriseInstance `json:"Instance"`
+}
+
+type DmsEnterpriseUser struct {
+	Uid       string `json:"Uid"`
+	UserId    string `json:"UserId"`
+	NickName  string `json:"NickName"`
+	Mobile    string `json:"Mobile"`
+	State     string `json:"State"`
+	RoleNames struct {
+		RoleNames []string `json:"RoleNames"`
+	} `json:"RoleNameList"`
+}
+
+type GetDmsEnterpriseUserResponse struct {
+	User DmsEnterpriseUser `json:"User"`
+}
+
+func (client *AliyunClient) dmsEnterpriseEndpoint() string {
+	return client.config.getEndpoint(DmsEnterpriseCode, fmt.Sprintf("dms-enterprise.%s.aliyuncs.com", client.Region))
+}
+
+// ProcessDmsEnterpriseRequest invokes the DMS Enterprise API and converts an unsuccessful
+// response into DmsEnterpriseError.
+func (client *AliyunClient) ProcessDmsEnterpriseRequest(action string, params map[string]string, result interface{}) error {
+	var raw json.RawMessage
+	if err := client.ProcessRpcRequest(client.dmsEnterpriseEndpoint(), DmsEnterpriseApiVersion, action, params, &raw); err != nil {
+		return err
+	}
+
+	e := &DmsEnterpriseError{}
+	if err := json.Unmarshal(raw, e); err != nil {
+		return fmt.Errorf("Unmarshalling %s response got an error: %#v", action, err)
+	}
+	if !e.Success {
+		return e
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, result)
+}
+
+func (client *AliyunClient) DescribeDmsEnterpriseInstance(host string, port int) (instance DmsEnterpriseInstance, err error) {
+	var resp GetDmsEnterpriseInstanceResponse
+	if err = client.ProcessDmsEnterpriseRequest("GetInstance", map[string]string{
+		"Host": host,
+		"Port": strconv.Itoa(port),
+	}, &resp); err != nil {
+		if IsExceptedError(err, DmsEnterpriseInstanceNotFound) {
+			return instance, GetNotFoundErrorFromString(GetNotFoundMessage("DMS Enterprise Instance", host))
+		}
+		return
+	}
+	if resp.Instance.Host != host {
+		return instance, GetNotFoundErrorFromString(GetNotFoundMessage("DMS Enterprise Instance", host))
+	}
+	return resp.Instance, nil
+}
+
+func (client *AliyunClient) DescribeDmsEnterpriseUser(uid string) (user DmsEnterpriseUser, err error) {
+	var resp GetDmsEnterpriseUserResponse
+	if err = client.ProcessDmsEnterpriseRequest("GetUser", map[string]string{
+		"Uid": uid,
+	}, &resp); err != nil {
+		if IsExceptedError(err, DmsEnterpriseUserNotFound) {
+			return user, GetNotFoundErrorFromString(GetNotFoundMessage("DMS Enterprise User", uid))
+		}
+		return
+	}
+	if resp.User.Uid != uid {
+		return user, GetNotFoundErrorFromString(GetNotFoundMessage("DMS Enterprise User", uid))
+	}
+	return resp.User, nil
+}
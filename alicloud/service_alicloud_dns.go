@@ -0,0 +1,39 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+)
+
+type DnsRecordLine struct {
+	LineCode        string `json:"LineCode"`
+	LineName        string `json:"LineName"`
+	LineDisplayName string `json:"LineDisplayName"`
+	FatherCode      string `json:"FatherCode"`
+}
+
+type describeDnsSupportLinesArgs struct {
+	DomainName string
+	Lang       string
+}
+
+type describeDnsSupportLinesResponse struct {
+	common.Response
+	RecordLines struct {
+		RecordLine []DnsRecordLine `json:"RecordLine"`
+	} `json:"RecordLines"`
+}
+
+// DescribeDnsSupportLines returns the resolution lines supported by AliDNS. When domainName is set,
+// the lines available to the edition of that domain are returned instead of the default ones.
+// The aliyungo dns client does not wrap DescribeSupportLines, so it is invoked directly.
+func (client *AliyunClient) DescribeDnsSupportLines(domainName, lang string) ([]DnsRecordLine, error) {
+	args := &describeDnsSupportLinesArgs{
+		DomainName: domainName,
+		Lang:       lang,
+	}
+	resp := &describeDnsSupportLinesResponse{}
+	if err := client.dnsconn().Invoke("DescribeSupportLines", args, resp); err != nil {
+		return nil, WrapErrorf(err, "DescribeSupportLines got an error")
+	}
+	return resp.RecordLines.RecordLine, nil
+}
@@ -4,78 +4,41 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func (client *AliyunClient) DescribeImage(imageId string) (*ecs.ImageType, error) {
-
-	pagination := common.Pagination{
-		PageNumber: 1,
-	}
-	args := ecs.DescribeImagesArgs{
-		Pagination: pagination,
-		RegionId:   client.Region,
-		Status:     ecs.ImageStatusAvailable,
-	}
-
-	var allImages []ecs.ImageType
-
-	for {
-		images, _, err := client.ecsconn.DescribeImages(&args)
-		if err != nil {
-			break
-		}
-
-		if len(images) == 0 {
-			break
-		}
-
-		allImages = append(allImages, images...)
-
-		args.Pagination.PageNumber++
+// DescribeZones returns the zones of the region of the client.
+func (client *AliyunClient) DescribeZones() ([]ecs.Zone, error) {
+	request := ecs.CreateDescribeZonesRequest()
+	request.RegionId = string(client.Region)
+	response := ecs.CreateDescribeZonesResponse()
+	if err := client.doAction(client.ecsconn(), request, response); err != nil {
+		return nil, WrapErrorf(err, "DescribeZones got an error")
 	}
-
-	if len(allImages) == 0 {
-		return nil, common.GetClientErrorFromString("Not found")
-	}
-
-	var image *ecs.ImageType
-	imageIds := []string{}
-	for _, im := range allImages {
-		if im.ImageId == imageId {
-			image = &im
-		}
-		imageIds = append(imageIds, im.ImageId)
-	}
-
-	if image == nil {
-		return nil, fmt.Errorf("image_id %s not exists in range %s, all images are %s", imageId, client.Region, imageIds)
-	}
-
-	return image, nil
+	return response.Zones.Zone, nil
 }
 
 // DescribeZone validate zoneId is valid in region
-func (client *AliyunClient) DescribeZone(zoneID string) (*ecs.ZoneType, error) {
-	zones, err := client.ecsconn.DescribeZones(client.Region)
+func (client *AliyunClient) DescribeZone(zoneID string) (*ecs.Zone, error) {
+	zones, err := client.DescribeZones()
 	if err != nil {
 		return nil, fmt.Errorf("error to list zones not found")
 	}
 
-	var zone *ecs.ZoneType
+	var zone *ecs.Zone
 	zoneIds := []string{}
-	for _, z := range zones {
+	for i, z := range zones {
 		if z.ZoneId == zoneID {
-			zone = &ecs.ZoneType{
-				ZoneId:                    z.ZoneId,
-				LocalName:                 z.LocalName,
-				AvailableResourceCreation: z.AvailableResourceCreation,
-				AvailableDiskCategories:   z.AvailableDiskCategories,
-			}
+			zone = &zones[i]
 		}
 		zoneIds = append(zoneIds, z.ZoneId)
 	}
@@ -87,27 +50,25 @@ func (client *AliyunClient) DescribeZone(zoneID string) (*ecs.ZoneType, error) {
 	return zone, nil
 }
 
-func (client *AliyunClient) QueryInstancesByIds(ids []string) (instances []ecs.InstanceAttributesType, err error) {
+func (client *AliyunClient) QueryInstancesByIds(ids []string) (instances []ecs.Instance, err error) {
 	idsStr, jerr := json.Marshal(ids)
 	if jerr != nil {
 		return nil, jerr
 	}
 
-	args := ecs.DescribeInstancesArgs{
-		RegionId:    client.Region,
-		InstanceIds: string(idsStr),
-	}
-
-	instances, _, errs := client.ecsconn.DescribeInstances(&args)
-
-	if errs != nil {
-		return nil, errs
+	request := ecs.CreateDescribeInstancesRequest()
+	request.RegionId = string(client.Region)
+	request.InstanceIds = string(idsStr)
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	response := ecs.CreateDescribeInstancesResponse()
+	if err := client.doAction(client.ecsconn(), request, response); err != nil {
+		return nil, err
 	}
 
-	return instances, nil
+	return response.Instances.Instance, nil
 }
 
-func (client *AliyunClient) QueryInstancesById(id string) (instance *ecs.InstanceAttributesType, err error) {
+func (client *AliyunClient) QueryInstancesById(id string) (instance *ecs.Instance, err error) {
 	ids := []string{id}
 
 	instances, errs := client.QueryInstancesByIds(ids)
@@ -122,13 +83,100 @@ func (client *AliyunClient) QueryInstancesById(id string) (instance *ecs.Instanc
 	return &instances[0], nil
 }
 
-func (client *AliyunClient) QueryInstanceSystemDisk(id string) (disk *ecs.DiskItemType, err error) {
-	args := ecs.DescribeDisksArgs{
-		RegionId:   client.Region,
-		InstanceId: string(id),
-		DiskType:   ecs.DiskTypeAllSystem,
+// DescribeEcsRegions returns the regions available to the account together with their ECS endpoints.
+func (client *AliyunClient) DescribeEcsRegions() (regions []ecs.Region, err error) {
+	request := ecs.CreateDescribeRegionsRequest()
+	response := ecs.CreateDescribeRegionsResponse()
+	if err = client.doAction(client.ecsconn(), request, response); err != nil {
+		return nil, err
 	}
-	disks, _, err := client.ecsconn.DescribeDisks(&args)
+	return response.Regions.Region, nil
+}
+
+// DescribeEcsInstance describes the instance by DescribeInstances, which returns its deletion protection and credit
+// specification besides the attributes returned by DescribeInstanceAttribute.
+func (client *AliyunClient) DescribeEcsInstance(instanceId string) (instance ecs.Instance, err error) {
+	instances, err := client.QueryInstancesByIds([]string{instanceId})
+	if err != nil {
+		return instance, err
+	}
+	if len(instances) < 1 || instances[0].InstanceId != instanceId {
+		return instance, GetNotFoundErrorFromString(InstanceNotFound)
+	}
+	return instances[0], nil
+}
+
+// InstanceStateRefreshFunc returns a resource.StateRefreshFunc which refreshes the status of an instance.
+// It returns a nil instance while the instance is not found, which happens just after it is created.
+func (client *AliyunClient) InstanceStateRefreshFunc(instanceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		request := ecs.CreateDescribeInstanceAttributeRequest()
+		request.InstanceId = instanceId
+		instance := ecs.CreateDescribeInstanceAttributeResponse()
+		if err := client.doAction(client.ecsconn(), request, instance); err != nil {
+			if NotFoundError(err) || IsExceptedError(err, InvalidInstanceIdNotFound) {
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+		return instance, instance.Status, nil
+	}
+}
+
+// WaitForInstance waits for the instance to reach the status, and timeout is in seconds.
+func (client *AliyunClient) WaitForInstance(instanceId string, status Status, timeout int) error {
+	statuses := []Status{Pending, Starting, Running, Stopping, Stopped}
+	return waitForStatus("Instance", client.InstanceStateRefreshFunc(instanceId), pendingStatuses(statuses, status), string(status), timeout)
+}
+
+func (client *AliyunClient) ecsEndpoint() string {
+	return client.config.getEndpoint(EcsCode, "ecs.aliyuncs.com")
+}
+
+// StopInstance stops the instance with the billing mode of the stopped instance, KeepCharging or StopCharging.
+func (client *AliyunClient) StopInstance(instanceId string, forceStop bool, stoppedMode string) error {
+	request := ecs.CreateStopInstanceRequest()
+	request.InstanceId = instanceId
+	request.ForceStop = requests.NewBoolean(forceStop)
+	request.StoppedMode = stoppedMode
+	return client.doAction(client.ecsconn(), request, ecs.CreateStopInstanceResponse())
+}
+
+func (client *AliyunClient) StartInstance(instanceId string) error {
+	request := ecs.CreateStartInstanceRequest()
+	request.InstanceId = instanceId
+	return client.doAction(client.ecsconn(), request, ecs.CreateStartInstanceResponse())
+}
+
+func (client *AliyunClient) AllocatePublicIpAddress(instanceId string) error {
+	request := ecs.CreateAllocatePublicIpAddressRequest()
+	request.InstanceId = instanceId
+	return client.doAction(client.ecsconn(), request, ecs.CreateAllocatePublicIpAddressResponse())
+}
+
+// DescribeInstanceNetworkInterfaces returns the network interfaces of the type which are attached to the instance.
+// All of the attached network interfaces are returned if the type is empty.
+func (client *AliyunClient) DescribeInstanceNetworkInterfaces(instanceId, eniType string) (enis []ecs.NetworkInterfaceSet, err error) {
+	request := ecs.CreateDescribeNetworkInterfacesRequest()
+	request.RegionId = string(client.Region)
+	request.InstanceId = instanceId
+	request.Type = eniType
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		resp := ecs.CreateDescribeNetworkInterfacesResponse()
+		if err := client.doAction(client.ecsconn(), request, resp); err != nil {
+			return nil, err
+		}
+		enis = append(enis, resp.NetworkInterfaceSets.NetworkInterfaceSet...)
+		if len(resp.NetworkInterfaceSets.NetworkInterfaceSet) < PageSizeLarge {
+			return enis, nil
+		}
+	}
+}
+
+func (client *AliyunClient) QueryInstanceSystemDisk(id string) (disk *ecs.Disk, err error) {
+	disks, err := client.QueryInstanceDisks(id, DiskTypeSystem)
 	if err != nil {
 		return nil, err
 	}
@@ -139,11 +187,20 @@ func (client *AliyunClient) QueryInstanceSystemDisk(id string) (disk *ecs.DiskIt
 	return &disks[0], nil
 }
 
+// QueryInstanceDisks returns the disks of the type attached to the instance.
+func (client *AliyunClient) QueryInstanceDisks(id, diskType string) ([]ecs.Disk, error) {
+	request := ecs.CreateDescribeDisksRequest()
+	request.RegionId = string(client.Region)
+	request.InstanceId = id
+	request.DiskType = diskType
+	return client.DescribeEcsDisks(request)
+}
+
 // ResourceAvailable check resource available for zone
-func (client *AliyunClient) ResourceAvailable(zone *ecs.ZoneType, resourceType ecs.ResourceType) error {
+func (client *AliyunClient) ResourceAvailable(zone *ecs.Zone, resourceType ResourceType) error {
 	available := false
 	for _, res := range zone.AvailableResourceCreation.ResourceTypes {
-		if res == resourceType {
+		if res == string(resourceType) {
 			available = true
 		}
 	}
@@ -154,10 +211,10 @@ func (client *AliyunClient) ResourceAvailable(zone *ecs.ZoneType, resourceType e
 	return nil
 }
 
-func (client *AliyunClient) DiskAvailable(zone *ecs.ZoneType, diskCategory ecs.DiskCategory) error {
+func (client *AliyunClient) DiskAvailable(zone *ecs.Zone, diskCategory DiskCategory) error {
 	available := false
 	for _, dist := range zone.AvailableDiskCategories.DiskCategories {
-		if dist == diskCategory {
+		if dist == string(diskCategory) {
 			available = true
 		}
 	}
@@ -170,10 +227,11 @@ func (client *AliyunClient) DiskAvailable(zone *ecs.ZoneType, diskCategory ecs.D
 // todo: support syc
 func (client *AliyunClient) JoinSecurityGroups(instanceId string, securityGroupIds []string) error {
 	for _, sid := range securityGroupIds {
-		err := client.ecsconn.JoinSecurityGroup(instanceId, sid)
-		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code != InvalidInstanceIdAlreadyExists {
+		request := ecs.CreateJoinSecurityGroupRequest()
+		request.InstanceId = instanceId
+		request.SecurityGroupId = sid
+		if err := client.doAction(client.ecsconn(), request, ecs.CreateJoinSecurityGroupResponse()); err != nil {
+			if !IsExceptedError(err, InvalidInstanceIdAlreadyExists) {
 				return err
 			}
 		}
@@ -184,10 +242,11 @@ func (client *AliyunClient) JoinSecurityGroups(instanceId string, securityGroupI
 
 func (client *AliyunClient) LeaveSecurityGroups(instanceId string, securityGroupIds []string) error {
 	for _, sid := range securityGroupIds {
-		err := client.ecsconn.LeaveSecurityGroup(instanceId, sid)
-		if err != nil {
-			e, _ := err.(*common.Error)
-			if e.ErrorResponse.Code != InvalidSecurityGroupIdNotFound {
+		request := ecs.CreateLeaveSecurityGroupRequest()
+		request.InstanceId = instanceId
+		request.SecurityGroupId = sid
+		if err := client.doAction(client.ecsconn(), request, ecs.CreateLeaveSecurityGroupResponse()); err != nil {
+			if !IsExceptedError(err, InvalidSecurityGroupIdNotFound) {
 				return err
 			}
 		}
@@ -197,40 +256,237 @@ func (client *AliyunClient) LeaveSecurityGroups(instanceId string, securityGroup
 }
 
 func (client *AliyunClient) DescribeSecurity(securityGroupId string) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
+	request := ecs.CreateDescribeSecurityGroupAttributeRequest()
+	request.RegionId = string(client.Region)
+	request.SecurityGroupId = securityGroupId
+	response := ecs.CreateDescribeSecurityGroupAttributeResponse()
+	if err := client.doAction(client.ecsconn(), request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
 
-	args := &ecs.DescribeSecurityGroupAttributeArgs{
-		RegionId:        client.Region,
-		SecurityGroupId: securityGroupId,
+// DescribeSecurityGroupType returns the type of the security group, normal or enterprise.
+// The type is not returned by DescribeSecurityGroupAttribute.
+func (client *AliyunClient) DescribeSecurityGroupType(securityGroupId string) (string, error) {
+	request := ecs.CreateDescribeSecurityGroupsRequest()
+	request.RegionId = string(client.Region)
+	request.SecurityGroupIds = convertListToJsonString([]interface{}{securityGroupId})
+	resp := ecs.CreateDescribeSecurityGroupsResponse()
+	if err := client.doAction(client.ecsconn(), request, resp); err != nil {
+		return "", err
+	}
+	if len(resp.SecurityGroups.SecurityGroup) < 1 {
+		return "", GetNotFoundErrorFromString(GetNotFoundMessage("Security Group", securityGroupId))
 	}
+	return resp.SecurityGroups.SecurityGroup[0].SecurityGroupType, nil
+}
 
-	return client.ecsconn.DescribeSecurityGroupAttribute(args)
+// EcsImage is an image with the family and the features which are not returned by DescribeImages of the vendored
+// SDK.
+type EcsImage struct {
+	ecs.Image
+	ImageFamily string `json:"ImageFamily"`
+	Features    struct {
+		NvmeSupport string `json:"NvmeSupport"`
+	} `json:"Features"`
 }
 
-func (client *AliyunClient) DescribeSecurityGroupRule(groupId, direction, ipProtocol, portRange, nicType, cidr_ip, policy string, priority int) (*ecs.PermissionType, error) {
-	rules, err := client.ecsconn.DescribeSecurityGroupAttribute(&ecs.DescribeSecurityGroupAttributeArgs{
-		RegionId:        client.Region,
-		SecurityGroupId: groupId,
-		Direction:       ecs.Direction(direction),
-		NicType:         ecs.NicType(nicType),
-	})
+// DescribeEcsImages returns all the images matching the params of DescribeImages, like ImageOwnerAlias and ImageOwnerId.
+func (client *AliyunClient) DescribeEcsImages(params map[string]string) (images []EcsImage, err error) {
+	params["PageSize"] = strconv.Itoa(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		params["PageNumber"] = strconv.Itoa(pageNumber)
+		var resp struct {
+			Images struct {
+				Image []EcsImage `json:"Image"`
+			} `json:"Images"`
+		}
+		if err := client.ProcessRpcRequest(client.ecsEndpoint(), EcsApiVersion20140526, "DescribeImages", params, &resp); err != nil {
+			return nil, WrapErrorf(err, "DescribeImages got an error")
+		}
+		images = append(images, resp.Images.Image...)
+		if len(resp.Images.Image) < PageSizeLarge {
+			break
+		}
+	}
+	return images, nil
+}
+
+// DescribeImageFromFamily returns the latest available custom image of the image family.
+func (client *AliyunClient) DescribeImageFromFamily(family string) (image EcsImage, err error) {
+	var resp struct {
+		Image EcsImage `json:"Image"`
+	}
+	if err := client.ProcessRpcRequest(client.ecsEndpoint(), EcsApiVersion20140526, "DescribeImageFromFamily", map[string]string{
+		"ImageFamily": family,
+	}, &resp); err != nil {
+		return image, WrapErrorf(err, "DescribeImageFromFamily got an error")
+	}
+	if resp.Image.ImageId == "" {
+		return image, GetNotFoundErrorFromString(GetNotFoundMessage("Image Family", family))
+	}
+	return resp.Image, nil
+}
+
+// describeEcsAvailableResource returns the available resources of each zone returned by DescribeAvailableResource.
+func (client *AliyunClient) describeEcsAvailableResource(params map[string]string) (map[string][]string, error) {
+	var resp struct {
+		AvailableZones struct {
+			AvailableZone []struct {
+				ZoneId             string `json:"ZoneId"`
+				AvailableResources struct {
+					AvailableResource []struct {
+						Type               string `json:"Type"`
+						SupportedResources struct {
+							SupportedResource []struct {
+								Value  string `json:"Value"`
+								Status string `json:"Status"`
+							} `json:"SupportedResource"`
+						} `json:"SupportedResources"`
+					} `json:"AvailableResource"`
+				} `json:"AvailableResources"`
+			} `json:"AvailableZone"`
+		} `json:"AvailableZones"`
+	}
+	if err := client.ProcessRpcRequest(client.ecsEndpoint(), EcsApiVersion20140526, "DescribeAvailableResource", params, &resp); err != nil {
+		return nil, WrapErrorf(err, "DescribeAvailableResource got an error")
+	}
+	resources := make(map[string][]string)
+	for _, zone := range resp.AvailableZones.AvailableZone {
+		for _, res := range zone.AvailableResources.AvailableResource {
+			for _, supported := range res.SupportedResources.SupportedResource {
+				if supported.Status == "Available" {
+					resources[zone.ZoneId] = append(resources[zone.ZoneId], supported.Value)
+				}
+			}
+		}
+	}
+	return resources, nil
+}
 
+// DescribeAvailableInstanceTypes returns the instance types which can be created in the zone with the charge type
+// and the spot strategy, according to DescribeAvailableResource.
+func (client *AliyunClient) DescribeAvailableInstanceTypes(zoneId, chargeType, spotStrategy string) (types []string, err error) {
+	params := map[string]string{
+		"DestinationResource": "InstanceType",
+		"ZoneId":              zoneId,
+		"InstanceChargeType":  chargeType,
+		"IoOptimized":         "optimized",
+	}
+	if spotStrategy != "" {
+		params["SpotStrategy"] = spotStrategy
+	}
+	resources, err := client.describeEcsAvailableResource(params)
 	if err != nil {
 		return nil, err
 	}
+	for _, values := range resources {
+		types = append(types, values...)
+	}
+	return types, nil
+}
+
+// DescribeAvailableInstanceZones returns the sorted zones in which the instance type has stock for the charge type
+// and the spot strategy, according to DescribeAvailableResource.
+func (client *AliyunClient) DescribeAvailableInstanceZones(instanceType, chargeType, spotStrategy string) (zoneIds []string, err error) {
+	params := map[string]string{
+		"DestinationResource": "InstanceType",
+		"InstanceType":        instanceType,
+		"IoOptimized":         "optimized",
+	}
+	if chargeType != "" {
+		params["InstanceChargeType"] = chargeType
+	}
+	if spotStrategy != "" {
+		params["SpotStrategy"] = spotStrategy
+	}
+	resources, err := client.describeEcsAvailableResource(params)
+	if err != nil {
+		return nil, err
+	}
+	for zoneId, values := range resources {
+		for _, value := range values {
+			if value == instanceType {
+				zoneIds = append(zoneIds, zoneId)
+				break
+			}
+		}
+	}
+	sort.Strings(zoneIds)
+	return zoneIds, nil
+}
+
+// EcsPrice is the price of an instance returned by DescribePrice.
+type EcsPrice struct {
+	OriginalPrice float64 `json:"OriginalPrice"`
+	DiscountPrice float64 `json:"DiscountPrice"`
+	TradePrice    float64 `json:"TradePrice"`
+	Currency      string  `json:"Currency"`
+}
+
+// DescribeInstanceTypePrice returns the price of a VPC instance of the type in the zone, with a system disk of
+// the category. The price is hourly for a PostPaid instance and monthly for a PrePaid one.
+func (client *AliyunClient) DescribeInstanceTypePrice(zoneId, instanceType, chargeType, spotStrategy, systemDiskCategory string) (price EcsPrice, err error) {
+	params := map[string]string{
+		"ResourceType":        "instance",
+		"InstanceType":        instanceType,
+		"ZoneId":              zoneId,
+		"InstanceNetworkType": "vpc",
+		"IoOptimized":         "optimized",
+		"SystemDisk.Category": systemDiskCategory,
+		"PriceUnit":           "Hour",
+	}
+	if chargeType == string(PrePaid) {
+		params["PriceUnit"] = "Month"
+		params["Period"] = "1"
+	}
+	if spotStrategy != "" {
+		params["SpotStrategy"] = spotStrategy
+	}
+	return client.DescribeEcsPrice(params)
+}
+
+// DescribeEcsPrice returns the price of the resource described by the DescribePrice parameters.
+func (client *AliyunClient) DescribeEcsPrice(params map[string]string) (price EcsPrice, err error) {
+	var resp struct {
+		PriceInfo struct {
+			Price EcsPrice `json:"Price"`
+		} `json:"PriceInfo"`
+	}
+	err = RetryOnError(EcsCode, DefaultTimeout*time.Second, func() error {
+		return client.ProcessRpcRequest(client.ecsEndpoint(), EcsApiVersion20140526, "DescribePrice", params, &resp)
+	})
+	if err != nil {
+		return price, WrapErrorf(err, "DescribePrice got an error")
+	}
+	return resp.PriceInfo.Price, nil
+}
+
+func (client *AliyunClient) DescribeSecurityGroupRule(groupId, direction, ipProtocol, portRange, nicType, cidr_ip, policy string, priority int) (*ecs.Permission, error) {
+	request := ecs.CreateDescribeSecurityGroupAttributeRequest()
+	request.RegionId = string(client.Region)
+	request.SecurityGroupId = groupId
+	request.Direction = direction
+	request.NicType = nicType
+	rules := ecs.CreateDescribeSecurityGroupAttributeResponse()
+	if err := client.doAction(client.ecsconn(), request, rules); err != nil {
+		return nil, err
+	}
 
 	for _, ru := range rules.Permissions.Permission {
 		if strings.ToLower(string(ru.IpProtocol)) == ipProtocol && ru.PortRange == portRange {
 			cidr := ru.SourceCidrIp
-			if ecs.Direction(direction) == ecs.DirectionIngress && cidr == "" {
+			if Direction(direction) == DirectionIngress && cidr == "" {
 				cidr = ru.SourceGroupId
 			}
-			if ecs.Direction(direction) == ecs.DirectionEgress {
+			if Direction(direction) == DirectionEgress {
 				if cidr = ru.DestCidrIp; cidr == "" {
 					cidr = ru.DestGroupId
 				}
 			}
 
-			if cidr == cidr_ip && strings.ToLower(string(ru.Policy)) == policy && ru.Priority == priority {
+			if cidr == cidr_ip && strings.ToLower(ru.Policy) == policy && ru.Priority == strconv.Itoa(priority) {
 				return &ru, nil
 			}
 		}
@@ -240,21 +496,123 @@ func (client *AliyunClient) DescribeSecurityGroupRule(groupId, direction, ipProt
 
 }
 
-func (client *AliyunClient) RevokeSecurityGroup(args *ecs.RevokeSecurityGroupArgs) error {
+// DescribeSecurityGroupRules returns all of the ingress and egress rules of the security group.
+// The intranet rules are the only rules of a vpc security group, and the internet rules of a classic
+// security group are returned by a separate query.
+func (client *AliyunClient) DescribeSecurityGroupRules(groupId string) ([]ecs.Permission, error) {
+	var rules []ecs.Permission
+	nicTypes := []GroupRuleNicType{GroupRuleIntranet, GroupRuleInternet}
+	for _, nicType := range nicTypes {
+		request := ecs.CreateDescribeSecurityGroupAttributeRequest()
+		request.RegionId = string(client.Region)
+		request.SecurityGroupId = groupId
+		request.Direction = string(DirectionAll)
+		request.NicType = string(nicType)
+		group := ecs.CreateDescribeSecurityGroupAttributeResponse()
+		if err := client.doAction(client.ecsconn(), request, group); err != nil {
+			if IsExceptedError(err, InvalidSecurityGroupIdNotFound) {
+				return nil, GetNotFoundErrorFromString(GetNotFoundMessage("Security Group", groupId))
+			}
+			return nil, err
+		}
+		rules = append(rules, group.Permissions.Permission...)
+		if group.VpcId != "" {
+			break
+		}
+	}
+	return rules, nil
+}
+
+// AuthorizeSecurityGroupRules authorizes the rules of the direction in batches of SecurityGroupRuleBatchSize,
+// which is the maximum number of permissions in one request.
+func (client *AliyunClient) AuthorizeSecurityGroupRules(groupId string, direction Direction, rules []map[string]interface{}) error {
+	action := "AuthorizeSecurityGroup"
+	if direction == DirectionEgress {
+		action = "AuthorizeSecurityGroupEgress"
+	}
+	return client.processSecurityGroupRules(action, groupId, direction, rules)
+}
+
+// RevokeSecurityGroupRules revokes the rules of the direction in batches of SecurityGroupRuleBatchSize.
+// The rules which do not exist are ignored by the API.
+func (client *AliyunClient) RevokeSecurityGroupRules(groupId string, direction Direction, rules []map[string]interface{}) error {
+	action := "RevokeSecurityGroup"
+	if direction == DirectionEgress {
+		action = "RevokeSecurityGroupEgress"
+	}
+	return client.processSecurityGroupRules(action, groupId, direction, rules)
+}
+
+func (client *AliyunClient) processSecurityGroupRules(action, groupId string, direction Direction, rules []map[string]interface{}) error {
+	for start := 0; start < len(rules); start += SecurityGroupRuleBatchSize {
+		end := start + SecurityGroupRuleBatchSize
+		if end > len(rules) {
+			end = len(rules)
+		}
+		params := buildSecurityGroupRulesParams(direction, rules[start:end])
+		params["SecurityGroupId"] = groupId
+		if err := client.ProcessRpcRequest(client.ecsEndpoint(), EcsApiVersion20140526, action, params, nil); err != nil {
+			return WrapErrorf(err, "%s got an error", action)
+		}
+	}
+	return nil
+}
+
+// buildSecurityGroupRulesParams converts the rules to the Permissions.N parameters of the batch API.
+// The cidr_ip and source_security_group_id of an egress rule are its destination.
+func buildSecurityGroupRulesParams(direction Direction, rules []map[string]interface{}) map[string]string {
+	prefix := "Source"
+	if direction == DirectionEgress {
+		prefix = "Dest"
+	}
+	params := make(map[string]string)
+	for i, rule := range rules {
+		key := fmt.Sprintf("Permissions.%d.", i+1)
+		params[key+"IpProtocol"] = rule["ip_protocol"].(string)
+		params[key+"PortRange"] = securityGroupRulePortRange(rule["ip_protocol"].(string), rule["port_range"].(string))
+		params[key+"NicType"] = rule["nic_type"].(string)
+		params[key+"Policy"] = rule["policy"].(string)
+		params[key+"Priority"] = strconv.Itoa(rule["priority"].(int))
+		if v := rule["cidr_ip"].(string); v != "" {
+			params[key+prefix+"CidrIp"] = v
+		}
+		if v := rule["source_security_group_id"].(string); v != "" {
+			params[key+prefix+"GroupId"] = v
+		}
+		if v := rule["source_group_owner_account"].(string); v != "" {
+			params[key+prefix+"GroupOwnerAccount"] = v
+		}
+		if v := rule["description"].(string); v != "" {
+			params[key+"Description"] = v
+		}
+	}
+	return params
+}
+
+// securityGroupRulePortRange returns the port range which is saved by the API. Only the tcp and udp
+// rules have a port range, and the others always cover all of the ports.
+func securityGroupRulePortRange(protocol, portRange string) string {
+	p := IpProtocol(strings.ToLower(protocol))
+	if p == IpProtocolTCP || p == IpProtocolUDP {
+		return portRange
+	}
+	return AllPortRange
+}
+
+func (client *AliyunClient) RevokeSecurityGroup(request *ecs.RevokeSecurityGroupRequest) error {
 	//when the rule is not exist, api will return success(200)
-	return client.ecsconn.RevokeSecurityGroup(args)
+	return client.doAction(client.ecsconn(), request, ecs.CreateRevokeSecurityGroupResponse())
 }
 
-func (client *AliyunClient) RevokeSecurityGroupEgress(args *ecs.RevokeSecurityGroupEgressArgs) error {
+func (client *AliyunClient) RevokeSecurityGroupEgress(request *ecs.RevokeSecurityGroupEgressRequest) error {
 	//when the rule is not exist, api will return success(200)
-	return client.ecsconn.RevokeSecurityGroupEgress(args)
+	return client.doAction(client.ecsconn(), request, ecs.CreateRevokeSecurityGroupEgressResponse())
 }
 
 func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta interface{}) (map[ResourceKeyType]interface{}, error) {
 	// Before creating resources, check input parameters validity according available zone.
 	// If availability zone is nil, it will return all of supported resources in the current.
-	conn := meta.(*AliyunClient).ecsconn
-	zones, err := conn.DescribeZones(getRegion(d, meta))
+	zones, err := client.DescribeZones()
 	if err != nil {
 		return nil, fmt.Errorf("Error DescribeZone: %#v", err)
 	}
@@ -273,7 +631,7 @@ func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta
 	for _, zone := range zones {
 		if zoneId != "" && zone.ZoneId == zoneId {
 			valid = true
-			zones = append(make([]ecs.ZoneType, 1), zone)
+			zones = append(make([]ecs.Zone, 1), zone)
 			break
 		}
 		validZones = append(validZones, zone.ZoneId)
@@ -284,7 +642,7 @@ func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta
 	}
 
 	// Retrieve series instance type family
-	ioOptimized := ecs.IoOptimizedOptimized
+	ioOptimized := IoOptimizedOptimized
 	var expectedFamilies []string
 	mapOutdatedInstanceFamilies, mapUpgradedInstanceFamilies, err := client.FetchSpecifiedInstanceTypeFamily(getRegion(d, meta), zoneId, []string{GenerationOne, GenerationTwo}, zones)
 	if err != nil {
@@ -295,12 +653,12 @@ func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta
 	}
 
 	validData := make(map[ResourceKeyType]interface{})
-	mapZones := make(map[string]ecs.ZoneType)
+	mapZones := make(map[string]ecs.Zone)
 	mapSupportedInstanceTypes := make(map[string]string)
 	mapUpgradedInstanceTypes := make(map[string]string)
 	mapOutdatedInstanceTypes := make(map[string]string)
-	mapOutdatedDiskCategories := make(map[ecs.DiskCategory]ecs.DiskCategory)
-	mapDiskCategories := make(map[ecs.DiskCategory]ecs.DiskCategory)
+	mapOutdatedDiskCategories := make(map[DiskCategory]DiskCategory)
+	mapDiskCategories := make(map[DiskCategory]DiskCategory)
 	for _, zone := range zones {
 		//Filter and get all instance types in the zones
 		for _, insType := range zone.AvailableInstanceTypes.InstanceTypes {
@@ -313,7 +671,8 @@ func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta
 			continue
 		}
 		//Filter and get all instance types in the zones
-		for _, category := range zone.AvailableDiskCategories.DiskCategories {
+		for _, c := range zone.AvailableDiskCategories.DiskCategories {
+			category := DiskCategory(c)
 			if _, ok := SupportedDiskCategory[category]; ok {
 				mapDiskCategories[category] = category
 			}
@@ -363,7 +722,7 @@ func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta
 			if !strings.HasPrefix(instanceType, "ecs.") {
 				return nil, fmt.Errorf("Invalid instance_type: %s. Please modify it and try again.", instanceType)
 			}
-			var instanceTypeObject ecs.InstanceTypeItemType
+			var instanceTypeObject ecs.InstanceType
 			targetFamily, ok := mapSupportedInstanceTypes[instanceType]
 			if ok {
 				mapInstanceTypes, err := client.FetchSpecifiedInstanceTypesByFamily(zoneId, targetFamily, zones)
@@ -394,7 +753,7 @@ func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta
 
 				outDisk := false
 				if disk, ok := d.GetOk("system_disk_category"); ok {
-					_, outDisk = OutdatedDiskCategory[ecs.DiskCategory(disk.(string))]
+					_, outDisk = OutdatedDiskCategory[DiskCategory(disk.(string))]
 				}
 
 				_, outdatedOk := mapOutdatedInstanceTypes[instanceType]
@@ -402,7 +761,7 @@ func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta
 
 				if expectedOk && outDisk {
 					return nil, fmt.Errorf("Instance type %s can't support 'cloud' as instance system disk. "+
-						"Please change your disk category to efficient disk '%s' or '%s'.", instanceType, ecs.DiskCategoryCloudSSD, ecs.DiskCategoryCloudEfficiency)
+						"Please change your disk category to efficient disk '%s' or '%s'.", instanceType, DiskCategoryCloudSSD, DiskCategoryCloudEfficiency)
 				}
 				if outdatedOk {
 					var expectedEqualCpus []string
@@ -443,14 +802,14 @@ func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta
 						_, halfOk := HalfIoOptimizedFamily[targetFamily]
 						if typeOk || famOk {
 							if outDisk {
-								ioOptimized = ecs.IoOptimizedNone
+								ioOptimized = IoOptimizedNone
 							} else {
 								return nil, fmt.Errorf("The current instance type %s is no I/O optimized, and it only supports 'cloud' as instance system disk. "+
 									"Suggest to upgrade instance type and use efficient disk. Expected instance types: %s.", instanceType, strings.Join(expectedInstanceTypes, ", "))
 							}
 						} else if outDisk {
 							if halfOk {
-								ioOptimized = ecs.IoOptimizedNone
+								ioOptimized = IoOptimizedNone
 							} else {
 								return nil, fmt.Errorf("The current instance type %s is I/O optimized, and it can't support 'cloud' as instance system disk. "+
 									"Suggest to upgrade instance type and use efficient disk. Expectd instance types: %s.", instanceType, strings.Join(expectedInstanceTypes, ", "))
@@ -493,14 +852,14 @@ func (client *AliyunClient) CheckParameterValidity(d *schema.ResourceData, meta
 	return validData, nil
 }
 
-func (client *AliyunClient) FetchSpecifiedInstanceTypeFamily(regionId common.Region, zoneId string, generations []string, all_zones []ecs.ZoneType) (map[string]ecs.InstanceTypeFamily, map[string]ecs.InstanceTypeFamily, error) {
+func (client *AliyunClient) FetchSpecifiedInstanceTypeFamily(regionId common.Region, zoneId string, generations []string, all_zones []ecs.Zone) (map[string]ecs.InstanceTypeFamily, map[string]ecs.InstanceTypeFamily, error) {
 	// Describe specified series instance type families
 	mapOutdatedInstanceFamilies := make(map[string]ecs.InstanceTypeFamily)
 	mapUpgradedInstanceFamilies := make(map[string]ecs.InstanceTypeFamily)
-	response, err := client.ecsconn.DescribeInstanceTypeFamilies(&ecs.DescribeInstanceTypeFamiliesArgs{
-		RegionId: regionId,
-	})
-	if err != nil {
+	request := ecs.CreateDescribeInstanceTypeFamiliesRequest()
+	request.RegionId = string(regionId)
+	response := ecs.CreateDescribeInstanceTypeFamiliesResponse()
+	if err := client.doAction(client.ecsconn(), request, response); err != nil {
 		return nil, nil, fmt.Errorf("Error DescribeInstanceTypeFamilies: %#v.", err)
 	}
 	log.Printf("All the instance families in the region %s: %#v", regionId, response)
@@ -525,7 +884,7 @@ func (client *AliyunClient) FetchSpecifiedInstanceTypeFamily(regionId common.Reg
 		for _, zone := range all_zones {
 			if zone.ZoneId == zoneId {
 				for _, resource := range zone.AvailableResources.ResourcesInfo {
-					families := resource.InstanceTypeFamilies[ecs.SupportedInstanceTypeFamily]
+					families := resource.InstanceTypeFamilies.SupportedInstanceTypeFamily
 					for _, familyId := range families {
 						if val, ok := mapOutdatedInstanceFamilies[familyId]; ok {
 							outdatedValidFamilies[familyId] = val
@@ -545,27 +904,25 @@ func (client *AliyunClient) FetchSpecifiedInstanceTypeFamily(regionId common.Reg
 	return mapOutdatedInstanceFamilies, mapUpgradedInstanceFamilies, nil
 }
 
-func (client *AliyunClient) FetchSpecifiedInstanceTypesByFamily(zoneId, instanceTypeFamily string, all_zones []ecs.ZoneType) (map[string]ecs.InstanceTypeItemType, error) {
+func (client *AliyunClient) FetchSpecifiedInstanceTypesByFamily(zoneId, instanceTypeFamily string, all_zones []ecs.Zone) (map[string]ecs.InstanceType, error) {
 	// Describe all instance types of specified families
-	types, err := client.ecsconn.DescribeInstanceTypesNew(&ecs.DescribeInstanceTypesArgs{
-		InstanceTypeFamily: instanceTypeFamily,
-	})
+	types, err := client.DescribeInstanceTypes(instanceTypeFamily)
 	if err != nil {
 		return nil, fmt.Errorf("Error DescribeInstanceTypes: %#v.", err)
 	}
 	log.Printf("All the instance types of family %s: %#v", instanceTypeFamily, types)
-	instanceTypes := make(map[string]ecs.InstanceTypeItemType)
+	instanceTypes := make(map[string]ecs.InstanceType)
 	for _, ty := range types {
 		instanceTypes[ty.InstanceTypeId] = ty
 	}
 
 	// Filter specified zone's instance types, and make them fit for specified families
 	if zoneId != "" {
-		validInstanceTypes := make(map[string]ecs.InstanceTypeItemType)
+		validInstanceTypes := make(map[string]ecs.InstanceType)
 		for _, zone := range all_zones {
 			if zone.ZoneId == zoneId {
 				for _, resource := range zone.AvailableResources.ResourcesInfo {
-					types := resource.InstanceTypes[ecs.SupportedInstanceType]
+					types := resource.InstanceTypes.SupportedInstanceType
 					for _, ty := range types {
 						if val, ok := instanceTypes[ty]; ok {
 							validInstanceTypes[ty] = val
@@ -580,6 +937,18 @@ func (client *AliyunClient) FetchSpecifiedInstanceTypesByFamily(zoneId, instance
 	return instanceTypes, nil
 }
 
+// DescribeInstanceTypes returns the instance types of the family, or all of the instance types if the family is empty.
+func (client *AliyunClient) DescribeInstanceTypes(instanceTypeFamily string) ([]ecs.InstanceType, error) {
+	request := ecs.CreateDescribeInstanceTypesRequest()
+	request.RegionId = string(client.Region)
+	request.InstanceTypeFamily = instanceTypeFamily
+	response := ecs.CreateDescribeInstanceTypesResponse()
+	if err := client.doAction(client.ecsconn(), request, response); err != nil {
+		return nil, err
+	}
+	return response.InstanceTypes.InstanceType, nil
+}
+
 func getExpectInstanceTypesAndFormatOut(zoneId, instanceTypeFamily string, regionId common.Region, mapInstanceFamilies map[string]ecs.InstanceTypeFamily) error {
 	var validFamilies []string
 
@@ -598,34 +967,116 @@ func getExpectInstanceTypesAndFormatOut(zoneId, instanceTypeFamily string, regio
 		instanceTypeFamily, zoneId, strings.Join(validFamilies, ", "))
 }
 
-func (client *AliyunClient) QueryInstancesWithKeyPair(region common.Region, instanceIds, keypair string) ([]interface{}, []ecs.InstanceAttributesType, error) {
+func (client *AliyunClient) QueryInstancesWithKeyPair(region common.Region, instanceIds, keypair string) ([]interface{}, []ecs.Instance, error) {
 	var instance_ids []interface{}
-	var instanceList []ecs.InstanceAttributesType
-
-	conn := client.ecsconn
-	args := &ecs.DescribeInstancesArgs{
-		RegionId: region,
-	}
-	pagination := getPagination(1, 50)
-	for true {
-		if instanceIds != "" {
-			args.InstanceIds = instanceIds
-		}
-		args.Pagination = pagination
-		instances, _, err := conn.DescribeInstances(args)
-		if err != nil {
-			return nil, nil, fmt.Errorf("Error DescribeInstances: %#v", err)
-		}
-		for _, inst := range instances {
-			if inst.KeyPairName == keypair {
-				instance_ids = append(instance_ids, inst.InstanceId)
-				instanceList = append(instanceList, inst)
-			}
-		}
-		if len(instances) < pagination.PageSize {
-			break
+	var instanceList []ecs.Instance
+
+	request := ecs.CreateDescribeInstancesRequest()
+	request.RegionId = string(region)
+	request.InstanceIds = instanceIds
+	request.KeyPairName = keypair
+	instances, err := client.DescribeEcsInstances(request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error DescribeInstances: %#v", err)
+	}
+	for _, inst := range instances {
+		if inst.KeyPairName == keypair {
+			instance_ids = append(instance_ids, inst.InstanceId)
+			instanceList = append(instanceList, inst)
 		}
-		pagination.PageNumber += 1
 	}
 	return instance_ids, instanceList, nil
 }
+
+// DescribeEcsInstances returns the instances on all of the pages of DescribeInstances with the request.
+func (client *AliyunClient) DescribeEcsInstances(request *ecs.DescribeInstancesRequest) (instances []ecs.Instance, err error) {
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response := ecs.CreateDescribeInstancesResponse()
+		if err := client.doAction(client.ecsconn(), request, response); err != nil {
+			return nil, err
+		}
+		instances = append(instances, response.Instances.Instance...)
+		if len(response.Instances.Instance) < PageSizeLarge {
+			return instances, nil
+		}
+	}
+}
+
+// DescribeEcsKeyPairs returns the key pairs on all of the pages of DescribeKeyPairs with the request.
+func (client *AliyunClient) DescribeEcsKeyPairs(request *ecs.DescribeKeyPairsRequest) (keyPairs []ecs.KeyPair, err error) {
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response := ecs.CreateDescribeKeyPairsResponse()
+		if err := client.doAction(client.ecsconn(), request, response); err != nil {
+			return nil, err
+		}
+		keyPairs = append(keyPairs, response.KeyPairs.KeyPair...)
+		if len(response.KeyPairs.KeyPair) < PageSizeLarge {
+			return keyPairs, nil
+		}
+	}
+}
+
+// DescribeEcsSecurityGroups returns the security groups on all of the pages of DescribeSecurityGroups with the request.
+func (client *AliyunClient) DescribeEcsSecurityGroups(request *ecs.DescribeSecurityGroupsRequest) (groups []ecs.SecurityGroup, err error) {
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response := ecs.CreateDescribeSecurityGroupsResponse()
+		if err := client.doAction(client.ecsconn(), request, response); err != nil {
+			return nil, err
+		}
+		groups = append(groups, response.SecurityGroups.SecurityGroup...)
+		if len(response.SecurityGroups.SecurityGroup) < PageSizeLarge {
+			return groups, nil
+		}
+	}
+}
+
+// DescribeEcsDisks returns the disks on all of the pages of DescribeDisks with the request.
+func (client *AliyunClient) DescribeEcsDisks(request *ecs.DescribeDisksRequest) (disks []ecs.Disk, err error) {
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response := ecs.CreateDescribeDisksResponse()
+		if err := client.doAction(client.ecsconn(), request, response); err != nil {
+			return nil, err
+		}
+		disks = append(disks, response.Disks.Disk...)
+		if len(response.Disks.Disk) < PageSizeLarge {
+			return disks, nil
+		}
+	}
+}
+
+// DescribeEcsDisk describes the disk by DescribeDisks, which returns its KMS key and performance level as well.
+func (client *AliyunClient) DescribeEcsDisk(diskId string) (disk ecs.Disk, err error) {
+	request := ecs.CreateDescribeDisksRequest()
+	request.RegionId = string(client.Region)
+	request.DiskIds = convertListToJsonString([]interface{}{diskId})
+	response := ecs.CreateDescribeDisksResponse()
+	if err = client.doAction(client.ecsconn(), request, response); err != nil {
+		return disk, err
+	}
+	if len(response.Disks.Disk) < 1 || response.Disks.Disk[0].DiskId != diskId {
+		return disk, GetNotFoundErrorFromString(GetNotFoundMessage("Disk", diskId))
+	}
+	return response.Disks.Disk[0], nil
+}
+
+func (client *AliyunClient) DescribeEcsDeploymentSet(id string) (set ecs.DeploymentSet, err error) {
+	request := ecs.CreateDescribeDeploymentSetsRequest()
+	request.RegionId = string(client.Region)
+	request.DeploymentSetIds = convertListToJsonString([]interface{}{id})
+	response := ecs.CreateDescribeDeploymentSetsResponse()
+	if err = client.doAction(client.ecsconn(), request, response); err != nil {
+		return set, WrapApiError(err, request.GetActionName(), id)
+	}
+	if len(response.DeploymentSets.DeploymentSet) < 1 || response.DeploymentSets.DeploymentSet[0].DeploymentSetId != id {
+		return set, GetNotFoundErrorFromString(GetNotFoundMessage("Deployment Set", id))
+	}
+	return response.DeploymentSets.DeploymentSet[0], nil
+}
@@ -0,0 +1,24 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// elasticsearchEndpoint returns the endpoint of the region, because Elasticsearch has no central endpoint.
+func (client *AliyunClient) elasticsearchEndpoint() string {
+	return client.config.getEndpoint(ElasticsearchCode, fmt.Sprintf("elasticsearch.%s.aliyuncs.com", client.Region))
+}
+
+// DescribeElasticsearchZones returns the zones of the current region where Elasticsearch instances can be created.
+func (client *AliyunClient) DescribeElasticsearchZones() (zoneIds []string, err error) {
+	var resp struct {
+		Result struct {
+			Zones []string `json:"zones"`
+		} `json:"Result"`
+	}
+	if err := client.ProcessRoaRequest(client.elasticsearchEndpoint(), ElasticsearchApiVersion, http.MethodGet, "/openapi/region", nil, &resp); err != nil {
+		return nil, WrapErrorf(err, "GetRegionConfiguration got an error")
+	}
+	return resp.Result.Zones, nil
+}
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/denverdino/aliyungo/common"
@@ -15,7 +16,7 @@ func (client *AliyunClient) DescribeScalingGroupById(sgId string) (*ess.ScalingG
 		ScalingGroupId: []string{sgId},
 	}
 
-	sgs, _, err := client.essconn.DescribeScalingGroups(&args)
+	sgs, _, err := client.essconn().DescribeScalingGroups(&args)
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +35,7 @@ func (client *AliyunClient) DescribeScalingConfigurationById(configId string) (*
 		ScalingConfigurationId: []string{configId},
 	}
 
-	cs, _, err := client.essconn.DescribeScalingConfigurations(&args)
+	cs, _, err := client.essconn().DescribeScalingConfigurations(&args)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +53,7 @@ func (client *AliyunClient) ActiveScalingConfigurationById(sgId, configId string
 		ActiveScalingConfigurationId: configId,
 	}
 
-	_, err := client.essconn.ModifyScalingGroup(&args)
+	_, err := client.essconn().ModifyScalingGroup(&args)
 	return err
 }
 
@@ -78,7 +79,7 @@ func (client *AliyunClient) DescribeScalingRuleById(sgId, ruleId string) (*ess.S
 		ScalingRuleId:  []string{ruleId},
 	}
 
-	cs, _, err := client.essconn.DescribeScalingRules(&args)
+	cs, _, err := client.essconn().DescribeScalingRules(&args)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +97,7 @@ func (client *AliyunClient) DeleteScalingRuleById(ruleId string) error {
 		ScalingRuleId: ruleId,
 	}
 
-	_, err := client.essconn.DeleteScalingRule(&args)
+	_, err := client.essconn().DeleteScalingRule(&args)
 	return err
 }
 
@@ -106,7 +107,7 @@ func (client *AliyunClient) DescribeScheduleById(scheduleId string) (*ess.Schedu
 		ScheduledTaskId: []string{scheduleId},
 	}
 
-	cs, _, err := client.essconn.DescribeScheduledTasks(&args)
+	cs, _, err := client.essconn().DescribeScheduledTasks(&args)
 	if err != nil {
 		return nil, err
 	}
@@ -124,14 +125,14 @@ func (client *AliyunClient) DeleteScheduleById(scheduleId string) error {
 		ScheduledTaskId: scheduleId,
 	}
 
-	_, err := client.essconn.DeleteScheduledTask(&args)
+	_, err := client.essconn().DeleteScheduledTask(&args)
 	return err
 }
 
 func (client *AliyunClient) DeleteScalingGroupById(sgId string) error {
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 
-		_, err := client.essconn.DeleteScalingGroup(&ess.DeleteScalingGroupArgs{
+		_, err := client.essconn().DeleteScalingGroup(&ess.DeleteScalingGroupArgs{
 			ScalingGroupId: sgId,
 			ForceDelete:    true,
 		})
@@ -169,7 +170,7 @@ func (client *AliyunClient) EssRemoveInstances(groupId string, instanceIds []str
 	if group.LifecycleState == ess.Inacitve {
 		return fmt.Errorf("Scaling group current status is %s, please active it before attaching or removing ECS instances.", group.LifecycleState)
 	} else {
-		if err := client.essconn.WaitForScalingGroup(client.Region, group.ScalingGroupId, ess.Active, DefaultTimeout); err != nil {
+		if err := client.essconn().WaitForScalingGroup(client.Region, group.ScalingGroupId, ess.Active, DefaultTimeout); err != nil {
 			if IsExceptedError(err, Notfound) {
 				return nil
 			}
@@ -178,7 +179,7 @@ func (client *AliyunClient) EssRemoveInstances(groupId string, instanceIds []str
 	}
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := client.essconn.RemoveInstances(&ess.RemoveInstancesArgs{
+		if _, err := client.essconn().RemoveInstances(&ess.RemoveInstancesArgs{
 			ScalingGroupId: groupId,
 			InstanceId:     instanceIds,
 		}); err != nil {
@@ -190,7 +191,6 @@ func (client *AliyunClient) EssRemoveInstances(groupId string, instanceIds []str
 					"Please shorten scaling group min size and try again.", len(instanceIds), group.MinSize))
 			}
 			if IsExceptedError(err, ScalingActivityInProgress) || IsExceptedError(err, IncorrectScalingGroupStatus) {
-				time.Sleep(5)
 				return resource.RetryableError(fmt.Errorf("Removing instances got an error: %#v", err))
 			}
 			if IsExceptedError(err, InvalidScalingGroupIdNotFound) {
@@ -199,7 +199,7 @@ func (client *AliyunClient) EssRemoveInstances(groupId string, instanceIds []str
 			return resource.NonRetryableError(fmt.Errorf("Removing instances got an error: %#v", err))
 		}
 
-		instances, _, err := client.essconn.DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
+		instances, _, err := client.essconn().DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
 			RegionId:       client.Region,
 			ScalingGroupId: groupId,
 			InstanceId:     instanceIds,
@@ -217,3 +217,150 @@ func (client *AliyunClient) EssRemoveInstances(groupId string, instanceIds []str
 		return nil
 	})
 }
+
+// describeEssOutdatedInstances returns the in-service instances of the scaling group which were created automatically
+// by a scaling configuration other than the active one.
+func (client *AliyunClient) describeEssOutdatedInstances(group *ess.ScalingGroupItemType) (instanceIds []string, err error) {
+	var instances []ess.ScalingInstanceItemType
+	err = describeAllPages(func(pagination common.Pagination) (*common.PaginationResult, error) {
+		items, result, err := client.essconn().DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
+			RegionId:       client.Region,
+			ScalingGroupId: group.ScalingGroupId,
+			CreationType:   "AutoCreated",
+			LifecycleState: ess.InService,
+			Pagination:     pagination,
+		})
+		instances = append(instances, items...)
+		return result, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DescribeScalingInstances got an error: %#v", err)
+	}
+	for _, instance := range instances {
+		if instance.ScalingConfigurationId != group.ActiveScalingConfigurationId {
+			instanceIds = append(instanceIds, instance.InstanceId)
+		}
+	}
+	return instanceIds, nil
+}
+
+// RefreshEssScalingGroupInstances replaces the outdated instances of the scaling group with the ones of the active scaling
+// configuration batch by batch. For each batch, the capacity is raised to launch the new instances, and the outdated
+// instances are removed after the new ones have been in service for the health check wait.
+func (client *AliyunClient) RefreshEssScalingGroupInstances(groupId string, batchSize int, pause, healthCheckWait time.Duration, timeout int) error {
+	group, err := client.DescribeScalingGroupById(groupId)
+	if err != nil {
+		return fmt.Errorf("DescribeScalingGroupById %s error: %#v", groupId, err)
+	}
+	if group.LifecycleState != ess.Active {
+		log.Printf("[WARN] Scaling group %s is %s and its instances are not refreshed.", groupId, group.LifecycleState)
+		return nil
+	}
+	outdated, err := client.describeEssOutdatedInstances(group)
+	if err != nil {
+		return err
+	}
+
+	minSize, maxSize := group.MinSize, group.MaxSize
+	for len(outdated) > 0 {
+		batch := outdated
+		if len(batch) > batchSize {
+			batch = outdated[:batchSize]
+		}
+		outdated = outdated[len(batch):]
+
+		group, err = client.DescribeScalingGroupById(groupId)
+		if err != nil {
+			return fmt.Errorf("DescribeScalingGroupById %s error: %#v", groupId, err)
+		}
+		capacity := group.TotalCapacity + len(batch)
+		args := &ess.ModifyScalingGroupArgs{
+			ScalingGroupId: groupId,
+			MinSize:        &capacity,
+		}
+		if capacity > maxSize {
+			args.MaxSize = &capacity
+		}
+		if _, err := client.essconn().ModifyScalingGroup(args); err != nil {
+			return fmt.Errorf("Raising the capacity of scaling group %s to %d got an error: %#v", groupId, capacity, err)
+		}
+
+		if err := resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+			group, err := client.DescribeScalingGroupById(groupId)
+			if err != nil {
+				return resource.NonRetryableError(fmt.Errorf("DescribeScalingGroupById %s error: %#v", groupId, err))
+			}
+			if group.ActiveCapacity < capacity {
+				return resource.RetryableError(fmt.Errorf("Waiting for the scaling group %s to have %d instances in service, and it has %d.", groupId, capacity, group.ActiveCapacity))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		time.Sleep(healthCheckWait)
+
+		// The min size is restored before removing the instances, and the max size after that.
+		if _, err := client.essconn().ModifyScalingGroup(&ess.ModifyScalingGroupArgs{
+			ScalingGroupId: groupId,
+			MinSize:        &minSize,
+		}); err != nil {
+			return fmt.Errorf("Restoring the min size of scaling group %s got an error: %#v", groupId, err)
+		}
+		if err := client.EssRemoveInstances(groupId, batch); err != nil {
+			return err
+		}
+		if args.MaxSize != nil {
+			if _, err := client.essconn().ModifyScalingGroup(&ess.ModifyScalingGroupArgs{
+				ScalingGroupId: groupId,
+				MaxSize:        &maxSize,
+			}); err != nil {
+				return fmt.Errorf("Restoring the max size of scaling group %s got an error: %#v", groupId, err)
+			}
+		}
+
+		if len(outdated) > 0 {
+			time.Sleep(pause)
+		}
+	}
+	return nil
+}
+
+func (client *AliyunClient) essEndpoint() string {
+	return client.config.getEndpoint(EssCode, "ess.aliyuncs.com")
+}
+
+// DescribeEssScalingGroupAllocation returns the allocation policies of the scaling group.
+func (client *AliyunClient) DescribeEssScalingGroupAllocation(groupId string) (allocation EssScalingGroupAllocation, err error) {
+	var resp struct {
+		ScalingGroups struct {
+			ScalingGroup []EssScalingGroupAllocation `json:"ScalingGroup"`
+		} `json:"ScalingGroups"`
+	}
+	if err := client.ProcessRpcRequest(client.essEndpoint(), EssApiVersion, "DescribeScalingGroups", map[string]string{
+		"ScalingGroupId.1": groupId,
+	}, &resp); err != nil {
+		return allocation, WrapErrorf(err, "DescribeScalingGroups got an error")
+	}
+	if len(resp.ScalingGroups.ScalingGroup) < 1 {
+		return allocation, GetNotFoundErrorFromString("Scaling group not found")
+	}
+	return resp.ScalingGroups.ScalingGroup[0], nil
+}
+
+// DescribeEssScalingConfigurationSpot returns the instance types and the spot strategy of the scaling configuration.
+func (client *AliyunClient) DescribeEssScalingConfigurationSpot(configId string) (spot EssScalingConfigurationSpot, err error) {
+	var resp struct {
+		ScalingConfigurations struct {
+			ScalingConfiguration []EssScalingConfigurationSpot `json:"ScalingConfiguration"`
+		} `json:"ScalingConfigurations"`
+	}
+	if err := client.ProcessRpcRequest(client.essEndpoint(), EssApiVersion, "DescribeScalingConfigurations", map[string]string{
+		"ScalingConfigurationId.1": configId,
+	}, &resp); err != nil {
+		return spot, WrapErrorf(err, "DescribeScalingConfigurations got an error")
+	}
+	if len(resp.ScalingConfigurations.ScalingConfiguration) < 1 {
+		return spot, GetNotFoundErrorFromString("Scaling configuration not found")
+	}
+	return resp.ScalingConfigurations.ScalingConfiguration[0], nil
+}
@@ -0,0 +1,46 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+func (client *AliyunClient) eventBridgeEndpoint() string {
+	return client.config.getEndpoint(EventBridgeCode, fmt.Sprintf("eventbridge-console.%s.aliyuncs.com", client.Region))
+}
+
+func (client *AliyunClient) DescribeEventBridgeEventBus(name string) (bus EventBridgeEventBus, err error) {
+	var resp struct {
+		Data EventBridgeEventBus `json:"Data"`
+	}
+	if err = client.ProcessRpcRequest(client.eventBridgeEndpoint(), EventBridgeApiVersion, "GetEventBus", map[string]string{
+		"EventBusName": name,
+	}, &resp); err != nil {
+		if IsExceptedError(err, EventBridgeEventBusNotExist) {
+			return bus, GetNotFoundErrorFromString(GetNotFoundMessage("EventBridge Event Bus", name))
+		}
+		return bus, WrapErrorf(err, "GetEventBus got an error")
+	}
+	if resp.Data.EventBusName != name {
+		return bus, GetNotFoundErrorFromString(GetNotFoundMessage("EventBridge Event Bus", name))
+	}
+	return resp.Data, nil
+}
+
+func (client *AliyunClient) DescribeEventBridgeRule(busName, ruleName string) (rule EventBridgeRule, err error) {
+	var resp struct {
+		Data EventBridgeRule `json:"Data"`
+	}
+	if err = client.ProcessRpcRequest(client.eventBridgeEndpoint(), EventBridgeApiVersion, "GetRule", map[string]string{
+		"EventBusName": busName,
+		"RuleName":     ruleName,
+	}, &resp); err != nil {
+		if IsExceptedError(err, EventBridgeRuleNotExist) || IsExceptedError(err, EventBridgeEventBusNotExist) {
+			return rule, GetNotFoundErrorFromString(GetNotFoundMessage("EventBridge Rule", busName+COLON_SEPARATED+ruleName))
+		}
+		return rule, WrapErrorf(err, "GetRule got an error")
+	}
+	if resp.Data.RuleName != ruleName {
+		return rule, GetNotFoundErrorFromString(GetNotFoundMessage("EventBridge Rule", busName+COLON_SEPARATED+ruleName))
+	}
+	return resp.Data, nil
+}
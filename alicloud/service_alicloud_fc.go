@@ -0,0 +1,177 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type FcService struct {
+	ServiceId        string `json:"serviceId"`
+	ServiceName      string `json:"serviceName"`
+	Description      string `json:"description"`
+	Role             string `json:"role"`
+	InternetAccess   bool   `json:"internetAccess"`
+	CreatedTime      string `json:"createdTime"`
+	LastModifiedTime string `json:"lastModifiedTime"`
+	LogConfig        struct {
+		Project  string `json:"project"`
+		Logstore string `json:"logstore"`
+	} `json:"logConfig"`
+	VpcConfig struct {
+		VpcId           string   `json:"vpcId"`
+		VSwitchIds      []string `json:"vSwitchIds"`
+		SecurityGroupId string   `json:"securityGroupId"`
+	} `json:"vpcConfig"`
+}
+
+type FcFunction struct {
+	FunctionId           string            `json:"functionId"`
+	FunctionName         string            `json:"functionName"`
+	Description          string            `json:"description"`
+	Runtime              string            `json:"runtime"`
+	Handler              string            `json:"handler"`
+	Timeout              int               `json:"timeout"`
+	MemorySize           int               `json:"memorySize"`
+	CodeSize             int64             `json:"codeSize"`
+	CodeChecksum         string            `json:"codeChecksum"`
+	EnvironmentVariables map[string]string `json:"environmentVariables"`
+	CreatedTime          string            `json:"createdTime"`
+	LastModifiedTime     string            `json:"lastModifiedTime"`
+}
+
+type FcTrigger struct {
+	TriggerId        string      `json:"triggerId"`
+	TriggerName      string      `json:"triggerName"`
+	TriggerType      string      `json:"triggerType"`
+	SourceArn        string      `json:"sourceArn"`
+	InvocationRole   string      `json:"invocationRole"`
+	TriggerConfig    interface{} `json:"triggerConfig"`
+	CreatedTime      string      `json:"createdTime"`
+	LastModifiedTime string      `json:"lastModifiedTime"`
+}
+
+type FcRoute struct {
+	Path         string `json:"path"`
+	ServiceName  string `json:"serviceName"`
+	FunctionName string `json:"functionName"`
+	Qualifier    string `json:"qualifier"`
+}
+
+type FcCustomDomain struct {
+	DomainName       string `json:"domainName"`
+	AccountId        string `json:"accountId"`
+	Protocol         string `json:"protocol"`
+	ApiVersion       string `json:"apiVersion"`
+	CreatedTime      string `json:"createdTime"`
+	LastModifiedTime string `json:"lastModifiedTime"`
+	RouteConfig      struct {
+		Routes []FcRoute `json:"routes"`
+	} `json:"routeConfig"`
+	CertConfig struct {
+		CertName string `json:"certName"`
+	} `json:"certConfig"`
+}
+
+// fcEndpoint returns the endpoint of Function Compute, which contains the account ID unless it is customized.
+func (client *AliyunClient) fcEndpoint() (string, error) {
+	if endpoint := client.config.getEndpoint(FcCode, ""); endpoint != "" {
+		return endpoint, nil
+	}
+	accountId, err := client.AccountId()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s.%s.fc.aliyuncs.com", accountId, client.Region), nil
+}
+
+// processFcListRequest lists all the pages of a list API. The pages are linked by nextToken, and fetch requests
+// each of them with the query and returns its nextToken.
+func (client *AliyunClient) processFcListRequest(fetch func(query map[string]string) (string, error)) error {
+	query := map[string]string{
+		"limit": strconv.Itoa(100),
+	}
+	for {
+		nextToken, err := fetch(query)
+		if err != nil {
+			return err
+		}
+		if nextToken == "" {
+			return nil
+		}
+		query["nextToken"] = nextToken
+	}
+}
+
+func (client *AliyunClient) fcGet(path string, query map[string]string, result interface{}) error {
+	endpoint, err := client.fcEndpoint()
+	if err != nil {
+		return err
+	}
+	if err := client.ProcessRoaRequest(endpoint, FcApiVersion, http.MethodGet, fmt.Sprintf("/%s%s", FcApiVersion, path), query, result); err != nil {
+		return WrapErrorf(err, "GET %s got an error", path)
+	}
+	return nil
+}
+
+func (client *AliyunClient) DescribeFcServices() (services []FcService, err error) {
+	err = client.processFcListRequest(func(query map[string]string) (string, error) {
+		var resp struct {
+			Services  []FcService `json:"services"`
+			NextToken string      `json:"nextToken"`
+		}
+		if err := client.fcGet("/services", query, &resp); err != nil {
+			return "", err
+		}
+		services = append(services, resp.Services...)
+		return resp.NextToken, nil
+	})
+	return services, err
+}
+
+func (client *AliyunClient) DescribeFcFunctions(serviceName string) (functions []FcFunction, err error) {
+	path := fmt.Sprintf("/services/%s/functions", serviceName)
+	err = client.processFcListRequest(func(query map[string]string) (string, error) {
+		var resp struct {
+			Functions []FcFunction `json:"functions"`
+			NextToken string       `json:"nextToken"`
+		}
+		if err := client.fcGet(path, query, &resp); err != nil {
+			return "", err
+		}
+		functions = append(functions, resp.Functions...)
+		return resp.NextToken, nil
+	})
+	return functions, err
+}
+
+func (client *AliyunClient) DescribeFcTriggers(serviceName, functionName string) (triggers []FcTrigger, err error) {
+	path := fmt.Sprintf("/services/%s/functions/%s/triggers", serviceName, functionName)
+	err = client.processFcListRequest(func(query map[string]string) (string, error) {
+		var resp struct {
+			Triggers  []FcTrigger `json:"triggers"`
+			NextToken string      `json:"nextToken"`
+		}
+		if err := client.fcGet(path, query, &resp); err != nil {
+			return "", err
+		}
+		triggers = append(triggers, resp.Triggers...)
+		return resp.NextToken, nil
+	})
+	return triggers, err
+}
+
+func (client *AliyunClient) DescribeFcCustomDomains() (domains []FcCustomDomain, err error) {
+	err = client.processFcListRequest(func(query map[string]string) (string, error) {
+		var resp struct {
+			CustomDomains []FcCustomDomain `json:"customDomains"`
+			NextToken     string           `json:"nextToken"`
+		}
+		if err := client.fcGet("/custom-domains", query, &resp); err != nil {
+			return "", err
+		}
+		domains = append(domains, resp.CustomDomains...)
+		return resp.NextToken, nil
+	})
+	return domains, err
+}
@@ -0,0 +1,40 @@
+package alicloud
+
+import (
+	"fmt"
+)
+
+func (client *AliyunClient) fnfEndpoint() string {
+	return client.config.getEndpoint(FnfCode, fmt.Sprintf("%s.fnf.aliyuncs.com", client.Region))
+}
+
+func (client *AliyunClient) DescribeFnfFlow(name string) (flow FnfFlow, err error) {
+	if err = client.ProcessRpcRequest(client.fnfEndpoint(), FnfApiVersion, "DescribeFlow", map[string]string{
+		"Name": name,
+	}, &flow); err != nil {
+		if IsExceptedError(err, FnfFlowNotExists) {
+			return flow, GetNotFoundErrorFromString(GetNotFoundMessage("FnF Flow", name))
+		}
+		return flow, WrapErrorf(err, "DescribeFlow got an error")
+	}
+	if flow.Name != name {
+		return flow, GetNotFoundErrorFromString(GetNotFoundMessage("FnF Flow", name))
+	}
+	return flow, nil
+}
+
+func (client *AliyunClient) DescribeFnfSchedule(flowName, scheduleName string) (schedule FnfSchedule, err error) {
+	if err = client.ProcessRpcRequest(client.fnfEndpoint(), FnfApiVersion, "DescribeSchedule", map[string]string{
+		"FlowName":     flowName,
+		"ScheduleName": scheduleName,
+	}, &schedule); err != nil {
+		if IsExceptedError(err, FnfScheduleNotExists) || IsExceptedError(err, FnfFlowNotExists) {
+			return schedule, GetNotFoundErrorFromString(GetNotFoundMessage("FnF Schedule", flowName+COLON_SEPARATED+scheduleName))
+		}
+		return schedule, WrapErrorf(err, "DescribeSchedule got an error")
+	}
+	if schedule.ScheduleName != scheduleName {
+		return schedule, GetNotFoundErrorFromString(GetNotFoundMessage("FnF Schedule", flowName+COLON_SEPARATED+scheduleName))
+	}
+	return schedule, nil
+}
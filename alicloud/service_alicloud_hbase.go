@@ -0,0 +1,33 @@
+package alicloud
+
+func (client *AliyunClient) hbaseEndpoint() string {
+	return client.config.getEndpoint(HBaseCode, "hbase.aliyuncs.com")
+}
+
+// DescribeHBaseZones returns the zones of the current region where HBase clusters can be created.
+func (client *AliyunClient) DescribeHBaseZones() (zoneIds []string, err error) {
+	var resp struct {
+		Regions struct {
+			Region []struct {
+				RegionId string `json:"RegionId"`
+				Zones    struct {
+					Zone []struct {
+						Id string `json:"Id"`
+					} `json:"Zone"`
+				} `json:"Zones"`
+			} `json:"Region"`
+		} `json:"Regions"`
+	}
+	if err := client.ProcessRpcRequest(client.hbaseEndpoint(), HBaseApiVersion, "DescribeRegions", map[string]string{}, &resp); err != nil {
+		return nil, WrapErrorf(err, "DescribeRegions got an error")
+	}
+	for _, region := range resp.Regions.Region {
+		if region.RegionId != string(client.Region) {
+			continue
+		}
+		for _, zone := range region.Zones.Zone {
+			zoneIds = append(zoneIds, zone.Id)
+		}
+	}
+	return zoneIds, nil
+}
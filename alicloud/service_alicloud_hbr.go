@@ -0,0 +1,80 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func (client *AliyunClient) hbrEndpoint() string {
+	return client.config.getEndpoint(HbrCode, fmt.Sprintf("hbr.%s.aliyuncs.com", client.Region))
+}
+
+// ProcessHbrRequest invokes the Hybrid Backup Recovery API and converts an unsuccessful response into successFlagError.
+func (client *AliyunClient) ProcessHbrRequest(action string, params map[string]string, result interface{}) error {
+	return processSuccessFlagRequest(HbrCode, action, func(raw *json.RawMessage) error {
+		return client.ProcessRpcRequest(client.hbrEndpoint(), HbrApiVersion, action, params, raw)
+	}, result)
+}
+
+func (client *AliyunClient) DescribeHbrVault(id string) (vault HbrVault, err error) {
+	var resp struct {
+		Vaults struct {
+			Vault []HbrVault `json:"Vault"`
+		} `json:"Vaults"`
+	}
+	if err = client.ProcessHbrRequest("DescribeVaults", map[string]string{
+		"VaultId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, HbrVaultNotFound) {
+			return vault, GetNotFoundErrorFromString(GetNotFoundMessage("HBR Vault", id))
+		}
+		return vault, WrapErrorf(err, "DescribeVaults got an error")
+	}
+	for _, v := range resp.Vaults.Vault {
+		if v.VaultId == id {
+			return v, nil
+		}
+	}
+	return vault, GetNotFoundErrorFromString(GetNotFoundMessage("HBR Vault", id))
+}
+
+// WaitForHbrVault waits until the vault is created. Timeout is in seconds.
+func (client *AliyunClient) WaitForHbrVault(id string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		vault, err := client.DescribeHbrVault(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if vault.Status == HbrVaultCreated {
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("HBR Vault", HbrVaultCreated)))
+	})
+}
+
+func (client *AliyunClient) DescribeHbrBackupPlan(id, sourceType string) (plan HbrBackupPlan, err error) {
+	var resp struct {
+		BackupPlans struct {
+			BackupPlan []HbrBackupPlan `json:"BackupPlan"`
+		} `json:"BackupPlans"`
+	}
+	if err = client.ProcessHbrRequest("DescribeBackupPlans", map[string]string{
+		"SourceType":         sourceType,
+		"Filters.1.Key":      "planId",
+		"Filters.1.Values.1": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, HbrPlanNotFound) {
+			return plan, GetNotFoundErrorFromString(GetNotFoundMessage("HBR Backup Plan", id))
+		}
+		return plan, WrapErrorf(err, "DescribeBackupPlans got an error")
+	}
+	for _, p := range resp.BackupPlans.BackupPlan {
+		if p.PlanId == id {
+			return p, nil
+		}
+	}
+	return plan, GetNotFoundErrorFromString(GetNotFoundMessage("HBR Backup Plan", id))
+}
@@ -0,0 +1,155 @@
+package alicloud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func (client *AliyunClient) hitsdbEndpoint() string {
+	return client.config.getEndpoint(HitsdbCode, "hitsdb.aliyuncs.com")
+}
+
+// hitsdbPayType converts the charge type to the pay type of Lindorm and TSDB.
+func hitsdbPayType(chargeType PayType) string {
+	if chargeType == PrePaid {
+		return "PREPAY"
+	}
+	return "POSTPAY"
+}
+
+func hitsdbChargeType(payType string) PayType {
+	if payType == "PREPAY" {
+		return PrePaid
+	}
+	return PostPaid
+}
+
+func (client *AliyunClient) DescribeLindormInstance(id string) (instance LindormInstance, err error) {
+	if err = client.ProcessRpcRequest(client.hitsdbEndpoint(), LindormApiVersion, "GetLindormInstance", map[string]string{
+		"InstanceId": id,
+	}, &instance); err != nil {
+		if IsExceptedError(err, HitsdbInstanceNotValid) || IsExceptedError(err, HitsdbInstanceDeleted) {
+			return instance, GetNotFoundErrorFromString(GetNotFoundMessage("Lindorm Instance", id))
+		}
+		return instance, WrapErrorf(err, "GetLindormInstance got an error")
+	}
+	if instance.InstanceId != id {
+		return instance, GetNotFoundErrorFromString(GetNotFoundMessage("Lindorm Instance", id))
+	}
+	return instance, nil
+}
+
+// WaitForLindormInstance waits until the instance is in the status ACTIVATION and ready returns true for it. Timeout is
+// in seconds.
+func (client *AliyunClient) WaitForLindormInstance(id string, ready func(LindormInstance) bool, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		instance, err := client.DescribeLindormInstance(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if instance.InstanceStatus == HitsdbActivation && (ready == nil || ready(instance)) {
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("Lindorm Instance", HitsdbActivation)))
+	})
+}
+
+// WaitForLindormInstanceDeleted waits until the instance is not found. Timeout is in seconds.
+func (client *AliyunClient) WaitForLindormInstanceDeleted(id string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		if _, err := client.DescribeLindormInstance(id); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("Lindorm Instance", "Deleted")))
+	})
+}
+
+// DescribeLindormIpWhiteList returns the IP addresses of the default white list of the instance.
+func (client *AliyunClient) DescribeLindormIpWhiteList(id string) ([]string, error) {
+	var resp struct {
+		GroupList []struct {
+			GroupName      string `json:"GroupName"`
+			SecurityIpList string `json:"SecurityIpList"`
+		} `json:"GroupList"`
+	}
+	if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), LindormApiVersion, "GetInstanceIpWhiteList", map[string]string{
+		"InstanceId": id,
+	}, &resp); err != nil {
+		return nil, WrapErrorf(err, "GetInstanceIpWhiteList got an error")
+	}
+	for _, group := range resp.GroupList {
+		if group.GroupName == "default" && group.SecurityIpList != "" {
+			return strings.Split(group.SecurityIpList, COMMA_SEPARATED), nil
+		}
+	}
+	return nil, nil
+}
+
+func (client *AliyunClient) DescribeTsdbInstance(id string) (instance TsdbInstance, err error) {
+	if err = client.ProcessRpcRequest(client.hitsdbEndpoint(), TsdbApiVersion, "DescribeHiTSDBInstance", map[string]string{
+		"InstanceId": id,
+	}, &instance); err != nil {
+		if IsExceptedError(err, HitsdbInstanceNotValid) || IsExceptedError(err, HitsdbInstanceDeleted) {
+			return instance, GetNotFoundErrorFromString(GetNotFoundMessage("TSDB Instance", id))
+		}
+		return instance, WrapErrorf(err, "DescribeHiTSDBInstance got an error")
+	}
+	if instance.InstanceId != id {
+		return instance, GetNotFoundErrorFromString(GetNotFoundMessage("TSDB Instance", id))
+	}
+	return instance, nil
+}
+
+// WaitForTsdbInstance waits until the instance is in the status ACTIVATION and ready returns true for it. Timeout is in
+// seconds.
+func (client *AliyunClient) WaitForTsdbInstance(id string, ready func(TsdbInstance) bool, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		instance, err := client.DescribeTsdbInstance(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if instance.Status == HitsdbActivation && (ready == nil || ready(instance)) {
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("TSDB Instance", HitsdbActivation)))
+	})
+}
+
+// WaitForTsdbInstanceDeleted waits until the instance is not found. Timeout is in seconds.
+func (client *AliyunClient) WaitForTsdbInstanceDeleted(id string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		if _, err := client.DescribeTsdbInstance(id); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("TSDB Instance", "Deleted")))
+	})
+}
+
+// DescribeTsdbSecurityIps returns the IP addresses of the white list of the instance.
+func (client *AliyunClient) DescribeTsdbSecurityIps(id string) ([]string, error) {
+	var resp struct {
+		SecurityIpList struct {
+			SecurityIp []string `json:"SecurityIp"`
+		} `json:"SecurityIpList"`
+	}
+	if err := client.ProcessRpcRequest(client.hitsdbEndpoint(), TsdbApiVersion, "DescribeHiTSDBInstanceSecurityIpList", map[string]string{
+		"InstanceId": id,
+	}, &resp); err != nil {
+		return nil, WrapErrorf(err, "DescribeHiTSDBInstanceSecurityIpList got an error")
+	}
+	return resp.SecurityIpList.SecurityIp, nil
+}
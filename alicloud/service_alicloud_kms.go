@@ -0,0 +1,142 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func (client *AliyunClient) kmsEndpoint() string {
+	return client.config.getEndpoint(KmsCode, fmt.Sprintf("kms.%s.aliyuncs.com", client.Region))
+}
+
+// Decrypt returns the plaintext decrypted from the ciphertext by KMS. The encryption context should be
+// the same as the one used to encrypt it. The aliyungo client does not encode the context as a json string.
+func (client *AliyunClient) Decrypt(ciphertext string, encryptionContext map[string]interface{}) (string, error) {
+	params := map[string]string{
+		"CiphertextBlob": ciphertext,
+	}
+	if len(encryptionContext) > 0 {
+		bs, err := json.Marshal(encryptionContext)
+		if err != nil {
+			return "", err
+		}
+		params["EncryptionContext"] = string(bs)
+	}
+
+	var resp struct {
+		Plaintext string `json:"Plaintext"`
+	}
+	if err := client.ProcessRpcRequest(client.kmsEndpoint(), KmsApiVersion, "Decrypt", params, &resp); err != nil {
+		return "", WrapErrorf(err, "Decrypt got an error")
+	}
+	return resp.Plaintext, nil
+}
+
+type KmsAlias struct {
+	KeyId     string `json:"KeyId"`
+	AliasName string `json:"AliasName"`
+	AliasArn  string `json:"AliasArn"`
+}
+
+type KmsSecret struct {
+	SecretName        string `json:"SecretName"`
+	CreateTime        string `json:"CreateTime"`
+	UpdateTime        string `json:"UpdateTime"`
+	PlannedDeleteTime string `json:"PlannedDeleteTime"`
+}
+
+type KmsSecretValue struct {
+	SecretName     string `json:"SecretName"`
+	VersionId      string `json:"VersionId"`
+	SecretData     string `json:"SecretData"`
+	SecretDataType string `json:"SecretDataType"`
+	CreateTime     string `json:"CreateTime"`
+}
+
+// processKmsPagedRequest requests all the pages of a list action. fetch decodes a page and returns the number of
+// its items and the total count.
+func (client *AliyunClient) processKmsPagedRequest(action string, fetch func(params map[string]string) (int, int, error)) error {
+	params := map[string]string{
+		"PageSize": strconv.Itoa(PageSizeLarge),
+	}
+	fetched := 0
+	for pageNumber := 1; ; pageNumber++ {
+		params["PageNumber"] = strconv.Itoa(pageNumber)
+		count, total, err := fetch(params)
+		if err != nil {
+			return WrapErrorf(err, "%s got an error", action)
+		}
+		fetched += count
+		if count < PageSizeLarge || fetched >= total {
+			return nil
+		}
+	}
+}
+
+func (client *AliyunClient) DescribeKmsAliases() (aliases []KmsAlias, err error) {
+	err = client.processKmsPagedRequest("ListAliases", func(params map[string]string) (int, int, error) {
+		var resp struct {
+			TotalCount int `json:"TotalCount"`
+			Aliases    struct {
+				Alias []KmsAlias `json:"Alias"`
+			} `json:"Aliases"`
+		}
+		if err := client.ProcessRpcRequest(client.kmsEndpoint(), KmsApiVersion, "ListAliases", params, &resp); err != nil {
+			return 0, 0, err
+		}
+		aliases = append(aliases, resp.Aliases.Alias...)
+		return len(resp.Aliases.Alias), resp.TotalCount, nil
+	})
+	return aliases, err
+}
+
+func (client *AliyunClient) DescribeKmsSecrets() (secrets []KmsSecret, err error) {
+	err = client.processKmsPagedRequest("ListSecrets", func(params map[string]string) (int, int, error) {
+		var resp struct {
+			TotalCount int `json:"TotalCount"`
+			SecretList struct {
+				Secret []KmsSecret `json:"Secret"`
+			} `json:"SecretList"`
+		}
+		if err := client.ProcessRpcRequest(client.kmsEndpoint(), KmsApiVersion, "ListSecrets", params, &resp); err != nil {
+			return 0, 0, err
+		}
+		secrets = append(secrets, resp.SecretList.Secret...)
+		return len(resp.SecretList.Secret), resp.TotalCount, nil
+	})
+	return secrets, err
+}
+
+// GetKmsSecretValue returns the value of the secret version with the stage, like ACSCurrent.
+func (client *AliyunClient) GetKmsSecretValue(secretName, versionStage string) (value KmsSecretValue, err error) {
+	params := map[string]string{
+		"SecretName": secretName,
+	}
+	if versionStage != "" {
+		params["VersionStage"] = versionStage
+	}
+	if err := client.ProcessRpcRequest(client.kmsEndpoint(), KmsApiVersion, "GetSecretValue", params, &value); err != nil {
+		return value, WrapErrorf(err, "GetSecretValue got an error")
+	}
+	return value, nil
+}
+
+// getPassword returns the password of the resource, or the one decrypted from the kms_encrypted_password
+// when the password is not set. The decrypted password is only sent to the API and never kept in the state.
+func getPassword(d *schema.ResourceData, client *AliyunClient) (string, error) {
+	if v := d.Get("password").(string); v != "" {
+		return v, nil
+	}
+	if v := d.Get("kms_encrypted_password").(string); v != "" {
+		return client.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
+	}
+	return "", nil
+}
+
+// kmsDiffSuppressFunc ignores the kms_encryption_context when the kms_encrypted_password is not set.
+func kmsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("kms_encrypted_password").(string) == ""
+}
@@ -0,0 +1,275 @@
+package alicloud
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+type KVStoreInstance struct {
+	InstanceId       string `json:"InstanceId"`
+	InstanceName     string `json:"InstanceName"`
+	InstanceStatus   string `json:"InstanceStatus"`
+	InstanceType     string `json:"InstanceType"`
+	InstanceClass    string `json:"InstanceClass"`
+	EngineVersion    string `json:"EngineVersion"`
+	ArchitectureType string `json:"ArchitectureType"`
+	NodeType         string `json:"NodeType"`
+	RegionId         string `json:"RegionId"`
+	ZoneId           string `json:"ZoneId"`
+	ChargeType       string `json:"ChargeType"`
+	NetworkType      string `json:"NetworkType"`
+	VpcId            string `json:"VpcId"`
+	VSwitchId        string `json:"VSwitchId"`
+	PrivateIp        string `json:"PrivateIp"`
+	ConnectionDomain string `json:"ConnectionDomain"`
+	Port             int    `json:"Port"`
+	Capacity         int64  `json:"Capacity"`
+	Bandwidth        int64  `json:"Bandwidth"`
+	Connections      int64  `json:"Connections"`
+	QPS              int64  `json:"QPS"`
+	CreateTime       string `json:"CreateTime"`
+	EndTime          string `json:"EndTime"`
+	// InstanceReleaseProtection is the deletion protection of the instance
+	InstanceReleaseProtection bool `json:"InstanceReleaseProtection"`
+}
+
+// KVStoreAvailableResource is an instance class which can be created in a zone. It flattens the nested
+// zones, engines, editions, series, versions, architectures, shard numbers and node types returned by
+// DescribeAvailableResource.
+type KVStoreAvailableResource struct {
+	ZoneId        string
+	Engine        string
+	EditionType   string
+	SeriesType    string
+	EngineVersion string
+	Architecture  string
+	ShardNumber   int
+	NodeType      string
+	InstanceClass string
+	Remark        string
+}
+
+func (client *AliyunClient) kvstoreEndpoint() string {
+	return client.config.getEndpoint(KVStoreCode, "r-kvstore.aliyuncs.com")
+}
+
+// DescribeKVStoreInstances returns the instances matching the filters, which are the parameters of DescribeInstances
+// like InstanceType and VpcId. The tags are converted into the Tag.N parameters.
+func (client *AliyunClient) DescribeKVStoreInstances(filters map[string]string, tags map[string]string) (instances []KVStoreInstance, err error) {
+	params := map[string]string{
+		"PageSize": strconv.Itoa(PageSizeLarge),
+	}
+	for k, v := range filters {
+		if v != "" {
+			params[k] = v
+		}
+	}
+	var keys []string
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		params[fmt.Sprintf("Tag.%d.Key", i+1)] = k
+		params[fmt.Sprintf("Tag.%d.Value", i+1)] = tags[k]
+	}
+	for pageNumber := 1; ; pageNumber++ {
+		params["PageNumber"] = strconv.Itoa(pageNumber)
+		var resp struct {
+			Instances struct {
+				KVStoreInstance []KVStoreInstance `json:"KVStoreInstance"`
+			} `json:"Instances"`
+		}
+		if err := client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "DescribeInstances", params, &resp); err != nil {
+			return nil, WrapApiError(err, "DescribeInstances", "")
+		}
+		instances = append(instances, resp.Instances.KVStoreInstance...)
+		if len(resp.Instances.KVStoreInstance) < PageSizeLarge {
+			break
+		}
+	}
+	return instances, nil
+}
+
+// DescribeKVStoreAvailableResources returns the instance classes available in the region. The engine, charge type
+// and zone are optional filters of the API.
+func (client *AliyunClient) DescribeKVStoreAvailableResources(engine, chargeType, zoneId string) (resources []KVStoreAvailableResource, err error) {
+	params := map[string]string{
+		"InstanceChargeType": chargeType,
+		"OrderType":          "BUY",
+	}
+	if engine != "" {
+		params["Engine"] = engine
+	}
+	if zoneId != "" {
+		params["ZoneId"] = zoneId
+	}
+	var resp struct {
+		AvailableZones struct {
+			AvailableZone []struct {
+				ZoneId           string `json:"ZoneId"`
+				SupportedEngines struct {
+					SupportedEngine []struct {
+						Engine                string `json:"Engine"`
+						SupportedEditionTypes struct {
+							SupportedEditionType []struct {
+								EditionType          string `json:"EditionType"`
+								SupportedSeriesTypes struct {
+									SupportedSeriesType []struct {
+										SeriesType              string `json:"SeriesType"`
+										SupportedEngineVersions struct {
+											SupportedEngineVersion []struct {
+												Version                    string `json:"Version"`
+												SupportedArchitectureTypes struct {
+													SupportedArchitectureType []struct {
+														Architecture          string `json:"Architecture"`
+														SupportedShardNumbers struct {
+															SupportedShardNumber []struct {
+																ShardNumber        string `json:"ShardNumber"`
+																SupportedNodeTypes struct {
+																	SupportedNodeType []struct {
+																		SupportedNodeType  string `json:"SupportedNodeType"`
+																		AvailableResources struct {
+																			AvailableResource []struct {
+																				InstanceClass       string `json:"InstanceClass"`
+																				InstanceClassRemark string `json:"InstanceClassRemark"`
+																			} `json:"AvailableResource"`
+																		} `json:"AvailableResources"`
+																	} `json:"SupportedNodeType"`
+																} `json:"SupportedNodeTypes"`
+															} `json:"SupportedShardNumber"`
+														} `json:"SupportedShardNumbers"`
+													} `json:"SupportedArchitectureType"`
+												} `json:"SupportedArchitectureTypes"`
+											} `json:"SupportedEngineVersion"`
+										} `json:"SupportedEngineVersions"`
+									} `json:"SupportedSeriesType"`
+								} `json:"SupportedSeriesTypes"`
+							} `json:"SupportedEditionType"`
+						} `json:"SupportedEditionTypes"`
+					} `json:"SupportedEngine"`
+				} `json:"SupportedEngines"`
+			} `json:"AvailableZone"`
+		} `json:"AvailableZones"`
+	}
+	if err = client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "DescribeAvailableResource", params, &resp); err != nil {
+		return nil, WrapApiError(err, "DescribeAvailableResource", "")
+	}
+	for _, zone := range resp.AvailableZones.AvailableZone {
+		for _, e := range zone.SupportedEngines.SupportedEngine {
+			for _, edition := range e.SupportedEditionTypes.SupportedEditionType {
+				for _, series := range edition.SupportedSeriesTypes.SupportedSeriesType {
+					for _, version := range series.SupportedEngineVersions.SupportedEngineVersion {
+						for _, arch := range version.SupportedArchitectureTypes.SupportedArchitectureType {
+							for _, shard := range arch.SupportedShardNumbers.SupportedShardNumber {
+								shardNumber, _ := strconv.Atoi(shard.ShardNumber)
+								for _, node := range shard.SupportedNodeTypes.SupportedNodeType {
+									for _, res := range node.AvailableResources.AvailableResource {
+										resources = append(resources, KVStoreAvailableResource{
+											ZoneId:        zone.ZoneId,
+											Engine:        e.Engine,
+											EditionType:   edition.EditionType,
+											SeriesType:    series.SeriesType,
+											EngineVersion: version.Version,
+											Architecture:  arch.Architecture,
+											ShardNumber:   shardNumber,
+											NodeType:      node.SupportedNodeType,
+											InstanceClass: res.InstanceClass,
+											Remark:        res.InstanceClassRemark,
+										})
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return resources, nil
+}
+
+func (client *AliyunClient) DescribeKVStoreInstance(id string) (instance KVStoreInstance, err error) {
+	var resp struct {
+		Instances struct {
+			DBInstanceAttribute []KVStoreInstance `json:"DBInstanceAttribute"`
+		} `json:"Instances"`
+	}
+	if err = client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "DescribeInstanceAttribute", map[string]string{
+		"InstanceId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, KVStoreInstanceNotFound) {
+			return instance, GetNotFoundErrorFromString(GetNotFoundMessage("KVStore Instance", id))
+		}
+		return instance, WrapApiError(err, "DescribeInstanceAttribute", id)
+	}
+	if len(resp.Instances.DBInstanceAttribute) < 1 || resp.Instances.DBInstanceAttribute[0].InstanceId != id {
+		return instance, GetNotFoundErrorFromString(GetNotFoundMessage("KVStore Instance", id))
+	}
+	return resp.Instances.DBInstanceAttribute[0], nil
+}
+
+// WaitForKVStoreInstance waits until the instance is in the status Normal and ready returns true for it. The instance
+// keeps the status Normal for a while after it is modified, so the modified attributes should be checked by ready.
+// Timeout is in seconds.
+func (client *AliyunClient) WaitForKVStoreInstance(id string, ready func(KVStoreInstance) bool, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		instance, err := client.DescribeKVStoreInstance(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if instance.InstanceStatus == string(KVStoreNormal) && (ready == nil || ready(instance)) {
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("KVStore Instance", string(KVStoreNormal))))
+	})
+}
+
+// WaitForKVStoreInstanceDeleted waits until the instance is not found. Timeout is in seconds.
+func (client *AliyunClient) WaitForKVStoreInstanceDeleted(id string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		if _, err := client.DescribeKVStoreInstance(id); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("KVStore Instance", "Deleted")))
+	})
+}
+
+// DescribeKVStoreTags returns all of the tags of the instance.
+func (client *AliyunClient) DescribeKVStoreTags(id string) ([]TagResource, error) {
+	return client.describeTagResources(client.kvstoreEndpoint(), KVStoreApiVersion, TagResourceKVStoreInstance, id)
+}
+
+// DescribeKVStoreSecurityIps returns the IP addresses of the default whitelist of the instance.
+func (client *AliyunClient) DescribeKVStoreSecurityIps(id string) ([]string, error) {
+	var resp struct {
+		SecurityIpGroups struct {
+			SecurityIpGroup []struct {
+				SecurityIpGroupName string `json:"SecurityIpGroupName"`
+				SecurityIpList      string `json:"SecurityIpList"`
+			} `json:"SecurityIpGroup"`
+		} `json:"SecurityIpGroups"`
+	}
+	if err := client.ProcessRpcRequest(client.kvstoreEndpoint(), KVStoreApiVersion, "DescribeSecurityIps", map[string]string{
+		"InstanceId": id,
+	}, &resp); err != nil {
+		return nil, WrapApiError(err, "DescribeSecurityIps", id)
+	}
+	for _, group := range resp.SecurityIpGroups.SecurityIpGroup {
+		if group.SecurityIpGroupName == "default" && group.SecurityIpList != "" {
+			return strings.Split(group.SecurityIpList, COMMA_SEPARATED), nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,277 @@
+package alicloud
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogClient is a lightweight client of the Log Service RESTful API which signs
+// every request using the Log Service signature.
+type LogClient struct {
+	Endpoint        string
+	AccessKeyId     string
+	AccessKeySecret string
+	SecurityToken   string
+	UserAgent       string
+	// Scheme is http or https, and it is https by default
+	Scheme string
+	// MaxRetries is the maximum times to retry the requests which timed out or failed with server errors
+	MaxRetries int
+
+	// credential holds the temporary credentials of the provider, which are used instead of the AccessKey if it is set
+	credential *sessionCredential
+	httpClient *http.Client
+}
+
+// getCredential returns the credentials which sign the requests.
+func (c *LogClient) getCredential() EcsRoleCredential {
+	if c.credential != nil {
+		return c.credential.get()
+	}
+	return EcsRoleCredential{AccessKeyId: c.AccessKeyId, AccessKeySecret: c.AccessKeySecret, SecurityToken: c.SecurityToken}
+}
+
+// LogError represents an error returned by the Log Service API
+type LogError struct {
+	HttpCode  int
+	Code      string `json:"errorCode"`
+	Message   string `json:"errorMessage"`
+	RequestId string
+}
+
+func (e *LogError) Error() string {
+	return fmt.Sprintf("Log Service Error: HttpCode: %d Code: %s Message: %s RequestId: %s", e.HttpCode, e.Code, e.Message, e.RequestId)
+}
+
+type LogShipperStorage struct {
+	Format string                 `json:"format"`
+	Detail map[string]interface{} `json:"detail"`
+}
+
+type LogShipperTargetConfiguration struct {
+	OssBucket      string            `json:"ossBucket"`
+	OssPrefix      string            `json:"ossPrefix"`
+	RoleArn        string            `json:"roleArn"`
+	BufferInterval int               `json:"bufferInterval"`
+	BufferSize     int               `json:"bufferSize"`
+	CompressType   string            `json:"compressType"`
+	PathFormat     string            `json:"pathFormat"`
+	Storage        LogShipperStorage `json:"storage"`
+}
+
+type LogShipper struct {
+	ShipperName         string                        `json:"shipperName"`
+	TargetType          string                        `json:"targetType"`
+	TargetConfiguration LogShipperTargetConfiguration `json:"targetConfiguration"`
+}
+
+type LogAuditAppModel struct {
+	AppName     string `json:"AppName"`
+	DisplayName string `json:"DisplayName"`
+	Config      string `json:"Config"`
+}
+
+type DescribeLogAppResponse struct {
+	RequestId string           `json:"RequestId"`
+	AppModel  LogAuditAppModel `json:"AppModel"`
+}
+
+func (c *LogClient) doRequest(project, method, uri string, body interface{}, result interface{}) (err error) {
+	for retry := 0; ; retry++ {
+		err = c.doRequestOnce(project, method, uri, body, result)
+		if err == nil || retry >= c.MaxRetries || !isLogRetryableError(err) {
+			return err
+		}
+		wait := time.Duration(retry+1) * time.Second
+		log.Printf("[WARN] Log Service request %s %s failed and it will be retried after %s: %#v", method, uri, wait, err)
+		time.Sleep(wait)
+	}
+}
+
+// isLogRetryableError returns true when the request timed out, or failed with a server error or throttling.
+func isLogRetryableError(err error) bool {
+	if e, ok := err.(*LogError); ok {
+		return e.HttpCode >= http.StatusInternalServerError || e.HttpCode == http.StatusTooManyRequests
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
+
+func (c *LogClient) doRequestOnce(project, method, uri string, body interface{}, result interface{}) error {
+	var content []byte
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		content = bs
+	}
+
+	scheme, host := splitScheme(c.Endpoint)
+	if c.Scheme != "" {
+		scheme = c.Scheme
+	} else if scheme == "" {
+		scheme = "https"
+	}
+	if project != "" {
+		host = project + "." + host
+	}
+	req, err := http.NewRequest(method, scheme+"://"+host+uri, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{
+		"x-log-apiversion":      LogApiVersion,
+		"x-log-signaturemethod": LogSignatureMethod,
+		"x-log-bodyrawsize":     strconv.Itoa(len(content)),
+		"Date":                  time.Now().UTC().Format(http.TimeFormat),
+		"Host":                  host,
+		"User-Agent":            c.UserAgent,
+	}
+	if len(content) > 0 {
+		headers["Content-Type"] = "application/json"
+		headers["Content-MD5"] = fmt.Sprintf("%X", md5.Sum(content))
+	}
+	credential := c.getCredential()
+	if credential.SecurityToken != "" {
+		headers["x-acs-security-token"] = credential.SecurityToken
+	}
+	headers["Authorization"] = fmt.Sprintf("LOG %s:%s", credential.AccessKeyId, signLogRequest(credential.AccessKeySecret, method, uri, headers))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Log Service request: %s %s%s", method, host, uri)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bs, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		e := &LogError{
+			HttpCode:  resp.StatusCode,
+			RequestId: resp.Header.Get("x-log-requestid"),
+		}
+		if err := json.Unmarshal(bs, e); err != nil {
+			e.Message = string(bs)
+		}
+		return e
+	}
+
+	if result != nil && len(bs) > 0 {
+		return json.Unmarshal(bs, result)
+	}
+	return nil
+}
+
+// signLogRequest signs the request with the AccessKey secret, and the string to sign is
+// VERB\nCONTENT-MD5\nCONTENT-TYPE\nDATE\nCanonicalizedLOGHeaders\nCanonicalizedResource
+func signLogRequest(accessKeySecret, method, uri string, headers map[string]string) string {
+	var keys []string
+	for k := range headers {
+		if strings.HasPrefix(k, "x-log-") || strings.HasPrefix(k, "x-acs-") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var canonicalizedHeaders []string
+	for _, k := range keys {
+		canonicalizedHeaders = append(canonicalizedHeaders, k+":"+headers[k])
+	}
+
+	resource := uri
+	if u, err := url.Parse(uri); err == nil {
+		resource = u.Path
+		if query := u.Query(); len(query) > 0 {
+			var params []string
+			for k := range query {
+				params = append(params, k+"="+query.Get(k))
+			}
+			sort.Strings(params)
+			resource += "?" + strings.Join(params, "&")
+		}
+	}
+
+	stringToSign := strings.Join([]string{
+		method,
+		headers["Content-MD5"],
+		headers["Content-Type"],
+		headers["Date"],
+		strings.Join(canonicalizedHeaders, "\n"),
+		resource,
+	}, "\n")
+
+	mac := hmac.New(sha1.New, []byte(accessKeySecret))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (c *LogClient) CreateShipper(project, logstore string, shipper *LogShipper) error {
+	return c.doRequest(project, http.MethodPost, fmt.Sprintf("/logstores/%s/shipper", logstore), shipper, nil)
+}
+
+func (c *LogClient) UpdateShipper(project, logstore string, shipper *LogShipper) error {
+	return c.doRequest(project, http.MethodPut, fmt.Sprintf("/logstores/%s/shipper/%s", logstore, shipper.ShipperName), shipper, nil)
+}
+
+func (c *LogClient) GetShipper(project, logstore, name string) (*LogShipper, error) {
+	shipper := &LogShipper{}
+	if err := c.doRequest(project, http.MethodGet, fmt.Sprintf("/logstores/%s/shipper/%s", logstore, name), nil, shipper); err != nil {
+		return nil, err
+	}
+	return shipper, nil
+}
+
+func (c *LogClient) DeleteShipper(project, logstore, name string) error {
+	return c.doRequest(project, http.MethodDelete, fmt.Sprintf("/logstores/%s/shipper/%s", logstore, name), nil, nil)
+}
+
+func (client *AliyunClient) DescribeLogOssShipper(project, logstore, name string) (*LogShipper, error) {
+	shipper, err := client.logconn.GetShipper(project, logstore, name)
+	if err != nil {
+		if IsExceptedError(err, LogShipperNotExist) || IsExceptedError(err, LogProjectNotExist) || IsExceptedError(err, LogStoreNotExist) {
+			return nil, GetNotFoundErrorFromString(GetNotFoundMessage("Log Oss Shipper", name))
+		}
+		return nil, err
+	}
+	return shipper, nil
+}
+
+func (client *AliyunClient) DescribeLogAudit() (app LogAuditAppModel, err error) {
+	var resp DescribeLogAppResponse
+	err = client.ProcessRpcRequest(client.logPopEndpoint(), LogPopApiVersion, "DescribeApp", map[string]string{
+		"AppName": LogAuditAppType,
+	}, &resp)
+	if err != nil {
+		if IsExceptedError(err, LogAppNotExist) {
+			return app, GetNotFoundErrorFromString(GetNotFoundMessage("Log Audit", LogAuditAppType))
+		}
+		return
+	}
+	return resp.AppModel, nil
+}
+
+func (client *AliyunClient) logPopEndpoint() string {
+	return fmt.Sprintf("sls.%s.aliyuncs.com", client.Region)
+}
@@ -0,0 +1,51 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (client *AliyunClient) marketEndpoint() string {
+	return client.config.getEndpoint(MarketCode, "market.aliyuncs.com")
+}
+
+// DescribeMarketProducts returns the products matching the filters, like productType and searchTerm.
+func (client *AliyunClient) DescribeMarketProducts(filters map[string]string) (products []MarketProduct, err error) {
+	params := map[string]string{
+		"PageSize": strconv.Itoa(PageSizeLarge),
+	}
+	i := 1
+	for k, v := range filters {
+		params[fmt.Sprintf("Filter.%d.Key", i)] = k
+		params[fmt.Sprintf("Filter.%d.Value", i)] = v
+		i++
+	}
+	for page := 1; ; page++ {
+		params["PageNumber"] = strconv.Itoa(page)
+		var resp struct {
+			ProductItems struct {
+				ProductItem []MarketProduct `json:"ProductItem"`
+			} `json:"ProductItems"`
+			TotalCount int `json:"TotalCount"`
+		}
+		if err = client.ProcessRpcRequest(client.marketEndpoint(), MarketApiVersion, "DescribeProducts", params, &resp); err != nil {
+			return nil, WrapErrorf(err, "DescribeProducts got an error")
+		}
+		products = append(products, resp.ProductItems.ProductItem...)
+		if len(resp.ProductItems.ProductItem) < PageSizeLarge || len(products) >= resp.TotalCount {
+			return products, nil
+		}
+	}
+}
+
+func (client *AliyunClient) DescribeMarketOrder(id string) (order MarketOrder, err error) {
+	if err = client.ProcessRpcRequest(client.marketEndpoint(), MarketApiVersion, "DescribeOrder", map[string]string{
+		"OrderId": id,
+	}, &order); err != nil {
+		return order, WrapErrorf(err, "DescribeOrder got an error")
+	}
+	if strconv.FormatInt(order.OrderId, 10) != id {
+		return order, GetNotFoundErrorFromString(GetNotFoundMessage("Market Order", id))
+	}
+	return order, nil
+}
@@ -0,0 +1,33 @@
+package alicloud
+
+func (client *AliyunClient) mongodbEndpoint() string {
+	return client.config.getEndpoint(MongoDBCode, "mongodb.aliyuncs.com")
+}
+
+// DescribeMongoDBZones returns the zones of the current region where MongoDB instances can be created.
+func (client *AliyunClient) DescribeMongoDBZones() (zoneIds []string, err error) {
+	var resp struct {
+		Regions struct {
+			DdsRegion []struct {
+				RegionId string `json:"RegionId"`
+				Zones    struct {
+					Zone []struct {
+						ZoneId string `json:"ZoneId"`
+					} `json:"Zone"`
+				} `json:"Zones"`
+			} `json:"DdsRegion"`
+		} `json:"Regions"`
+	}
+	if err := client.ProcessRpcRequest(client.mongodbEndpoint(), MongoDBApiVersion, "DescribeRegions", map[string]string{}, &resp); err != nil {
+		return nil, WrapErrorf(err, "DescribeRegions got an error")
+	}
+	for _, region := range resp.Regions.DdsRegion {
+		if region.RegionId != string(client.Region) {
+			continue
+		}
+		for _, zone := range region.Zones.Zone {
+			zoneIds = append(zoneIds, zone.ZoneId)
+		}
+	}
+	return zoneIds, nil
+}
@@ -0,0 +1,123 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func (client *AliyunClient) mseEndpoint() string {
+	return client.config.getEndpoint(MseCode, fmt.Sprintf("mse.%s.aliyuncs.com", client.Region))
+}
+
+// ProcessMseRequest invokes the Microservice Engine API and converts an unsuccessful response into successFlagError.
+func (client *AliyunClient) ProcessMseRequest(action string, params map[string]string, result interface{}) error {
+	return processSuccessFlagRequest(MseCode, action, func(raw *json.RawMessage) error {
+		return client.ProcessRpcRequest(client.mseEndpoint(), MseApiVersion, action, params, raw)
+	}, result)
+}
+
+func (client *AliyunClient) DescribeMseCluster(id string) (cluster MseCluster, err error) {
+	var resp struct {
+		Data MseCluster `json:"Data"`
+	}
+	if err = client.ProcessMseRequest("QueryClusterDetail", map[string]string{
+		"InstanceId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, MseInstanceNotFound) {
+			return cluster, GetNotFoundErrorFromString(GetNotFoundMessage("MSE Cluster", id))
+		}
+		return cluster, WrapErrorf(err, "QueryClusterDetail got an error")
+	}
+	if resp.Data.InstanceId != id {
+		return cluster, GetNotFoundErrorFromString(GetNotFoundMessage("MSE Cluster", id))
+	}
+	return resp.Data, nil
+}
+
+// WaitForMseCluster waits until the cluster is initialized. Timeout is in seconds.
+func (client *AliyunClient) WaitForMseCluster(id string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		cluster, err := client.DescribeMseCluster(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if cluster.InitStatus == MseClusterInitSuccess {
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("MSE Cluster", MseClusterInitSuccess)))
+	})
+}
+
+// WaitForMseClusterDeleted waits until the cluster is not found. Timeout is in seconds.
+func (client *AliyunClient) WaitForMseClusterDeleted(id string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		if _, err := client.DescribeMseCluster(id); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("MSE Cluster", "Deleted")))
+	})
+}
+
+func (client *AliyunClient) DescribeMseGateway(id string) (gateway MseGateway, err error) {
+	filter, err := json.Marshal(map[string]string{"GatewayUniqueId": id})
+	if err != nil {
+		return gateway, WrapError(err)
+	}
+	var resp struct {
+		Data struct {
+			Result []MseGateway `json:"Result"`
+		} `json:"Data"`
+	}
+	if err = client.ProcessMseRequest("ListGateway", map[string]string{
+		"FilterParams": string(filter),
+		"PageNumber":   "1",
+		"PageSize":     "10",
+	}, &resp); err != nil {
+		return gateway, WrapErrorf(err, "ListGateway got an error")
+	}
+	for _, g := range resp.Data.Result {
+		if g.GatewayUniqueId == id {
+			return g, nil
+		}
+	}
+	return gateway, GetNotFoundErrorFromString(GetNotFoundMessage("MSE Gateway", id))
+}
+
+// WaitForMseGateway waits until the gateway is running. Timeout is in seconds.
+func (client *AliyunClient) WaitForMseGateway(id string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		gateway, err := client.DescribeMseGateway(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if gateway.Status == MseGatewayRunning {
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("MSE Gateway", "Running")))
+	})
+}
+
+// WaitForMseGatewayDeleted waits until the gateway is not found. Timeout is in seconds.
+func (client *AliyunClient) WaitForMseGatewayDeleted(id string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		if _, err := client.DescribeMseGateway(id); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("MSE Gateway", "Deleted")))
+	})
+}
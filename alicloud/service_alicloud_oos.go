@@ -0,0 +1,70 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func (client *AliyunClient) oosEndpoint() string {
+	return client.config.getEndpoint(OosCode, fmt.Sprintf("oos.%s.aliyuncs.com", client.Region))
+}
+
+// DescribeOosTemplate returns the latest version of the template and its content.
+func (client *AliyunClient) DescribeOosTemplate(name string) (template OosTemplate, content string, err error) {
+	var resp struct {
+		Template OosTemplate `json:"Template"`
+		Content  string      `json:"Content"`
+	}
+	if err = client.ProcessRpcRequest(client.oosEndpoint(), OosApiVersion, "GetTemplate", map[string]string{
+		"TemplateName": name,
+	}, &resp); err != nil {
+		if IsExceptedError(err, OosTemplateNotExists) {
+			return template, content, GetNotFoundErrorFromString(GetNotFoundMessage("OOS Template", name))
+		}
+		return template, content, WrapErrorf(err, "GetTemplate got an error")
+	}
+	if resp.Template.TemplateName != name {
+		return template, content, GetNotFoundErrorFromString(GetNotFoundMessage("OOS Template", name))
+	}
+	return resp.Template, resp.Content, nil
+}
+
+func (client *AliyunClient) DescribeOosExecution(id string) (execution OosExecution, err error) {
+	var resp struct {
+		Executions []OosExecution `json:"Executions"`
+	}
+	if err = client.ProcessRpcRequest(client.oosEndpoint(), OosApiVersion, "ListExecutions", map[string]string{
+		"ExecutionId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, OosExecutionNotExists) {
+			return execution, GetNotFoundErrorFromString(GetNotFoundMessage("OOS Execution", id))
+		}
+		return execution, WrapErrorf(err, "ListExecutions got an error")
+	}
+	for _, e := range resp.Executions {
+		if e.ExecutionId == id {
+			return e, nil
+		}
+	}
+	return execution, GetNotFoundErrorFromString(GetNotFoundMessage("OOS Execution", id))
+}
+
+// WaitForOosExecution waits until the execution ends, whatever it succeeds or not. Timeout is in seconds.
+func (client *AliyunClient) WaitForOosExecution(id string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		execution, err := client.DescribeOosExecution(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		switch execution.Status {
+		case OosExecutionSuccess, OosExecutionFailed, OosExecutionCancelled:
+			return nil
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("OOS Execution", "Ended")))
+	})
+}
@@ -6,10 +6,23 @@ import (
 
 func (client *AliyunClient) QueryOssBucketById(id string) (info *oss.BucketInfo, err error) {
 
-	bucket, err := client.ossconn.GetBucketInfo(id)
+	ossconn, err := client.ossconn()
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := ossconn.GetBucketInfo(id)
 	if err != nil {
 		return nil, err
 	}
 
 	return &bucket.BucketInfo, nil
 }
+
+// ossBucket returns the client of the bucket.
+func (client *AliyunClient) ossBucket(name string) (*oss.Bucket, error) {
+	ossconn, err := client.ossconn()
+	if err != nil {
+		return nil, err
+	}
+	return ossconn.Bucket(name)
+}
@@ -0,0 +1,37 @@
+package alicloud
+
+func (client *AliyunClient) polardbEndpoint() string {
+	return client.config.getEndpoint(PolarDBCode, "polardb.aliyuncs.com")
+}
+
+// DescribePolarDBZones returns the zones of the current region where PolarDB clusters can be created.
+func (client *AliyunClient) DescribePolarDBZones() (zoneIds []string, err error) {
+	var resp struct {
+		Regions struct {
+			Region []struct {
+				RegionId string `json:"RegionId"`
+				Zones    struct {
+					Zone []struct {
+						ZoneId     string `json:"ZoneId"`
+						VpcEnabled bool   `json:"VpcEnabled"`
+					} `json:"Zone"`
+				} `json:"Zones"`
+			} `json:"Region"`
+		} `json:"Regions"`
+	}
+	if err := client.ProcessRpcRequest(client.polardbEndpoint(), PolarDBApiVersion, "DescribeRegions", map[string]string{}, &resp); err != nil {
+		return nil, WrapErrorf(err, "DescribeRegions got an error")
+	}
+	for _, region := range resp.Regions.Region {
+		if region.RegionId != string(client.Region) {
+			continue
+		}
+		for _, zone := range region.Zones.Zone {
+			// PolarDB clusters can only be created in VPC.
+			if zone.VpcEnabled {
+				zoneIds = append(zoneIds, zone.ZoneId)
+			}
+		}
+	}
+	return zoneIds, nil
+}
@@ -0,0 +1,79 @@
+package alicloud
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+func (client *AliyunClient) quotasEndpoint() string {
+	return client.config.getEndpoint(QuotasCode, "quotas.aliyuncs.com")
+}
+
+// setQuotasDimensionParams sets the dimensions as the repeated parameter Dimensions, which are sorted by their keys.
+func setQuotasDimensionParams(params map[string]string, dimensions map[string]interface{}) {
+	var keys []string
+	for k := range dimensions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		params[fmt.Sprintf("Dimensions.%d.Key", i+1)] = k
+		params[fmt.Sprintf("Dimensions.%d.Value", i+1)] = dimensions[k].(string)
+	}
+}
+
+func (client *AliyunClient) DescribeQuotasQuotaApplication(id string) (application QuotasQuotaApplication, err error) {
+	var resp struct {
+		QuotaApplication QuotasQuotaApplication `json:"QuotaApplication"`
+	}
+	if err = client.ProcessRpcRequest(client.quotasEndpoint(), QuotasApiVersion, "GetQuotaApplication", map[string]string{
+		"ApplicationId": id,
+	}, &resp); err != nil {
+		return application, WrapErrorf(err, "GetQuotaApplication got an error")
+	}
+	if resp.QuotaApplication.ApplicationId != id {
+		return application, GetNotFoundErrorFromString(GetNotFoundMessage("Quotas Quota Application", id))
+	}
+	return resp.QuotaApplication, nil
+}
+
+// DescribeQuotasQuotas returns the quotas of the product which match the filters in params, like QuotaActionCode and
+// Dimensions.
+func (client *AliyunClient) DescribeQuotasQuotas(params map[string]string) (quotas []QuotasQuota, err error) {
+	params["MaxResults"] = strconv.Itoa(PageSizeLarge)
+	for {
+		var resp struct {
+			Quotas    []QuotasQuota `json:"Quotas"`
+			NextToken string        `json:"NextToken"`
+		}
+		if err = client.ProcessRpcRequest(client.quotasEndpoint(), QuotasApiVersion, "ListProductQuotas", params, &resp); err != nil {
+			return nil, WrapErrorf(err, "ListProductQuotas got an error")
+		}
+		quotas = append(quotas, resp.Quotas...)
+		if resp.NextToken == "" {
+			return quotas, nil
+		}
+		params["NextToken"] = resp.NextToken
+	}
+}
+
+// DescribeQuotasQuotaApplications returns the quota applications of the product which match the filters in params,
+// like QuotaActionCode and Status.
+func (client *AliyunClient) DescribeQuotasQuotaApplications(params map[string]string) (applications []QuotasQuotaApplication, err error) {
+	params["MaxResults"] = strconv.Itoa(PageSizeLarge)
+	for {
+		var resp struct {
+			QuotaApplications []QuotasQuotaApplication `json:"QuotaApplications"`
+			NextToken         string                   `json:"NextToken"`
+		}
+		if err = client.ProcessRpcRequest(client.quotasEndpoint(), QuotasApiVersion, "ListQuotaApplications", params, &resp); err != nil {
+			return nil, WrapErrorf(err, "ListQuotaApplications got an error")
+		}
+		applications = append(applications, resp.QuotaApplications...)
+		if resp.NextToken == "" {
+			return applications, nil
+		}
+		params["NextToken"] = resp.NextToken
+	}
+}
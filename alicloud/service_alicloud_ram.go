@@ -3,6 +3,8 @@ package alicloud
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/denverdino/aliyungo/ram"
@@ -134,9 +136,51 @@ func AssemblePolicyDocument(document []interface{}, version string) (string, err
 	return string(data), nil
 }
 
+// RamPolicyVersionLimit is the maximum number of the versions of a policy.
+const RamPolicyVersionLimit = 5
+
+// ramPolicyVersionNumber returns the number of the policy version like v3, which is increased by each new version.
+func ramPolicyVersionNumber(versionId string) int {
+	n, _ := strconv.Atoi(strings.TrimPrefix(versionId, "v"))
+	return n
+}
+
+// PruneRamPolicyVersions deletes the oldest versions of the policy which are not the default one until there are at
+// most max versions.
+func (client *AliyunClient) PruneRamPolicyVersions(policyName string, max int) error {
+	args := ram.PolicyRequest{
+		PolicyName: policyName,
+		PolicyType: ram.Custom,
+	}
+	resp, err := client.ramconn().ListPolicyVersionsNew(args)
+	if err != nil {
+		return fmt.Errorf("ListPolicyVersions got an error: %#v", err)
+	}
+
+	versions := resp.PolicyVersions.PolicyVersion
+	sort.Slice(versions, func(i, j int) bool {
+		return ramPolicyVersionNumber(versions[i].VersionId) < ramPolicyVersionNumber(versions[j].VersionId)
+	})
+	count := len(versions)
+	for _, v := range versions {
+		if count <= max {
+			break
+		}
+		if v.IsDefaultVersion {
+			continue
+		}
+		args.VersionId = v.VersionId
+		if _, err := client.ramconn().DeletePolicyVersion(args); err != nil && !RamEntityNotExist(err) {
+			return fmt.Errorf("Error delete policy version %s for policy %s:%#v", v.VersionId, policyName, err)
+		}
+		count--
+	}
+	return nil
+}
+
 // Judge whether the role policy contains service "ecs.aliyuncs.com"
 func (client *AliyunClient) JudgeRolePolicyPrincipal(roleName string) error {
-	conn := client.ramconn
+	conn := client.ramconn()
 	resp, err := conn.GetRole(ram.RoleQueryRequest{RoleName: roleName})
 	if err != nil {
 		return fmt.Errorf("GetRole %s got an error: %#v", roleName, err)
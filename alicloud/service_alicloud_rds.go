@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,7 +29,8 @@ func (client *AliyunClient) DescribeDBInstanceById(id string) (instance *rds.DBI
 
 	request := rds.CreateDescribeDBInstanceAttributeRequest()
 	request.DBInstanceId = id
-	resp, err := client.rdsconn.DescribeDBInstanceAttribute(request)
+	resp := rds.CreateDescribeDBInstanceAttributeResponse()
+	err = client.doAction(client.rdsconn, request, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +51,8 @@ func (client *AliyunClient) DescribeDatabaseAccount(instanceId, accountName stri
 	request.DBInstanceId = instanceId
 	request.AccountName = accountName
 
-	resp, err := conn.DescribeAccounts(request)
+	resp := rds.CreateDescribeAccountsResponse()
+	err = client.doAction(conn, request, resp)
 
 	if err != nil {
 		return nil, err
@@ -68,7 +71,8 @@ func (client *AliyunClient) DescribeDatabaseByName(instanceId, dbName string) (d
 	request.DBName = dbName
 
 	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
-		resp, err := client.rdsconn.DescribeDatabases(request)
+		resp := rds.CreateDescribeDatabasesResponse()
+		err := client.doAction(client.rdsconn, request, resp)
 		if err != nil {
 			if IsExceptedError(err, DBInternalError) {
 				return resource.RetryableError(fmt.Errorf("Describe Databases got an error %#v.", err))
@@ -97,7 +101,7 @@ func (client *AliyunClient) AllocateDBPublicConnection(instanceId, prefix, port
 	request.Port = port
 
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		if _, err := conn.AllocateInstancePublicConnection(request); err != nil {
+		if err := client.doAction(conn, request, rds.CreateAllocateInstancePublicConnectionResponse()); err != nil {
 			if IsExceptedError(err, ConnectionOperationDenied) && IsExceptedError(err, ConnectionConflictMessage) {
 				return resource.NonRetryableError(fmt.Errorf("Specified connection prefix %s has already been occupied. Please modify it and try again.", prefix))
 			}
@@ -136,7 +140,8 @@ func (client *AliyunClient) DescribeDBInstanceNetInfos(instanceId string) ([]rds
 
 	request := rds.CreateDescribeDBInstanceNetInfoRequest()
 	request.DBInstanceId = instanceId
-	resp, err := client.rdsconn.DescribeDBInstanceNetInfo(request)
+	resp := rds.CreateDescribeDBInstanceNetInfoResponse()
+	err := client.doAction(client.rdsconn, request, resp)
 
 	if err != nil {
 		return nil, err
@@ -179,7 +184,7 @@ func (client *AliyunClient) GrantAccountPrivilege(instanceId, account, dbName, p
 
 	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 		rq := request
-		if _, err := client.rdsconn.GrantAccountPrivilege(rq); err != nil {
+		if err := client.doAction(client.rdsconn, rq, rds.CreateGrantAccountPrivilegeResponse()); err != nil {
 			if IsExceptedError(err, OperationDeniedDBInstanceStatus) {
 				return resource.RetryableError(fmt.Errorf("Grant DB %s account %s privilege got an error: %#v.", dbName, account, err))
 			}
@@ -208,7 +213,7 @@ func (client *AliyunClient) RevokeAccountPrivilege(instanceId, account, dbName s
 
 	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 		ag := request
-		if _, err := client.rdsconn.RevokeAccountPrivilege(ag); err != nil {
+		if err := client.doAction(client.rdsconn, ag, rds.CreateRevokeAccountPrivilegeResponse()); err != nil {
 			if IsExceptedError(err, OperationDeniedDBInstanceStatus) {
 				return resource.RetryableError(fmt.Errorf("Revoke DB %s account %s privilege got an error: %#v.", dbName, account, err))
 			}
@@ -234,7 +239,7 @@ func (client *AliyunClient) ReleaseDBPublicConnection(instanceId, connection str
 	request.DBInstanceId = instanceId
 	request.CurrentConnectionString = connection
 
-	if _, err := client.rdsconn.ReleaseInstancePublicConnection(request); err != nil {
+	if err := client.doAction(client.rdsconn, request, rds.CreateReleaseInstancePublicConnectionResponse()); err != nil {
 		return err
 	}
 	return nil
@@ -250,7 +255,7 @@ func (client *AliyunClient) ModifyDBBackupPolicy(instanceId, backupTime, backupP
 	request.BackupLog = backupLog
 	request.LogBackupRetentionPeriod = LogBackupRetentionPeriod
 
-	if _, err := client.rdsconn.ModifyBackupPolicy(request); err != nil {
+	if err := client.doAction(client.rdsconn, request, rds.CreateModifyBackupPolicyResponse()); err != nil {
 		return err
 	}
 
@@ -266,7 +271,7 @@ func (client *AliyunClient) ModifyDBSecurityIps(instanceId, ips string) error {
 	request.DBInstanceId = instanceId
 	request.SecurityIps = ips
 
-	if _, err := client.rdsconn.ModifySecurityIps(request); err != nil {
+	if err := client.doAction(client.rdsconn, request, rds.CreateModifySecurityIpsResponse()); err != nil {
 		return err
 	}
 
@@ -281,7 +286,8 @@ func (client *AliyunClient) DescribeDBSecurityIps(instanceId string) (ips []rds.
 	request := rds.CreateDescribeDBInstanceIPArrayListRequest()
 	request.DBInstanceId = instanceId
 
-	resp, err := client.rdsconn.DescribeDBInstanceIPArrayList(request)
+	resp := rds.CreateDescribeDBInstanceIPArrayListResponse()
+	err = client.doAction(client.rdsconn, request, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -317,8 +323,8 @@ func (client *AliyunClient) GetSecurityIps(instanceId string) ([]string, error)
 
 // return multiIZ list of current region
 func (client *AliyunClient) DescribeMultiIZByRegion() (izs []string, err error) {
-	resp, err := client.rdsconn.DescribeRegions(rds.CreateDescribeRegionsRequest())
-	if err != nil {
+	resp := rds.CreateDescribeRegionsResponse()
+	if err := client.doAction(client.rdsconn, rds.CreateDescribeRegionsRequest(), resp); err != nil {
 		return nil, fmt.Errorf("error to list regions not found")
 	}
 	regions := resp.Regions.RDSRegion
@@ -338,7 +344,9 @@ func (client *AliyunClient) DescribeBackupPolicy(instanceId string) (policy *rds
 	request := rds.CreateDescribeBackupPolicyRequest()
 	request.DBInstanceId = instanceId
 
-	return client.rdsconn.DescribeBackupPolicy(request)
+	policy = rds.CreateDescribeBackupPolicyResponse()
+	err = client.doAction(client.rdsconn, request, policy)
+	return
 }
 
 // WaitForInstance waits for instance to given status
@@ -365,6 +373,31 @@ func (client *AliyunClient) WaitForDBInstance(instanceId string, status Status,
 	return nil
 }
 
+// WaitForDBInstanceEngineVersion waits for the engine version of the instance to be upgraded to version.
+// The instance keeps running while it is upgraded, so its status can not be used to check the upgrade.
+func (client *AliyunClient) WaitForDBInstanceEngineVersion(instanceId, version string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	for {
+		instance, err := client.DescribeDBInstanceById(instanceId)
+		if err != nil {
+			return err
+		}
+		if instance.EngineVersion == version && instance.DBInstanceStatus == string(Running) {
+			break
+		}
+
+		if timeout <= 0 {
+			return common.GetClientErrorFromString("Timeout")
+		}
+
+		timeout = timeout - DefaultIntervalMedium
+		time.Sleep(DefaultIntervalMedium * time.Second)
+	}
+	return nil
+}
+
 func (client *AliyunClient) WaitForDBConnection(instanceId string, netType IPType, timeout int) error {
 	if timeout <= 0 {
 		timeout = DefaultTimeout
@@ -536,3 +569,156 @@ func NotFoundDBInstance(err error) bool {
 
 	return false
 }
+
+func (client *AliyunClient) rdsEndpoint() string {
+	return client.config.getEndpoint(RdsCode, "rds.aliyuncs.com")
+}
+
+// DBInstanceExtraAttribute is the attributes of the db instance which are not returned by the vendored rds client.
+type DBInstanceExtraAttribute struct {
+	DeletionProtection   bool   `json:"DeletionProtection"`
+	DedicatedHostGroupId string `json:"DedicatedHostGroupId"`
+}
+
+// DescribeDBInstanceExtraAttribute returns the attributes of the db instance which are not returned by the vendored rds
+// client, like whether the deletion protection is enabled and the dedicated host group which the instance is in.
+func (client *AliyunClient) DescribeDBInstanceExtraAttribute(instanceId string) (attr DBInstanceExtraAttribute, err error) {
+	var resp struct {
+		Items struct {
+			DBInstanceAttribute []DBInstanceExtraAttribute `json:"DBInstanceAttribute"`
+		} `json:"Items"`
+	}
+	if err := client.ProcessRpcRequest(client.rdsEndpoint(), RdsApiVersion, "DescribeDBInstanceAttribute", map[string]string{
+		"DBInstanceId": instanceId,
+	}, &resp); err != nil {
+		return attr, err
+	}
+	if len(resp.Items.DBInstanceAttribute) < 1 {
+		return attr, GetNotFoundErrorFromString(fmt.Sprintf("DB instance %s is not found.", instanceId))
+	}
+	return resp.Items.DBInstanceAttribute[0], nil
+}
+
+// RdsPrice is the price of a db instance returned by DescribePrice.
+type RdsPrice struct {
+	OriginalPrice float64 `json:"OriginalPrice"`
+	DiscountPrice float64 `json:"DiscountPrice"`
+	TradePrice    float64 `json:"TradePrice"`
+	Currency      string  `json:"Currency"`
+}
+
+// DescribeRdsPrice returns the price of the db instance described by the DescribePrice parameters.
+func (client *AliyunClient) DescribeRdsPrice(params map[string]string) (price RdsPrice, err error) {
+	var resp struct {
+		PriceInfo RdsPrice `json:"PriceInfo"`
+	}
+	err = RetryOnError(RdsCode, DefaultTimeout*time.Second, func() error {
+		return client.ProcessRpcRequest(client.rdsEndpoint(), RdsApiVersion, "DescribePrice", params, &resp)
+	})
+	if err != nil {
+		return price, WrapErrorf(err, "DescribePrice got an error")
+	}
+	return resp.PriceInfo, nil
+}
+
+func (client *AliyunClient) ModifyDBInstanceDeletionProtection(instanceId string, enabled bool) error {
+	return client.ProcessRpcRequest(client.rdsEndpoint(), RdsApiVersion, "ModifyDBInstanceDeletionProtection", map[string]string{
+		"DBInstanceId":       instanceId,
+		"DeletionProtection": strconv.FormatBool(enabled),
+	}, nil)
+}
+
+// RdsAvailableResource is an instance class which can be created in a zone. It flattens the nested
+// zones, engines, versions, categories and storage types returned by DescribeAvailableResource.
+type RdsAvailableResource struct {
+	ZoneId          string
+	Engine          string
+	EngineVersion   string
+	Category        string
+	StorageType     string
+	DBInstanceClass string
+	StorageRange    RdsStorageRange
+}
+
+// RdsStorageRange is the storage range in GB of an instance class.
+type RdsStorageRange struct {
+	Min  int `json:"Min"`
+	Max  int `json:"Max"`
+	Step int `json:"Step"`
+}
+
+// DescribeRdsAvailableResources returns the instance classes available in the region. The engine, version,
+// charge type and zone are optional filters of the API.
+func (client *AliyunClient) DescribeRdsAvailableResources(engine, engineVersion, chargeType, zoneId string) (resources []RdsAvailableResource, err error) {
+	params := map[string]string{
+		"InstanceChargeType": chargeType,
+	}
+	if engine != "" {
+		params["Engine"] = engine
+	}
+	if engineVersion != "" {
+		params["EngineVersion"] = engineVersion
+	}
+	if zoneId != "" {
+		params["ZoneId"] = zoneId
+	}
+	var resp struct {
+		AvailableZones struct {
+			AvailableZone []struct {
+				ZoneId           string `json:"ZoneId"`
+				SupportedEngines struct {
+					SupportedEngine []struct {
+						Engine                  string `json:"Engine"`
+						SupportedEngineVersions struct {
+							SupportedEngineVersion []struct {
+								Version            string `json:"Version"`
+								SupportedCategorys struct {
+									SupportedCategory []struct {
+										Category              string `json:"Category"`
+										SupportedStorageTypes struct {
+											SupportedStorageType []struct {
+												StorageType        string `json:"StorageType"`
+												AvailableResources struct {
+													AvailableResource []struct {
+														DBInstanceClass string          `json:"DBInstanceClass"`
+														StorageRange    RdsStorageRange `json:"StorageRange"`
+													} `json:"AvailableResource"`
+												} `json:"AvailableResources"`
+											} `json:"SupportedStorageType"`
+										} `json:"SupportedStorageTypes"`
+									} `json:"SupportedCategory"`
+								} `json:"SupportedCategorys"`
+							} `json:"SupportedEngineVersion"`
+						} `json:"SupportedEngineVersions"`
+					} `json:"SupportedEngine"`
+				} `json:"SupportedEngines"`
+			} `json:"AvailableZone"`
+		} `json:"AvailableZones"`
+	}
+	if err = client.ProcessRpcRequest(client.rdsEndpoint(), RdsApiVersion, "DescribeAvailableResource", params, &resp); err != nil {
+		return nil, WrapErrorf(err, "DescribeAvailableResource got an error")
+	}
+	for _, zone := range resp.AvailableZones.AvailableZone {
+		for _, e := range zone.SupportedEngines.SupportedEngine {
+			for _, version := range e.SupportedEngineVersions.SupportedEngineVersion {
+				for _, category := range version.SupportedCategorys.SupportedCategory {
+					for _, storage := range category.SupportedStorageTypes.SupportedStorageType {
+						for _, res := range storage.AvailableResources.AvailableResource {
+							resources = append(resources, RdsAvailableResource{
+								ZoneId:          zone.ZoneId,
+								Engine:          e.Engine,
+								EngineVersion:   version.Version,
+								Category:        category.Category,
+								StorageType:     storage.StorageType,
+								DBInstanceClass: res.DBInstanceClass,
+								StorageRange:    res.StorageRange,
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+	return resources, nil
+}
+
@@ -0,0 +1,151 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func (client *AliyunClient) saeEndpoint() string {
+	return client.config.getEndpoint(SaeCode, fmt.Sprintf("sae.%s.aliyuncs.com", client.Region))
+}
+
+// ProcessSaeRequest invokes the Serverless App Engine API and converts an unsuccessful response into successFlagError.
+func (client *AliyunClient) ProcessSaeRequest(method, path string, query map[string]string, result interface{}) error {
+	return processSuccessFlagRequest(SaeCode, method+" "+path, func(raw *json.RawMessage) error {
+		return client.ProcessRoaRequest(client.saeEndpoint(), SaeApiVersion, method, path, query, raw)
+	}, result)
+}
+
+func (client *AliyunClient) DescribeSaeNamespace(id string) (namespace SaeNamespace, err error) {
+	var resp struct {
+		Data SaeNamespace `json:"Data"`
+	}
+	if err = client.ProcessSaeRequest(http.MethodGet, "/pop/v1/paas/namespace", map[string]string{
+		"NamespaceId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, SaeNamespaceNotFound) {
+			return namespace, GetNotFoundErrorFromString(GetNotFoundMessage("SAE Namespace", id))
+		}
+		return namespace, WrapErrorf(err, "DescribeNamespace got an error")
+	}
+	if resp.Data.NamespaceId != id {
+		return namespace, GetNotFoundErrorFromString(GetNotFoundMessage("SAE Namespace", id))
+	}
+	return resp.Data, nil
+}
+
+func (client *AliyunClient) DescribeSaeApplication(id string) (app SaeApplication, err error) {
+	var resp struct {
+		Data SaeApplication `json:"Data"`
+	}
+	if err = client.ProcessSaeRequest(http.MethodGet, "/pop/v1/sam/app/describeApplicationConfig", map[string]string{
+		"AppId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, SaeAppNotFound) {
+			return app, GetNotFoundErrorFromString(GetNotFoundMessage("SAE Application", id))
+		}
+		return app, WrapErrorf(err, "DescribeApplicationConfig got an error")
+	}
+	if resp.Data.AppId != id {
+		return app, GetNotFoundErrorFromString(GetNotFoundMessage("SAE Application", id))
+	}
+	return resp.Data, nil
+}
+
+func (client *AliyunClient) DescribeSaeApplicationStatus(id string) (status SaeApplicationStatus, err error) {
+	var resp struct {
+		Data SaeApplicationStatus `json:"Data"`
+	}
+	if err = client.ProcessSaeRequest(http.MethodGet, "/pop/v1/sam/app/describeApplicationStatus", map[string]string{
+		"AppId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, SaeAppNotFound) {
+			return status, GetNotFoundErrorFromString(GetNotFoundMessage("SAE Application", id))
+		}
+		return status, WrapErrorf(err, "DescribeApplicationStatus got an error")
+	}
+	return resp.Data, nil
+}
+
+// WaitForSaeApplication waits until the last change order of the application, like a deployment, ends.
+// Timeout is in seconds.
+func (client *AliyunClient) WaitForSaeApplication(id string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		status, err := client.DescribeSaeApplicationStatus(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if status.LastChangeOrderRunning {
+			return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("SAE Application", "Deployed")))
+		}
+		if status.LastChangeOrderStatus == SaeChangeOrderFail {
+			return resource.NonRetryableError(fmt.Errorf("The change order %s of the SAE application %s failed.", status.LastChangeOrderId, id))
+		}
+		return nil
+	})
+}
+
+// WaitForSaeApplicationDeleted waits until the application is not found. Timeout is in seconds.
+func (client *AliyunClient) WaitForSaeApplicationDeleted(id string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		if _, err := client.DescribeSaeApplication(id); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("SAE Application", "Deleted")))
+	})
+}
+
+func (client *AliyunClient) DescribeSaeApplicationSlb(id string) (slb SaeApplicationSlb, err error) {
+	var resp struct {
+		Data SaeApplicationSlb `json:"Data"`
+	}
+	if err = client.ProcessSaeRequest(http.MethodGet, "/pop/v1/sam/app/slb", map[string]string{
+		"AppId": id,
+	}, &resp); err != nil {
+		return slb, WrapErrorf(err, "DescribeApplicationSlb got an error")
+	}
+	return resp.Data, nil
+}
+
+func (client *AliyunClient) DescribeSaeScalingRules(id string) (rules []SaeScalingRule, err error) {
+	var resp struct {
+		Data struct {
+			ApplicationScalingRules []SaeScalingRule `json:"ApplicationScalingRules"`
+		} `json:"Data"`
+	}
+	if err = client.ProcessSaeRequest(http.MethodGet, "/pop/v1/sam/scale/listApplicationScalingRules", map[string]string{
+		"AppId": id,
+	}, &resp); err != nil {
+		return nil, WrapErrorf(err, "ListApplicationScalingRules got an error")
+	}
+	return resp.Data.ApplicationScalingRules, nil
+}
+
+func (client *AliyunClient) DescribeSaeConfigMap(id string) (configMap SaeConfigMap, err error) {
+	var resp struct {
+		Data SaeConfigMap `json:"Data"`
+	}
+	if err = client.ProcessSaeRequest(http.MethodGet, "/pop/v1/sam/configmap/configMap", map[string]string{
+		"ConfigMapId": id,
+	}, &resp); err != nil {
+		if IsExceptedError(err, SaeConfigMapNotFound) {
+			return configMap, GetNotFoundErrorFromString(GetNotFoundMessage("SAE Config Map", id))
+		}
+		return configMap, WrapErrorf(err, "DescribeConfigMap got an error")
+	}
+	if strconv.FormatInt(resp.Data.ConfigMapId, 10) != id {
+		return configMap, GetNotFoundErrorFromString(GetNotFoundMessage("SAE Config Map", id))
+	}
+	return resp.Data, nil
+}
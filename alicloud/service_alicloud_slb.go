@@ -2,33 +2,69 @@ package alicloud
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
-	"github.com/denverdino/aliyungo/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 )
 
-func (client *AliyunClient) DescribeLoadBalancerAttribute(slbId string) (*slb.LoadBalancerType, error) {
+func (client *AliyunClient) DescribeLoadBalancerAttribute(slbId string) (*slb.DescribeLoadBalancerAttributeResponse, error) {
+	request := slb.CreateDescribeLoadBalancerAttributeRequest()
+	request.RegionId = string(client.Region)
+	request.LoadBalancerId = slbId
+	response := slb.CreateDescribeLoadBalancerAttributeResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return nil, WrapApiError(err, request.GetActionName(), slbId)
+	}
+	return response, nil
+}
 
-	loadBalancer, err := client.slbconn.NewDescribeLoadBalancerAttribute(&slb.NewDescribeLoadBalancerAttributeArgs{
-		RegionId:       client.Region,
-		LoadBalancerId: slbId,
-	})
+// WaitForLoadBalancer waits for the load balancer to become the status, like active.
+func (client *AliyunClient) WaitForLoadBalancer(slbId, status string, timeout int) error {
+	return waitForStatus("Load Balancer", func() (interface{}, string, error) {
+		loadBalancer, err := client.DescribeLoadBalancerAttribute(slbId)
+		if err != nil {
+			return nil, "", err
+		}
+		return loadBalancer, loadBalancer.LoadBalancerStatus, nil
+	}, pendingStatuses([]Status{SlbInactive, SlbLocked, SlbActive}, Status(status)), status, timeout)
+}
 
-	if err != nil {
-		return nil, err
+func (client *AliyunClient) slbEndpoint() string {
+	return client.config.getEndpoint(SlbCode, "slb.aliyuncs.com")
+}
+
+func (client *AliyunClient) SetSlbDeleteProtection(slbId string, enabled bool) error {
+	request := slb.CreateSetLoadBalancerDeleteProtectionRequest()
+	request.RegionId = string(client.Region)
+	request.LoadBalancerId = slbId
+	request.DeleteProtection = SlbDeleteProtectionOff
+	if enabled {
+		request.DeleteProtection = SlbDeleteProtectionOn
+	}
+	return client.doAction(client.slbconn(), request, slb.CreateSetLoadBalancerDeleteProtectionResponse())
+}
+
+// DescribeSlbRules returns the forwarding rules of the listener of the load balancer.
+func (client *AliyunClient) DescribeSlbRules(slbId string, port int) ([]slb.Rule, error) {
+	request := slb.CreateDescribeRulesRequest()
+	request.RegionId = string(client.Region)
+	request.LoadBalancerId = slbId
+	request.ListenerPort = requests.NewInteger(port)
+	response := slb.CreateDescribeRulesResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return nil, WrapApiError(err, request.GetActionName(), slbId)
 	}
-	return loadBalancer, nil
+	return response.Rules.Rule, nil
 }
 
 func (client *AliyunClient) DescribeLoadBalancerRuleId(slbId string, port int, domain, url string) (string, error) {
 
-	if rules, err := client.slbconn.DescribeRules(&slb.DescribeRulesArgs{
-		RegionId:       client.Region,
-		LoadBalancerId: slbId,
-		ListenerPort:   port,
-	}); err != nil {
+	if rules, err := client.DescribeSlbRules(slbId, port); err != nil {
 		return "", fmt.Errorf("DescribeRules got an error: %#v", err)
 	} else {
-		for _, rule := range rules.Rules.Rule {
+		for _, rule := range rules {
 			if rule.Domain == domain && rule.Url == url {
 				return rule.RuleId, nil
 			}
@@ -36,3 +72,136 @@ func (client *AliyunClient) DescribeLoadBalancerRuleId(slbId string, port int, d
 	}
 	return "", GetNotFoundErrorFromString(fmt.Sprintf("Rule is not found based on domain %s and url %s.", domain, url))
 }
+
+func (client *AliyunClient) DescribeSlbRule(ruleId string) (*slb.DescribeRuleAttributeResponse, error) {
+	request := slb.CreateDescribeRuleAttributeRequest()
+	request.RegionId = string(client.Region)
+	request.RuleId = ruleId
+	response := slb.CreateDescribeRuleAttributeResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return nil, WrapApiError(err, request.GetActionName(), ruleId)
+	}
+	return response, nil
+}
+
+// SlbListenerAttribute holds the attributes shared by the listeners of all protocols, which are returned by
+// DescribeLoadBalancer<Protocol>ListenerAttribute.
+type SlbListenerAttribute struct {
+	ListenerPort        int    `json:"ListenerPort"`
+	BackendServerPort   int    `json:"BackendServerPort"`
+	Bandwidth           int    `json:"Bandwidth"`
+	Status              string `json:"Status"`
+	Scheduler           string `json:"Scheduler"`
+	VServerGroupId      string `json:"VServerGroupId"`
+	HealthCheck         string `json:"HealthCheck"`
+	StickySession       string `json:"StickySession"`
+	ServerCertificateId string `json:"ServerCertificateId"`
+}
+
+// DescribeSlbListenerAttribute returns the listener of the protocol, which is one of http, https, tcp and udp.
+func (client *AliyunClient) DescribeSlbListenerAttribute(slbId, protocol string, port int) (listener SlbListenerAttribute, err error) {
+	action := fmt.Sprintf("DescribeLoadBalancer%sListenerAttribute", strings.ToUpper(protocol))
+	if err = client.ProcessRpcRequest(client.slbEndpoint(), SlbApiVersion, action, map[string]string{
+		"LoadBalancerId": slbId,
+		"ListenerPort":   strconv.Itoa(port),
+	}, &listener); err != nil {
+		return listener, WrapErrorf(err, "%s got an error", action)
+	}
+	return listener, nil
+}
+
+// WaitForSlbListener waits for the listener of the protocol to become the status, like running.
+func (client *AliyunClient) WaitForSlbListener(slbId, protocol string, port int, status string, timeout int) error {
+	return waitForStatus("Load Balancer Listener", func() (interface{}, string, error) {
+		listener, err := client.DescribeSlbListenerAttribute(slbId, protocol, port)
+		if err != nil {
+			return nil, "", err
+		}
+		return listener, listener.Status, nil
+	}, pendingStatuses([]Status{SlbListenerStarting, SlbListenerRunning, SlbListenerConfiguring, SlbListenerStopping, SlbListenerStopped},
+		Status(status)), status, timeout)
+}
+
+func (client *AliyunClient) DescribeSlbVServerGroup(groupId string) (*slb.DescribeVServerGroupAttributeResponse, error) {
+	request := slb.CreateDescribeVServerGroupAttributeRequest()
+	request.RegionId = string(client.Region)
+	request.VServerGroupId = groupId
+	response := slb.CreateDescribeVServerGroupAttributeResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return nil, WrapApiError(err, request.GetActionName(), groupId)
+	}
+	return response, nil
+}
+
+// DescribeSlbVServerGroupBackendServers returns the backend servers of the vserver group.
+func (client *AliyunClient) DescribeSlbVServerGroupBackendServers(groupId string) ([]slb.BackendServer, error) {
+	group, err := client.DescribeSlbVServerGroup(groupId)
+	if err != nil {
+		return nil, err
+	}
+	return group.BackendServers.BackendServer, nil
+}
+
+func (client *AliyunClient) DescribeSlbHTTPListener(loadBalancerId string, port int) (*slb.DescribeLoadBalancerHTTPListenerAttributeResponse, error) {
+	request := slb.CreateDescribeLoadBalancerHTTPListenerAttributeRequest()
+	request.RegionId = string(client.Region)
+	request.LoadBalancerId = loadBalancerId
+	request.ListenerPort = requests.NewInteger(port)
+	response := slb.CreateDescribeLoadBalancerHTTPListenerAttributeResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (client *AliyunClient) DescribeSlbHTTPSListener(loadBalancerId string, port int) (*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse, error) {
+	request := slb.CreateDescribeLoadBalancerHTTPSListenerAttributeRequest()
+	request.RegionId = string(client.Region)
+	request.LoadBalancerId = loadBalancerId
+	request.ListenerPort = requests.NewInteger(port)
+	response := slb.CreateDescribeLoadBalancerHTTPSListenerAttributeResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (client *AliyunClient) DescribeSlbTCPListener(loadBalancerId string, port int) (*slb.DescribeLoadBalancerTCPListenerAttributeResponse, error) {
+	request := slb.CreateDescribeLoadBalancerTCPListenerAttributeRequest()
+	request.RegionId = string(client.Region)
+	request.LoadBalancerId = loadBalancerId
+	request.ListenerPort = requests.NewInteger(port)
+	response := slb.CreateDescribeLoadBalancerTCPListenerAttributeResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (client *AliyunClient) DescribeSlbUDPListener(loadBalancerId string, port int) (*slb.DescribeLoadBalancerUDPListenerAttributeResponse, error) {
+	request := slb.CreateDescribeLoadBalancerUDPListenerAttributeRequest()
+	request.RegionId = string(client.Region)
+	request.LoadBalancerId = loadBalancerId
+	request.ListenerPort = requests.NewInteger(port)
+	response := slb.CreateDescribeLoadBalancerUDPListenerAttributeResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (client *AliyunClient) DescribeSlbCACertificate(id string) (cert slb.CACertificate, err error) {
+	request := slb.CreateDescribeCACertificatesRequest()
+	request.RegionId = string(client.Region)
+	request.CACertificateId = id
+	response := slb.CreateDescribeCACertificatesResponse()
+	if err := client.doAction(client.slbconn(), request, response); err != nil {
+		return cert, WrapErrorf(err, "DescribeCACertificates got an error")
+	}
+	for _, cert := range response.CACertificates.CACertificate {
+		if cert.CACertificateId == id {
+			return cert, nil
+		}
+	}
+	return cert, GetNotFoundErrorFromString(GetNotFoundMessage("SLB CA Certificate", id))
+}
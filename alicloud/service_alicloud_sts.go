@@ -0,0 +1,101 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+)
+
+type StsCredentials struct {
+	AccessKeyId     string `json:"AccessKeyId"`
+	AccessKeySecret string `json:"AccessKeySecret"`
+	SecurityToken   string `json:"SecurityToken"`
+	Expiration      string `json:"Expiration"`
+}
+
+type StsAssumedRoleUser struct {
+	Arn           string `json:"Arn"`
+	AssumedRoleId string `json:"AssumedRoleId"`
+}
+
+type AssumeRoleResponse struct {
+	RequestId       string             `json:"RequestId"`
+	Credentials     StsCredentials     `json:"Credentials"`
+	AssumedRoleUser StsAssumedRoleUser `json:"AssumedRoleUser"`
+}
+
+// stsAssumeRole exchanges the credentials used by conn for the temporary credentials of the role, which is requested
+// on domain by the scheme. The policy is optional and it further restricts the permissions of the role.
+func stsAssumeRole(conn *sdk.Client, scheme, domain, roleArn, sessionName, policy string, expiration int) (*AssumeRoleResponse, error) {
+	request := requests.NewCommonRequest()
+	request.Method = requests.POST
+	request.Scheme = scheme
+	request.Domain = domain
+	request.Version = StsApiVersion
+	request.ApiName = "AssumeRole"
+	request.FormParams["RoleArn"] = roleArn
+	request.FormParams["RoleSessionName"] = sessionName
+	if policy != "" {
+		request.FormParams["Policy"] = policy
+	}
+	if expiration > 0 {
+		request.FormParams["DurationSeconds"] = strconv.Itoa(expiration)
+	}
+
+	resp, err := conn.ProcessCommonRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AssumeRoleResponse{}
+	if err := json.Unmarshal(resp.GetHttpContentBytes(), response); err != nil {
+		return nil, fmt.Errorf("Unmarshalling AssumeRole response got an error: %#v", err)
+	}
+	return response, nil
+}
+
+type CallerIdentity struct {
+	AccountId    string `json:"AccountId"`
+	UserId       string `json:"UserId"`
+	Arn          string `json:"Arn"`
+	IdentityType string `json:"IdentityType"`
+	PrincipalId  string `json:"PrincipalId"`
+}
+
+// GetCallerIdentity returns the identity of the credentials used by the provider.
+func (client *AliyunClient) GetCallerIdentity() (identity CallerIdentity, err error) {
+	err = client.ProcessRpcRequest(client.config.getEndpoint(StsCode, StsDomain), StsApiVersion, "GetCallerIdentity", map[string]string{}, &identity)
+	if err != nil {
+		return identity, WrapErrorf(err, "GetCallerIdentity got an error")
+	}
+	return identity, nil
+}
+
+// AccountId returns the ID of the account owning the credentials. It is required by the endpoints of some
+// products, and it is loaded once and cached.
+func (client *AliyunClient) AccountId() (string, error) {
+	client.accountIdMutex.Lock()
+	defer client.accountIdMutex.Unlock()
+
+	if client.accountId == "" {
+		identity, err := client.GetCallerIdentity()
+		if err != nil {
+			return "", err
+		}
+		client.accountId = identity.AccountId
+	}
+	return client.accountId, nil
+}
+
+// AssumeRole returns the temporary credentials of the role for the credentials used by the provider.
+func (client *AliyunClient) AssumeRole(roleArn, sessionName, policy string, expiration int) (*AssumeRoleResponse, error) {
+	scheme, domain := client.config.splitEndpoint(client.config.getEndpoint(StsCode, StsDomain))
+	resp, err := stsAssumeRole(client.commonconn, scheme, domain, roleArn, sessionName, policy, expiration)
+	if err != nil {
+		return nil, WrapErrorf(err, "AssumeRole got an error")
+	}
+	return resp, nil
+}
@@ -1,13 +1,107 @@
 package alicloud
 
 import (
-	"time"
+	"strconv"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
-	"github.com/denverdino/aliyungo/ecs"
 )
 
-const Negative = ecs.Spec("Negative")
+const Negative = Spec("Negative")
+
+func (client *AliyunClient) vpcEndpoint() string {
+	return client.config.getEndpoint(VpcCode, "vpc.aliyuncs.com")
+}
+
+// DescribeVpcTags returns all of the tags of the VPC resource with the specified type.
+func (client *AliyunClient) DescribeVpcTags(resourceType, resourceId string) ([]TagResource, error) {
+	return client.describeTagResources(client.vpcEndpoint(), VpcApiVersion, resourceType, resourceId)
+}
+
+// DescribeNatGatewayDeletionProtection returns whether the deletion protection of the nat gateway is enabled.
+// The attribute is not returned by the vendored vpc client.
+func (client *AliyunClient) DescribeNatGatewayDeletionProtection(natGatewayId string) (bool, error) {
+	var resp struct {
+		NatGateways struct {
+			NatGateway []struct {
+				DeletionProtection bool `json:"DeletionProtection"`
+			} `json:"NatGateway"`
+		} `json:"NatGateways"`
+	}
+	if err := client.ProcessRpcRequest(client.vpcEndpoint(), VpcApiVersion, "DescribeNatGateways", map[string]string{
+		"NatGatewayId": natGatewayId,
+	}, &resp); err != nil {
+		return false, err
+	}
+	if len(resp.NatGateways.NatGateway) < 1 {
+		return false, GetNotFoundErrorFromString(GetNotFoundMessage("Nat Gateway", natGatewayId))
+	}
+	return resp.NatGateways.NatGateway[0].DeletionProtection, nil
+}
+
+// SetVpcDeletionProtection enables or disables the deletion protection of the VPC instance with the specified type.
+func (client *AliyunClient) SetVpcDeletionProtection(instanceType, instanceId string, enabled bool) error {
+	return client.ProcessRpcRequest(client.vpcEndpoint(), VpcApiVersion, "DeletionProtection", map[string]string{
+		"Type":             instanceType,
+		"InstanceId":       instanceId,
+		"ProtectionEnable": strconv.FormatBool(enabled),
+	}, nil)
+}
+
+// EipAssociation is the binding of an EIP, and it is described by the API rather than the SDK
+// which does not return the private ip address and the mode.
+type EipAssociation struct {
+	InstanceId       string `json:"InstanceId"`
+	InstanceType     string `json:"InstanceType"`
+	PrivateIpAddress string `json:"PrivateIpAddress"`
+	Mode             string `json:"Mode"`
+}
+
+func (client *AliyunClient) DescribeEipAssociation(allocationId string) (association EipAssociation, err error) {
+	var resp struct {
+		EipAddresses struct {
+			EipAddress []EipAssociation `json:"EipAddress"`
+		} `json:"EipAddresses"`
+	}
+	if err = client.ProcessRpcRequest(client.vpcEndpoint(), VpcApiVersion, "DescribeEipAddresses", map[string]string{
+		"AllocationId": allocationId,
+	}, &resp); err != nil {
+		return
+	}
+	if len(resp.EipAddresses.EipAddress) < 1 {
+		return association, GetNotFoundErrorFromString(GetNotFoundMessage("EIP", allocationId))
+	}
+	return resp.EipAddresses.EipAddress[0], nil
+}
+
+// AssociateEip binds the EIP to the instance. The private ip address and the mode are only valid
+// for a network interface, and they are ignored when they are empty.
+func (client *AliyunClient) AssociateEip(allocationId, instanceId, instanceType, privateIpAddress, mode string) error {
+	params := map[string]string{
+		"AllocationId": allocationId,
+		"InstanceId":   instanceId,
+		"InstanceType": instanceType,
+	}
+	if privateIpAddress != "" {
+		params["PrivateIpAddress"] = privateIpAddress
+	}
+	if mode != "" {
+		params["Mode"] = mode
+	}
+	return client.ProcessRpcRequest(client.vpcEndpoint(), VpcApiVersion, "AssociateEipAddress", params, nil)
+}
+
+func (client *AliyunClient) UnassociateEip(allocationId, instanceId, instanceType, privateIpAddress string) error {
+	params := map[string]string{
+		"AllocationId": allocationId,
+		"InstanceId":   instanceId,
+		"InstanceType": instanceType,
+	}
+	if privateIpAddress != "" {
+		params["PrivateIpAddress"] = privateIpAddress
+	}
+	return client.ProcessRpcRequest(client.vpcEndpoint(), VpcApiVersion, "UnassociateEipAddress", params, nil)
+}
 
 func (client *AliyunClient) DescribeEipAddress(allocationId string) (eip vpc.EipAddress, err error) {
 
@@ -15,7 +109,8 @@ func (client *AliyunClient) DescribeEipAddress(allocationId string) (eip vpc.Eip
 	args.RegionId = string(client.Region)
 	args.AllocationId = allocationId
 
-	eips, err := client.vpcconn.DescribeEipAddresses(args)
+	eips := vpc.CreateDescribeEipAddressesResponse()
+	err = client.doAction(client.vpcconn, args, eips)
 	if err != nil {
 		return
 	}
@@ -32,7 +127,8 @@ func (client *AliyunClient) DescribeNatGateway(natGatewayId string) (nat vpc.Nat
 	args.RegionId = string(client.Region)
 	args.NatGatewayId = natGatewayId
 
-	resp, err := client.vpcconn.DescribeNatGateways(args)
+	resp := vpc.CreateDescribeNatGatewaysResponse()
+	err = client.doAction(client.vpcconn, args, resp)
 	if err != nil {
 		if IsExceptedError(err, InvalidNatGatewayIdNotFound) {
 			return nat, GetNotFoundErrorFromString(GetNotFoundMessage("Nat Gateway", natGatewayId))
@@ -51,7 +147,8 @@ func (client *AliyunClient) DescribeVpc(vpcId string) (v vpc.DescribeVpcAttribut
 	request := vpc.CreateDescribeVpcAttributeRequest()
 	request.VpcId = vpcId
 
-	resp, err := client.vpcconn.DescribeVpcAttribute(request)
+	resp := vpc.CreateDescribeVpcAttributeResponse()
+	err = client.doAction(client.vpcconn, request, resp)
 	if err != nil {
 		if IsExceptedError(err, InvalidVpcIDNotFound) || IsExceptedError(err, ForbiddenVpcNotFound) {
 			return v, GetNotFoundErrorFromString(GetNotFoundMessage("VPC", vpcId))
@@ -69,7 +166,8 @@ func (client *AliyunClient) DescribeVswitch(vswitchId string) (v vpc.DescribeVSw
 	request.RegionId = string(client.Region)
 	request.VSwitchId = vswitchId
 
-	resp, err := client.vpcconn.DescribeVSwitchAttributes(request)
+	resp := vpc.CreateDescribeVSwitchAttributesResponse()
+	err = client.doAction(client.vpcconn, request, resp)
 	if err != nil {
 		if IsExceptedError(err, InvalidVswitchIDNotFound) {
 			return v, GetNotFoundErrorFromString(GetNotFoundMessage("VSwitch", vswitchId))
@@ -82,13 +180,33 @@ func (client *AliyunClient) DescribeVswitch(vswitchId string) (v vpc.DescribeVSw
 	return *resp, nil
 }
 
-func (client *AliyunClient) DescribeSnatEntry(snatTableId string, snatEntryId string) (snat vpc.SnatTableEntry, err error) {
-
+// DescribeSnatEntries returns all of the entries of the snat table.
+func (client *AliyunClient) DescribeSnatEntries(snatTableId string) (entries []vpc.SnatTableEntry, err error) {
 	request := vpc.CreateDescribeSnatTableEntriesRequest()
 	request.RegionId = string(client.Region)
 	request.SnatTableId = snatTableId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		resp := vpc.CreateDescribeSnatTableEntriesResponse()
+		err := client.doAction(client.vpcconn, request, resp)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil || len(resp.SnatTableEntries.SnatTableEntry) < 1 {
+			break
+		}
+		entries = append(entries, resp.SnatTableEntries.SnatTableEntry...)
+		if len(resp.SnatTableEntries.SnatTableEntry) < PageSizeLarge {
+			break
+		}
+	}
+	return entries, nil
+}
+
+func (client *AliyunClient) DescribeSnatEntry(snatTableId string, snatEntryId string) (snat vpc.SnatTableEntry, err error) {
 
-	snatEntries, err := client.vpcconn.DescribeSnatTableEntries(request)
+	snatEntries, err := client.DescribeSnatEntries(snatTableId)
 
 	//this special deal cause the DescribeSnatEntry can't find the records would be throw "cant find the snatTable error"
 	//so judge the snatEntries length priority
@@ -99,11 +217,7 @@ func (client *AliyunClient) DescribeSnatEntry(snatTableId string, snatEntryId st
 		return
 	}
 
-	if len(snatEntries.SnatTableEntries.SnatTableEntry) == 0 {
-		return snat, GetNotFoundErrorFromString(GetNotFoundMessage("Snat Entry", snatEntryId))
-	}
-
-	for _, snat := range snatEntries.SnatTableEntries.SnatTableEntry {
+	for _, snat := range snatEntries {
 		if snat.SnatEntryId == snatEntryId {
 			return snat, nil
 		}
@@ -112,13 +226,33 @@ func (client *AliyunClient) DescribeSnatEntry(snatTableId string, snatEntryId st
 	return snat, GetNotFoundErrorFromString(GetNotFoundMessage("Snat Entry", snatEntryId))
 }
 
-func (client *AliyunClient) DescribeForwardEntry(forwardTableId string, forwardEntryId string) (entry vpc.ForwardTableEntry, err error) {
+// DescribeForwardEntries returns all of the entries of the forward table.
+func (client *AliyunClient) DescribeForwardEntries(forwardTableId string) (entries []vpc.ForwardTableEntry, err error) {
+	request := vpc.CreateDescribeForwardTableEntriesRequest()
+	request.RegionId = string(client.Region)
+	request.ForwardTableId = forwardTableId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		resp := vpc.CreateDescribeForwardTableEntriesResponse()
+		err := client.doAction(client.vpcconn, request, resp)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil || len(resp.ForwardTableEntries.ForwardTableEntry) < 1 {
+			break
+		}
+		entries = append(entries, resp.ForwardTableEntries.ForwardTableEntry...)
+		if len(resp.ForwardTableEntries.ForwardTableEntry) < PageSizeLarge {
+			break
+		}
+	}
+	return entries, nil
+}
 
-	args := vpc.CreateDescribeForwardTableEntriesRequest()
-	args.RegionId = string(client.Region)
-	args.ForwardTableId = forwardTableId
+func (client *AliyunClient) DescribeForwardEntry(forwardTableId string, forwardEntryId string) (entry vpc.ForwardTableEntry, err error) {
 
-	resp, err := client.vpcconn.DescribeForwardTableEntries(args)
+	entries, err := client.DescribeForwardEntries(forwardTableId)
 	//this special deal cause the DescribeSnatEntry can't find the records would be throw "cant find the snatTable error"
 	//so judge the snatEntries length priority
 	if err != nil {
@@ -128,11 +262,7 @@ func (client *AliyunClient) DescribeForwardEntry(forwardTableId string, forwardE
 		return
 	}
 
-	if resp == nil || len(resp.ForwardTableEntries.ForwardTableEntry) <= 0 {
-		return entry, GetNotFoundErrorFromString(GetNotFoundMessage("Forward Entry", forwardTableId))
-	}
-
-	for _, forward := range resp.ForwardTableEntries.ForwardTableEntry {
+	for _, forward := range entries {
 		if forward.ForwardEntryId == forwardEntryId {
 			return forward, nil
 		}
@@ -141,11 +271,54 @@ func (client *AliyunClient) DescribeForwardEntry(forwardTableId string, forwardE
 	return entry, GetNotFoundErrorFromString(GetNotFoundMessage("Forward Entry", forwardTableId))
 }
 
+// RouteTableListType is a route table returned by DescribeRouteTableList, and it is described by the API
+// rather than the SDK which does not return the associated vswitches.
+type RouteTableListType struct {
+	VpcId          string `json:"VpcId"`
+	RouterType     string `json:"RouterType"`
+	RouterId       string `json:"RouterId"`
+	RouteTableId   string `json:"RouteTableId"`
+	RouteTableName string `json:"RouteTableName"`
+	RouteTableType string `json:"RouteTableType"`
+	Description    string `json:"Description"`
+	CreationTime   string `json:"CreationTime"`
+	VSwitchIds     struct {
+		VSwitchId []string `json:"VSwitchId"`
+	} `json:"VSwitchIds"`
+}
+
+// DescribeRouteTableList returns all of the route tables of the vpc, or of the region if vpcId is empty.
+func (client *AliyunClient) DescribeRouteTableList(vpcId string) (tables []RouteTableListType, err error) {
+	params := map[string]string{
+		"PageSize": strconv.Itoa(PageSizeLarge),
+	}
+	if vpcId != "" {
+		params["VpcId"] = vpcId
+	}
+	for pageNumber := 1; ; pageNumber++ {
+		params["PageNumber"] = strconv.Itoa(pageNumber)
+		var resp struct {
+			RouterTableList struct {
+				RouterTableListType []RouteTableListType `json:"RouterTableListType"`
+			} `json:"RouterTableList"`
+		}
+		if err := client.ProcessRpcRequest(client.vpcEndpoint(), VpcApiVersion, "DescribeRouteTableList", params, &resp); err != nil {
+			return nil, err
+		}
+		tables = append(tables, resp.RouterTableList.RouterTableListType...)
+		if len(resp.RouterTableList.RouterTableListType) < PageSizeLarge {
+			break
+		}
+	}
+	return tables, nil
+}
+
 func (client *AliyunClient) QueryRouteTableById(routeTableId string) (rt vpc.RouteTable, err error) {
 	request := vpc.CreateDescribeRouteTablesRequest()
 	request.RouteTableId = routeTableId
 
-	rts, err := client.vpcconn.DescribeRouteTables(request)
+	rts := vpc.CreateDescribeRouteTablesResponse()
+	err = client.doAction(client.vpcconn, request, rts)
 	if err != nil {
 		return
 	}
@@ -172,7 +345,7 @@ func (client *AliyunClient) QueryRouteEntry(routeTableId, cidrBlock, nextHopType
 	return rn, GetNotFoundErrorFromString(GetNotFoundMessage("Route Entry", routeTableId))
 }
 
-func (client *AliyunClient) DescribeRouterInterface(interfaceId string) (ri vpc.RouterInterfaceTypeInDescribeRouterInterfaces, err error) {
+func (client *AliyunClient) DescribeRouterInterface(interfaceId string) (ri vpc.RouterInterfaceType, err error) {
 	request := vpc.CreateDescribeRouterInterfacesRequest()
 	request.RegionId = string(client.Region)
 	values := []string{interfaceId}
@@ -183,7 +356,8 @@ func (client *AliyunClient) DescribeRouterInterface(interfaceId string) (ri vpc.
 	}
 	request.Filter = &filter
 
-	resp, err := client.vpcconn.DescribeRouterInterfaces(request)
+	resp := vpc.CreateDescribeRouterInterfacesResponse()
+	err = client.doAction(client.vpcconn, request, resp)
 	if err != nil {
 		return
 	}
@@ -195,128 +369,123 @@ func (client *AliyunClient) DescribeRouterInterface(interfaceId string) (ri vpc.
 }
 
 func (client *AliyunClient) WaitForVpc(vpcId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
+	return waitForStatus("VPC", func() (interface{}, string, error) {
 		vpc, err := client.DescribeVpc(vpcId)
 		if err != nil {
-			return err
-		}
-		if vpc.Status == string(status) {
-			break
+			return nil, "", err
 		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("VPC", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-	}
-	return nil
+		return vpc, vpc.Status, nil
+	}, []string{string(Pending)}, string(status), timeout)
 }
 
 func (client *AliyunClient) WaitForVSwitch(vswitchId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
+	return waitForStatus("VSwitch", func() (interface{}, string, error) {
 		vswitch, err := client.DescribeVswitch(vswitchId)
 		if err != nil {
-			return err
-		}
-		if vswitch.Status == string(status) {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("VSwitch", string(status)))
+			return nil, "", err
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-	}
-	return nil
+		return vswitch, vswitch.Status, nil
+	}, []string{string(Pending)}, string(status), timeout)
 }
 
 func (client *AliyunClient) WaitForAllRouteEntries(routeTableId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-	for {
-
+	return waitForStatus("All Route Entries", func() (interface{}, string, error) {
 		table, err := client.QueryRouteTableById(routeTableId)
-
 		if err != nil {
-			return err
+			return nil, "", err
 		}
-
-		success := true
-
 		for _, routeEntry := range table.RouteEntrys.RouteEntry {
 			if routeEntry.Status != string(status) {
-				success = false
-				break
+				return table, routeEntry.Status, nil
 			}
 		}
-		if success {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("All Route Entries", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-	}
-	return nil
+		return table, string(status), nil
+	}, []string{string(Pending), string(Modifying), string(Deleting)}, string(status), timeout)
 }
 
 func (client *AliyunClient) WaitForRouterInterface(interfaceId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-	for {
+	return waitForStatus("Router Interface", func() (interface{}, string, error) {
 		result, err := client.DescribeRouterInterface(interfaceId)
 		if err != nil {
-			return err
-		} else if result.Status == string(status) {
-			break
-		}
-
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("Router Interface", string(status)))
+			return nil, "", err
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-	}
-	return nil
+		return result, result.Status, nil
+	}, pendingStatuses([]Status{Idle, Creating, Connecting, Activating, Active, Deactivating, Inactive}, status), string(status), timeout)
 }
 
 func (client *AliyunClient) WaitForEip(allocationId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
+	return waitForStatus("EIP", func() (interface{}, string, error) {
 		eip, err := client.DescribeEipAddress(allocationId)
 		if err != nil {
-			return err
-		}
-
-		if eip.Status == string(status) {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("EIP", string(status)))
+			return nil, "", err
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-	}
-	return nil
+		return eip, eip.Status, nil
+	}, pendingStatuses([]Status{Available, Associating, Unassociating, InUse}, status), string(status), timeout)
 }
 
 func GetAllRouterInterfaceSpec() (specifications []string) {
-	specifications = append(specifications, string(ecs.Large1), string(ecs.Large2),
-		string(ecs.Small1), string(ecs.Small2), string(ecs.Small5), string(ecs.Middle1),
-		string(ecs.Middle2), string(ecs.Middle5), string(Negative))
+	specifications = append(specifications, string(Large1), string(Large2),
+		string(Small1), string(Small2), string(Small5), string(Middle1),
+		string(Middle2), string(Middle5), string(Negative))
 	return
 }
+
+// NetworkAclEntry is an ingress or egress entry of a network ACL.
+type NetworkAclEntry struct {
+	NetworkAclEntryName string `json:"NetworkAclEntryName"`
+	Description         string `json:"Description"`
+	Policy              string `json:"Policy"`
+	Protocol            string `json:"Protocol"`
+	Port                string `json:"Port"`
+	SourceCidrIp        string `json:"SourceCidrIp"`
+	DestinationCidrIp   string `json:"DestinationCidrIp"`
+}
+
+// NetworkAcl is a network ACL returned by DescribeNetworkAcls, which is not covered by the vendored SDK.
+type NetworkAcl struct {
+	NetworkAclId   string `json:"NetworkAclId"`
+	NetworkAclName string `json:"NetworkAclName"`
+	Description    string `json:"Description"`
+	VpcId          string `json:"VpcId"`
+	Status         string `json:"Status"`
+	CreationTime   string `json:"CreationTime"`
+	Resources      struct {
+		Resource []struct {
+			ResourceId   string `json:"ResourceId"`
+			ResourceType string `json:"ResourceType"`
+			Status       string `json:"Status"`
+		} `json:"Resource"`
+	} `json:"Resources"`
+	IngressAclEntries struct {
+		IngressAclEntry []NetworkAclEntry `json:"IngressAclEntry"`
+	} `json:"IngressAclEntries"`
+	EgressAclEntries struct {
+		EgressAclEntry []NetworkAclEntry `json:"EgressAclEntry"`
+	} `json:"EgressAclEntries"`
+}
+
+// DescribeNetworkAcls returns all of the network ACLs matching the filters, such as VpcId, ResourceId and ResourceType.
+func (client *AliyunClient) DescribeNetworkAcls(filters map[string]string) (acls []NetworkAcl, err error) {
+	params := map[string]string{
+		"PageSize": strconv.Itoa(PageSizeLarge),
+	}
+	for k, v := range filters {
+		params[k] = v
+	}
+	for pageNumber := 1; ; pageNumber++ {
+		params["PageNumber"] = strconv.Itoa(pageNumber)
+		var resp struct {
+			NetworkAcls struct {
+				NetworkAcl []NetworkAcl `json:"NetworkAcl"`
+			} `json:"NetworkAcls"`
+		}
+		if err := client.ProcessRpcRequest(client.vpcEndpoint(), VpcApiVersion, "DescribeNetworkAcls", params, &resp); err != nil {
+			return nil, err
+		}
+		acls = append(acls, resp.NetworkAcls.NetworkAcl...)
+		if len(resp.NetworkAcls.NetworkAcl) < PageSizeLarge {
+			break
+		}
+	}
+	return acls, nil
+}
@@ -0,0 +1,90 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+type VpcPeerConnection struct {
+	InstanceId        string `json:"InstanceId"`
+	Name              string `json:"Name"`
+	Description       string `json:"Description"`
+	Status            string `json:"Status"`
+	Bandwidth         int    `json:"Bandwidth"`
+	RegionId          string `json:"RegionId"`
+	OwnerId           int64  `json:"OwnerId"`
+	AcceptingOwnerUid int64  `json:"AcceptingOwnerUid"`
+	AcceptingRegionId string `json:"AcceptingRegionId"`
+	GmtCreate         string `json:"GmtCreate"`
+	Vpc               struct {
+		VpcId string `json:"VpcId"`
+	} `json:"Vpc"`
+	AcceptingVpc struct {
+		VpcId string `json:"VpcId"`
+	} `json:"AcceptingVpc"`
+}
+
+func (client *AliyunClient) vpcPeerEndpoint() string {
+	return client.config.getEndpoint(VpcPeerCode, "vpcpeer.aliyuncs.com")
+}
+
+func (client *AliyunClient) DescribeVpcPeerConnection(id string) (conn VpcPeerConnection, err error) {
+	if err = client.ProcessRpcRequest(client.vpcPeerEndpoint(), VpcPeerApiVersion, "GetVpcPeerConnectionAttribute", map[string]string{
+		"InstanceId": id,
+	}, &conn); err != nil {
+		if IsExceptedError(err, VpcPeerConnectionNotFound) {
+			return conn, GetNotFoundErrorFromString(GetNotFoundMessage("VPC Peer Connection", id))
+		}
+		return
+	}
+	if conn.InstanceId != id {
+		return conn, GetNotFoundErrorFromString(GetNotFoundMessage("VPC Peer Connection", id))
+	}
+	return conn, nil
+}
+
+// WaitForVpcPeerConnection waits for the VPC peer connection to leave the pending statuses and returns an
+// error if it ends up in a status other than the targets. Timeout is in seconds.
+func (client *AliyunClient) WaitForVpcPeerConnection(id string, pending []Status, targets []Status, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	conf := &resource.StateChangeConf{
+		Refresh: func() (interface{}, string, error) {
+			conn, err := client.DescribeVpcPeerConnection(id)
+			if err != nil {
+				return nil, "", err
+			}
+			return conn, conn.Status, nil
+		},
+		Timeout:    time.Duration(timeout) * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	for _, status := range pending {
+		conf.Pending = append(conf.Pending, string(status))
+	}
+	for _, status := range targets {
+		conf.Target = append(conf.Target, string(status))
+	}
+	if _, err := conf.WaitForState(); err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok {
+			return GetTimeErrorFromString(GetTimeoutMessage("VPC Peer Connection", conf.Target[0]))
+		}
+		return err
+	}
+	return nil
+}
+
+// WaitForVpcPeerConnectionDeleted waits until the VPC peer connection is not found. Timeout is in seconds.
+func (client *AliyunClient) WaitForVpcPeerConnectionDeleted(id string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		if _, err := client.DescribeVpcPeerConnection(id); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(GetTimeErrorFromString(GetTimeoutMessage("VPC Peer Connection", "Deleted")))
+	})
+}
@@ -1,11 +1,16 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 
-	"github.com/denverdino/aliyungo/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -15,7 +20,6 @@ func String(v string) *string {
 }
 
 // tagsSchema returns the schema to use for tags.
-//
 func tagsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type: schema.TypeMap,
@@ -26,47 +30,326 @@ func tagsSchema() *schema.Schema {
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTags(client *AliyunClient, resourceType ecs.TagResourceType, d *schema.ResourceData) error {
-
-	conn := client.ecsconn
-
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			err := RemoveTags(conn, &RemoveTagsArgs{
-				RegionId:     client.Region,
-				ResourceId:   d.Id(),
-				ResourceType: resourceType,
-				Tag:          remove,
-			})
-			if err != nil {
-				return fmt.Errorf("Remove tags got error: %s", err)
-			}
+func setTags(client *AliyunClient, resourceType TagResourceType, d *schema.ResourceData) error {
+	tags, err := client.describeEcsTags(client.Region, resourceType, d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeTags", d.Id())
+	}
+
+	create, remove := client.changedTags(d, tagsToMap(tags))
+
+	// Set tags
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
+		request := ecs.CreateRemoveTagsRequest()
+		request.RegionId = string(client.Region)
+		request.ResourceId = d.Id()
+		request.ResourceType = string(resourceType)
+		tags := make([]ecs.RemoveTagsTag, 0, len(remove))
+		for _, t := range remove {
+			tags = append(tags, ecs.RemoveTagsTag{Key: t.Key, Value: t.Value})
+		}
+		request.Tag = &tags
+		if err := client.doAction(client.ecsconn(), request, ecs.CreateRemoveTagsResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
+	}
 
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			err := AddTags(conn, &AddTagsArgs{
-				RegionId:     client.Region,
-				ResourceId:   d.Id(),
-				ResourceType: resourceType,
-				Tag:          create,
-			})
-			if err != nil {
-				return fmt.Errorf("Creating tags got error: %s", err)
-			}
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+		request := ecs.CreateAddTagsRequest()
+		request.RegionId = string(client.Region)
+		request.ResourceId = d.Id()
+		request.ResourceType = string(resourceType)
+		tags := make([]ecs.AddTagsTag, 0, len(create))
+		for _, t := range create {
+			tags = append(tags, ecs.AddTagsTag{Key: t.Key, Value: t.Value})
+		}
+		request.Tag = &tags
+		if err := client.doAction(client.ecsconn(), request, ecs.CreateAddTagsResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
+		}
+	}
+
+	return nil
+}
+
+// changedTags returns the tags which should be created and removed for the resource by comparing
+// the remote tags with the configured ones merged with the default_tags of the provider, so that
+// a change of the default_tags is applied even though the tags of the resource are not changed.
+func (client *AliyunClient) changedTags(d *schema.ResourceData, remote map[string]string) (create, remove []Tag) {
+	o := make(map[string]interface{}, len(remote))
+	for k, v := range remote {
+		o[k] = v
+	}
+	n := client.mergeDefaultTags(d.Get("tags").(map[string]interface{}))
+
+	all, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+	for _, t := range all {
+		if v, ok := remote[t.Key]; !ok || v != t.Value {
+			create = append(create, t)
+		}
+	}
+	return create, remove
+}
+
+// setSlbTags is the same as setTags for the load balancers, whose tags are
+// managed by the SLB API instead of the ECS one.
+func setSlbTags(client *AliyunClient, d *schema.ResourceData) error {
+	tags, err := client.describeSlbTags(client.Region, d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeTags", d.Id())
+	}
+
+	create, remove := client.changedTags(d, slbTagsToMap(tags))
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
+		request := slb.CreateRemoveTagsRequest()
+		request.RegionId = string(client.Region)
+		request.LoadBalancerId = d.Id()
+		request.Tags = slbTagsToString(remove)
+		if err := client.doAction(client.slbconn(), request, slb.CreateRemoveTagsResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
+		}
+	}
+
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+		request := slb.CreateAddTagsRequest()
+		request.RegionId = string(client.Region)
+		request.LoadBalancerId = d.Id()
+		request.Tags = slbTagsToString(create)
+		if err := client.doAction(client.slbconn(), request, slb.CreateAddTagsResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
 		}
 	}
 
 	return nil
 }
 
+// setRdsTags is the same as setTags for the db instances.
+func setRdsTags(client *AliyunClient, d *schema.ResourceData) error {
+	tags, err := client.describeRdsTags(d.Id())
+	if err != nil {
+		return WrapApiError(err, "DescribeTags", d.Id())
+	}
+
+	create, remove := client.changedTags(d, rdsTagsToMap(tags))
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
+		request := rds.CreateRemoveTagsFromResourceRequest()
+		request.DBInstanceId = d.Id()
+		request.Tags = rdsTagsToString(remove)
+		if err := client.doAction(client.rdsconn, request, rds.CreateRemoveTagsFromResourceResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
+		}
+	}
+
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+		request := rds.CreateAddTagsToResourceRequest()
+		request.DBInstanceId = d.Id()
+		request.Tags = rdsTagsToString(create)
+		if err := client.doAction(client.rdsconn, request, rds.CreateAddTagsToResourceResponse()); err != nil {
+			return WrapApiError(err, request.GetActionName(), d.Id())
+		}
+	}
+
+	return nil
+}
+
+// setVpcTags is the same as setTags for the resources of VPC, e.g. NAT gateway, whose
+// tags are managed by the TagResources and UntagResources API.
+func setVpcTags(client *AliyunClient, resourceType string, d *schema.ResourceData) error {
+	return setTagResources(client, client.vpcEndpoint(), VpcApiVersion, resourceType, d)
+}
+
+// setTagResources is the same as setTags for the resources of the products served on domain, like VPC
+// and KVStore, whose tags are managed by the TagResources and UntagResources API.
+func setTagResources(client *AliyunClient, domain, version, resourceType string, d *schema.ResourceData) error {
+	tags, err := client.describeTagResources(domain, version, resourceType, d.Id())
+	if err != nil {
+		return err
+	}
+
+	create, remove := client.changedTags(d, tagResourcesToMap(tags))
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
+		params := map[string]string{
+			"ResourceType": resourceType,
+			"ResourceId.1": d.Id(),
+		}
+		for i, t := range remove {
+			params[fmt.Sprintf("TagKey.%d", i+1)] = t.Key
+		}
+		if err := client.ProcessRpcRequest(domain, version, "UntagResources", params, nil); err != nil {
+			return WrapApiError(err, "UntagResources", d.Id())
+		}
+	}
+
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+		params := map[string]string{
+			"ResourceType": resourceType,
+			"ResourceId.1": d.Id(),
+		}
+		for i, t := range create {
+			params[fmt.Sprintf("Tag.%d.Key", i+1)] = t.Key
+			params[fmt.Sprintf("Tag.%d.Value", i+1)] = t.Value
+		}
+		if err := client.ProcessRpcRequest(domain, version, "TagResources", params, nil); err != nil {
+			return WrapApiError(err, "TagResources", d.Id())
+		}
+	}
+
+	return nil
+}
+
+type TagResource struct {
+	ResourceType string `json:"ResourceType"`
+	ResourceId   string `json:"ResourceId"`
+	TagKey       string `json:"TagKey"`
+	TagValue     string `json:"TagValue"`
+}
+
+type ListTagResourcesResponse struct {
+	RequestId    string `json:"RequestId"`
+	NextToken    string `json:"NextToken"`
+	TagResources struct {
+		TagResource []TagResource `json:"TagResource"`
+	} `json:"TagResources"`
+}
+
+// describeTagResources returns all of the tags of the resource with the specified type, which is served on domain
+// and tagged by the TagResources API.
+func (client *AliyunClient) describeTagResources(domain, version, resourceType, resourceId string) (tags []TagResource, err error) {
+	params := map[string]string{
+		"ResourceType": resourceType,
+		"ResourceId.1": resourceId,
+	}
+	for {
+		var resp ListTagResourcesResponse
+		if err = client.ProcessRpcRequest(domain, version, "ListTagResources", params, &resp); err != nil {
+			return nil, WrapApiError(err, "ListTagResources", resourceId)
+		}
+		tags = append(tags, resp.TagResources.TagResource...)
+		if resp.NextToken == "" {
+			return
+		}
+		params["NextToken"] = resp.NextToken
+	}
+}
+
+// describeEcsResourceIdsByTags returns the ids of the ECS resources with the specified type which have all of the tags.
+func (client *AliyunClient) describeEcsResourceIdsByTags(resourceType TagResourceType, tags map[string]interface{}) (map[string]bool, error) {
+	request := ecs.CreateDescribeResourceByTagsRequest()
+	request.RegionId = string(client.Region)
+	request.ResourceType = string(resourceType)
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	requestTags := make([]ecs.DescribeResourceByTagsTag, 0, len(tags))
+	for k, v := range tags {
+		requestTags = append(requestTags, ecs.DescribeResourceByTagsTag{Key: k, Value: v.(string)})
+	}
+	request.Tag = &requestTags
+
+	ids := make(map[string]bool)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response := ecs.CreateDescribeResourceByTagsResponse()
+		if err := client.doAction(client.ecsconn(), request, response); err != nil {
+			return nil, fmt.Errorf("DescribeResourceByTags got an error: %#v", err)
+		}
+		for _, r := range response.Resources.Resource {
+			ids[r.ResourceId] = true
+		}
+		if len(response.Resources.Resource) < PageSizeLarge {
+			return ids, nil
+		}
+	}
+}
+
+// describeEcsTags returns all of the tags of the ECS resource.
+func (client *AliyunClient) describeEcsTags(region common.Region, resourceType TagResourceType, resourceId string) (tags []ecs.Tag, err error) {
+	request := ecs.CreateDescribeTagsRequest()
+	request.RegionId = string(region)
+	request.ResourceType = string(resourceType)
+	request.ResourceId = resourceId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response := ecs.CreateDescribeTagsResponse()
+		if err = client.doAction(client.ecsconn(), request, response); err != nil {
+			return nil, err
+		}
+		tags = append(tags, response.Tags.Tag...)
+		if len(response.Tags.Tag) < PageSizeLarge {
+			return tags, nil
+		}
+	}
+}
+
+// describeSlbTags returns all of the tags of the load balancer.
+func (client *AliyunClient) describeSlbTags(region common.Region, loadBalancerId string) (tags []slb.TagSet, err error) {
+	request := slb.CreateDescribeTagsRequest()
+	request.RegionId = string(region)
+	request.LoadBalancerId = loadBalancerId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response := slb.CreateDescribeTagsResponse()
+		if err = client.doAction(client.slbconn(), request, response); err != nil {
+			return nil, err
+		}
+		tags = append(tags, response.TagSets.TagSet...)
+		if len(response.TagSets.TagSet) < PageSizeLarge {
+			return tags, nil
+		}
+	}
+}
+
+// describeRdsTags returns all of the tags of the db instance.
+func (client *AliyunClient) describeRdsTags(instanceId string) (tags []rds.TagInfos, err error) {
+	request := rds.CreateDescribeTagsRequest()
+	request.DBInstanceId = instanceId
+	response := rds.CreateDescribeTagsResponse()
+	if err = client.doAction(client.rdsconn, request, response); err != nil {
+		return nil, err
+	}
+	return response.Items.TagInfos, nil
+}
+
+// mergeDefaultTags returns the tags of the resource merged with the default_tags of the provider.
+// The tags of the resource take precedence over the default ones with the same keys.
+func (client *AliyunClient) mergeDefaultTags(tags map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+	for k, v := range client.config.DefaultTags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// ignoreDefaultTags removes the tags applied by the default_tags of the provider from the remote tags,
+// unless they are declared by the resource as well, so that they do not show up as the diff of the resource.
+func (client *AliyunClient) ignoreDefaultTags(d *schema.ResourceData, tags map[string]string) map[string]string {
+	declared := d.Get("tags").(map[string]interface{})
+	result := make(map[string]string)
+	for k, v := range tags {
+		if value, ok := client.config.DefaultTags[k]; ok && value == v {
+			if _, ok := declared[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
@@ -103,7 +386,7 @@ func tagsFromMap(m map[string]interface{}) []Tag {
 	return result
 }
 
-func tagsToMap(tags []ecs.TagItemType) map[string]string {
+func tagsToMap(tags []ecs.Tag) map[string]string {
 	result := make(map[string]string)
 	for _, t := range tags {
 		result[t.TagKey] = t.TagValue
@@ -120,16 +403,65 @@ func essTagsToMap(tags []ess.TagItemType) map[string]string {
 
 	return result
 }
-func tagsToString(tags []ecs.TagItemType) string {
+func tagsToString(tags []ecs.Tag) string {
 	result := make([]string, 0, len(tags))
 
 	for _, tag := range tags {
-		ecsTags := ecs.TagItemType{
-			TagKey:   tag.TagKey,
-			TagValue: tag.TagValue,
-		}
-		result = append(result, ecsTags.TagKey+":"+ecsTags.TagValue)
+		result = append(result, tag.TagKey+":"+tag.TagValue)
 	}
 
 	return strings.Join(result, ",")
 }
+
+func slbTagsToMap(tags []slb.TagSet) map[string]string {
+	result := make(map[string]string)
+	for _, t := range tags {
+		result[t.TagKey] = t.TagValue
+	}
+
+	return result
+}
+
+// slbTagItem is a tag of a load balancer in the JSON format required by the SLB API.
+type slbTagItem struct {
+	TagKey   string `json:"TagKey"`
+	TagValue string `json:"TagValue"`
+}
+
+// slbTagsToString returns the tags in the JSON format required by the SLB API, like [{"TagKey":"k","TagValue":"v"}].
+func slbTagsToString(tags []Tag) string {
+	items := make([]slbTagItem, 0, len(tags))
+	for _, t := range tags {
+		items = append(items, slbTagItem{TagKey: t.Key, TagValue: t.Value})
+	}
+	bs, _ := json.Marshal(items)
+	return string(bs)
+}
+
+func rdsTagsToMap(tags []rds.TagInfos) map[string]string {
+	result := make(map[string]string)
+	for _, t := range tags {
+		result[t.TagKey] = t.TagValue
+	}
+
+	return result
+}
+
+// rdsTagsToString returns the tags in the JSON format required by the RDS API, like {"k":"v"}.
+func rdsTagsToString(tags []Tag) string {
+	items := make(map[string]string)
+	for _, t := range tags {
+		items[t.Key] = t.Value
+	}
+	bs, _ := json.Marshal(items)
+	return string(bs)
+}
+
+func tagResourcesToMap(tags []TagResource) map[string]string {
+	result := make(map[string]string)
+	for _, t := range tags {
+		result[t.TagKey] = t.TagValue
+	}
+
+	return result
+}
@@ -0,0 +1,112 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDefaultTags(t *testing.T) {
+	client := &AliyunClient{
+		config: &Config{
+			DefaultTags: map[string]string{
+				"owner":       "platform",
+				"cost-center": "1001",
+			},
+		},
+	}
+
+	merged := client.mergeDefaultTags(map[string]interface{}{
+		"owner": "database",
+		"name":  "web",
+	})
+	expected := map[string]interface{}{
+		"owner":       "database",
+		"cost-center": "1001",
+		"name":        "web",
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("Expected the merged tags %#v, got %#v", expected, merged)
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"owner": "database",
+			"name":  "web",
+		},
+	})
+	remote := map[string]string{
+		"owner":       "database",
+		"cost-center": "1001",
+		"name":        "web",
+	}
+	if tags := client.ignoreDefaultTags(d, remote); !reflect.DeepEqual(tags, map[string]string{"owner": "database", "name": "web"}) {
+		t.Fatalf("Expected the default tags are ignored, got %#v", tags)
+	}
+}
+
+func TestSetTagsWithChangedDefaultTags(t *testing.T) {
+	removed := make(map[string]bool)
+	created := make(map[string]string)
+	client, server := newTestAliyunClient(t, VpcCode, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if id := r.FormValue("ResourceId.1"); id != "vpc-abc" {
+			t.Errorf("Expected the tags of vpc-abc, got %s", id)
+		}
+		switch r.FormValue("Action") {
+		case "ListTagResources":
+			w.Write([]byte(`{"RequestId":"A1B2C3D4","TagResources":{"TagResource":[` +
+				`{"ResourceType":"VPC","ResourceId":"vpc-abc","TagKey":"name","TagValue":"web"},` +
+				`{"ResourceType":"VPC","ResourceId":"vpc-abc","TagKey":"owner","TagValue":"platform"}]}}`))
+		case "UntagResources":
+			for i := 1; r.FormValue(fmt.Sprintf("TagKey.%d", i)) != ""; i++ {
+				removed[r.FormValue(fmt.Sprintf("TagKey.%d", i))] = true
+			}
+			w.Write([]byte(`{"RequestId":"A1B2C3D4"}`))
+		case "TagResources":
+			for i := 1; r.FormValue(fmt.Sprintf("Tag.%d.Key", i)) != ""; i++ {
+				created[r.FormValue(fmt.Sprintf("Tag.%d.Key", i))] = r.FormValue(fmt.Sprintf("Tag.%d.Value", i))
+			}
+			w.Write([]byte(`{"RequestId":"A1B2C3D4"}`))
+		default:
+			t.Errorf("Unexpected action %s", r.FormValue("Action"))
+		}
+	})
+	defer server.Close()
+
+	// The vpc was tagged with owner=platform by the previous default_tags, which are changed now
+	// while the tags of the vpc itself are kept.
+	client.config.DefaultTags = map[string]string{
+		"owner":       "database",
+		"cost-center": "1001",
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"name": "web",
+		},
+	})
+	d.SetId("vpc-abc")
+	if err := setVpcTags(client, TagResourceVpc, d); err != nil {
+		t.Fatalf("Setting the tags got an error: %#v", err)
+	}
+	if !reflect.DeepEqual(removed, map[string]bool{"owner": true}) {
+		t.Fatalf("Expected the previous default tag is removed, got %#v", removed)
+	}
+	if !reflect.DeepEqual(created, map[string]string{"owner": "database", "cost-center": "1001"}) {
+		t.Fatalf("Expected the changed default tags are created, got %#v", created)
+	}
+}
+
+func TestProductTagsToString(t *testing.T) {
+	tags := []Tag{{Key: "owner", Value: "platform"}}
+
+	if s := slbTagsToString(tags); s != `[{"TagKey":"owner","TagValue":"platform"}]` {
+		t.Fatalf("Unexpected slb tags %s", s)
+	}
+	if s := rdsTagsToString(tags); s != `{"owner":"platform"}` {
+		t.Fatalf("Unexpected rds tags %s", s)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -13,9 +14,7 @@ import (
 	"github.com/denverdino/aliyungo/cdn"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/dns"
-	"github.com/denverdino/aliyungo/ecs"
 	"github.com/denverdino/aliyungo/ram"
-	"github.com/denverdino/aliyungo/slb"
 	"github.com/hashicorp/terraform/helper/schema"
 	"gopkg.in/yaml.v2"
 )
@@ -45,7 +44,7 @@ func validateInstanceProtocol(v interface{}, k string) (ws []string, errors []er
 
 // ecs
 func validateDiskCategory(v interface{}, k string) (ws []string, errors []error) {
-	category := ecs.DiskCategory(v.(string))
+	category := DiskCategory(v.(string))
 	if _, ok := SupportedDiskCategory[category]; !ok {
 		var valid []string
 		for key := range SupportedDiskCategory {
@@ -130,20 +129,20 @@ func validateSecurityGroupDescription(v interface{}, k string) (ws []string, err
 }
 
 func validateSecurityRuleType(v interface{}, k string) (ws []string, errors []error) {
-	rt := ecs.Direction(v.(string))
-	if rt != ecs.DirectionIngress && rt != ecs.DirectionEgress {
-		errors = append(errors, fmt.Errorf("%s must be one of %s %s", k, ecs.DirectionIngress, ecs.DirectionEgress))
+	rt := Direction(v.(string))
+	if rt != DirectionIngress && rt != DirectionEgress {
+		errors = append(errors, fmt.Errorf("%s must be one of %s %s", k, DirectionIngress, DirectionEgress))
 	}
 
 	return
 }
 
 func validateSecurityRuleIpProtocol(v interface{}, k string) (ws []string, errors []error) {
-	pt := ecs.IpProtocol(v.(string))
-	if pt != ecs.IpProtocolTCP && pt != ecs.IpProtocolUDP && pt != ecs.IpProtocolICMP &&
-		pt != ecs.IpProtocolGRE && pt != ecs.IpProtocolAll {
+	pt := IpProtocol(v.(string))
+	if pt != IpProtocolTCP && pt != IpProtocolUDP && pt != IpProtocolICMP &&
+		pt != IpProtocolGRE && pt != IpProtocolAll {
 		errors = append(errors, fmt.Errorf("%s must be one of %s, %s, %s, %s and %s", k,
-			ecs.IpProtocolTCP, ecs.IpProtocolUDP, ecs.IpProtocolICMP, ecs.IpProtocolGRE, ecs.IpProtocolAll))
+			IpProtocolTCP, IpProtocolUDP, IpProtocolICMP, IpProtocolGRE, IpProtocolAll))
 	}
 
 	return
@@ -199,10 +198,10 @@ func validateCIDRNetworkAddress(v interface{}, k string) (ws []string, errors []
 }
 
 func validateRouteEntryNextHopType(v interface{}, k string) (ws []string, errors []error) {
-	nht := ecs.NextHopType(v.(string))
-	if nht != ecs.NextHopIntance && nht != ecs.NextHopTunnelRouterInterface {
+	nht := NextHopType(v.(string))
+	if nht != NextHopInstance && nht != NextHopTunnelRouterInterface {
 		errors = append(errors, fmt.Errorf("%s must be one of %s %s", k,
-			ecs.NextHopIntance, ecs.NextHopTunnelRouterInterface))
+			NextHopInstance, NextHopTunnelRouterInterface))
 	}
 
 	return
@@ -238,12 +237,12 @@ func validateSwitchCIDRNetworkAddress(v interface{}, k string) (ws []string, err
 // represents a IoOptimized - it adds an error otherwise
 func validateIoOptimized(v interface{}, k string) (ws []string, errors []error) {
 	if value := v.(string); value != "" {
-		ioOptimized := ecs.IoOptimized(value)
-		if ioOptimized != ecs.IoOptimizedNone &&
-			ioOptimized != ecs.IoOptimizedOptimized {
+		ioOptimized := IoOptimizedType(value)
+		if ioOptimized != IoOptimizedNone &&
+			ioOptimized != IoOptimizedOptimized {
 			errors = append(errors, fmt.Errorf(
 				"%q must contain a valid IoOptimized, expected %s or %s, got %q",
-				k, ecs.IoOptimizedNone, ecs.IoOptimizedOptimized, ioOptimized))
+				k, IoOptimizedNone, IoOptimizedOptimized, ioOptimized))
 		}
 	}
 
@@ -323,12 +322,12 @@ func validateInstanceChargeTypePeriodUnit(v interface{}, k string) (ws []string,
 }
 
 func validateInstanceStatus(v interface{}, k string) (ws []string, errors []error) {
-	status := ecs.InstanceStatus(v.(string))
-	if status != ecs.Running && status != ecs.Stopped && status != ecs.Creating &&
-		status != ecs.Starting && status != ecs.Stopping {
+	status := Status(v.(string))
+	if status != Running && status != Stopped && status != Creating &&
+		status != Starting && status != Stopping {
 		errors = append(errors, fmt.Errorf(
 			"%q must contain a valid status, expected %s or %s or %s or %s or %s, got %s.",
-			k, ecs.Creating, ecs.Starting, ecs.Running, ecs.Stopping, ecs.Stopped, status))
+			k, Creating, Starting, Running, Stopping, Stopped, status))
 	}
 	return
 }
@@ -364,12 +363,11 @@ func validateSlbInternetChargeType(v interface{}, k string) (ws []string, errors
 
 func validateSlbInstanceSpecType(v interface{}, k string) (ws []string, errors []error) {
 	if value := v.(string); value != "" {
-		specType := slb.LoadBalancerSpecType(value)
-		validLoadBalancerSpec := []slb.LoadBalancerSpecType{slb.S1Small, slb.S2Small,
-			slb.S2Medium, slb.S3Small, slb.S3Medium, slb.S3Large}
+		validLoadBalancerSpec := []string{SlbS1Small, SlbS2Small,
+			SlbS2Medium, SlbS3Small, SlbS3Medium, SlbS3Large}
 
 		for _, s := range validLoadBalancerSpec {
-			if s == specType {
+			if s == value {
 				return
 			}
 		}
@@ -392,9 +390,7 @@ func validateSlbListenerBandwidth(v interface{}, k string) (ws []string, errors
 
 func validateSlbListenerScheduler(v interface{}, k string) (ws []string, errors []error) {
 	if value := v.(string); value != "" {
-		scheduler := slb.SchedulerType(value)
-
-		if scheduler != "wrr" && scheduler != "wlc" {
+		if value != SlbWRRScheduler && value != SlbWLCScheduler {
 			errors = append(errors, fmt.Errorf(
 				"%q must contain a valid SchedulerType, expected %s or %s, got %q",
 				k, "wrr", "wlc", value))
@@ -591,15 +587,15 @@ func validateNameRegex(v interface{}, k string) (ws []string, errors []error) {
 
 func validateImageOwners(v interface{}, k string) (ws []string, errors []error) {
 	if value := v.(string); value != "" {
-		owners := ecs.ImageOwnerAlias(value)
-		if owners != ecs.ImageOwnerSystem &&
-			owners != ecs.ImageOwnerSelf &&
-			owners != ecs.ImageOwnerOthers &&
-			owners != ecs.ImageOwnerMarketplace &&
-			owners != ecs.ImageOwnerDefault {
+		owners := ImageOwnerAlias(value)
+		if owners != ImageOwnerSystem &&
+			owners != ImageOwnerSelf &&
+			owners != ImageOwnerOthers &&
+			owners != ImageOwnerMarketplace &&
+			owners != ImageOwnerDefault {
 			errors = append(errors, fmt.Errorf(
 				"%q must contain a valid Image owner , expected %s, %s, %s, %s or %s, got %q",
-				k, ecs.ImageOwnerSystem, ecs.ImageOwnerSelf, ecs.ImageOwnerOthers, ecs.ImageOwnerMarketplace, ecs.ImageOwnerDefault, owners))
+				k, ImageOwnerSystem, ImageOwnerSelf, ImageOwnerOthers, ImageOwnerMarketplace, ImageOwnerDefault, owners))
 		}
 	}
 	return
@@ -672,6 +668,14 @@ func validateOssBucketDateTimestamp(v interface{}, k string) (ws []string, error
 	return
 }
 
+func validateRdsRestoreTime(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.Parse(RdsRestoreTimeFormat, value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be in the format yyyy-MM-ddTHH:mm:ssZ in UTC, got %s.", k, value))
+	}
+	return
+}
+
 func validateOssBucketObjectServerSideEncryption(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -905,6 +909,14 @@ func validateJsonString(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// validateJsonDocument is like validateJsonString, while it allows the document to be formatted.
+func validateJsonDocument(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := normalizeJsonString(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+	}
+	return
+}
+
 func validatePolicyType(v interface{}, k string) (ws []string, errors []error) {
 	value := ram.Type(v.(string))
 
@@ -1154,11 +1166,11 @@ func validateDBConnectionPort(v interface{}, k string) (ws []string, errors []er
 
 func validateInstanceSpotStrategy(v interface{}, k string) (ws []string, errors []error) {
 	if value := v.(string); value != "" {
-		spot := ecs.SpotStrategyType(value)
-		if spot != ecs.NoSpot && spot != ecs.SpotAsPriceGo && spot != ecs.SpotWithPriceLimit {
+		spot := SpotStrategyType(value)
+		if spot != NoSpot && spot != SpotAsPriceGo && spot != SpotWithPriceLimit {
 			errors = append(errors, fmt.Errorf(
 				"%q must be a valid Spot Strategy value , expected %s, %s or %s, got %q",
-				k, ecs.NoSpot, ecs.SpotAsPriceGo, ecs.SpotWithPriceLimit, spot))
+				k, NoSpot, SpotAsPriceGo, SpotWithPriceLimit, spot))
 		}
 	}
 	return
@@ -1193,11 +1205,22 @@ func validateKmsKeyStatus(v interface{}, k string) (ws []string, errors []error)
 }
 
 func validateNatGatewaySpec(v interface{}, k string) (ws []string, errors []error) {
-	spec := ecs.NatGatewaySpec(v.(string))
-	if spec != ecs.NatGatewaySmallSpec && spec != ecs.NatGatewayMiddleSpec && spec != ecs.NatGatewayLargeSpec {
+	spec := NatGatewaySpec(v.(string))
+	if spec != NatGatewaySmallSpec && spec != NatGatewayMiddleSpec && spec != NatGatewayLargeSpec {
 		errors = append(errors, fmt.Errorf(
 			"%q must contain a valid specification, expected %s or %s or %s, got %s.",
-			k, ecs.NatGatewaySmallSpec, ecs.NatGatewayMiddleSpec, ecs.NatGatewayLargeSpec, spec))
+			k, NatGatewaySmallSpec, NatGatewayMiddleSpec, NatGatewayLargeSpec, spec))
+	}
+	return
+}
+
+func validateProxyUrl(v interface{}, k string) (ws []string, errors []error) {
+	if value := v.(string); value != "" {
+		u, err := url.Parse(value)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5") {
+			errors = append(errors, fmt.Errorf(
+				"%q must be a valid proxy url, like http://proxy.example.com:8080, got %s.", k, value))
+		}
 	}
 	return
 }